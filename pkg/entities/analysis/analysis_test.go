@@ -24,6 +24,7 @@ import (
 
 	vulnerabilityEntities "github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
 	analysisEnum "github.com/ZupIT/horusec-devkit/pkg/enums/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/testutil"
 )
 
 func TestGetTableAnalysis(t *testing.T) {
@@ -40,6 +41,18 @@ func TestToBytesAnalysis(t *testing.T) {
 
 		assert.NotEmpty(t, analysis.ToBytes())
 	})
+
+	t.Run("should match the analysis json golden file", func(t *testing.T) {
+		analysis := &Analysis{
+			ID: uuid.New(), RepositoryID: uuid.New(), RepositoryName: "repository", WorkspaceID: uuid.New(),
+			WorkspaceName: "workspace", Status: analysisEnum.Success, CreatedAt: time.Now(), FinishedAt: time.Now(),
+			AnalysisVulnerabilities: []AnalysisVulnerabilities{{Vulnerability: vulnerabilityEntities.Vulnerability{}}},
+		}
+
+		analysis.SetAllAnalysisVulnerabilitiesDefaultData()
+
+		testutil.AssertGoldenJSON(t, analysis.ToBytes(), "testdata/analysis.json")
+	})
 }
 
 func TestGetID(t *testing.T) {
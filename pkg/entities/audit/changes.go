@@ -0,0 +1,156 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/audit"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/sanitize"
+	sanitizeEnums "github.com/ZupIT/horusec-devkit/pkg/utils/sanitize/enums"
+)
+
+// Change is a field that was changed, nested fields are joined by dots like "config.url"
+type Change struct {
+	Field  string      `json:"field"`
+	Before interface{} `json:"before,omitempty"`
+	After  interface{} `json:"after,omitempty"`
+}
+
+// Changes is saved as a json column
+type Changes []Change
+
+// NewChanges compares the json representation of both values, so only the exported fields are compared and they are
+// named by their json tags. Arrays are compared as a single field and the changes are sorted by the field name.
+func NewChanges(before, after interface{}) (Changes, error) {
+	beforeFields, err := flatten(before)
+	if err != nil {
+		return nil, err
+	}
+
+	afterFields, err := flatten(after)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := Changes{}
+
+	for field, value := range beforeFields {
+		if afterValue, ok := afterFields[field]; !ok || !reflect.DeepEqual(value, afterValue) {
+			changes = append(changes, newChange(field, value, afterFields[field]))
+		}
+	}
+
+	for field, value := range afterFields {
+		if _, ok := beforeFields[field]; !ok {
+			changes = append(changes, newChange(field, nil, value))
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+
+	return changes, nil
+}
+
+func newChange(field string, before, after interface{}) Change {
+	if isSecret(field) {
+		return Change{Field: field, Before: maskValue(before), After: maskValue(after)}
+	}
+
+	return Change{Field: field, Before: before, After: after}
+}
+
+func isSecret(field string) bool {
+	return sanitize.IsSecretName(field[strings.LastIndex(field, ".")+1:])
+}
+
+func maskValue(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+
+	return sanitizeEnums.MaskedValue
+}
+
+// flatten returns the fields of the json object of the value, the values that are not objects are returned as a
+// single field without name
+func flatten(value interface{}) (map[string]interface{}, error) {
+	fields := map[string]interface{}{}
+	if value == nil {
+		return fields, nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, err
+	}
+
+	flattenValue(fields, "", decoded)
+
+	return fields, nil
+}
+
+func flattenValue(fields map[string]interface{}, prefix string, value interface{}) {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		if value != nil {
+			fields[prefix] = value
+		}
+
+		return
+	}
+
+	for key, nested := range object {
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+
+		flattenValue(fields, key, nested)
+	}
+}
+
+func (c Changes) Value() (driver.Value, error) {
+	if c == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(c)
+}
+
+func (c *Changes) Scan(value interface{}) error {
+	*c = nil
+
+	switch typed := value.(type) {
+	case nil:
+		return nil
+	case string:
+		return json.Unmarshal([]byte(typed), c)
+	case []byte:
+		return json.Unmarshal(typed, c)
+	default:
+		return audit.ErrorInvalidChangesValue
+	}
+}
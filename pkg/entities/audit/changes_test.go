@@ -0,0 +1,115 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/audit"
+	sanitizeEnums "github.com/ZupIT/horusec-devkit/pkg/utils/sanitize/enums"
+)
+
+type testResource struct {
+	Name     string            `json:"name"`
+	Password string            `json:"password,omitempty"`
+	Tags     []string          `json:"tags"`
+	Config   map[string]string `json:"config"`
+	internal string
+}
+
+func TestNewChanges(t *testing.T) {
+	t.Run("should return changed, added and removed fields sorted by name", func(t *testing.T) {
+		changes, err := NewChanges(
+			&testResource{Name: "a", Tags: []string{"x"}, Config: map[string]string{"url": "a", "old": "a"}},
+			&testResource{Name: "b", Tags: []string{"x"}, Config: map[string]string{"url": "b", "new": "b"},
+				internal: "test"},
+		)
+
+		assert.NoError(t, err)
+		assert.Equal(t, Changes{
+			{Field: "config.new", After: "b"},
+			{Field: "config.old", Before: "a"},
+			{Field: "config.url", Before: "a", After: "b"},
+			{Field: "name", Before: "a", After: "b"},
+		}, changes)
+	})
+
+	t.Run("should return every field when creating or removing", func(t *testing.T) {
+		changes, err := NewChanges(nil, map[string]interface{}{"name": "a", "tags": []string{"x"}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, Changes{{Field: "name", After: "a"}, {Field: "tags", After: []interface{}{"x"}}}, changes)
+
+		changes, err = NewChanges(map[string]interface{}{"name": "a"}, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, Changes{{Field: "name", Before: "a"}}, changes)
+	})
+
+	t.Run("should mask secret fields", func(t *testing.T) {
+		changes, err := NewChanges(&testResource{Password: "a"},
+			map[string]interface{}{"password": "b", "api_token": "c", "nested": map[string]string{"clientSecret": "d"}})
+
+		assert.NoError(t, err)
+		masked := sanitizeEnums.MaskedValue
+		assert.Contains(t, changes, Change{Field: "password", Before: masked, After: masked})
+		assert.Contains(t, changes, Change{Field: "api_token", After: masked})
+		assert.Contains(t, changes, Change{Field: "nested.clientSecret", After: masked})
+	})
+
+	t.Run("should return empty changes when values are equal", func(t *testing.T) {
+		changes, err := NewChanges(&testResource{Name: "a"}, &testResource{Name: "a"})
+
+		assert.NoError(t, err)
+		assert.Empty(t, changes)
+	})
+
+	t.Run("should compare values that are not objects", func(t *testing.T) {
+		changes, err := NewChanges("a", "b")
+
+		assert.NoError(t, err)
+		assert.Equal(t, Changes{{Before: "a", After: "b"}}, changes)
+	})
+}
+
+func TestChangesValueAndScan(t *testing.T) {
+	t.Run("should save and read changes as json", func(t *testing.T) {
+		value, err := Changes{{Field: "name", Before: "a", After: "b"}}.Value()
+		assert.NoError(t, err)
+
+		changes := Changes{}
+		assert.NoError(t, changes.Scan(value))
+		assert.Equal(t, Changes{{Field: "name", Before: "a", After: "b"}}, changes)
+
+		assert.NoError(t, changes.Scan(`[{"field":"name"}]`))
+		assert.Equal(t, Changes{{Field: "name"}}, changes)
+	})
+
+	t.Run("should save and read nil changes as null", func(t *testing.T) {
+		value, err := Changes(nil).Value()
+		assert.NoError(t, err)
+		assert.Nil(t, value)
+
+		changes := Changes{{Field: "name"}}
+		assert.NoError(t, changes.Scan(nil))
+		assert.Nil(t, changes)
+	})
+
+	t.Run("should return error when invalid database value", func(t *testing.T) {
+		assert.ErrorIs(t, (&Changes{}).Scan(1), audit.ErrorInvalidChangesValue)
+	})
+}
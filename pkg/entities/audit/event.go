@@ -0,0 +1,65 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/audit"
+)
+
+// Event records an administrative action, the actor is the account that made it and the changes are the fields of
+// the resource that were changed, with the secret like fields masked
+//
+//nolint:lll // notations need more than 130 characters
+type Event struct {
+	EventID      uuid.UUID    `json:"eventID" gorm:"Column:event_id" example:"00000000-0000-0000-0000-000000000000"`
+	ActorID      uuid.UUID    `json:"actorID" gorm:"Column:actor_id" example:"00000000-0000-0000-0000-000000000000"`
+	ActorEmail   string       `json:"actorEmail" gorm:"Column:actor_email" example:"horusec@zup.com.br"`
+	Action       audit.Action `json:"action" gorm:"Column:action" enums:"create,update,delete,login,logout,invite,grant,revoke" example:"update"`
+	ResourceType string       `json:"resourceType" gorm:"Column:resource_type" example:"repository"`
+	ResourceID   string       `json:"resourceID" gorm:"Column:resource_id" example:"00000000-0000-0000-0000-000000000000"`
+	WorkspaceID  uuid.UUID    `json:"workspaceID" gorm:"Column:workspace_id" example:"00000000-0000-0000-0000-000000000000"`
+	Changes      Changes      `json:"changes" gorm:"Column:changes"`
+	IP           string       `json:"ip" gorm:"Column:ip" example:"127.0.0.1"`
+	UserAgent    string       `json:"userAgent" gorm:"Column:user_agent" example:"Mozilla/5.0"`
+	CreatedAt    time.Time    `json:"createdAt" gorm:"Column:created_at" example:"2021-12-30T23:59:59Z"`
+}
+
+func (e *Event) GetTable() string {
+	return "audit_events"
+}
+
+func (e *Event) ToBytes() []byte {
+	bytes, _ := json.Marshal(e)
+
+	return bytes
+}
+
+// SetChanges sets the changes between the resource before and after the action, where before is nil on creations and
+// after is nil on removals
+func (e *Event) SetChanges(before, after interface{}) error {
+	changes, err := NewChanges(before, after)
+	if err != nil {
+		return err
+	}
+
+	e.Changes = changes
+
+	return nil
+}
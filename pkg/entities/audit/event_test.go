@@ -0,0 +1,47 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTable(t *testing.T) {
+	t.Run("should return audit events table name", func(t *testing.T) {
+		assert.Equal(t, "audit_events", (&Event{}).GetTable())
+	})
+}
+
+func TestToBytes(t *testing.T) {
+	t.Run("should parse event to bytes", func(t *testing.T) {
+		assert.NotEmpty(t, (&Event{}).ToBytes())
+	})
+}
+
+func TestSetChanges(t *testing.T) {
+	t.Run("should set changes between before and after", func(t *testing.T) {
+		event := &Event{}
+
+		assert.NoError(t, event.SetChanges(map[string]string{"name": "a"}, map[string]string{"name": "b"}))
+		assert.Equal(t, Changes{{Field: "name", Before: "a", After: "b"}}, event.Changes)
+	})
+
+	t.Run("should return error when value can not be parsed to json", func(t *testing.T) {
+		assert.Error(t, (&Event{}).SetChanges(nil, func() {}))
+		assert.Error(t, (&Event{}).SetChanges(func() {}, nil))
+	})
+}
@@ -25,6 +25,7 @@ type Message struct {
 	Subject      string         `json:"subject"`
 	TemplateName email.Template `json:"templateName"`
 	Data         interface{}    `json:"data"`
+	Language     string         `json:"language,omitempty"`
 }
 
 func (m *Message) ToBytes() []byte {
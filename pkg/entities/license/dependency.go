@@ -0,0 +1,40 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package license
+
+import (
+	"encoding/json"
+)
+
+// Dependency is a package found by the SCA tools with the SPDX license expression declared by it, which is empty or
+// NOASSERTION when the package does not declare a license
+type Dependency struct {
+	Name      string `json:"name" example:"github.com/google/uuid"`
+	Version   string `json:"version" example:"v1.3.0"`
+	Ecosystem string `json:"ecosystem" example:"Go"`
+	File      string `json:"file" example:"go.mod"`
+	License   string `json:"license" example:"BSD-3-Clause"`
+}
+
+func (d *Dependency) ToBytes() []byte {
+	bytes, _ := json.Marshal(d)
+
+	return bytes
+}
+
+// ParseLicense parses the license expression of the dependency
+func (d *Dependency) ParseLicense() (*Expression, error) {
+	return ParseExpression(d.License)
+}
@@ -0,0 +1,38 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package license
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	licenseEnums "github.com/ZupIT/horusec-devkit/pkg/enums/license"
+)
+
+func TestDependencyToBytes(t *testing.T) {
+	t.Run("should parse dependency to bytes", func(t *testing.T) {
+		assert.NotEmpty(t, (&Dependency{}).ToBytes())
+	})
+}
+
+func TestParseLicense(t *testing.T) {
+	t.Run("should parse the license expression of the dependency", func(t *testing.T) {
+		expression, err := (&Dependency{License: "Apache-2.0"}).ParseLicense()
+
+		assert.NoError(t, err)
+		assert.Equal(t, licenseEnums.Apache20, expression.License)
+	})
+}
@@ -0,0 +1,205 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package license
+
+import (
+	"fmt"
+	"strings"
+
+	licenseEnums "github.com/ZupIT/horusec-devkit/pkg/enums/license"
+)
+
+const (
+	OperatorAnd  = "AND"
+	OperatorOr   = "OR"
+	OperatorWith = "WITH"
+)
+
+// Expression is a parsed SPDX license expression, the leaves have the license and the optional exception while the
+// others have the AND or OR operator with their operands
+type Expression struct {
+	Operator  string
+	License   licenseEnums.License
+	Exception string
+	Operands  []*Expression
+}
+
+// ParseExpression parses the SPDX license expressions, like "MIT OR (Apache-2.0 AND BSD-3-Clause)", where AND takes
+// precedence over OR. The operators are accepted in any case, since the package managers do not always follow the
+// SPDX specification.
+func ParseExpression(value string) (*Expression, error) {
+	parser := &expressionParser{tokens: tokenizeExpression(value)}
+	if len(parser.tokens) == 0 {
+		return nil, fmt.Errorf("%w: empty expression", licenseEnums.ErrorInvalidExpression)
+	}
+
+	expression, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if parser.position < len(parser.tokens) {
+		return nil, fmt.Errorf("%w: unexpected %s", licenseEnums.ErrorInvalidExpression, parser.tokens[parser.position])
+	}
+
+	return expression, nil
+}
+
+// Licenses returns the licenses of the expression leaves in the order they are declared
+func (e *Expression) Licenses() (licenses []licenseEnums.License) {
+	if e.Operator == "" {
+		return []licenseEnums.License{e.License}
+	}
+
+	for _, operand := range e.Operands {
+		licenses = append(licenses, operand.Licenses()...)
+	}
+
+	return licenses
+}
+
+func (e *Expression) String() string {
+	if e.Operator == "" {
+		if e.Exception != "" {
+			return fmt.Sprintf("%s %s %s", e.License, OperatorWith, e.Exception)
+		}
+
+		return e.License.ToString()
+	}
+
+	operands := make([]string, 0, len(e.Operands))
+	for _, operand := range e.Operands {
+		if operand.Operator != "" {
+			operands = append(operands, "("+operand.String()+")")
+		} else {
+			operands = append(operands, operand.String())
+		}
+	}
+
+	return strings.Join(operands, " "+e.Operator+" ")
+}
+
+func tokenizeExpression(value string) []string {
+	value = strings.NewReplacer("(", " ( ", ")", " ) ").Replace(value)
+
+	return strings.Fields(value)
+}
+
+type expressionParser struct {
+	tokens   []string
+	position int
+}
+
+func (p *expressionParser) parseOr() (*Expression, error) {
+	return p.parseOperation(OperatorOr, p.parseAnd)
+}
+
+func (p *expressionParser) parseAnd() (*Expression, error) {
+	return p.parseOperation(OperatorAnd, p.parseTerm)
+}
+
+func (p *expressionParser) parseOperation(operator string,
+	parseOperand func() (*Expression, error)) (*Expression, error) {
+	operand, err := parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	operands := []*Expression{operand}
+	for p.next(operator) {
+		if operand, err = parseOperand(); err != nil {
+			return nil, err
+		}
+
+		operands = append(operands, operand)
+	}
+
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+
+	return &Expression{Operator: operator, Operands: operands}, nil
+}
+
+func (p *expressionParser) parseTerm() (*Expression, error) {
+	token, ok := p.take()
+	if !ok {
+		return nil, fmt.Errorf("%w: unexpected end", licenseEnums.ErrorInvalidExpression)
+	}
+
+	if token == "(" {
+		return p.parseGroup()
+	}
+
+	if isReservedToken(token) {
+		return nil, fmt.Errorf("%w: unexpected %s", licenseEnums.ErrorInvalidExpression, token)
+	}
+
+	expression := &Expression{License: licenseEnums.ParseStringToLicense(token)}
+	if p.next(OperatorWith) {
+		exception, ok := p.take()
+		if !ok || isReservedToken(exception) {
+			return nil, fmt.Errorf("%w: missing exception", licenseEnums.ErrorInvalidExpression)
+		}
+
+		expression.Exception = exception
+	}
+
+	return expression, nil
+}
+
+func (p *expressionParser) parseGroup() (*Expression, error) {
+	expression, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if token, ok := p.take(); !ok || token != ")" {
+		return nil, fmt.Errorf("%w: missing closing parenthesis", licenseEnums.ErrorInvalidExpression)
+	}
+
+	return expression, nil
+}
+
+// next consumes the token when it is the operator
+func (p *expressionParser) next(operator string) bool {
+	if p.position < len(p.tokens) && strings.EqualFold(p.tokens[p.position], operator) {
+		p.position++
+
+		return true
+	}
+
+	return false
+}
+
+func (p *expressionParser) take() (string, bool) {
+	if p.position >= len(p.tokens) {
+		return "", false
+	}
+
+	p.position++
+
+	return p.tokens[p.position-1], true
+}
+
+func isReservedToken(token string) bool {
+	for _, reserved := range []string{OperatorAnd, OperatorOr, OperatorWith, "(", ")"} {
+		if strings.EqualFold(token, reserved) {
+			return true
+		}
+	}
+
+	return false
+}
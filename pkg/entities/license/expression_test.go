@@ -0,0 +1,60 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package license
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	licenseEnums "github.com/ZupIT/horusec-devkit/pkg/enums/license"
+)
+
+func TestParseExpression(t *testing.T) {
+	t.Run("should parse a single license", func(t *testing.T) {
+		expression, err := ParseExpression("mit")
+
+		assert.NoError(t, err)
+		assert.Equal(t, &Expression{License: licenseEnums.MIT}, expression)
+	})
+
+	t.Run("should parse the operators with and taking precedence over or", func(t *testing.T) {
+		expression, err := ParseExpression("MIT or Apache-2.0 AND BSD-3-Clause")
+
+		assert.NoError(t, err)
+		assert.Equal(t, OperatorOr, expression.Operator)
+		assert.Equal(t, OperatorAnd, expression.Operands[1].Operator)
+		assert.Equal(t, "MIT OR (Apache-2.0 AND BSD-3-Clause)", expression.String())
+	})
+
+	t.Run("should parse the groups and exceptions", func(t *testing.T) {
+		expression, err := ParseExpression("(GPL-2.0+ WITH Classpath-exception-2.0 OR MIT) AND ISC")
+
+		assert.NoError(t, err)
+		assert.Equal(t, OperatorAnd, expression.Operator)
+		assert.Equal(t, []licenseEnums.License{licenseEnums.GPL20OrLater, licenseEnums.MIT, licenseEnums.ISC},
+			expression.Licenses())
+		assert.Equal(t, "Classpath-exception-2.0", expression.Operands[0].Operands[0].Exception)
+		assert.Equal(t, "(GPL-2.0-or-later WITH Classpath-exception-2.0 OR MIT) AND ISC", expression.String())
+	})
+
+	t.Run("should return error when the expression is invalid", func(t *testing.T) {
+		for _, value := range []string{"", "MIT OR", "AND MIT", "(MIT", "MIT)", "MIT WITH", "MIT Apache-2.0"} {
+			_, err := ParseExpression(value)
+
+			assert.ErrorIs(t, err, licenseEnums.ErrorInvalidExpression, value)
+		}
+	})
+}
@@ -0,0 +1,167 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package license
+
+import (
+	"fmt"
+	"strings"
+
+	licenseEnums "github.com/ZupIT/horusec-devkit/pkg/enums/license"
+)
+
+// Policy decides which licenses the dependencies may have. The denied licenses and categories always fail, while the
+// allowed licenses are the only ones that pass when they are set, otherwise every known license passes. The licenses
+// that are unknown and not mentioned by the policy pass only when the unknown licenses are allowed.
+type Policy struct {
+	Allowed          []licenseEnums.License  `json:"allowed"`
+	Denied           []licenseEnums.License  `json:"denied"`
+	DeniedCategories []licenseEnums.Category `json:"deniedCategories"`
+	AllowUnknown     bool                    `json:"allowUnknown"`
+}
+
+// Result is the compliance of a dependency, with the denied licenses of its expression when it is a violation
+type Result struct {
+	Dependency *Dependency                   `json:"dependency"`
+	Status     licenseEnums.ComplianceStatus `json:"status"`
+	Violations []licenseEnums.License        `json:"violations"`
+}
+
+func (r *Result) IsCompliant() bool {
+	return r.Status == licenseEnums.Compliant
+}
+
+// Validate checks the denied categories, since the unknown ones would never match a license
+func (p *Policy) Validate() error {
+	for _, category := range p.DeniedCategories {
+		if !category.IsValid() {
+			return fmt.Errorf("%w: unknown category %s", licenseEnums.ErrorInvalidPolicy, category)
+		}
+	}
+
+	return nil
+}
+
+// Evaluate checks the license expression of the dependency, an OR expression passes when any license passes and an
+// AND expression when all of them pass. The exceptions of the expression are not evaluated, only their licenses.
+func (p *Policy) Evaluate(dependency *Dependency) *Result {
+	result := &Result{Dependency: dependency, Status: licenseEnums.Unknown, Violations: []licenseEnums.License{}}
+
+	expression, err := dependency.ParseLicense()
+	if err == nil {
+		result.Status = p.evaluateExpression(expression)
+	}
+
+	if result.Status == licenseEnums.Violation {
+		result.Violations = p.getViolations(expression)
+	}
+
+	if result.Status == licenseEnums.Unknown && p.AllowUnknown {
+		result.Status = licenseEnums.Compliant
+	}
+
+	return result
+}
+
+func (p *Policy) EvaluateAll(dependencies []*Dependency) []*Result {
+	results := make([]*Result, 0, len(dependencies))
+	for _, dependency := range dependencies {
+		results = append(results, p.Evaluate(dependency))
+	}
+
+	return results
+}
+
+// EvaluateLicense checks a single license, where NOASSERTION and NONE are unknown unless they are denied
+func (p *Policy) EvaluateLicense(license licenseEnums.License) licenseEnums.ComplianceStatus {
+	if containsLicense(p.Denied, license) || p.isCategoryDenied(license.GetCategory()) {
+		return licenseEnums.Violation
+	}
+
+	if containsLicense(p.Allowed, license) {
+		return licenseEnums.Compliant
+	}
+
+	if !license.IsValid() {
+		return licenseEnums.Unknown
+	}
+
+	if len(p.Allowed) > 0 {
+		return licenseEnums.Violation
+	}
+
+	return licenseEnums.Compliant
+}
+
+func (p *Policy) evaluateExpression(expression *Expression) licenseEnums.ComplianceStatus {
+	if expression.Operator == "" {
+		return p.EvaluateLicense(expression.License)
+	}
+
+	status := p.evaluateExpression(expression.Operands[0])
+	for _, operand := range expression.Operands[1:] {
+		operandStatus := p.evaluateExpression(operand)
+
+		if expression.Operator == OperatorOr && statusRank(operandStatus) > statusRank(status) ||
+			expression.Operator == OperatorAnd && statusRank(operandStatus) < statusRank(status) {
+			status = operandStatus
+		}
+	}
+
+	return status
+}
+
+func (p *Policy) getViolations(expression *Expression) (violations []licenseEnums.License) {
+	for _, license := range expression.Licenses() {
+		if p.EvaluateLicense(license) == licenseEnums.Violation && !containsLicense(violations, license) {
+			violations = append(violations, license)
+		}
+	}
+
+	return violations
+}
+
+func (p *Policy) isCategoryDenied(category licenseEnums.Category) bool {
+	for _, denied := range p.DeniedCategories {
+		if denied == category {
+			return true
+		}
+	}
+
+	return false
+}
+
+// statusRank orders the status from the worst to the best, so the OR expressions keep the best status and the AND
+// expressions the worst one
+func statusRank(status licenseEnums.ComplianceStatus) int {
+	switch status {
+	case licenseEnums.Compliant:
+		return 2
+	case licenseEnums.Unknown:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// containsLicense ignores the case, so the custom LicenseRef identifiers of the policy match the dependencies
+func containsLicense(licenses []licenseEnums.License, license licenseEnums.License) bool {
+	for _, value := range licenses {
+		if strings.EqualFold(value.ToString(), license.ToString()) {
+			return true
+		}
+	}
+
+	return false
+}
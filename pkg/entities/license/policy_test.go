@@ -0,0 +1,105 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package license
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	licenseEnums "github.com/ZupIT/horusec-devkit/pkg/enums/license"
+)
+
+func TestValidate(t *testing.T) {
+	t.Run("should return error when a denied category is unknown", func(t *testing.T) {
+		assert.NoError(t, (&Policy{DeniedCategories: []licenseEnums.Category{licenseEnums.CategoryRestricted}}).Validate())
+		assert.ErrorIs(t, (&Policy{DeniedCategories: []licenseEnums.Category{"test"}}).Validate(),
+			licenseEnums.ErrorInvalidPolicy)
+	})
+}
+
+func TestEvaluateLicense(t *testing.T) {
+	t.Run("should allow every known license without allowed licenses", func(t *testing.T) {
+		policy := &Policy{}
+
+		assert.Equal(t, licenseEnums.Compliant, policy.EvaluateLicense(licenseEnums.GPL30Only))
+		assert.Equal(t, licenseEnums.Unknown, policy.EvaluateLicense("LicenseRef-test"))
+		assert.Equal(t, licenseEnums.Unknown, policy.EvaluateLicense(licenseEnums.NoAssertion))
+	})
+
+	t.Run("should allow only the allowed licenses when they are set", func(t *testing.T) {
+		policy := &Policy{Allowed: []licenseEnums.License{licenseEnums.MIT, "licenseref-test"}}
+
+		assert.Equal(t, licenseEnums.Compliant, policy.EvaluateLicense(licenseEnums.MIT))
+		assert.Equal(t, licenseEnums.Compliant, policy.EvaluateLicense("LicenseRef-test"))
+		assert.Equal(t, licenseEnums.Violation, policy.EvaluateLicense(licenseEnums.ISC))
+		assert.Equal(t, licenseEnums.Unknown, policy.EvaluateLicense("LicenseRef-other"))
+	})
+
+	t.Run("should deny the denied licenses and categories even when allowed", func(t *testing.T) {
+		policy := &Policy{
+			Allowed:          []licenseEnums.License{licenseEnums.MIT, licenseEnums.AGPL30Only},
+			Denied:           []licenseEnums.License{licenseEnums.MIT},
+			DeniedCategories: []licenseEnums.Category{licenseEnums.CategoryNetworkCopyleft},
+		}
+
+		assert.Equal(t, licenseEnums.Violation, policy.EvaluateLicense(licenseEnums.MIT))
+		assert.Equal(t, licenseEnums.Violation, policy.EvaluateLicense(licenseEnums.AGPL30Only))
+	})
+}
+
+func TestEvaluate(t *testing.T) {
+	policy := &Policy{DeniedCategories: []licenseEnums.Category{licenseEnums.CategoryStrongCopyleft}}
+
+	t.Run("should pass when any license of an or expression passes", func(t *testing.T) {
+		result := policy.Evaluate(&Dependency{License: "GPL-3.0-only OR MIT"})
+
+		assert.True(t, result.IsCompliant())
+		assert.Empty(t, result.Violations)
+	})
+
+	t.Run("should fail with the denied licenses when any license of an and expression fails", func(t *testing.T) {
+		result := policy.Evaluate(&Dependency{License: "MIT AND (GPL-2.0 OR GPL-3.0+)"})
+
+		assert.Equal(t, licenseEnums.Violation, result.Status)
+		assert.Equal(t, []licenseEnums.License{licenseEnums.GPL20Only, licenseEnums.GPL30OrLater}, result.Violations)
+	})
+
+	t.Run("should return unknown when the license is not declared or not known", func(t *testing.T) {
+		for _, value := range []string{"", "NOASSERTION", "MIT AND LicenseRef-test", "MIT OR"} {
+			assert.Equal(t, licenseEnums.Unknown, policy.Evaluate(&Dependency{License: value}).Status, value)
+		}
+	})
+
+	t.Run("should pass the unknown licenses when allowed", func(t *testing.T) {
+		policy := &Policy{AllowUnknown: true}
+
+		assert.True(t, policy.Evaluate(&Dependency{License: "NOASSERTION"}).IsCompliant())
+	})
+}
+
+func TestEvaluateAll(t *testing.T) {
+	t.Run("should evaluate every dependency", func(t *testing.T) {
+		dependencies := []*Dependency{{Name: "a", License: "MIT"}, {Name: "b", License: "BUSL-1.1"}}
+		policy := &Policy{DeniedCategories: []licenseEnums.Category{licenseEnums.CategoryRestricted}}
+
+		results := policy.EvaluateAll(dependencies)
+
+		assert.Len(t, results, 2)
+		assert.True(t, results[0].IsCompliant())
+		assert.Equal(t, dependencies[1], results[1].Dependency)
+		assert.Equal(t, licenseEnums.Violation, results[1].Status)
+	})
+}
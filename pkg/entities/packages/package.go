@@ -0,0 +1,276 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packages
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/ecosystem"
+)
+
+const (
+	purlScheme          = "pkg:"
+	purlSeparator       = "/"
+	purlVersion         = "@"
+	purlQualifiers      = "?"
+	purlQualifier       = "&"
+	purlQualifierValue  = "="
+	purlSubpath         = "#"
+	purlUnreservedChars = "-._~:"
+)
+
+// Package is the identity of a package on its ecosystem, following the package-url specification where the name
+// has the namespace, like the maven group, the npm scope or the go module path without its last segment
+type Package struct {
+	Ecosystem  ecosystem.Ecosystem `json:"ecosystem" example:"golang"`
+	Namespace  string              `json:"namespace,omitempty" example:"github.com/google"`
+	Name       string              `json:"name" example:"uuid"`
+	Version    string              `json:"version,omitempty" example:"v1.3.0"`
+	Qualifiers map[string]string   `json:"qualifiers,omitempty"`
+	Subpath    string              `json:"subpath,omitempty"`
+}
+
+// ParsePURL parses the package-url, like pkg:npm/%40angular/core@12.0.0, normalizing the names of the ecosystems
+// that are case insensitive
+func ParsePURL(value string) (*Package, error) {
+	remainder, ok := cutPrefixFold(strings.TrimSpace(value), purlScheme)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s must start with %s", ecosystem.ErrorInvalidPURL, value, purlScheme)
+	}
+
+	pkg := &Package{}
+	remainder, pkg.Subpath = cutLast(remainder, purlSubpath)
+	remainder, qualifiers := cutLast(remainder, purlQualifiers)
+	remainder, version := cutLast(strings.Trim(remainder, purlSeparator), purlVersion)
+
+	segments := strings.Split(strings.Trim(remainder, purlSeparator), purlSeparator)
+	if len(segments) < 2 || segments[0] == "" || segments[len(segments)-1] == "" {
+		return nil, fmt.Errorf("%w: %s must have the type and the name", ecosystem.ErrorInvalidPURL, value)
+	}
+
+	pkg.Ecosystem = ecosystem.Ecosystem(strings.ToLower(segments[0]))
+
+	return pkg, pkg.parseComponents(segments[1:], version, qualifiers)
+}
+
+func (p *Package) parseComponents(segments []string, version, qualifiers string) (err error) {
+	for index, segment := range segments {
+		if segments[index], err = url.PathUnescape(segment); err != nil {
+			return fmt.Errorf("%w: %s", ecosystem.ErrorInvalidPURL, err.Error())
+		}
+	}
+
+	p.Name = segments[len(segments)-1]
+	p.Namespace = strings.Join(segments[:len(segments)-1], purlSeparator)
+
+	if p.Version, err = url.PathUnescape(version); err != nil {
+		return fmt.Errorf("%w: %s", ecosystem.ErrorInvalidPURL, err.Error())
+	}
+
+	if p.Subpath, err = parseSubpath(p.Subpath); err != nil {
+		return err
+	}
+
+	if p.Qualifiers, err = parseQualifiers(qualifiers); err != nil {
+		return err
+	}
+
+	p.normalize()
+
+	return nil
+}
+
+func parseSubpath(value string) (string, error) {
+	segments := []string{}
+
+	for _, segment := range strings.Split(value, purlSeparator) {
+		if segment == "" || segment == "." || segment == ".." {
+			continue
+		}
+
+		unescaped, err := url.PathUnescape(segment)
+		if err != nil {
+			return "", fmt.Errorf("%w: %s", ecosystem.ErrorInvalidPURL, err.Error())
+		}
+
+		segments = append(segments, unescaped)
+	}
+
+	return strings.Join(segments, purlSeparator), nil
+}
+
+// parseQualifiers lowers the keys and skips the qualifiers without value, as defined by the specification
+func parseQualifiers(value string) (map[string]string, error) {
+	qualifiers := map[string]string{}
+
+	for _, qualifier := range strings.Split(value, purlQualifier) {
+		key, value, _ := strings.Cut(qualifier, purlQualifierValue)
+		if key == "" || value == "" {
+			continue
+		}
+
+		unescaped, err := url.PathUnescape(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ecosystem.ErrorInvalidPURL, err.Error())
+		}
+
+		qualifiers[strings.ToLower(key)] = unescaped
+	}
+
+	if len(qualifiers) == 0 {
+		return nil, nil
+	}
+
+	return qualifiers, nil
+}
+
+// normalize lowers the names of the ecosystems that compare them ignoring the case, also replacing the underscores
+// of the python packages as defined by the PEP 503
+func (p *Package) normalize() {
+	switch p.Ecosystem {
+	case ecosystem.PyPI:
+		p.Name = strings.ReplaceAll(strings.ToLower(p.Name), "_", "-")
+	case ecosystem.NPM, ecosystem.GitHub, ecosystem.Composer:
+		p.Namespace = strings.ToLower(p.Namespace)
+		p.Name = strings.ToLower(p.Name)
+	}
+}
+
+// PURL returns the canonical package-url, with the qualifiers sorted by their keys
+func (p *Package) PURL() string {
+	builder := strings.Builder{}
+	builder.WriteString(purlScheme + p.Ecosystem.ToString() + purlSeparator)
+
+	if p.Namespace != "" {
+		builder.WriteString(escapeSegments(p.Namespace) + purlSeparator)
+	}
+
+	builder.WriteString(escapePURL(p.Name))
+
+	if p.Version != "" {
+		builder.WriteString(purlVersion + escapePURL(p.Version))
+	}
+
+	if len(p.Qualifiers) > 0 {
+		builder.WriteString(purlQualifiers + p.formatQualifiers())
+	}
+
+	if p.Subpath != "" {
+		builder.WriteString(purlSubpath + escapeSegments(p.Subpath))
+	}
+
+	return builder.String()
+}
+
+func (p *Package) formatQualifiers() string {
+	qualifiers := make([]string, 0, len(p.Qualifiers))
+	for key, value := range p.Qualifiers {
+		qualifiers = append(qualifiers, strings.ToLower(key)+purlQualifierValue+escapePURL(value))
+	}
+
+	sort.Strings(qualifiers)
+
+	return strings.Join(qualifiers, purlQualifier)
+}
+
+// Key returns the package-url without the version, qualifiers and subpath, which identifies the package on all its
+// versions
+func (p *Package) Key() string {
+	return (&Package{Ecosystem: p.Ecosystem, Namespace: p.Namespace, Name: p.Name}).PURL()
+}
+
+// FullName returns the name with the namespace as used by the package managers, like the maven group:artifact
+func (p *Package) FullName() string {
+	if p.Namespace == "" {
+		return p.Name
+	}
+
+	if p.Ecosystem == ecosystem.Maven {
+		return p.Namespace + ":" + p.Name
+	}
+
+	return p.Namespace + purlSeparator + p.Name
+}
+
+// InVersionRange reports if the version of the package is in the version range
+func (p *Package) InVersionRange(value string) (bool, error) {
+	versionRange, err := ParseVersionRange(value)
+	if err != nil {
+		return false, err
+	}
+
+	return versionRange.Contains(p.Version), nil
+}
+
+func (p *Package) ToBytes() []byte {
+	bytes, _ := json.Marshal(p)
+
+	return bytes
+}
+
+func (p *Package) String() string {
+	return p.PURL()
+}
+
+func escapeSegments(value string) string {
+	segments := strings.Split(value, purlSeparator)
+	for index, segment := range segments {
+		segments[index] = escapePURL(segment)
+	}
+
+	return strings.Join(segments, purlSeparator)
+}
+
+// escapePURL percent encodes everything but the unreserved characters and the colon, which is how the specification
+// expects the canonical package-urls
+func escapePURL(value string) string {
+	builder := strings.Builder{}
+
+	for _, char := range []byte(value) {
+		if isUnreservedChar(char) {
+			builder.WriteByte(char)
+		} else {
+			builder.WriteString(fmt.Sprintf("%%%02X", char))
+		}
+	}
+
+	return builder.String()
+}
+
+func isUnreservedChar(char byte) bool {
+	return 'a' <= char && char <= 'z' || 'A' <= char && char <= 'Z' || '0' <= char && char <= '9' ||
+		strings.IndexByte(purlUnreservedChars, char) >= 0
+}
+
+func cutPrefixFold(value, prefix string) (string, bool) {
+	if len(value) < len(prefix) || !strings.EqualFold(value[:len(prefix)], prefix) {
+		return value, false
+	}
+
+	return value[len(prefix):], true
+}
+
+func cutLast(value, separator string) (string, string) {
+	index := strings.LastIndex(value, separator)
+	if index < 0 {
+		return value, ""
+	}
+
+	return value[:index], value[index+len(separator):]
+}
@@ -0,0 +1,138 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packages
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/ecosystem"
+)
+
+func TestParsePURL(t *testing.T) {
+	t.Run("should parse all components of the package url", func(t *testing.T) {
+		pkg, err := ParsePURL("pkg:maven/org.apache.commons/commons-lang3@3.12.0?Classifier=sources&type=jar#src/main")
+
+		assert.NoError(t, err)
+		assert.Equal(t, &Package{
+			Ecosystem:  ecosystem.Maven,
+			Namespace:  "org.apache.commons",
+			Name:       "commons-lang3",
+			Version:    "3.12.0",
+			Qualifiers: map[string]string{"classifier": "sources", "type": "jar"},
+			Subpath:    "src/main",
+		}, pkg)
+	})
+
+	t.Run("should unescape the components and normalize the names", func(t *testing.T) {
+		pkg, err := ParsePURL("PKG:npm/%40Angular/Core@12.0.0-rc.1%2Bbuild")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "@angular", pkg.Namespace)
+		assert.Equal(t, "core", pkg.Name)
+		assert.Equal(t, "12.0.0-rc.1+build", pkg.Version)
+
+		pkg, err = ParsePURL("pkg:pypi/Django_Rest@1.0")
+		assert.NoError(t, err)
+		assert.Equal(t, "django-rest", pkg.Name)
+	})
+
+	t.Run("should parse the go modules with their path on the namespace", func(t *testing.T) {
+		pkg, err := ParsePURL("pkg:golang/github.com/google/uuid@v1.3.0")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "github.com/google", pkg.Namespace)
+		assert.Equal(t, "uuid", pkg.Name)
+		assert.Nil(t, pkg.Qualifiers)
+	})
+
+	t.Run("should return error when the package url is invalid", func(t *testing.T) {
+		for _, value := range []string{"", "npm/test", "pkg:npm", "pkg:/test", "pkg:npm/", "pkg:npm/te%zzst"} {
+			_, err := ParsePURL(value)
+
+			assert.ErrorIs(t, err, ecosystem.ErrorInvalidPURL, value)
+		}
+	})
+}
+
+func TestPURL(t *testing.T) {
+	t.Run("should return the canonical package url", func(t *testing.T) {
+		pkg := &Package{
+			Ecosystem:  ecosystem.NPM,
+			Namespace:  "@angular",
+			Name:       "core",
+			Version:    "12.0.0+build",
+			Qualifiers: map[string]string{"repository_url": "https://example.com", "arch": "x86 64"},
+			Subpath:    "lib/index",
+		}
+
+		assert.Equal(t, "pkg:npm/%40angular/core@12.0.0%2Bbuild?arch=x86%2064&repository_url=https:%2F%2Fexample.com"+
+			"#lib/index", pkg.PURL())
+		assert.Equal(t, pkg.PURL(), pkg.String())
+	})
+
+	t.Run("should parse the formatted package url back", func(t *testing.T) {
+		for _, value := range []string{
+			"pkg:golang/github.com/google/uuid@v1.3.0",
+			"pkg:maven/org.apache.commons/commons-lang3@3.12.0?type=jar",
+			"pkg:npm/%40angular/core@12.0.0",
+			"pkg:gem/rails",
+		} {
+			pkg, err := ParsePURL(value)
+
+			assert.NoError(t, err)
+			assert.Equal(t, value, pkg.PURL())
+		}
+	})
+}
+
+func TestKey(t *testing.T) {
+	t.Run("should return the package url without the version", func(t *testing.T) {
+		pkg := &Package{Ecosystem: ecosystem.Go, Namespace: "github.com/google", Name: "uuid", Version: "v1.3.0",
+			Qualifiers: map[string]string{"type": "module"}}
+
+		assert.Equal(t, "pkg:golang/github.com/google/uuid", pkg.Key())
+	})
+}
+
+func TestFullName(t *testing.T) {
+	t.Run("should return the name with the namespace of the ecosystem", func(t *testing.T) {
+		assert.Equal(t, "org.apache:commons", (&Package{Ecosystem: ecosystem.Maven, Namespace: "org.apache",
+			Name: "commons"}).FullName())
+		assert.Equal(t, "@angular/core", (&Package{Ecosystem: ecosystem.NPM, Namespace: "@angular",
+			Name: "core"}).FullName())
+		assert.Equal(t, "rails", (&Package{Ecosystem: ecosystem.RubyGems, Name: "rails"}).FullName())
+	})
+}
+
+func TestInVersionRange(t *testing.T) {
+	t.Run("should check the version of the package", func(t *testing.T) {
+		pkg := &Package{Ecosystem: ecosystem.Go, Name: "test", Version: "v1.2.0"}
+
+		inRange, err := pkg.InVersionRange(">= 1.0.0, < 1.2.1")
+		assert.NoError(t, err)
+		assert.True(t, inRange)
+
+		_, err = pkg.InVersionRange("^1.0.0")
+		assert.ErrorIs(t, err, ecosystem.ErrorInvalidVersionRange)
+	})
+}
+
+func TestPackageToBytes(t *testing.T) {
+	t.Run("should parse package to bytes", func(t *testing.T) {
+		assert.NotEmpty(t, (&Package{}).ToBytes())
+	})
+}
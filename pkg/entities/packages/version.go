@@ -0,0 +1,288 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packages
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/ecosystem"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/semver"
+)
+
+const (
+	operatorEqual          = "="
+	operatorDoubleEqual    = "=="
+	operatorNotEqual       = "!="
+	operatorGreater        = ">"
+	operatorGreaterOrEqual = ">="
+	operatorLess           = "<"
+	operatorLessOrEqual    = "<="
+
+	// unsupportedOperators are the npm and cargo shorthands, which depend on the rules of each ecosystem
+	unsupportedOperators = "^~"
+
+	rangeAny = "*"
+	rangeOr  = "||"
+)
+
+// nolint:gochecknoglobals // compiled once, the expressions are constant
+var (
+	comparatorPattern = regexp.MustCompile(`(>=|<=|!=|==|>|<|=)?\s*([^\s,<>=!|]+)`)
+	intervalPattern   = regexp.MustCompile(`([\[(])\s*([^,\[\]()]*?)\s*(?:(,)\s*([^,\[\]()]*?)\s*)?([\])])`)
+)
+
+// CompareVersions compares the semantic versions by their precedence. The other versions are compared by their
+// numeric segments, ignoring the v prefix and the build metadata, where a prerelease is lower than its release, and
+// the segments that are not numbers are compared as text, so it is an approximation for the ecosystems that are not
+// semantic versioned.
+func CompareVersions(first, second string) int {
+	if result, err := semver.Compare(first, second); err == nil {
+		return result
+	}
+
+	firstRelease, firstPrerelease := splitVersion(first)
+	secondRelease, secondPrerelease := splitVersion(second)
+
+	if result := compareSegments(firstRelease, secondRelease, "0"); result != 0 {
+		return result
+	}
+
+	switch {
+	case firstPrerelease == "" && secondPrerelease == "":
+		return 0
+	case firstPrerelease == "":
+		return 1
+	case secondPrerelease == "":
+		return -1
+	}
+
+	return compareSegments(strings.Split(firstPrerelease, "."), strings.Split(secondPrerelease, "."), "")
+}
+
+func splitVersion(version string) ([]string, string) {
+	version = strings.TrimPrefix(strings.TrimPrefix(strings.TrimSpace(version), "v"), "V")
+	version, _, _ = strings.Cut(version, "+")
+	release, prerelease, _ := strings.Cut(version, "-")
+
+	return strings.Split(release, "."), prerelease
+}
+
+// compareSegments fills the missing segments with the filler, so 1.0 is equal to 1.0.0 and the prerelease alpha is
+// lower than alpha.1
+func compareSegments(first, second []string, filler string) int {
+	for index := 0; index < len(first) || index < len(second); index++ {
+		if result := compareSegment(segmentAt(first, index, filler), segmentAt(second, index, filler)); result != 0 {
+			return result
+		}
+	}
+
+	return 0
+}
+
+func segmentAt(segments []string, index int, filler string) string {
+	if index < len(segments) {
+		return segments[index]
+	}
+
+	return filler
+}
+
+// compareSegment compares the numbers by their values, and they are lower than the texts
+func compareSegment(first, second string) int {
+	firstNumber, firstErr := strconv.ParseUint(first, 10, 64)
+	secondNumber, secondErr := strconv.ParseUint(second, 10, 64)
+
+	switch {
+	case firstErr == nil && secondErr == nil:
+		return compareOrdered(firstNumber, secondNumber)
+	case firstErr == nil && second != "":
+		return -1
+	case secondErr == nil && first != "":
+		return 1
+	}
+
+	return strings.Compare(first, second)
+}
+
+func compareOrdered(first, second uint64) int {
+	switch {
+	case first < second:
+		return -1
+	case first > second:
+		return 1
+	}
+
+	return 0
+}
+
+type comparator struct {
+	operator string
+	version  string
+}
+
+func (c *comparator) matches(version string) bool {
+	result := CompareVersions(version, c.version)
+
+	switch c.operator {
+	case operatorGreater:
+		return result > 0
+	case operatorGreaterOrEqual:
+		return result >= 0
+	case operatorLess:
+		return result < 0
+	case operatorLessOrEqual:
+		return result <= 0
+	case operatorNotEqual:
+		return result != 0
+	default:
+		return result == 0
+	}
+}
+
+// VersionRange is a set of alternatives where a version is contained when it matches all comparators of any of them
+type VersionRange struct {
+	value        string
+	alternatives [][]*comparator
+}
+
+// ParseVersionRange parses the version ranges of the advisories and package managers, like ">= 1.0.0, < 1.2.3",
+// ">=1.0.0 <2.0.0 || 3.0.0", "==1.0.0" and the maven intervals, like "[1.0,2.0)" or "(,1.0],[1.2,)". An empty range
+// or * contains every version.
+func ParseVersionRange(value string) (*VersionRange, error) {
+	versionRange := &VersionRange{value: strings.TrimSpace(value)}
+	if versionRange.value == "" || versionRange.value == rangeAny {
+		versionRange.alternatives = [][]*comparator{{}}
+
+		return versionRange, nil
+	}
+
+	if strings.HasPrefix(versionRange.value, "[") || strings.HasPrefix(versionRange.value, "(") {
+		return versionRange, versionRange.parseIntervals()
+	}
+
+	for _, alternative := range strings.Split(versionRange.value, rangeOr) {
+		comparators, err := parseComparators(alternative)
+		if err != nil {
+			return nil, err
+		}
+
+		versionRange.alternatives = append(versionRange.alternatives, comparators)
+	}
+
+	return versionRange, nil
+}
+
+func parseComparators(value string) ([]*comparator, error) {
+	comparators := []*comparator{}
+
+	for _, match := range comparatorPattern.FindAllStringSubmatch(value, -1) {
+		operator := match[1]
+		if strings.ContainsAny(match[2], unsupportedOperators) {
+			return nil, fmt.Errorf("%w: %s is not supported", ecosystem.ErrorInvalidVersionRange, match[2])
+		}
+
+		if operator == operatorDoubleEqual {
+			operator = operatorEqual
+		}
+
+		comparators = append(comparators, &comparator{operator: operator, version: match[2]})
+	}
+
+	if len(comparators) == 0 || strings.Trim(comparatorPattern.ReplaceAllString(value, ""), " ,") != "" {
+		return nil, fmt.Errorf("%w: %s", ecosystem.ErrorInvalidVersionRange, value)
+	}
+
+	return comparators, nil
+}
+
+// parseIntervals parses the maven intervals, where the brackets include the limit and the parentheses exclude it
+func (v *VersionRange) parseIntervals() error {
+	for _, match := range intervalPattern.FindAllStringSubmatch(v.value, -1) {
+		comparators, err := parseInterval(match[1], match[2], match[3] != "", match[4], match[5])
+		if err != nil {
+			return err
+		}
+
+		v.alternatives = append(v.alternatives, comparators)
+	}
+
+	if len(v.alternatives) == 0 || strings.Trim(intervalPattern.ReplaceAllString(v.value, ""), " ,") != "" {
+		return fmt.Errorf("%w: %s", ecosystem.ErrorInvalidVersionRange, v.value)
+	}
+
+	return nil
+}
+
+func parseInterval(opening, lower string, hasComma bool, upper, closing string) ([]*comparator, error) {
+	if !hasComma {
+		if lower == "" || opening != "[" || closing != "]" {
+			return nil, fmt.Errorf("%w: %s%s%s", ecosystem.ErrorInvalidVersionRange, opening, lower, closing)
+		}
+
+		return []*comparator{{operator: operatorEqual, version: lower}}, nil
+	}
+
+	comparators := []*comparator{}
+	if lower != "" {
+		comparators = append(comparators, &comparator{operator: intervalOperator(opening == "[", true), version: lower})
+	}
+
+	if upper != "" {
+		comparators = append(comparators, &comparator{operator: intervalOperator(closing == "]", false), version: upper})
+	}
+
+	return comparators, nil
+}
+
+func intervalOperator(inclusive, isLower bool) string {
+	switch {
+	case isLower && inclusive:
+		return operatorGreaterOrEqual
+	case isLower:
+		return operatorGreater
+	case inclusive:
+		return operatorLessOrEqual
+	default:
+		return operatorLess
+	}
+}
+
+// Contains reports if the version matches all comparators of any alternative of the range
+func (v *VersionRange) Contains(version string) bool {
+	for _, alternative := range v.alternatives {
+		if matchesAll(alternative, version) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (v *VersionRange) String() string {
+	return v.value
+}
+
+func matchesAll(comparators []*comparator, version string) bool {
+	for _, comparator := range comparators {
+		if !comparator.matches(version) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,90 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packages
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/ecosystem"
+)
+
+func TestCompareVersions(t *testing.T) {
+	t.Run("should compare the versions", func(t *testing.T) {
+		for _, versions := range [][2]string{
+			{"1.0.0", "1.0.1"},
+			{"v1.9.0", "1.10.0"},
+			{"1.0.0-alpha", "1.0.0"},
+			{"1.0.0-alpha", "1.0.0-alpha.1"},
+			{"1.0.0-alpha.1", "1.0.0-alpha.beta"},
+			{"1.0.0-beta.2", "1.0.0-beta.11"},
+			{"1.0.0.Beta", "1.0.0.Final"},
+		} {
+			assert.Equal(t, -1, CompareVersions(versions[0], versions[1]), versions)
+			assert.Equal(t, 1, CompareVersions(versions[1], versions[0]), versions)
+		}
+	})
+
+	t.Run("should return zero when the versions are equal", func(t *testing.T) {
+		assert.Zero(t, CompareVersions("1.0", "v1.0.0"))
+		assert.Zero(t, CompareVersions("1.0.0+build.1", "1.0.0+build.2"))
+	})
+}
+
+func TestParseVersionRange(t *testing.T) {
+	t.Run("should parse the comparators", func(t *testing.T) {
+		versionRange, err := ParseVersionRange(">= 1.0.0, < 1.2.3 || ==2.0.0 || >3.0.0 <=3.1.0 != 3.0.5")
+
+		assert.NoError(t, err)
+		assert.True(t, versionRange.Contains("1.0.0"))
+		assert.True(t, versionRange.Contains("1.2.2"))
+		assert.False(t, versionRange.Contains("1.2.3"))
+		assert.True(t, versionRange.Contains("v2.0.0"))
+		assert.False(t, versionRange.Contains("3.0.0"))
+		assert.False(t, versionRange.Contains("3.0.5"))
+		assert.True(t, versionRange.Contains("3.1.0"))
+	})
+
+	t.Run("should parse the maven intervals", func(t *testing.T) {
+		versionRange, err := ParseVersionRange("(,1.0],[1.2,1.5),[2.0]")
+
+		assert.NoError(t, err)
+		assert.True(t, versionRange.Contains("0.9"))
+		assert.True(t, versionRange.Contains("1.0"))
+		assert.False(t, versionRange.Contains("1.1"))
+		assert.True(t, versionRange.Contains("1.2"))
+		assert.False(t, versionRange.Contains("1.5"))
+		assert.True(t, versionRange.Contains("2.0"))
+		assert.Equal(t, "(,1.0],[1.2,1.5),[2.0]", versionRange.String())
+	})
+
+	t.Run("should contain every version when the range is empty", func(t *testing.T) {
+		for _, value := range []string{"", "*"} {
+			versionRange, err := ParseVersionRange(value)
+
+			assert.NoError(t, err)
+			assert.True(t, versionRange.Contains("1.0.0"))
+		}
+	})
+
+	t.Run("should return error when the range is invalid", func(t *testing.T) {
+		for _, value := range []string{">=", "~1.0", "^1.0.0", "[1.0", "(1.0)", "[1.0,2.0) test", "1.0 ||"} {
+			_, err := ParseVersionRange(value)
+
+			assert.ErrorIs(t, err, ecosystem.ErrorInvalidVersionRange, value)
+		}
+	})
+}
@@ -0,0 +1,68 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retention
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Policy limits how long the analyses are kept, removing the analyses older than the keep days and the ones beyond
+// the max analyses of each repository, where zero disables the rule. The policy applies to every repository when the
+// workspace and repository are not set, to the repositories of the workspace when only it is set, or to the
+// repository otherwise.
+//
+//nolint:lll // notations need more than 130 characters
+type Policy struct {
+	PolicyID     uuid.UUID `json:"policyID" gorm:"Column:policy_id" example:"00000000-0000-0000-0000-000000000000"`
+	WorkspaceID  uuid.UUID `json:"workspaceID" gorm:"Column:workspace_id" example:"00000000-0000-0000-0000-000000000000"`
+	RepositoryID uuid.UUID `json:"repositoryID" gorm:"Column:repository_id" example:"00000000-0000-0000-0000-000000000000"`
+	KeepDays     int       `json:"keepDays" gorm:"Column:keep_days" example:"90"`
+	MaxAnalyses  int       `json:"maxAnalyses" gorm:"Column:max_analyses" example:"100"`
+	CreatedAt    time.Time `json:"createdAt" gorm:"Column:created_at" example:"2021-12-30T23:59:59Z"`
+	UpdatedAt    time.Time `json:"updatedAt" gorm:"Column:updated_at" example:"2021-12-30T23:59:59Z"`
+}
+
+func (p *Policy) GetTable() string {
+	return "retention_policies"
+}
+
+func (p *Policy) ToBytes() []byte {
+	bytes, _ := json.Marshal(p)
+
+	return bytes
+}
+
+// IsValid reports if the rules are not negative
+func (p *Policy) IsValid() bool {
+	return p.KeepDays >= 0 && p.MaxAnalyses >= 0
+}
+
+// IsEnabled reports if any rule removes analyses
+func (p *Policy) IsEnabled() bool {
+	return p.KeepDays > 0 || p.MaxAnalyses > 0
+}
+
+// GetCutoff returns the creation date before which the analyses are expired, or the zero time when the keep days
+// rule is disabled
+func (p *Policy) GetCutoff(now time.Time) time.Time {
+	if p.KeepDays <= 0 {
+		return time.Time{}
+	}
+
+	return now.AddDate(0, 0, -p.KeepDays)
+}
@@ -0,0 +1,59 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retention
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTable(t *testing.T) {
+	t.Run("should return retention policies table name", func(t *testing.T) {
+		assert.Equal(t, "retention_policies", (&Policy{}).GetTable())
+	})
+}
+
+func TestToBytes(t *testing.T) {
+	t.Run("should parse policy to bytes", func(t *testing.T) {
+		assert.NotEmpty(t, (&Policy{}).ToBytes())
+	})
+}
+
+func TestIsValid(t *testing.T) {
+	t.Run("should return false when any rule is negative", func(t *testing.T) {
+		assert.True(t, (&Policy{KeepDays: 90}).IsValid())
+		assert.False(t, (&Policy{KeepDays: -1}).IsValid())
+		assert.False(t, (&Policy{MaxAnalyses: -1}).IsValid())
+	})
+}
+
+func TestIsEnabled(t *testing.T) {
+	t.Run("should return true when any rule is set", func(t *testing.T) {
+		assert.True(t, (&Policy{KeepDays: 90}).IsEnabled())
+		assert.True(t, (&Policy{MaxAnalyses: 10}).IsEnabled())
+		assert.False(t, (&Policy{}).IsEnabled())
+	})
+}
+
+func TestGetCutoff(t *testing.T) {
+	t.Run("should return the date before which analyses are expired", func(t *testing.T) {
+		now := time.Date(2021, 3, 31, 0, 0, 0, 0, time.UTC)
+
+		assert.Equal(t, time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC), (&Policy{KeepDays: 30}).GetCutoff(now))
+		assert.True(t, (&Policy{}).GetCutoff(now).IsZero())
+	})
+}
@@ -0,0 +1,56 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scim
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/scim"
+)
+
+// Group is provisioned as the membership of a workspace, where the members are references to the users
+type Group struct {
+	Schemas     []scim.Schema `json:"schemas"`
+	ID          string        `json:"id,omitempty"`
+	ExternalID  string        `json:"externalId,omitempty"`
+	DisplayName string        `json:"displayName"`
+	Members     []Reference   `json:"members,omitempty"`
+	Meta        *Meta         `json:"meta,omitempty"`
+}
+
+func (g *Group) GetID() string {
+	return g.ID
+}
+
+func (g *Group) SetMeta(resourceType, location string) {
+	if g.Meta == nil {
+		g.Meta = &Meta{}
+	}
+
+	g.Meta.ResourceType = resourceType
+	g.Meta.Location = location
+	g.Schemas = []scim.Schema{scim.GroupSchema}
+}
+
+func (g *Group) Validate() error {
+	return validation.ValidateStruct(g,
+		validation.Field(&g.DisplayName, validation.Required),
+		validation.Field(&g.Members, validation.Each(validation.By(func(value interface{}) error {
+			member, _ := value.(Reference)
+
+			return validation.Validate(member.Value, validation.Required)
+		}))),
+	)
+}
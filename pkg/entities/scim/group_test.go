@@ -0,0 +1,52 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/scim"
+)
+
+func TestGroupValidate(t *testing.T) {
+	t.Run("should return no error when group is valid", func(t *testing.T) {
+		assert.NoError(t, (&Group{DisplayName: "workspace", Members: []Reference{{Value: "1"}}}).Validate())
+	})
+
+	t.Run("should return error when display name or member value is empty", func(t *testing.T) {
+		assert.Error(t, (&Group{}).Validate())
+		assert.Error(t, (&Group{DisplayName: "workspace", Members: []Reference{{}}}).Validate())
+	})
+}
+
+func TestNewError(t *testing.T) {
+	t.Run("should return error with string status", func(t *testing.T) {
+		err := NewError(400, scim.InvalidFilter, "test")
+
+		assert.Equal(t, "400", err.Status)
+		assert.Equal(t, []scim.Schema{scim.ErrorSchema}, err.Schemas)
+	})
+}
+
+func TestNewListResponse(t *testing.T) {
+	t.Run("should return list response", func(t *testing.T) {
+		response := NewListResponse([]*Group{}, 10, 1, 0)
+
+		assert.Equal(t, 10, response.TotalResults)
+		assert.Equal(t, []scim.Schema{scim.ListResponseSchema}, response.Schemas)
+	})
+}
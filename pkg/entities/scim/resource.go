@@ -0,0 +1,101 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scim
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/scim"
+)
+
+// Resource is implemented by the users and groups, so the handlers can validate them and fill their meta
+type Resource interface {
+	GetID() string
+	SetMeta(resourceType, location string)
+	Validate() error
+}
+
+type Meta struct {
+	ResourceType string     `json:"resourceType,omitempty"`
+	Created      *time.Time `json:"created,omitempty"`
+	LastModified *time.Time `json:"lastModified,omitempty"`
+	Location     string     `json:"location,omitempty"`
+	Version      string     `json:"version,omitempty"`
+}
+
+// MultiValued is an item of the multi valued attributes, like the emails of an user
+type MultiValued struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+	Display string `json:"display,omitempty"`
+}
+
+// Reference points to another resource, like the members of a group and the groups of an user
+type Reference struct {
+	Value   string `json:"value"`
+	Ref     string `json:"$ref,omitempty"`
+	Display string `json:"display,omitempty"`
+	Type    string `json:"type,omitempty"`
+}
+
+type ListResponse struct {
+	Schemas      []scim.Schema `json:"schemas"`
+	TotalResults int           `json:"totalResults"`
+	StartIndex   int           `json:"startIndex"`
+	ItemsPerPage int           `json:"itemsPerPage"`
+	Resources    interface{}   `json:"Resources"`
+}
+
+func NewListResponse(resources interface{}, total, startIndex, itemsPerPage int) *ListResponse {
+	return &ListResponse{
+		Schemas:      []scim.Schema{scim.ListResponseSchema},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: itemsPerPage,
+		Resources:    resources,
+	}
+}
+
+// PatchOperation has the value as raw json, since its type depends on the path, so the providers decode it
+type PatchOperation struct {
+	Op    scim.Operation  `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+type PatchRequest struct {
+	Schemas    []scim.Schema    `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+// Error is the body of the error responses, where the status is a string as defined by the specification
+type Error struct {
+	Schemas  []scim.Schema  `json:"schemas"`
+	Status   string         `json:"status"`
+	ScimType scim.ErrorType `json:"scimType,omitempty"`
+	Detail   string         `json:"detail,omitempty"`
+}
+
+func NewError(status int, scimType scim.ErrorType, detail string) *Error {
+	return &Error{
+		Schemas:  []scim.Schema{scim.ErrorSchema},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+	}
+}
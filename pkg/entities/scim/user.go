@@ -0,0 +1,84 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scim
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/scim"
+	validationUtils "github.com/ZupIT/horusec-devkit/pkg/utils/validation"
+)
+
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+}
+
+// User is provisioned as a horusec account, where the user name is usually the email of the user on the identity
+// provider and the groups are read only, changed through the members of the groups
+type User struct {
+	Schemas     []scim.Schema `json:"schemas"`
+	ID          string        `json:"id,omitempty"`
+	ExternalID  string        `json:"externalId,omitempty"`
+	UserName    string        `json:"userName"`
+	Name        *Name         `json:"name,omitempty"`
+	DisplayName string        `json:"displayName,omitempty"`
+	Active      bool          `json:"active"`
+	Emails      []MultiValued `json:"emails,omitempty"`
+	Groups      []Reference   `json:"groups,omitempty"`
+	Meta        *Meta         `json:"meta,omitempty"`
+}
+
+func (u *User) GetID() string {
+	return u.ID
+}
+
+func (u *User) SetMeta(resourceType, location string) {
+	if u.Meta == nil {
+		u.Meta = &Meta{}
+	}
+
+	u.Meta.ResourceType = resourceType
+	u.Meta.Location = location
+	u.Schemas = []scim.Schema{scim.UserSchema}
+}
+
+func (u *User) Validate() error {
+	return validation.ValidateStruct(u,
+		validation.Field(&u.UserName, validation.Required),
+		validation.Field(&u.Emails, validation.Each(validation.By(func(value interface{}) error {
+			email, _ := value.(MultiValued)
+
+			return validation.Validate(email.Value, validationUtils.EmailValidationRules()...)
+		}))),
+	)
+}
+
+// PrimaryEmail returns the email marked as primary, the first email when none is marked, or the user name when the
+// user has no emails
+func (u *User) PrimaryEmail() string {
+	for _, email := range u.Emails {
+		if email.Primary {
+			return email.Value
+		}
+	}
+
+	if len(u.Emails) > 0 {
+		return u.Emails[0].Value
+	}
+
+	return u.UserName
+}
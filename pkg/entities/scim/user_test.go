@@ -0,0 +1,56 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/scim"
+)
+
+func TestUserValidate(t *testing.T) {
+	t.Run("should return no error when user is valid", func(t *testing.T) {
+		user := &User{UserName: "user", Emails: []MultiValued{{Value: "user@horusec.io"}}}
+
+		assert.NoError(t, user.Validate())
+	})
+
+	t.Run("should return error when user name is empty or email is invalid", func(t *testing.T) {
+		assert.Error(t, (&User{}).Validate())
+		assert.Error(t, (&User{UserName: "user", Emails: []MultiValued{{Value: "invalid"}}}).Validate())
+	})
+}
+
+func TestPrimaryEmail(t *testing.T) {
+	t.Run("should return primary email, first email or user name", func(t *testing.T) {
+		assert.Equal(t, "b@horusec.io", (&User{Emails: []MultiValued{{Value: "a@horusec.io"},
+			{Value: "b@horusec.io", Primary: true}}}).PrimaryEmail())
+		assert.Equal(t, "a@horusec.io", (&User{Emails: []MultiValued{{Value: "a@horusec.io"}}}).PrimaryEmail())
+		assert.Equal(t, "user", (&User{UserName: "user"}).PrimaryEmail())
+	})
+}
+
+func TestSetMeta(t *testing.T) {
+	t.Run("should set meta and schemas", func(t *testing.T) {
+		user := &User{ID: "1"}
+		user.SetMeta("User", "/scim/v2/Users/1")
+
+		assert.Equal(t, "/scim/v2/Users/1", user.Meta.Location)
+		assert.Equal(t, []scim.Schema{scim.UserSchema}, user.Schemas)
+		assert.Equal(t, "1", user.GetID())
+	})
+}
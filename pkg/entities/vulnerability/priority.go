@@ -0,0 +1,77 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vulnerability
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+)
+
+// SetExploitability sets the epss score and percentile of the vulnerability and if it is on the kev catalog
+func (v *Vulnerability) SetExploitability(score, percentile float64, knownExploited bool) {
+	v.EPSSScore = score
+	v.EPSSPercentile = percentile
+	v.KnownExploited = knownExploited
+}
+
+// IsLikelyExploited returns true when the vulnerability is known to be exploited or its epss score, the probability
+// of being exploited on the next 30 days, is at least the threshold
+func (v *Vulnerability) IsLikelyExploited(threshold float64) bool {
+	return v.KnownExploited || (v.EPSSScore > 0 && v.EPSSScore >= threshold)
+}
+
+// ComparePriority returns a negative number when the vulnerability must be fixed before the other one, a positive
+// number when after and zero when they have the same priority. The known exploited vulnerabilities come first,
+// followed by the highest epss scores and then by the most severe ones.
+func (v *Vulnerability) ComparePriority(other *Vulnerability) int {
+	if v.KnownExploited != other.KnownExploited {
+		if v.KnownExploited {
+			return -1
+		}
+
+		return 1
+	}
+
+	if v.EPSSScore != other.EPSSScore {
+		if v.EPSSScore > other.EPSSScore {
+			return -1
+		}
+
+		return 1
+	}
+
+	return severityRank(v.Severity) - severityRank(other.Severity)
+}
+
+// SortByPriority sorts the vulnerabilities by their priority keeping the order of the ones with the same priority
+func SortByPriority(vulnerabilities []Vulnerability) {
+	sort.SliceStable(vulnerabilities, func(i, j int) bool {
+		return vulnerabilities[i].ComparePriority(&vulnerabilities[j]) < 0
+	})
+}
+
+// severityRank returns the position of the severity from the most to the least severe, the invalid ones are ranked
+// as unknown
+func severityRank(severity severities.Severity) int {
+	for index, value := range severities.Values() {
+		if strings.EqualFold(value.ToString(), severity.ToString()) {
+			return index
+		}
+	}
+
+	return severityRank(severities.Unknown)
+}
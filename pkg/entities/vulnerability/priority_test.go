@@ -0,0 +1,68 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vulnerability
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+)
+
+func TestSetExploitability(t *testing.T) {
+	t.Run("should set the epss score and the kev flag", func(t *testing.T) {
+		vulnerability := &Vulnerability{}
+
+		vulnerability.SetExploitability(0.5, 0.9, true)
+		assert.Equal(t, 0.5, vulnerability.EPSSScore)
+		assert.Equal(t, 0.9, vulnerability.EPSSPercentile)
+		assert.True(t, vulnerability.KnownExploited)
+	})
+}
+
+func TestIsLikelyExploited(t *testing.T) {
+	t.Run("should return true when known exploited or above the threshold", func(t *testing.T) {
+		assert.True(t, (&Vulnerability{KnownExploited: true}).IsLikelyExploited(0.1))
+		assert.True(t, (&Vulnerability{EPSSScore: 0.1}).IsLikelyExploited(0.1))
+		assert.False(t, (&Vulnerability{EPSSScore: 0.09}).IsLikelyExploited(0.1))
+		assert.False(t, (&Vulnerability{}).IsLikelyExploited(0))
+	})
+}
+
+func TestSortByPriority(t *testing.T) {
+	t.Run("should sort by kev, epss score and severity", func(t *testing.T) {
+		vulnerabilities := []Vulnerability{
+			{Code: "info", Severity: severities.Info},
+			{Code: "critical", Severity: severities.Critical},
+			{Code: "epss", Severity: severities.Low, EPSSScore: 0.2},
+			{Code: "invalid", Severity: "invalid"},
+			{Code: "kev", Severity: severities.Medium, KnownExploited: true},
+			{Code: "high", Severity: "high"},
+			{Code: "epss-high", Severity: severities.Low, EPSSScore: 0.7},
+			{Code: "critical-2", Severity: severities.Critical},
+		}
+
+		SortByPriority(vulnerabilities)
+
+		var codes []string
+		for index := range vulnerabilities {
+			codes = append(codes, vulnerabilities[index].Code)
+		}
+
+		assert.Equal(t, []string{"kev", "epss-high", "epss", "critical", "critical-2", "high", "invalid", "info"},
+			codes)
+	})
+}
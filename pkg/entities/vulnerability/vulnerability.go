@@ -22,6 +22,7 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
 	"github.com/ZupIT/horusec-devkit/pkg/enums/tools"
 	"github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	uuidUtils "github.com/ZupIT/horusec-devkit/pkg/utils/uuid"
 )
 
 //nolint:lll // notations need more than 130 characters
@@ -60,6 +61,13 @@ type Vulnerability struct {
 	//
 	// For more info see https://github.com/ZupIT/horusec/issues/680
 	VulnHashInvalid string `json:"-" gorm:"-" swaggerignore:"true"`
+
+	// EPSSScore, EPSSPercentile and KnownExploited are the exploitability of the cves found on the vulnerability,
+	// filled from the epss scores and the cisa kev catalog when the vulnerabilities are read. They are not persisted
+	// since the epss scores are recalculated every day.
+	EPSSScore      float64 `json:"epssScore,omitempty" gorm:"-" example:"0.97565"`
+	EPSSPercentile float64 `json:"epssPercentile,omitempty" gorm:"-" example:"0.99996"`
+	KnownExploited bool    `json:"knownExploited,omitempty" gorm:"-" example:"true"`
 }
 
 func (v *Vulnerability) GetTable() string {
@@ -67,7 +75,7 @@ func (v *Vulnerability) GetTable() string {
 }
 
 func (v *Vulnerability) GenerateID() {
-	v.VulnerabilityID = uuid.New()
+	v.VulnerabilityID = uuidUtils.New()
 }
 
 func (v *Vulnerability) SetType(vulnType vulnerability.Type) {
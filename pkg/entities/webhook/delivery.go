@@ -0,0 +1,62 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/webhook"
+)
+
+// Delivery is the result of sending an event to a webhook, kept so the users can see why a webhook is failing
+//
+//nolint:lll // notations need more than 130 characters
+type Delivery struct {
+	DeliveryID      uuid.UUID              `json:"deliveryID" gorm:"Column:delivery_id" example:"00000000-0000-0000-0000-000000000000"`
+	WebhookID       uuid.UUID              `json:"webhookID" gorm:"Column:webhook_id" example:"00000000-0000-0000-0000-000000000000"`
+	EventID         uuid.UUID              `json:"eventID" gorm:"Column:event_id" example:"00000000-0000-0000-0000-000000000000"`
+	EventType       string                 `json:"eventType" gorm:"Column:event_type" example:"analysis.finished"`
+	URL             string                 `json:"url" gorm:"Column:url" example:"https://example.com/webhook"`
+	Status          webhook.DeliveryStatus `json:"status" gorm:"Column:status" enums:"success,failed,circuit-open" example:"success"`
+	StatusCode      int                    `json:"statusCode" gorm:"Column:status_code" example:"200"`
+	Attempts        int                    `json:"attempts" gorm:"Column:attempts" example:"1"`
+	ResponseSnippet string                 `json:"responseSnippet" gorm:"Column:response_snippet"`
+	Error           string                 `json:"error" gorm:"Column:error"`
+	CreatedAt       time.Time              `json:"createdAt" gorm:"Column:created_at" example:"2021-12-30T23:59:59Z"`
+	FinishedAt      time.Time              `json:"finishedAt" gorm:"Column:finished_at" example:"2021-12-30T23:59:59Z"`
+}
+
+func (d *Delivery) GetTable() string {
+	return "webhook_deliveries"
+}
+
+func (d *Delivery) ToBytes() []byte {
+	bytes, _ := json.Marshal(d)
+
+	return bytes
+}
+
+func (d *Delivery) IsSuccess() bool {
+	return d.Status == webhook.Success
+}
+
+func (d *Delivery) SetError(err error) {
+	if err != nil {
+		d.Error = err.Error()
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/webhook"
+)
+
+func TestGetTable(t *testing.T) {
+	t.Run("should return webhook deliveries table name", func(t *testing.T) {
+		assert.Equal(t, "webhook_deliveries", (&Delivery{}).GetTable())
+	})
+}
+
+func TestToBytes(t *testing.T) {
+	t.Run("should parse delivery to bytes", func(t *testing.T) {
+		assert.NotEmpty(t, (&Delivery{}).ToBytes())
+	})
+}
+
+func TestIsSuccess(t *testing.T) {
+	t.Run("should return true only when status is success", func(t *testing.T) {
+		assert.True(t, (&Delivery{Status: webhook.Success}).IsSuccess())
+		assert.False(t, (&Delivery{Status: webhook.Failed}).IsSuccess())
+	})
+}
+
+func TestSetError(t *testing.T) {
+	t.Run("should set error message only when error is not nil", func(t *testing.T) {
+		delivery := &Delivery{}
+
+		delivery.SetError(nil)
+		assert.Empty(t, delivery.Error)
+
+		delivery.SetError(errors.New("test"))
+		assert.Equal(t, "test", delivery.Error)
+	})
+}
@@ -0,0 +1,45 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+type Action string
+
+const (
+	Create Action = "create"
+	Update Action = "update"
+	Delete Action = "delete"
+	Login  Action = "login"
+	Logout Action = "logout"
+	Invite Action = "invite"
+	Grant  Action = "grant"
+	Revoke Action = "revoke"
+)
+
+func Values() []Action {
+	return []Action{
+		Create,
+		Update,
+		Delete,
+		Login,
+		Logout,
+		Invite,
+		Grant,
+		Revoke,
+	}
+}
+
+func (a Action) ToString() string {
+	return string(a)
+}
@@ -0,0 +1,40 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValues(t *testing.T) {
+	t.Run("should return 8 valid values", func(t *testing.T) {
+		assert.Len(t, Values(), 8)
+	})
+}
+
+func TestToString(t *testing.T) {
+	t.Run("should success parse to string", func(t *testing.T) {
+		assert.Equal(t, "create", Create.ToString())
+		assert.Equal(t, "update", Update.ToString())
+		assert.Equal(t, "delete", Delete.ToString())
+		assert.Equal(t, "login", Login.ToString())
+		assert.Equal(t, "logout", Logout.ToString())
+		assert.Equal(t, "invite", Invite.ToString())
+		assert.Equal(t, "grant", Grant.ToString())
+		assert.Equal(t, "revoke", Revoke.ToString())
+	})
+}
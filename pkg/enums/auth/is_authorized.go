@@ -14,8 +14,16 @@
 
 package auth
 
+import (
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/account"
+)
+
 type AuthorizationType string
 
+const typePrefix = "Is"
+
 const (
 	ApplicationAdmin     AuthorizationType = "IsApplicationAdmin"
 	WorkspaceAdmin       AuthorizationType = "IsWorkspaceAdmin"
@@ -39,3 +47,19 @@ func Values() []AuthorizationType {
 		RepositoryMember,
 	}
 }
+
+// NewAuthorizationType returns the type checked by the auth service for the role on the scope, following the
+// Is<Scope><Role> format of the existing types, so the auditor role on a repository is IsRepositoryAuditor. The
+// application admin role is already scoped, so it is always IsApplicationAdmin.
+func NewAuthorizationType(scope Scope, role account.Role) AuthorizationType {
+	if role == account.ApplicationAdmin {
+		return ApplicationAdmin
+	}
+
+	name := string(role)
+	if name != "" {
+		name = strings.ToUpper(name[:1]) + name[1:]
+	}
+
+	return AuthorizationType(typePrefix + scope.ToString() + name)
+}
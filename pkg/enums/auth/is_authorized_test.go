@@ -18,6 +18,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/account"
 )
 
 func TestToStringIsAuthorizedType(t *testing.T) {
@@ -51,3 +53,17 @@ func TestValuesIsAuthorizedType(t *testing.T) {
 		assert.Len(t, Values(), 6)
 	})
 }
+
+func TestNewAuthorizationType(t *testing.T) {
+	t.Run("should return the existing types for the existing roles", func(t *testing.T) {
+		assert.Equal(t, WorkspaceAdmin, NewAuthorizationType(ScopeWorkspace, account.Admin))
+		assert.Equal(t, WorkspaceMember, NewAuthorizationType(ScopeWorkspace, account.Member))
+		assert.Equal(t, RepositorySupervisor, NewAuthorizationType(ScopeRepository, account.Supervisor))
+		assert.Equal(t, ApplicationAdmin, NewAuthorizationType(ScopeRepository, account.ApplicationAdmin))
+	})
+
+	t.Run("should return the type of a new role", func(t *testing.T) {
+		assert.Equal(t, AuthorizationType("IsRepositoryAuditor"), NewAuthorizationType(ScopeRepository, "auditor"))
+		assert.Equal(t, AuthorizationType("IsWorkspace"), NewAuthorizationType(ScopeWorkspace, ""))
+	})
+}
@@ -0,0 +1,106 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecosystem
+
+import "strings"
+
+// Ecosystem is the package manager of a package, the values are the package-url types so they are kept on the purls
+type Ecosystem string
+
+const (
+	Go        Ecosystem = "golang"
+	NPM       Ecosystem = "npm"
+	PyPI      Ecosystem = "pypi"
+	Maven     Ecosystem = "maven"
+	NuGet     Ecosystem = "nuget"
+	RubyGems  Ecosystem = "gem"
+	Cargo     Ecosystem = "cargo"
+	Composer  Ecosystem = "composer"
+	Pub       Ecosystem = "pub"
+	Hex       Ecosystem = "hex"
+	Swift     Ecosystem = "swift"
+	CocoaPods Ecosystem = "cocoapods"
+	GitHub    Ecosystem = "github"
+	Generic   Ecosystem = "generic"
+)
+
+func (e Ecosystem) ToString() string {
+	return string(e)
+}
+
+//nolint:funlen // method need to have more then 15 lines
+func Values() []Ecosystem {
+	return []Ecosystem{
+		Go,
+		NPM,
+		PyPI,
+		Maven,
+		NuGet,
+		RubyGems,
+		Cargo,
+		Composer,
+		Pub,
+		Hex,
+		Swift,
+		CocoaPods,
+		GitHub,
+		Generic,
+	}
+}
+
+func (e Ecosystem) IsValid() bool {
+	for _, value := range Values() {
+		if e == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ToOSV returns the ecosystem name used by the OSV advisories, which is empty for the ecosystems it does not have
+func (e Ecosystem) ToOSV() string {
+	return mapOSVEcosystems()[e]
+}
+
+// ParseStringToEcosystem accepts the package-url types and the OSV ecosystem names ignoring the case, returning
+// generic for the unknown ones
+func ParseStringToEcosystem(value string) Ecosystem {
+	for _, ecosystem := range Values() {
+		osv := ecosystem.ToOSV()
+		if strings.EqualFold(value, ecosystem.ToString()) || (osv != "" && strings.EqualFold(value, osv)) {
+			return ecosystem
+		}
+	}
+
+	return Generic
+}
+
+func mapOSVEcosystems() map[Ecosystem]string {
+	return map[Ecosystem]string{
+		Go:       "Go",
+		NPM:      "npm",
+		PyPI:     "PyPI",
+		Maven:    "Maven",
+		NuGet:    "NuGet",
+		RubyGems: "RubyGems",
+		Cargo:    "crates.io",
+		Composer: "Packagist",
+		Pub:      "Pub",
+		Hex:      "Hex",
+		Swift:    "SwiftURL",
+		GitHub:   "GitHub Actions",
+	}
+}
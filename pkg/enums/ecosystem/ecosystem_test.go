@@ -0,0 +1,54 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecosystem
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValues(t *testing.T) {
+	t.Run("should return 14 valid values", func(t *testing.T) {
+		assert.Len(t, Values(), 14)
+		assert.True(t, Maven.IsValid())
+		assert.False(t, Ecosystem("test").IsValid())
+	})
+}
+
+func TestToString(t *testing.T) {
+	t.Run("should success parse to string", func(t *testing.T) {
+		assert.Equal(t, "golang", Go.ToString())
+		assert.Equal(t, "gem", RubyGems.ToString())
+	})
+}
+
+func TestToOSV(t *testing.T) {
+	t.Run("should return the osv ecosystem name", func(t *testing.T) {
+		assert.Equal(t, "crates.io", Cargo.ToOSV())
+		assert.Empty(t, Generic.ToOSV())
+	})
+}
+
+func TestParseStringToEcosystem(t *testing.T) {
+	t.Run("should parse the purl types and osv names", func(t *testing.T) {
+		assert.Equal(t, Go, ParseStringToEcosystem("golang"))
+		assert.Equal(t, Go, ParseStringToEcosystem("Go"))
+		assert.Equal(t, Composer, ParseStringToEcosystem("packagist"))
+		assert.Equal(t, PyPI, ParseStringToEcosystem("PYPI"))
+		assert.Equal(t, Generic, ParseStringToEcosystem("test"))
+		assert.Equal(t, Generic, ParseStringToEcosystem(""))
+	})
+}
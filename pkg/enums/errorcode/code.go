@@ -0,0 +1,49 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errorcode
+
+type Code string
+
+const (
+	InvalidArgument  Code = "invalid_argument"
+	Unauthenticated  Code = "unauthenticated"
+	PermissionDenied Code = "permission_denied"
+	NotFound         Code = "not_found"
+	Conflict         Code = "conflict"
+	Unprocessable    Code = "unprocessable"
+	TooManyRequests  Code = "too_many_requests"
+	Timeout          Code = "timeout"
+	Unavailable      Code = "unavailable"
+	Internal         Code = "internal"
+)
+
+func Values() []Code {
+	return []Code{
+		InvalidArgument,
+		Unauthenticated,
+		PermissionDenied,
+		NotFound,
+		Conflict,
+		Unprocessable,
+		TooManyRequests,
+		Timeout,
+		Unavailable,
+		Internal,
+	}
+}
+
+func (c Code) ToString() string {
+	return string(c)
+}
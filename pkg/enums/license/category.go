@@ -0,0 +1,53 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package license
+
+// Category groups the licenses by the obligations they impose on the software that uses them, the restricted
+// licenses are source available but limit the commercial use
+type Category string
+
+const (
+	CategoryPermissive      Category = "permissive"
+	CategoryWeakCopyleft    Category = "weak-copyleft"
+	CategoryStrongCopyleft  Category = "strong-copyleft"
+	CategoryNetworkCopyleft Category = "network-copyleft"
+	CategoryRestricted      Category = "restricted"
+	CategoryUnknown         Category = "unknown"
+)
+
+func (c Category) ToString() string {
+	return string(c)
+}
+
+func CategoryValues() []Category {
+	return []Category{
+		CategoryPermissive,
+		CategoryWeakCopyleft,
+		CategoryStrongCopyleft,
+		CategoryNetworkCopyleft,
+		CategoryRestricted,
+		CategoryUnknown,
+	}
+}
+
+func (c Category) IsValid() bool {
+	for _, value := range CategoryValues() {
+		if c == value {
+			return true
+		}
+	}
+
+	return false
+}
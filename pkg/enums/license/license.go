@@ -0,0 +1,192 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package license
+
+import "strings"
+
+// License is a SPDX license identifier, the known identifiers are the common licenses of the open source packages
+type License string
+
+const (
+	MIT             License = "MIT"
+	MIT0            License = "MIT-0"
+	Apache11        License = "Apache-1.1"
+	Apache20        License = "Apache-2.0"
+	BSD2Clause      License = "BSD-2-Clause"
+	BSD3Clause      License = "BSD-3-Clause"
+	BSD3ClauseClear License = "BSD-3-Clause-Clear"
+	ZeroBSD         License = "0BSD"
+	ISC             License = "ISC"
+	Zlib            License = "Zlib"
+	Unlicense       License = "Unlicense"
+	CC010           License = "CC0-1.0"
+	CCBY40          License = "CC-BY-4.0"
+	BSL10           License = "BSL-1.0"
+	PostgreSQL      License = "PostgreSQL"
+	Python20        License = "Python-2.0"
+	X11             License = "X11"
+	NCSA            License = "NCSA"
+	UPL10           License = "UPL-1.0"
+	WTFPL           License = "WTFPL"
+	Artistic20      License = "Artistic-2.0"
+	BlueOak100      License = "BlueOak-1.0.0"
+	LGPL20Only      License = "LGPL-2.0-only"
+	LGPL20OrLater   License = "LGPL-2.0-or-later"
+	LGPL21Only      License = "LGPL-2.1-only"
+	LGPL21OrLater   License = "LGPL-2.1-or-later"
+	LGPL30Only      License = "LGPL-3.0-only"
+	LGPL30OrLater   License = "LGPL-3.0-or-later"
+	MPL11           License = "MPL-1.1"
+	MPL20           License = "MPL-2.0"
+	EPL10           License = "EPL-1.0"
+	EPL20           License = "EPL-2.0"
+	CDDL10          License = "CDDL-1.0"
+	CDDL11          License = "CDDL-1.1"
+	GPL20Only       License = "GPL-2.0-only"
+	GPL20OrLater    License = "GPL-2.0-or-later"
+	GPL30Only       License = "GPL-3.0-only"
+	GPL30OrLater    License = "GPL-3.0-or-later"
+	EUPL12          License = "EUPL-1.2"
+	OSL30           License = "OSL-3.0"
+	CCBYSA40        License = "CC-BY-SA-4.0"
+	AGPL30Only      License = "AGPL-3.0-only"
+	AGPL30OrLater   License = "AGPL-3.0-or-later"
+	SSPL10          License = "SSPL-1.0"
+	BUSL11          License = "BUSL-1.1"
+	CCBYNC40        License = "CC-BY-NC-4.0"
+
+	// NoAssertion and None are the SPDX values of the packages without a known license, they are not licenses
+	NoAssertion License = "NOASSERTION"
+	None        License = "NONE"
+
+	suffixOrLater = "-or-later"
+	suffixOnly    = "-only"
+	suffixPlus    = "+"
+)
+
+func (l License) ToString() string {
+	return string(l)
+}
+
+// IsValid reports if the license is a known SPDX identifier, the custom LicenseRef identifiers are not known
+func (l License) IsValid() bool {
+	_, ok := mapLicenseCategories()[l]
+
+	return ok
+}
+
+// GetCategory returns the category of the known licenses, or unknown for the others
+func (l License) GetCategory() Category {
+	if category, ok := mapLicenseCategories()[l]; ok {
+		return category
+	}
+
+	return CategoryUnknown
+}
+
+// ParseStringToLicense returns the known license ignoring the case, like the SPDX matching, and converts the
+// deprecated GPL family identifiers, so GPL-2.0 is GPL-2.0-only and GPL-2.0+ is GPL-2.0-or-later. The value is
+// returned as is when it is not known.
+func ParseStringToLicense(value string) License {
+	value = strings.TrimSpace(value)
+
+	candidates := []string{value, value + suffixOnly}
+	if strings.HasSuffix(value, suffixPlus) {
+		candidates = []string{strings.TrimSuffix(value, suffixPlus) + suffixOrLater}
+	}
+
+	for _, candidate := range candidates {
+		for _, license := range Values() {
+			if strings.EqualFold(candidate, license.ToString()) {
+				return license
+			}
+		}
+	}
+
+	return License(value)
+}
+
+//nolint:funlen // method need to have more then 15 lines
+func Values() []License {
+	return []License{
+		MIT,
+		MIT0,
+		Apache11,
+		Apache20,
+		BSD2Clause,
+		BSD3Clause,
+		BSD3ClauseClear,
+		ZeroBSD,
+		ISC,
+		Zlib,
+		Unlicense,
+		CC010,
+		CCBY40,
+		BSL10,
+		PostgreSQL,
+		Python20,
+		X11,
+		NCSA,
+		UPL10,
+		WTFPL,
+		Artistic20,
+		BlueOak100,
+		LGPL20Only,
+		LGPL20OrLater,
+		LGPL21Only,
+		LGPL21OrLater,
+		LGPL30Only,
+		LGPL30OrLater,
+		MPL11,
+		MPL20,
+		EPL10,
+		EPL20,
+		CDDL10,
+		CDDL11,
+		GPL20Only,
+		GPL20OrLater,
+		GPL30Only,
+		GPL30OrLater,
+		EUPL12,
+		OSL30,
+		CCBYSA40,
+		AGPL30Only,
+		AGPL30OrLater,
+		SSPL10,
+		BUSL11,
+		CCBYNC40,
+	}
+}
+
+//nolint:funlen // method need to have more then 15 lines
+func mapLicenseCategories() map[License]Category {
+	categories := map[License]Category{}
+
+	for category, licenses := range map[Category][]License{
+		CategoryPermissive: {MIT, MIT0, Apache11, Apache20, BSD2Clause, BSD3Clause, BSD3ClauseClear, ZeroBSD, ISC, Zlib,
+			Unlicense, CC010, CCBY40, BSL10, PostgreSQL, Python20, X11, NCSA, UPL10, WTFPL, Artistic20, BlueOak100},
+		CategoryWeakCopyleft: {LGPL20Only, LGPL20OrLater, LGPL21Only, LGPL21OrLater, LGPL30Only, LGPL30OrLater, MPL11,
+			MPL20, EPL10, EPL20, CDDL10, CDDL11},
+		CategoryStrongCopyleft:  {GPL20Only, GPL20OrLater, GPL30Only, GPL30OrLater, EUPL12, OSL30, CCBYSA40},
+		CategoryNetworkCopyleft: {AGPL30Only, AGPL30OrLater, SSPL10},
+		CategoryRestricted:      {BUSL11, CCBYNC40},
+	} {
+		for _, license := range licenses {
+			categories[license] = category
+		}
+	}
+
+	return categories
+}
@@ -0,0 +1,82 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package license
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValues(t *testing.T) {
+	t.Run("should return 46 valid values with a category", func(t *testing.T) {
+		assert.Len(t, Values(), 46)
+
+		for _, license := range Values() {
+			assert.True(t, license.IsValid())
+			assert.NotEqual(t, CategoryUnknown, license.GetCategory())
+		}
+	})
+}
+
+func TestToString(t *testing.T) {
+	t.Run("should success parse to string", func(t *testing.T) {
+		assert.Equal(t, "Apache-2.0", Apache20.ToString())
+		assert.Equal(t, "permissive", CategoryPermissive.ToString())
+		assert.Equal(t, "violation", Violation.ToString())
+	})
+}
+
+func TestGetCategory(t *testing.T) {
+	t.Run("should return the category of the license", func(t *testing.T) {
+		assert.Equal(t, CategoryPermissive, MIT.GetCategory())
+		assert.Equal(t, CategoryWeakCopyleft, MPL20.GetCategory())
+		assert.Equal(t, CategoryStrongCopyleft, GPL30Only.GetCategory())
+		assert.Equal(t, CategoryNetworkCopyleft, AGPL30OrLater.GetCategory())
+		assert.Equal(t, CategoryUnknown, License("LicenseRef-test").GetCategory())
+	})
+}
+
+func TestParseStringToLicense(t *testing.T) {
+	t.Run("should return the known license ignoring the case", func(t *testing.T) {
+		assert.Equal(t, Apache20, ParseStringToLicense(" apache-2.0 "))
+		assert.Equal(t, MIT, ParseStringToLicense("mit"))
+	})
+
+	t.Run("should convert the deprecated identifiers", func(t *testing.T) {
+		assert.Equal(t, GPL20Only, ParseStringToLicense("GPL-2.0"))
+		assert.Equal(t, GPL30OrLater, ParseStringToLicense("GPL-3.0+"))
+		assert.Equal(t, LGPL21OrLater, ParseStringToLicense("LGPL-2.1+"))
+	})
+
+	t.Run("should return the unknown licenses as is", func(t *testing.T) {
+		assert.Equal(t, License("LicenseRef-test"), ParseStringToLicense("LicenseRef-test"))
+		assert.False(t, ParseStringToLicense("LicenseRef-test").IsValid())
+	})
+}
+
+func TestCategoryValues(t *testing.T) {
+	t.Run("should return 6 valid values", func(t *testing.T) {
+		assert.Len(t, CategoryValues(), 6)
+		assert.True(t, CategoryRestricted.IsValid())
+		assert.False(t, Category("test").IsValid())
+	})
+}
+
+func TestComplianceStatusValues(t *testing.T) {
+	t.Run("should return 3 valid values", func(t *testing.T) {
+		assert.Len(t, ComplianceStatusValues(), 3)
+	})
+}
@@ -0,0 +1,45 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package password
+
+type Rule string
+
+const (
+	MinLength Rule = "min_length"
+	MaxLength Rule = "max_length"
+	Uppercase Rule = "uppercase"
+	Lowercase Rule = "lowercase"
+	Numeric   Rule = "numeric"
+	Special   Rule = "special"
+	Common    Rule = "common"
+	Breached  Rule = "breached"
+)
+
+func Values() []Rule {
+	return []Rule{
+		MinLength,
+		MaxLength,
+		Uppercase,
+		Lowercase,
+		Numeric,
+		Special,
+		Common,
+		Breached,
+	}
+}
+
+func (r Rule) ToString() string {
+	return string(r)
+}
@@ -25,6 +25,7 @@ const (
 	HorusecAnalyticNewAnalysisByTime       Queue = "horusec-analytic::new-analysis-by-time"
 	HorusecEmail                           Queue = "horusec-email"
 	HorusecWebhook                         Queue = "horusec-webhook"
+	HorusecAudit                           Queue = "horusec-audit"
 )
 
 func Values() []Queue {
@@ -35,6 +36,7 @@ func Values() []Queue {
 		HorusecAnalyticNewAnalysisByTime,
 		HorusecEmail,
 		HorusecWebhook,
+		HorusecAudit,
 	}
 }
 
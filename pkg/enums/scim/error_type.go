@@ -0,0 +1,50 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scim
+
+// ErrorType is the scimType of the error responses with the bad request status
+type ErrorType string
+
+const (
+	InvalidFilter ErrorType = "invalidFilter"
+	TooMany       ErrorType = "tooMany"
+	Uniqueness    ErrorType = "uniqueness"
+	Mutability    ErrorType = "mutability"
+	InvalidSyntax ErrorType = "invalidSyntax"
+	InvalidPath   ErrorType = "invalidPath"
+	NoTarget      ErrorType = "noTarget"
+	InvalidValue  ErrorType = "invalidValue"
+	InvalidVers   ErrorType = "invalidVers"
+	Sensitive     ErrorType = "sensitive"
+)
+
+func ErrorTypeValues() []ErrorType {
+	return []ErrorType{
+		InvalidFilter,
+		TooMany,
+		Uniqueness,
+		Mutability,
+		InvalidSyntax,
+		InvalidPath,
+		NoTarget,
+		InvalidValue,
+		InvalidVers,
+		Sensitive,
+	}
+}
+
+func (e ErrorType) ToString() string {
+	return string(e)
+}
@@ -0,0 +1,62 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scim
+
+import "strings"
+
+type FilterOperator string
+
+const (
+	Equal          FilterOperator = "eq"
+	NotEqual       FilterOperator = "ne"
+	Contains       FilterOperator = "co"
+	StartsWith     FilterOperator = "sw"
+	EndsWith       FilterOperator = "ew"
+	GreaterThan    FilterOperator = "gt"
+	GreaterOrEqual FilterOperator = "ge"
+	LessThan       FilterOperator = "lt"
+	LessOrEqual    FilterOperator = "le"
+	Present        FilterOperator = "pr"
+)
+
+func FilterOperatorValues() []FilterOperator {
+	return []FilterOperator{
+		Equal,
+		NotEqual,
+		Contains,
+		StartsWith,
+		EndsWith,
+		GreaterThan,
+		GreaterOrEqual,
+		LessThan,
+		LessOrEqual,
+		Present,
+	}
+}
+
+func (f FilterOperator) ToString() string {
+	return string(f)
+}
+
+// ParseFilterOperator returns the operator ignoring the case, or empty when it is not a valid operator
+func ParseFilterOperator(value string) FilterOperator {
+	for _, operator := range FilterOperatorValues() {
+		if strings.EqualFold(operator.ToString(), value) {
+			return operator
+		}
+	}
+
+	return ""
+}
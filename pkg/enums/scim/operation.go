@@ -0,0 +1,50 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scim
+
+import "strings"
+
+// Operation is the op of a patch request. Some identity providers send it capitalized, so it should be read with
+// ParseOperation.
+type Operation string
+
+const (
+	Add     Operation = "add"
+	Remove  Operation = "remove"
+	Replace Operation = "replace"
+)
+
+func OperationValues() []Operation {
+	return []Operation{
+		Add,
+		Remove,
+		Replace,
+	}
+}
+
+func (o Operation) ToString() string {
+	return string(o)
+}
+
+// ParseOperation returns the operation ignoring the case, or empty when it is not a valid operation
+func ParseOperation(value string) Operation {
+	for _, operation := range OperationValues() {
+		if strings.EqualFold(operation.ToString(), value) {
+			return operation
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,32 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scim
+
+type Schema string
+
+const (
+	UserSchema                  Schema = "urn:ietf:params:scim:schemas:core:2.0:User"
+	GroupSchema                 Schema = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	EnterpriseUserSchema        Schema = "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"
+	ListResponseSchema          Schema = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	PatchOpSchema               Schema = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	ErrorSchema                 Schema = "urn:ietf:params:scim:api:messages:2.0:Error"
+	ServiceProviderConfigSchema Schema = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	ResourceTypeSchema          Schema = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+)
+
+func (s Schema) ToString() string {
+	return string(s)
+}
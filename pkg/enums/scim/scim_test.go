@@ -0,0 +1,52 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValues(t *testing.T) {
+	t.Run("should return the valid values", func(t *testing.T) {
+		assert.Len(t, ErrorTypeValues(), 10)
+		assert.Len(t, OperationValues(), 3)
+		assert.Len(t, FilterOperatorValues(), 10)
+	})
+}
+
+func TestToString(t *testing.T) {
+	t.Run("should parse to string", func(t *testing.T) {
+		assert.Equal(t, "invalidFilter", InvalidFilter.ToString())
+		assert.Equal(t, "replace", Replace.ToString())
+		assert.Equal(t, "eq", Equal.ToString())
+		assert.Equal(t, "urn:ietf:params:scim:schemas:core:2.0:User", UserSchema.ToString())
+	})
+}
+
+func TestParseOperation(t *testing.T) {
+	t.Run("should parse ignoring the case", func(t *testing.T) {
+		assert.Equal(t, Replace, ParseOperation("Replace"))
+		assert.Empty(t, ParseOperation("move"))
+	})
+}
+
+func TestParseFilterOperator(t *testing.T) {
+	t.Run("should parse ignoring the case", func(t *testing.T) {
+		assert.Equal(t, Present, ParseFilterOperator("PR"))
+		assert.Empty(t, ParseFilterOperator("in"))
+	})
+}
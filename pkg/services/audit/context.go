@@ -0,0 +1,53 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"context"
+	"net"
+	"net/http"
+)
+
+type requestInfoKey struct{}
+
+type requestInfo struct {
+	ip        string
+	userAgent string
+}
+
+// Middleware keeps the ip and user agent of the request in its context, so the emitted events contain them. It should
+// be used after the real ip middleware, otherwise the ip is the one of the proxy.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(ContextWithRequest(r.Context(), r)))
+	})
+}
+
+func ContextWithRequest(ctx context.Context, r *http.Request) context.Context {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+
+	return context.WithValue(ctx, requestInfoKey{}, &requestInfo{ip: ip, userAgent: r.UserAgent()})
+}
+
+func getRequestInfo(ctx context.Context) *requestInfo {
+	if info, ok := ctx.Value(requestInfoKey{}).(*requestInfo); ok {
+		return info
+	}
+
+	return &requestInfo{}
+}
@@ -0,0 +1,112 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	auditEntities "github.com/ZupIT/horusec-devkit/pkg/entities/audit"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/queues"
+	"github.com/ZupIT/horusec-devkit/pkg/services/audit/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+	uuidUtils "github.com/ZupIT/horusec-devkit/pkg/utils/uuid"
+)
+
+type IEmitter interface {
+	Emit(ctx context.Context, event *auditEntities.Event) error
+	EmitAndLog(ctx context.Context, event *auditEntities.Event)
+}
+
+type Emitter struct {
+	options  *Options
+	broker   broker.IBroker
+	database database.IDatabaseWrite
+}
+
+// NewEmitter returns an emitter that writes the events on the destinations enabled by the options, a nil broker or
+// database disables its destination
+func NewEmitter(options *Options, brokerLib broker.IBroker, databaseWrite database.IDatabaseWrite) IEmitter {
+	return &Emitter{options: options, broker: brokerLib, database: databaseWrite}
+}
+
+// Emit completes the event with the id, the creation date, the account id of the context as actor and the request
+// info of the context when they are empty, then writes it on every destination, returning the errors of all of them
+func (e *Emitter) Emit(ctx context.Context, event *auditEntities.Event) error {
+	e.complete(ctx, event)
+
+	return errors.Join(e.publish(event), e.save(ctx, event))
+}
+
+// EmitAndLog only logs the emit errors, for the actions that should not fail when the event is lost
+func (e *Emitter) EmitAndLog(ctx context.Context, event *auditEntities.Event) {
+	if err := e.Emit(ctx, event); err != nil {
+		logger.FromContext(ctx).LogError(enums.MessageFailedToEmitEvent, err)
+	}
+}
+
+func (e *Emitter) complete(ctx context.Context, event *auditEntities.Event) {
+	if event.EventID == uuid.Nil {
+		event.EventID = uuidUtils.New()
+	}
+
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+
+	if accountID, ok := jwt.GetAccountIDFromContext(ctx); ok && event.ActorID == uuid.Nil {
+		event.ActorID = accountID
+	}
+
+	info := getRequestInfo(ctx)
+	if event.IP == "" {
+		event.IP = info.ip
+	}
+
+	if event.UserAgent == "" {
+		event.UserAgent = info.userAgent
+	}
+}
+
+func (e *Emitter) publish(event *auditEntities.Event) error {
+	if !e.options.UseBroker || e.broker == nil {
+		return nil
+	}
+
+	if err := e.broker.Publish(queues.HorusecAudit.ToString(), "", "", event.ToBytes()); err != nil {
+		return fmt.Errorf("%w: %s", enums.ErrorFailedToPublishEvent, err.Error())
+	}
+
+	return nil
+}
+
+func (e *Emitter) save(ctx context.Context, event *auditEntities.Event) error {
+	if !e.options.UseDatabase || e.database == nil {
+		return nil
+	}
+
+	if err := database.WithContext(ctx, e.database).Create(event, event.GetTable()).GetError(); err != nil {
+		return fmt.Errorf("%w: %s", enums.ErrorFailedToSaveEvent, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	auditEntities "github.com/ZupIT/horusec-devkit/pkg/entities/audit"
+	auditEnums "github.com/ZupIT/horusec-devkit/pkg/enums/audit"
+	"github.com/ZupIT/horusec-devkit/pkg/services/audit/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
+)
+
+func newTestEvent() *auditEntities.Event {
+	return &auditEntities.Event{Action: auditEnums.Update, ResourceType: "repository", ResourceID: "1"}
+}
+
+func TestNewOptions(t *testing.T) {
+	t.Run("should read options from env", func(t *testing.T) {
+		t.Setenv(enums.HorusecAuditBrokerEnabled, "false")
+		t.Setenv(enums.HorusecAuditDatabaseEnabled, "true")
+
+		options := NewOptions()
+
+		assert.False(t, options.UseBroker)
+		assert.True(t, options.UseDatabase)
+	})
+}
+
+func TestEmit(t *testing.T) {
+	t.Run("should complete event with context values", func(t *testing.T) {
+		accountID := uuid.New()
+		request := httptest.NewRequest(http.MethodGet, "/", nil)
+		request.RemoteAddr = "10.0.0.1:1234"
+		request.Header.Set(enums.HeaderUserAgent, "test")
+
+		ctx := ContextWithRequest(jwt.ContextWithAccountID(context.Background(), accountID), request)
+		event := newTestEvent()
+
+		assert.NoError(t, NewEmitter(&Options{}, nil, nil).Emit(ctx, event))
+		assert.NotEqual(t, uuid.Nil, event.EventID)
+		assert.False(t, event.CreatedAt.IsZero())
+		assert.Equal(t, accountID, event.ActorID)
+		assert.Equal(t, "10.0.0.1", event.IP)
+		assert.Equal(t, "test", event.UserAgent)
+	})
+
+	t.Run("should keep values already set", func(t *testing.T) {
+		actorID := uuid.New()
+		event := newTestEvent()
+		event.ActorID, event.IP = actorID, "127.0.0.1"
+
+		ctx := jwt.ContextWithAccountID(context.Background(), uuid.New())
+
+		assert.NoError(t, NewEmitter(&Options{}, nil, nil).Emit(ctx, event))
+		assert.Equal(t, actorID, event.ActorID)
+		assert.Equal(t, "127.0.0.1", event.IP)
+	})
+
+	t.Run("should publish and save event", func(t *testing.T) {
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("WithContext").Return(databaseMock)
+		databaseMock.On("Create").Return(response.NewResponse(1, nil, nil))
+
+		emitter := NewEmitter(&Options{UseBroker: true, UseDatabase: true}, brokerMock, databaseMock)
+
+		assert.NoError(t, emitter.Emit(context.Background(), newTestEvent()))
+		brokerMock.AssertCalled(t, "Publish")
+		databaseMock.AssertCalled(t, "Create")
+	})
+
+	t.Run("should return errors of every destination", func(t *testing.T) {
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(errors.New("test"))
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("WithContext").Return(databaseMock)
+		databaseMock.On("Create").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		emitter := NewEmitter(&Options{UseBroker: true, UseDatabase: true}, brokerMock, databaseMock)
+		err := emitter.Emit(context.Background(), newTestEvent())
+
+		assert.ErrorIs(t, err, enums.ErrorFailedToPublishEvent)
+		assert.ErrorIs(t, err, enums.ErrorFailedToSaveEvent)
+	})
+
+	t.Run("should not use disabled destinations", func(t *testing.T) {
+		brokerMock := &broker.Mock{}
+		databaseMock := &database.Mock{}
+
+		emitter := NewEmitter(&Options{}, brokerMock, databaseMock)
+
+		assert.NoError(t, emitter.Emit(context.Background(), newTestEvent()))
+		brokerMock.AssertNotCalled(t, "Publish")
+		databaseMock.AssertNotCalled(t, "Create")
+	})
+}
+
+func TestEmitAndLog(t *testing.T) {
+	t.Run("should not panic when emit fails", func(t *testing.T) {
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(errors.New("test"))
+
+		assert.NotPanics(t, func() {
+			NewEmitter(&Options{UseBroker: true}, brokerMock, nil).EmitAndLog(context.Background(), newTestEvent())
+		})
+	})
+}
+
+func TestMiddleware(t *testing.T) {
+	t.Run("should keep request info in context", func(t *testing.T) {
+		var info *requestInfo
+
+		handler := Middleware(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			info = getRequestInfo(r.Context())
+		}))
+
+		request := httptest.NewRequest(http.MethodGet, "/", nil)
+		request.RemoteAddr = "10.0.0.1"
+		handler.ServeHTTP(httptest.NewRecorder(), request)
+
+		assert.Equal(t, "10.0.0.1", info.ip)
+	})
+}
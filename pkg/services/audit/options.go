@@ -0,0 +1,34 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/services/audit/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// Options selects where the events are written, the broker is used to let another service store the events and the
+// database when the service that made the action stores them itself
+type Options struct {
+	UseBroker   bool
+	UseDatabase bool
+}
+
+func NewOptions() *Options {
+	return &Options{
+		UseBroker:   env.GetEnvOrDefaultBool(enums.HorusecAuditBrokerEnabled, true),
+		UseDatabase: env.GetEnvOrDefaultBool(enums.HorusecAuditDatabaseEnabled, false),
+	}
+}
@@ -0,0 +1,268 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/services/backup/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+)
+
+type memoryStorage struct {
+	records map[enums.Kind]map[string]json.RawMessage
+	order   []enums.Kind
+}
+
+func newMemoryStorage() *memoryStorage {
+	return &memoryStorage{records: map[enums.Kind]map[string]json.RawMessage{}}
+}
+
+func (m *memoryStorage) add(kind enums.Kind, id string, data string) {
+	if m.records[kind] == nil {
+		m.records[kind] = map[string]json.RawMessage{}
+	}
+
+	m.records[kind][id] = json.RawMessage(data)
+}
+
+func (m *memoryStorage) Export(_ context.Context, _ uuid.UUID, kind enums.Kind,
+	write func(id string, data interface{}) error) error {
+	for id, data := range m.records[kind] {
+		if err := write(id, data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *memoryStorage) Exists(_ context.Context, kind enums.Kind, id string) (bool, error) {
+	_, ok := m.records[kind][id]
+
+	return ok, nil
+}
+
+func (m *memoryStorage) Create(_ context.Context, kind enums.Kind, record *Record) error {
+	m.order = append(m.order, kind)
+	m.add(kind, record.ID, string(record.Data))
+
+	return nil
+}
+
+func (m *memoryStorage) Replace(_ context.Context, kind enums.Kind, record *Record) error {
+	m.add(kind, record.ID, string(record.Data))
+
+	return nil
+}
+
+type errorStorage struct{}
+
+func (e *errorStorage) Export(_ context.Context, _ uuid.UUID, _ enums.Kind, _ func(string, interface{}) error) error {
+	return errors.New("test")
+}
+
+func (e *errorStorage) Exists(_ context.Context, _ enums.Kind, _ string) (bool, error) {
+	return false, errors.New("test")
+}
+
+func (e *errorStorage) Create(_ context.Context, _ enums.Kind, _ *Record) error {
+	return errors.New("test")
+}
+
+func (e *errorStorage) Replace(_ context.Context, _ enums.Kind, _ *Record) error {
+	return errors.New("test")
+}
+
+func newTestArchive(t *testing.T, source ISource) []byte {
+	buffer := &bytes.Buffer{}
+
+	_, err := NewExporter(&Options{Source: "test", Clock: clock.NewFakeClock(time.Now())}, source).
+		Export(context.Background(), uuid.New(), buffer)
+	assert.NoError(t, err)
+
+	return buffer.Bytes()
+}
+
+func newTestSource() *memoryStorage {
+	source := newMemoryStorage()
+	source.add(enums.KindWorkspace, "workspace", `{"name":"test"}`)
+	source.add(enums.KindRepository, "first", `{"name":"first"}`)
+	source.add(enums.KindRepository, "second", `{"name":"second"}`)
+	source.add(enums.KindSetting, "setting", `{"enabled":true}`)
+
+	return source
+}
+
+// rewriteArchive copies the archive replacing the content of the given files
+func rewriteArchive(t *testing.T, content []byte, replace map[string]string) []byte {
+	reader, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	assert.NoError(t, err)
+
+	buffer := &bytes.Buffer{}
+	writer := zip.NewWriter(buffer)
+
+	for _, file := range reader.File {
+		entry, err := writer.Create(file.Name)
+		assert.NoError(t, err)
+
+		if value, ok := replace[file.Name]; ok {
+			_, err = io.WriteString(entry, value)
+			assert.NoError(t, err)
+
+			continue
+		}
+
+		opened, err := file.Open()
+		assert.NoError(t, err)
+
+		_, err = io.Copy(entry, opened)
+		assert.NoError(t, err)
+		assert.NoError(t, opened.Close())
+	}
+
+	assert.NoError(t, writer.Close())
+
+	return buffer.Bytes()
+}
+
+func importArchive(target ITarget, content []byte, strategy enums.Strategy) (*Result, error) {
+	return NewImporter(&Options{}, target).Import(context.Background(), bytes.NewReader(content),
+		int64(len(content)), strategy)
+}
+
+func TestExport(t *testing.T) {
+	t.Run("should export records of each kind with manifest", func(t *testing.T) {
+		buffer := &bytes.Buffer{}
+		workspaceID := uuid.New()
+		source := newTestSource()
+		source.add(enums.KindAnalysis, "analysis", string((&analysis.Analysis{ID: uuid.New()}).ToBytes()))
+
+		manifest, err := NewExporter(&Options{Source: "test"}, source).Export(context.Background(), workspaceID,
+			buffer)
+
+		assert.NoError(t, err)
+		assert.Equal(t, enums.FormatVersion, manifest.FormatVersion)
+		assert.Equal(t, workspaceID, manifest.WorkspaceID)
+		assert.Len(t, manifest.Files, len(enums.Values()))
+		assert.Equal(t, 2, manifest.GetFiles(enums.KindRepository)[0].Records)
+
+		read, err := NewImporter(&Options{}, nil).ReadManifest(bytes.NewReader(buffer.Bytes()),
+			int64(buffer.Len()))
+		assert.NoError(t, err)
+		assert.Equal(t, manifest.Files, read.Files)
+		assert.Equal(t, "test", read.Source)
+	})
+
+	t.Run("should return error when workspace id is missing or source fails", func(t *testing.T) {
+		_, err := NewExporter(&Options{}, newTestSource()).Export(context.Background(), uuid.Nil, &bytes.Buffer{})
+		assert.ErrorIs(t, err, enums.ErrorWorkspaceIDRequired)
+
+		_, err = NewExporter(&Options{}, &errorStorage{}).Export(context.Background(), uuid.New(), &bytes.Buffer{})
+		assert.EqualError(t, err, "test")
+	})
+}
+
+func TestImport(t *testing.T) {
+	t.Run("should import every record on the kind order", func(t *testing.T) {
+		target := newMemoryStorage()
+
+		result, err := importArchive(target, newTestArchive(t, newTestSource()), enums.StrategyFail)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result.Created[enums.KindRepository])
+		assert.Equal(t, []enums.Kind{enums.KindWorkspace, enums.KindRepository, enums.KindRepository,
+			enums.KindSetting}, target.order)
+		assert.JSONEq(t, `{"name":"first"}`, string(target.records[enums.KindRepository]["first"]))
+	})
+
+	t.Run("should handle conflicts with the strategy", func(t *testing.T) {
+		archive := newTestArchive(t, newTestSource())
+
+		target := newMemoryStorage()
+		target.add(enums.KindRepository, "first", `{"name":"old"}`)
+
+		_, err := importArchive(target, archive, enums.StrategyFail)
+		assert.ErrorIs(t, err, enums.ErrorRecordAlreadyExists)
+		assert.Empty(t, target.order)
+
+		result, err := importArchive(target, archive, enums.StrategySkip)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result.Skipped[enums.KindRepository])
+		assert.JSONEq(t, `{"name":"old"}`, string(target.records[enums.KindRepository]["first"]))
+
+		result, err = importArchive(target, archive, enums.StrategyOverwrite)
+		assert.NoError(t, err)
+		assert.Equal(t, 4, result.Overwritten[enums.KindWorkspace]+result.Overwritten[enums.KindRepository]+
+			result.Overwritten[enums.KindSetting])
+		assert.JSONEq(t, `{"name":"first"}`, string(target.records[enums.KindRepository]["first"]))
+	})
+
+	t.Run("should reject modified or invalid archives before importing", func(t *testing.T) {
+		archive := newTestArchive(t, newTestSource())
+		target := newMemoryStorage()
+
+		_, err := importArchive(target, rewriteArchive(t, archive,
+			map[string]string{enums.KindRepository.FileName(): `{"id":"first","data":{"name":"changed"}}` + "\n"}),
+			enums.StrategySkip)
+		assert.ErrorIs(t, err, enums.ErrorChecksumMismatch)
+
+		_, err = importArchive(target, rewriteArchive(t, archive,
+			map[string]string{enums.KindRepository.FileName(): "test\n"}), enums.StrategySkip)
+		assert.ErrorIs(t, err, enums.ErrorInvalidRecord)
+
+		_, err = importArchive(target, rewriteArchive(t, archive,
+			map[string]string{enums.ManifestName: `{"formatVersion":99}`}), enums.StrategySkip)
+		assert.ErrorIs(t, err, enums.ErrorUnsupportedVersion)
+
+		_, err = importArchive(target, []byte("test"), enums.StrategySkip)
+		assert.ErrorIs(t, err, enums.ErrorInvalidArchive)
+
+		assert.Empty(t, target.order)
+	})
+
+	t.Run("should reject records larger than the max size", func(t *testing.T) {
+		source := newMemoryStorage()
+		source.add(enums.KindSetting, "setting", `"`+strings.Repeat("a", 128)+`"`)
+		archive := newTestArchive(t, source)
+
+		_, err := NewImporter(&Options{MaxRecordSize: 64}, newMemoryStorage()).Import(context.Background(),
+			bytes.NewReader(archive), int64(len(archive)), enums.StrategySkip)
+
+		assert.ErrorIs(t, err, enums.ErrorInvalidRecord)
+	})
+
+	t.Run("should return error when strategy is invalid or target fails", func(t *testing.T) {
+		archive := newTestArchive(t, newTestSource())
+
+		_, err := importArchive(newMemoryStorage(), archive, "test")
+		assert.ErrorIs(t, err, enums.ErrorInvalidStrategy)
+
+		_, err = importArchive(&errorStorage{}, archive, enums.StrategySkip)
+		assert.EqualError(t, err, "test")
+	})
+}
@@ -0,0 +1,54 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+type Kind string
+
+const (
+	KindWorkspace  Kind = "workspace"
+	KindRepository Kind = "repository"
+	KindSetting    Kind = "setting"
+	KindAnalysis   Kind = "analysis"
+)
+
+// Values returns the kinds on the order they are exported and imported, so the records are imported after the ones
+// they reference
+func Values() []Kind {
+	return []Kind{
+		KindWorkspace,
+		KindRepository,
+		KindSetting,
+		KindAnalysis,
+	}
+}
+
+func (k Kind) ToString() string {
+	return string(k)
+}
+
+func (k Kind) IsValid() bool {
+	for _, value := range Values() {
+		if k == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FileName returns the name of the json lines file of the kind on the archive
+func (k Kind) FileName() string {
+	return string(k) + FileExtension
+}
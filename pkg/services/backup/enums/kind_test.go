@@ -0,0 +1,43 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValues(t *testing.T) {
+	t.Run("should return kinds with workspaces first", func(t *testing.T) {
+		assert.Equal(t, KindWorkspace, Values()[0])
+		assert.Len(t, Values(), 4)
+	})
+}
+
+func TestIsValid(t *testing.T) {
+	t.Run("should return true only for known kinds and strategies", func(t *testing.T) {
+		assert.True(t, KindAnalysis.IsValid())
+		assert.False(t, Kind("test").IsValid())
+		assert.True(t, StrategyOverwrite.IsValid())
+		assert.False(t, Strategy("test").IsValid())
+	})
+}
+
+func TestFileName(t *testing.T) {
+	t.Run("should return json lines file name", func(t *testing.T) {
+		assert.Equal(t, "analysis.jsonl", KindAnalysis.FileName())
+	})
+}
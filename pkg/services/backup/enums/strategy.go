@@ -0,0 +1,46 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+// Strategy decides what happens when an imported record already exists on the target installation
+type Strategy string
+
+const (
+	StrategySkip      Strategy = "skip"
+	StrategyOverwrite Strategy = "overwrite"
+	StrategyFail      Strategy = "fail"
+)
+
+func StrategyValues() []Strategy {
+	return []Strategy{
+		StrategySkip,
+		StrategyOverwrite,
+		StrategyFail,
+	}
+}
+
+func (s Strategy) ToString() string {
+	return string(s)
+}
+
+func (s Strategy) IsValid() bool {
+	for _, value := range StrategyValues() {
+		if s == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,125 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/backup/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+// ISource returns the records of a workspace, calling write for each record of the kind, so the services stream
+// their rows into the archive without loading the whole workspace in memory
+type ISource interface {
+	Export(ctx context.Context, workspaceID uuid.UUID, kind enums.Kind,
+		write func(id string, data interface{}) error) error
+}
+
+type IExporter interface {
+	Export(ctx context.Context, workspaceID uuid.UUID, writer io.Writer) (*Manifest, error)
+}
+
+// Exporter writes a zip archive with a json lines file for each kind and the manifest, which is written last since
+// it has the checksums of the other files
+type Exporter struct {
+	options *Options
+	source  ISource
+	clock   clock.IClock
+}
+
+func NewExporter(options *Options, source ISource) IExporter {
+	return &Exporter{options: options, source: source, clock: clock.OrDefault(options.Clock)}
+}
+
+func (e *Exporter) Export(ctx context.Context, workspaceID uuid.UUID, writer io.Writer) (*Manifest, error) {
+	if workspaceID == uuid.Nil {
+		return nil, enums.ErrorWorkspaceIDRequired
+	}
+
+	archive := zip.NewWriter(writer)
+	manifest := &Manifest{FormatVersion: enums.FormatVersion, Source: e.options.Source, WorkspaceID: workspaceID,
+		CreatedAt: e.clock.Now().UTC()}
+
+	for _, kind := range enums.Values() {
+		file, err := e.exportKind(ctx, archive, workspaceID, kind)
+		if err != nil {
+			return nil, err
+		}
+
+		manifest.Files = append(manifest.Files, file)
+	}
+
+	if err := e.writeManifest(archive, manifest); err != nil {
+		return nil, err
+	}
+
+	logger.LogInfoWithFields(enums.MessageExportFinished, map[string]interface{}{enums.LogFieldWorkspaceID: workspaceID})
+
+	return manifest, nil
+}
+
+func (e *Exporter) exportKind(ctx context.Context, archive *zip.Writer, workspaceID uuid.UUID,
+	kind enums.Kind) (*File, error) {
+	entry, err := archive.Create(kind.FileName())
+	if err != nil {
+		return nil, err
+	}
+
+	file := &File{Kind: kind, Name: kind.FileName()}
+	hash := sha256.New()
+	encoder := json.NewEncoder(io.MultiWriter(entry, hash))
+
+	err = e.source.Export(ctx, workspaceID, kind, func(id string, data interface{}) error {
+		content, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+
+		record := &Record{ID: id, Data: content}
+		if err := record.validate(); err != nil {
+			return err
+		}
+
+		file.Records++
+
+		return encoder.Encode(record)
+	})
+
+	file.SHA256 = hex.EncodeToString(hash.Sum(nil))
+
+	return file, err
+}
+
+func (e *Exporter) writeManifest(archive *zip.Writer, manifest *Manifest) error {
+	entry, err := archive.Create(enums.ManifestName)
+	if err != nil {
+		return err
+	}
+
+	if err := json.NewEncoder(entry).Encode(manifest); err != nil {
+		return err
+	}
+
+	return archive.Close()
+}
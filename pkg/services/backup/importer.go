@@ -0,0 +1,246 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"archive/zip"
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/backup/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+// ITarget writes the imported records on the installation, where exists reports if a record with the same id of the
+// kind is already there, which is handled by the conflict strategy of the import
+type ITarget interface {
+	Exists(ctx context.Context, kind enums.Kind, id string) (bool, error)
+	Create(ctx context.Context, kind enums.Kind, record *Record) error
+	Replace(ctx context.Context, kind enums.Kind, record *Record) error
+}
+
+type IImporter interface {
+	ReadManifest(reader io.ReaderAt, size int64) (*Manifest, error)
+	Import(ctx context.Context, reader io.ReaderAt, size int64, strategy enums.Strategy) (*Result, error)
+}
+
+// Result counts the imported records of each kind
+type Result struct {
+	Manifest    *Manifest          `json:"manifest"`
+	Created     map[enums.Kind]int `json:"created"`
+	Overwritten map[enums.Kind]int `json:"overwritten"`
+	Skipped     map[enums.Kind]int `json:"skipped"`
+}
+
+// Importer reads the archives written by the exporter. The whole archive is verified before the first record is
+// written, checking the checksums and record counts of the manifest and, with the fail strategy, the conflicts, so
+// an invalid archive or a conflict does not leave a workspace half imported.
+type Importer struct {
+	options *Options
+	target  ITarget
+}
+
+func NewImporter(options *Options, target ITarget) IImporter {
+	return &Importer{options: options, target: target}
+}
+
+func (i *Importer) ReadManifest(reader io.ReaderAt, size int64) (*Manifest, error) {
+	archive, err := zip.NewReader(reader, size)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", enums.ErrorInvalidArchive, err.Error())
+	}
+
+	return i.readManifest(archive)
+}
+
+func (i *Importer) Import(ctx context.Context, reader io.ReaderAt, size int64,
+	strategy enums.Strategy) (*Result, error) {
+	if !strategy.IsValid() {
+		return nil, enums.ErrorInvalidStrategy
+	}
+
+	archive, err := zip.NewReader(reader, size)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", enums.ErrorInvalidArchive, err.Error())
+	}
+
+	manifest, err := i.readManifest(archive)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := i.verify(ctx, archive, manifest, strategy); err != nil {
+		return nil, err
+	}
+
+	return i.importFiles(ctx, archive, manifest, strategy)
+}
+
+func (i *Importer) readManifest(archive *zip.Reader) (*Manifest, error) {
+	entry, err := archive.Open(enums.ManifestName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", enums.ErrorInvalidArchive, err.Error())
+	}
+
+	defer func() {
+		_ = entry.Close()
+	}()
+
+	manifest := &Manifest{}
+	if err := json.NewDecoder(io.LimitReader(entry, enums.MaxManifestSize)).Decode(manifest); err != nil {
+		return nil, fmt.Errorf("%w: %s", enums.ErrorInvalidArchive, err.Error())
+	}
+
+	return manifest, manifest.Validate()
+}
+
+// verify reads every file once, comparing its checksum and record count with the manifest
+func (i *Importer) verify(ctx context.Context, archive *zip.Reader, manifest *Manifest,
+	strategy enums.Strategy) error {
+	for _, file := range manifest.Files {
+		hash := sha256.New()
+
+		records, err := i.readFile(archive, file, hash, func(record *Record) error {
+			if strategy == enums.StrategyFail {
+				return i.checkConflict(ctx, file.Kind, record)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if records != file.Records || hex.EncodeToString(hash.Sum(nil)) != file.SHA256 {
+			return fmt.Errorf("%w: %s", enums.ErrorChecksumMismatch, file.Name)
+		}
+	}
+
+	return nil
+}
+
+func (i *Importer) checkConflict(ctx context.Context, kind enums.Kind, record *Record) error {
+	exists, err := i.target.Exists(ctx, kind, record.ID)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return fmt.Errorf("%w: %s %s", enums.ErrorRecordAlreadyExists, kind, record.ID)
+	}
+
+	return nil
+}
+
+func (i *Importer) importFiles(ctx context.Context, archive *zip.Reader, manifest *Manifest,
+	strategy enums.Strategy) (*Result, error) {
+	result := &Result{Manifest: manifest, Created: map[enums.Kind]int{}, Overwritten: map[enums.Kind]int{},
+		Skipped: map[enums.Kind]int{}}
+
+	for _, kind := range enums.Values() {
+		for _, file := range manifest.GetFiles(kind) {
+			if _, err := i.readFile(archive, file, io.Discard, func(record *Record) error {
+				return i.importRecord(ctx, kind, record, strategy, result)
+			}); err != nil {
+				return result, err
+			}
+		}
+	}
+
+	logger.LogInfoWithFields(enums.MessageImportFinished, map[string]interface{}{
+		enums.LogFieldWorkspaceID: manifest.WorkspaceID, enums.LogFieldSource: manifest.Source,
+		enums.LogFieldCreated: result.Created, enums.LogFieldOverwritten: result.Overwritten,
+		enums.LogFieldSkipped: result.Skipped,
+	})
+
+	return result, nil
+}
+
+func (i *Importer) importRecord(ctx context.Context, kind enums.Kind, record *Record, strategy enums.Strategy,
+	result *Result) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	exists, err := i.target.Exists(ctx, kind, record.ID)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case !exists:
+		result.Created[kind]++
+
+		return i.target.Create(ctx, kind, record)
+	case strategy == enums.StrategyOverwrite:
+		result.Overwritten[kind]++
+
+		return i.target.Replace(ctx, kind, record)
+	case strategy == enums.StrategySkip:
+		result.Skipped[kind]++
+
+		return nil
+	default:
+		return fmt.Errorf("%w: %s %s", enums.ErrorRecordAlreadyExists, kind, record.ID)
+	}
+}
+
+// readFile calls handle for each record of the file, writing the raw content to the hash, and returns the count of
+// records read
+func (i *Importer) readFile(archive *zip.Reader, file *File, hash io.Writer,
+	handle func(record *Record) error) (int, error) {
+	entry, err := archive.Open(file.Name)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", enums.ErrorInvalidArchive, err.Error())
+	}
+
+	defer func() {
+		_ = entry.Close()
+	}()
+
+	scanner := bufio.NewScanner(io.TeeReader(entry, hash))
+	scanner.Buffer(nil, i.options.getMaxRecordSize())
+
+	records := 0
+
+	for scanner.Scan() {
+		record := &Record{}
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil || record.validate() != nil {
+			return records, fmt.Errorf("%w: %s line %d", enums.ErrorInvalidRecord, file.Name, records+1)
+		}
+
+		records++
+
+		if err := handle(record); err != nil {
+			return records, err
+		}
+	}
+
+	return records, i.getScanError(scanner.Err(), file)
+}
+
+func (i *Importer) getScanError(err error, file *File) error {
+	if errors.Is(err, bufio.ErrTooLong) {
+		return fmt.Errorf("%w: %s record exceeds the max size", enums.ErrorInvalidRecord, file.Name)
+	}
+
+	return err
+}
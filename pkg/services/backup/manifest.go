@@ -0,0 +1,87 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/backup/enums"
+)
+
+// Manifest describes the archive, listing each json lines file with its record count and sha256, so the importer
+// can reject truncated or modified archives before writing anything
+type Manifest struct {
+	FormatVersion int       `json:"formatVersion"`
+	Source        string    `json:"source"`
+	WorkspaceID   uuid.UUID `json:"workspaceID"`
+	CreatedAt     time.Time `json:"createdAt"`
+	Files         []*File   `json:"files"`
+}
+
+type File struct {
+	Kind    enums.Kind `json:"kind"`
+	Name    string     `json:"name"`
+	Records int        `json:"records"`
+	SHA256  string     `json:"sha256"`
+}
+
+// Record is a line of the json lines files, where the id identifies the record on the conflicts and the data is
+// kept as raw json, so each service decodes it into its own entity
+type Record struct {
+	ID   string          `json:"id"`
+	Data json.RawMessage `json:"data"`
+}
+
+// Validate accepts the manifests of any format version up to the current one
+func (m *Manifest) Validate() error {
+	if m.FormatVersion < 1 || m.FormatVersion > enums.FormatVersion {
+		return fmt.Errorf("%w: %d", enums.ErrorUnsupportedVersion, m.FormatVersion)
+	}
+
+	names := map[string]bool{}
+
+	for _, file := range m.Files {
+		if file == nil || !file.Kind.IsValid() || file.Name == "" || names[file.Name] || file.Records < 0 {
+			return fmt.Errorf("%w: invalid manifest file", enums.ErrorInvalidArchive)
+		}
+
+		names[file.Name] = true
+	}
+
+	return nil
+}
+
+// GetFiles returns the files of the kind, keeping the order of the manifest
+func (m *Manifest) GetFiles(kind enums.Kind) (files []*File) {
+	for _, file := range m.Files {
+		if file.Kind == kind {
+			files = append(files, file)
+		}
+	}
+
+	return files
+}
+
+func (r *Record) validate() error {
+	if r.ID == "" || len(r.Data) == 0 {
+		return enums.ErrorInvalidRecord
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/backup/enums"
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+)
+
+type ExporterMock struct {
+	mock.Mock
+}
+
+func (m *ExporterMock) Export(_ context.Context, _ uuid.UUID, _ io.Writer) (*Manifest, error) {
+	args := m.MethodCalled("Export")
+
+	return args.Get(0).(*Manifest), mockUtils.ReturnNilOrError(args, 1)
+}
+
+type ImporterMock struct {
+	mock.Mock
+}
+
+func (m *ImporterMock) ReadManifest(_ io.ReaderAt, _ int64) (*Manifest, error) {
+	args := m.MethodCalled("ReadManifest")
+
+	return args.Get(0).(*Manifest), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *ImporterMock) Import(_ context.Context, _ io.ReaderAt, _ int64, _ enums.Strategy) (*Result, error) {
+	args := m.MethodCalled("Import")
+
+	return args.Get(0).(*Result), mockUtils.ReturnNilOrError(args, 1)
+}
@@ -0,0 +1,45 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/services/backup/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// Options configures the source written on the manifests, which identifies the installation that exported the
+// archive, and the max size of each record, which limits the memory used to import an archive
+type Options struct {
+	Source        string
+	MaxRecordSize int
+	Clock         clock.IClock
+}
+
+func NewOptions() *Options {
+	return &Options{
+		Source:        env.GetEnvOrDefault(enums.HorusecBackupSource, ""),
+		MaxRecordSize: env.GetEnvOrDefaultInt(enums.HorusecBackupMaxRecordSize, enums.DefaultMaxRecordSize),
+		Clock:         clock.NewClock(),
+	}
+}
+
+func (o *Options) getMaxRecordSize() int {
+	if o.MaxRecordSize <= 0 {
+		return enums.DefaultMaxRecordSize
+	}
+
+	return o.MaxRecordSize
+}
@@ -15,13 +15,18 @@
 package broker
 
 import (
+	"context"
+
 	"github.com/pkg/errors"
 	"github.com/streadway/amqp"
 
 	brokerConfig "github.com/ZupIT/horusec-devkit/pkg/services/broker/config"
 	"github.com/ZupIT/horusec-devkit/pkg/services/broker/enums"
 	brokerPacket "github.com/ZupIT/horusec-devkit/pkg/services/broker/packet"
+	"github.com/ZupIT/horusec-devkit/pkg/services/chaos"
+	chaosEnums "github.com/ZupIT/horusec-devkit/pkg/services/chaos/enums"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/retry"
 )
 
 type IBroker interface {
@@ -35,6 +40,7 @@ type Broker struct {
 	connection iConnection
 	channel    iChannel
 	config     brokerConfig.IConfig
+	injector   chaos.IInjector
 }
 
 func NewBroker(config brokerConfig.IConfig) (IBroker, error) {
@@ -42,14 +48,29 @@ func NewBroker(config brokerConfig.IConfig) (IBroker, error) {
 		return nil, err
 	}
 
-	broker := &Broker{config: config}
-	if err := broker.setupConnection(); err != nil {
+	broker := &Broker{config: config, injector: chaos.NewInjector(chaos.NewOptions())}
+	if err := broker.setupConnectionWithRetry(); err != nil {
 		return nil, errors.Wrap(err, enums.MessageFailedConnectBroker)
 	}
 
 	return broker, broker.setupChannel()
 }
 
+// setupConnectionWithRetry is only used on the creation of the broker, so the services do not crash when they start
+// before the broker is ready, while the later reconnections are made on the next publish or consume
+func (b *Broker) setupConnectionWithRetry() error {
+	return retry.Do(context.Background(), retry.NewPolicy(enums.RetryOperationConnect), func(context.Context) error {
+		connection, err := b.makeConnection()
+		if err != nil {
+			return err
+		}
+
+		b.connection = connection
+
+		return nil
+	})
+}
+
 func (b *Broker) setupConnection() (err error) {
 	if b.isEmptyOrNilConnection() {
 		b.connection, err = b.makeConnection()
@@ -133,6 +154,10 @@ func (b *Broker) exchangeDeclare(exchange, exchangeKind string) error {
 }
 
 func (b *Broker) Publish(queue, exchange, exchangeKind string, body []byte) error {
+	if err := b.injectFault(queue); err != nil {
+		return err
+	}
+
 	if err := b.setupChannel(); err != nil {
 		logger.LogError(enums.MessageFailedCreateChannelPublish, err)
 
@@ -180,10 +205,28 @@ func (b *Broker) handleDeliveries(queue string, handler func(packet brokerPacket
 
 	for delivery := range deliveries {
 		message := delivery
-		handler(brokerPacket.NewPacket(&message))
+		packet := brokerPacket.NewPacket(&message)
+
+		if err := b.injectFault(queue); err != nil {
+			logger.LogError(enums.MessageFaultInjectedConsume, err)
+			logger.LogError(enums.MessageFailedNackFaultInjected, packet.Nack())
+
+			continue
+		}
+
+		handler(packet)
 	}
 }
 
+// injectFault injects the faults of the chaos hooks, nacking the consumed messages like a consumer that failed
+func (b *Broker) injectFault(queue string) error {
+	if b.injector == nil {
+		return nil
+	}
+
+	return b.injector.Inject(context.Background(), chaosEnums.TargetBroker, queue)
+}
+
 func (b *Broker) setConsumerPrefetch() {
 	if err := b.channel.Qos(1, 0, false); err != nil {
 		logger.LogPanic(enums.MessageFailedSetConsumerPrefetch, err)
@@ -23,6 +23,8 @@ import (
 
 	"github.com/ZupIT/horusec-devkit/pkg/services/broker/config"
 	"github.com/ZupIT/horusec-devkit/pkg/services/broker/packet"
+	"github.com/ZupIT/horusec-devkit/pkg/services/chaos"
+	chaosEnums "github.com/ZupIT/horusec-devkit/pkg/services/chaos/enums"
 )
 
 func getTestConfig() *config.Config {
@@ -276,6 +278,59 @@ func TestPublish(t *testing.T) {
 
 		assert.Error(t, broker.Publish("", "", "", []byte("")))
 	})
+
+	t.Run("should return error without publishing when chaos fault injected", func(t *testing.T) {
+		injector := &chaos.Mock{}
+		injector.On("Inject").Return(chaosEnums.ErrorFaultInjected)
+
+		broker := &Broker{config: getTestConfig(), injector: injector}
+
+		assert.ErrorIs(t, broker.Publish("", "", "", []byte("")), chaosEnums.ErrorFaultInjected)
+	})
+}
+
+type nackAcknowledger struct {
+	nacked int
+}
+
+func (n *nackAcknowledger) Ack(_ uint64, _ bool) error {
+	return nil
+}
+
+func (n *nackAcknowledger) Nack(_ uint64, _, _ bool) error {
+	n.nacked++
+
+	return nil
+}
+
+func (n *nackAcknowledger) Reject(_ uint64, _ bool) error {
+	return nil
+}
+
+func TestHandleDeliveries(t *testing.T) {
+	t.Run("should nack without calling handler when chaos fault injected", func(t *testing.T) {
+		acknowledger := &nackAcknowledger{}
+		deliveries := make(chan amqp.Delivery, 2)
+		deliveries <- amqp.Delivery{Acknowledger: acknowledger}
+		deliveries <- amqp.Delivery{Acknowledger: acknowledger}
+		close(deliveries)
+
+		channelMock := &channelMock{}
+		channelMock.On("Consume").Return((<-chan amqp.Delivery)(deliveries), nil)
+
+		injector := &chaos.Mock{}
+		injector.On("Inject").Return(chaosEnums.ErrorFaultInjected).Once()
+		injector.On("Inject").Return(nil).Once()
+
+		handled := 0
+		broker := &Broker{channel: channelMock, config: getTestConfig(), injector: injector}
+		broker.handleDeliveries("test", func(packet packet.IPacket) {
+			handled++
+		})
+
+		assert.Equal(t, 1, handled)
+		assert.Equal(t, 1, acknowledger.nacked)
+	})
 }
 
 func TestConsume(t *testing.T) {
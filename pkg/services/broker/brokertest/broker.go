@@ -0,0 +1,195 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package brokertest
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	brokerPacket "github.com/ZupIT/horusec-devkit/pkg/services/broker/packet"
+)
+
+const consumerTimeout = 5 * time.Second
+
+// Message is a message published on the broker
+type Message struct {
+	Queue        string
+	Exchange     string
+	ExchangeKind string
+	Body         []byte
+}
+
+// Broker is an in memory IBroker that records the published messages and delivers the messages injected by the
+// tests to the registered consumers on the goroutine of the test, so the assertions can be made right after it
+type Broker struct {
+	mutex      sync.Mutex
+	messages   []*Message
+	consumers  map[string]func(packet brokerPacket.IPacket)
+	publishErr error
+	available  bool
+	closed     chan struct{}
+	closeOnce  sync.Once
+}
+
+func NewBroker() *Broker {
+	return &Broker{
+		consumers: map[string]func(packet brokerPacket.IPacket){},
+		available: true,
+		closed:    make(chan struct{}),
+	}
+}
+
+func (b *Broker) IsAvailable() bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return b.available
+}
+
+func (b *Broker) SetAvailable(available bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.available = available
+}
+
+// SetPublishError makes the next publishes return the error without recording the messages
+func (b *Broker) SetPublishError(err error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.publishErr = err
+}
+
+func (b *Broker) Publish(queue, exchange, exchangeKind string, body []byte) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.publishErr != nil {
+		return b.publishErr
+	}
+
+	b.messages = append(b.messages, &Message{Queue: queue, Exchange: exchange, ExchangeKind: exchangeKind,
+		Body: append([]byte{}, body...)})
+
+	return nil
+}
+
+// Consume registers the handler of the queue and blocks until the broker is closed, as the real broker does
+func (b *Broker) Consume(queue, _, _ string, handler func(packet brokerPacket.IPacket)) {
+	b.mutex.Lock()
+	b.consumers[queue] = handler
+	b.mutex.Unlock()
+
+	<-b.closed
+}
+
+func (b *Broker) Close() error {
+	b.closeOnce.Do(func() {
+		close(b.closed)
+	})
+
+	return nil
+}
+
+// Deliver calls the consumer of the queue with the body and returns the packet to assert if it was acked. Since
+// consumers are usually started on a goroutine, it waits for the consumer to be registered before failing the test
+func (b *Broker) Deliver(t testing.TB, queue string, body []byte) *Packet {
+	t.Helper()
+
+	handler := b.waitConsumer(queue)
+	if handler == nil {
+		t.Fatalf("no consumer registered for queue %s", queue)
+
+		return nil
+	}
+
+	packet := &Packet{body: body}
+	handler(packet)
+
+	return packet
+}
+
+func (b *Broker) waitConsumer(queue string) func(packet brokerPacket.IPacket) {
+	deadline := time.Now().Add(consumerTimeout)
+
+	for {
+		b.mutex.Lock()
+		handler := b.consumers[queue]
+		b.mutex.Unlock()
+
+		if handler != nil || time.Now().After(deadline) {
+			return handler
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// Published returns the published messages that match every matcher
+func (b *Broker) Published(matchers ...Matcher) (messages []*Message) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	for _, message := range b.messages {
+		if matchAll(message, matchers) {
+			messages = append(messages, message)
+		}
+	}
+
+	return messages
+}
+
+func (b *Broker) AssertPublished(t testing.TB, matchers ...Matcher) bool {
+	t.Helper()
+
+	return assert.NotEmpty(t, b.Published(matchers...), "no published message matches, published: %s",
+		b.describeMessages())
+}
+
+func (b *Broker) AssertNotPublished(t testing.TB, matchers ...Matcher) bool {
+	t.Helper()
+
+	return assert.Empty(t, b.Published(matchers...), "unexpected published message")
+}
+
+func (b *Broker) AssertPublishedTimes(t testing.TB, times int, matchers ...Matcher) bool {
+	t.Helper()
+
+	return assert.Len(t, b.Published(matchers...), times, "published: %s", b.describeMessages())
+}
+
+// Reset removes the recorded messages
+func (b *Broker) Reset() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.messages = nil
+}
+
+func (b *Broker) describeMessages() (description string) {
+	for _, message := range b.Published() {
+		description += "\n" + message.String()
+	}
+
+	return description
+}
+
+func (m *Message) String() string {
+	return "queue=" + m.Queue + " exchange=" + m.Exchange + " body=" + string(m.Body)
+}
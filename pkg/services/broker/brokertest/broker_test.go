@@ -0,0 +1,120 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package brokertest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	brokerPacket "github.com/ZupIT/horusec-devkit/pkg/services/broker/packet"
+)
+
+func TestNewBroker(t *testing.T) {
+	t.Run("should implement broker interface", func(t *testing.T) {
+		var b broker.IBroker = NewBroker()
+
+		assert.True(t, b.IsAvailable())
+	})
+}
+
+func TestPublish(t *testing.T) {
+	t.Run("should record published messages and match them", func(t *testing.T) {
+		b := NewBroker()
+
+		assert.NoError(t, b.Publish("queue", "", "", []byte(`{"id": 1, "name": "test"}`)))
+		assert.NoError(t, b.Publish("", "exchange", "fanout", []byte("other")))
+
+		b.AssertPublished(t, WithQueue("queue"), WithJSONBody(map[string]interface{}{"name": "test", "id": 1}))
+		b.AssertPublished(t, WithExchange("exchange"), WithBody([]byte("other")))
+		b.AssertPublishedTimes(t, 1, WithBodyContaining("test"))
+		b.AssertNotPublished(t, WithQueue("queue"), WithBody([]byte("other")))
+		assert.Len(t, b.Published(), 2)
+
+		b.Reset()
+		assert.Empty(t, b.Published())
+	})
+
+	t.Run("should not match invalid json body", func(t *testing.T) {
+		b := NewBroker()
+
+		assert.NoError(t, b.Publish("queue", "", "", []byte("test")))
+
+		assert.Empty(t, b.Published(WithJSONBody("test")))
+		assert.Empty(t, b.Published(WithJSONBody(func() {})))
+	})
+
+	t.Run("should return the publish error", func(t *testing.T) {
+		b := NewBroker()
+		b.SetPublishError(errors.New("test"))
+
+		assert.Error(t, b.Publish("queue", "", "", nil))
+		assert.Empty(t, b.Published())
+	})
+
+	t.Run("should fail the assertion when no message matches", func(t *testing.T) {
+		b := NewBroker()
+		assert.NoError(t, b.Publish("queue", "", "", nil))
+
+		assert.False(t, b.AssertPublished(&testing.T{}, WithQueue("other")))
+		assert.False(t, b.AssertNotPublished(&testing.T{}, WithQueue("queue")))
+	})
+}
+
+func TestDeliver(t *testing.T) {
+	t.Run("should deliver synchronously to the consumer started on a goroutine", func(t *testing.T) {
+		b := NewBroker()
+		consumed := ""
+
+		go b.Consume("queue", "", "", func(packet brokerPacket.IPacket) {
+			consumed = string(packet.GetBody())
+			_ = packet.Ack()
+		})
+
+		packet := b.Deliver(t, "queue", []byte("test"))
+
+		assert.Equal(t, "test", consumed)
+		assert.True(t, packet.IsAcked())
+		assert.False(t, packet.IsNacked())
+		assert.NoError(t, b.Close())
+		assert.NoError(t, b.Close())
+	})
+
+	t.Run("should record nack and body changes", func(t *testing.T) {
+		b := NewBroker()
+
+		go b.Consume("queue", "", "", func(packet brokerPacket.IPacket) {
+			packet.SetBody([]byte("changed"))
+			_ = packet.Nack()
+		})
+
+		packet := b.Deliver(t, "queue", []byte("test"))
+
+		assert.True(t, packet.IsNacked())
+		assert.Equal(t, []byte("changed"), packet.GetBody())
+		assert.NoError(t, b.Close())
+	})
+}
+
+func TestSetAvailable(t *testing.T) {
+	t.Run("should set availability", func(t *testing.T) {
+		b := NewBroker()
+		b.SetAvailable(false)
+
+		assert.False(t, b.IsAvailable())
+	})
+}
@@ -0,0 +1,78 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package brokertest
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// Matcher filters the published messages on the assertions
+type Matcher func(message *Message) bool
+
+func WithQueue(queue string) Matcher {
+	return func(message *Message) bool {
+		return message.Queue == queue
+	}
+}
+
+func WithExchange(exchange string) Matcher {
+	return func(message *Message) bool {
+		return message.Exchange == exchange
+	}
+}
+
+func WithBody(body []byte) Matcher {
+	return func(message *Message) bool {
+		return bytes.Equal(message.Body, body)
+	}
+}
+
+func WithBodyContaining(value string) Matcher {
+	return func(message *Message) bool {
+		return strings.Contains(string(message.Body), value)
+	}
+}
+
+// WithJSONBody compares the body decoded as json with the expected value encoded as json, so the order of the
+// fields and the formatting of the body do not matter
+func WithJSONBody(expected interface{}) Matcher {
+	return func(message *Message) bool {
+		var expectedValue, value interface{}
+
+		encoded, err := json.Marshal(expected)
+		if err != nil || json.Unmarshal(encoded, &expectedValue) != nil {
+			return false
+		}
+
+		if err := json.Unmarshal(message.Body, &value); err != nil {
+			return false
+		}
+
+		return reflect.DeepEqual(expectedValue, value)
+	}
+}
+
+func matchAll(message *Message, matchers []Matcher) bool {
+	for _, matcher := range matchers {
+		if !matcher(message) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,71 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package brokertest
+
+import "sync"
+
+// Packet is the packet delivered to the consumers, recording if it was acked or nacked
+type Packet struct {
+	mutex  sync.Mutex
+	body   []byte
+	acked  bool
+	nacked bool
+}
+
+func (p *Packet) Ack() error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.acked = true
+
+	return nil
+}
+
+func (p *Packet) Nack() error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.nacked = true
+
+	return nil
+}
+
+func (p *Packet) GetBody() []byte {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	return p.body
+}
+
+func (p *Packet) SetBody(body []byte) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.body = body
+}
+
+func (p *Packet) IsAcked() bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	return p.acked
+}
+
+func (p *Packet) IsNacked() bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	return p.nacked
+}
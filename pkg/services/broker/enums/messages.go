@@ -24,6 +24,8 @@ const (
 	MessageFailedSetConsumerPrefetch      = "{ERROR_BROKER} failed to set consumer prefetch"
 	MessageFailedToDeclareExchangeQueue   = "{ERROR_BROKER} failed to declare exchange while declaring queue"
 	MessageFailedBindQueueConsume         = "{ERROR_BROKER} failed to queue bind in consume"
+	MessageFaultInjectedConsume           = "{ERROR_BROKER} chaos fault injected while consuming, message requeued"
+	MessageFailedNackFaultInjected        = "{ERROR_BROKER} failed to nack message after chaos fault injected"
 	MessageWarningDefaultBrokerConnection = "{WARN} your user or password for connection with message broker " +
 		"is default content, please change for you best security"
 )
@@ -22,4 +22,6 @@ const (
 
 	DefaultUsername = "guest"
 	DefaultPassword = "guest"
+
+	RetryOperationConnect = "broker_connect"
 )
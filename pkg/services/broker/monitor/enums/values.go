@@ -0,0 +1,52 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+import "time"
+
+const (
+	HorusecBrokerMonitorInterval     = "HORUSEC_BROKER_MONITOR_INTERVAL"
+	HorusecBrokerMonitorQueues       = "HORUSEC_BROKER_MONITOR_QUEUES"
+	HorusecBrokerMonitorMaxMessages  = "HORUSEC_BROKER_MONITOR_MAX_MESSAGES"
+	HorusecBrokerMonitorMinConsumers = "HORUSEC_BROKER_MONITOR_MIN_CONSUMERS"
+	HorusecBrokerMonitorMaxLag       = "HORUSEC_BROKER_MONITOR_MAX_LAG"
+	HorusecBrokerManagementURL       = "HORUSEC_BROKER_MANAGEMENT_URL"
+	HorusecBrokerManagementVHost     = "HORUSEC_BROKER_MANAGEMENT_VHOST"
+	HorusecBrokerManagementTimeout   = "HORUSEC_BROKER_MANAGEMENT_TIMEOUT"
+
+	DefaultInterval          = 30 * time.Second
+	DefaultMaxMessages       = 1000
+	DefaultMinConsumers      = 1
+	DefaultMaxLag            = 5 * time.Minute
+	DefaultVHost             = "/"
+	DefaultManagementTimeout = 10 * time.Second
+
+	ManagementQueuePath = "/api/queues/"
+
+	// UnknownLag is returned when there are messages on the queue but none was acknowledged recently
+	UnknownLag = time.Duration(-1)
+
+	MetricsQueueMessages        = "broker_queue_messages"
+	MetricsQueueConsumers       = "broker_queue_consumers"
+	MetricsQueueLag             = "broker_queue_lag_seconds"
+	MetricsQueueAlerting        = "broker_queue_alerting"
+	MetricsQueueInspectionError = "broker_queue_inspection_errors_total"
+	MetricsLabelQueue           = "queue"
+	MetricsLabelState           = "state"
+	StateReady                  = "ready"
+	StateUnacknowledged         = "unacknowledged"
+
+	LogFieldQueue = "queue"
+)
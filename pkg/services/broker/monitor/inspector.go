@@ -0,0 +1,195 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/streadway/amqp"
+
+	brokerConfig "github.com/ZupIT/horusec-devkit/pkg/services/broker/config"
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker/monitor/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+// QueueStats is the depth and the consumers of a queue at the inspection time. The rates are the messages published
+// and acknowledged per second, which are only returned by the management api.
+type QueueStats struct {
+	Queue          string
+	Messages       int
+	Ready          int
+	Unacknowledged int
+	Consumers      int
+	PublishRate    float64
+	AckRate        float64
+	HasRates       bool
+	InspectedAt    time.Time
+}
+
+// Lag estimates how long the consumers take to drain the queue with the current ack rate, which is the UnknownLag
+// when there are messages but none was acknowledged recently
+func (q *QueueStats) Lag() time.Duration {
+	if q.Messages == 0 {
+		return 0
+	}
+
+	if q.AckRate <= 0 {
+		return enums.UnknownLag
+	}
+
+	return time.Duration(float64(q.Messages) / q.AckRate * float64(time.Second))
+}
+
+type IInspector interface {
+	Inspect(ctx context.Context, queue string) (*QueueStats, error)
+}
+
+// NewInspector returns the inspector of the management api when its url is set, otherwise the passive declare one
+func NewInspector(options *Options, config brokerConfig.IConfig) IInspector {
+	if options.ManagementURL != "" {
+		return NewManagementInspector(options)
+	}
+
+	return NewPassiveInspector(config)
+}
+
+type managementQueue struct {
+	Messages       int `json:"messages"`
+	Ready          int `json:"messages_ready"`
+	Unacknowledged int `json:"messages_unacknowledged"`
+	Consumers      int `json:"consumers"`
+	MessageStats   struct {
+		PublishDetails struct {
+			Rate float64 `json:"rate"`
+		} `json:"publish_details"`
+		AckDetails struct {
+			Rate float64 `json:"rate"`
+		} `json:"ack_details"`
+	} `json:"message_stats"`
+}
+
+type managementInspector struct {
+	options *Options
+	client  *http.Client
+}
+
+func NewManagementInspector(options *Options) IInspector {
+	return &managementInspector{options: options, client: &http.Client{Timeout: options.Timeout}}
+}
+
+func (m *managementInspector) Inspect(ctx context.Context, queue string) (*QueueStats, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, m.getQueueURL(queue), http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", enums.ErrorFailedToInspectQueue, err.Error())
+	}
+
+	request.SetBasicAuth(m.options.ManagementUsername, m.options.ManagementPassword)
+
+	response, err := m.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", enums.ErrorFailedToInspectQueue, err.Error())
+	}
+
+	defer response.Body.Close()
+
+	return m.decode(queue, response)
+}
+
+func (m *managementInspector) getQueueURL(queue string) string {
+	return strings.TrimSuffix(m.options.ManagementURL, "/") + enums.ManagementQueuePath +
+		url.PathEscape(m.options.VHost) + "/" + url.PathEscape(queue)
+}
+
+func (m *managementInspector) decode(queue string, response *http.Response) (*QueueStats, error) {
+	switch {
+	case response.StatusCode == http.StatusNotFound:
+		return nil, fmt.Errorf("%w: %s", enums.ErrorQueueNotFound, queue)
+	case response.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("%w: %s", enums.ErrorUnexpectedManagementAPI, response.Status)
+	}
+
+	body := &managementQueue{}
+	if err := json.NewDecoder(response.Body).Decode(body); err != nil {
+		return nil, fmt.Errorf("%w: %s", enums.ErrorUnexpectedManagementAPI, err.Error())
+	}
+
+	return &QueueStats{
+		Queue: queue, Messages: body.Messages, Ready: body.Ready, Unacknowledged: body.Unacknowledged,
+		Consumers: body.Consumers, PublishRate: body.MessageStats.PublishDetails.Rate,
+		AckRate: body.MessageStats.AckDetails.Rate, HasRates: true,
+	}, nil
+}
+
+// passiveInspector keeps its own connection, since a passive declare of a missing queue closes the channel and the
+// channel of the broker is shared by the publishers. The returned messages are only the ready ones.
+type passiveInspector struct {
+	config     brokerConfig.IConfig
+	mutex      sync.Mutex
+	connection *amqp.Connection
+}
+
+func NewPassiveInspector(config brokerConfig.IConfig) IInspector {
+	return &passiveInspector{config: config}
+}
+
+func (p *passiveInspector) Inspect(_ context.Context, queue string) (*QueueStats, error) {
+	channel, err := p.openChannel()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", enums.ErrorFailedToInspectQueue, err.Error())
+	}
+
+	declared, err := channel.QueueDeclarePassive(queue, true, false, false, false, nil)
+	if err != nil {
+		return nil, p.parseDeclareError(queue, err)
+	}
+
+	logger.LogError(enums.MessageFailedToCloseChannel, channel.Close())
+
+	return &QueueStats{Queue: queue, Messages: declared.Messages, Ready: declared.Messages,
+		Consumers: declared.Consumers}, nil
+}
+
+func (p *passiveInspector) openChannel() (*amqp.Channel, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.connection == nil || p.connection.IsClosed() {
+		connection, err := amqp.Dial(p.config.GetConnectionString())
+		if err != nil {
+			return nil, err
+		}
+
+		p.connection = connection
+	}
+
+	return p.connection.Channel()
+}
+
+func (p *passiveInspector) parseDeclareError(queue string, err error) error {
+	var amqpErr *amqp.Error
+	if errors.As(err, &amqpErr) && amqpErr.Code == amqp.NotFound {
+		return fmt.Errorf("%w: %s", enums.ErrorQueueNotFound, queue)
+	}
+
+	return fmt.Errorf("%w: %s", enums.ErrorFailedToInspectQueue, err.Error())
+}
@@ -0,0 +1,103 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker/config"
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker/monitor/enums"
+)
+
+func TestManagementInspector(t *testing.T) {
+	t.Run("should return stats of the queue on the vhost", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			username, password, _ := r.BasicAuth()
+			assert.Equal(t, "/api/queues/%2F/horusec-email", r.URL.EscapedPath())
+			assert.Equal(t, "user", username)
+			assert.Equal(t, "pass", password)
+
+			_, _ = w.Write([]byte(`{"messages": 30, "messages_ready": 20, "messages_unacknowledged": 10,
+				"consumers": 2, "message_stats": {"publish_details": {"rate": 4.5}, "ack_details": {"rate": 3}}}`))
+		}))
+		defer server.Close()
+
+		options := NewOptions()
+		options.ManagementURL, options.ManagementUsername, options.ManagementPassword = server.URL+"/", "user", "pass"
+
+		stats, err := NewInspector(options, config.NewBrokerConfig()).Inspect(context.Background(), "horusec-email")
+
+		assert.NoError(t, err)
+		assert.Equal(t, &QueueStats{Queue: "horusec-email", Messages: 30, Ready: 20, Unacknowledged: 10,
+			Consumers: 2, PublishRate: 4.5, AckRate: 3, HasRates: true}, stats)
+		assert.Equal(t, 10*time.Second, stats.Lag())
+	})
+
+	t.Run("should return error when queue not found or unexpected response", func(t *testing.T) {
+		status := http.StatusNotFound
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+		}))
+		defer server.Close()
+
+		options := NewOptions()
+		options.ManagementURL = server.URL
+		inspector := NewManagementInspector(options)
+
+		_, err := inspector.Inspect(context.Background(), "test")
+		assert.ErrorIs(t, err, enums.ErrorQueueNotFound)
+
+		status = http.StatusInternalServerError
+
+		_, err = inspector.Inspect(context.Background(), "test")
+		assert.ErrorIs(t, err, enums.ErrorUnexpectedManagementAPI)
+
+		status = http.StatusOK
+
+		_, err = inspector.Inspect(context.Background(), "test")
+		assert.ErrorIs(t, err, enums.ErrorUnexpectedManagementAPI)
+	})
+
+	t.Run("should return error when management api is unavailable", func(t *testing.T) {
+		options := NewOptions()
+		options.ManagementURL = "http://127.0.0.1:0"
+
+		_, err := NewManagementInspector(options).Inspect(context.Background(), "test")
+
+		assert.ErrorIs(t, err, enums.ErrorFailedToInspectQueue)
+	})
+}
+
+func TestQueueStatsLag(t *testing.T) {
+	t.Run("should return zero when empty and unknown when nothing is acknowledged", func(t *testing.T) {
+		assert.Equal(t, time.Duration(0), (&QueueStats{}).Lag())
+		assert.Equal(t, enums.UnknownLag, (&QueueStats{Messages: 1}).Lag())
+	})
+}
+
+func TestNewInspector(t *testing.T) {
+	t.Run("should return passive inspector without management url", func(t *testing.T) {
+		options := NewOptions()
+		options.ManagementURL = ""
+
+		assert.IsType(t, &passiveInspector{}, NewInspector(options, config.NewBrokerConfig()))
+	})
+}
@@ -0,0 +1,65 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitor
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker/monitor/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/metrics"
+)
+
+type queueMetrics struct {
+	messages  *prometheus.GaugeVec
+	consumers *prometheus.GaugeVec
+	lag       *prometheus.GaugeVec
+	alerting  *prometheus.GaugeVec
+	errors    *prometheus.CounterVec
+}
+
+func newQueueMetrics() *queueMetrics {
+	return &queueMetrics{
+		messages: metrics.NewGaugeVec(enums.MetricsQueueMessages, "Messages on the broker queue by state.",
+			enums.MetricsLabelQueue, enums.MetricsLabelState),
+		consumers: metrics.NewGaugeVec(enums.MetricsQueueConsumers, "Consumers of the broker queue.",
+			enums.MetricsLabelQueue),
+		lag: metrics.NewGaugeVec(enums.MetricsQueueLag, "Estimated time to drain the broker queue.",
+			enums.MetricsLabelQueue),
+		alerting: metrics.NewGaugeVec(enums.MetricsQueueAlerting, "Whether the broker queue breaches a threshold.",
+			enums.MetricsLabelQueue),
+		errors: metrics.NewCounterVec(enums.MetricsQueueInspectionError, "Total of failed broker queue inspections.",
+			enums.MetricsLabelQueue),
+	}
+}
+
+func (m *queueMetrics) observe(stats *QueueStats, alerting bool) {
+	m.messages.WithLabelValues(stats.Queue, enums.StateReady).Set(float64(stats.Ready))
+	m.messages.WithLabelValues(stats.Queue, enums.StateUnacknowledged).Set(float64(stats.Unacknowledged))
+	m.consumers.WithLabelValues(stats.Queue).Set(float64(stats.Consumers))
+
+	if lag := stats.Lag(); stats.HasRates && lag != enums.UnknownLag {
+		m.lag.WithLabelValues(stats.Queue).Set(lag.Seconds())
+	}
+
+	if alerting {
+		m.alerting.WithLabelValues(stats.Queue).Set(1)
+	} else {
+		m.alerting.WithLabelValues(stats.Queue).Set(0)
+	}
+}
+
+func (m *queueMetrics) observeError(queue string) {
+	m.errors.WithLabelValues(queue).Inc()
+}
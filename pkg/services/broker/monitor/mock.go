@@ -0,0 +1,58 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitor
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) OnAlert(_ func(alert *Alert)) {
+	_ = m.MethodCalled("OnAlert")
+}
+
+func (m *Mock) Check(_ context.Context) []*QueueStats {
+	args := m.MethodCalled("Check")
+	return args.Get(0).([]*QueueStats)
+}
+
+func (m *Mock) GetStats(_ string) *QueueStats {
+	args := m.MethodCalled("GetStats")
+	return args.Get(0).(*QueueStats)
+}
+
+func (m *Mock) Start(_ context.Context) {
+	_ = m.MethodCalled("Start")
+}
+
+func (m *Mock) Stop() {
+	_ = m.MethodCalled("Stop")
+}
+
+type InspectorMock struct {
+	mock.Mock
+}
+
+func (m *InspectorMock) Inspect(_ context.Context, queue string) (*QueueStats, error) {
+	args := m.MethodCalled("Inspect", queue)
+	return args.Get(0).(*QueueStats), mockUtils.ReturnNilOrError(args, 1)
+}
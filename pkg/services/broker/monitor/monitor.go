@@ -0,0 +1,216 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitor
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker/monitor/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+// Alert is sent when the breached thresholds of a queue change, so the handlers are not called again on each
+// inspection while the queue keeps falling behind. It is not firing when the queue has recovered.
+type Alert struct {
+	Queue   string
+	Reasons []enums.Reason
+	Stats   *QueueStats
+	Firing  bool
+}
+
+type IMonitor interface {
+	OnAlert(handler func(alert *Alert))
+	Check(ctx context.Context) []*QueueStats
+	GetStats(queue string) *QueueStats
+	Start(ctx context.Context)
+	Stop()
+}
+
+type Monitor struct {
+	options   *Options
+	inspector IInspector
+	clock     clock.IClock
+	metrics   *queueMetrics
+	mutex     sync.RWMutex
+	stats     map[string]*QueueStats
+	reasons   map[string][]enums.Reason
+	handlers  []func(alert *Alert)
+	cancel    context.CancelFunc
+	wait      sync.WaitGroup
+}
+
+func NewMonitor(options *Options, inspector IInspector) IMonitor {
+	return &Monitor{
+		options:   options,
+		inspector: inspector,
+		clock:     clock.OrDefault(options.Clock),
+		metrics:   newQueueMetrics(),
+		stats:     map[string]*QueueStats{},
+		reasons:   map[string][]enums.Reason{},
+	}
+}
+
+// OnAlert adds a handler called with the alerts of every queue, it should be added before the monitor is started
+func (m *Monitor) OnAlert(handler func(alert *Alert)) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.handlers = append(m.handlers, handler)
+}
+
+// GetStats returns the stats of the last successful inspection of the queue, or nil when it was never inspected
+func (m *Monitor) GetStats(queue string) *QueueStats {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.stats[queue]
+}
+
+// Check inspects every queue once, returning the stats of the successful inspections. A failed inspection is logged
+// and counted, keeping the last stats and alerts of the queue.
+func (m *Monitor) Check(ctx context.Context) []*QueueStats {
+	inspected := make([]*QueueStats, 0, len(m.options.Queues))
+
+	for _, queue := range m.options.Queues {
+		stats, err := m.inspector.Inspect(ctx, queue)
+		if err != nil {
+			m.metrics.observeError(queue)
+			logger.LogError(enums.MessageFailedToInspectQueue, err, map[string]interface{}{enums.LogFieldQueue: queue})
+
+			continue
+		}
+
+		stats.InspectedAt = m.clock.Now()
+		m.update(stats)
+		inspected = append(inspected, stats)
+	}
+
+	return inspected
+}
+
+func (m *Monitor) update(stats *QueueStats) {
+	m.mutex.Lock()
+
+	reasons := m.evaluate(stats, m.stats[stats.Queue])
+	changed := !isSameReasons(reasons, m.reasons[stats.Queue])
+	m.stats[stats.Queue], m.reasons[stats.Queue] = stats, reasons
+	handlers := m.handlers
+
+	m.mutex.Unlock()
+
+	m.metrics.observe(stats, len(reasons) > 0)
+
+	if changed {
+		m.notify(handlers, &Alert{Queue: stats.Queue, Reasons: reasons, Stats: stats, Firing: len(reasons) > 0})
+	}
+}
+
+// evaluate only considers the unknown lag as breached when the queue already had messages on the last inspection,
+// since the ack rate of an idle queue is zero until its consumers acknowledge the first new message
+func (m *Monitor) evaluate(stats, last *QueueStats) (reasons []enums.Reason) {
+	threshold := m.options.getThreshold(stats.Queue)
+
+	if threshold.MaxMessages > 0 && stats.Messages > threshold.MaxMessages {
+		reasons = append(reasons, enums.ReasonMessages)
+	}
+
+	if threshold.MinConsumers > 0 && stats.Consumers < threshold.MinConsumers {
+		reasons = append(reasons, enums.ReasonConsumers)
+	}
+
+	if threshold.MaxLag > 0 && stats.HasRates {
+		lag := stats.Lag()
+		if lag > threshold.MaxLag || (lag == enums.UnknownLag && last != nil && last.Messages > 0) {
+			reasons = append(reasons, enums.ReasonLag)
+		}
+	}
+
+	return reasons
+}
+
+func (m *Monitor) notify(handlers []func(alert *Alert), alert *Alert) {
+	fields := map[string]interface{}{enums.LogFieldQueue: alert.Queue}
+
+	if alert.Firing {
+		logger.LogWarn(enums.MessageQueueAlertFiring, alert.Queue, alert.Reasons)
+	} else {
+		logger.LogInfoWithFields(enums.MessageQueueAlertResolved, fields)
+	}
+
+	for _, handler := range handlers {
+		handler(alert)
+	}
+}
+
+// Start checks the queues at each interval until the context is done or the monitor is stopped
+func (m *Monitor) Start(ctx context.Context) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.cancel != nil {
+		return
+	}
+
+	ctx, m.cancel = context.WithCancel(ctx)
+	m.wait.Add(1)
+
+	go m.loop(ctx)
+}
+
+// Stop waits for the running check to return, it should be called on graceful shutdown
+func (m *Monitor) Stop() {
+	m.mutex.RLock()
+	cancel := m.cancel
+	m.mutex.RUnlock()
+
+	if cancel != nil {
+		cancel()
+	}
+
+	m.wait.Wait()
+}
+
+func (m *Monitor) loop(ctx context.Context) {
+	defer m.wait.Done()
+
+	ticker := m.clock.NewTicker(m.options.Interval)
+	defer ticker.Stop()
+
+	for {
+		m.Check(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C():
+		}
+	}
+}
+
+func isSameReasons(reasons, last []enums.Reason) bool {
+	if len(reasons) != len(last) {
+		return false
+	}
+
+	for index := range reasons {
+		if reasons[index] != last[index] {
+			return false
+		}
+	}
+
+	return true
+}
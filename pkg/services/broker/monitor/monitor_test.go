@@ -0,0 +1,143 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker/monitor/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+)
+
+func newTestOptions() *Options {
+	options := NewOptions()
+	options.Queues = []string{"test"}
+	options.Threshold = Threshold{MaxMessages: 100, MinConsumers: 1, MaxLag: time.Minute}
+	options.Clock = clock.NewFakeClock(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	return options
+}
+
+func TestCheck(t *testing.T) {
+	t.Run("should alert only when the breached thresholds change", func(t *testing.T) {
+		inspector := &InspectorMock{}
+		monitor := NewMonitor(newTestOptions(), inspector)
+
+		var alerts []*Alert
+		monitor.OnAlert(func(alert *Alert) {
+			alerts = append(alerts, alert)
+		})
+
+		for _, stats := range []*QueueStats{
+			{Queue: "test", Messages: 10, Consumers: 1},
+			{Queue: "test", Messages: 200, Consumers: 0},
+			{Queue: "test", Messages: 300, Consumers: 0},
+			{Queue: "test", Messages: 300, Consumers: 2},
+			{Queue: "test", Messages: 0, Consumers: 2},
+		} {
+			inspector.On("Inspect", "test").Return(stats, nil).Once()
+
+			assert.Equal(t, []*QueueStats{stats}, monitor.Check(context.Background()))
+		}
+
+		assert.Len(t, alerts, 3)
+		assert.True(t, alerts[0].Firing)
+		assert.Equal(t, []enums.Reason{enums.ReasonMessages, enums.ReasonConsumers}, alerts[0].Reasons)
+		assert.Equal(t, []enums.Reason{enums.ReasonMessages}, alerts[1].Reasons)
+		assert.False(t, alerts[2].Firing)
+		assert.Equal(t, 0, monitor.GetStats("test").Messages)
+	})
+
+	t.Run("should alert lag when drain is slow or nothing is acknowledged since last check", func(t *testing.T) {
+		inspector := &InspectorMock{}
+		monitor := NewMonitor(newTestOptions(), inspector)
+
+		var alerts []*Alert
+		monitor.OnAlert(func(alert *Alert) {
+			alerts = append(alerts, alert)
+		})
+
+		for _, stats := range []*QueueStats{
+			{Queue: "test", Messages: 5, Consumers: 1, HasRates: true},
+			{Queue: "test", Messages: 5, Consumers: 1, HasRates: true},
+			{Queue: "test", Messages: 90, Consumers: 1, AckRate: 1, HasRates: true},
+			{Queue: "test", Messages: 90, Consumers: 1, AckRate: 10, HasRates: true},
+			{Queue: "test", Messages: 90, Consumers: 1},
+		} {
+			inspector.On("Inspect", "test").Return(stats, nil).Once()
+			monitor.Check(context.Background())
+		}
+
+		assert.Len(t, alerts, 2)
+		assert.Equal(t, []enums.Reason{enums.ReasonLag}, alerts[0].Reasons)
+		assert.False(t, alerts[1].Firing)
+	})
+
+	t.Run("should keep last stats when inspection fails", func(t *testing.T) {
+		inspector := &InspectorMock{}
+		monitor := NewMonitor(newTestOptions(), inspector)
+
+		inspector.On("Inspect", "test").Return(&QueueStats{Queue: "test", Consumers: 1}, nil).Once()
+		inspector.On("Inspect", "test").Return(&QueueStats{}, errors.New("test")).Once()
+
+		assert.Len(t, monitor.Check(context.Background()), 1)
+		assert.Len(t, monitor.Check(context.Background()), 0)
+		assert.Equal(t, 1, monitor.GetStats("test").Consumers)
+	})
+
+	t.Run("should use threshold of the queue when overridden", func(t *testing.T) {
+		options := newTestOptions()
+		options.Thresholds["test"] = Threshold{MaxMessages: 1000}
+		inspector := &InspectorMock{}
+		monitor := NewMonitor(options, inspector)
+
+		monitor.OnAlert(func(alert *Alert) {
+			assert.Fail(t, "should not alert")
+		})
+
+		inspector.On("Inspect", "test").Return(&QueueStats{Queue: "test", Messages: 500}, nil).Once()
+		monitor.Check(context.Background())
+	})
+}
+
+func TestStartAndStop(t *testing.T) {
+	t.Run("should check queues at each interval until stopped", func(t *testing.T) {
+		options := newTestOptions()
+		fakeClock := options.Clock.(*clock.FakeClock)
+		inspector := &InspectorMock{}
+		checked := make(chan struct{})
+		monitor := NewMonitor(options, inspector)
+
+		inspector.On("Inspect", "test").Return(&QueueStats{Queue: "test", Consumers: 1}, nil).
+			Run(func(_ mock.Arguments) { checked <- struct{}{} })
+
+		monitor.Start(context.Background())
+		monitor.Start(context.Background())
+		<-checked
+
+		fakeClock.BlockUntil(1)
+		fakeClock.Advance(options.Interval)
+		<-checked
+
+		monitor.Stop()
+		inspector.AssertNumberOfCalls(t, "Inspect", 2)
+	})
+}
@@ -0,0 +1,85 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitor
+
+import (
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/queues"
+	brokerEnums "github.com/ZupIT/horusec-devkit/pkg/services/broker/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker/monitor/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// Threshold is breached when the queue has more messages than the max, fewer consumers than the min or when the
+// estimated time to drain the queue is longer than the max lag. Zero disables each check.
+type Threshold struct {
+	MaxMessages  int
+	MinConsumers int
+	MaxLag       time.Duration
+}
+
+// Options configures the queues inspected at each interval and their thresholds, which can be overridden by queue.
+// The queues are inspected with the rabbitmq management api when its url is set, otherwise with passive declares,
+// which do not return the rates, so the lag is not checked.
+type Options struct {
+	Interval           time.Duration
+	Queues             []string
+	Threshold          Threshold
+	Thresholds         map[string]Threshold
+	ManagementURL      string
+	ManagementUsername string
+	ManagementPassword string
+	VHost              string
+	Timeout            time.Duration
+	Clock              clock.IClock
+}
+
+func NewOptions() *Options {
+	return &Options{
+		Interval: env.GetDuration(enums.HorusecBrokerMonitorInterval, enums.DefaultInterval),
+		Queues:   env.GetStringSlice(enums.HorusecBrokerMonitorQueues, defaultQueues()),
+		Threshold: Threshold{
+			MaxMessages:  env.GetEnvOrDefaultInt(enums.HorusecBrokerMonitorMaxMessages, enums.DefaultMaxMessages),
+			MinConsumers: env.GetEnvOrDefaultInt(enums.HorusecBrokerMonitorMinConsumers, enums.DefaultMinConsumers),
+			MaxLag:       env.GetDuration(enums.HorusecBrokerMonitorMaxLag, enums.DefaultMaxLag),
+		},
+		Thresholds:         map[string]Threshold{},
+		ManagementURL:      env.GetEnvOrDefault(enums.HorusecBrokerManagementURL, ""),
+		ManagementUsername: env.GetEnvOrDefault(brokerEnums.EnvBrokerUsername, brokerEnums.DefaultUsername),
+		ManagementPassword: env.GetEnvOrDefault(brokerEnums.EnvBrokerPassword, brokerEnums.DefaultPassword),
+		VHost:              env.GetEnvOrDefault(enums.HorusecBrokerManagementVHost, enums.DefaultVHost),
+		Timeout:            env.GetDuration(enums.HorusecBrokerManagementTimeout, enums.DefaultManagementTimeout),
+		Clock:              clock.NewClock(),
+	}
+}
+
+func (o *Options) getThreshold(queue string) Threshold {
+	if threshold, ok := o.Thresholds[queue]; ok {
+		return threshold
+	}
+
+	return o.Threshold
+}
+
+func defaultQueues() []string {
+	values := make([]string, 0, len(queues.Values()))
+	for _, queue := range queues.Values() {
+		values = append(values, queue.ToString())
+	}
+
+	return values
+}
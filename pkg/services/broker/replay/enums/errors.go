@@ -0,0 +1,31 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+import "errors"
+
+var (
+	ErrorQueueNotAllowed      = errors.New("{ERROR_BROKER_REPLAY} queue is not a replayable dead letter queue")
+	ErrorMessageNotFound      = errors.New("{ERROR_BROKER_REPLAY} message not found on the scanned messages")
+	ErrorEmptySelection       = errors.New("{ERROR_BROKER_REPLAY} select the message ids or all messages")
+	ErrorUnknownTarget        = errors.New("{ERROR_BROKER_REPLAY} message has no x-death header, set the target")
+	ErrorPublishNotConfirmed  = errors.New("{ERROR_BROKER_REPLAY} replayed message was not confirmed by the broker")
+	ErrorFailedToOpenChannel  = errors.New("{ERROR_BROKER_REPLAY} failed to open the broker channel")
+	ErrorFailedToReadMessage  = errors.New("{ERROR_BROKER_REPLAY} failed to read message from queue")
+	ErrorFailedToAckMessage   = errors.New("{ERROR_BROKER_REPLAY} failed to ack the processed message")
+	ErrorInvalidLimit         = errors.New("{ERROR_BROKER_REPLAY} limit must be a positive number")
+	ErrorInvalidRequestBody   = errors.New("{ERROR_BROKER_REPLAY} invalid request body")
+	ErrorMissingAuthorization = errors.New("{ERROR_BROKER_REPLAY} replay routes need an authorization middleware")
+)
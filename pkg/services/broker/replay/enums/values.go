@@ -0,0 +1,45 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+const (
+	HorusecBrokerReplayQueues        = "HORUSEC_BROKER_REPLAY_QUEUES"
+	HorusecBrokerReplayMaxMessages   = "HORUSEC_BROKER_REPLAY_MAX_MESSAGES"
+	HorusecBrokerReplayPreviewLength = "HORUSEC_BROKER_REPLAY_PREVIEW_LENGTH"
+
+	DefaultMaxMessages   = 500
+	DefaultPreviewLength = 256
+	DefaultListLimit     = 20
+
+	HeaderDeath            = "x-death"
+	HeaderReplayCount      = "x-horusec-replay-count"
+	DeathFieldQueue        = "queue"
+	DeathFieldExchange     = "exchange"
+	DeathFieldRoutingKeys  = "routing-keys"
+	DeathFieldReason       = "reason"
+	DeathFieldCount        = "count"
+	DeathFieldTime         = "time"
+	DefaultExchange        = ""
+	GeneratedMessageIDSize = 16
+
+	Route         = "/broker/dead-letters"
+	MessagesRoute = "/{queue}/messages"
+	MessageRoute  = "/{queue}/messages/{id}"
+	ReplayRoute   = "/{queue}/replay"
+	PurgeRoute    = "/{queue}/purge"
+	ParamQueue    = "queue"
+	ParamID       = "id"
+	QueryLimit    = "limit"
+)
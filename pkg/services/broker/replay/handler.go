@@ -0,0 +1,147 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker/replay/enums"
+	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
+)
+
+type IHandler interface {
+	Routes(router chi.Router)
+}
+
+// Handler exposes the replayer under /broker/dead-letters. Every request must pass through the authorization
+// middleware, which should be the application admin one, and the routes reject every request without it.
+type Handler struct {
+	replayer      IReplayer
+	authorization func(next http.Handler) http.Handler
+}
+
+func NewHandler(replayer IReplayer, authorization func(next http.Handler) http.Handler) IHandler {
+	return &Handler{replayer: replayer, authorization: authorization}
+}
+
+func (h *Handler) Routes(router chi.Router) {
+	router.Route(enums.Route, func(router chi.Router) {
+		router.Use(h.authorize)
+		router.Get(enums.MessagesRoute, h.list)
+		router.Get(enums.MessageRoute, h.get)
+		router.Post(enums.ReplayRoute, h.replay)
+		router.Post(enums.PurgeRoute, h.purge)
+	})
+}
+
+func (h *Handler) authorize(next http.Handler) http.Handler {
+	if h.authorization == nil {
+		return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			httpUtil.StatusUnauthorized(w, enums.ErrorMissingAuthorization)
+		})
+	}
+
+	return h.authorization(next)
+}
+
+func (h *Handler) list(w http.ResponseWriter, r *http.Request) {
+	limit, err := getLimit(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+
+		return
+	}
+
+	messages, err := h.replayer.List(r.Context(), chi.URLParam(r, enums.ParamQueue), limit)
+	if err != nil {
+		writeError(w, err)
+
+		return
+	}
+
+	httpUtil.StatusOK(w, messages)
+}
+
+func (h *Handler) get(w http.ResponseWriter, r *http.Request) {
+	message, err := h.replayer.Get(r.Context(), chi.URLParam(r, enums.ParamQueue), chi.URLParam(r, enums.ParamID))
+	if err != nil {
+		writeError(w, err)
+
+		return
+	}
+
+	httpUtil.StatusOK(w, message)
+}
+
+func (h *Handler) replay(w http.ResponseWriter, r *http.Request) {
+	h.process(w, r, h.replayer.Replay)
+}
+
+func (h *Handler) purge(w http.ResponseWriter, r *http.Request) {
+	h.process(w, r, h.replayer.Purge)
+}
+
+func (h *Handler) process(w http.ResponseWriter, r *http.Request,
+	operation func(ctx context.Context, queue string, selection *Selection) (*Result, error)) {
+	selection := &Selection{}
+	if err := json.NewDecoder(r.Body).Decode(selection); err != nil {
+		httpUtil.StatusBadRequest(w, enums.ErrorInvalidRequestBody)
+
+		return
+	}
+
+	result, err := operation(r.Context(), chi.URLParam(r, enums.ParamQueue), selection)
+	if err != nil {
+		writeError(w, err)
+
+		return
+	}
+
+	httpUtil.StatusOK(w, result)
+}
+
+func getLimit(r *http.Request) (int, error) {
+	value := r.URL.Query().Get(enums.QueryLimit)
+	if value == "" {
+		return enums.DefaultListLimit, nil
+	}
+
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit <= 0 {
+		return 0, enums.ErrorInvalidLimit
+	}
+
+	return limit, nil
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, enums.ErrorQueueNotAllowed):
+		httpUtil.StatusForbidden(w, err)
+	case errors.Is(err, enums.ErrorMessageNotFound):
+		httpUtil.StatusNotFound(w, err)
+	case errors.Is(err, enums.ErrorEmptySelection), errors.Is(err, enums.ErrorUnknownTarget),
+		errors.Is(err, enums.ErrorInvalidLimit):
+		httpUtil.StatusBadRequest(w, err)
+	default:
+		httpUtil.StatusInternalServerError(w, err)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replay
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker/replay/enums"
+)
+
+func allowAll(next http.Handler) http.Handler {
+	return next
+}
+
+func doRequest(handler IHandler, method, route, body string) *httptest.ResponseRecorder {
+	router := chi.NewRouter()
+	handler.Routes(router)
+
+	req, _ := http.NewRequest(method, route, strings.NewReader(body))
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	return w
+}
+
+func TestHandler(t *testing.T) {
+	t.Run("should reject requests without authorization middleware", func(t *testing.T) {
+		w := doRequest(NewHandler(&Mock{}, nil), http.MethodGet, "/broker/dead-letters/test/messages", "")
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("should list and get messages", func(t *testing.T) {
+		replayer := &Mock{}
+		replayer.On("List").Return([]*Message{{ID: "1", Body: "test"}}, nil)
+		replayer.On("Get").Return(&Message{ID: "1", Body: "full test"}, nil)
+
+		handler := NewHandler(replayer, allowAll)
+
+		w := doRequest(handler, http.MethodGet, "/broker/dead-letters/test/messages?limit=10", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"body":"test"`)
+
+		w = doRequest(handler, http.MethodGet, "/broker/dead-letters/test/messages/1", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"body":"full test"`)
+
+		w = doRequest(handler, http.MethodGet, "/broker/dead-letters/test/messages?limit=-1", "")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should replay and purge messages", func(t *testing.T) {
+		replayer := &Mock{}
+		replayer.On("Replay").Return(&Result{Processed: 1, Scanned: 2}, nil)
+		replayer.On("Purge").Return(&Result{}, enums.ErrorEmptySelection)
+
+		handler := NewHandler(replayer, allowAll)
+
+		w := doRequest(handler, http.MethodPost, "/broker/dead-letters/test/replay", `{"ids": ["1"]}`)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"processed":1`)
+
+		w = doRequest(handler, http.MethodPost, "/broker/dead-letters/test/purge", `{}`)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = doRequest(handler, http.MethodPost, "/broker/dead-letters/test/purge", `invalid`)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should map the replayer errors to status", func(t *testing.T) {
+		for err, status := range map[error]int{
+			enums.ErrorQueueNotAllowed: http.StatusForbidden,
+			enums.ErrorMessageNotFound: http.StatusNotFound,
+			errors.New("test"):         http.StatusInternalServerError,
+		} {
+			replayer := &Mock{}
+			replayer.On("Get").Return(&Message{}, err)
+
+			w := doRequest(NewHandler(replayer, allowAll), http.MethodGet, "/broker/dead-letters/test/messages/1", "")
+			assert.Equal(t, status, w.Code)
+		}
+	})
+}
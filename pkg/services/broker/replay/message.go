@@ -0,0 +1,152 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replay
+
+import (
+	"time"
+	"unicode/utf8"
+
+	"github.com/streadway/amqp"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker/replay/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/sanitize"
+)
+
+// Death is the last dead letter of the message, read from the x-death header added by the broker
+type Death struct {
+	Reason      string    `json:"reason"`
+	Queue       string    `json:"queue"`
+	Exchange    string    `json:"exchange"`
+	RoutingKeys []string  `json:"routingKeys"`
+	Count       int64     `json:"count"`
+	Time        time.Time `json:"time"`
+}
+
+// Message is identified by its message id property, or by the hash of the body when it was published without one,
+// so the messages with the same body and without id are selected together
+type Message struct {
+	ID          string                 `json:"id"`
+	Queue       string                 `json:"queue"`
+	Body        string                 `json:"body"`
+	BodySize    int                    `json:"bodySize"`
+	Truncated   bool                   `json:"truncated"`
+	ContentType string                 `json:"contentType"`
+	Headers     map[string]interface{} `json:"headers"`
+	Timestamp   time.Time              `json:"timestamp"`
+	Redelivered bool                   `json:"redelivered"`
+	Death       *Death                 `json:"death"`
+}
+
+// Selection selects the messages by id or all of them. The target replaces the queue where the messages are replayed,
+// which is the queue where they died by default.
+type Selection struct {
+	IDs    []string `json:"ids"`
+	All    bool     `json:"all"`
+	Target string   `json:"target"`
+}
+
+func (s *Selection) isEmpty() bool {
+	return !s.All && len(s.IDs) == 0
+}
+
+func (s *Selection) matches(id string) bool {
+	if s.All {
+		return true
+	}
+
+	for _, selected := range s.IDs {
+		if selected == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Result has the number of messages replayed or purged and the number of messages scanned by the operation
+type Result struct {
+	Processed int `json:"processed"`
+	Scanned   int `json:"scanned"`
+}
+
+func getMessageID(delivery *amqp.Delivery) string {
+	if delivery.MessageId != "" {
+		return delivery.MessageId
+	}
+
+	return crypto.GenerateSHA256(string(delivery.Body))[:enums.GeneratedMessageIDSize]
+}
+
+// newMessage truncates the body to the preview length when it is greater than zero
+func newMessage(queue string, delivery *amqp.Delivery, previewLength int) *Message {
+	body, truncated := string(delivery.Body), previewLength > 0 && utf8.RuneCount(delivery.Body) > previewLength
+	if truncated {
+		body = sanitize.Truncate(body, previewLength)
+	}
+
+	return &Message{
+		ID:          getMessageID(delivery),
+		Queue:       queue,
+		Body:        body,
+		BodySize:    len(delivery.Body),
+		Truncated:   truncated,
+		ContentType: delivery.ContentType,
+		Headers:     withoutDeath(delivery.Headers),
+		Timestamp:   delivery.Timestamp,
+		Redelivered: delivery.Redelivered,
+		Death:       getDeath(delivery.Headers),
+	}
+}
+
+func getDeath(headers amqp.Table) *Death {
+	deaths, ok := headers[enums.HeaderDeath].([]interface{})
+	if !ok || len(deaths) == 0 {
+		return nil
+	}
+
+	last, ok := deaths[0].(amqp.Table)
+	if !ok {
+		return nil
+	}
+
+	death := &Death{}
+	death.Reason, _ = last[enums.DeathFieldReason].(string)
+	death.Queue, _ = last[enums.DeathFieldQueue].(string)
+	death.Exchange, _ = last[enums.DeathFieldExchange].(string)
+	death.Count, _ = last[enums.DeathFieldCount].(int64)
+	death.Time, _ = last[enums.DeathFieldTime].(time.Time)
+
+	keys, _ := last[enums.DeathFieldRoutingKeys].([]interface{})
+	for _, key := range keys {
+		if value, ok := key.(string); ok {
+			death.RoutingKeys = append(death.RoutingKeys, value)
+		}
+	}
+
+	return death
+}
+
+func withoutDeath(headers amqp.Table) map[string]interface{} {
+	copied := map[string]interface{}{}
+
+	for key, value := range headers {
+		if key != enums.HeaderDeath {
+			copied[key] = value
+		}
+	}
+
+	return copied
+}
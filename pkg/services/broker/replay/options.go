@@ -0,0 +1,47 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replay
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker/replay/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// Options configures the queues that can be read and changed, which are usually the dead letter and parking queues,
+// and the max messages scanned by each operation, since the messages are read one by one to keep the order of the
+// ones that are not selected. The preview length limits the body returned when listing the messages.
+type Options struct {
+	Queues        []string
+	MaxMessages   int
+	PreviewLength int
+}
+
+func NewOptions() *Options {
+	return &Options{
+		Queues:        env.GetStringSlice(enums.HorusecBrokerReplayQueues, nil),
+		MaxMessages:   env.GetEnvOrDefaultInt(enums.HorusecBrokerReplayMaxMessages, enums.DefaultMaxMessages),
+		PreviewLength: env.GetEnvOrDefaultInt(enums.HorusecBrokerReplayPreviewLength, enums.DefaultPreviewLength),
+	}
+}
+
+func (o *Options) isAllowed(queue string) bool {
+	for _, allowed := range o.Queues {
+		if allowed == queue {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,282 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replay
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/streadway/amqp"
+
+	brokerConfig "github.com/ZupIT/horusec-devkit/pkg/services/broker/config"
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker/replay/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+type iChannel interface {
+	Get(queue string, autoAck bool) (amqp.Delivery, bool, error)
+	Publish(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error
+	Confirmations() <-chan amqp.Confirmation
+	Close() error
+}
+
+// confirmedChannel listens the confirmations once, since the library blocks sending them to every listener
+type confirmedChannel struct {
+	*amqp.Channel
+	confirmations chan amqp.Confirmation
+}
+
+func (c *confirmedChannel) Confirmations() <-chan amqp.Confirmation {
+	return c.confirmations
+}
+
+type IReplayer interface {
+	List(ctx context.Context, queue string, limit int) ([]*Message, error)
+	Get(ctx context.Context, queue, id string) (*Message, error)
+	Replay(ctx context.Context, queue string, selection *Selection) (*Result, error)
+	Purge(ctx context.Context, queue string, selection *Selection) (*Result, error)
+}
+
+// Replayer reads the messages without acknowledging them, so every message that is not replayed or purged returns to
+// the queue on the same position when the channel is closed at the end of the operation. It keeps its own connection,
+// since the channels of the broker are shared by the publishers and consumers of the service.
+type Replayer struct {
+	options    *Options
+	config     brokerConfig.IConfig
+	mutex      sync.Mutex
+	connection *amqp.Connection
+	open       func() (iChannel, error)
+}
+
+func NewReplayer(options *Options, config brokerConfig.IConfig) IReplayer {
+	replayer := &Replayer{options: options, config: config}
+	replayer.open = replayer.openChannel
+
+	return replayer
+}
+
+// List returns the first messages of the queue with the body truncated to the preview length. The listed messages
+// are marked as redelivered by the broker.
+func (r *Replayer) List(ctx context.Context, queue string, limit int) ([]*Message, error) {
+	if limit <= 0 || limit > r.options.MaxMessages {
+		return nil, enums.ErrorInvalidLimit
+	}
+
+	messages := make([]*Message, 0, limit)
+
+	err := r.scan(ctx, queue, limit, func(_ iChannel, delivery *amqp.Delivery) (bool, error) {
+		messages = append(messages, newMessage(queue, delivery, r.options.PreviewLength))
+
+		return false, nil
+	})
+
+	return messages, err
+}
+
+// Get returns the message with the full body, scanning until the max messages
+func (r *Replayer) Get(ctx context.Context, queue, id string) (message *Message, err error) {
+	err = r.scan(ctx, queue, r.options.MaxMessages, func(_ iChannel, delivery *amqp.Delivery) (bool, error) {
+		if message == nil && getMessageID(delivery) == id {
+			message = newMessage(queue, delivery, 0)
+		}
+
+		return false, nil
+	})
+
+	if err == nil && message == nil {
+		return nil, enums.ErrorMessageNotFound
+	}
+
+	return message, err
+}
+
+// Replay publishes the selected messages on the queue where they died, or on the target of the selection, waiting
+// for the broker confirmation before removing them from the dead letter queue. The x-death header is removed and
+// the replay count header is incremented, so the consumers can give up on messages that keep failing.
+func (r *Replayer) Replay(ctx context.Context, queue string, selection *Selection) (*Result, error) {
+	result, err := r.process(ctx, queue, selection, func(channel iChannel, delivery *amqp.Delivery) error {
+		return r.publish(ctx, channel, delivery, selection.Target)
+	})
+
+	if result.Processed > 0 {
+		logger.LogInfoWithFields(enums.MessageMessagesReplayed, map[string]interface{}{
+			enums.LogFieldQueue: queue, enums.LogFieldProcessed: result.Processed})
+	}
+
+	return result, err
+}
+
+// Purge removes the selected messages from the queue
+func (r *Replayer) Purge(ctx context.Context, queue string, selection *Selection) (*Result, error) {
+	result, err := r.process(ctx, queue, selection, func(_ iChannel, _ *amqp.Delivery) error {
+		return nil
+	})
+
+	if result.Processed > 0 {
+		logger.LogInfoWithFields(enums.MessageMessagesPurged, map[string]interface{}{
+			enums.LogFieldQueue: queue, enums.LogFieldProcessed: result.Processed})
+	}
+
+	return result, err
+}
+
+// process acknowledges the selected messages after the action succeeds. It stops on the first error, so the failed
+// message and every message after it stay on the queue.
+func (r *Replayer) process(ctx context.Context, queue string, selection *Selection,
+	action func(channel iChannel, delivery *amqp.Delivery) error) (*Result, error) {
+	result := &Result{}
+	if selection.isEmpty() {
+		return result, enums.ErrorEmptySelection
+	}
+
+	err := r.scan(ctx, queue, r.options.MaxMessages, func(channel iChannel, delivery *amqp.Delivery) (bool, error) {
+		result.Scanned++
+
+		if !selection.matches(getMessageID(delivery)) {
+			return false, nil
+		}
+
+		if err := action(channel, delivery); err != nil {
+			return true, err
+		}
+
+		if err := delivery.Ack(false); err != nil {
+			return true, fmt.Errorf("%w: %s", enums.ErrorFailedToAckMessage, err.Error())
+		}
+
+		result.Processed++
+
+		return false, nil
+	})
+
+	return result, err
+}
+
+// scan reads until the limit or the queue is empty, calling the visitor with each message until it asks to stop
+func (r *Replayer) scan(ctx context.Context, queue string, limit int,
+	visitor func(channel iChannel, delivery *amqp.Delivery) (bool, error)) error {
+	if !r.options.isAllowed(queue) {
+		return enums.ErrorQueueNotAllowed
+	}
+
+	channel, err := r.open()
+	if err != nil {
+		return fmt.Errorf("%w: %s", enums.ErrorFailedToOpenChannel, err.Error())
+	}
+
+	defer func() {
+		logger.LogError(enums.MessageFailedToCloseChannel, channel.Close())
+	}()
+
+	for index := 0; index < limit && ctx.Err() == nil; index++ {
+		delivery, ok, err := channel.Get(queue, false)
+		if err != nil {
+			return fmt.Errorf("%w: %s", enums.ErrorFailedToReadMessage, err.Error())
+		}
+
+		if !ok {
+			return nil
+		}
+
+		if stop, err := visitor(channel, &delivery); stop || err != nil {
+			return err
+		}
+	}
+
+	return ctx.Err()
+}
+
+func (r *Replayer) publish(ctx context.Context, channel iChannel, delivery *amqp.Delivery, target string) error {
+	if target == "" {
+		if death := getDeath(delivery.Headers); death != nil {
+			target = death.Queue
+		}
+	}
+
+	if target == "" {
+		return fmt.Errorf("%w: %s", enums.ErrorUnknownTarget, getMessageID(delivery))
+	}
+
+	if err := channel.Publish(enums.DefaultExchange, target, false, false, newPublishing(delivery)); err != nil {
+		return err
+	}
+
+	return waitConfirmation(ctx, channel)
+}
+
+func waitConfirmation(ctx context.Context, channel iChannel) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case confirmation, ok := <-channel.Confirmations():
+		if !ok || !confirmation.Ack {
+			return enums.ErrorPublishNotConfirmed
+		}
+
+		return nil
+	}
+}
+
+func newPublishing(delivery *amqp.Delivery) amqp.Publishing {
+	headers := amqp.Table(withoutDeath(delivery.Headers))
+	count, _ := headers[enums.HeaderReplayCount].(int64)
+	headers[enums.HeaderReplayCount] = count + 1
+
+	return amqp.Publishing{
+		Headers:         headers,
+		ContentType:     delivery.ContentType,
+		ContentEncoding: delivery.ContentEncoding,
+		DeliveryMode:    delivery.DeliveryMode,
+		Priority:        delivery.Priority,
+		CorrelationId:   delivery.CorrelationId,
+		ReplyTo:         delivery.ReplyTo,
+		MessageId:       delivery.MessageId,
+		Timestamp:       delivery.Timestamp,
+		Type:            delivery.Type,
+		UserId:          delivery.UserId,
+		AppId:           delivery.AppId,
+		Body:            delivery.Body,
+	}
+}
+
+// openChannel puts the channel on confirm mode, so the replayed messages are only removed after the broker confirms
+func (r *Replayer) openChannel() (iChannel, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.connection == nil || r.connection.IsClosed() {
+		connection, err := amqp.Dial(r.config.GetConnectionString())
+		if err != nil {
+			return nil, err
+		}
+
+		r.connection = connection
+	}
+
+	channel, err := r.connection.Channel()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := channel.Confirm(false); err != nil {
+		logger.LogError(enums.MessageFailedToCloseChannel, channel.Close())
+
+		return nil, err
+	}
+
+	return &confirmedChannel{Channel: channel,
+		confirmations: channel.NotifyPublish(make(chan amqp.Confirmation, 1))}, nil
+}
@@ -0,0 +1,291 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replay
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/streadway/amqp"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker/config"
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker/replay/enums"
+)
+
+// fakeChannel keeps the unacknowledged messages until it is closed, returning them to the front of the queue like
+// the broker does
+type fakeChannel struct {
+	queue         *[]amqp.Delivery
+	held          map[uint64]amqp.Delivery
+	tag           uint64
+	published     []amqp.Publishing
+	keys          []string
+	confirmations chan amqp.Confirmation
+	publishErr    error
+	reject        bool
+}
+
+func newFakeChannel(messages ...amqp.Delivery) *fakeChannel {
+	return &fakeChannel{queue: &messages, held: map[uint64]amqp.Delivery{},
+		confirmations: make(chan amqp.Confirmation, 1)}
+}
+
+func (f *fakeChannel) Get(_ string, _ bool) (amqp.Delivery, bool, error) {
+	if len(*f.queue) == 0 {
+		return amqp.Delivery{}, false, nil
+	}
+
+	delivery := (*f.queue)[0]
+	*f.queue = (*f.queue)[1:]
+	f.tag++
+	delivery.DeliveryTag, delivery.Acknowledger = f.tag, f
+	f.held[f.tag] = delivery
+
+	return delivery, true, nil
+}
+
+func (f *fakeChannel) Publish(_, key string, _, _ bool, msg amqp.Publishing) error {
+	if f.publishErr != nil {
+		return f.publishErr
+	}
+
+	f.published, f.keys = append(f.published, msg), append(f.keys, key)
+	f.confirmations <- amqp.Confirmation{DeliveryTag: uint64(len(f.published)), Ack: !f.reject}
+
+	return nil
+}
+
+func (f *fakeChannel) Confirmations() <-chan amqp.Confirmation {
+	return f.confirmations
+}
+
+func (f *fakeChannel) Close() error {
+	tags := make([]uint64, 0, len(f.held))
+	for tag := range f.held {
+		tags = append(tags, tag)
+	}
+
+	sort.Slice(tags, func(i, j int) bool { return tags[i] < tags[j] })
+
+	requeued := make([]amqp.Delivery, 0, len(tags)+len(*f.queue))
+	for _, tag := range tags {
+		requeued = append(requeued, f.held[tag])
+	}
+
+	*f.queue, f.held = append(requeued, *f.queue...), map[uint64]amqp.Delivery{}
+
+	return nil
+}
+
+func (f *fakeChannel) Ack(tag uint64, _ bool) error {
+	delete(f.held, tag)
+
+	return nil
+}
+
+func (f *fakeChannel) Nack(_ uint64, _, _ bool) error {
+	return nil
+}
+
+func (f *fakeChannel) Reject(_ uint64, _ bool) error {
+	return nil
+}
+
+func (f *fakeChannel) ids() (ids []string) {
+	for index := range *f.queue {
+		ids = append(ids, getMessageID(&(*f.queue)[index]))
+	}
+
+	return ids
+}
+
+func newDeadLetter(id, body string) amqp.Delivery {
+	return amqp.Delivery{MessageId: id, Body: []byte(body), ContentType: "application/json", Headers: amqp.Table{
+		"x-custom": "value",
+		enums.HeaderDeath: []interface{}{amqp.Table{
+			enums.DeathFieldReason: "rejected", enums.DeathFieldQueue: "horusec-email", enums.DeathFieldCount: int64(2),
+			enums.DeathFieldExchange: "", enums.DeathFieldRoutingKeys: []interface{}{"horusec-email"},
+			enums.DeathFieldTime: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		}},
+	}}
+}
+
+func newTestReplayer(channel *fakeChannel) *Replayer {
+	options := NewOptions()
+	options.Queues, options.PreviewLength = []string{"horusec-email.dlq"}, 5
+
+	replayer := NewReplayer(options, config.NewBrokerConfig()).(*Replayer)
+	replayer.open = func() (iChannel, error) {
+		return channel, nil
+	}
+
+	return replayer
+}
+
+func TestList(t *testing.T) {
+	t.Run("should list messages with preview and keep them on the queue", func(t *testing.T) {
+		channel := newFakeChannel(newDeadLetter("1", "first message"), newDeadLetter("", "second"),
+			newDeadLetter("3", "third"))
+
+		messages, err := newTestReplayer(channel).List(context.Background(), "horusec-email.dlq", 2)
+
+		assert.NoError(t, err)
+		assert.Len(t, messages, 2)
+		assert.Equal(t, "1", messages[0].ID)
+		assert.Equal(t, "firs…", messages[0].Body)
+		assert.True(t, messages[0].Truncated)
+		assert.Equal(t, 13, messages[0].BodySize)
+		assert.Equal(t, map[string]interface{}{"x-custom": "value"}, messages[0].Headers)
+		assert.Equal(t, &Death{Reason: "rejected", Queue: "horusec-email", RoutingKeys: []string{"horusec-email"},
+			Count: 2, Time: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)}, messages[0].Death)
+		assert.Len(t, messages[1].ID, enums.GeneratedMessageIDSize)
+		assert.Equal(t, []string{"1", messages[1].ID, "3"}, channel.ids())
+	})
+
+	t.Run("should return error when queue is not allowed or invalid limit", func(t *testing.T) {
+		replayer := newTestReplayer(newFakeChannel())
+
+		_, err := replayer.List(context.Background(), "horusec-email", 1)
+		assert.ErrorIs(t, err, enums.ErrorQueueNotAllowed)
+
+		_, err = replayer.List(context.Background(), "horusec-email.dlq", 0)
+		assert.ErrorIs(t, err, enums.ErrorInvalidLimit)
+	})
+
+	t.Run("should return error when failed to open channel", func(t *testing.T) {
+		replayer := newTestReplayer(newFakeChannel())
+		replayer.open = func() (iChannel, error) {
+			return nil, errors.New("test")
+		}
+
+		_, err := replayer.List(context.Background(), "horusec-email.dlq", 1)
+		assert.ErrorIs(t, err, enums.ErrorFailedToOpenChannel)
+	})
+}
+
+func TestGet(t *testing.T) {
+	t.Run("should return message with full body", func(t *testing.T) {
+		channel := newFakeChannel(newDeadLetter("1", "first"), newDeadLetter("2", "second message"))
+
+		message, err := newTestReplayer(channel).Get(context.Background(), "horusec-email.dlq", "2")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "second message", message.Body)
+		assert.False(t, message.Truncated)
+		assert.Equal(t, []string{"1", "2"}, channel.ids())
+	})
+
+	t.Run("should return not found", func(t *testing.T) {
+		_, err := newTestReplayer(newFakeChannel()).Get(context.Background(), "horusec-email.dlq", "1")
+
+		assert.ErrorIs(t, err, enums.ErrorMessageNotFound)
+	})
+}
+
+func TestReplay(t *testing.T) {
+	t.Run("should replay selected messages on the dead queue and keep the others", func(t *testing.T) {
+		replayed := newDeadLetter("2", "second")
+		replayed.Headers[enums.HeaderReplayCount] = int64(1)
+		channel := newFakeChannel(newDeadLetter("1", "first"), replayed, newDeadLetter("3", "third"))
+
+		result, err := newTestReplayer(channel).Replay(context.Background(), "horusec-email.dlq",
+			&Selection{IDs: []string{"2"}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, &Result{Processed: 1, Scanned: 3}, result)
+		assert.Equal(t, []string{"1", "3"}, channel.ids())
+		assert.Equal(t, []string{"horusec-email"}, channel.keys)
+		assert.Equal(t, "second", string(channel.published[0].Body))
+		assert.Equal(t, "2", channel.published[0].MessageId)
+		assert.Equal(t, amqp.Table{"x-custom": "value", enums.HeaderReplayCount: int64(2)},
+			channel.published[0].Headers)
+	})
+
+	t.Run("should replay all messages on the target", func(t *testing.T) {
+		channel := newFakeChannel(amqp.Delivery{MessageId: "1"}, newDeadLetter("2", "second"))
+
+		result, err := newTestReplayer(channel).Replay(context.Background(), "horusec-email.dlq",
+			&Selection{All: true, Target: "horusec-email-retry"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result.Processed)
+		assert.Empty(t, channel.ids())
+		assert.Equal(t, []string{"horusec-email-retry", "horusec-email-retry"}, channel.keys)
+	})
+
+	t.Run("should stop and keep messages when target is unknown", func(t *testing.T) {
+		channel := newFakeChannel(newDeadLetter("1", "first"), amqp.Delivery{MessageId: "2"},
+			newDeadLetter("3", "third"))
+
+		result, err := newTestReplayer(channel).Replay(context.Background(), "horusec-email.dlq",
+			&Selection{All: true})
+
+		assert.ErrorIs(t, err, enums.ErrorUnknownTarget)
+		assert.Equal(t, 1, result.Processed)
+		assert.Equal(t, []string{"2", "3"}, channel.ids())
+	})
+
+	t.Run("should keep message when publish fails or is not confirmed", func(t *testing.T) {
+		channel := newFakeChannel(newDeadLetter("1", "first"))
+		channel.reject = true
+
+		_, err := newTestReplayer(channel).Replay(context.Background(), "horusec-email.dlq", &Selection{All: true})
+		assert.ErrorIs(t, err, enums.ErrorPublishNotConfirmed)
+		assert.Equal(t, []string{"1"}, channel.ids())
+
+		channel.publishErr = errors.New("test")
+
+		_, err = newTestReplayer(channel).Replay(context.Background(), "horusec-email.dlq", &Selection{All: true})
+		assert.Equal(t, channel.publishErr, err)
+		assert.Equal(t, []string{"1"}, channel.ids())
+	})
+
+	t.Run("should return error when selection is empty", func(t *testing.T) {
+		_, err := newTestReplayer(newFakeChannel()).Replay(context.Background(), "horusec-email.dlq", &Selection{})
+
+		assert.ErrorIs(t, err, enums.ErrorEmptySelection)
+	})
+}
+
+func TestPurge(t *testing.T) {
+	t.Run("should remove selected messages", func(t *testing.T) {
+		channel := newFakeChannel(newDeadLetter("1", "first"), newDeadLetter("2", "second"),
+			newDeadLetter("3", "third"))
+
+		result, err := newTestReplayer(channel).Purge(context.Background(), "horusec-email.dlq",
+			&Selection{IDs: []string{"1", "3"}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result.Processed)
+		assert.Equal(t, []string{"2"}, channel.ids())
+		assert.Empty(t, channel.published)
+	})
+
+	t.Run("should stop scanning when context is canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		channel := newFakeChannel(newDeadLetter("1", "first"))
+
+		_, err := newTestReplayer(channel).Purge(ctx, "horusec-email.dlq", &Selection{All: true})
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, []string{"1"}, channel.ids())
+	})
+}
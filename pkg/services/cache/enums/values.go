@@ -19,4 +19,12 @@ import "time"
 const (
 	DefaultExpirationTime   = time.Minute * 30
 	DefaultCheckExpiredTime = time.Minute * 10
+
+	DefaultTTL           = time.Minute * 5
+	DefaultMaxEntries    = 10000
+	MetricsLabelCache    = "cache"
+	MetricsLabelResult   = "result"
+	MetricsResultHit     = "hit"
+	MetricsResultMiss    = "miss"
+	MetricsResultEvicted = "evicted"
 )
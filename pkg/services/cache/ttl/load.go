@@ -0,0 +1,83 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ttl
+
+import (
+	"context"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/cache/enums"
+)
+
+// call is a load in progress, the waiters receive the same result when done is closed
+type call[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+// GetOrLoad returns the cached value or loads it, concurrent calls for the same key wait for the first load instead
+// of calling it again. The load context keeps the values of the first caller without its cancellation, so a caller
+// giving up does not fail the others, while each caller stops waiting when its own context is done. The errors are
+// not cached, so the load function must limit its own duration.
+func (c *Cache[K, V]) GetOrLoad(ctx context.Context, key K, load func(ctx context.Context) (V, error)) (V, error) {
+	c.mutex.Lock()
+
+	if value, ok := c.get(key); ok {
+		c.mutex.Unlock()
+
+		return value, nil
+	}
+
+	if current, ok := c.calls[key]; ok {
+		c.mutex.Unlock()
+
+		return c.wait(ctx, current)
+	}
+
+	current := &call[V]{done: make(chan struct{}), err: enums.ErrorLoadPanicked}
+	c.calls[key] = current
+	c.mutex.Unlock()
+
+	go c.load(context.WithoutCancel(ctx), key, current, load)
+
+	return c.wait(ctx, current)
+}
+
+// load releases the waiters even when the load function panics, in that case they receive ErrorLoadPanicked
+func (c *Cache[K, V]) load(ctx context.Context, key K, current *call[V], load func(ctx context.Context) (V, error)) {
+	defer func() {
+		_ = recover()
+
+		c.mutex.Lock()
+		if current.err == nil {
+			c.set(key, current.value, c.options.TTL)
+		}
+
+		delete(c.calls, key)
+		c.mutex.Unlock()
+		close(current.done)
+	}()
+
+	current.value, current.err = load(ctx)
+}
+
+func (c *Cache[K, V]) wait(ctx context.Context, current *call[V]) (value V, err error) {
+	select {
+	case <-ctx.Done():
+		return value, ctx.Err()
+	case <-current.done:
+		return current.value, current.err
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ttl
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/cache/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/metrics"
+)
+
+func newMetrics() *prometheus.CounterVec {
+	return metrics.NewCounterVec("cache_operations_total", "Total of cache lookups by result and of evicted entries.",
+		enums.MetricsLabelCache, enums.MetricsLabelResult)
+}
@@ -0,0 +1,41 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ttl
+
+import (
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/cache/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+)
+
+// Options configures a cache, the name is used as the label of the metrics, so it must be unique by service.
+// A max entries lower or equal than zero disables the lru eviction, and the clock is replaced on tests to expire the
+// entries without waiting their ttl.
+type Options struct {
+	Name       string
+	TTL        time.Duration
+	MaxEntries int
+	Clock      clock.IClock
+}
+
+func NewOptions(name string) *Options {
+	return &Options{
+		Name:       name,
+		TTL:        enums.DefaultTTL,
+		MaxEntries: enums.DefaultMaxEntries,
+		Clock:      clock.NewClock(),
+	}
+}
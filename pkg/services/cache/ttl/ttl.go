@@ -0,0 +1,157 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ttl
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/cache/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+)
+
+type ICache[K comparable, V any] interface {
+	Get(key K) (V, bool)
+	GetOrLoad(ctx context.Context, key K, load func(ctx context.Context) (V, error)) (V, error)
+	Set(key K, value V)
+	SetWithTTL(key K, value V, ttl time.Duration)
+	Delete(key K)
+	Clear()
+	Len() int
+}
+
+type entry[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt time.Time
+}
+
+// Cache keeps the entries in memory until their ttl expires, evicting the least recently used one when the max
+// entries is reached. The expired entries are only removed when they are read or evicted.
+type Cache[K comparable, V any] struct {
+	options *Options
+	clock   clock.IClock
+	mutex   sync.Mutex
+	entries map[K]*list.Element
+	lru     *list.List
+	calls   map[K]*call[V]
+	hits    prometheus.Counter
+	misses  prometheus.Counter
+	evicted prometheus.Counter
+}
+
+func NewCache[K comparable, V any](options *Options) ICache[K, V] {
+	metrics := newMetrics()
+
+	return &Cache[K, V]{
+		options: options,
+		clock:   clock.OrDefault(options.Clock),
+		entries: map[K]*list.Element{},
+		lru:     list.New(),
+		calls:   map[K]*call[V]{},
+		hits:    metrics.WithLabelValues(options.Name, enums.MetricsResultHit),
+		misses:  metrics.WithLabelValues(options.Name, enums.MetricsResultMiss),
+		evicted: metrics.WithLabelValues(options.Name, enums.MetricsResultEvicted),
+	}
+}
+
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.get(key)
+}
+
+func (c *Cache[K, V]) get(key K) (value V, ok bool) {
+	element, ok := c.entries[key]
+	if !ok {
+		c.misses.Inc()
+
+		return value, false
+	}
+
+	current := element.Value.(*entry[K, V])
+	if c.clock.Now().After(current.expiresAt) {
+		c.remove(element)
+		c.misses.Inc()
+
+		return value, false
+	}
+
+	c.lru.MoveToFront(element)
+	c.hits.Inc()
+
+	return current.value, true
+}
+
+func (c *Cache[K, V]) Set(key K, value V) {
+	c.SetWithTTL(key, value, c.options.TTL)
+}
+
+func (c *Cache[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.set(key, value, ttl)
+}
+
+func (c *Cache[K, V]) set(key K, value V, ttl time.Duration) {
+	if element, ok := c.entries[key]; ok {
+		element.Value = &entry[K, V]{key: key, value: value, expiresAt: c.clock.Now().Add(ttl)}
+		c.lru.MoveToFront(element)
+
+		return
+	}
+
+	c.entries[key] = c.lru.PushFront(&entry[K, V]{key: key, value: value, expiresAt: c.clock.Now().Add(ttl)})
+
+	if c.options.MaxEntries > 0 && c.lru.Len() > c.options.MaxEntries {
+		c.remove(c.lru.Back())
+		c.evicted.Inc()
+	}
+}
+
+func (c *Cache[K, V]) Delete(key K) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		c.remove(element)
+	}
+}
+
+func (c *Cache[K, V]) Clear() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.entries = map[K]*list.Element{}
+	c.lru.Init()
+}
+
+func (c *Cache[K, V]) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.lru.Len()
+}
+
+func (c *Cache[K, V]) remove(element *list.Element) {
+	c.lru.Remove(element)
+	delete(c.entries, element.Value.(*entry[K, V]).key)
+}
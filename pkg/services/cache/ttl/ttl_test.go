@@ -0,0 +1,238 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ttl
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/cache/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+)
+
+func TestNewOptions(t *testing.T) {
+	t.Run("should return default options", func(t *testing.T) {
+		options := NewOptions("test")
+
+		assert.Equal(t, "test", options.Name)
+		assert.Equal(t, enums.DefaultTTL, options.TTL)
+		assert.Equal(t, enums.DefaultMaxEntries, options.MaxEntries)
+	})
+}
+
+func TestGetAndSet(t *testing.T) {
+	t.Run("should return value until ttl expires", func(t *testing.T) {
+		cache := NewCache[string, int](NewOptions("test-ttl"))
+
+		cache.Set("test", 1)
+		cache.SetWithTTL("expired", 2, -time.Second)
+
+		value, ok := cache.Get("test")
+		assert.True(t, ok)
+		assert.Equal(t, 1, value)
+
+		_, ok = cache.Get("expired")
+		assert.False(t, ok)
+		assert.Equal(t, 1, cache.Len())
+	})
+
+	t.Run("should expire value when the clock reaches its ttl", func(t *testing.T) {
+		options := NewOptions("test-ttl-clock")
+		fakeClock := clock.NewFakeClock(time.Now())
+		options.Clock = fakeClock
+		cache := NewCache[string, int](options)
+
+		cache.Set("test", 1)
+		fakeClock.Advance(options.TTL)
+
+		_, ok := cache.Get("test")
+		assert.True(t, ok)
+
+		fakeClock.Advance(time.Nanosecond)
+
+		_, ok = cache.Get("test")
+		assert.False(t, ok)
+	})
+
+	t.Run("should evict least recently used entry", func(t *testing.T) {
+		options := NewOptions("test-lru")
+		options.MaxEntries = 2
+		cache := NewCache[int, int](options)
+
+		cache.Set(1, 1)
+		cache.Set(2, 2)
+		cache.Get(1)
+		cache.Set(3, 3)
+
+		_, ok := cache.Get(2)
+		assert.False(t, ok)
+		_, ok = cache.Get(1)
+		assert.True(t, ok)
+		assert.Positive(t, testutil.ToFloat64(newMetrics().WithLabelValues("test-lru",
+			enums.MetricsResultEvicted)))
+	})
+
+	t.Run("should delete and clear entries", func(t *testing.T) {
+		cache := NewCache[string, string](NewOptions("test-delete"))
+
+		cache.Set("a", "a")
+		cache.Set("b", "b")
+		cache.Set("b", "c")
+		cache.Delete("a")
+
+		value, _ := cache.Get("b")
+		assert.Equal(t, "c", value)
+		assert.Equal(t, 1, cache.Len())
+
+		cache.Clear()
+		assert.Equal(t, 0, cache.Len())
+	})
+
+	t.Run("should count hits and misses", func(t *testing.T) {
+		cache := NewCache[string, string](NewOptions("test-metrics"))
+		hits := newMetrics().WithLabelValues("test-metrics", enums.MetricsResultHit)
+		misses := newMetrics().WithLabelValues("test-metrics", enums.MetricsResultMiss)
+		hitsBefore, missesBefore := testutil.ToFloat64(hits), testutil.ToFloat64(misses)
+
+		cache.Set("a", "a")
+		cache.Get("a")
+		cache.Get("b")
+
+		assert.Equal(t, hitsBefore+1, testutil.ToFloat64(hits))
+		assert.Equal(t, missesBefore+1, testutil.ToFloat64(misses))
+	})
+}
+
+func TestGetOrLoad(t *testing.T) {
+	t.Run("should load only once for concurrent calls", func(t *testing.T) {
+		cache := NewCache[string, int](NewOptions("test-load"))
+		calls, release := int32(0), make(chan struct{})
+		group := sync.WaitGroup{}
+
+		for i := 0; i < 10; i++ {
+			group.Add(1)
+
+			go func() {
+				defer group.Done()
+
+				value, err := cache.GetOrLoad(context.Background(), "test", func(context.Context) (int, error) {
+					atomic.AddInt32(&calls, 1)
+					<-release
+
+					return 1, nil
+				})
+
+				assert.NoError(t, err)
+				assert.Equal(t, 1, value)
+			}()
+		}
+
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+		group.Wait()
+
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+		assert.Equal(t, 1, cache.Len())
+	})
+
+	t.Run("should not cache errors", func(t *testing.T) {
+		cache := NewCache[string, int](NewOptions("test-load-error"))
+
+		_, err := cache.GetOrLoad(context.Background(), "test", func(context.Context) (int, error) {
+			return 0, errors.New("test")
+		})
+
+		assert.Error(t, err)
+		assert.Equal(t, 0, cache.Len())
+	})
+
+	t.Run("should release waiters when load panics", func(t *testing.T) {
+		cache := NewCache[string, int](NewOptions("test-load-panic"))
+
+		_, err := cache.GetOrLoad(context.Background(), "test", func(context.Context) (int, error) {
+			panic("test")
+		})
+
+		assert.ErrorIs(t, err, enums.ErrorLoadPanicked)
+		assert.Eventually(t, func() bool {
+			return cache.Len() == 0 && len(cache.(*Cache[string, int]).calls) == 0
+		}, time.Second, time.Millisecond)
+	})
+
+	t.Run("should stop waiting when context is done", func(t *testing.T) {
+		cache := NewCache[string, int](NewOptions("test-load-context"))
+		release := make(chan struct{})
+		defer close(release)
+
+		go func() {
+			_, _ = cache.GetOrLoad(context.Background(), "test", func(context.Context) (int, error) {
+				<-release
+
+				return 1, nil
+			})
+		}()
+
+		time.Sleep(20 * time.Millisecond)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := cache.GetOrLoad(ctx, "test", func(context.Context) (int, error) {
+			return 2, nil
+		})
+
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("should not fail the waiters when the first caller cancels", func(t *testing.T) {
+		cache := NewCache[string, int](NewOptions("test-load-first-cancel"))
+		release, loadErr := make(chan struct{}), make(chan error, 1)
+		ctx, cancel := context.WithCancel(context.Background())
+
+		go func() {
+			_, err := cache.GetOrLoad(ctx, "test", func(ctx context.Context) (int, error) {
+				<-release
+				loadErr <- ctx.Err()
+
+				return 1, nil
+			})
+
+			assert.ErrorIs(t, err, context.Canceled)
+		}()
+
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			close(release)
+		}()
+
+		value, err := cache.GetOrLoad(context.Background(), "test", func(context.Context) (int, error) {
+			return 2, nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, value)
+		assert.NoError(t, <-loadErr)
+	})
+}
@@ -0,0 +1,134 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaos
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/chaos/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/metrics"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+type IInjector interface {
+	IsEnabled(target enums.Target) bool
+	Inject(ctx context.Context, target enums.Target, operation string) error
+}
+
+type Injector struct {
+	options *Options
+	clock   clock.IClock
+	faults  *prometheus.CounterVec
+}
+
+// NewInjector warns when the faults are enabled, since they should never reach a production environment by mistake
+func NewInjector(options *Options) IInjector {
+	if options.Enabled {
+		logger.LogWarn(enums.MessageChaosEnabled, options.Targets)
+	}
+
+	return &Injector{
+		options: options,
+		clock:   clock.OrDefault(options.Clock),
+		faults: metrics.NewCounterVec(enums.MetricsFaultsInjected, "Total of faults injected by the chaos hooks.",
+			enums.MetricsLabelTarget, enums.MetricsLabelFault),
+	}
+}
+
+func (i *Injector) IsEnabled(target enums.Target) bool {
+	if i == nil || !i.options.Enabled {
+		return false
+	}
+
+	for _, enabled := range i.options.Targets {
+		if enabled == target {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Inject waits the latency and returns the ErrorFaultInjected according to the percents of the options. The latency
+// is interrupted when the context is done, returning its error like a slow dependency would.
+func (i *Injector) Inject(ctx context.Context, target enums.Target, operation string) error {
+	if !i.IsEnabled(target) || !i.isSelected(operation) {
+		return nil
+	}
+
+	if isLucky(i.options.LatencyPercent) {
+		i.faults.WithLabelValues(target.ToString(), enums.FaultLatency).Inc()
+
+		if err := i.sleep(ctx); err != nil {
+			return err
+		}
+	}
+
+	if isLucky(i.options.ErrorPercent) {
+		i.faults.WithLabelValues(target.ToString(), enums.FaultError).Inc()
+
+		return enums.ErrorFaultInjected
+	}
+
+	return nil
+}
+
+func (i *Injector) isSelected(operation string) bool {
+	if len(i.options.Operations) == 0 {
+		return true
+	}
+
+	for _, selected := range i.options.Operations {
+		if strings.Contains(operation, selected) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (i *Injector) sleep(ctx context.Context) error {
+	latency := i.options.Latency
+	if i.options.LatencyJitter > 0 {
+		// nolint:gosec // the injected latency does not need a secure random
+		latency += time.Duration(rand.Int63n(int64(i.options.LatencyJitter)))
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-i.clock.After(latency):
+		return nil
+	}
+}
+
+func isLucky(percent int) bool {
+	if percent <= 0 {
+		return false
+	}
+
+	// nolint:gosec // the faults do not need a secure random
+	return percent >= enums.MaxPercent || rand.Intn(enums.MaxPercent) < percent
+}
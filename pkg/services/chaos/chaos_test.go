@@ -0,0 +1,119 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaos
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/chaos/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+)
+
+func TestNewOptions(t *testing.T) {
+	t.Run("should be disabled by default for every target", func(t *testing.T) {
+		options := NewOptions()
+
+		assert.False(t, options.Enabled)
+		assert.Equal(t, enums.Values(), options.Targets)
+		assert.Equal(t, enums.DefaultLatency, options.Latency)
+	})
+
+	t.Run("should read options from env", func(t *testing.T) {
+		t.Setenv(enums.HorusecChaosEnabled, "true")
+		t.Setenv(enums.HorusecChaosTargets, "grpc, broker")
+		t.Setenv(enums.HorusecChaosOperations, "horusec-email")
+		t.Setenv(enums.HorusecChaosLatency, "2s")
+		t.Setenv(enums.HorusecChaosLatencyPercent, "50")
+		t.Setenv(enums.HorusecChaosErrorPercent, "10")
+
+		options := NewOptions()
+
+		assert.True(t, options.Enabled)
+		assert.Equal(t, []enums.Target{enums.TargetGRPC, enums.TargetBroker}, options.Targets)
+		assert.Equal(t, []string{"horusec-email"}, options.Operations)
+		assert.Equal(t, 2*time.Second, options.Latency)
+		assert.Equal(t, 50, options.LatencyPercent)
+		assert.Equal(t, 10, options.ErrorPercent)
+	})
+}
+
+func TestInject(t *testing.T) {
+	t.Run("should not inject when disabled or target not enabled", func(t *testing.T) {
+		options := &Options{Enabled: false, Targets: enums.Values(), ErrorPercent: 100}
+		assert.NoError(t, NewInjector(options).Inject(context.Background(), enums.TargetGRPC, "test"))
+
+		options = &Options{Enabled: true, Targets: []enums.Target{enums.TargetBroker}, ErrorPercent: 100}
+		assert.False(t, NewInjector(options).IsEnabled(enums.TargetGRPC))
+		assert.NoError(t, NewInjector(options).Inject(context.Background(), enums.TargetGRPC, "test"))
+	})
+
+	t.Run("should inject error only on selected operations", func(t *testing.T) {
+		injector := NewInjector(&Options{Enabled: true, Targets: enums.Values(), ErrorPercent: 100,
+			Operations: []string{"horusec-email"}})
+
+		assert.ErrorIs(t, injector.Inject(context.Background(), enums.TargetBroker, "horusec-email"),
+			enums.ErrorFaultInjected)
+		assert.NoError(t, injector.Inject(context.Background(), enums.TargetBroker, "horusec-webhook"))
+	})
+
+	t.Run("should inject error on about the given percent of operations", func(t *testing.T) {
+		injector := NewInjector(&Options{Enabled: true, Targets: enums.Values(), ErrorPercent: 50})
+
+		errors := 0
+		for index := 0; index < 1000; index++ {
+			if injector.Inject(context.Background(), enums.TargetDatabase, "test") != nil {
+				errors++
+			}
+		}
+
+		assert.InDelta(t, 500, errors, 100)
+	})
+
+	t.Run("should wait the latency before returning", func(t *testing.T) {
+		fakeClock := clock.NewFakeClock(time.Now())
+		injector := NewInjector(&Options{Enabled: true, Targets: enums.Values(), LatencyPercent: 100,
+			Latency: time.Second, Clock: fakeClock})
+
+		done := make(chan error)
+		go func() {
+			done <- injector.Inject(context.Background(), enums.TargetGRPC, "test")
+		}()
+
+		fakeClock.BlockUntil(1)
+
+		select {
+		case <-done:
+			assert.Fail(t, "should wait the latency")
+		default:
+		}
+
+		fakeClock.Advance(time.Second)
+		assert.NoError(t, <-done)
+	})
+
+	t.Run("should return context error when canceled during latency", func(t *testing.T) {
+		injector := NewInjector(&Options{Enabled: true, Targets: enums.Values(), LatencyPercent: 100,
+			Latency: time.Hour, LatencyJitter: time.Minute, ErrorPercent: 100})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		assert.ErrorIs(t, injector.Inject(ctx, enums.TargetGRPC, "test"), context.Canceled)
+	})
+}
@@ -0,0 +1,43 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+import "time"
+
+const (
+	HorusecChaosEnabled        = "HORUSEC_CHAOS_ENABLED"
+	HorusecChaosTargets        = "HORUSEC_CHAOS_TARGETS"
+	HorusecChaosOperations     = "HORUSEC_CHAOS_OPERATIONS"
+	HorusecChaosLatency        = "HORUSEC_CHAOS_LATENCY"
+	HorusecChaosLatencyJitter  = "HORUSEC_CHAOS_LATENCY_JITTER"
+	HorusecChaosLatencyPercent = "HORUSEC_CHAOS_LATENCY_PERCENT"
+	HorusecChaosErrorPercent   = "HORUSEC_CHAOS_ERROR_PERCENT"
+
+	DefaultLatency = 500 * time.Millisecond
+	MaxPercent     = 100
+
+	CallbackCreate = "horusec:chaos_create"
+	CallbackQuery  = "horusec:chaos_query"
+	CallbackUpdate = "horusec:chaos_update"
+	CallbackDelete = "horusec:chaos_delete"
+	CallbackRow    = "horusec:chaos_row"
+	CallbackRaw    = "horusec:chaos_raw"
+
+	MetricsFaultsInjected = "chaos_faults_injected_total"
+	MetricsLabelTarget    = "target"
+	MetricsLabelFault     = "fault"
+	FaultLatency          = "latency"
+	FaultError            = "error"
+)
@@ -0,0 +1,60 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaos
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/chaos/enums"
+)
+
+// RegisterCallbacks injects the faults before every gorm operation when the database target is enabled, using the
+// table, or the sql of the raw statements, as the operation. The injected error is added to the statement, so gorm
+// skips the query like it failed.
+func RegisterCallbacks(db *gorm.DB, injector IInjector) error {
+	if !injector.IsEnabled(enums.TargetDatabase) {
+		return nil
+	}
+
+	callbacks, callback := db.Callback(), inject(injector)
+
+	for _, register := range []func() error{
+		func() error { return callbacks.Create().Before("gorm:create").Register(enums.CallbackCreate, callback) },
+		func() error { return callbacks.Query().Before("gorm:query").Register(enums.CallbackQuery, callback) },
+		func() error { return callbacks.Update().Before("gorm:update").Register(enums.CallbackUpdate, callback) },
+		func() error { return callbacks.Delete().Before("gorm:delete").Register(enums.CallbackDelete, callback) },
+		func() error { return callbacks.Row().Before("gorm:row").Register(enums.CallbackRow, callback) },
+		func() error { return callbacks.Raw().Before("gorm:raw").Register(enums.CallbackRaw, callback) },
+	} {
+		if err := register(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func inject(injector IInjector) func(db *gorm.DB) {
+	return func(db *gorm.DB) {
+		operation := db.Statement.Table
+		if operation == "" {
+			operation = db.Statement.SQL.String()
+		}
+
+		if err := injector.Inject(db.Statement.Context, enums.TargetDatabase, operation); err != nil {
+			_ = db.AddError(err)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build cgo
+
+package chaos
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/chaos/enums"
+)
+
+type testEntity struct {
+	ID   int
+	Name string
+}
+
+func newTestDatabase(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open("file:"+uuid.NewString()+"?mode=memory&cache=shared"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&testEntity{}))
+
+	return db
+}
+
+func TestRegisterCallbacks(t *testing.T) {
+	t.Run("should fail the operations on the selected tables", func(t *testing.T) {
+		db := newTestDatabase(t)
+		injector := NewInjector(&Options{Enabled: true, Targets: []enums.Target{enums.TargetDatabase},
+			ErrorPercent: 100, Operations: []string{"test_entities"}})
+
+		assert.NoError(t, RegisterCallbacks(db, injector))
+
+		assert.ErrorIs(t, db.Create(&testEntity{ID: 1}).Error, enums.ErrorFaultInjected)
+		assert.ErrorIs(t, db.Find(&[]testEntity{}).Error, enums.ErrorFaultInjected)
+		assert.ErrorIs(t, db.Exec("DELETE FROM test_entities").Error, enums.ErrorFaultInjected)
+		assert.NoError(t, db.Table("sqlite_master").Find(&[]map[string]interface{}{}).Error)
+	})
+
+	t.Run("should not register callbacks when database target is disabled", func(t *testing.T) {
+		db := newTestDatabase(t)
+		injector := NewInjector(&Options{Enabled: true, Targets: []enums.Target{enums.TargetGRPC}, ErrorPercent: 100})
+
+		assert.NoError(t, RegisterCallbacks(db, injector))
+		assert.NoError(t, db.Create(&testEntity{ID: 1}).Error)
+		assert.Nil(t, db.Callback().Create().Get(enums.CallbackCreate))
+	})
+}
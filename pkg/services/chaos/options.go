@@ -0,0 +1,64 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaos
+
+import (
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/chaos/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// Options configures the faults injected on the targets, which must only be enabled on resilience tests. The latency
+// plus a random jitter is added to the given percent of the operations, and the given percent of the operations
+// fails. When operations are set, only the ones containing any of them are affected, like a grpc method or a table.
+type Options struct {
+	Enabled        bool
+	Targets        []enums.Target
+	Operations     []string
+	Latency        time.Duration
+	LatencyJitter  time.Duration
+	LatencyPercent int
+	ErrorPercent   int
+	Clock          clock.IClock
+}
+
+func NewOptions() *Options {
+	return &Options{
+		Enabled:        env.GetEnvOrDefaultBool(enums.HorusecChaosEnabled, false),
+		Targets:        getTargets(env.GetStringSlice(enums.HorusecChaosTargets, nil)),
+		Operations:     env.GetStringSlice(enums.HorusecChaosOperations, nil),
+		Latency:        env.GetDuration(enums.HorusecChaosLatency, enums.DefaultLatency),
+		LatencyJitter:  env.GetDuration(enums.HorusecChaosLatencyJitter, 0),
+		LatencyPercent: env.GetEnvOrDefaultInt(enums.HorusecChaosLatencyPercent, 0),
+		ErrorPercent:   env.GetEnvOrDefaultInt(enums.HorusecChaosErrorPercent, 0),
+		Clock:          clock.NewClock(),
+	}
+}
+
+// getTargets returns every target when none is set
+func getTargets(values []string) []enums.Target {
+	if len(values) == 0 {
+		return enums.Values()
+	}
+
+	targets := make([]enums.Target, 0, len(values))
+	for _, value := range values {
+		targets = append(targets, enums.Target(value))
+	}
+
+	return targets
+}
@@ -0,0 +1,234 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package circuitbreaker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	circuitBreakerEnums "github.com/ZupIT/horusec-devkit/pkg/enums/circuitbreaker"
+	"github.com/ZupIT/horusec-devkit/pkg/services/circuitbreaker/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+// ICircuitBreaker rejects the requests with ErrorCircuitOpen while the dependency is failing, so the callers fail
+// fast instead of waiting the timeouts. Allow is used when the result is only known after the call, like an http
+// status code, and the returned done function must be called once with the result.
+type ICircuitBreaker interface {
+	Execute(ctx context.Context, fn func(ctx context.Context) error) error
+	Allow() (done func(err error), err error)
+	State() circuitBreakerEnums.State
+	Name() string
+}
+
+type CircuitBreaker struct {
+	name              string
+	options           *Options
+	metrics           *breakerMetrics
+	mutex             sync.Mutex
+	state             circuitBreakerEnums.State
+	generation        uint64
+	openedAt          time.Time
+	window            *window
+	consecutive       int
+	halfOpenRequests  int
+	halfOpenSuccesses int
+	changes           []stateChange
+}
+
+type stateChange struct {
+	from circuitBreakerEnums.State
+	to   circuitBreakerEnums.State
+}
+
+// NewCircuitBreaker creates a closed breaker, where the name identifies the dependency on the metrics and logs
+func NewCircuitBreaker(name string, options *Options) ICircuitBreaker {
+	breaker := &CircuitBreaker{
+		name:    name,
+		options: options,
+		metrics: newBreakerMetrics(name),
+		state:   circuitBreakerEnums.Closed,
+		window:  newWindow(options.Window, options.WindowBuckets),
+	}
+
+	breaker.metrics.state.Set(enums.MetricsStateClosed)
+
+	return breaker
+}
+
+func (c *CircuitBreaker) Name() string {
+	return c.name
+}
+
+func (c *CircuitBreaker) State() circuitBreakerEnums.State {
+	c.mutex.Lock()
+	defer c.unlock()
+
+	c.refreshState(time.Now())
+
+	return c.state
+}
+
+func (c *CircuitBreaker) Execute(ctx context.Context, fn func(ctx context.Context) error) error {
+	done, err := c.Allow()
+	if err != nil {
+		return err
+	}
+
+	err = fn(ctx)
+	done(err)
+
+	return err
+}
+
+func (c *CircuitBreaker) Allow() (func(err error), error) {
+	c.mutex.Lock()
+	defer c.unlock()
+
+	c.refreshState(time.Now())
+
+	switch c.state {
+	case circuitBreakerEnums.Open:
+		c.metrics.request(enums.ResultRejected)
+
+		return nil, enums.ErrorCircuitOpen
+	case circuitBreakerEnums.HalfOpen:
+		if c.halfOpenRequests >= c.options.getHalfOpenRequests() {
+			c.metrics.request(enums.ResultRejected)
+
+			return nil, enums.ErrorTooManyRequests
+		}
+
+		c.halfOpenRequests++
+	}
+
+	generation := c.generation
+
+	var once sync.Once
+
+	return func(err error) {
+		once.Do(func() {
+			c.record(generation, err)
+		})
+	}, nil
+}
+
+// record ignores the results of the requests allowed before the last state change
+func (c *CircuitBreaker) record(generation uint64, err error) {
+	c.mutex.Lock()
+	defer c.unlock()
+
+	failure := c.options.isFailure(err)
+	if failure {
+		c.metrics.request(enums.ResultFailure)
+	} else {
+		c.metrics.request(enums.ResultSuccess)
+	}
+
+	if generation != c.generation {
+		return
+	}
+
+	now := time.Now()
+
+	if c.state == circuitBreakerEnums.HalfOpen {
+		c.recordHalfOpen(now, failure)
+
+		return
+	}
+
+	c.recordClosed(now, failure)
+}
+
+func (c *CircuitBreaker) recordHalfOpen(now time.Time, failure bool) {
+	if failure {
+		c.setState(now, circuitBreakerEnums.Open)
+
+		return
+	}
+
+	if c.halfOpenSuccesses++; c.halfOpenSuccesses >= c.options.getHalfOpenRequests() {
+		c.setState(now, circuitBreakerEnums.Closed)
+	}
+}
+
+func (c *CircuitBreaker) recordClosed(now time.Time, failure bool) {
+	c.window.add(now, failure)
+
+	c.consecutive++
+	if !failure {
+		c.consecutive = 0
+	}
+
+	if c.shouldOpen(now) {
+		c.setState(now, circuitBreakerEnums.Open)
+	}
+}
+
+func (c *CircuitBreaker) shouldOpen(now time.Time) bool {
+	if c.options.ConsecutiveFailures > 0 && c.consecutive >= c.options.ConsecutiveFailures {
+		return true
+	}
+
+	requests, failures := c.window.counts(now)
+	if c.options.FailureRatio <= 0 || requests == 0 || requests < c.options.MinRequests {
+		return false
+	}
+
+	return float64(failures)/float64(requests) >= c.options.FailureRatio
+}
+
+func (c *CircuitBreaker) refreshState(now time.Time) {
+	if c.state == circuitBreakerEnums.Open && now.Sub(c.openedAt) >= c.options.OpenTimeout {
+		c.setState(now, circuitBreakerEnums.HalfOpen)
+	}
+}
+
+func (c *CircuitBreaker) setState(now time.Time, state circuitBreakerEnums.State) {
+	from := c.state
+
+	c.state, c.generation = state, c.generation+1
+	c.consecutive, c.halfOpenRequests, c.halfOpenSuccesses = 0, 0, 0
+	c.window.reset()
+
+	if state == circuitBreakerEnums.Open {
+		c.openedAt = now
+	}
+
+	c.metrics.transition(from, state)
+	logger.LogInfoWithFields(enums.MessageStateChanged, map[string]interface{}{
+		enums.LogFieldCircuitBreaker: c.name, enums.LogFieldFrom: from, enums.LogFieldTo: state,
+	})
+
+	c.changes = append(c.changes, stateChange{from: from, to: state})
+}
+
+// unlock calls OnStateChange with the transitions made while the breaker was locked, after unlocking it, so the
+// callback is able to call the breaker
+func (c *CircuitBreaker) unlock() {
+	changes := c.changes
+	c.changes = nil
+
+	c.mutex.Unlock()
+
+	if c.options.OnStateChange == nil {
+		return
+	}
+
+	for _, change := range changes {
+		c.options.OnStateChange(c.name, change.from, change.to)
+	}
+}
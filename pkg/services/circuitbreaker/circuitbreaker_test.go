@@ -0,0 +1,191 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	circuitBreakerEnums "github.com/ZupIT/horusec-devkit/pkg/enums/circuitbreaker"
+	"github.com/ZupIT/horusec-devkit/pkg/services/circuitbreaker/enums"
+)
+
+func newTestOptions() *Options {
+	return &Options{Window: time.Minute, WindowBuckets: 10, MinRequests: 4, FailureRatio: 0.5,
+		OpenTimeout: 10 * time.Millisecond, HalfOpenRequests: 1}
+}
+
+func execute(breaker ICircuitBreaker, err error) error {
+	return breaker.Execute(context.Background(), func(ctx context.Context) error {
+		return err
+	})
+}
+
+func TestNewOptions(t *testing.T) {
+	t.Run("should read options from env", func(t *testing.T) {
+		t.Setenv(enums.HorusecCircuitBreakerWindowSeconds, "10")
+		t.Setenv(enums.HorusecCircuitBreakerFailurePercent, "25")
+		t.Setenv(enums.HorusecCircuitBreakerConsecutiveFailures, "3")
+
+		options := NewOptions()
+
+		assert.Equal(t, 10*time.Second, options.Window)
+		assert.Equal(t, 0.25, options.FailureRatio)
+		assert.Equal(t, 3, options.ConsecutiveFailures)
+		assert.Equal(t, enums.DefaultMinRequests, options.MinRequests)
+	})
+}
+
+func TestExecute(t *testing.T) {
+	t.Run("should open when failure ratio is reached after min requests", func(t *testing.T) {
+		breaker := NewCircuitBreaker("test", newTestOptions())
+
+		assert.NoError(t, execute(breaker, nil))
+		assert.Error(t, execute(breaker, errors.New("test")))
+		assert.NoError(t, execute(breaker, nil))
+		assert.Equal(t, circuitBreakerEnums.Closed, breaker.State())
+
+		assert.Error(t, execute(breaker, errors.New("test")))
+		assert.Equal(t, circuitBreakerEnums.Open, breaker.State())
+		assert.ErrorIs(t, execute(breaker, nil), enums.ErrorCircuitOpen)
+	})
+
+	t.Run("should open after consecutive failures", func(t *testing.T) {
+		options := newTestOptions()
+		options.FailureRatio, options.ConsecutiveFailures = 0, 2
+		breaker := NewCircuitBreaker("test", options)
+
+		_ = execute(breaker, errors.New("test"))
+		_ = execute(breaker, nil)
+		_ = execute(breaker, errors.New("test"))
+		assert.Equal(t, circuitBreakerEnums.Closed, breaker.State())
+
+		_ = execute(breaker, errors.New("test"))
+		assert.Equal(t, circuitBreakerEnums.Open, breaker.State())
+	})
+
+	t.Run("should not count context cancellation and ignored errors as failures", func(t *testing.T) {
+		options := newTestOptions()
+		options.ConsecutiveFailures = 1
+		ignoredErr := errors.New("test")
+		options.IsFailure = func(err error) bool {
+			return !errors.Is(err, ignoredErr) && !errors.Is(err, context.Canceled)
+		}
+		breaker := NewCircuitBreaker("test", options)
+
+		_ = execute(breaker, context.Canceled)
+		_ = execute(breaker, ignoredErr)
+
+		assert.Equal(t, circuitBreakerEnums.Closed, breaker.State())
+	})
+
+	t.Run("should close after successful trial on half-open", func(t *testing.T) {
+		var changes []circuitBreakerEnums.State
+
+		options := newTestOptions()
+		options.ConsecutiveFailures = 1
+		options.OnStateChange = func(name string, from, to circuitBreakerEnums.State) {
+			assert.Equal(t, "test", name)
+
+			changes = append(changes, to)
+		}
+		breaker := NewCircuitBreaker("test", options)
+
+		_ = execute(breaker, errors.New("test"))
+		time.Sleep(20 * time.Millisecond)
+		assert.Equal(t, circuitBreakerEnums.HalfOpen, breaker.State())
+
+		done, err := breaker.Allow()
+		assert.NoError(t, err)
+
+		_, err = breaker.Allow()
+		assert.ErrorIs(t, err, enums.ErrorTooManyRequests)
+
+		done(nil)
+		assert.Equal(t, circuitBreakerEnums.Closed, breaker.State())
+		assert.Equal(t, []circuitBreakerEnums.State{circuitBreakerEnums.Open, circuitBreakerEnums.HalfOpen,
+			circuitBreakerEnums.Closed}, changes)
+	})
+
+	t.Run("should call the state change callback outside the lock", func(t *testing.T) {
+		var states []circuitBreakerEnums.State
+
+		options := newTestOptions()
+		options.ConsecutiveFailures = 1
+		breaker := NewCircuitBreaker("test", options)
+		options.OnStateChange = func(name string, from, to circuitBreakerEnums.State) {
+			states = append(states, breaker.State())
+		}
+
+		_ = execute(breaker, errors.New("test"))
+
+		assert.Equal(t, []circuitBreakerEnums.State{circuitBreakerEnums.Open}, states)
+	})
+
+	t.Run("should open again after failed trial on half-open", func(t *testing.T) {
+		options := newTestOptions()
+		options.ConsecutiveFailures = 1
+		breaker := NewCircuitBreaker("test", options)
+
+		_ = execute(breaker, errors.New("test"))
+		time.Sleep(20 * time.Millisecond)
+		_ = execute(breaker, errors.New("test"))
+
+		assert.ErrorIs(t, execute(breaker, nil), enums.ErrorCircuitOpen)
+	})
+
+	t.Run("should ignore results of requests allowed before the state change", func(t *testing.T) {
+		options := newTestOptions()
+		options.ConsecutiveFailures = 1
+		breaker := NewCircuitBreaker("test", options)
+
+		done, err := breaker.Allow()
+		assert.NoError(t, err)
+
+		_ = execute(breaker, errors.New("test"))
+		time.Sleep(20 * time.Millisecond)
+		assert.Equal(t, circuitBreakerEnums.HalfOpen, breaker.State())
+
+		done(errors.New("test"))
+		assert.Equal(t, circuitBreakerEnums.HalfOpen, breaker.State())
+	})
+}
+
+func TestWindow(t *testing.T) {
+	t.Run("should drop the results older than the window", func(t *testing.T) {
+		now := time.Now()
+		current := newWindow(10*time.Second, 10)
+
+		current.add(now, true)
+		current.add(now.Add(5*time.Second), false)
+
+		requests, failures := current.counts(now.Add(5 * time.Second))
+		assert.Equal(t, 2, requests)
+		assert.Equal(t, 1, failures)
+
+		requests, failures = current.counts(now.Add(11 * time.Second))
+		assert.Equal(t, 1, requests)
+		assert.Equal(t, 0, failures)
+	})
+
+	t.Run("should use default window when duration is invalid", func(t *testing.T) {
+		assert.Equal(t, 6*time.Second, newWindow(0, 10).bucketDuration)
+		assert.Len(t, newWindow(time.Second, 0).buckets, 1)
+	})
+}
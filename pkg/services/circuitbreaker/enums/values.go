@@ -0,0 +1,51 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+const (
+	HorusecCircuitBreakerWindowSeconds       = "HORUSEC_CIRCUIT_BREAKER_WINDOW_SECONDS"
+	HorusecCircuitBreakerWindowBuckets       = "HORUSEC_CIRCUIT_BREAKER_WINDOW_BUCKETS"
+	HorusecCircuitBreakerMinRequests         = "HORUSEC_CIRCUIT_BREAKER_MIN_REQUESTS"
+	HorusecCircuitBreakerFailurePercent      = "HORUSEC_CIRCUIT_BREAKER_FAILURE_PERCENT"
+	HorusecCircuitBreakerConsecutiveFailures = "HORUSEC_CIRCUIT_BREAKER_CONSECUTIVE_FAILURES"
+	HorusecCircuitBreakerOpenTimeoutSeconds  = "HORUSEC_CIRCUIT_BREAKER_OPEN_TIMEOUT_SECONDS"
+	HorusecCircuitBreakerHalfOpenRequests    = "HORUSEC_CIRCUIT_BREAKER_HALF_OPEN_REQUESTS"
+
+	DefaultWindowSeconds       = 60
+	DefaultWindowBuckets       = 10
+	DefaultMinRequests         = 20
+	DefaultFailurePercent      = 50
+	PercentBase                = 100
+	DefaultConsecutiveFailures = 0
+	DefaultOpenTimeoutSeconds  = 30
+	DefaultHalfOpenRequests    = 1
+
+	MetricsState           = "circuit_breaker_state"
+	MetricsRequests        = "circuit_breaker_requests_total"
+	MetricsTransitions     = "circuit_breaker_transitions_total"
+	MetricsLabelName       = "name"
+	MetricsLabelResult     = "result"
+	MetricsLabelFrom       = "from"
+	MetricsLabelTo         = "to"
+	ResultSuccess          = "success"
+	ResultFailure          = "failure"
+	ResultRejected         = "rejected"
+	MetricsStateClosed     = 0
+	MetricsStateHalfOpen   = 1
+	MetricsStateOpen       = 2
+	LogFieldCircuitBreaker = "circuitBreaker"
+	LogFieldFrom           = "from"
+	LogFieldTo             = "to"
+)
@@ -0,0 +1,59 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package circuitbreaker
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	circuitBreakerEnums "github.com/ZupIT/horusec-devkit/pkg/enums/circuitbreaker"
+	"github.com/ZupIT/horusec-devkit/pkg/services/circuitbreaker/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/metrics"
+)
+
+type breakerMetrics struct {
+	name        string
+	state       prometheus.Gauge
+	requests    *prometheus.CounterVec
+	transitions *prometheus.CounterVec
+}
+
+func newBreakerMetrics(name string) *breakerMetrics {
+	return &breakerMetrics{
+		name: name,
+		state: metrics.NewGaugeVec(enums.MetricsState, "State of the circuit breaker, 0 closed, 1 half-open, 2 open.",
+			enums.MetricsLabelName).WithLabelValues(name),
+		requests: metrics.NewCounterVec(enums.MetricsRequests, "Total of circuit breaker requests by result.",
+			enums.MetricsLabelName, enums.MetricsLabelResult),
+		transitions: metrics.NewCounterVec(enums.MetricsTransitions, "Total of circuit breaker state changes.",
+			enums.MetricsLabelName, enums.MetricsLabelFrom, enums.MetricsLabelTo),
+	}
+}
+
+func (m *breakerMetrics) request(result string) {
+	m.requests.WithLabelValues(m.name, result).Inc()
+}
+
+func (m *breakerMetrics) transition(from, to circuitBreakerEnums.State) {
+	m.transitions.WithLabelValues(m.name, from.ToString(), to.ToString()).Inc()
+
+	switch to {
+	case circuitBreakerEnums.Open:
+		m.state.Set(enums.MetricsStateOpen)
+	case circuitBreakerEnums.HalfOpen:
+		m.state.Set(enums.MetricsStateHalfOpen)
+	default:
+		m.state.Set(enums.MetricsStateClosed)
+	}
+}
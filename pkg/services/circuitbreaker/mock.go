@@ -0,0 +1,56 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package circuitbreaker
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	circuitBreakerEnums "github.com/ZupIT/horusec-devkit/pkg/enums/circuitbreaker"
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+)
+
+// Mock runs the functions given to Execute when it returns no error
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) Execute(ctx context.Context, fn func(ctx context.Context) error) error {
+	args := m.MethodCalled("Execute")
+	if err := mockUtils.ReturnNilOrError(args, 0); err != nil {
+		return err
+	}
+
+	return fn(ctx)
+}
+
+func (m *Mock) Allow() (func(err error), error) {
+	args := m.MethodCalled("Allow")
+
+	return func(error) {}, mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) State() circuitBreakerEnums.State {
+	args := m.MethodCalled("State")
+
+	return args.Get(0).(circuitBreakerEnums.State)
+}
+
+func (m *Mock) Name() string {
+	args := m.MethodCalled("Name")
+
+	return args.Get(0).(string)
+}
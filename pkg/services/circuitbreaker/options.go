@@ -0,0 +1,79 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	circuitBreakerEnums "github.com/ZupIT/horusec-devkit/pkg/enums/circuitbreaker"
+	"github.com/ZupIT/horusec-devkit/pkg/services/circuitbreaker/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// Options configures when the breaker opens, which is when the failure ratio of the window reaches FailureRatio
+// after MinRequests or when ConsecutiveFailures happen in a row, each condition being disabled when zero. After the
+// OpenTimeout, HalfOpenRequests trial requests are allowed and the breaker closes when all of them succeed.
+// IsFailure counts every error except the context cancellation when nil, and OnStateChange is called after the
+// breaker is unlocked, so it may call the breaker, but the calls of concurrent transitions are not ordered.
+type Options struct {
+	Window              time.Duration
+	WindowBuckets       int
+	MinRequests         int
+	FailureRatio        float64
+	ConsecutiveFailures int
+	OpenTimeout         time.Duration
+	HalfOpenRequests    int
+	IsFailure           func(err error) bool
+	OnStateChange       func(name string, from, to circuitBreakerEnums.State)
+}
+
+func NewOptions() *Options {
+	return &Options{
+		Window: time.Duration(env.GetEnvOrDefaultInt(enums.HorusecCircuitBreakerWindowSeconds,
+			enums.DefaultWindowSeconds)) * time.Second,
+		WindowBuckets: env.GetEnvOrDefaultInt(enums.HorusecCircuitBreakerWindowBuckets, enums.DefaultWindowBuckets),
+		MinRequests:   env.GetEnvOrDefaultInt(enums.HorusecCircuitBreakerMinRequests, enums.DefaultMinRequests),
+		FailureRatio: float64(env.GetEnvOrDefaultInt(enums.HorusecCircuitBreakerFailurePercent,
+			enums.DefaultFailurePercent)) / enums.PercentBase,
+		ConsecutiveFailures: env.GetEnvOrDefaultInt(enums.HorusecCircuitBreakerConsecutiveFailures,
+			enums.DefaultConsecutiveFailures),
+		OpenTimeout: time.Duration(env.GetEnvOrDefaultInt(enums.HorusecCircuitBreakerOpenTimeoutSeconds,
+			enums.DefaultOpenTimeoutSeconds)) * time.Second,
+		HalfOpenRequests: env.GetEnvOrDefaultInt(enums.HorusecCircuitBreakerHalfOpenRequests,
+			enums.DefaultHalfOpenRequests),
+	}
+}
+
+func (o *Options) isFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if o.IsFailure != nil {
+		return o.IsFailure(err)
+	}
+
+	return !errors.Is(err, context.Canceled)
+}
+
+func (o *Options) getHalfOpenRequests() int {
+	if o.HalfOpenRequests <= 0 {
+		return 1
+	}
+
+	return o.HalfOpenRequests
+}
@@ -0,0 +1,85 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package circuitbreaker
+
+import (
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/circuitbreaker/enums"
+)
+
+type bucket struct {
+	start     time.Time
+	successes int
+	failures  int
+}
+
+// window counts the results of the last window duration in buckets, dropping the expired buckets as the time passes
+// instead of keeping each result
+type window struct {
+	buckets        []bucket
+	bucketDuration time.Duration
+}
+
+func newWindow(duration time.Duration, size int) *window {
+	if size <= 0 {
+		size = 1
+	}
+
+	if duration < time.Duration(size) {
+		duration = enums.DefaultWindowSeconds * time.Second
+	}
+
+	return &window{buckets: make([]bucket, size), bucketDuration: duration / time.Duration(size)}
+}
+
+func (w *window) add(now time.Time, failure bool) {
+	current := w.current(now)
+	if failure {
+		current.failures++
+
+		return
+	}
+
+	current.successes++
+}
+
+func (w *window) counts(now time.Time) (requests, failures int) {
+	for i := range w.buckets {
+		if now.Sub(w.buckets[i].start) < w.bucketDuration*time.Duration(len(w.buckets)) {
+			requests += w.buckets[i].successes + w.buckets[i].failures
+			failures += w.buckets[i].failures
+		}
+	}
+
+	return requests, failures
+}
+
+func (w *window) reset() {
+	for i := range w.buckets {
+		w.buckets[i] = bucket{}
+	}
+}
+
+func (w *window) current(now time.Time) *bucket {
+	start := now.Truncate(w.bucketDuration)
+
+	current := &w.buckets[int(start.UnixNano()/int64(w.bucketDuration))%len(w.buckets)]
+	if !current.start.Equal(start) {
+		*current = bucket{start: start}
+	}
+
+	return current
+}
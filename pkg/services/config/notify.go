@@ -0,0 +1,91 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/config/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+// Reload loads the configuration again and notifies the subscribers of the changed keys, when the new configuration
+// is invalid the previous one is kept and the error is returned
+func (r *Reloader) Reload() error {
+	modTime := r.getModTime()
+
+	loaded, values, err := r.load()
+	if err != nil {
+		return err
+	}
+
+	r.mutex.Lock()
+	changed := r.getChangedKeys(values)
+	r.config, r.values, r.modTime = loaded, values, modTime
+	subscribers := r.subscribers
+	r.mutex.Unlock()
+
+	if len(changed) > 0 {
+		logger.LogInfo(enums.MessageConfigReloaded + strings.Join(changed, ", "))
+		notify(subscribers, changed, loaded)
+	}
+
+	return nil
+}
+
+func (r *Reloader) getChangedKeys(values map[string]string) (changed []string) {
+	for key, value := range values {
+		if r.values[key] != value {
+			changed = append(changed, key)
+		}
+	}
+
+	sort.Strings(changed)
+
+	return changed
+}
+
+func notify(subscribers []*subscriber, changed []string, loaded interface{}) {
+	reloadable := map[string]bool{}
+
+	for _, current := range subscribers {
+		if containsAny(current.keys, changed) {
+			current.handler(loaded)
+		}
+
+		for _, key := range current.keys {
+			reloadable[key] = true
+		}
+	}
+
+	for _, key := range changed {
+		if !reloadable[key] {
+			logger.LogWarn(enums.MessageKeyRequiresRestart + key)
+		}
+	}
+}
+
+func containsAny(keys, changed []string) bool {
+	for _, key := range keys {
+		for _, changedKey := range changed {
+			if key == changedKey {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,42 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/config/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/config"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// Options configures the reload triggers. The file is checked by its modification time at each interval, which is
+// disabled when it is zero or there is no file, and the reload can also be requested by sending a SIGHUP. The secrets
+// provider is optional and resolves the secret references again on each reload
+type Options struct {
+	Path            string
+	Interval        time.Duration
+	ReloadOnSighup  bool
+	SecretsProvider config.ISecretsProvider
+}
+
+func NewOptions() *Options {
+	return &Options{
+		Path: env.GetEnvOrDefault(enums.HorusecConfigFile, ""),
+		Interval: time.Duration(env.GetEnvOrDefaultInt(enums.HorusecConfigReloadIntervalSeconds,
+			enums.DefaultConfigReloadIntervalSeconds)) * time.Second,
+		ReloadOnSighup: env.GetEnvOrDefaultBool(enums.HorusecConfigReloadOnSighup, true),
+	}
+}
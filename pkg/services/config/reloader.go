@@ -0,0 +1,154 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/config/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/config"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+type IReloader interface {
+	GetConfig() interface{}
+	Subscribe(keys []string, handler func(config interface{}))
+	Reload() error
+	Start(ctx context.Context)
+}
+
+type subscriber struct {
+	keys    []string
+	handler func(config interface{})
+}
+
+// Reloader keeps the last valid configuration loaded with the env tags of the struct returned by newConfig. Only the
+// keys declared by the subscribers are reloadable, changes in any other key are logged as requiring a restart.
+type Reloader struct {
+	options     *Options
+	newConfig   func() interface{}
+	mutex       sync.RWMutex
+	config      interface{}
+	values      map[string]string
+	modTime     time.Time
+	subscribers []*subscriber
+}
+
+func NewReloader(newConfig func() interface{}, options *Options) (IReloader, error) {
+	reloader := &Reloader{options: options, newConfig: newConfig}
+
+	loaded, values, err := reloader.load()
+	if err != nil {
+		return nil, err
+	}
+
+	reloader.config, reloader.values, reloader.modTime = loaded, values, reloader.getModTime()
+
+	return reloader, nil
+}
+
+func (r *Reloader) GetConfig() interface{} {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return r.config
+}
+
+// Subscribe calls the handler with the new configuration when any of the keys, which are the env variable names of
+// the config struct, changes after a reload
+func (r *Reloader) Subscribe(keys []string, handler func(config interface{})) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.subscribers = append(r.subscribers, &subscriber{keys: keys, handler: handler})
+}
+
+// Start watches the reload triggers until the context is done
+func (r *Reloader) Start(ctx context.Context) {
+	signals := make(chan os.Signal, 1)
+	if r.options.ReloadOnSighup {
+		signal.Notify(signals, syscall.SIGHUP)
+	}
+
+	go r.watch(ctx, signals)
+}
+
+func (r *Reloader) watch(ctx context.Context, signals chan os.Signal) {
+	defer signal.Stop(signals)
+
+	ticker := r.newTicker()
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-signals:
+			logger.LogError(enums.MessageFailedToReloadConfig, r.Reload())
+		case <-ticker.C:
+			if r.hasChanged() {
+				logger.LogError(enums.MessageFailedToReloadConfig, r.Reload())
+			}
+		}
+	}
+}
+
+// newTicker returns a stopped ticker when the file polling is disabled, so the watch loop only waits for signals
+func (r *Reloader) newTicker() *time.Ticker {
+	if r.options.Path == "" || r.options.Interval <= 0 {
+		ticker := time.NewTicker(time.Hour)
+		ticker.Stop()
+
+		return ticker
+	}
+
+	return time.NewTicker(r.options.Interval)
+}
+
+func (r *Reloader) hasChanged() bool {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return r.getModTime().After(r.modTime)
+}
+
+func (r *Reloader) getModTime() time.Time {
+	if r.options.Path == "" {
+		return time.Time{}
+	}
+
+	info, err := os.Stat(r.options.Path)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return info.ModTime()
+}
+
+func (r *Reloader) load() (interface{}, map[string]string, error) {
+	loaded := r.newConfig()
+	if err := config.LoadWithSecrets(r.options.Path, loaded, r.options.SecretsProvider); err != nil {
+		return nil, nil, err
+	}
+
+	values, err := config.GetValues(loaded)
+
+	return loaded, values, err
+}
@@ -0,0 +1,149 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/config/enums"
+)
+
+type testConfig struct {
+	LogLevel string `env:"TEST_RELOAD_LOG_LEVEL,default=info" yaml:"logLevel"`
+	Port     int    `env:"TEST_RELOAD_PORT,default=8000" yaml:"port"`
+}
+
+func newTestConfig() interface{} {
+	return &testConfig{}
+}
+
+func writeTestConfig(t *testing.T, path, content string, modTime time.Time) {
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	assert.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func newTestReloader(t *testing.T, interval time.Duration) (IReloader, string) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	writeTestConfig(t, path, "logLevel: info\n", time.Now().Add(-time.Hour))
+
+	reloader, err := NewReloader(newTestConfig, &Options{Path: path, Interval: interval, ReloadOnSighup: true})
+	assert.NoError(t, err)
+
+	return reloader, path
+}
+
+func TestNewOptions(t *testing.T) {
+	t.Run("should read options from env", func(t *testing.T) {
+		t.Setenv(enums.HorusecConfigFile, "config.yaml")
+		t.Setenv(enums.HorusecConfigReloadIntervalSeconds, "5")
+		t.Setenv(enums.HorusecConfigReloadOnSighup, "false")
+
+		options := NewOptions()
+
+		assert.Equal(t, "config.yaml", options.Path)
+		assert.Equal(t, 5*time.Second, options.Interval)
+		assert.False(t, options.ReloadOnSighup)
+	})
+}
+
+func TestReload(t *testing.T) {
+	t.Run("should notify only subscribers of the changed keys", func(t *testing.T) {
+		reloader, path := newTestReloader(t, 0)
+		logLevel, portCalls := "", 0
+
+		reloader.Subscribe([]string{"TEST_RELOAD_LOG_LEVEL"}, func(config interface{}) {
+			logLevel = config.(*testConfig).LogLevel
+		})
+		reloader.Subscribe([]string{"TEST_RELOAD_PORT"}, func(config interface{}) {
+			portCalls++
+		})
+
+		writeTestConfig(t, path, "logLevel: debug\n", time.Now())
+
+		assert.NoError(t, reloader.Reload())
+		assert.Equal(t, "debug", logLevel)
+		assert.Equal(t, 0, portCalls)
+		assert.Equal(t, "debug", reloader.GetConfig().(*testConfig).LogLevel)
+	})
+
+	t.Run("should keep previous config when the new one is invalid", func(t *testing.T) {
+		reloader, path := newTestReloader(t, 0)
+
+		writeTestConfig(t, path, "port: test\n", time.Now())
+
+		assert.Error(t, reloader.Reload())
+		assert.Equal(t, 8000, reloader.GetConfig().(*testConfig).Port)
+	})
+
+	t.Run("should return error when initial config is invalid", func(t *testing.T) {
+		_, err := NewReloader(newTestConfig, &Options{Path: filepath.Join(t.TempDir(), "config.toml")})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestStart(t *testing.T) {
+	t.Run("should reload when file changes", func(t *testing.T) {
+		reloader, path := newTestReloader(t, 10*time.Millisecond)
+		reloaded := make(chan string, 1)
+
+		reloader.Subscribe([]string{"TEST_RELOAD_LOG_LEVEL"}, func(config interface{}) {
+			reloaded <- config.(*testConfig).LogLevel
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		reloader.Start(ctx)
+		writeTestConfig(t, path, "logLevel: warn\n", time.Now())
+
+		select {
+		case logLevel := <-reloaded:
+			assert.Equal(t, "warn", logLevel)
+		case <-time.After(5 * time.Second):
+			assert.Fail(t, "config was not reloaded")
+		}
+	})
+
+	t.Run("should reload on sighup", func(t *testing.T) {
+		reloader, _ := newTestReloader(t, 0)
+		reloaded := make(chan int, 1)
+
+		reloader.Subscribe([]string{"TEST_RELOAD_PORT"}, func(config interface{}) {
+			reloaded <- config.(*testConfig).Port
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		reloader.Start(ctx)
+		t.Setenv("TEST_RELOAD_PORT", "9000")
+		assert.NoError(t, syscall.Kill(os.Getpid(), syscall.SIGHUP))
+
+		select {
+		case port := <-reloaded:
+			assert.Equal(t, 9000, port)
+		case <-time.After(5 * time.Second):
+			assert.Fail(t, "config was not reloaded")
+		}
+	})
+}
@@ -0,0 +1,106 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/audit/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
+)
+
+// RegisterCallbacks fills the audit columns of entities with a parsed schema before creating or updating them. The
+// account id is read from the statement context, so it's only filled when the connection was used with a context
+// that went through the authorization middleware. Updates made using maps are not changed, since there is no way
+// to know if the table contains the audit columns.
+func RegisterCallbacks(db *gorm.DB) error {
+	if err := db.Callback().Create().Before("gorm:create").Register(enums.CallbackCreate, beforeCreate); err != nil {
+		return err
+	}
+
+	return db.Callback().Update().Before("gorm:update").Register(enums.CallbackUpdate, beforeUpdate)
+}
+
+func beforeCreate(db *gorm.DB) {
+	if db.Statement.Schema == nil {
+		return
+	}
+
+	now := time.Now()
+	setField(db, enums.CreatedAt, now, true)
+	setField(db, enums.UpdatedAt, now, true)
+
+	if accountID, ok := jwt.GetAccountIDFromContext(db.Statement.Context); ok {
+		setField(db, enums.CreatedBy, accountID, true)
+		setField(db, enums.UpdatedBy, accountID, true)
+	}
+}
+
+func beforeUpdate(db *gorm.DB) {
+	if db.Statement.Schema == nil {
+		return
+	}
+
+	setField(db, enums.UpdatedAt, time.Now(), false)
+
+	if accountID, ok := jwt.GetAccountIDFromContext(db.Statement.Context); ok {
+		setField(db, enums.UpdatedBy, accountID, false)
+	}
+}
+
+func setField(db *gorm.DB, column string, value interface{}, onlyWhenZero bool) {
+	field := db.Statement.Schema.LookUpField(column)
+	if field == nil {
+		return
+	}
+
+	switch reflectValue := db.Statement.ReflectValue; reflectValue.Kind() {
+	case reflect.Slice, reflect.Array:
+		for index := 0; index < reflectValue.Len(); index++ {
+			setValue(db, field, reflect.Indirect(reflectValue.Index(index)), value, onlyWhenZero)
+		}
+	case reflect.Struct:
+		setValue(db, field, reflectValue, value, onlyWhenZero)
+	}
+}
+
+func setValue(db *gorm.DB, field *schema.Field, reflectValue reflect.Value, value interface{}, onlyWhenZero bool) {
+	if _, isZero := field.ValueOf(reflectValue); onlyWhenZero && !isZero {
+		return
+	}
+
+	_ = db.AddError(field.Set(reflectValue, convertValue(field, value)))
+}
+
+func convertValue(field *schema.Field, value interface{}) interface{} {
+	accountID, ok := value.(uuid.UUID)
+	if !ok {
+		return value
+	}
+
+	switch field.FieldType.Kind() {
+	case reflect.String:
+		return accountID.String()
+	case reflect.Ptr:
+		return &accountID
+	default:
+		return accountID
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build cgo
+
+package audit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
+)
+
+type testEntity struct {
+	ID        int        `gorm:"Column:id;primaryKey"`
+	Text      string     `gorm:"Column:text"`
+	CreatedBy uuid.UUID  `gorm:"Column:created_by;type:text"`
+	UpdatedBy *uuid.UUID `gorm:"Column:updated_by;type:text"`
+	CreatedAt time.Time  `gorm:"Column:created_at"`
+	UpdatedAt time.Time  `gorm:"Column:updated_at"`
+}
+
+type testStringEntity struct {
+	ID        int    `gorm:"Column:id;primaryKey"`
+	CreatedBy string `gorm:"Column:created_by"`
+}
+
+func newTestConnection(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open("file:"+uuid.NewString()+"?mode=memory&cache=shared"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, RegisterCallbacks(db))
+	require.NoError(t, db.Table("test").AutoMigrate(&testEntity{}))
+	require.NoError(t, db.Table("test_string").AutoMigrate(&testStringEntity{}))
+
+	return db
+}
+
+func TestRegisterCallbacks(t *testing.T) {
+	t.Run("should fill audit columns with account id from context on create", func(t *testing.T) {
+		accountID := uuid.New()
+		db := newTestConnection(t).WithContext(jwt.ContextWithAccountID(context.Background(), accountID))
+
+		entity := &testEntity{ID: 1}
+		assert.NoError(t, db.Table("test").Create(entity).Error)
+
+		assert.Equal(t, accountID, entity.CreatedBy)
+		assert.Equal(t, accountID, *entity.UpdatedBy)
+		assert.False(t, entity.CreatedAt.IsZero())
+		assert.False(t, entity.UpdatedAt.IsZero())
+	})
+
+	t.Run("should fill audit columns of every entity on batch create", func(t *testing.T) {
+		accountID := uuid.New()
+		db := newTestConnection(t).WithContext(jwt.ContextWithAccountID(context.Background(), accountID))
+
+		entities := []*testEntity{{ID: 1}, {ID: 2}}
+		assert.NoError(t, db.Table("test").Create(&entities).Error)
+
+		assert.Equal(t, accountID, entities[0].CreatedBy)
+		assert.Equal(t, accountID, entities[1].CreatedBy)
+	})
+
+	t.Run("should keep values already set on create", func(t *testing.T) {
+		accountID := uuid.New()
+		createdAt := time.Now().Add(-time.Hour)
+		db := newTestConnection(t).WithContext(jwt.ContextWithAccountID(context.Background(), uuid.New()))
+
+		entity := &testEntity{ID: 1, CreatedBy: accountID, CreatedAt: createdAt}
+		assert.NoError(t, db.Table("test").Create(entity).Error)
+
+		assert.Equal(t, accountID, entity.CreatedBy)
+		assert.Equal(t, createdAt, entity.CreatedAt)
+	})
+
+	t.Run("should only fill dates when context does not contain account id", func(t *testing.T) {
+		db := newTestConnection(t)
+
+		entity := &testEntity{ID: 1}
+		assert.NoError(t, db.Table("test").Create(entity).Error)
+
+		assert.Equal(t, uuid.Nil, entity.CreatedBy)
+		assert.Nil(t, entity.UpdatedBy)
+		assert.False(t, entity.CreatedAt.IsZero())
+	})
+
+	t.Run("should fill updated columns on update", func(t *testing.T) {
+		creator, updater := uuid.New(), uuid.New()
+		db := newTestConnection(t)
+
+		entity := &testEntity{ID: 1}
+		assert.NoError(t, db.WithContext(jwt.ContextWithAccountID(context.Background(), creator)).
+			Table("test").Create(entity).Error)
+
+		update := &testEntity{Text: "test"}
+		assert.NoError(t, db.WithContext(jwt.ContextWithAccountID(context.Background(), updater)).
+			Table("test").Where(map[string]interface{}{"id": 1}).Updates(update).Error)
+
+		result := &testEntity{}
+		assert.NoError(t, db.Table("test").First(result, 1).Error)
+		assert.Equal(t, creator, result.CreatedBy)
+		assert.Equal(t, updater, *result.UpdatedBy)
+		assert.Equal(t, "test", result.Text)
+	})
+
+	t.Run("should convert account id to string columns", func(t *testing.T) {
+		accountID := uuid.New()
+		db := newTestConnection(t).WithContext(jwt.ContextWithAccountID(context.Background(), accountID))
+
+		entity := &testStringEntity{ID: 1}
+		assert.NoError(t, db.Table("test_string").Create(entity).Error)
+
+		assert.Equal(t, accountID.String(), entity.CreatedBy)
+	})
+
+	t.Run("should ignore updates using maps", func(t *testing.T) {
+		db := newTestConnection(t).WithContext(jwt.ContextWithAccountID(context.Background(), uuid.New()))
+
+		assert.NoError(t, db.Table("test").Create(&testEntity{ID: 1}).Error)
+		assert.NoError(t, db.Table("test").Where("id = ?", 1).Updates(map[string]interface{}{"text": "test"}).Error)
+	})
+
+	t.Run("should not fail when callbacks are registered twice", func(t *testing.T) {
+		db := newTestConnection(t)
+
+		assert.NoError(t, RegisterCallbacks(db))
+	})
+}
@@ -15,13 +15,16 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 	"strings"
 
-	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	gormLogger "gorm.io/gorm/logger"
 
+	"github.com/ZupIT/horusec-devkit/pkg/services/chaos"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/audit"
 	databaseConfig "github.com/ZupIT/horusec-devkit/pkg/services/database/config"
 	"github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
 	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
@@ -33,6 +36,8 @@ type (
 		connectionWrite *gorm.DB
 		connectionRead  *gorm.DB
 		config          databaseConfig.IConfig
+		lastErrorWrite  *connectionError
+		lastErrorRead   *connectionError
 	}
 
 	Connection struct {
@@ -46,13 +51,39 @@ func NewDatabaseReadAndWrite(config databaseConfig.IConfig) (*Connection, error)
 		return nil, err
 	}
 
-	database := &database{config: config}
+	database := newDatabase(config)
 	database.makeConnection()
 	database.setLogMode()
 
 	return database.setConnections(), nil
 }
 
+// NewDatabaseWithDialector opens a single connection pool used for both reads and writes, since it is intended for
+// embedded databases like sqlite, which can not keep consistency between two pools of the same in-memory database.
+func NewDatabaseWithDialector(config databaseConfig.IConfig,
+	dialector func(dsn string) gorm.Dialector) (*Connection, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	database := newDatabase(config)
+	if err := database.makeSharedConnection(dialector); err != nil {
+		return nil, err
+	}
+
+	database.setLogMode()
+
+	return database.setConnections(), nil
+}
+
+func newDatabase(config databaseConfig.IConfig) *database {
+	return &database{
+		config:         config,
+		lastErrorWrite: newConnectionError(),
+		lastErrorRead:  newConnectionError(),
+	}
+}
+
 func (d *database) setConnections() *Connection {
 	return &Connection{
 		Read:  d,
@@ -66,23 +97,53 @@ func (d *database) makeConnection() {
 }
 
 func (d *database) makeConnectionWrite() {
-	connectionWrite, err := gorm.Open(postgres.Open(d.config.GetURI()), &gorm.Config{})
+	connectionWrite, err := d.openConnectionWithRetry()
 	if err != nil {
 		logger.LogPanic(enums.MessageFailedToConnectToDatabase, enums.ErrorConnectingToDB)
 	}
 
+	if err = audit.RegisterCallbacks(connectionWrite); err != nil {
+		logger.LogPanic(enums.MessageFailedToRegisterAuditCallbacks, err)
+	}
+
+	if err = chaos.RegisterCallbacks(connectionWrite, chaos.NewInjector(chaos.NewOptions())); err != nil {
+		logger.LogPanic(enums.MessageFailedToRegisterChaosCallbacks, err)
+	}
+
 	d.connectionWrite = connectionWrite
 }
 
 func (d *database) makeConnectionRead() {
-	connectionRead, err := gorm.Open(postgres.Open(d.config.GetURI()), &gorm.Config{})
+	connectionRead, err := d.openConnectionWithRetry()
 	if err != nil {
 		logger.LogPanic(enums.MessageFailedToConnectToDatabase, enums.ErrorConnectingToDB)
 	}
 
+	if err = chaos.RegisterCallbacks(connectionRead, chaos.NewInjector(chaos.NewOptions())); err != nil {
+		logger.LogPanic(enums.MessageFailedToRegisterChaosCallbacks, err)
+	}
+
 	d.connectionRead = connectionRead
 }
 
+func (d *database) makeSharedConnection(dialector func(dsn string) gorm.Dialector) error {
+	connection, err := gorm.Open(dialector(d.config.GetURI()), &gorm.Config{})
+	if err != nil {
+		logger.LogError(enums.MessageFailedToOpenWithDialector, err)
+
+		return err
+	}
+
+	d.connectionWrite = connection
+	d.connectionRead = connection
+
+	if err := audit.RegisterCallbacks(connection); err != nil {
+		return err
+	}
+
+	return chaos.RegisterCallbacks(connection, chaos.NewInjector(chaos.NewOptions()))
+}
+
 func (d *database) setLogMode() {
 	if d.config.GetLogMode() {
 		d.connectionWrite.Logger = d.connectionWrite.Logger.LogMode(gormLogger.Info)
@@ -98,6 +159,19 @@ func (d *database) setLogMode() {
 func (d *database) StartTransaction() IDatabaseWrite {
 	return &database{
 		connectionWrite: d.connectionWrite.Begin(),
+		lastErrorWrite:  d.lastErrorWrite,
+	}
+}
+
+// WithContext returns a copy of the write connection bound to the context, which is used by the audit callbacks to
+// fill the created_by and updated_by columns with the account id set by the authorization middleware.
+func (d *database) WithContext(ctx context.Context) IDatabaseWrite {
+	return &database{
+		connectionWrite: d.connectionWrite.WithContext(ctx),
+		connectionRead:  d.connectionRead,
+		config:          d.config,
+		lastErrorWrite:  d.lastErrorWrite,
+		lastErrorRead:   d.lastErrorRead,
 	}
 }
 
@@ -169,6 +243,27 @@ func (d *database) Delete(where map[string]interface{}, table string) response.I
 	return response.NewResponse(result.RowsAffected, result.Error, nil)
 }
 
+func (d *database) FindWithExpressions(entityPointer interface{}, where map[string]interface{}, table string,
+	expressions ...clause.Expression) response.IResponse {
+	query := d.connectionRead.Table(table).Where(where)
+	for _, expression := range expressions {
+		query = query.Where(expression)
+	}
+
+	result := query.Find(entityPointer)
+	if err := d.verifyNotFoundError(result); err != nil {
+		return response.NewResponse(0, err, nil)
+	}
+
+	return response.NewResponse(result.RowsAffected, result.Error, entityPointer)
+}
+
+func (d *database) Exec(rawSQL string, values ...interface{}) response.IResponse {
+	result := d.connectionWrite.Exec(rawSQL, values...)
+
+	return response.NewResponse(result.RowsAffected, result.Error, nil)
+}
+
 func (d *database) First(entityPointer interface{}, where map[string]interface{}, table string) response.IResponse {
 	result := d.connectionRead.Table(table).Where(where).First(entityPointer)
 	if err := d.verifyNotFoundError(result); err != nil {
@@ -15,10 +15,13 @@
 package database
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm/clause"
 
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/entities"
 	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
 	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
 )
@@ -32,11 +35,22 @@ func (m *Mock) IsAvailable() bool {
 	return mockUtils.ReturnBool(args, 0)
 }
 
+func (m *Mock) HealthCheck(_ context.Context) *entities.HealthCheck {
+	args := m.MethodCalled("HealthCheck")
+	return args.Get(0).(*entities.HealthCheck)
+}
+
 func (m *Mock) Find(entityPointer interface{}, _ map[string]interface{}, _ string) response.IResponse {
 	args := m.MethodCalled("Find")
 	return m.reflectValues(entityPointer, args.Get(0).(response.IResponse))
 }
 
+func (m *Mock) FindWithExpressions(entityPointer interface{}, _ map[string]interface{}, _ string,
+	_ ...clause.Expression) response.IResponse {
+	args := m.MethodCalled("FindWithExpressions")
+	return m.reflectValues(entityPointer, args.Get(0).(response.IResponse))
+}
+
 func (m *Mock) First(entityPointer interface{}, _ map[string]interface{}, _ string) response.IResponse {
 	args := m.MethodCalled("First")
 	return m.reflectValues(entityPointer, args.Get(0).(response.IResponse))
@@ -52,6 +66,11 @@ func (m *Mock) StartTransaction() IDatabaseWrite {
 	return args.Get(0).(IDatabaseWrite)
 }
 
+func (m *Mock) WithContext(_ context.Context) IDatabaseWrite {
+	args := m.MethodCalled("WithContext")
+	return args.Get(0).(IDatabaseWrite)
+}
+
 func (m *Mock) RollbackTransaction() response.IResponse {
 	args := m.MethodCalled("RollbackTransaction")
 	return args.Get(0).(response.IResponse)
@@ -82,6 +101,11 @@ func (m *Mock) Delete(_ map[string]interface{}, _ string) response.IResponse {
 	return args.Get(0).(response.IResponse)
 }
 
+func (m *Mock) Exec(_ string, _ ...interface{}) response.IResponse {
+	args := m.MethodCalled("Exec")
+	return args.Get(0).(response.IResponse)
+}
+
 func (m *Mock) FindPreload(entityPointer interface{}, _ map[string]interface{}, _ map[string][]interface{}, _ string) response.IResponse {
 	args := m.MethodCalled("FindPreload")
 	return m.reflectValues(entityPointer, args.Get(0).(response.IResponse))
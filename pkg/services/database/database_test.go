@@ -15,6 +15,7 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"testing"
@@ -23,9 +24,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/ZupIT/horusec-devkit/pkg/services/database/config"
 	"github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
 )
 
 type testEntity struct {
@@ -131,6 +134,33 @@ func TestSetLogMode(t *testing.T) {
 	})
 }
 
+func TestWithContext(t *testing.T) {
+	t.Run("should return a write connection bound to the context", func(t *testing.T) {
+		db, _, err := sqlmock.New()
+		assert.NoError(t, err)
+
+		databaseService := &database{
+			config:          config.NewDatabaseConfig(),
+			connectionRead:  getMockedConnection(db),
+			connectionWrite: getMockedConnection(db),
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		result := databaseService.WithContext(ctx)
+
+		assert.NotNil(t, result)
+		assert.Equal(t, ctx, result.(*database).connectionWrite.Statement.Context)
+	})
+
+	t.Run("should return the same connection when it is not bound to contexts", func(t *testing.T) {
+		connection := struct{ IDatabaseWrite }{&Mock{}}
+
+		assert.Equal(t, connection, WithContext(context.Background(), connection))
+	})
+}
+
 func TestStartTransaction(t *testing.T) {
 	t.Run("should success start transaction and not panic", func(t *testing.T) {
 		db, mock, err := sqlmock.New()
@@ -304,6 +334,61 @@ func TestCreateOrUpdate(t *testing.T) {
 	})
 }
 
+func TestFindWithExpressions(t *testing.T) {
+	t.Run("should success find a database record using expressions", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+
+		mock.ExpectQuery("SELECT").
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"text", "text"}).
+				AddRow("test", "test"))
+
+		database := &database{
+			config:          config.NewDatabaseConfig(),
+			connectionRead:  getMockedConnection(db),
+			connectionWrite: getMockedConnection(db),
+		}
+
+		response := database.FindWithExpressions(newTestEntity(), map[string]interface{}{"text": "test"}, "test",
+			gorm.Expr("? @> ?::jsonb", clause.Column{Name: "data"}, `{"test":1}`))
+
+		assert.NoError(t, response.GetError())
+		assert.Equal(t, 1, response.GetRowsAffected())
+		assert.Equal(t, newTestEntity(), response.GetData())
+	})
+
+	t.Run("should return error not found records when no rows affected", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+
+		mock.ExpectQuery("SELECT").
+			WillReturnRows(sqlmock.NewRows([]string{"text"}))
+
+		database := &database{
+			config:          config.NewDatabaseConfig(),
+			connectionRead:  getMockedConnection(db),
+			connectionWrite: getMockedConnection(db),
+		}
+
+		response := database.FindWithExpressions(newTestEntity(), map[string]interface{}{}, "test")
+
+		assert.Equal(t, enums.ErrorNotFoundRecords, response.GetError())
+		assert.Nil(t, response.GetData())
+	})
+
+	t.Run("should find without expressions when the connection does not support them", func(t *testing.T) {
+		databaseMock := &Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(1, nil, nil))
+
+		connection := struct{ IDatabaseRead }{databaseMock}
+
+		assert.NoError(t, FindWithExpressions(connection, newTestEntity(), map[string]interface{}{}, "test").GetError())
+		assert.Equal(t, enums.ErrorExpressionsNotSupported, FindWithExpressions(connection, newTestEntity(),
+			map[string]interface{}{}, "test", clause.Expr{SQL: "text = ?", Vars: []interface{}{"test"}}).GetError())
+	})
+}
+
 func TestFind(t *testing.T) {
 	t.Run("should success find a database record", func(t *testing.T) {
 		db, mock, err := sqlmock.New()
@@ -442,6 +527,83 @@ func TestDelete(t *testing.T) {
 	})
 }
 
+func TestExec(t *testing.T) {
+	t.Run("should success exec raw sql", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+
+		mock.ExpectExec("UPDATE").
+			WithArgs(sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+
+		database := &database{
+			config:          config.NewDatabaseConfig(),
+			connectionRead:  getMockedConnection(db),
+			connectionWrite: getMockedConnection(db),
+		}
+
+		response := database.Exec("UPDATE test SET text = ?", "test")
+
+		assert.NoError(t, response.GetError())
+		assert.Equal(t, 2, response.GetRowsAffected())
+		assert.Nil(t, response.GetData())
+	})
+
+	t.Run("should return error when failed to exec", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+
+		mock.ExpectExec("UPDATE").WillReturnError(errors.New("test"))
+
+		database := &database{
+			config:          config.NewDatabaseConfig(),
+			connectionRead:  getMockedConnection(db),
+			connectionWrite: getMockedConnection(db),
+		}
+
+		assert.Error(t, database.Exec("UPDATE test SET text = 'test'").GetError())
+	})
+
+	t.Run("should return error when the connection does not support raw sql", func(t *testing.T) {
+		connection := struct{ IDatabaseWrite }{&Mock{}}
+
+		assert.Equal(t, enums.ErrorExecNotSupported, Exec(connection, "UPDATE test SET text = 'test'").GetError())
+	})
+}
+
+func TestNewDatabaseWithDialector(t *testing.T) {
+	t.Run("should success create connection sharing the same pool", func(t *testing.T) {
+		db, _, err := sqlmock.New()
+		assert.NoError(t, err)
+
+		databaseConfig := &config.Config{}
+		databaseConfig.SetURI("test")
+
+		connection, err := NewDatabaseWithDialector(databaseConfig, func(dsn string) gorm.Dialector {
+			return postgres.New(postgres.Config{DSN: dsn, Conn: db, PreferSimpleProtocol: true})
+		})
+
+		assert.NoError(t, err)
+		assert.NotNil(t, connection.Read)
+		assert.NotNil(t, connection.Write)
+	})
+
+	t.Run("should return error when invalid config", func(t *testing.T) {
+		_, err := NewDatabaseWithDialector(&config.Config{}, nil)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when failed to open connection", func(t *testing.T) {
+		databaseConfig := &config.Config{}
+		databaseConfig.SetURI("test")
+
+		_, err := NewDatabaseWithDialector(databaseConfig, postgres.Open)
+
+		assert.Error(t, err)
+	})
+}
+
 func TestFirst(t *testing.T) {
 	t.Run("should success get first entity", func(t *testing.T) {
 		db, mock, err := sqlmock.New()
@@ -0,0 +1,78 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgconn/stmtcache"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/stdlib"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	databaseConfig "github.com/ZupIT/horusec-devkit/pkg/services/database/config"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/retry"
+)
+
+// openConnectionWithRetry retries the connection with the default policy, so the services do not crash when they
+// start before the database is ready
+func (d *database) openConnectionWithRetry() (*gorm.DB, error) {
+	var connection *gorm.DB
+
+	err := retry.Do(context.Background(), retry.NewPolicy(enums.RetryOperationConnect), func(context.Context) error {
+		var err error
+
+		connection, err = d.openConnection()
+
+		return err
+	})
+
+	return connection, err
+}
+
+func (d *database) openConnection() (*gorm.DB, error) {
+	driverConfig, ok := d.config.(databaseConfig.IDriverConfig)
+	if ok && driverConfig.GetDriver() == enums.DriverPgx {
+		return d.openPgxConnection()
+	}
+
+	return gorm.Open(postgres.Open(d.config.GetURI()), &gorm.Config{})
+}
+
+// openPgxConnection keeps a per connection cache of named prepared statements, so repeated queries skip
+// parsing and planning and use the binary protocol for the types pgx knows
+func (d *database) openPgxConnection() (*gorm.DB, error) {
+	connConfig, err := pgx.ParseConfig(d.config.GetURI())
+	if err != nil {
+		return nil, err
+	}
+
+	connConfig.PreferSimpleProtocol = false
+	connConfig.BuildStatementCache = d.buildStatementCache
+
+	return gorm.Open(postgres.New(postgres.Config{Conn: stdlib.OpenDB(*connConfig)}), &gorm.Config{})
+}
+
+func (d *database) buildStatementCache(conn *pgconn.PgConn) stmtcache.Cache {
+	driverConfig, ok := d.config.(databaseConfig.IDriverConfig)
+	if !ok || driverConfig.GetStatementCacheCapacity() <= 0 {
+		return nil
+	}
+
+	return stmtcache.New(conn, stmtcache.ModePrepare, driverConfig.GetStatementCacheCapacity())
+}
@@ -0,0 +1,72 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import (
+	"database/sql/driver"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/encryption/enums"
+)
+
+// EncryptedString is stored as base64 of nonce and AES-GCM ciphertext and decrypted when scanned.
+// Empty values are kept empty so optional columns stay distinguishable.
+type EncryptedString string
+
+func (e EncryptedString) String() string {
+	return string(e)
+}
+
+func (e EncryptedString) Value() (driver.Value, error) {
+	if e == "" {
+		return "", nil
+	}
+
+	return Encrypt(string(e))
+}
+
+func (e *EncryptedString) Scan(value interface{}) error {
+	stored, err := e.toString(value)
+	if err != nil || stored == "" {
+		*e = ""
+
+		return err
+	}
+
+	plaintext, err := Decrypt(stored)
+	if err != nil {
+		return err
+	}
+
+	*e = EncryptedString(plaintext)
+
+	return nil
+}
+
+func (e *EncryptedString) toString(value interface{}) (string, error) {
+	switch converted := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return converted, nil
+	case []byte:
+		return string(converted), nil
+	default:
+		return "", enums.ErrorInvalidEncryptedValue
+	}
+}
+
+func (e EncryptedString) GormDataType() string {
+	return enums.GormDataType
+}
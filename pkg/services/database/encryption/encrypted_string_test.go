@@ -0,0 +1,88 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build cgo
+
+package encryption
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/encryption/enums"
+)
+
+type testEntity struct {
+	ID     string `gorm:"primaryKey"`
+	Secret EncryptedString
+}
+
+func TestEncryptedString(t *testing.T) {
+	SetKeyProvider(&testKeyProvider{key: testKey})
+	defer SetKeyProvider(NewEnvKeyProvider())
+
+	t.Run("should encrypt on write and decrypt on read", func(t *testing.T) {
+		db, err := gorm.Open(sqlite.Open("file:"+uuid.NewString()+"?mode=memory&cache=shared"), &gorm.Config{})
+		require.NoError(t, err)
+		assert.NoError(t, db.AutoMigrate(&testEntity{}))
+		assert.NoError(t, db.Create(&testEntity{ID: "1", Secret: "test"}).Error)
+
+		var raw string
+		assert.NoError(t, db.Raw("SELECT secret FROM test_entities WHERE id = ?", "1").Scan(&raw).Error)
+		assert.NotEqual(t, "test", raw)
+
+		entity := &testEntity{}
+		assert.NoError(t, db.First(entity, "id = ?", "1").Error)
+		assert.Equal(t, "test", entity.Secret.String())
+	})
+
+	t.Run("should keep empty values empty", func(t *testing.T) {
+		value, err := EncryptedString("").Value()
+		assert.NoError(t, err)
+		assert.Equal(t, "", value)
+
+		var encrypted EncryptedString
+		assert.NoError(t, encrypted.Scan(nil))
+		assert.Empty(t, encrypted)
+	})
+
+	t.Run("should scan bytes value", func(t *testing.T) {
+		value, _ := EncryptedString("test").Value()
+
+		var encrypted EncryptedString
+		assert.NoError(t, encrypted.Scan([]byte(value.(string))))
+		assert.Equal(t, EncryptedString("test"), encrypted)
+	})
+
+	t.Run("should return error when scan invalid type", func(t *testing.T) {
+		var encrypted EncryptedString
+
+		assert.ErrorIs(t, encrypted.Scan(1), enums.ErrorInvalidEncryptedValue)
+	})
+
+	t.Run("should return error when scan invalid ciphertext", func(t *testing.T) {
+		var encrypted EncryptedString
+
+		assert.Error(t, encrypted.Scan("test"))
+	})
+
+	t.Run("should return text gorm data type", func(t *testing.T) {
+		assert.Equal(t, enums.GormDataType, EncryptedString("").GormDataType())
+	})
+}
@@ -0,0 +1,71 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import (
+	"encoding/base64"
+	"sync"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto"
+)
+
+var (
+	keyProvider      = NewEnvKeyProvider()
+	keyProviderMutex = sync.RWMutex{}
+)
+
+func SetKeyProvider(provider IKeyProvider) {
+	keyProviderMutex.Lock()
+	defer keyProviderMutex.Unlock()
+
+	keyProvider = provider
+}
+
+func getKey() ([]byte, error) {
+	keyProviderMutex.RLock()
+	defer keyProviderMutex.RUnlock()
+
+	return keyProvider.GetKey()
+}
+
+func Encrypt(plaintext string) (string, error) {
+	key, err := getKey()
+	if err != nil {
+		return "", err
+	}
+
+	ciphertext, err := crypto.EncryptAESGCM(key, []byte(plaintext))
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+func Decrypt(value string) (string, error) {
+	key, err := getKey()
+	if err != nil {
+		return "", err
+	}
+
+	ciphertext, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return "", err
+	}
+
+	plaintext, err := crypto.DecryptAESGCM(key, ciphertext)
+
+	return string(plaintext), err
+}
@@ -0,0 +1,71 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testKey = []byte("0123456789abcdef0123456789abcdef")
+
+type testKeyProvider struct {
+	key []byte
+	err error
+}
+
+func (t *testKeyProvider) GetKey() ([]byte, error) {
+	return t.key, t.err
+}
+
+func TestEncrypt(t *testing.T) {
+	SetKeyProvider(&testKeyProvider{key: testKey})
+	defer SetKeyProvider(NewEnvKeyProvider())
+
+	t.Run("should encrypt and decrypt value", func(t *testing.T) {
+		encrypted, err := Encrypt("test")
+		assert.NoError(t, err)
+		assert.NotEqual(t, "test", encrypted)
+
+		decrypted, err := Decrypt(encrypted)
+		assert.NoError(t, err)
+		assert.Equal(t, "test", decrypted)
+	})
+
+	t.Run("should return error when value is not base64", func(t *testing.T) {
+		_, err := Decrypt("@@@")
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when key provider fails", func(t *testing.T) {
+		SetKeyProvider(&testKeyProvider{err: errors.New("test")})
+
+		_, err := Encrypt("test")
+		assert.Error(t, err)
+
+		_, err = Decrypt("test")
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when invalid key size", func(t *testing.T) {
+		SetKeyProvider(&testKeyProvider{key: []byte("test")})
+
+		_, err := Encrypt("test")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,43 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import (
+	"encoding/base64"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/encryption/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// IKeyProvider allows the encryption key to come from places other than the environment, like a KMS
+type IKeyProvider interface {
+	GetKey() ([]byte, error)
+}
+
+type EnvKeyProvider struct{}
+
+func NewEnvKeyProvider() IKeyProvider {
+	return &EnvKeyProvider{}
+}
+
+// GetKey expects the env value to be a base64 encoded key with 16, 24 or 32 bytes
+func (e *EnvKeyProvider) GetKey() ([]byte, error) {
+	value := env.GetEnvOrDefault(enums.EnvEncryptionKey, "")
+	if value == "" {
+		return nil, enums.ErrorEmptyEncryptionKey
+	}
+
+	return base64.StdEncoding.DecodeString(value)
+}
@@ -0,0 +1,53 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import (
+	"encoding/base64"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/encryption/enums"
+)
+
+func TestGetKey(t *testing.T) {
+	t.Run("should return decoded key from env", func(t *testing.T) {
+		assert.NoError(t, os.Setenv(enums.EnvEncryptionKey, base64.StdEncoding.EncodeToString(testKey)))
+
+		key, err := NewEnvKeyProvider().GetKey()
+		assert.NoError(t, err)
+		assert.Equal(t, testKey, key)
+
+		assert.NoError(t, os.Unsetenv(enums.EnvEncryptionKey))
+	})
+
+	t.Run("should return error when env is empty", func(t *testing.T) {
+		key, err := NewEnvKeyProvider().GetKey()
+
+		assert.ErrorIs(t, err, enums.ErrorEmptyEncryptionKey)
+		assert.Nil(t, key)
+	})
+
+	t.Run("should return error when env is not base64", func(t *testing.T) {
+		assert.NoError(t, os.Setenv(enums.EnvEncryptionKey, "@@@"))
+
+		_, err := NewEnvKeyProvider().GetKey()
+		assert.Error(t, err)
+
+		assert.NoError(t, os.Unsetenv(enums.EnvEncryptionKey))
+	})
+}
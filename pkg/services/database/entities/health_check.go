@@ -0,0 +1,72 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entities
+
+import (
+	"database/sql"
+	"time"
+)
+
+type HealthCheck struct {
+	Available bool              `json:"available"`
+	Write     *ConnectionHealth `json:"write"`
+	Read      *ConnectionHealth `json:"read"`
+}
+
+type ConnectionHealth struct {
+	Available       bool          `json:"available"`
+	Latency         time.Duration `json:"latency"`
+	OpenConnections int           `json:"openConnections"`
+	InUse           int           `json:"inUse"`
+	Idle            int           `json:"idle"`
+	WaitCount       int64         `json:"waitCount"`
+	Error           string        `json:"error,omitempty"`
+	LastError       string        `json:"lastError,omitempty"`
+	LastErrorAt     *time.Time    `json:"lastErrorAt,omitempty"`
+}
+
+func NewHealthCheck(write, read *ConnectionHealth) *HealthCheck {
+	return &HealthCheck{
+		Available: write.Available && read.Available,
+		Write:     write,
+		Read:      read,
+	}
+}
+
+func NewConnectionHealth(latency time.Duration, stats sql.DBStats, err error) *ConnectionHealth {
+	health := &ConnectionHealth{
+		Available:       err == nil,
+		Latency:         latency,
+		OpenConnections: stats.OpenConnections,
+		InUse:           stats.InUse,
+		Idle:            stats.Idle,
+		WaitCount:       stats.WaitCount,
+	}
+
+	if err != nil {
+		health.Error = err.Error()
+	}
+
+	return health
+}
+
+func (c *ConnectionHealth) SetLastError(lastError error, lastErrorAt time.Time) {
+	if lastError == nil {
+		return
+	}
+
+	c.LastError = lastError.Error()
+	c.LastErrorAt = &lastErrorAt
+}
@@ -0,0 +1,79 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entities
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHealthCheck(t *testing.T) {
+	t.Run("should be available when both connections are available", func(t *testing.T) {
+		healthCheck := NewHealthCheck(&ConnectionHealth{Available: true}, &ConnectionHealth{Available: true})
+
+		assert.True(t, healthCheck.Available)
+	})
+
+	t.Run("should not be available when one of the connections is not available", func(t *testing.T) {
+		healthCheck := NewHealthCheck(&ConnectionHealth{Available: true}, &ConnectionHealth{})
+
+		assert.False(t, healthCheck.Available)
+	})
+}
+
+func TestNewConnectionHealth(t *testing.T) {
+	t.Run("should success create connection health with pool stats", func(t *testing.T) {
+		health := NewConnectionHealth(time.Millisecond, sql.DBStats{OpenConnections: 3, InUse: 1, Idle: 2}, nil)
+
+		assert.True(t, health.Available)
+		assert.Equal(t, time.Millisecond, health.Latency)
+		assert.Equal(t, 3, health.OpenConnections)
+		assert.Equal(t, 1, health.InUse)
+		assert.Equal(t, 2, health.Idle)
+		assert.Empty(t, health.Error)
+	})
+
+	t.Run("should not be available when ping returned error", func(t *testing.T) {
+		health := NewConnectionHealth(time.Millisecond, sql.DBStats{}, errors.New("test"))
+
+		assert.False(t, health.Available)
+		assert.Equal(t, "test", health.Error)
+	})
+}
+
+func TestSetLastError(t *testing.T) {
+	t.Run("should set last error and the time it happened", func(t *testing.T) {
+		health := &ConnectionHealth{}
+		now := time.Now()
+
+		health.SetLastError(errors.New("test"), now)
+
+		assert.Equal(t, "test", health.LastError)
+		assert.Equal(t, &now, health.LastErrorAt)
+	})
+
+	t.Run("should do nothing when there is no last error", func(t *testing.T) {
+		health := &ConnectionHealth{}
+
+		health.SetLastError(nil, time.Now())
+
+		assert.Empty(t, health.LastError)
+		assert.Nil(t, health.LastErrorAt)
+	})
+}
@@ -21,3 +21,9 @@ var ErrorNotFoundRecords = errors.New("{ERROR_DATABASE} database not found recor
 var ErrorConnectingToDB = errors.New("{ERROR_DATABASE} error connecting to db, use this format string for " +
 	"connection in " + EnvRelationalURI + ": 'host=localhost user=username password=user_password dbname=db_name " +
 	"port=5432 sslmode=disable TimeZone=Asia/Shanghai'")
+
+var ErrorNilConnection = errors.New("{ERROR_DATABASE} database connection was not initialized")
+
+var ErrorExpressionsNotSupported = errors.New("{ERROR_DATABASE} database connection does not support expressions")
+
+var ErrorExecNotSupported = errors.New("{ERROR_DATABASE} database connection does not support raw sql")
@@ -16,6 +16,9 @@ package enums
 
 const (
 	MessageFailedToConnectToDatabase        = "{ERROR_DATABASE} failed to connect with postgres database"
+	MessageFailedToOpenWithDialector        = "{ERROR_DATABASE} failed to open database connection with dialector"
+	MessageFailedToRegisterAuditCallbacks   = "{ERROR_DATABASE} failed to register audit columns callbacks"
+	MessageFailedToRegisterChaosCallbacks   = "{ERROR_DATABASE} failed to register chaos testing callbacks"
 	MessageFailedToVerifyIsAvailable        = "{ERROR_DATABASE} failed to get database while checking if is available"
 	MessageWarningDefaultDatabaseConnection = "{WARN} your user or password for connection with database " +
 		"is default content, please change for you best security"
@@ -17,6 +17,14 @@ package enums
 const (
 	EnvRelationalURI     = "HORUSEC_DATABASE_SQL_URI"
 	EnvRelationalLogMode = "HORUSEC_DATABASE_SQL_LOG_MODE"
+	EnvRelationalDriver  = "HORUSEC_DATABASE_SQL_DRIVER"
+
+	EnvRelationalStatementCacheCapacity = "HORUSEC_DATABASE_SQL_STATEMENT_CACHE_CAPACITY"
+	DefaultStatementCacheCapacity       = 512
+
+	DriverPostgres = "postgres"
+	DriverPgx      = "pgx"
 
 	DefaultUsernameAndPassword = "root:root"
+	RetryOperationConnect      = "database_connect"
 )
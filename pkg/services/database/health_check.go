@@ -0,0 +1,95 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/entities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+)
+
+// IHealthChecker is implemented by the connections of this package, but is kept out of IDatabaseRead and
+// IDatabaseWrite so the implementations outside of the devkit keep compiling, checking for it with a type assertion
+type IHealthChecker interface {
+	HealthCheck(ctx context.Context) *entities.HealthCheck
+}
+
+type connectionError struct {
+	mutex sync.RWMutex
+	err   error
+	at    time.Time
+}
+
+func newConnectionError() *connectionError {
+	return &connectionError{}
+}
+
+func (c *connectionError) set(err error) {
+	if c == nil || err == nil {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.err = err
+	c.at = time.Now()
+}
+
+func (c *connectionError) setOnHealth(health *entities.ConnectionHealth) *entities.ConnectionHealth {
+	if c == nil {
+		return health
+	}
+
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	health.SetLastError(c.err, c.at)
+
+	return health
+}
+
+func (d *database) HealthCheck(ctx context.Context) *entities.HealthCheck {
+	return entities.NewHealthCheck(
+		d.checkConnectionHealth(ctx, d.connectionWrite, d.lastErrorWrite),
+		d.checkConnectionHealth(ctx, d.connectionRead, d.lastErrorRead),
+	)
+}
+
+func (d *database) checkConnectionHealth(ctx context.Context, connection *gorm.DB,
+	lastError *connectionError) *entities.ConnectionHealth {
+	if connection == nil {
+		return entities.NewConnectionHealth(0, sql.DBStats{}, enums.ErrorNilConnection)
+	}
+
+	db, err := connection.DB()
+	if err != nil {
+		lastError.set(err)
+
+		return lastError.setOnHealth(entities.NewConnectionHealth(0, sql.DBStats{}, err))
+	}
+
+	start := time.Now()
+	err = db.PingContext(ctx)
+	lastError.set(err)
+
+	return lastError.setOnHealth(entities.NewConnectionHealth(time.Since(start), db.Stats(), err))
+}
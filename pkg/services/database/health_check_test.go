@@ -0,0 +1,102 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/config"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/entities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+)
+
+func TestHealthCheck(t *testing.T) {
+	t.Run("should return available health check with pool diagnostics", func(t *testing.T) {
+		db, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+		assert.NoError(t, err)
+
+		database := &database{
+			config:          config.NewDatabaseConfig(),
+			connectionRead:  getMockedConnection(db),
+			connectionWrite: getMockedConnection(db),
+			lastErrorWrite:  newConnectionError(),
+			lastErrorRead:   newConnectionError(),
+		}
+
+		mock.ExpectPing()
+		mock.ExpectPing()
+
+		healthCheck := database.HealthCheck(context.Background())
+
+		assert.True(t, healthCheck.Available)
+		assert.True(t, healthCheck.Write.Available)
+		assert.True(t, healthCheck.Read.Available)
+		assert.Empty(t, healthCheck.Read.LastError)
+	})
+
+	t.Run("should return unavailable health check and keep last error", func(t *testing.T) {
+		db, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+		assert.NoError(t, err)
+
+		database := &database{
+			config:          config.NewDatabaseConfig(),
+			connectionRead:  getMockedConnection(db),
+			connectionWrite: getMockedConnection(db),
+			lastErrorWrite:  newConnectionError(),
+			lastErrorRead:   newConnectionError(),
+		}
+
+		mock.ExpectPing()
+		mock.ExpectPing().WillReturnError(errors.New("test"))
+		mock.ExpectPing()
+		mock.ExpectPing()
+
+		healthCheck := database.HealthCheck(context.Background())
+		assert.False(t, healthCheck.Available)
+		assert.Equal(t, "test", healthCheck.Read.Error)
+		assert.Equal(t, "test", healthCheck.Read.LastError)
+
+		healthCheck = database.HealthCheck(context.Background())
+		assert.True(t, healthCheck.Available)
+		assert.Empty(t, healthCheck.Read.Error)
+		assert.Equal(t, "test", healthCheck.Read.LastError)
+		assert.NotNil(t, healthCheck.Read.LastErrorAt)
+	})
+
+	t.Run("should return unavailable when connections are nil", func(t *testing.T) {
+		database := &database{}
+
+		healthCheck := database.HealthCheck(context.Background())
+
+		assert.False(t, healthCheck.Available)
+		assert.Equal(t, enums.ErrorNilConnection.Error(), healthCheck.Write.Error)
+		assert.Equal(t, enums.ErrorNilConnection.Error(), healthCheck.Read.Error)
+	})
+}
+
+func TestConnectionErrorSetOnHealth(t *testing.T) {
+	t.Run("should return the same health when connection error is nil", func(t *testing.T) {
+		var lastError *connectionError
+
+		lastError.set(errors.New("test"))
+
+		assert.Equal(t, &entities.ConnectionHealth{}, lastError.setOnHealth(&entities.ConnectionHealth{}))
+	})
+}
@@ -0,0 +1,79 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonb
+
+import (
+	"encoding/json"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Contains matches rows where the column contains the given value (@> operator). This is the query shape that
+// benefits the most from a GIN index, prefer creating it as "USING GIN (column jsonb_path_ops)".
+func Contains(column string, value interface{}) (clause.Expr, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return clause.Expr{}, err
+	}
+
+	return gorm.Expr("? @> ?::jsonb", clause.Column{Name: column}, string(data)), nil
+}
+
+// HasKey matches rows where the top level of the column contains the given key. The jsonb_exists function is used
+// instead of the ? operator to avoid conflicts with placeholders, keep in mind that it is not able to use indexes.
+func HasKey(column, key string) clause.Expr {
+	return gorm.Expr("jsonb_exists(?, ?)", clause.Column{Name: column}, key)
+}
+
+// ExtractPath returns the value found in the path as text (#>> operator). Filtering by it can only use an expression
+// index created for the same path, like "CREATE INDEX ON table ((column #>> '{path,to,field}'))".
+func ExtractPath(column string, path ...string) clause.Expr {
+	return gorm.Expr("? #>> ?::text[]", clause.Column{Name: column}, ToTextArray(path))
+}
+
+func PathEquals(column, value string, path ...string) clause.Expr {
+	return gorm.Expr("? = ?", ExtractPath(column, path...), value)
+}
+
+// Set returns an expression to partially update the column, replacing or creating only the value in the path. It
+// should be used as a value in an update map, like map[string]interface{}{"column": jsonb.Set(...)}.
+func Set(column string, value interface{}, path ...string) (clause.Expr, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return clause.Expr{}, err
+	}
+
+	return gorm.Expr("jsonb_set(COALESCE(?, '{}'::jsonb), ?::text[], ?::jsonb, true)",
+		clause.Column{Name: column}, ToTextArray(path), string(data)), nil
+}
+
+func Remove(column string, path ...string) clause.Expr {
+	return gorm.Expr("? #- ?::text[]", clause.Column{Name: column}, ToTextArray(path))
+}
+
+// ToTextArray formats the path as a postgres text array literal, quoting every element so keys with commas,
+// braces or quotes are bound as a single parameter without any chance of sql injection.
+func ToTextArray(path []string) string {
+	quoted := make([]string, len(path))
+
+	for index, key := range path {
+		key = strings.ReplaceAll(key, `\`, `\\`)
+		quoted[index] = `"` + strings.ReplaceAll(key, `"`, `\"`) + `"`
+	}
+
+	return "{" + strings.Join(quoted, ",") + "}"
+}
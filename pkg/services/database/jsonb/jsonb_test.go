@@ -0,0 +1,133 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonb
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type testEntity struct {
+	Data string
+}
+
+func getDryRunStatement(t *testing.T, fn func(db *gorm.DB) *gorm.DB) *gorm.Statement {
+	sqlDB, _, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB, PreferSimpleProtocol: true}),
+		&gorm.Config{DryRun: true, SkipDefaultTransaction: true})
+	assert.NoError(t, err)
+
+	return fn(db.Table("test")).Statement
+}
+
+func TestContains(t *testing.T) {
+	t.Run("should build containment expression with bound json value", func(t *testing.T) {
+		expression, err := Contains("data", map[string]string{"status": "success"})
+		assert.NoError(t, err)
+
+		statement := getDryRunStatement(t, func(db *gorm.DB) *gorm.DB {
+			return db.Where(expression).Find(&[]testEntity{})
+		})
+
+		assert.Equal(t, `SELECT * FROM "test" WHERE "data" @> $1::jsonb`, statement.SQL.String())
+		assert.Equal(t, []interface{}{`{"status":"success"}`}, statement.Vars)
+	})
+
+	t.Run("should return error when value is not json serializable", func(t *testing.T) {
+		_, err := Contains("data", make(chan int))
+
+		assert.Error(t, err)
+	})
+}
+
+func TestHasKey(t *testing.T) {
+	t.Run("should build has key expression", func(t *testing.T) {
+		statement := getDryRunStatement(t, func(db *gorm.DB) *gorm.DB {
+			return db.Where(HasKey("data", "status")).Find(&[]testEntity{})
+		})
+
+		assert.Equal(t, `SELECT * FROM "test" WHERE jsonb_exists("data", $1)`, statement.SQL.String())
+		assert.Equal(t, []interface{}{"status"}, statement.Vars)
+	})
+}
+
+func TestExtractPath(t *testing.T) {
+	t.Run("should build path extraction expression", func(t *testing.T) {
+		statement := getDryRunStatement(t, func(db *gorm.DB) *gorm.DB {
+			return db.Select("?", ExtractPath("data", "vulnerability", "severity")).Find(&[]testEntity{})
+		})
+
+		assert.Equal(t, `SELECT "data" #>> $1::text[] FROM "test"`, statement.SQL.String())
+		assert.Equal(t, []interface{}{`{"vulnerability","severity"}`}, statement.Vars)
+	})
+}
+
+func TestPathEquals(t *testing.T) {
+	t.Run("should build path equals expression", func(t *testing.T) {
+		statement := getDryRunStatement(t, func(db *gorm.DB) *gorm.DB {
+			return db.Where(PathEquals("data", "HIGH", "severity")).Find(&[]testEntity{})
+		})
+
+		assert.Equal(t, `SELECT * FROM "test" WHERE "data" #>> $1::text[] = $2`, statement.SQL.String())
+		assert.Equal(t, []interface{}{`{"severity"}`, "HIGH"}, statement.Vars)
+	})
+}
+
+func TestSet(t *testing.T) {
+	t.Run("should build partial update expression", func(t *testing.T) {
+		expression, err := Set("data", "HIGH", "severity")
+		assert.NoError(t, err)
+
+		statement := getDryRunStatement(t, func(db *gorm.DB) *gorm.DB {
+			return db.Model(&testEntity{}).Where("id = ?", 1).Updates(map[string]interface{}{"data": expression})
+		})
+
+		assert.Equal(t, `UPDATE "test" SET "data"=jsonb_set(COALESCE("data", '{}'::jsonb), $1::text[], `+
+			`$2::jsonb, true) WHERE id = $3`, statement.SQL.String())
+		assert.Equal(t, []interface{}{`{"severity"}`, `"HIGH"`, 1}, statement.Vars)
+	})
+
+	t.Run("should return error when value is not json serializable", func(t *testing.T) {
+		_, err := Set("data", make(chan int), "severity")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestRemove(t *testing.T) {
+	t.Run("should build remove path expression", func(t *testing.T) {
+		expression := Remove("data", "severity")
+
+		assert.Equal(t, "? #- ?::text[]", expression.SQL)
+		assert.Equal(t, []interface{}{clause.Column{Name: "data"}, `{"severity"}`}, expression.Vars)
+	})
+}
+
+func TestToTextArray(t *testing.T) {
+	t.Run("should quote and escape path elements", func(t *testing.T) {
+		assert.Equal(t, `{"a,b","c\"d","e\\f"}`, ToTextArray([]string{"a,b", `c"d`, `e\f`}))
+	})
+
+	t.Run("should return empty array when empty path", func(t *testing.T) {
+		assert.Equal(t, "{}", ToTextArray(nil))
+	})
+}
@@ -0,0 +1,43 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+)
+
+type connectionMock struct {
+	mock.Mock
+}
+
+func (c *connectionMock) Exec(_ context.Context, _ string, _ ...interface{}) (pgconn.CommandTag, error) {
+	args := c.MethodCalled("Exec")
+	return nil, mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (c *connectionMock) WaitForNotification(_ context.Context) (*pgconn.Notification, error) {
+	args := c.MethodCalled("WaitForNotification")
+	return args.Get(0).(*pgconn.Notification), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (c *connectionMock) Close(_ context.Context) error {
+	args := c.MethodCalled("Close")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
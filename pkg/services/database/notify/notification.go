@@ -0,0 +1,37 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"encoding/json"
+
+	"github.com/jackc/pgconn"
+)
+
+type Notification struct {
+	Channel string
+	Payload string
+}
+
+func newNotification(notification *pgconn.Notification) *Notification {
+	return &Notification{
+		Channel: notification.Channel,
+		Payload: notification.Payload,
+	}
+}
+
+func (n *Notification) ParsePayload(entityPointer interface{}) error {
+	return json.Unmarshal([]byte(n.Payload), entityPointer)
+}
@@ -0,0 +1,41 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"testing"
+
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePayload(t *testing.T) {
+	t.Run("should success parse json payload", func(t *testing.T) {
+		notification := newNotification(&pgconn.Notification{Channel: "test", Payload: `{"test":"value"}`})
+
+		var payload map[string]string
+
+		assert.NoError(t, notification.ParsePayload(&payload))
+		assert.Equal(t, "value", payload["test"])
+	})
+
+	t.Run("should return error when invalid payload", func(t *testing.T) {
+		notification := newNotification(&pgconn.Notification{Payload: "test"})
+
+		var payload map[string]string
+
+		assert.Error(t, notification.ParsePayload(&payload))
+	})
+}
@@ -0,0 +1,149 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	databaseConfig "github.com/ZupIT/horusec-devkit/pkg/services/database/config"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/notify/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+type INotify interface {
+	Listen(ctx context.Context, channel string, handler func(notification *Notification))
+	Notify(ctx context.Context, channel string, payload interface{}) error
+}
+
+type Notify struct {
+	config            databaseConfig.IConfig
+	reconnectInterval time.Duration
+	connect           func(ctx context.Context, uri string) (iConnection, error)
+}
+
+func NewNotify(config databaseConfig.IConfig) (INotify, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Notify{
+		config:            config,
+		reconnectInterval: enums.DefaultReconnectInterval,
+		connect:           connect,
+	}, nil
+}
+
+func connect(ctx context.Context, uri string) (iConnection, error) {
+	return pgx.Connect(ctx, uri)
+}
+
+// Listen blocks handling the notifications of the channel until the context is canceled. If the connection is lost,
+// it reconnects and listens again, so notifications sent while disconnected are lost and should not be used to
+// carry data that can't be fetched again, like cache invalidation events.
+func (n *Notify) Listen(ctx context.Context, channel string, handler func(notification *Notification)) {
+	for ctx.Err() == nil {
+		connection, err := n.connectAndListen(ctx, channel)
+		if err == nil {
+			n.handleNotifications(ctx, connection, handler)
+			n.closeConnection(connection)
+		}
+
+		n.waitReconnectInterval(ctx)
+	}
+}
+
+func (n *Notify) connectAndListen(ctx context.Context, channel string) (iConnection, error) {
+	connection, err := n.connect(ctx, n.config.GetURI())
+	if err != nil {
+		logger.LogError(enums.MessageFailedToConnectListener, err)
+
+		return nil, err
+	}
+
+	if _, err = connection.Exec(ctx, fmt.Sprintf(enums.ListenQuery, pgx.Identifier{channel}.Sanitize())); err != nil {
+		logger.LogError(enums.MessageFailedToListenChannel, err)
+		n.closeConnection(connection)
+
+		return nil, err
+	}
+
+	logger.LogInfo(fmt.Sprintf(enums.MessageListeningChannel, channel))
+
+	return connection, nil
+}
+
+func (n *Notify) handleNotifications(ctx context.Context, connection iConnection,
+	handler func(notification *Notification)) {
+	for {
+		notification, err := connection.WaitForNotification(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				logger.LogError(enums.MessageFailedWaitingNotify, err)
+			}
+
+			return
+		}
+
+		n.handle(handler, newNotification(notification))
+	}
+}
+
+func (n *Notify) handle(handler func(notification *Notification), notification *Notification) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			logger.LogError(enums.MessageFailedToHandleNotify, fmt.Errorf("%v", recovered))
+		}
+	}()
+
+	handler(notification)
+}
+
+func (n *Notify) waitReconnectInterval(ctx context.Context) {
+	select {
+	case <-ctx.Done():
+	case <-time.After(n.reconnectInterval):
+	}
+}
+
+func (n *Notify) closeConnection(connection iConnection) {
+	logger.LogError(enums.MessageFailedToCloseListener, connection.Close(context.Background()))
+}
+
+func (n *Notify) Notify(ctx context.Context, channel string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	connection, err := n.connect(ctx, n.config.GetURI())
+	if err != nil {
+		logger.LogError(enums.MessageFailedToConnectNotifier, err)
+
+		return err
+	}
+
+	defer func() {
+		logger.LogError(enums.MessageFailedToCloseNotifyConn, connection.Close(context.Background()))
+	}()
+
+	_, err = connection.Exec(ctx, enums.NotifyQuery, channel, string(data))
+
+	return err
+}
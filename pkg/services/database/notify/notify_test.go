@@ -0,0 +1,189 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/config"
+)
+
+func getTestConfig() *config.Config {
+	databaseConfig := &config.Config{}
+	databaseConfig.SetURI("test")
+
+	return databaseConfig
+}
+
+func newTestNotify(connection iConnection, connectErr error) *Notify {
+	return &Notify{
+		config:            getTestConfig(),
+		reconnectInterval: time.Millisecond,
+		connect: func(ctx context.Context, uri string) (iConnection, error) {
+			return connection, connectErr
+		},
+	}
+}
+
+func TestNewNotify(t *testing.T) {
+	t.Run("should success create a new notify", func(t *testing.T) {
+		notify, err := NewNotify(getTestConfig())
+
+		assert.NoError(t, err)
+		assert.NotNil(t, notify)
+	})
+
+	t.Run("should return error when invalid config", func(t *testing.T) {
+		notify, err := NewNotify(&config.Config{})
+
+		assert.Error(t, err)
+		assert.Nil(t, notify)
+	})
+}
+
+func TestListen(t *testing.T) {
+	t.Run("should handle notifications until context is canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		connectionMock := &connectionMock{}
+
+		connectionMock.On("Exec").Return(nil)
+		connectionMock.On("WaitForNotification").
+			Return(&pgconn.Notification{Channel: "test", Payload: `{"test":"test"}`}, nil).Once()
+		connectionMock.On("WaitForNotification").Return(&pgconn.Notification{}, context.Canceled)
+		connectionMock.On("Close").Return(nil)
+
+		var received *Notification
+
+		newTestNotify(connectionMock, nil).Listen(ctx, "test", func(notification *Notification) {
+			received = notification
+			cancel()
+		})
+
+		assert.Equal(t, "test", received.Channel)
+		assert.Equal(t, `{"test":"test"}`, received.Payload)
+		connectionMock.AssertCalled(t, "Close")
+	})
+
+	t.Run("should reconnect after connection failure", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		connectionMock := &connectionMock{}
+		attempts := 0
+
+		connectionMock.On("Exec").Return(nil)
+		connectionMock.On("WaitForNotification").Return(&pgconn.Notification{}, context.Canceled)
+		connectionMock.On("Close").Return(nil)
+
+		notify := newTestNotify(connectionMock, nil)
+		notify.connect = func(ctx context.Context, uri string) (iConnection, error) {
+			if attempts++; attempts < 3 {
+				return nil, errors.New("test")
+			}
+
+			cancel()
+
+			return connectionMock, nil
+		}
+
+		notify.Listen(ctx, "test", func(notification *Notification) {})
+
+		assert.Equal(t, 3, attempts)
+	})
+
+	t.Run("should close connection and retry when failed to listen", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		connectionMock := &connectionMock{}
+
+		connectionMock.On("Exec").Return(errors.New("test"))
+		connectionMock.On("Close").Run(func(_ mock.Arguments) { cancel() }).Return(nil)
+
+		newTestNotify(connectionMock, nil).Listen(ctx, "test", func(notification *Notification) {})
+
+		connectionMock.AssertNotCalled(t, "WaitForNotification")
+		connectionMock.AssertCalled(t, "Close")
+	})
+
+	t.Run("should recover from handler panic and keep listening", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		connectionMock := &connectionMock{}
+		calls := 0
+
+		connectionMock.On("Exec").Return(nil)
+		connectionMock.On("WaitForNotification").Return(&pgconn.Notification{}, nil).Twice()
+		connectionMock.On("WaitForNotification").Return(&pgconn.Notification{}, context.Canceled)
+		connectionMock.On("Close").Return(nil)
+
+		newTestNotify(connectionMock, nil).Listen(ctx, "test", func(notification *Notification) {
+			if calls++; calls == 1 {
+				panic("test")
+			}
+
+			cancel()
+		})
+
+		assert.Equal(t, 2, calls)
+	})
+}
+
+func TestNotify(t *testing.T) {
+	t.Run("should success send notification", func(t *testing.T) {
+		connectionMock := &connectionMock{}
+
+		connectionMock.On("Exec").Return(nil)
+		connectionMock.On("Close").Return(nil)
+
+		err := newTestNotify(connectionMock, nil).Notify(context.Background(), "test", map[string]string{})
+
+		assert.NoError(t, err)
+		connectionMock.AssertCalled(t, "Close")
+	})
+
+	t.Run("should return error when failed to connect", func(t *testing.T) {
+		err := newTestNotify(nil, errors.New("test")).Notify(context.Background(), "test", "test")
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when invalid payload", func(t *testing.T) {
+		err := newTestNotify(nil, nil).Notify(context.Background(), "test", make(chan int))
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when failed to exec notify", func(t *testing.T) {
+		connectionMock := &connectionMock{}
+
+		connectionMock.On("Exec").Return(errors.New("test"))
+		connectionMock.On("Close").Return(nil)
+
+		err := newTestNotify(connectionMock, nil).Notify(context.Background(), "test", "test")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestConnect(t *testing.T) {
+	t.Run("should return error when failed to connect", func(t *testing.T) {
+		_, err := connect(context.Background(), "test")
+
+		assert.Error(t, err)
+	})
+}
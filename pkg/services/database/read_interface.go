@@ -15,6 +15,9 @@
 package database
 
 import (
+	"gorm.io/gorm/clause"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
 	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
 )
 
@@ -28,3 +31,25 @@ type IDatabaseRead interface {
 	FindPreloadWitLimitAndPage(entityPointer interface{}, where map[string]interface{},
 		preloads map[string][]interface{}, table string, limit, page int) response.IResponse
 }
+
+// IExpressionReader is implemented by the read connections of this package, but is kept out of IDatabaseRead so the
+// implementations outside of the devkit keep compiling
+type IExpressionReader interface {
+	FindWithExpressions(entityPointer interface{}, where map[string]interface{}, table string,
+		expressions ...clause.Expression) response.IResponse
+}
+
+// FindWithExpressions finds with the expressions when the connection implements IExpressionReader, otherwise it
+// returns ErrorExpressionsNotSupported, unless there are no expressions and a plain find is enough
+func FindWithExpressions(connection IDatabaseRead, entityPointer interface{}, where map[string]interface{},
+	table string, expressions ...clause.Expression) response.IResponse {
+	if expressionReader, ok := connection.(IExpressionReader); ok {
+		return expressionReader.FindWithExpressions(entityPointer, where, table, expressions...)
+	}
+
+	if len(expressions) == 0 {
+		return connection.Find(entityPointer, where, table)
+	}
+
+	return response.NewResponse(0, enums.ErrorExpressionsNotSupported, nil)
+}
@@ -0,0 +1,54 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seeder
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/seeder/enums"
+)
+
+type Fixture struct {
+	Table       string                   `json:"table" yaml:"table"`
+	PrimaryKeys []string                 `json:"primaryKeys" yaml:"primaryKeys"`
+	DependsOn   []string                 `json:"dependsOn" yaml:"dependsOn"`
+	Rows        []map[string]interface{} `json:"rows" yaml:"rows"`
+}
+
+func (f *Fixture) Validate() error {
+	return validation.ValidateStruct(f,
+		validation.Field(&f.Table, validation.Required),
+		validation.Field(&f.PrimaryKeys, validation.Required),
+	)
+}
+
+func (f *Fixture) getDependencies() []string {
+	return append(append([]string{}, enums.DefaultDependencies[f.Table]...), f.DependsOn...)
+}
+
+func (f *Fixture) getPrimaryKeyWhere(row map[string]interface{}) (map[string]interface{}, error) {
+	where := map[string]interface{}{}
+
+	for _, key := range f.PrimaryKeys {
+		value, ok := row[key]
+		if !ok || value == nil {
+			return nil, enums.ErrorMissingPrimaryKeyValue
+		}
+
+		where[key] = value
+	}
+
+	return where, nil
+}
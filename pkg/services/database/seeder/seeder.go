@@ -0,0 +1,215 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seeder
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/seeder/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+type ISeeder interface {
+	LoadFiles(paths ...string) error
+	LoadDir(dir string) error
+	Seed() error
+}
+
+type Seeder struct {
+	connection *database.Connection
+	fixtures   []*Fixture
+	byTable    map[string]*Fixture
+}
+
+func NewSeeder(connection *database.Connection) ISeeder {
+	return &Seeder{
+		connection: connection,
+		byTable:    map[string]*Fixture{},
+	}
+}
+
+func (s *Seeder) LoadDir(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	var paths []string
+	for _, entry := range entries {
+		if !entry.IsDir() && isFixtureFile(entry.Name()) {
+			paths = append(paths, filepath.Join(dir, entry.Name()))
+		}
+	}
+
+	sort.Strings(paths)
+
+	return s.LoadFiles(paths...)
+}
+
+func (s *Seeder) LoadFiles(paths ...string) error {
+	for _, path := range paths {
+		if err := s.loadFile(path); err != nil {
+			logger.LogError(enums.MessageFailedToLoadFixture, err)
+
+			return fmt.Errorf("%s: %w", path, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Seeder) loadFile(path string) error {
+	content, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+
+	fixtures, err := decodeFixtures(path, content)
+	if err != nil {
+		return err
+	}
+
+	return s.addFixtures(fixtures)
+}
+
+func decodeFixtures(path string, content []byte) (fixtures []*Fixture, err error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case enums.ExtensionYAML, enums.ExtensionYML:
+		err = yaml.Unmarshal(content, &fixtures)
+	case enums.ExtensionJSON:
+		err = json.Unmarshal(content, &fixtures)
+	default:
+		err = enums.ErrorUnsupportedFileFormat
+	}
+
+	return fixtures, err
+}
+
+func isFixtureFile(name string) bool {
+	_, err := decodeFixtures(name, []byte("[]"))
+
+	return err == nil
+}
+
+// addFixtures merges fixtures of an already loaded table, so data can be split across files
+func (s *Seeder) addFixtures(fixtures []*Fixture) error {
+	for _, fixture := range fixtures {
+		if err := fixture.Validate(); err != nil {
+			return err
+		}
+
+		if loaded, ok := s.byTable[fixture.Table]; ok {
+			loaded.Rows = append(loaded.Rows, fixture.Rows...)
+			loaded.DependsOn = append(loaded.DependsOn, fixture.DependsOn...)
+
+			continue
+		}
+
+		s.byTable[fixture.Table] = fixture
+		s.fixtures = append(s.fixtures, fixture)
+	}
+
+	return nil
+}
+
+// Seed creates the rows that don't exist yet, identified by the fixture primary keys, so it can run many times
+// against the same database without duplicating or overwriting data
+func (s *Seeder) Seed() error {
+	fixtures, err := sortByDependencies(s.fixtures)
+	if err != nil {
+		return err
+	}
+
+	missing, err := s.getMissingRows(fixtures)
+	if err != nil {
+		return err
+	}
+
+	return s.createMissingRows(fixtures, missing)
+}
+
+// getMissingRows runs before the transaction starts, since some databases lock the tables being written for
+// the other connections until the commit
+func (s *Seeder) getMissingRows(fixtures []*Fixture) (map[string][]map[string]interface{}, error) {
+	missing := map[string][]map[string]interface{}{}
+
+	for _, fixture := range fixtures {
+		for _, row := range fixture.Rows {
+			exists, err := s.exists(fixture, row)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", fixture.Table, err)
+			}
+
+			if !exists {
+				missing[fixture.Table] = append(missing[fixture.Table], row)
+			}
+		}
+	}
+
+	return missing, nil
+}
+
+func (s *Seeder) exists(fixture *Fixture, row map[string]interface{}) (bool, error) {
+	where, err := fixture.getPrimaryKeyWhere(row)
+	if err != nil {
+		return false, err
+	}
+
+	var rows []map[string]interface{}
+
+	result := s.connection.Read.Find(&rows, where, fixture.Table)
+
+	return result.GetRowsAffected() > 0, result.GetErrorExceptNotFound()
+}
+
+func (s *Seeder) createMissingRows(fixtures []*Fixture, missing map[string][]map[string]interface{}) error {
+	transaction := s.connection.Write.StartTransaction()
+
+	for _, fixture := range fixtures {
+		if err := s.createRows(transaction, fixture.Table, missing[fixture.Table]); err != nil {
+			logger.LogError(enums.MessageFailedToSeedTable, err)
+
+			return s.rollback(transaction, err)
+		}
+	}
+
+	return transaction.CommitTransaction().GetError()
+}
+
+func (s *Seeder) createRows(transaction database.IDatabaseWrite, table string, rows []map[string]interface{}) error {
+	for _, row := range rows {
+		if err := transaction.Create(row, table).GetError(); err != nil {
+			return fmt.Errorf("%s: %w", table, err)
+		}
+	}
+
+	logger.LogInfo(fmt.Sprintf(enums.MessageSeededTable, table, len(rows)))
+
+	return nil
+}
+
+func (s *Seeder) rollback(transaction database.IDatabaseWrite, err error) error {
+	logger.LogError(enums.MessageFailedToSeedTable, transaction.RollbackTransaction().GetError())
+
+	return err
+}
@@ -0,0 +1,176 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build cgo
+
+package seeder
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/seeder/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/sqlite"
+)
+
+const (
+	testAccountsYAML = `
+- table: accounts
+  primaryKeys: [account_id]
+  rows:
+    - account_id: 1
+      email: test@horusec.io
+`
+	testWorkspacesJSON = `[{
+  "table": "workspaces",
+  "primaryKeys": ["workspace_id"],
+  "rows": [{"workspace_id": 1, "account_id": 1, "name": "test"}]
+}]`
+)
+
+func newTestConnection(t *testing.T) *database.Connection {
+	connection, err := sqlite.NewInMemoryDatabase()
+	require.NoError(t, err)
+
+	assert.NoError(t, database.Exec(connection.Write,
+		"CREATE TABLE accounts (account_id INTEGER PRIMARY KEY, email TEXT)").GetError())
+	assert.NoError(t, database.Exec(connection.Write, "CREATE TABLE workspaces (workspace_id INTEGER PRIMARY KEY, "+
+		"account_id INTEGER NOT NULL REFERENCES accounts(account_id), name TEXT)").GetError())
+
+	return connection
+}
+
+func writeTestFile(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+func countRows(t *testing.T, connection *database.Connection, table string) int {
+	var rows []map[string]interface{}
+
+	assert.NoError(t, connection.Read.Find(&rows, map[string]interface{}{}, table).GetErrorExceptNotFound())
+
+	return len(rows)
+}
+
+func TestSeed(t *testing.T) {
+	t.Run("should seed tables in dependency order and be idempotent", func(t *testing.T) {
+		connection := newTestConnection(t)
+		dir := t.TempDir()
+		writeTestFile(t, dir, "1_workspaces.json", testWorkspacesJSON)
+		writeTestFile(t, dir, "2_accounts.yaml", testAccountsYAML)
+		writeTestFile(t, dir, "README.md", "test")
+
+		for i := 0; i < 2; i++ {
+			seeder := NewSeeder(connection)
+
+			assert.NoError(t, seeder.LoadDir(dir))
+			assert.NoError(t, seeder.Seed())
+		}
+
+		assert.Equal(t, 1, countRows(t, connection, "accounts"))
+		assert.Equal(t, 1, countRows(t, connection, "workspaces"))
+	})
+
+	t.Run("should rollback when failed to create a row", func(t *testing.T) {
+		connection := newTestConnection(t)
+		seeder := NewSeeder(connection)
+
+		assert.NoError(t, seeder.LoadFiles(writeTestFile(t, t.TempDir(), "test.yml", testAccountsYAML+`
+- table: workspaces
+  primaryKeys: [workspace_id]
+  rows:
+    - workspace_id: 1
+      invalid_column: test
+`)))
+
+		assert.Error(t, seeder.Seed())
+		assert.Equal(t, 0, countRows(t, connection, "accounts"))
+	})
+
+	t.Run("should return error when missing primary key value", func(t *testing.T) {
+		seeder := NewSeeder(newTestConnection(t))
+
+		assert.NoError(t, seeder.LoadFiles(writeTestFile(t, t.TempDir(), "test.yaml", `
+- table: accounts
+  primaryKeys: [account_id]
+  rows:
+    - email: test@horusec.io
+`)))
+
+		assert.ErrorIs(t, seeder.Seed(), enums.ErrorMissingPrimaryKeyValue)
+	})
+
+	t.Run("should return error when cyclic dependencies", func(t *testing.T) {
+		seeder := NewSeeder(newTestConnection(t))
+
+		assert.NoError(t, seeder.LoadFiles(writeTestFile(t, t.TempDir(), "test.yaml", `
+- table: accounts
+  primaryKeys: [account_id]
+  dependsOn: [workspaces]
+- table: workspaces
+  primaryKeys: [workspace_id]
+`)))
+
+		assert.ErrorIs(t, seeder.Seed(), enums.ErrorCyclicDependency)
+	})
+}
+
+func TestLoadFiles(t *testing.T) {
+	t.Run("should merge fixtures of the same table", func(t *testing.T) {
+		dir := t.TempDir()
+		seeder := &Seeder{byTable: map[string]*Fixture{}}
+
+		assert.NoError(t, seeder.LoadFiles(writeTestFile(t, dir, "1.yaml", testAccountsYAML),
+			writeTestFile(t, dir, "2.yaml", testAccountsYAML)))
+
+		assert.Len(t, seeder.fixtures, 1)
+		assert.Len(t, seeder.fixtures[0].Rows, 2)
+	})
+
+	t.Run("should return error when unsupported file format", func(t *testing.T) {
+		err := NewSeeder(nil).LoadFiles(writeTestFile(t, t.TempDir(), "test.txt", testAccountsYAML))
+
+		assert.ErrorIs(t, err, enums.ErrorUnsupportedFileFormat)
+	})
+
+	t.Run("should return error when invalid fixture", func(t *testing.T) {
+		err := NewSeeder(nil).LoadFiles(writeTestFile(t, t.TempDir(), "test.json", `[{"table": "accounts"}]`))
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when invalid file content", func(t *testing.T) {
+		err := NewSeeder(nil).LoadFiles(writeTestFile(t, t.TempDir(), "test.json", "test"))
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when file does not exist", func(t *testing.T) {
+		assert.Error(t, NewSeeder(nil).LoadFiles("test.json"))
+	})
+}
+
+func TestLoadDir(t *testing.T) {
+	t.Run("should return error when dir does not exist", func(t *testing.T) {
+		assert.Error(t, NewSeeder(nil).LoadDir(filepath.Join(t.TempDir(), "test")))
+	})
+}
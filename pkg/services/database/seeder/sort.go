@@ -0,0 +1,63 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seeder
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/seeder/enums"
+)
+
+// sortByDependencies orders the fixtures so every table is seeded after the tables it references, keeping the load
+// order between tables without relation. Dependencies without fixtures are expected to be already filled.
+func sortByDependencies(fixtures []*Fixture) ([]*Fixture, error) {
+	sorted := make([]*Fixture, 0, len(fixtures))
+	visited := map[string]bool{}
+
+	for len(sorted) < len(fixtures) {
+		next := nextWithoutPendingDependencies(fixtures, visited)
+		if next == nil {
+			return nil, enums.ErrorCyclicDependency
+		}
+
+		visited[next.Table] = true
+		sorted = append(sorted, next)
+	}
+
+	return sorted, nil
+}
+
+func nextWithoutPendingDependencies(fixtures []*Fixture, visited map[string]bool) *Fixture {
+	tables := map[string]bool{}
+	for _, fixture := range fixtures {
+		tables[fixture.Table] = true
+	}
+
+	for _, fixture := range fixtures {
+		if !visited[fixture.Table] && !hasPendingDependency(fixture, tables, visited) {
+			return fixture
+		}
+	}
+
+	return nil
+}
+
+func hasPendingDependency(fixture *Fixture, tables, visited map[string]bool) bool {
+	for _, dependency := range fixture.getDependencies() {
+		if tables[dependency] && !visited[dependency] && dependency != fixture.Table {
+			return true
+		}
+	}
+
+	return false
+}
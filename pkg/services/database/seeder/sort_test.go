@@ -0,0 +1,56 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seeder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/seeder/enums"
+)
+
+func TestSortByDependencies(t *testing.T) {
+	t.Run("should sort using default and declared dependencies", func(t *testing.T) {
+		sorted, err := sortByDependencies([]*Fixture{
+			{Table: "test", DependsOn: []string{"repositories"}},
+			{Table: "repositories"},
+			{Table: "workspaces"},
+			{Table: "accounts"},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "accounts", sorted[0].Table)
+		assert.Equal(t, "workspaces", sorted[1].Table)
+		assert.Equal(t, "repositories", sorted[2].Table)
+		assert.Equal(t, "test", sorted[3].Table)
+	})
+
+	t.Run("should ignore dependencies without fixtures and self references", func(t *testing.T) {
+		sorted, err := sortByDependencies([]*Fixture{{Table: "workspaces", DependsOn: []string{"workspaces"}}})
+
+		assert.NoError(t, err)
+		assert.Len(t, sorted, 1)
+	})
+
+	t.Run("should return error when cyclic dependencies", func(t *testing.T) {
+		_, err := sortByDependencies([]*Fixture{
+			{Table: "accounts", DependsOn: []string{"workspaces"}},
+			{Table: "workspaces"},
+		})
+
+		assert.ErrorIs(t, err, enums.ErrorCyclicDependency)
+	})
+}
@@ -0,0 +1,55 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build cgo
+
+package sqlite
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseConfig "github.com/ZupIT/horusec-devkit/pkg/services/database/config"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/sqlite/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// NewSQLiteDatabase opens the database file of HORUSEC_DATABASE_SQLITE_PATH. The sqlite driver uses cgo, so this package is
+// only built with cgo enabled.
+func NewSQLiteDatabase() (*database.Connection, error) {
+	return database.NewDatabaseWithDialector(newConfig(fmt.Sprintf(enums.FileURI,
+		env.GetEnvOrDefault(enums.EnvSQLitePath, enums.DefaultPath))), dialector)
+}
+
+// NewInMemoryDatabase creates an isolated in-memory database, which is discarded as soon as its connections are
+// closed. Since every call uses a random name, tests running in parallel will not see each other's data.
+func NewInMemoryDatabase() (*database.Connection, error) {
+	return database.NewDatabaseWithDialector(newConfig(fmt.Sprintf(enums.InMemoryURI, uuid.NewString())), dialector)
+}
+
+func newConfig(uri string) databaseConfig.IConfig {
+	config := &databaseConfig.Config{}
+	config.SetURI(uri)
+	config.SetLogMode(env.GetEnvOrDefaultBool(enums.EnvSQLiteLogMode, false))
+
+	return config
+}
+
+func dialector(dsn string) gorm.Dialector {
+	return sqlite.Open(dsn)
+}
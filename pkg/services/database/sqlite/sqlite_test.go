@@ -0,0 +1,85 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build cgo
+
+package sqlite
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	sqliteEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/sqlite/enums"
+)
+
+type testEntity struct {
+	ID   int    `gorm:"Column:id"`
+	Text string `gorm:"Column:text"`
+}
+
+func TestNewInMemoryDatabase(t *testing.T) {
+	t.Run("should success create and use an in-memory database", func(t *testing.T) {
+		connection, err := NewInMemoryDatabase()
+		require.NoError(t, err)
+
+		assert.NoError(t, database.Exec(connection.Write, "CREATE TABLE test (id INTEGER PRIMARY KEY, text TEXT)").GetError())
+		assert.NoError(t, connection.Write.Create(&testEntity{ID: 1, Text: "test"}, "test").GetError())
+
+		entity := &testEntity{}
+		assert.NoError(t, connection.Read.First(entity, map[string]interface{}{"id": 1}, "test").GetError())
+		assert.Equal(t, "test", entity.Text)
+		assert.True(t, connection.Read.IsAvailable())
+	})
+
+	t.Run("should isolate data between in-memory databases", func(t *testing.T) {
+		first, err := NewInMemoryDatabase()
+		require.NoError(t, err)
+
+		second, err := NewInMemoryDatabase()
+		require.NoError(t, err)
+
+		assert.NoError(t, database.Exec(first.Write, "CREATE TABLE test (id INTEGER PRIMARY KEY, text TEXT)").GetError())
+		assert.Error(t, second.Read.Find(&[]testEntity{}, map[string]interface{}{}, "test").GetError())
+	})
+
+	t.Run("should return not found error when no records", func(t *testing.T) {
+		connection, err := NewInMemoryDatabase()
+		require.NoError(t, err)
+
+		assert.NoError(t, database.Exec(connection.Write, "CREATE TABLE test (id INTEGER PRIMARY KEY, text TEXT)").GetError())
+
+		response := connection.Read.Find(&[]testEntity{}, map[string]interface{}{}, "test")
+		assert.ErrorIs(t, response.GetError(), enums.ErrorNotFoundRecords)
+	})
+}
+
+func TestNewSQLiteDatabase(t *testing.T) {
+	t.Run("should success create a file database in the path from env", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "test.db")
+		_ = os.Setenv(sqliteEnums.EnvSQLitePath, path)
+
+		connection, err := NewSQLiteDatabase()
+		require.NoError(t, err)
+		assert.True(t, connection.Write.IsAvailable())
+
+		assert.NoError(t, database.Exec(connection.Write, "CREATE TABLE test (id INTEGER PRIMARY KEY)").GetError())
+		assert.FileExists(t, path)
+	})
+}
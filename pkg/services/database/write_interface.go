@@ -14,7 +14,12 @@
 
 package database
 
-import "github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+import (
+	"context"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+)
 
 type IDatabaseWrite interface {
 	StartTransaction() IDatabaseWrite
@@ -26,3 +31,34 @@ type IDatabaseWrite interface {
 	Update(entityPointer interface{}, where map[string]interface{}, table string) response.IResponse
 	Delete(where map[string]interface{}, table string) response.IResponse
 }
+
+// IContextWriter is implemented by the write connections of this package, but is kept out of IDatabaseWrite so the
+// implementations outside of the devkit keep compiling
+type IContextWriter interface {
+	WithContext(ctx context.Context) IDatabaseWrite
+}
+
+// WithContext binds the connection to the context when it implements IContextWriter, otherwise the connection is
+// returned as it is and the audit columns are not filled
+func WithContext(ctx context.Context, connection IDatabaseWrite) IDatabaseWrite {
+	if contextWriter, ok := connection.(IContextWriter); ok {
+		return contextWriter.WithContext(ctx)
+	}
+
+	return connection
+}
+
+// IExecWriter is implemented by the write connections of this package, but is kept out of IDatabaseWrite so the
+// implementations outside of the devkit keep compiling
+type IExecWriter interface {
+	Exec(rawSQL string, values ...interface{}) response.IResponse
+}
+
+// Exec runs the raw sql when the connection implements IExecWriter, otherwise it returns ErrorExecNotSupported
+func Exec(connection IDatabaseWrite, rawSQL string, values ...interface{}) response.IResponse {
+	if execWriter, ok := connection.(IExecWriter); ok {
+		return execWriter.Exec(rawSQL, values...)
+	}
+
+	return response.NewResponse(0, enums.ErrorExecNotSupported, nil)
+}
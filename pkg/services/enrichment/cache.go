@@ -0,0 +1,73 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enrichment
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/cache/ttl"
+	"github.com/ZupIT/horusec-devkit/pkg/services/enrichment/entities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/enrichment/enums"
+)
+
+// CachedClient keeps the advisories returned by a client. The ids not found are also cached as nil, since asking
+// again for them would only spend the rate limit of the database.
+type CachedClient struct {
+	client IClient
+	cache  ttl.ICache[string, *entities.Advisory]
+}
+
+func NewCachedClient(client IClient, cache ttl.ICache[string, *entities.Advisory]) IClient {
+	return &CachedClient{
+		client: client,
+		cache:  cache,
+	}
+}
+
+func (c *CachedClient) Source() enums.Source {
+	return c.client.Source()
+}
+
+func (c *CachedClient) GetAdvisory(ctx context.Context, id string) (*entities.Advisory, error) {
+	id = NormalizeID(id)
+
+	advisory, err := c.cache.GetOrLoad(ctx, id, func(ctx context.Context) (*entities.Advisory, error) {
+		advisory, err := c.client.GetAdvisory(ctx, id)
+		if errors.Is(err, enums.ErrorAdvisoryNotFound) {
+			return nil, nil
+		}
+
+		return advisory, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if advisory == nil {
+		return nil, enums.ErrorAdvisoryNotFound
+	}
+
+	return advisory, nil
+}
+
+func newAdvisoryCache(options *Options, source enums.Source) ttl.ICache[string, *entities.Advisory] {
+	cacheOptions := ttl.NewOptions(enums.CacheName + "_" + source.ToString())
+	cacheOptions.TTL = options.CacheTTL
+	cacheOptions.MaxEntries = enums.DefaultMaxEntries
+	cacheOptions.Clock = options.Clock
+
+	return ttl.NewCache[string, *entities.Advisory](cacheOptions)
+}
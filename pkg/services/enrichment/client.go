@@ -0,0 +1,152 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enrichment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/enrichment/entities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/enrichment/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/request"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/semver"
+)
+
+// nolint:gochecknoglobals // compiled once since it is used for every vulnerability
+var advisoryIDRegexp = regexp.MustCompile(enums.AdvisoryIDPattern)
+
+// IClient returns the advisory of a cve or ghsa id from a vulnerability database, or enums.ErrorAdvisoryNotFound
+// when the database does not know the id
+type IClient interface {
+	Source() enums.Source
+	GetAdvisory(ctx context.Context, id string) (*entities.Advisory, error)
+}
+
+// httpClient contains the request and the rate limit shared by the clients of online databases
+type httpClient struct {
+	request request.IRequest
+	limiter ILimiter
+	headers map[string]string
+}
+
+func (c *httpClient) get(ctx context.Context, url string, result interface{}) error {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return fmt.Errorf("%w: %s", enums.ErrorRequestFailed, err.Error())
+	}
+
+	req.Header.Set(enums.HeaderAccept, enums.ContentTypeJSON)
+	for key, value := range c.headers {
+		req.Header.Set(key, value)
+	}
+
+	return c.do(req, result)
+}
+
+func (c *httpClient) do(req *http.Request, result interface{}) error {
+	response, err := c.request.DoRequest(req, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %s", enums.ErrorRequestFailed, err.Error())
+	}
+
+	defer response.CloseBody()
+
+	switch code := response.GetStatusCode(); {
+	case code == http.StatusNotFound:
+		return enums.ErrorAdvisoryNotFound
+	case code == http.StatusTooManyRequests:
+		return enums.ErrorRateLimited
+	case code >= http.StatusBadRequest:
+		return fmt.Errorf("%w: %s", enums.ErrorRequestFailed, response.GetStatusCodeString())
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(result); err != nil {
+		return fmt.Errorf("%w: %s", enums.ErrorInvalidAdvisory, err.Error())
+	}
+
+	return nil
+}
+
+// ExtractIDs returns the cve and ghsa ids found on a text without duplicates, on the order they appear
+func ExtractIDs(text string) (ids []string) {
+	seen := map[string]bool{}
+
+	for _, match := range advisoryIDRegexp.FindAllString(text, -1) {
+		id := NormalizeID(match)
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// ExtractCVEs returns only the cve ids found on a text without duplicates, on the order they appear
+func ExtractCVEs(text string) (cves []string) {
+	for _, id := range ExtractIDs(text) {
+		if isCVE(id) {
+			cves = append(cves, id)
+		}
+	}
+
+	return cves
+}
+
+// NormalizeID returns the id on the case used by the databases, which is upper case for the cve ids and for the
+// prefix of the ghsa ids, whose remaining characters are lower case
+func NormalizeID(id string) string {
+	id = strings.TrimSpace(id)
+
+	if len(id) > len(enums.PrefixGHSA) && strings.EqualFold(id[:len(enums.PrefixGHSA)], enums.PrefixGHSA) {
+		return enums.PrefixGHSA + strings.ToLower(id[len(enums.PrefixGHSA):])
+	}
+
+	return strings.ToUpper(id)
+}
+
+func isCVE(id string) bool {
+	return strings.HasPrefix(id, enums.PrefixCVE)
+}
+
+// affectedRange joins the operators and versions into a semantic version constraint, ignoring the empty versions. The
+// versions are normalized so partial versions as 2.0 are not expanded to ranges, and an empty string is returned
+// when some version is not a semantic version, as happens on some maven and pypi ranges
+func affectedRange(comparators ...[2]string) string {
+	var values []string
+
+	for _, comparator := range comparators {
+		if comparator[1] == "" {
+			continue
+		}
+
+		version, err := semver.Parse(comparator[1])
+		if err != nil {
+			return ""
+		}
+
+		values = append(values, comparator[0]+version.String())
+	}
+
+	return strings.Join(values, ", ")
+}
@@ -0,0 +1,168 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enrichment
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/services/enrichment/entities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/enrichment/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+// IEnricher augments the vulnerabilities with the advisories of the cve and ghsa ids found on their details
+type IEnricher interface {
+	GetAdvisory(ctx context.Context, id string) (*entities.Advisory, error)
+	Enrich(ctx context.Context, vuln *vulnerability.Vulnerability) (*entities.Enriched, error)
+	EnrichAll(ctx context.Context, vulnerabilities []*vulnerability.Vulnerability) ([]*entities.Enriched, error)
+}
+
+type Enricher struct {
+	clients []IClient
+}
+
+// NewEnricher returns an enricher that queries the clients on the given order, the fields of the first client that
+// knows an id take precedence when the advisories are merged
+func NewEnricher(clients ...IClient) IEnricher {
+	return &Enricher{
+		clients: clients,
+	}
+}
+
+// NewEnricherFromOptions returns an enricher with only the offline database when its path is set, otherwise with
+// the osv and nvd apis, on this order since the osv also knows the ghsa ids and the fixed versions by package
+func NewEnricherFromOptions(options *Options) (IEnricher, error) {
+	if options.OfflinePath != "" {
+		client, err := NewOfflineClient(options.OfflinePath)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewEnricher(client), nil
+	}
+
+	clients := []IClient{NewOSVClient(options), NewNVDClient(options)}
+	if options.CacheTTL > 0 {
+		for index, client := range clients {
+			clients[index] = NewCachedClient(client, newAdvisoryCache(options, client.Source()))
+		}
+	}
+
+	return NewEnricher(clients...), nil
+}
+
+// GetAdvisory merges the advisories of all clients for the id. The errors of a client are logged and skipped, and
+// only returned when no client found the advisory.
+func (e *Enricher) GetAdvisory(ctx context.Context, id string) (*entities.Advisory, error) {
+	var merged *entities.Advisory
+
+	var failure error
+
+	for _, client := range e.clients {
+		advisory, err := client.GetAdvisory(ctx, id)
+		if err == nil {
+			merged = e.merge(merged, advisory)
+			continue
+		}
+
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		if errors.Is(err, enums.ErrorAdvisoryNotFound) {
+			continue
+		}
+
+		logger.LogError(enums.MessageFailedToGetAdvisory, err,
+			map[string]interface{}{"source": client.Source().ToString(), "id": id})
+
+		if failure == nil {
+			failure = err
+		}
+	}
+
+	return e.checkMergeResult(merged, failure)
+}
+
+// merge copies the first advisory before merging, since the advisories of the clients can be shared by a cache
+func (e *Enricher) merge(merged, advisory *entities.Advisory) *entities.Advisory {
+	if merged == nil {
+		merged = &entities.Advisory{ID: advisory.ID}
+	}
+
+	merged.Merge(advisory)
+
+	return merged
+}
+
+func (e *Enricher) checkMergeResult(merged *entities.Advisory, failure error) (*entities.Advisory, error) {
+	if merged != nil {
+		return merged, nil
+	}
+
+	if failure != nil {
+		return nil, failure
+	}
+
+	return nil, enums.ErrorAdvisoryNotFound
+}
+
+// Enrich returns the vulnerability with the advisories of its ids. An id that is an alias of an advisory already
+// found is not queried again, and the ids that could not be found are skipped.
+func (e *Enricher) Enrich(ctx context.Context, vuln *vulnerability.Vulnerability) (*entities.Enriched, error) {
+	enriched := &entities.Enriched{Vulnerability: vuln}
+	found := map[string]bool{}
+
+	for _, id := range ExtractIDs(vuln.Details) {
+		if found[id] {
+			continue
+		}
+
+		advisory, err := e.GetAdvisory(ctx, id)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
+			continue
+		}
+
+		for _, alias := range advisory.IDs() {
+			found[NormalizeID(alias)] = true
+		}
+
+		enriched.Advisories = append(enriched.Advisories, advisory)
+	}
+
+	return enriched, nil
+}
+
+func (e *Enricher) EnrichAll(ctx context.Context,
+	vulnerabilities []*vulnerability.Vulnerability) ([]*entities.Enriched, error) {
+	result := make([]*entities.Enriched, 0, len(vulnerabilities))
+
+	for _, vuln := range vulnerabilities {
+		enriched, err := e.Enrich(ctx, vuln)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, enriched)
+	}
+
+	return result, nil
+}
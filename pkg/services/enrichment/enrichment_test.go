@@ -0,0 +1,482 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enrichment
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/services/cache/ttl"
+	"github.com/ZupIT/horusec-devkit/pkg/services/enrichment/entities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/enrichment/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+	semverEnums "github.com/ZupIT/horusec-devkit/pkg/utils/semver/enums"
+)
+
+const (
+	testCVE  = "CVE-2021-44228"
+	testGHSA = "GHSA-jfh8-c2jp-5v3q"
+
+	testOSVBody = `{
+		"id": "GHSA-jfh8-c2jp-5v3q",
+		"aliases": ["CVE-2021-44228"],
+		"summary": "Remote code injection in Log4j",
+		"details": "Log4j2 JNDI features do not protect against attacker controlled LDAP endpoints.",
+		"published": "2021-12-10T00:40:56Z",
+		"modified": "2023-01-01T00:00:00Z",
+		"severity": [{"type": "CVSS_V3", "score": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H"}],
+		"affected": [{"ranges": [{"type": "ECOSYSTEM", "events": [{"introduced": "2.0"}, {"fixed": "2.15.0"}]},
+			{"type": "ECOSYSTEM", "events": [{"introduced": "2.13.0"}, {"fixed": "2.15.0"}]}]}],
+		"references": [{"type": "WEB", "url": "https://logging.apache.org/log4j/2.x/security.html"}],
+		"database_specific": {"severity": "critical"}
+	}`
+
+	testNVDBody = `{
+		"totalResults": 1,
+		"vulnerabilities": [{"cve": {
+			"id": "CVE-2021-44228",
+			"published": "2021-12-10T10:15:09.143",
+			"lastModified": "2023-11-07T03:39:36.747",
+			"descriptions": [{"lang": "es", "value": "Apache Log4j2"}, {"lang": "en", "value": "Apache Log4j2 JNDI"}],
+			"metrics": {"cvssMetricV31": [{"cvssData": {"baseScore": 10.0, "baseSeverity": "CRITICAL",
+				"vectorString": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H"}}]},
+			"references": [{"url": "https://logging.apache.org/log4j/2.x/security.html"},
+				{"url": "https://nvd.nist.gov/vuln/detail/CVE-2021-44228"}],
+			"configurations": [{"nodes": [{"cpeMatch": [
+				{"vulnerable": true, "versionStartIncluding": "2.0-beta9", "versionEndExcluding": "2.12.2"},
+				{"vulnerable": true, "versionStartIncluding": "2.13.0", "versionEndExcluding": "2.15.0"},
+				{"vulnerable": true, "versionEndIncluding": "1.2"},
+				{"vulnerable": false, "versionEndExcluding": "1.0.0"}]}]}]
+		}}]
+	}`
+)
+
+func newTestOptions(url string) *Options {
+	options := NewOptions()
+	options.OSVURL = url
+	options.NVDURL = url
+	options.OfflinePath = ""
+	options.Clock = clock.NewFakeClock(time.Now())
+
+	return options
+}
+
+func newClientMock(source enums.Source, advisories map[string]*entities.Advisory, fallback error) *ClientMock {
+	client := &ClientMock{}
+	client.On("Source").Return(source)
+
+	for id, advisory := range advisories {
+		client.On("GetAdvisory", id).Return(advisory, nil)
+	}
+
+	client.On("GetAdvisory", mock.Anything).Return((*entities.Advisory)(nil), fallback)
+
+	return client
+}
+
+func TestExtractIDs(t *testing.T) {
+	t.Run("should return the normalized ids without duplicates", func(t *testing.T) {
+		ids := ExtractIDs("(1/1) * Possible vulnerability detected: cve-2021-44228, see ghsa-JFH8-C2JP-5V3Q " +
+			"and CVE-2021-44228 or CVE-2021-45046.")
+
+		assert.Equal(t, []string{testCVE, testGHSA, "CVE-2021-45046"}, ids)
+	})
+
+	t.Run("should return empty when the text has no ids", func(t *testing.T) {
+		assert.Empty(t, ExtractIDs("Asymmetric Private Key Found, see CWE-312"))
+	})
+}
+
+func TestExtractCVEs(t *testing.T) {
+	t.Run("should return only the cve ids", func(t *testing.T) {
+		assert.Equal(t, []string{testCVE}, ExtractCVEs("GHSA-jfh8-c2jp-5v3q and cve-2021-44228"))
+	})
+}
+
+func TestLimiter(t *testing.T) {
+	t.Run("should space the requests by the interval of the window", func(t *testing.T) {
+		fakeClock := clock.NewFakeClock(time.Now())
+		limiter := NewLimiter(2, time.Second, fakeClock)
+
+		assert.NoError(t, limiter.Wait(context.Background()))
+
+		done := make(chan error)
+		go func() { done <- limiter.Wait(context.Background()) }()
+
+		fakeClock.BlockUntil(1)
+		fakeClock.Advance(499 * time.Millisecond)
+		assert.Len(t, done, 0)
+
+		fakeClock.Advance(time.Millisecond)
+		assert.NoError(t, <-done)
+	})
+
+	t.Run("should return the error of a canceled context", func(t *testing.T) {
+		limiter := NewLimiter(1, time.Minute, clock.NewFakeClock(time.Now()))
+		assert.NoError(t, limiter.Wait(context.Background()))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		assert.ErrorIs(t, limiter.Wait(ctx), context.Canceled)
+	})
+}
+
+func TestOSVClient(t *testing.T) {
+	t.Run("should return the advisory of the osv api", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, enums.OSVVulnerabilityPath+testCVE, r.URL.Path)
+			_, _ = w.Write([]byte(testOSVBody))
+		}))
+		defer server.Close()
+
+		advisory, err := NewOSVClient(newTestOptions(server.URL)).GetAdvisory(context.Background(), "cve-2021-44228")
+
+		assert.NoError(t, err)
+		assert.Equal(t, testGHSA, advisory.ID)
+		assert.Equal(t, []string{testCVE}, advisory.Aliases)
+		assert.Equal(t, "CRITICAL", advisory.Severity)
+		assert.Equal(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H", advisory.CVSSVector)
+		assert.Equal(t, []string{"2.15.0"}, advisory.FixedVersions)
+		assert.Equal(t, []string{">=2.0.0, <2.15.0", ">=2.13.0, <2.15.0"}, advisory.AffectedRanges)
+		assert.Equal(t, []string{"https://logging.apache.org/log4j/2.x/security.html"}, advisory.References)
+		assert.Equal(t, []enums.Source{enums.SourceOSV}, advisory.Sources)
+		assert.Equal(t, 2021, advisory.Published.Year())
+	})
+
+	t.Run("should return not found when the api returns not found", func(t *testing.T) {
+		server := httptest.NewServer(http.NotFoundHandler())
+		defer server.Close()
+
+		_, err := NewOSVClient(newTestOptions(server.URL)).GetAdvisory(context.Background(), testCVE)
+
+		assert.ErrorIs(t, err, enums.ErrorAdvisoryNotFound)
+	})
+
+	t.Run("should return rate limited when the api returns too many requests", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		_, err := NewOSVClient(newTestOptions(server.URL)).GetAdvisory(context.Background(), testCVE)
+
+		assert.ErrorIs(t, err, enums.ErrorRateLimited)
+	})
+
+	t.Run("should return request failed when the api fails", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		_, err := NewOSVClient(newTestOptions(server.URL)).GetAdvisory(context.Background(), testCVE)
+
+		assert.ErrorIs(t, err, enums.ErrorRequestFailed)
+	})
+
+	t.Run("should return invalid advisory when the body is not json", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte("not json"))
+		}))
+		defer server.Close()
+
+		_, err := NewOSVClient(newTestOptions(server.URL)).GetAdvisory(context.Background(), testCVE)
+
+		assert.ErrorIs(t, err, enums.ErrorInvalidAdvisory)
+	})
+}
+
+func TestNVDClient(t *testing.T) {
+	t.Run("should return the advisory of the nvd api sending the api key", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, testCVE, r.URL.Query().Get("cveId"))
+			assert.Equal(t, "key", r.Header.Get(enums.HeaderAPIKey))
+			_, _ = w.Write([]byte(testNVDBody))
+		}))
+		defer server.Close()
+
+		options := newTestOptions(server.URL)
+		options.NVDAPIKey = "key"
+
+		advisory, err := NewNVDClient(options).GetAdvisory(context.Background(), testCVE)
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCVE, advisory.ID)
+		assert.Equal(t, "Apache Log4j2 JNDI", advisory.Details)
+		assert.Equal(t, "CRITICAL", advisory.Severity)
+		assert.Equal(t, 10.0, advisory.CVSSScore)
+		assert.Equal(t, []string{"2.12.2", "2.15.0"}, advisory.FixedVersions)
+		assert.Equal(t, []string{">=2.13.0, <2.15.0", "<=1.2.0"}, advisory.AffectedRanges)
+		assert.Len(t, advisory.References, 2)
+		assert.Equal(t, time.Date(2021, 12, 10, 10, 15, 9, 143000000, time.UTC), advisory.Published)
+	})
+
+	t.Run("should return not found when the nvd has no results", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"totalResults": 0, "vulnerabilities": []}`))
+		}))
+		defer server.Close()
+
+		_, err := NewNVDClient(newTestOptions(server.URL)).GetAdvisory(context.Background(), testCVE)
+
+		assert.ErrorIs(t, err, enums.ErrorAdvisoryNotFound)
+	})
+
+	t.Run("should return not found without request when the id is not a cve", func(t *testing.T) {
+		_, err := NewNVDClient(newTestOptions("http://invalid")).GetAdvisory(context.Background(), testGHSA)
+
+		assert.ErrorIs(t, err, enums.ErrorAdvisoryNotFound)
+	})
+}
+
+func TestOfflineClient(t *testing.T) {
+	t.Run("should return the advisories of the directory by id and alias", func(t *testing.T) {
+		path := t.TempDir()
+		assert.NoError(t, os.MkdirAll(filepath.Join(path, "maven"), 0o750))
+		assert.NoError(t, os.WriteFile(filepath.Join(path, "maven", testGHSA+".json"), []byte(testOSVBody), 0o600))
+		assert.NoError(t, os.WriteFile(filepath.Join(path, "invalid.json"), []byte("invalid"), 0o600))
+		assert.NoError(t, os.WriteFile(filepath.Join(path, "README.md"), []byte("invalid"), 0o600))
+
+		client, err := NewOfflineClient(path)
+		assert.NoError(t, err)
+		assert.Equal(t, enums.SourceOffline, client.Source())
+
+		advisory, err := client.GetAdvisory(context.Background(), "cve-2021-44228")
+		assert.NoError(t, err)
+		assert.Equal(t, testGHSA, advisory.ID)
+		assert.Equal(t, []enums.Source{enums.SourceOffline}, advisory.Sources)
+
+		advisory, err = client.GetAdvisory(context.Background(), testGHSA)
+		assert.NoError(t, err)
+		assert.Equal(t, testGHSA, advisory.ID)
+
+		_, err = client.GetAdvisory(context.Background(), "CVE-2021-45046")
+		assert.ErrorIs(t, err, enums.ErrorAdvisoryNotFound)
+	})
+
+	t.Run("should return error when the directory does not exist", func(t *testing.T) {
+		_, err := NewOfflineClient(filepath.Join(t.TempDir(), "missing"))
+
+		assert.Error(t, err)
+	})
+}
+
+func TestCachedClient(t *testing.T) {
+	newCache := func() ttl.ICache[string, *entities.Advisory] {
+		return ttl.NewCache[string, *entities.Advisory](ttl.NewOptions("enrichment_test"))
+	}
+
+	t.Run("should return the cached advisory and not found", func(t *testing.T) {
+		client := newClientMock(enums.SourceNVD,
+			map[string]*entities.Advisory{testCVE: {ID: testCVE}}, enums.ErrorAdvisoryNotFound)
+		cached := NewCachedClient(client, newCache())
+
+		for i := 0; i < 2; i++ {
+			advisory, err := cached.GetAdvisory(context.Background(), "cve-2021-44228")
+			assert.NoError(t, err)
+			assert.Equal(t, testCVE, advisory.ID)
+
+			_, err = cached.GetAdvisory(context.Background(), "CVE-2021-45046")
+			assert.ErrorIs(t, err, enums.ErrorAdvisoryNotFound)
+		}
+
+		assert.Equal(t, enums.SourceNVD, cached.Source())
+		client.AssertNumberOfCalls(t, "GetAdvisory", 2)
+	})
+
+	t.Run("should not cache the errors of the client", func(t *testing.T) {
+		client := newClientMock(enums.SourceNVD, nil, enums.ErrorRateLimited)
+		cached := NewCachedClient(client, newCache())
+
+		for i := 0; i < 2; i++ {
+			_, err := cached.GetAdvisory(context.Background(), testCVE)
+			assert.ErrorIs(t, err, enums.ErrorRateLimited)
+		}
+
+		client.AssertNumberOfCalls(t, "GetAdvisory", 2)
+	})
+}
+
+func TestOSVRange(t *testing.T) {
+	t.Run("should pair the introduced events with the fixed and last affected events", func(t *testing.T) {
+		versionRange := &osvRange{Events: []map[string]string{{"introduced": "0"}, {"fixed": "1.0.1"},
+			{"introduced": "v1.2"}, {"last_affected": "1.3.0"}, {"introduced": "2.0.0-rc.1"}, {"introduced": "3.0.0"}}}
+
+		assert.Equal(t, []string{">=0.0.0, <1.0.1", ">=1.2.0, <=1.3.0", ">=2.0.0-rc.1", ">=3.0.0"},
+			versionRange.constraints())
+	})
+
+	t.Run("should ignore the git ranges and the versions that are not semantic versions", func(t *testing.T) {
+		vulnerability := &osvVulnerability{Affected: []osvAffected{{Ranges: []osvRange{
+			{Type: "GIT", Events: []map[string]string{{"introduced": "0"}, {"fixed": "1"}}},
+			{Type: "ECOSYSTEM", Events: []map[string]string{{"introduced": "1.0.post1"}, {"fixed": "1.1"}}},
+		}}}}
+
+		assert.Empty(t, vulnerability.affectedRanges())
+	})
+}
+
+func TestAdvisoryAffects(t *testing.T) {
+	advisory := &entities.Advisory{AffectedRanges: []string{">=2.0.0, <2.15.0", ">=3.0.0-rc.1, <=3.0.1"}}
+
+	t.Run("should return if the version is inside some affected range", func(t *testing.T) {
+		for version, expected := range map[string]bool{"1.9.9": false, "2.0.0": true, "v2.14.1": true,
+			"2.15.0": false, "2.15.0-rc.2": true, "3.0.0-rc.2": true, "3.0.1": true, "3.0.2": false} {
+			affected, err := advisory.Affects(version)
+
+			assert.NoError(t, err)
+			assert.Equal(t, expected, affected, version)
+		}
+	})
+
+	t.Run("should return if some advisory of the enriched vulnerability affects the version", func(t *testing.T) {
+		enriched := &entities.Enriched{Advisories: []*entities.Advisory{{}, advisory}}
+
+		affected, err := enriched.Affects("2.14.1")
+
+		assert.NoError(t, err)
+		assert.True(t, affected)
+
+		affected, err = enriched.Affects("2.15.0")
+
+		assert.NoError(t, err)
+		assert.False(t, affected)
+	})
+
+	t.Run("should return error when the version or the range are invalid", func(t *testing.T) {
+		_, err := advisory.Affects("invalid")
+		assert.ErrorIs(t, err, semverEnums.ErrorInvalidVersion)
+
+		_, err = (&entities.Enriched{Advisories: []*entities.Advisory{{AffectedRanges: []string{"<a"}}}}).Affects("1.0.0")
+		assert.ErrorIs(t, err, semverEnums.ErrorInvalidConstraint)
+	})
+}
+
+func TestEnricher(t *testing.T) {
+	osvAdvisory := &entities.Advisory{ID: testGHSA, Aliases: []string{testCVE}, Summary: "Log4Shell",
+		FixedVersions: []string{"2.15.0"}, AffectedRanges: []string{">=2.0.0, <2.15.0"},
+		Sources: []enums.Source{enums.SourceOSV}}
+	nvdAdvisory := &entities.Advisory{ID: testCVE, Details: "Apache Log4j2", CVSSScore: 10,
+		FixedVersions: []string{"2.12.2", "2.15.0"}, AffectedRanges: []string{">=2.13.0, <2.15.0"},
+		Sources: []enums.Source{enums.SourceNVD}}
+
+	t.Run("should merge the advisories of all clients", func(t *testing.T) {
+		osv := newClientMock(enums.SourceOSV, map[string]*entities.Advisory{testCVE: osvAdvisory}, nil)
+		nvd := newClientMock(enums.SourceNVD, map[string]*entities.Advisory{testCVE: nvdAdvisory}, nil)
+
+		advisory, err := NewEnricher(osv, nvd).GetAdvisory(context.Background(), testCVE)
+
+		assert.NoError(t, err)
+		assert.Equal(t, testGHSA, advisory.ID)
+		assert.Equal(t, []string{testCVE}, advisory.Aliases)
+		assert.Equal(t, "Log4Shell", advisory.Summary)
+		assert.Equal(t, "Apache Log4j2", advisory.Details)
+		assert.Equal(t, 10.0, advisory.CVSSScore)
+		assert.Equal(t, []string{"2.15.0", "2.12.2"}, advisory.FixedVersions)
+		assert.Equal(t, []string{">=2.0.0, <2.15.0", ">=2.13.0, <2.15.0"}, advisory.AffectedRanges)
+		assert.Equal(t, []enums.Source{enums.SourceOSV, enums.SourceNVD}, advisory.Sources)
+		assert.Equal(t, []string{"2.15.0"}, osvAdvisory.FixedVersions)
+	})
+
+	t.Run("should return the advisory when a client fails", func(t *testing.T) {
+		osv := newClientMock(enums.SourceOSV, nil, enums.ErrorRequestFailed)
+		nvd := newClientMock(enums.SourceNVD, map[string]*entities.Advisory{testCVE: nvdAdvisory}, nil)
+
+		advisory, err := NewEnricher(osv, nvd).GetAdvisory(context.Background(), testCVE)
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCVE, advisory.ID)
+	})
+
+	t.Run("should return the error of the clients when no advisory was found", func(t *testing.T) {
+		osv := newClientMock(enums.SourceOSV, nil, enums.ErrorAdvisoryNotFound)
+		nvd := newClientMock(enums.SourceNVD, nil, enums.ErrorRateLimited)
+
+		_, err := NewEnricher(osv, nvd).GetAdvisory(context.Background(), testCVE)
+		assert.ErrorIs(t, err, enums.ErrorRateLimited)
+
+		_, err = NewEnricher(osv).GetAdvisory(context.Background(), testCVE)
+		assert.ErrorIs(t, err, enums.ErrorAdvisoryNotFound)
+	})
+
+	t.Run("should enrich the vulnerabilities skipping aliases and unknown ids", func(t *testing.T) {
+		osv := newClientMock(enums.SourceOSV, map[string]*entities.Advisory{testCVE: osvAdvisory},
+			enums.ErrorAdvisoryNotFound)
+		vulnerabilities := []*vulnerability.Vulnerability{
+			{Details: "log4j-core 2.14.1 is vulnerable to CVE-2021-44228 (GHSA-jfh8-c2jp-5v3q), see CVE-2021-45046"},
+			{Details: "Asymmetric Private Key Found"},
+		}
+
+		enriched, err := NewEnricher(osv).EnrichAll(context.Background(), vulnerabilities)
+
+		assert.NoError(t, err)
+		assert.Len(t, enriched, 2)
+		assert.Len(t, enriched[0].Advisories, 1)
+		assert.Equal(t, []string{"2.15.0"}, enriched[0].FixedVersions())
+		assert.Empty(t, enriched[1].Advisories)
+		osv.AssertNumberOfCalls(t, "GetAdvisory", 2)
+	})
+
+	t.Run("should return the error of a canceled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		osv := newClientMock(enums.SourceOSV, nil, ctx.Err())
+
+		_, err := NewEnricher(osv).Enrich(ctx, &vulnerability.Vulnerability{Details: testCVE})
+
+		assert.True(t, errors.Is(err, context.Canceled))
+	})
+}
+
+func TestNewEnricherFromOptions(t *testing.T) {
+	t.Run("should return an enricher with the online clients", func(t *testing.T) {
+		enricher, err := NewEnricherFromOptions(newTestOptions("http://localhost"))
+
+		assert.NoError(t, err)
+		assert.Len(t, enricher.(*Enricher).clients, 2)
+		assert.IsType(t, &CachedClient{}, enricher.(*Enricher).clients[0])
+	})
+
+	t.Run("should return an enricher with the offline client", func(t *testing.T) {
+		options := newTestOptions("http://localhost")
+		options.OfflinePath = t.TempDir()
+
+		enricher, err := NewEnricherFromOptions(options)
+
+		assert.NoError(t, err)
+		assert.IsType(t, &OfflineClient{}, enricher.(*Enricher).clients[0])
+	})
+
+	t.Run("should return error when the offline path does not exist", func(t *testing.T) {
+		options := newTestOptions("http://localhost")
+		options.OfflinePath = filepath.Join(t.TempDir(), "missing")
+
+		_, err := NewEnricherFromOptions(options)
+
+		assert.Error(t, err)
+	})
+}
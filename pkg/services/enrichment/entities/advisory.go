@@ -0,0 +1,145 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entities
+
+import (
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/enrichment/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/semver"
+)
+
+// Advisory contains the details of a published vulnerability. The sources contains every database that contributed
+// to the advisory when it was merged from more than one of them.
+type Advisory struct {
+	ID            string   `json:"id"`
+	Aliases       []string `json:"aliases,omitempty"`
+	Summary       string   `json:"summary,omitempty"`
+	Details       string   `json:"details,omitempty"`
+	Severity      string   `json:"severity,omitempty"`
+	CVSSScore     float64  `json:"cvssScore,omitempty"`
+	CVSSVector    string   `json:"cvssVector,omitempty"`
+	References    []string `json:"references,omitempty"`
+	FixedVersions []string `json:"fixedVersions,omitempty"`
+	// AffectedRanges contains the semantic version constraints of the vulnerable versions, like >=2.0.0, <2.15.0
+	AffectedRanges []string       `json:"affectedRanges,omitempty"`
+	Published      time.Time      `json:"published,omitempty"`
+	Modified       time.Time      `json:"modified,omitempty"`
+	Sources        []enums.Source `json:"sources,omitempty"`
+}
+
+// IDs returns the id of the advisory followed by its aliases
+func (a *Advisory) IDs() []string {
+	return append([]string{a.ID}, a.Aliases...)
+}
+
+// Affects returns true when the version is inside any of the affected ranges. The prereleases are compared by their
+// precedence, since a prerelease of a vulnerable version is vulnerable too
+func (a *Advisory) Affects(version string) (bool, error) {
+	parsed, err := semver.Parse(version)
+	if err != nil {
+		return false, err
+	}
+
+	for _, affectedRange := range a.AffectedRanges {
+		constraint, err := semver.ParseConstraint(affectedRange)
+		if err != nil {
+			return false, err
+		}
+
+		constraint.IncludePrerelease = true
+		if constraint.Check(parsed) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Merge fills the empty fields of the advisory with the ones of the other advisory and joins the aliases,
+// references, fixed versions, affected ranges and sources of both without duplicates
+func (a *Advisory) Merge(other *Advisory) {
+	if other == nil {
+		return
+	}
+
+	a.mergeDescription(other)
+	a.mergeScore(other)
+	a.Aliases = union(a.Aliases, other.IDs())
+	a.Aliases = remove(a.Aliases, a.ID)
+	a.References = union(a.References, other.References)
+	a.FixedVersions = union(a.FixedVersions, other.FixedVersions)
+	a.AffectedRanges = union(a.AffectedRanges, other.AffectedRanges)
+	a.Sources = union(a.Sources, other.Sources)
+}
+
+func (a *Advisory) mergeDescription(other *Advisory) {
+	if a.Summary == "" {
+		a.Summary = other.Summary
+	}
+
+	if a.Details == "" {
+		a.Details = other.Details
+	}
+
+	if a.Published.IsZero() {
+		a.Published = other.Published
+	}
+
+	if a.Modified.IsZero() {
+		a.Modified = other.Modified
+	}
+}
+
+func (a *Advisory) mergeScore(other *Advisory) {
+	if a.Severity == "" {
+		a.Severity = other.Severity
+	}
+
+	if a.CVSSScore == 0 {
+		a.CVSSScore = other.CVSSScore
+	}
+
+	if a.CVSSVector == "" {
+		a.CVSSVector = other.CVSSVector
+	}
+}
+
+func union[T comparable](values, others []T) []T {
+	seen := make(map[T]bool, len(values))
+	for _, value := range values {
+		seen[value] = true
+	}
+
+	for _, value := range others {
+		if !seen[value] {
+			seen[value] = true
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+func remove[T comparable](values []T, target T) []T {
+	result := values[:0]
+	for _, value := range values {
+		if value != target {
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
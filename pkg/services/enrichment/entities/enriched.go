@@ -0,0 +1,54 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entities
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+)
+
+// Enriched is a vulnerability with the advisories of the cve and ghsa ids found on its details
+type Enriched struct {
+	Vulnerability *vulnerability.Vulnerability `json:"vulnerability"`
+	Advisories    []*Advisory                  `json:"advisories,omitempty"`
+}
+
+// FixedVersions returns the fixed versions of all advisories of the vulnerability
+func (e *Enriched) FixedVersions() (versions []string) {
+	for _, advisory := range e.Advisories {
+		versions = union(versions, advisory.FixedVersions)
+	}
+
+	return versions
+}
+
+// Affects returns true when the version is affected by any advisory of the vulnerability
+func (e *Enriched) Affects(version string) (bool, error) {
+	for _, advisory := range e.Advisories {
+		if affected, err := advisory.Affects(version); err != nil || affected {
+			return affected, err
+		}
+	}
+
+	return false, nil
+}
+
+// References returns the references of all advisories of the vulnerability
+func (e *Enriched) References() (references []string) {
+	for _, advisory := range e.Advisories {
+		references = union(references, advisory.References)
+	}
+
+	return references
+}
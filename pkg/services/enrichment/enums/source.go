@@ -0,0 +1,46 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+// Source is the vulnerability database that returned an advisory
+type Source string
+
+const (
+	SourceOSV     Source = "osv"
+	SourceNVD     Source = "nvd"
+	SourceOffline Source = "offline"
+)
+
+func SourceValues() []Source {
+	return []Source{
+		SourceOSV,
+		SourceNVD,
+		SourceOffline,
+	}
+}
+
+func (s Source) ToString() string {
+	return string(s)
+}
+
+func (s Source) IsValid() bool {
+	for _, value := range SourceValues() {
+		if s == value {
+			return true
+		}
+	}
+
+	return false
+}
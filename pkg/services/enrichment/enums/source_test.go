@@ -0,0 +1,36 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSource(t *testing.T) {
+	t.Run("should return all sources", func(t *testing.T) {
+		assert.Len(t, SourceValues(), 3)
+	})
+
+	t.Run("should return source as string", func(t *testing.T) {
+		assert.Equal(t, "nvd", SourceNVD.ToString())
+	})
+
+	t.Run("should validate sources", func(t *testing.T) {
+		assert.True(t, SourceOffline.IsValid())
+		assert.False(t, Source("github").IsValid())
+	})
+}
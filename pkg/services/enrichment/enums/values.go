@@ -0,0 +1,61 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+import "time"
+
+const (
+	HorusecEnrichmentOSVURL         = "HORUSEC_ENRICHMENT_OSV_URL"
+	HorusecEnrichmentNVDURL         = "HORUSEC_ENRICHMENT_NVD_URL"
+	HorusecEnrichmentNVDAPIKey      = "HORUSEC_ENRICHMENT_NVD_API_KEY"
+	HorusecEnrichmentOfflinePath    = "HORUSEC_ENRICHMENT_OFFLINE_PATH"
+	HorusecEnrichmentTimeoutSeconds = "HORUSEC_ENRICHMENT_TIMEOUT_SECONDS"
+	HorusecEnrichmentCacheTTL       = "HORUSEC_ENRICHMENT_CACHE_TTL"
+
+	DefaultOSVURL         = "https://api.osv.dev"
+	DefaultNVDURL         = "https://services.nvd.nist.gov"
+	DefaultTimeoutSeconds = 10
+	DefaultCacheTTL       = 24 * time.Hour
+	DefaultMaxEntries     = 10000
+
+	// the nvd allows 5 requests on a rolling window of 30 seconds without api key and 50 with it, while osv does not
+	// publish a limit, so a conservative one is used
+	NVDRequestsWithoutKey = 5
+	NVDRequestsWithKey    = 50
+	NVDRateWindow         = 30 * time.Second
+	OSVRequests           = 20
+	OSVRateWindow         = time.Second
+
+	OSVVulnerabilityPath = "/v1/vulns/"
+	NVDCVEPath           = "/rest/json/cves/2.0?cveId="
+	HeaderAPIKey         = "apiKey"
+	HeaderAccept         = "Accept"
+	ContentTypeJSON      = "application/json"
+
+	OSVSeverityCVSSV3    = "CVSS_V3"
+	OSVEventFixed        = "fixed"
+	OSVEventIntroduced   = "introduced"
+	OSVEventLastAffected = "last_affected"
+	OSVRangeGit          = "GIT"
+	NVDLanguage          = "en"
+	NVDTimeLayout        = "2006-01-02T15:04:05.999"
+	OfflineExtension     = ".json"
+	CacheName            = "enrichment_advisories"
+	PrefixCVE            = "CVE-"
+	PrefixGHSA           = "GHSA-"
+
+	// AdvisoryIDPattern matches the cve and github advisory ids on the details of the vulnerabilities
+	AdvisoryIDPattern = `(?i)\b(CVE-\d{4}-\d{4,}|GHSA(?:-[23456789cfghjmpqrvwx]{4}){3})\b`
+)
@@ -0,0 +1,79 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enrichment
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+)
+
+// ILimiter spaces the requests to a vulnerability database to respect its rate limit
+type ILimiter interface {
+	Wait(ctx context.Context) error
+}
+
+// Limiter allows a number of requests by window, evenly spaced, so a burst of requests never exceeds the limit of
+// the database on a rolling window
+type Limiter struct {
+	mutex    sync.Mutex
+	clock    clock.IClock
+	interval time.Duration
+	next     time.Time
+}
+
+func NewLimiter(requests int, window time.Duration, clk clock.IClock) ILimiter {
+	if requests <= 0 {
+		requests = 1
+	}
+
+	return &Limiter{
+		clock:    clock.OrDefault(clk),
+		interval: window / time.Duration(requests),
+	}
+}
+
+// Wait blocks until the request is allowed or the context is done. A canceled request keeps its reserved slot,
+// which only delays the following requests by one interval.
+func (l *Limiter) Wait(ctx context.Context) error {
+	delay := l.reserve()
+	if delay <= 0 {
+		return ctx.Err()
+	}
+
+	select {
+	case <-l.clock.After(delay):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (l *Limiter) reserve() time.Duration {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := l.clock.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+
+	slot := l.next
+	l.next = slot.Add(l.interval)
+
+	return slot.Sub(now)
+}
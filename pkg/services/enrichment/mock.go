@@ -0,0 +1,65 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enrichment
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/services/enrichment/entities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/enrichment/enums"
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) GetAdvisory(_ context.Context, id string) (*entities.Advisory, error) {
+	args := m.MethodCalled("GetAdvisory", id)
+
+	return args.Get(0).(*entities.Advisory), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) Enrich(_ context.Context, _ *vulnerability.Vulnerability) (*entities.Enriched, error) {
+	args := m.MethodCalled("Enrich")
+
+	return args.Get(0).(*entities.Enriched), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) EnrichAll(_ context.Context,
+	_ []*vulnerability.Vulnerability) ([]*entities.Enriched, error) {
+	args := m.MethodCalled("EnrichAll")
+
+	return args.Get(0).([]*entities.Enriched), mockUtils.ReturnNilOrError(args, 1)
+}
+
+type ClientMock struct {
+	mock.Mock
+}
+
+func (m *ClientMock) Source() enums.Source {
+	args := m.MethodCalled("Source")
+
+	return args.Get(0).(enums.Source)
+}
+
+func (m *ClientMock) GetAdvisory(_ context.Context, id string) (*entities.Advisory, error) {
+	args := m.MethodCalled("GetAdvisory", id)
+
+	return args.Get(0).(*entities.Advisory), mockUtils.ReturnNilOrError(args, 1)
+}
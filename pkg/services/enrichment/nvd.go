@@ -0,0 +1,210 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enrichment
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/enrichment/entities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/enrichment/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/request"
+)
+
+type nvdResponse struct {
+	Vulnerabilities []struct {
+		CVE nvdCVE `json:"cve"`
+	} `json:"vulnerabilities"`
+}
+
+type nvdCVE struct {
+	ID           string `json:"id"`
+	Published    string `json:"published"`
+	LastModified string `json:"lastModified"`
+	Descriptions []struct {
+		Lang  string `json:"lang"`
+		Value string `json:"value"`
+	} `json:"descriptions"`
+	Metrics struct {
+		CVSSMetricV31 []nvdMetric `json:"cvssMetricV31"`
+		CVSSMetricV30 []nvdMetric `json:"cvssMetricV30"`
+	} `json:"metrics"`
+	References []struct {
+		URL string `json:"url"`
+	} `json:"references"`
+	Configurations []struct {
+		Nodes []struct {
+			CPEMatch []struct {
+				Vulnerable            bool   `json:"vulnerable"`
+				VersionStartIncluding string `json:"versionStartIncluding"`
+				VersionStartExcluding string `json:"versionStartExcluding"`
+				VersionEndIncluding   string `json:"versionEndIncluding"`
+				VersionEndExcluding   string `json:"versionEndExcluding"`
+			} `json:"cpeMatch"`
+		} `json:"nodes"`
+	} `json:"configurations"`
+}
+
+type nvdMetric struct {
+	CVSSData struct {
+		BaseScore    float64 `json:"baseScore"`
+		BaseSeverity string  `json:"baseSeverity"`
+		VectorString string  `json:"vectorString"`
+	} `json:"cvssData"`
+}
+
+// NVDClient queries the cve api 2.0 of the nvd, which only knows cve ids, so any other id is not found without a
+// request
+type NVDClient struct {
+	httpClient
+	url string
+}
+
+func NewNVDClient(options *Options) IClient {
+	client := &NVDClient{
+		url: strings.TrimSuffix(options.NVDURL, "/"),
+		httpClient: httpClient{
+			request: request.NewHTTPRequestService(options.Timeout),
+			limiter: NewLimiter(options.nvdRequests(), enums.NVDRateWindow, options.Clock),
+		},
+	}
+
+	if options.NVDAPIKey != "" {
+		client.headers = map[string]string{enums.HeaderAPIKey: options.NVDAPIKey}
+	}
+
+	return client
+}
+
+func (c *NVDClient) Source() enums.Source {
+	return enums.SourceNVD
+}
+
+func (c *NVDClient) GetAdvisory(ctx context.Context, id string) (*entities.Advisory, error) {
+	id = NormalizeID(id)
+	if !isCVE(id) {
+		return nil, enums.ErrorAdvisoryNotFound
+	}
+
+	response := &nvdResponse{}
+	if err := c.get(ctx, c.url+enums.NVDCVEPath+url.QueryEscape(id), response); err != nil {
+		return nil, err
+	}
+
+	if len(response.Vulnerabilities) == 0 {
+		return nil, enums.ErrorAdvisoryNotFound
+	}
+
+	return response.Vulnerabilities[0].CVE.toAdvisory(), nil
+}
+
+func (c *nvdCVE) toAdvisory() *entities.Advisory {
+	advisory := &entities.Advisory{
+		ID:             c.ID,
+		Details:        c.description(),
+		FixedVersions:  c.fixedVersions(),
+		AffectedRanges: c.affectedRanges(),
+		Published:      parseNVDTime(c.Published),
+		Modified:       parseNVDTime(c.LastModified),
+		Sources:        []enums.Source{enums.SourceNVD},
+	}
+
+	if metric := c.metric(); metric != nil {
+		advisory.Severity = metric.CVSSData.BaseSeverity
+		advisory.CVSSScore = metric.CVSSData.BaseScore
+		advisory.CVSSVector = metric.CVSSData.VectorString
+	}
+
+	for _, reference := range c.References {
+		advisory.References = append(advisory.References, reference.URL)
+	}
+
+	return advisory
+}
+
+func (c *nvdCVE) description() string {
+	for _, description := range c.Descriptions {
+		if description.Lang == enums.NVDLanguage {
+			return description.Value
+		}
+	}
+
+	return ""
+}
+
+// metric returns the first cvss v3.1 metric, falling back to the v3.0 one for older cves
+func (c *nvdCVE) metric() *nvdMetric {
+	for _, metrics := range [][]nvdMetric{c.Metrics.CVSSMetricV31, c.Metrics.CVSSMetricV30} {
+		if len(metrics) > 0 {
+			return &metrics[0]
+		}
+	}
+
+	return nil
+}
+
+// fixedVersions returns the versions excluded from the end of the vulnerable ranges, which are the first versions
+// released with the fix
+func (c *nvdCVE) fixedVersions() (versions []string) {
+	seen := map[string]bool{}
+
+	for _, configuration := range c.Configurations {
+		for _, node := range configuration.Nodes {
+			for _, match := range node.CPEMatch {
+				if match.Vulnerable && match.VersionEndExcluding != "" && !seen[match.VersionEndExcluding] {
+					seen[match.VersionEndExcluding] = true
+					versions = append(versions, match.VersionEndExcluding)
+				}
+			}
+		}
+	}
+
+	return versions
+}
+
+// affectedRanges returns the semantic version constraints of the vulnerable matches limited by some version, since the
+// matches without limits refer to the exact version of the cpe
+func (c *nvdCVE) affectedRanges() (ranges []string) {
+	seen := map[string]bool{}
+
+	for _, configuration := range c.Configurations {
+		for _, node := range configuration.Nodes {
+			for _, match := range node.CPEMatch {
+				constraint := affectedRange([2]string{">=", match.VersionStartIncluding},
+					[2]string{">", match.VersionStartExcluding}, [2]string{"<", match.VersionEndExcluding},
+					[2]string{"<=", match.VersionEndIncluding})
+
+				if match.Vulnerable && constraint != "" && !seen[constraint] {
+					seen[constraint] = true
+					ranges = append(ranges, constraint)
+				}
+			}
+		}
+	}
+
+	return ranges
+}
+
+// parseNVDTime parses the timestamps of the nvd, which are sent without time zone and are on utc
+func parseNVDTime(value string) time.Time {
+	parsed, err := time.Parse(enums.NVDTimeLayout, value)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return parsed
+}
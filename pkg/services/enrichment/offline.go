@@ -0,0 +1,91 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enrichment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/enrichment/entities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/enrichment/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+// OfflineClient answers from a directory of osv json files, such as the extracted exports of the osv database, to
+// enrich vulnerabilities without network access. The advisories are indexed by their ids and aliases when loaded.
+type OfflineClient struct {
+	advisories map[string]*entities.Advisory
+}
+
+// NewOfflineClient loads every json file found on the directory and its subdirectories. The files that are not valid
+// osv vulnerabilities are logged and skipped, so a single broken file does not disable the whole database.
+func NewOfflineClient(path string) (IClient, error) {
+	client := &OfflineClient{advisories: map[string]*entities.Advisory{}}
+
+	err := filepath.WalkDir(path, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() || !strings.EqualFold(filepath.Ext(filePath), enums.OfflineExtension) {
+			return err
+		}
+
+		if err := client.load(filePath); err != nil {
+			logger.LogError(enums.MessageFailedToLoadOfflineFile, err, map[string]interface{}{"file": filePath})
+		}
+
+		return nil
+	})
+
+	return client, err
+}
+
+func (c *OfflineClient) load(filePath string) error {
+	content, err := os.ReadFile(filepath.Clean(filePath))
+	if err != nil {
+		return err
+	}
+
+	vulnerability := &osvVulnerability{}
+	if err := json.Unmarshal(content, vulnerability); err != nil {
+		return fmt.Errorf("%w: %s", enums.ErrorInvalidAdvisory, err.Error())
+	}
+
+	if vulnerability.ID == "" {
+		return enums.ErrorInvalidAdvisory
+	}
+
+	advisory := vulnerability.toAdvisory(enums.SourceOffline)
+	for _, id := range advisory.IDs() {
+		c.advisories[NormalizeID(id)] = advisory
+	}
+
+	return nil
+}
+
+func (c *OfflineClient) Source() enums.Source {
+	return enums.SourceOffline
+}
+
+func (c *OfflineClient) GetAdvisory(_ context.Context, id string) (*entities.Advisory, error) {
+	advisory, ok := c.advisories[NormalizeID(id)]
+	if !ok {
+		return nil, enums.ErrorAdvisoryNotFound
+	}
+
+	return advisory, nil
+}
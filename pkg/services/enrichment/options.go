@@ -0,0 +1,57 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enrichment
+
+import (
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/enrichment/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// Options configures the vulnerability databases queried by the enricher. When the offline path is set, only the
+// directory of osv json files is used, so the enrichment works without network access. A cache ttl lower or equal
+// than zero disables the cache of the advisories.
+type Options struct {
+	OSVURL      string
+	NVDURL      string
+	NVDAPIKey   string
+	OfflinePath string
+	Timeout     int
+	CacheTTL    time.Duration
+	Clock       clock.IClock
+}
+
+func NewOptions() *Options {
+	return &Options{
+		OSVURL:      env.GetEnvOrDefault(enums.HorusecEnrichmentOSVURL, enums.DefaultOSVURL),
+		NVDURL:      env.GetEnvOrDefault(enums.HorusecEnrichmentNVDURL, enums.DefaultNVDURL),
+		NVDAPIKey:   env.GetEnvOrDefault(enums.HorusecEnrichmentNVDAPIKey, ""),
+		OfflinePath: env.GetEnvOrDefault(enums.HorusecEnrichmentOfflinePath, ""),
+		Timeout:     env.GetEnvOrDefaultInt(enums.HorusecEnrichmentTimeoutSeconds, enums.DefaultTimeoutSeconds),
+		CacheTTL:    env.GetDuration(enums.HorusecEnrichmentCacheTTL, enums.DefaultCacheTTL),
+		Clock:       clock.NewClock(),
+	}
+}
+
+// nvdRequests returns how many requests the nvd allows on its rate window, which is higher with an api key
+func (o *Options) nvdRequests() int {
+	if o.NVDAPIKey != "" {
+		return enums.NVDRequestsWithKey
+	}
+
+	return enums.NVDRequestsWithoutKey
+}
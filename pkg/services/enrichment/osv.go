@@ -0,0 +1,191 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enrichment
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/enrichment/entities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/enrichment/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/request"
+)
+
+// osvVulnerability is the osv schema, used by the osv api and by the files of the offline database
+type osvVulnerability struct {
+	ID               string          `json:"id"`
+	Aliases          []string        `json:"aliases"`
+	Summary          string          `json:"summary"`
+	Details          string          `json:"details"`
+	Published        time.Time       `json:"published"`
+	Modified         time.Time       `json:"modified"`
+	Severity         []osvSeverity   `json:"severity"`
+	Affected         []osvAffected   `json:"affected"`
+	References       []osvReference  `json:"references"`
+	DatabaseSpecific osvDatabaseInfo `json:"database_specific"`
+}
+
+type osvSeverity struct {
+	Type  string `json:"type"`
+	Score string `json:"score"`
+}
+
+type osvAffected struct {
+	Ranges []osvRange `json:"ranges"`
+}
+
+type osvRange struct {
+	Type   string              `json:"type"`
+	Events []map[string]string `json:"events"`
+}
+
+type osvReference struct {
+	URL string `json:"url"`
+}
+
+type osvDatabaseInfo struct {
+	Severity string `json:"severity"`
+}
+
+type OSVClient struct {
+	httpClient
+	url string
+}
+
+func NewOSVClient(options *Options) IClient {
+	return &OSVClient{
+		url: strings.TrimSuffix(options.OSVURL, "/"),
+		httpClient: httpClient{
+			request: request.NewHTTPRequestService(options.Timeout),
+			limiter: NewLimiter(enums.OSVRequests, enums.OSVRateWindow, options.Clock),
+		},
+	}
+}
+
+func (c *OSVClient) Source() enums.Source {
+	return enums.SourceOSV
+}
+
+func (c *OSVClient) GetAdvisory(ctx context.Context, id string) (*entities.Advisory, error) {
+	vulnerability := &osvVulnerability{}
+
+	if err := c.get(ctx, c.url+enums.OSVVulnerabilityPath+NormalizeID(id), vulnerability); err != nil {
+		return nil, err
+	}
+
+	return vulnerability.toAdvisory(enums.SourceOSV), nil
+}
+
+func (v *osvVulnerability) toAdvisory(source enums.Source) *entities.Advisory {
+	advisory := &entities.Advisory{
+		ID:             v.ID,
+		Aliases:        v.Aliases,
+		Summary:        v.Summary,
+		Details:        v.Details,
+		Severity:       strings.ToUpper(v.DatabaseSpecific.Severity),
+		CVSSVector:     v.cvssVector(),
+		FixedVersions:  v.fixedVersions(),
+		AffectedRanges: v.affectedRanges(),
+		Published:      v.Published,
+		Modified:       v.Modified,
+		Sources:        []enums.Source{source},
+	}
+
+	for _, reference := range v.References {
+		advisory.References = append(advisory.References, reference.URL)
+	}
+
+	return advisory
+}
+
+func (v *osvVulnerability) cvssVector() string {
+	for _, severity := range v.Severity {
+		if severity.Type == enums.OSVSeverityCVSSV3 {
+			return severity.Score
+		}
+	}
+
+	return ""
+}
+
+func (v *osvVulnerability) fixedVersions() (versions []string) {
+	seen := map[string]bool{}
+
+	for _, affected := range v.Affected {
+		for _, versionRange := range affected.Ranges {
+			for _, event := range versionRange.Events {
+				if fixed, ok := event[enums.OSVEventFixed]; ok && !seen[fixed] {
+					seen[fixed] = true
+					versions = append(versions, fixed)
+				}
+			}
+		}
+	}
+
+	return versions
+}
+
+// affectedRanges returns the semantic version constraints of the ranges, ignoring the git ones since they contain
+// commits instead of versions
+func (v *osvVulnerability) affectedRanges() (ranges []string) {
+	seen := map[string]bool{}
+
+	for _, affected := range v.Affected {
+		for index := range affected.Ranges {
+			if affected.Ranges[index].Type == enums.OSVRangeGit {
+				continue
+			}
+
+			for _, constraint := range affected.Ranges[index].constraints() {
+				if constraint != "" && !seen[constraint] {
+					seen[constraint] = true
+					ranges = append(ranges, constraint)
+				}
+			}
+		}
+	}
+
+	return ranges
+}
+
+// constraints pairs each introduced event with the next fixed or last affected event, leaving the range open when
+// the introduced version was not fixed yet
+func (r *osvRange) constraints() (constraints []string) {
+	introduced, isOpen := "", false
+
+	for _, event := range r.Events {
+		if version, ok := event[enums.OSVEventIntroduced]; ok {
+			if isOpen {
+				constraints = append(constraints, affectedRange([2]string{">=", introduced}))
+			}
+
+			introduced, isOpen = version, true
+		}
+
+		for key, operator := range map[string]string{enums.OSVEventFixed: "<", enums.OSVEventLastAffected: "<="} {
+			if version, ok := event[key]; ok && isOpen {
+				constraints = append(constraints, affectedRange([2]string{">=", introduced}, [2]string{operator, version}))
+				isOpen = false
+			}
+		}
+	}
+
+	if isOpen {
+		constraints = append(constraints, affectedRange([2]string{">=", introduced}))
+	}
+
+	return constraints
+}
@@ -0,0 +1,26 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entities
+
+import "time"
+
+// EPSS is the exploit prediction scoring system score of a cve, the score is the probability of the cve being
+// exploited on the next 30 days and the percentile is the proportion of cves with the same or a lower score
+type EPSS struct {
+	CVE        string    `json:"cve"`
+	Score      float64   `json:"score"`
+	Percentile float64   `json:"percentile"`
+	Date       time.Time `json:"date"`
+}
@@ -0,0 +1,49 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entities
+
+import "strings"
+
+// KEVCatalog is the cisa catalog of the vulnerabilities known to be exploited in the wild
+type KEVCatalog struct {
+	Title           string      `json:"title"`
+	CatalogVersion  string      `json:"catalogVersion"`
+	DateReleased    string      `json:"dateReleased"`
+	Count           int         `json:"count"`
+	Vulnerabilities []*KEVEntry `json:"vulnerabilities"`
+}
+
+type KEVEntry struct {
+	CVEID                      string `json:"cveID"`
+	VendorProject              string `json:"vendorProject"`
+	Product                    string `json:"product"`
+	VulnerabilityName          string `json:"vulnerabilityName"`
+	DateAdded                  string `json:"dateAdded"`
+	ShortDescription           string `json:"shortDescription"`
+	RequiredAction             string `json:"requiredAction"`
+	DueDate                    string `json:"dueDate"`
+	KnownRansomwareCampaignUse string `json:"knownRansomwareCampaignUse"`
+	Notes                      string `json:"notes"`
+}
+
+// Index returns the entries of the catalog by their upper case cve id
+func (k *KEVCatalog) Index() map[string]*KEVEntry {
+	index := make(map[string]*KEVEntry, len(k.Vulnerabilities))
+	for _, entry := range k.Vulnerabilities {
+		index[strings.ToUpper(entry.CVEID)] = entry
+	}
+
+	return index
+}
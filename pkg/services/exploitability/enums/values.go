@@ -0,0 +1,43 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+import "time"
+
+const (
+	HorusecExploitabilityEPSSURL        = "HORUSEC_EXPLOITABILITY_EPSS_URL"
+	HorusecExploitabilityKEVURL         = "HORUSEC_EXPLOITABILITY_KEV_URL"
+	HorusecExploitabilityTimeoutSeconds = "HORUSEC_EXPLOITABILITY_TIMEOUT_SECONDS"
+	HorusecExploitabilityCacheTTL       = "HORUSEC_EXPLOITABILITY_CACHE_TTL"
+
+	DefaultEPSSURL        = "https://api.first.org/data/v1/epss"
+	DefaultKEVURL         = "https://www.cisa.gov/sites/default/files/feeds/known_exploited_vulnerabilities.json"
+	DefaultTimeoutSeconds = 30
+	// the epss scores are published once a day, so there is no reason to fetch them more often
+	DefaultCacheTTL   = 24 * time.Hour
+	DefaultMaxEntries = 50000
+
+	// EPSSBatchSize is how many cves are sent by request, which keeps the query string under the limit of the api
+	EPSSBatchSize    = 100
+	EPSSQueryCVE     = "cve"
+	EPSSCVESeparator = ","
+	DateLayout       = "2006-01-02"
+	HeaderAccept     = "Accept"
+	ContentTypeJSON  = "application/json"
+
+	EPSSCacheName = "exploitability_epss"
+	KEVCacheName  = "exploitability_kev"
+	KEVCacheKey   = "catalog"
+)
@@ -0,0 +1,133 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exploitability
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/cache/ttl"
+	"github.com/ZupIT/horusec-devkit/pkg/services/exploitability/entities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/exploitability/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/request"
+)
+
+// IEPSSClient returns the epss scores of the cves, the cves without score are absent of the returned map
+type IEPSSClient interface {
+	GetScores(ctx context.Context, cves []string) (map[string]*entities.EPSS, error)
+}
+
+// epssResponse is the response of the epss api, which sends the scores as strings
+type epssResponse struct {
+	Data []struct {
+		CVE        string  `json:"cve"`
+		EPSS       float64 `json:"epss,string"`
+		Percentile float64 `json:"percentile,string"`
+		Date       string  `json:"date"`
+	} `json:"data"`
+}
+
+// EPSSClient requests the scores not cached in batches. The cves without score are also cached, as nil, so they are
+// not requested again until the scores of the next day.
+type EPSSClient struct {
+	url     string
+	request request.IRequest
+	cache   ttl.ICache[string, *entities.EPSS]
+}
+
+func NewEPSSClient(options *Options) IEPSSClient {
+	cacheOptions := ttl.NewOptions(enums.EPSSCacheName)
+	cacheOptions.TTL = options.CacheTTL
+	cacheOptions.MaxEntries = enums.DefaultMaxEntries
+	cacheOptions.Clock = options.Clock
+
+	return NewEPSSClientWithCache(options, ttl.NewCache[string, *entities.EPSS](cacheOptions))
+}
+
+func NewEPSSClientWithCache(options *Options, cache ttl.ICache[string, *entities.EPSS]) IEPSSClient {
+	return &EPSSClient{
+		url:     options.EPSSURL,
+		request: request.NewHTTPRequestService(options.Timeout),
+		cache:   cache,
+	}
+}
+
+func (c *EPSSClient) GetScores(ctx context.Context, cves []string) (map[string]*entities.EPSS, error) {
+	scores := map[string]*entities.EPSS{}
+
+	missing := c.getCached(cves, scores)
+	for start := 0; start < len(missing); start += enums.EPSSBatchSize {
+		end := start + enums.EPSSBatchSize
+		if end > len(missing) {
+			end = len(missing)
+		}
+
+		if err := c.fetch(ctx, missing[start:end], scores); err != nil {
+			return scores, err
+		}
+	}
+
+	return scores, nil
+}
+
+// getCached adds the cached scores to the result and returns the cves that are not cached
+func (c *EPSSClient) getCached(cves []string, scores map[string]*entities.EPSS) (missing []string) {
+	seen := map[string]bool{}
+
+	for _, cve := range cves {
+		cve = strings.ToUpper(strings.TrimSpace(cve))
+		if seen[cve] {
+			continue
+		}
+
+		seen[cve] = true
+
+		score, ok := c.cache.Get(cve)
+		if !ok {
+			missing = append(missing, cve)
+		} else if score != nil {
+			scores[cve] = score
+		}
+	}
+
+	return missing
+}
+
+func (c *EPSSClient) fetch(ctx context.Context, cves []string, scores map[string]*entities.EPSS) error {
+	query := url.Values{enums.EPSSQueryCVE: {strings.Join(cves, enums.EPSSCVESeparator)}}
+
+	response := &epssResponse{}
+	if err := get(ctx, c.request, c.url+"?"+query.Encode(), response); err != nil {
+		return err
+	}
+
+	for _, data := range response.Data {
+		date, _ := time.Parse(enums.DateLayout, data.Date)
+		scores[strings.ToUpper(data.CVE)] = &entities.EPSS{
+			CVE:        data.CVE,
+			Score:      data.EPSS,
+			Percentile: data.Percentile,
+			Date:       date,
+		}
+	}
+
+	for _, cve := range cves {
+		c.cache.Set(cve, scores[cve])
+	}
+
+	return nil
+}
@@ -0,0 +1,220 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exploitability
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/services/exploitability/entities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/exploitability/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+)
+
+const (
+	testEPSSBody = `{"status": "OK", "total": 2, "data": [
+		{"cve": "CVE-2021-44228", "epss": "0.975650000", "percentile": "0.999960000", "date": "2024-01-02"},
+		{"cve": "CVE-2021-45046", "epss": "0.974410000", "percentile": "0.999380000", "date": "2024-01-02"}]}`
+
+	testKEVBody = `{"title": "CISA Catalog of Known Exploited Vulnerabilities", "catalogVersion": "2024.01.02",
+		"count": 1, "vulnerabilities": [{"cveID": "CVE-2021-44228", "vendorProject": "Apache",
+		"product": "Log4j2", "dateAdded": "2021-12-10", "dueDate": "2021-12-24",
+		"knownRansomwareCampaignUse": "Known"}]}`
+)
+
+func newTestOptions(url string) (*Options, *clock.FakeClock) {
+	fakeClock := clock.NewFakeClock(time.Now())
+
+	options := NewOptions()
+	options.EPSSURL = url
+	options.KEVURL = url
+	options.Clock = fakeClock
+
+	return options, fakeClock
+}
+
+func TestEPSSClient(t *testing.T) {
+	t.Run("should return and cache the scores including the cves without score", func(t *testing.T) {
+		var requests int32
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			assert.Equal(t, "CVE-2021-44228,CVE-2021-45046,CVE-2000-0001", r.URL.Query().Get(enums.EPSSQueryCVE))
+			_, _ = w.Write([]byte(testEPSSBody))
+		}))
+		defer server.Close()
+
+		options, fakeClock := newTestOptions(server.URL)
+		client := NewEPSSClient(options)
+		cves := []string{"cve-2021-44228", "CVE-2021-45046", "CVE-2000-0001", "CVE-2021-44228"}
+
+		for i := 0; i < 2; i++ {
+			scores, err := client.GetScores(context.Background(), cves)
+
+			assert.NoError(t, err)
+			assert.Len(t, scores, 2)
+			assert.Equal(t, 0.97565, scores["CVE-2021-44228"].Score)
+			assert.Equal(t, 0.99996, scores["CVE-2021-44228"].Percentile)
+			assert.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), scores["CVE-2021-44228"].Date)
+		}
+
+		assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+		fakeClock.Advance(options.CacheTTL + time.Second)
+		_, err := client.GetScores(context.Background(), cves)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+	})
+
+	t.Run("should request the cves in batches", func(t *testing.T) {
+		var requests int32
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			assert.LessOrEqual(t, len(strings.Split(r.URL.Query().Get(enums.EPSSQueryCVE), ",")), enums.EPSSBatchSize)
+			_, _ = w.Write([]byte(`{"data": []}`))
+		}))
+		defer server.Close()
+
+		options, _ := newTestOptions(server.URL)
+		cves := make([]string, enums.EPSSBatchSize+1)
+		for index := range cves {
+			cves[index] = fmt.Sprintf("CVE-2024-%05d", index)
+		}
+
+		scores, err := NewEPSSClient(options).GetScores(context.Background(), cves)
+
+		assert.NoError(t, err)
+		assert.Empty(t, scores)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+	})
+
+	t.Run("should return error when the api fails", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		options, _ := newTestOptions(server.URL)
+
+		_, err := NewEPSSClient(options).GetScores(context.Background(), []string{"CVE-2021-44228"})
+
+		assert.ErrorIs(t, err, enums.ErrorRequestFailed)
+	})
+}
+
+func TestKEVClient(t *testing.T) {
+	t.Run("should return the catalog and its entries downloading it once", func(t *testing.T) {
+		var requests int32
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			_, _ = w.Write([]byte(testKEVBody))
+		}))
+		defer server.Close()
+
+		options, _ := newTestOptions(server.URL)
+		client := NewKEVClient(options)
+
+		catalog, err := client.GetCatalog(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "2024.01.02", catalog.CatalogVersion)
+
+		entries, err := client.GetEntries(context.Background(), []string{"cve-2021-44228", "CVE-2021-45046"})
+		assert.NoError(t, err)
+		assert.Len(t, entries, 1)
+		assert.Equal(t, "Log4j2", entries["CVE-2021-44228"].Product)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+	})
+
+	t.Run("should return error when the catalog is invalid", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte("invalid"))
+		}))
+		defer server.Close()
+
+		options, _ := newTestOptions(server.URL)
+
+		_, err := NewKEVClient(options).GetEntries(context.Background(), []string{"CVE-2021-44228"})
+
+		assert.ErrorIs(t, err, enums.ErrorInvalidResponse)
+	})
+}
+
+func TestScorer(t *testing.T) {
+	newVulnerabilities := func() []*vulnerability.Vulnerability {
+		return []*vulnerability.Vulnerability{
+			{Details: "log4j-core is vulnerable to CVE-2021-45046 and CVE-2021-44228"},
+			{Details: "vulnerable to CVE-2021-45046"},
+			{Details: "Asymmetric Private Key Found", EPSSScore: 0.5},
+		}
+	}
+
+	scores := map[string]*entities.EPSS{
+		"CVE-2021-44228": {Score: 0.9, Percentile: 0.99},
+		"CVE-2021-45046": {Score: 0.4, Percentile: 0.8},
+	}
+	entries := map[string]*entities.KEVEntry{"CVE-2021-44228": {CVEID: "CVE-2021-44228"}}
+
+	t.Run("should score the vulnerabilities by their cves", func(t *testing.T) {
+		epss := &EPSSMock{}
+		epss.On("GetScores").Return(scores, nil)
+		kev := &KEVMock{}
+		kev.On("GetEntries").Return(entries, nil)
+
+		vulnerabilities := newVulnerabilities()
+
+		assert.NoError(t, NewScorer(epss, kev).Score(context.Background(), vulnerabilities))
+		assert.Equal(t, 0.9, vulnerabilities[0].EPSSScore)
+		assert.Equal(t, 0.99, vulnerabilities[0].EPSSPercentile)
+		assert.True(t, vulnerabilities[0].KnownExploited)
+		assert.Equal(t, 0.4, vulnerabilities[1].EPSSScore)
+		assert.False(t, vulnerabilities[1].KnownExploited)
+		assert.Zero(t, vulnerabilities[2].EPSSScore)
+	})
+
+	t.Run("should score by the kev catalog when the epss fails", func(t *testing.T) {
+		epss := &EPSSMock{}
+		epss.On("GetScores").Return(map[string]*entities.EPSS{}, enums.ErrorRequestFailed)
+		kev := &KEVMock{}
+		kev.On("GetEntries").Return(entries, nil)
+
+		vulnerabilities := newVulnerabilities()
+
+		assert.ErrorIs(t, NewScorer(epss, kev).Score(context.Background(), vulnerabilities), enums.ErrorRequestFailed)
+		assert.True(t, vulnerabilities[0].KnownExploited)
+		assert.Zero(t, vulnerabilities[0].EPSSScore)
+	})
+
+	t.Run("should not request the feeds when there are no cves", func(t *testing.T) {
+		epss := &EPSSMock{}
+		kev := &KEVMock{}
+
+		err := NewScorer(epss, kev).Score(context.Background(),
+			[]*vulnerability.Vulnerability{{Details: "Asymmetric Private Key Found"}})
+
+		assert.NoError(t, err)
+		epss.AssertNotCalled(t, "GetScores")
+	})
+}
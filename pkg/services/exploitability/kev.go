@@ -0,0 +1,94 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exploitability
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/cache/ttl"
+	"github.com/ZupIT/horusec-devkit/pkg/services/exploitability/entities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/exploitability/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/request"
+)
+
+// IKEVClient returns the cisa catalog of known exploited vulnerabilities and its entries of the cves, the cves that
+// are not on the catalog are absent of the returned map
+type IKEVClient interface {
+	GetCatalog(ctx context.Context) (*entities.KEVCatalog, error)
+	GetEntries(ctx context.Context, cves []string) (map[string]*entities.KEVEntry, error)
+}
+
+type kevCatalog struct {
+	catalog *entities.KEVCatalog
+	index   map[string]*entities.KEVEntry
+}
+
+// KEVClient downloads the whole catalog, which has only some thousands of entries, and keeps it with its index on
+// the cache, concurrent requests while the catalog is downloaded wait for the same download
+type KEVClient struct {
+	url     string
+	request request.IRequest
+	cache   ttl.ICache[string, *kevCatalog]
+}
+
+func NewKEVClient(options *Options) IKEVClient {
+	cacheOptions := ttl.NewOptions(enums.KEVCacheName)
+	cacheOptions.TTL = options.CacheTTL
+	cacheOptions.Clock = options.Clock
+
+	return &KEVClient{
+		url:     options.KEVURL,
+		request: request.NewHTTPRequestService(options.Timeout),
+		cache:   ttl.NewCache[string, *kevCatalog](cacheOptions),
+	}
+}
+
+func (c *KEVClient) GetCatalog(ctx context.Context) (*entities.KEVCatalog, error) {
+	catalog, err := c.getCatalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return catalog.catalog, nil
+}
+
+func (c *KEVClient) GetEntries(ctx context.Context, cves []string) (map[string]*entities.KEVEntry, error) {
+	catalog, err := c.getCatalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := map[string]*entities.KEVEntry{}
+	for _, cve := range cves {
+		cve = strings.ToUpper(strings.TrimSpace(cve))
+		if entry, ok := catalog.index[cve]; ok {
+			entries[cve] = entry
+		}
+	}
+
+	return entries, nil
+}
+
+func (c *KEVClient) getCatalog(ctx context.Context) (*kevCatalog, error) {
+	return c.cache.GetOrLoad(ctx, enums.KEVCacheKey, func(ctx context.Context) (*kevCatalog, error) {
+		catalog := &entities.KEVCatalog{}
+		if err := get(ctx, c.request, c.url, catalog); err != nil {
+			return nil, err
+		}
+
+		return &kevCatalog{catalog: catalog, index: catalog.Index()}, nil
+	})
+}
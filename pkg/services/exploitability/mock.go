@@ -0,0 +1,61 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exploitability
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/services/exploitability/entities"
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) Score(_ context.Context, _ []*vulnerability.Vulnerability) error {
+	args := m.MethodCalled("Score")
+
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+type EPSSMock struct {
+	mock.Mock
+}
+
+func (m *EPSSMock) GetScores(_ context.Context, _ []string) (map[string]*entities.EPSS, error) {
+	args := m.MethodCalled("GetScores")
+
+	return args.Get(0).(map[string]*entities.EPSS), mockUtils.ReturnNilOrError(args, 1)
+}
+
+type KEVMock struct {
+	mock.Mock
+}
+
+func (m *KEVMock) GetCatalog(_ context.Context) (*entities.KEVCatalog, error) {
+	args := m.MethodCalled("GetCatalog")
+
+	return args.Get(0).(*entities.KEVCatalog), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *KEVMock) GetEntries(_ context.Context, _ []string) (map[string]*entities.KEVEntry, error) {
+	args := m.MethodCalled("GetEntries")
+
+	return args.Get(0).(map[string]*entities.KEVEntry), mockUtils.ReturnNilOrError(args, 1)
+}
@@ -0,0 +1,43 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exploitability
+
+import (
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/exploitability/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// Options configures the feeds of the epss scores and of the kev catalog. The urls can point to internal mirrors
+// of the feeds on environments without internet access.
+type Options struct {
+	EPSSURL  string
+	KEVURL   string
+	Timeout  int
+	CacheTTL time.Duration
+	Clock    clock.IClock
+}
+
+func NewOptions() *Options {
+	return &Options{
+		EPSSURL:  env.GetEnvOrDefault(enums.HorusecExploitabilityEPSSURL, enums.DefaultEPSSURL),
+		KEVURL:   env.GetEnvOrDefault(enums.HorusecExploitabilityKEVURL, enums.DefaultKEVURL),
+		Timeout:  env.GetEnvOrDefaultInt(enums.HorusecExploitabilityTimeoutSeconds, enums.DefaultTimeoutSeconds),
+		CacheTTL: env.GetDuration(enums.HorusecExploitabilityCacheTTL, enums.DefaultCacheTTL),
+		Clock:    clock.NewClock(),
+	}
+}
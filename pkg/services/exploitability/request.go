@@ -0,0 +1,51 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exploitability
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/exploitability/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/request"
+)
+
+func get(ctx context.Context, service request.IRequest, url string, result interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return fmt.Errorf("%w: %s", enums.ErrorRequestFailed, err.Error())
+	}
+
+	req.Header.Set(enums.HeaderAccept, enums.ContentTypeJSON)
+
+	response, err := service.DoRequest(req, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %s", enums.ErrorRequestFailed, err.Error())
+	}
+
+	defer response.CloseBody()
+
+	if response.GetStatusCode() >= http.StatusBadRequest {
+		return fmt.Errorf("%w: %s", enums.ErrorRequestFailed, response.GetStatusCodeString())
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(result); err != nil {
+		return fmt.Errorf("%w: %s", enums.ErrorInvalidResponse, err.Error())
+	}
+
+	return nil
+}
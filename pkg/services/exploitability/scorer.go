@@ -0,0 +1,110 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exploitability
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/services/enrichment"
+	"github.com/ZupIT/horusec-devkit/pkg/services/exploitability/entities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/exploitability/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+// IScorer fills the exploitability of the vulnerabilities from the cves found on their details
+type IScorer interface {
+	Score(ctx context.Context, vulnerabilities []*vulnerability.Vulnerability) error
+}
+
+type Scorer struct {
+	epss IEPSSClient
+	kev  IKEVClient
+}
+
+func NewScorer(epss IEPSSClient, kev IKEVClient) IScorer {
+	return &Scorer{
+		epss: epss,
+		kev:  kev,
+	}
+}
+
+func NewScorerFromOptions(options *Options) IScorer {
+	return NewScorer(NewEPSSClient(options), NewKEVClient(options))
+}
+
+// Score sets on each vulnerability the highest epss score of its cves and if any of them is on the kev catalog. When
+// one of the feeds fails the vulnerabilities are still scored by the other one and the error is returned.
+func (s *Scorer) Score(ctx context.Context, vulnerabilities []*vulnerability.Vulnerability) error {
+	cvesByVulnerability, cves := s.extractCVEs(vulnerabilities)
+	if len(cves) == 0 {
+		return nil
+	}
+
+	scores, epssErr := s.epss.GetScores(ctx, cves)
+	if epssErr != nil {
+		logger.LogError(enums.MessageFailedToGetEPSS, epssErr)
+	}
+
+	entries, kevErr := s.kev.GetEntries(ctx, cves)
+	if kevErr != nil {
+		logger.LogError(enums.MessageFailedToGetKEV, kevErr)
+	}
+
+	for index, vuln := range vulnerabilities {
+		s.setExploitability(vuln, cvesByVulnerability[index], scores, entries)
+	}
+
+	return errors.Join(epssErr, kevErr)
+}
+
+func (s *Scorer) extractCVEs(vulnerabilities []*vulnerability.Vulnerability) (cvesByVulnerability [][]string,
+	cves []string) {
+	seen := map[string]bool{}
+	cvesByVulnerability = make([][]string, len(vulnerabilities))
+
+	for index, vuln := range vulnerabilities {
+		cvesByVulnerability[index] = enrichment.ExtractCVEs(vuln.Details)
+
+		for _, cve := range cvesByVulnerability[index] {
+			if !seen[cve] {
+				seen[cve] = true
+				cves = append(cves, cve)
+			}
+		}
+	}
+
+	return cvesByVulnerability, cves
+}
+
+func (s *Scorer) setExploitability(vuln *vulnerability.Vulnerability, cves []string,
+	scores map[string]*entities.EPSS, entries map[string]*entities.KEVEntry) {
+	var score, percentile float64
+
+	knownExploited := false
+
+	for _, cve := range cves {
+		if epss, ok := scores[cve]; ok && epss.Score > score {
+			score, percentile = epss.Score, epss.Percentile
+		}
+
+		if _, ok := entries[cve]; ok {
+			knownExploited = true
+		}
+	}
+
+	vuln.SetExploitability(score, percentile, knownExploited)
+}
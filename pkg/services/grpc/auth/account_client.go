@@ -0,0 +1,70 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/singleflight"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+type IAccountClient interface {
+	GetAccountInfo(ctx context.Context, token string) (*proto.GetAccountDataResponse, error)
+	ListPermissions(ctx context.Context, token string) (*proto.ListPermissionsResponse, error)
+}
+
+// AccountClient collapses the concurrent requests of the same token and keeps the responses for the cache duration,
+// using the token hash as key, so a token is never kept in memory in plain text
+type AccountClient struct {
+	client      proto.AuthServiceClient
+	accounts    *singleflight.Group[*proto.GetAccountDataResponse]
+	permissions *singleflight.Group[*proto.ListPermissionsResponse]
+}
+
+func NewAccountClient(conn grpc.ClientConnInterface) IAccountClient {
+	cacheDuration := time.Duration(env.GetEnvOrDefaultInt(enums.HorusecGRPCAccountCacheDuration,
+		enums.DefaultAccountCacheDuration)) * time.Second
+
+	return &AccountClient{
+		client:      proto.NewAuthServiceClient(conn),
+		accounts:    singleflight.NewGroup[*proto.GetAccountDataResponse](cacheDuration),
+		permissions: singleflight.NewGroup[*proto.ListPermissionsResponse](cacheDuration),
+	}
+}
+
+func (a *AccountClient) GetAccountInfo(ctx context.Context, token string) (*proto.GetAccountDataResponse, error) {
+	response, _, err := a.accounts.Do(ctx, enums.AccountInfoCachePrefix+crypto.GenerateSHA256(token),
+		func(ctx context.Context) (*proto.GetAccountDataResponse, error) {
+			return a.client.GetAccountInfo(ctx, &proto.GetAccountData{Token: token})
+		})
+
+	return response, err
+}
+
+func (a *AccountClient) ListPermissions(ctx context.Context, token string) (*proto.ListPermissionsResponse, error) {
+	response, _, err := a.permissions.Do(ctx, enums.ListPermissionsCachePrefix+crypto.GenerateSHA256(token),
+		func(ctx context.Context) (*proto.ListPermissionsResponse, error) {
+			return a.client.ListPermissions(ctx, &proto.ListPermissionsData{Token: token})
+		})
+
+	return response, err
+}
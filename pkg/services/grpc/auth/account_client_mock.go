@@ -0,0 +1,40 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+)
+
+type AccountClientMock struct {
+	mock.Mock
+}
+
+func (m *AccountClientMock) GetAccountInfo(_ context.Context, _ string) (*proto.GetAccountDataResponse, error) {
+	args := m.MethodCalled("GetAccountInfo")
+
+	return args.Get(0).(*proto.GetAccountDataResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *AccountClientMock) ListPermissions(_ context.Context, _ string) (*proto.ListPermissionsResponse, error) {
+	args := m.MethodCalled("ListPermissions")
+
+	return args.Get(0).(*proto.ListPermissionsResponse), mockUtils.ReturnNilOrError(args, 1)
+}
@@ -0,0 +1,123 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+	"github.com/ZupIT/horusec-devkit/pkg/services/singleflight"
+)
+
+func newTestAccountClient(client proto.AuthServiceClient) *AccountClient {
+	return &AccountClient{
+		client:      client,
+		accounts:    singleflight.NewGroup[*proto.GetAccountDataResponse](time.Minute),
+		permissions: singleflight.NewGroup[*proto.ListPermissionsResponse](time.Minute),
+	}
+}
+
+func TestNewAccountClient(t *testing.T) {
+	t.Run("should create a new account client", func(t *testing.T) {
+		assert.NotNil(t, NewAccountClient(nil))
+	})
+}
+
+func TestGetAccountInfo(t *testing.T) {
+	t.Run("should return account info and cache it by token", func(t *testing.T) {
+		client := &proto.Mock{}
+		client.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{Email: "test@horusec.io"}, nil).Once()
+		accountClient := newTestAccountClient(client)
+
+		for i := 0; i < 2; i++ {
+			response, err := accountClient.GetAccountInfo(context.Background(), "token")
+
+			assert.NoError(t, err)
+			assert.Equal(t, "test@horusec.io", response.Email)
+		}
+
+		client.AssertNumberOfCalls(t, "GetAccountInfo", 1)
+	})
+
+	t.Run("should make a single request for concurrent calls of the same token", func(t *testing.T) {
+		client := &proto.Mock{}
+		client.On("GetAccountInfo").WaitUntil(time.After(20*time.Millisecond)).
+			Return(&proto.GetAccountDataResponse{Email: "test@horusec.io"}, nil)
+		accountClient := newTestAccountClient(client)
+		wait := sync.WaitGroup{}
+
+		for i := 0; i < 5; i++ {
+			wait.Add(1)
+
+			go func() {
+				defer wait.Done()
+
+				_, err := accountClient.GetAccountInfo(context.Background(), "token")
+				assert.NoError(t, err)
+			}()
+		}
+
+		wait.Wait()
+		client.AssertNumberOfCalls(t, "GetAccountInfo", 1)
+	})
+
+	t.Run("should return error and not cache when request fails", func(t *testing.T) {
+		client := &proto.Mock{}
+		client.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{}, errors.New("test"))
+		accountClient := newTestAccountClient(client)
+
+		_, err := accountClient.GetAccountInfo(context.Background(), "token")
+		assert.Error(t, err)
+
+		_, err = accountClient.GetAccountInfo(context.Background(), "token")
+		assert.Error(t, err)
+
+		client.AssertNumberOfCalls(t, "GetAccountInfo", 2)
+	})
+}
+
+func TestListPermissions(t *testing.T) {
+	t.Run("should return permissions and cache it by token", func(t *testing.T) {
+		client := &proto.Mock{}
+		client.On("ListPermissions").Return(&proto.ListPermissionsResponse{
+			Workspaces: map[string]string{"test": "admin"},
+		}, nil).Once()
+		accountClient := newTestAccountClient(client)
+
+		for i := 0; i < 2; i++ {
+			response, err := accountClient.ListPermissions(context.Background(), "token")
+
+			assert.NoError(t, err)
+			assert.Equal(t, "admin", response.Workspaces["test"])
+		}
+
+		client.AssertNumberOfCalls(t, "ListPermissions", 1)
+	})
+
+	t.Run("should return error when request fails", func(t *testing.T) {
+		client := &proto.Mock{}
+		client.On("ListPermissions").Return(&proto.ListPermissionsResponse{}, errors.New("test"))
+
+		_, err := newTestAccountClient(client).ListPermissions(context.Background(), "token")
+
+		assert.Error(t, err)
+	})
+}
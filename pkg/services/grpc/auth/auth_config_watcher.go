@@ -0,0 +1,122 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+type IAuthConfigWatcher interface {
+	Start(ctx context.Context)
+	GetAuthConfig() (*proto.GetAuthConfigResponse, bool)
+}
+
+// AuthConfigWatcher keeps the last auth config sent by the auth service stream. When the auth service does not
+// implement the stream yet, the config is fetched again at each retry interval.
+type AuthConfigWatcher struct {
+	client        proto.AuthServiceClient
+	mutex         sync.RWMutex
+	authConfig    *proto.GetAuthConfigResponse
+	retryInterval time.Duration
+}
+
+func NewAuthConfigWatcher(conn grpc.ClientConnInterface) IAuthConfigWatcher {
+	return &AuthConfigWatcher{
+		client: proto.NewAuthServiceClient(conn),
+		retryInterval: time.Duration(env.GetEnvOrDefaultInt(enums.HorusecGRPCAuthConfigRetryInterval,
+			enums.DefaultAuthConfigRetryInterval)) * time.Second,
+	}
+}
+
+func (a *AuthConfigWatcher) Start(ctx context.Context) {
+	go a.watch(ctx)
+}
+
+func (a *AuthConfigWatcher) GetAuthConfig() (*proto.GetAuthConfigResponse, bool) {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
+	return a.authConfig, a.authConfig != nil
+}
+
+func (a *AuthConfigWatcher) setAuthConfig(authConfig *proto.GetAuthConfigResponse) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.authConfig = authConfig
+}
+
+func (a *AuthConfigWatcher) watch(ctx context.Context) {
+	for ctx.Err() == nil {
+		err := a.receive(ctx)
+		if status.Code(err) == codes.Unimplemented {
+			err = a.refresh(ctx)
+		}
+
+		if ctx.Err() == nil {
+			logger.LogError(enums.MessageFailedToWatchAuthConfig, err)
+		}
+
+		a.wait(ctx)
+	}
+}
+
+func (a *AuthConfigWatcher) receive(ctx context.Context) error {
+	stream, err := a.client.WatchAuthConfig(ctx, &proto.GetAuthConfigData{})
+	if err != nil {
+		return err
+	}
+
+	for {
+		authConfig, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		a.setAuthConfig(authConfig)
+	}
+}
+
+func (a *AuthConfigWatcher) refresh(ctx context.Context) error {
+	authConfig, err := a.client.GetAuthConfig(ctx, &proto.GetAuthConfigData{})
+	if err != nil {
+		return err
+	}
+
+	a.setAuthConfig(authConfig)
+
+	return nil
+}
+
+func (a *AuthConfigWatcher) wait(ctx context.Context) {
+	timer := time.NewTimer(a.retryInterval)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+)
+
+type testAuthServer struct {
+	proto.UnimplementedAuthServiceServer
+	configs chan *proto.GetAuthConfigResponse
+}
+
+func (t *testAuthServer) GetAuthConfig(context.Context,
+	*proto.GetAuthConfigData) (*proto.GetAuthConfigResponse, error) {
+	return &proto.GetAuthConfigResponse{AuthType: "polling"}, nil
+}
+
+type testStreamAuthServer struct {
+	testAuthServer
+}
+
+func (t *testStreamAuthServer) WatchAuthConfig(_ *proto.GetAuthConfigData,
+	stream proto.AuthService_WatchAuthConfigServer) error {
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case config := <-t.configs:
+			if err := stream.Send(config); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func newTestAuthConnection(t *testing.T, server proto.AuthServiceServer) grpc.ClientConnInterface {
+	listener := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	proto.RegisterAuthServiceServer(grpcServer, server)
+
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return listener.Dial()
+		}))
+	assert.NoError(t, err)
+
+	return conn
+}
+
+func getAuthType(watcher IAuthConfigWatcher) string {
+	if authConfig, ok := watcher.GetAuthConfig(); ok {
+		return authConfig.AuthType
+	}
+
+	return ""
+}
+
+func TestAuthConfigWatcher(t *testing.T) {
+	t.Run("should keep the last auth config sent by the stream", func(t *testing.T) {
+		server := &testStreamAuthServer{testAuthServer{configs: make(chan *proto.GetAuthConfigResponse)}}
+		watcher := NewAuthConfigWatcher(newTestAuthConnection(t, server))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		_, ok := watcher.GetAuthConfig()
+		assert.False(t, ok)
+
+		watcher.Start(ctx)
+
+		server.configs <- &proto.GetAuthConfigResponse{AuthType: "horusec"}
+		assert.Eventually(t, func() bool { return getAuthType(watcher) == "horusec" }, time.Second, time.Millisecond)
+
+		server.configs <- &proto.GetAuthConfigResponse{AuthType: "ldap"}
+		assert.Eventually(t, func() bool { return getAuthType(watcher) == "ldap" }, time.Second, time.Millisecond)
+	})
+
+	t.Run("should fetch auth config when stream is not implemented", func(t *testing.T) {
+		watcher := NewAuthConfigWatcher(newTestAuthConnection(t, &testAuthServer{}))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		watcher.Start(ctx)
+
+		assert.Eventually(t, func() bool { return getAuthType(watcher) == "polling" }, time.Second, time.Millisecond)
+	})
+
+	t.Run("should retry when failed to connect until context is done", func(t *testing.T) {
+		client := &proto.Mock{}
+		client.On("WatchAuthConfig").Return(nil, context.Canceled)
+		watcher := &AuthConfigWatcher{client: client, retryInterval: time.Millisecond}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		watcher.watch(ctx)
+
+		_, ok := watcher.GetAuthConfig()
+		assert.False(t, ok)
+		assert.Greater(t, len(client.Calls), 1)
+	})
+}
@@ -0,0 +1,163 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authtest
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	authEnums "github.com/ZupIT/horusec-devkit/pkg/enums/auth"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+)
+
+const bufferSize = 1024 * 1024
+
+type authorization struct {
+	token        string
+	authzType    string
+	workspaceID  string
+	repositoryID string
+}
+
+// Server is an in memory auth service that only authorizes the requests allowed with Authorize, unless a custom
+// handler is set with SetIsAuthorized. Every IsAuthorized request is recorded, so tests can assert what the
+// middlewares sent to the auth service.
+type Server struct {
+	proto.UnimplementedAuthServiceServer
+	mutex          sync.Mutex
+	conn           *grpc.ClientConn
+	authorizations map[authorization]bool
+	isAuthorized   func(data *proto.IsAuthorizedData) (bool, error)
+	accounts       map[string]*proto.GetAccountDataResponse
+	permissions    map[string]*proto.ListPermissionsResponse
+	authConfig     *proto.GetAuthConfigResponse
+	errors         map[string]error
+	requests       []*proto.IsAuthorizedData
+	watchers       map[chan *proto.GetAuthConfigResponse]bool
+}
+
+// NewServer starts the server on a bufconn listener, stopping it when the test finishes
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	server := &Server{
+		authorizations: map[authorization]bool{},
+		accounts:       map[string]*proto.GetAccountDataResponse{},
+		permissions:    map[string]*proto.ListPermissionsResponse{},
+		authConfig:     &proto.GetAuthConfigResponse{},
+		errors:         map[string]error{},
+		watchers:       map[chan *proto.GetAuthConfigResponse]bool{},
+	}
+
+	listener := bufconn.Listen(bufferSize)
+	grpcServer := grpc.NewServer()
+	proto.RegisterAuthServiceServer(grpcServer, server)
+
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return listener.Dial()
+		}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_ = conn.Close()
+		grpcServer.Stop()
+	})
+
+	server.conn = conn
+
+	return server
+}
+
+// Conn returns the client connection used by the middlewares and clients under test
+func (s *Server) Conn() *grpc.ClientConn {
+	return s.conn
+}
+
+// Authorize allows the token for the authorization type on the workspace and repository, which are empty for the
+// types that do not use them, like the application admin
+func (s *Server) Authorize(token string, authzType authEnums.AuthorizationType, workspaceID, repositoryID string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.authorizations[authorization{token, authzType.ToString(), workspaceID, repositoryID}] = true
+}
+
+// SetIsAuthorized replaces the authorizations allowed with Authorize by the handler
+func (s *Server) SetIsAuthorized(handler func(data *proto.IsAuthorizedData) (bool, error)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.isAuthorized = handler
+}
+
+func (s *Server) SetAccount(token string, account *proto.GetAccountDataResponse) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.accounts[token] = account
+}
+
+// SetPermissions sets the permissions of the token, without it they are only the account id and admin flag of the
+// account set with SetAccount
+func (s *Server) SetPermissions(token string, permissions *proto.ListPermissionsResponse) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.permissions[token] = permissions
+}
+
+// SetAuthConfig also sends the config to the clients watching it
+func (s *Server) SetAuthConfig(config *proto.GetAuthConfigResponse) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.authConfig = config
+
+	for watcher := range s.watchers {
+		select {
+		case <-watcher:
+		default:
+		}
+
+		watcher <- config
+	}
+}
+
+// SetError makes the method, like "IsAuthorized", return the error until it is set again as nil
+func (s *Server) SetError(method string, err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.errors[method] = err
+}
+
+func (s *Server) GetIsAuthorizedRequests() []*proto.IsAuthorizedData {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return append([]*proto.IsAuthorizedData{}, s.requests...)
+}
@@ -0,0 +1,205 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authtest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authEnums "github.com/ZupIT/horusec-devkit/pkg/enums/auth"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares"
+	jwtEnums "github.com/ZupIT/horusec-devkit/pkg/utils/jwt/enums"
+)
+
+func TestIsAuthorized(t *testing.T) {
+	t.Run("should only authorize the allowed requests and record them", func(t *testing.T) {
+		server := NewServer(t)
+		server.Authorize("token", authEnums.WorkspaceMember, "workspace", "")
+		client := proto.NewAuthServiceClient(server.Conn())
+
+		response, err := client.IsAuthorized(context.Background(), &proto.IsAuthorizedData{
+			Token: "token", Type: authEnums.WorkspaceMember.ToString(), WorkspaceID: "workspace"})
+		assert.NoError(t, err)
+		assert.True(t, response.IsAuthorized)
+
+		response, err = client.IsAuthorized(context.Background(), &proto.IsAuthorizedData{
+			Token: "token", Type: authEnums.WorkspaceAdmin.ToString(), WorkspaceID: "workspace"})
+		assert.NoError(t, err)
+		assert.False(t, response.IsAuthorized)
+
+		requests := server.GetIsAuthorizedRequests()
+		assert.Len(t, requests, 2)
+		assert.Equal(t, authEnums.WorkspaceAdmin.ToString(), requests[1].Type)
+	})
+
+	t.Run("should use the custom handler", func(t *testing.T) {
+		server := NewServer(t)
+		server.SetIsAuthorized(func(data *proto.IsAuthorizedData) (bool, error) {
+			return data.Token == "admin", nil
+		})
+
+		response, err := proto.NewAuthServiceClient(server.Conn()).IsAuthorized(context.Background(),
+			&proto.IsAuthorizedData{Token: "admin"})
+
+		assert.NoError(t, err)
+		assert.True(t, response.IsAuthorized)
+	})
+
+	t.Run("should return the programmed error", func(t *testing.T) {
+		server := NewServer(t)
+		server.SetError("IsAuthorized", status.Error(codes.Unavailable, "test"))
+
+		_, err := proto.NewAuthServiceClient(server.Conn()).IsAuthorized(context.Background(),
+			&proto.IsAuthorizedData{})
+
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+}
+
+func TestIsAuthorizedBatch(t *testing.T) {
+	t.Run("should check each item of the batch", func(t *testing.T) {
+		server := NewServer(t)
+		server.Authorize("token", authEnums.RepositoryMember, "workspace", "repository")
+
+		response, err := proto.NewAuthServiceClient(server.Conn()).IsAuthorizedBatch(context.Background(),
+			&proto.IsAuthorizedBatchData{Token: "token", Items: []*proto.IsAuthorizedBatchItem{
+				auth.NewIsAuthorizedBatchItem(authEnums.RepositoryMember, "workspace", "repository"),
+				auth.NewIsAuthorizedBatchItem(authEnums.RepositoryAdmin, "workspace", "repository"),
+			}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []bool{true, false}, response.IsAuthorized)
+	})
+
+	t.Run("should return error from the custom handler", func(t *testing.T) {
+		server := NewServer(t)
+		server.SetIsAuthorized(func(*proto.IsAuthorizedData) (bool, error) {
+			return false, status.Error(codes.Internal, "test")
+		})
+
+		_, err := proto.NewAuthServiceClient(server.Conn()).IsAuthorizedBatch(context.Background(),
+			&proto.IsAuthorizedBatchData{Items: []*proto.IsAuthorizedBatchItem{{}}})
+
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
+
+func TestAccounts(t *testing.T) {
+	t.Run("should return the account and its permissions", func(t *testing.T) {
+		server := NewServer(t)
+		server.SetAccount("token", &proto.GetAccountDataResponse{AccountID: "account", IsApplicationAdmin: true})
+		client := auth.NewAccountClient(server.Conn())
+
+		account, err := client.GetAccountInfo(context.Background(), "token")
+		assert.NoError(t, err)
+		assert.Equal(t, "account", account.AccountID)
+
+		permissions, err := client.ListPermissions(context.Background(), "token")
+		assert.NoError(t, err)
+		assert.Equal(t, "account", permissions.AccountID)
+		assert.True(t, permissions.IsApplicationAdmin)
+	})
+
+	t.Run("should return the permissions set", func(t *testing.T) {
+		server := NewServer(t)
+		server.SetPermissions("token", &proto.ListPermissionsResponse{Workspaces: map[string]string{"id": "admin"}})
+
+		permissions, err := auth.NewAccountClient(server.Conn()).ListPermissions(context.Background(), "token")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "admin", permissions.Workspaces["id"])
+	})
+
+	t.Run("should return unauthenticated when account was not set", func(t *testing.T) {
+		server := NewServer(t)
+		client := auth.NewAccountClient(server.Conn())
+
+		_, err := client.GetAccountInfo(context.Background(), "token")
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		_, err = client.ListPermissions(context.Background(), "token")
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func TestAuthConfig(t *testing.T) {
+	t.Run("should send the updated config to the watcher", func(t *testing.T) {
+		server := NewServer(t)
+		server.SetAuthConfig(&proto.GetAuthConfigResponse{AuthType: authEnums.Horusec.ToString()})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		watcher := auth.NewAuthConfigWatcher(server.Conn())
+		watcher.Start(ctx)
+
+		assert.Eventually(t, func() bool {
+			config, ok := watcher.GetAuthConfig()
+
+			return ok && config.AuthType == authEnums.Horusec.ToString()
+		}, 5*time.Second, 10*time.Millisecond)
+
+		server.SetAuthConfig(&proto.GetAuthConfigResponse{AuthType: authEnums.Keycloak.ToString()})
+
+		assert.Eventually(t, func() bool {
+			config, _ := watcher.GetAuthConfig()
+
+			return config.AuthType == authEnums.Keycloak.ToString()
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("should return the programmed error", func(t *testing.T) {
+		server := NewServer(t)
+		server.SetError("GetAuthConfig", errors.New("test"))
+
+		_, err := proto.NewAuthServiceClient(server.Conn()).GetAuthConfig(context.Background(),
+			&proto.GetAuthConfigData{})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestWithAuthzMiddleware(t *testing.T) {
+	t.Run("should authorize application admin through the middleware", func(t *testing.T) {
+		server := NewServer(t)
+		server.SetAuthConfig(&proto.GetAuthConfigResponse{EnableApplicationAdmin: true})
+		server.Authorize("admin", authEnums.ApplicationAdmin, "", "")
+
+		handler := middlewares.NewAuthzMiddleware(server.Conn()).IsApplicationAdmin(
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			}))
+
+		for token, expected := range map[string]int{"admin": http.StatusNoContent, "user": http.StatusUnauthorized} {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.Header.Set(jwtEnums.HorusecJWTHeader, token)
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, r)
+
+			assert.Equal(t, expected, w.Code)
+		}
+	})
+}
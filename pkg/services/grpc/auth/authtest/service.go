@@ -0,0 +1,154 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authtest
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+)
+
+func (s *Server) IsAuthorized(_ context.Context, data *proto.IsAuthorizedData) (*proto.IsAuthorizedResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.requests = append(s.requests, data)
+	if err := s.errors["IsAuthorized"]; err != nil {
+		return nil, err
+	}
+
+	isAuthorized, err := s.checkAuthorization(data)
+
+	return &proto.IsAuthorizedResponse{IsAuthorized: isAuthorized}, err
+}
+
+func (s *Server) checkAuthorization(data *proto.IsAuthorizedData) (bool, error) {
+	if s.isAuthorized != nil {
+		return s.isAuthorized(data)
+	}
+
+	return s.authorizations[authorization{data.Token, data.Type, data.WorkspaceID, data.RepositoryID}], nil
+}
+
+func (s *Server) IsAuthorizedBatch(_ context.Context,
+	data *proto.IsAuthorizedBatchData) (*proto.IsAuthorizedBatchResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := s.errors["IsAuthorizedBatch"]; err != nil {
+		return nil, err
+	}
+
+	response := &proto.IsAuthorizedBatchResponse{}
+
+	for _, item := range data.Items {
+		isAuthorized, err := s.checkAuthorization(&proto.IsAuthorizedData{Token: data.Token, Type: item.Type,
+			WorkspaceID: item.WorkspaceID, RepositoryID: item.RepositoryID})
+		if err != nil {
+			return nil, err
+		}
+
+		response.IsAuthorized = append(response.IsAuthorized, isAuthorized)
+	}
+
+	return response, nil
+}
+
+func (s *Server) GetAccountInfo(_ context.Context,
+	data *proto.GetAccountData) (*proto.GetAccountDataResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := s.errors["GetAccountInfo"]; err != nil {
+		return nil, err
+	}
+
+	if account, ok := s.accounts[data.Token]; ok {
+		return account, nil
+	}
+
+	return nil, status.Error(codes.Unauthenticated, "account not found")
+}
+
+func (s *Server) ListPermissions(_ context.Context,
+	data *proto.ListPermissionsData) (*proto.ListPermissionsResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := s.errors["ListPermissions"]; err != nil {
+		return nil, err
+	}
+
+	if permissions, ok := s.permissions[data.Token]; ok {
+		return permissions, nil
+	}
+
+	account, ok := s.accounts[data.Token]
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "account not found")
+	}
+
+	return &proto.ListPermissionsResponse{AccountID: account.AccountID,
+		IsApplicationAdmin: account.IsApplicationAdmin}, nil
+}
+
+func (s *Server) GetAuthConfig(context.Context, *proto.GetAuthConfigData) (*proto.GetAuthConfigResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := s.errors["GetAuthConfig"]; err != nil {
+		return nil, err
+	}
+
+	return s.authConfig, nil
+}
+
+// WatchAuthConfig sends the current config and then each config set until the client cancels the stream
+func (s *Server) WatchAuthConfig(_ *proto.GetAuthConfigData, stream proto.AuthService_WatchAuthConfigServer) error {
+	watcher := s.addWatcher()
+	defer s.removeWatcher(watcher)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case config := <-watcher:
+			if err := stream.Send(config); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *Server) addWatcher() chan *proto.GetAuthConfigResponse {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	watcher := make(chan *proto.GetAuthConfigResponse, 1)
+	watcher <- s.authConfig
+	s.watchers[watcher] = true
+
+	return watcher
+}
+
+func (s *Server) removeWatcher(watcher chan *proto.GetAuthConfigResponse) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.watchers, watcher)
+}
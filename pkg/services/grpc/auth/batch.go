@@ -0,0 +1,51 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+
+	authEnums "github.com/ZupIT/horusec-devkit/pkg/enums/auth"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/enums"
+)
+
+func NewIsAuthorizedBatchItem(authorizationType authEnums.AuthorizationType, workspaceID,
+	repositoryID string) *proto.IsAuthorizedBatchItem {
+	return &proto.IsAuthorizedBatchItem{
+		Type:         authorizationType.ToString(),
+		WorkspaceID:  workspaceID,
+		RepositoryID: repositoryID,
+	}
+}
+
+// IsAuthorizedBatch checks every item in a single call, returning the results in the same order of the items
+func IsAuthorizedBatch(ctx context.Context, client proto.AuthServiceClient, token string,
+	items ...*proto.IsAuthorizedBatchItem) ([]bool, error) {
+	if len(items) == 0 {
+		return []bool{}, nil
+	}
+
+	response, err := client.IsAuthorizedBatch(ctx, &proto.IsAuthorizedBatchData{Token: token, Items: items})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(response.IsAuthorized) != len(items) {
+		return nil, enums.ErrorInvalidBatchResult
+	}
+
+	return response.IsAuthorized, nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	authEnums "github.com/ZupIT/horusec-devkit/pkg/enums/auth"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/enums"
+)
+
+func TestNewIsAuthorizedBatchItem(t *testing.T) {
+	t.Run("should create item with authorization type", func(t *testing.T) {
+		item := NewIsAuthorizedBatchItem(authEnums.WorkspaceAdmin, "test", "")
+
+		assert.Equal(t, authEnums.WorkspaceAdmin.ToString(), item.Type)
+		assert.Equal(t, "test", item.WorkspaceID)
+	})
+}
+
+func TestIsAuthorizedBatch(t *testing.T) {
+	items := []*proto.IsAuthorizedBatchItem{
+		NewIsAuthorizedBatchItem(authEnums.WorkspaceMember, "test", ""),
+		NewIsAuthorizedBatchItem(authEnums.RepositoryAdmin, "test", "test"),
+	}
+
+	t.Run("should return results in the same order of the items", func(t *testing.T) {
+		client := &proto.Mock{}
+		client.On("IsAuthorizedBatch").Return(&proto.IsAuthorizedBatchResponse{IsAuthorized: []bool{true, false}}, nil)
+
+		result, err := IsAuthorizedBatch(context.Background(), client, "token", items...)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []bool{true, false}, result)
+	})
+
+	t.Run("should return empty result without calling the service when no items", func(t *testing.T) {
+		result, err := IsAuthorizedBatch(context.Background(), &proto.Mock{}, "token")
+
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("should return error when service fails", func(t *testing.T) {
+		client := &proto.Mock{}
+		client.On("IsAuthorizedBatch").Return(&proto.IsAuthorizedBatchResponse{}, errors.New("test"))
+
+		_, err := IsAuthorizedBatch(context.Background(), client, "token", items...)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when number of results is different", func(t *testing.T) {
+		client := &proto.Mock{}
+		client.On("IsAuthorizedBatch").Return(&proto.IsAuthorizedBatchResponse{IsAuthorized: []bool{true}}, nil)
+
+		_, err := IsAuthorizedBatch(context.Background(), client, "token", items...)
+
+		assert.ErrorIs(t, err, enums.ErrorInvalidBatchResult)
+	})
+}
@@ -16,13 +16,20 @@ package auth
 
 import (
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials"
 
+	"github.com/ZupIT/horusec-devkit/pkg/services/chaos"
+	"github.com/ZupIT/horusec-devkit/pkg/services/circuitbreaker"
 	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/interceptors"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/options"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/pool"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/security"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
 )
 
+// NewAuthGRPCConnection returns a single *grpc.ClientConn to the auth service, which can be given to the health check
+// client. Use NewAuthGRPCPool to spread the calls between many connections.
 func NewAuthGRPCConnection() grpc.ClientConnInterface {
 	conn, err := makeConnection()
 	if err != nil {
@@ -32,30 +39,60 @@ func NewAuthGRPCConnection() grpc.ClientConnInterface {
 	return conn
 }
 
-func makeConnection() (grpc.ClientConnInterface, error) {
-	if env.GetEnvOrDefaultBool(enums.HorusecGRPCConnectionUsesCerts, false) {
-		return setupWithCerts()
+// NewAuthGRPCPool returns a pool of connections to the auth service configured by the pool options, which resolves
+// the targets without scheme by dns to balance the calls between all the addresses of the service
+func NewAuthGRPCPool() pool.IPool {
+	connections, err := makePool()
+	if err != nil {
+		logger.LogPanic(enums.MessageFailedToConnectToAuthGRPC, err)
+	}
+
+	return connections
+}
+
+func makeConnection() (*grpc.ClientConn, error) {
+	dialOptions, err := getDialOptions()
+	if err != nil {
+		return nil, err
 	}
 
-	return setupWithoutCerts()
+	return grpc.Dial(getAuthGRPCURL(), dialOptions...)
 }
 
-func setupWithoutCerts() (grpc.ClientConnInterface, error) {
-	return grpc.Dial(env.GetEnvOrDefault(enums.HorusecAuthGRPCURL, enums.HorusecDefaultAuthHost),
-		grpc.WithInsecure())
+func makePool() (pool.IPool, error) {
+	dialOptions, err := getDialOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	return pool.NewPool(getAuthGRPCURL(), pool.NewOptions(), dialOptions...)
 }
 
-func setupWithCerts() (grpc.ClientConnInterface, error) {
-	return grpc.Dial(env.GetEnvOrDefault(enums.HorusecAuthGRPCURL, enums.HorusecDefaultAuthHost),
-		grpc.WithTransportCredentials(getCredentials()))
+func getAuthGRPCURL() string {
+	return env.GetEnvOrDefault(enums.HorusecAuthGRPCURL, enums.HorusecDefaultAuthHost)
 }
 
-func getCredentials() credentials.TransportCredentials {
-	cred, err := credentials.NewClientTLSFromFile(
-		env.GetEnvOrDefault(enums.HorusecGRPCCertificatePath, ""), "")
+func getDialOptions() ([]grpc.DialOption, error) {
+	credentials, err := security.NewConfig().GetDialOption()
 	if err != nil {
 		logger.LogError(enums.MessageFailedToGetGRPCCerts, err)
+
+		return nil, err
 	}
 
-	return cred
+	return append(options.NewOptions().DialOptions(), credentials, getInterceptors()), nil
+}
+
+func getInterceptors() grpc.DialOption {
+	return grpc.WithChainUnaryInterceptor(interceptors.UnaryClientPropagation(),
+		interceptors.UnaryClientCircuitBreaker(newCircuitBreaker()),
+		interceptors.UnaryClientRetry(interceptors.NewRetryOptions()),
+		interceptors.UnaryClientChaos(chaos.NewInjector(chaos.NewOptions())))
+}
+
+func newCircuitBreaker() circuitbreaker.ICircuitBreaker {
+	options := circuitbreaker.NewOptions()
+	options.IsFailure = interceptors.IsCircuitBreakerFailure
+
+	return circuitbreaker.NewCircuitBreaker(enums.AuthCircuitBreakerName, options)
 }
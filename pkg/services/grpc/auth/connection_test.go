@@ -19,6 +19,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/health"
 )
 
 func TestNewAuthGRPCConnection(t *testing.T) {
@@ -28,6 +31,20 @@ func TestNewAuthGRPCConnection(t *testing.T) {
 		assert.NotNil(t, NewAuthGRPCConnection())
 	})
 
+	t.Run("should return a grpc client connection usable by the health check client", func(t *testing.T) {
+		_ = os.Setenv("HORUSEC_GRPC_USE_CERTS", "false")
+
+		connection, ok := NewAuthGRPCConnection().(*grpc.ClientConn)
+		assert.True(t, ok)
+
+		defer func() {
+			_ = connection.Close()
+		}()
+
+		_, status := health.NewHealthCheckGrpcClient(connection).IsAvailable()
+		assert.NotEmpty(t, status)
+	})
+
 	t.Run("should panic when failed to make connection with certs", func(t *testing.T) {
 		_ = os.Setenv("HORUSEC_GRPC_USE_CERTS", "true")
 
@@ -36,3 +53,26 @@ func TestNewAuthGRPCConnection(t *testing.T) {
 		})
 	})
 }
+
+func TestNewAuthGRPCPool(t *testing.T) {
+	t.Run("should return a pool usable by the health check client", func(t *testing.T) {
+		_ = os.Setenv("HORUSEC_GRPC_USE_CERTS", "false")
+
+		connections := NewAuthGRPCPool()
+
+		defer func() {
+			_ = connections.Close()
+		}()
+
+		_, status := health.NewHealthCheckGrpcClient(connections).IsAvailable()
+		assert.NotEmpty(t, status)
+	})
+
+	t.Run("should panic when failed to make the pool with certs", func(t *testing.T) {
+		_ = os.Setenv("HORUSEC_GRPC_USE_CERTS", "true")
+
+		assert.Panics(t, func() {
+			NewAuthGRPCPool()
+		})
+	})
+}
@@ -0,0 +1,175 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"google.golang.org/grpc"
+	protobuf "google.golang.org/protobuf/proto"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
+)
+
+type IGateway interface {
+	Routes(router chi.Router)
+}
+
+// Gateway exposes the auth grpc service over http for the deployments where the ingress is not able to route grpc.
+// The request and response bodies use the protobuf json mapping, so it should be mounted with the router Route func.
+// The request bodies are limited by HORUSEC_GRPC_GATEWAY_MAX_BODY_SIZE_BYTES, answering 413 when it is exceeded.
+type Gateway struct {
+	client      proto.AuthServiceClient
+	maxBodySize int64
+}
+
+func NewGateway(conn grpc.ClientConnInterface) IGateway {
+	return &Gateway{
+		client: proto.NewAuthServiceClient(conn),
+		maxBodySize: int64(env.GetEnvOrDefaultInt(enums.HorusecGRPCGatewayMaxBodySize,
+			enums.DefaultGatewayMaxBodySize)),
+	}
+}
+
+func (g *Gateway) Routes(router chi.Router) {
+	router.Post(enums.GatewayIsAuthorizedRoute, g.IsAuthorized)
+	router.Post(enums.GatewayIsAuthorizedBatchRoute, g.IsAuthorizedBatch)
+	router.Post(enums.GatewayAccountInfoRoute, g.GetAccountInfo)
+	router.Post(enums.GatewayListPermissionsRoute, g.ListPermissions)
+	router.Get(enums.GatewayAuthConfigRoute, g.GetAuthConfig)
+}
+
+func (g *Gateway) IsAuthorized(w http.ResponseWriter, r *http.Request) {
+	data := &proto.IsAuthorizedData{}
+	if err := g.decodeBody(w, r, data); err != nil {
+		writeDecodeError(w, err)
+
+		return
+	}
+
+	data.Token = getToken(r, data.Token)
+	response, err := g.client.IsAuthorized(r.Context(), data)
+	writeResponse(w, response, err)
+}
+
+func (g *Gateway) IsAuthorizedBatch(w http.ResponseWriter, r *http.Request) {
+	data := &proto.IsAuthorizedBatchData{}
+	if err := g.decodeBody(w, r, data); err != nil {
+		writeDecodeError(w, err)
+
+		return
+	}
+
+	data.Token = getToken(r, data.Token)
+	response, err := g.client.IsAuthorizedBatch(r.Context(), data)
+	writeResponse(w, response, err)
+}
+
+func (g *Gateway) GetAccountInfo(w http.ResponseWriter, r *http.Request) {
+	data := &proto.GetAccountData{}
+	if err := g.decodeBody(w, r, data); err != nil {
+		writeDecodeError(w, err)
+
+		return
+	}
+
+	data.Token = getToken(r, data.Token)
+	response, err := g.client.GetAccountInfo(r.Context(), data)
+	writeResponse(w, response, err)
+}
+
+func (g *Gateway) ListPermissions(w http.ResponseWriter, r *http.Request) {
+	data := &proto.ListPermissionsData{}
+	if err := g.decodeBody(w, r, data); err != nil {
+		writeDecodeError(w, err)
+
+		return
+	}
+
+	data.Token = getToken(r, data.Token)
+	response, err := g.client.ListPermissions(r.Context(), data)
+	writeResponse(w, response, err)
+}
+
+func (g *Gateway) GetAuthConfig(w http.ResponseWriter, r *http.Request) {
+	response, err := g.client.GetAuthConfig(r.Context(), &proto.GetAuthConfigData{})
+	writeResponse(w, response, err)
+}
+
+// getToken allows the clients to send the token in the same headers used by the authorization middlewares
+func getToken(r *http.Request, token string) string {
+	if token != "" {
+		return token
+	}
+
+	return jwt.GetTokenFromRequest(r)
+}
+
+func (g *Gateway) decodeBody(w http.ResponseWriter, r *http.Request, message protobuf.Message) error {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, g.maxBodySize))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return enums.ErrorGatewayBodyTooLarge
+		}
+
+		return enums.ErrorGatewayInvalidBody
+	}
+
+	if len(body) == 0 {
+		return nil
+	}
+
+	if err := unmarshalOptions.Unmarshal(body, message); err != nil {
+		return enums.ErrorGatewayInvalidBody
+	}
+
+	return nil
+}
+
+func writeDecodeError(w http.ResponseWriter, err error) {
+	if errors.Is(err, enums.ErrorGatewayBodyTooLarge) {
+		httpUtil.StatusRequestEntityTooLarge(w, err)
+
+		return
+	}
+
+	httpUtil.StatusBadRequest(w, err)
+}
+
+func writeResponse(w http.ResponseWriter, response protobuf.Message, err error) {
+	if err != nil {
+		writeError(w, err)
+
+		return
+	}
+
+	content, err := marshalOptions.Marshal(response)
+	if err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+
+		return
+	}
+
+	httpUtil.StatusOK(w, json.RawMessage(content))
+}
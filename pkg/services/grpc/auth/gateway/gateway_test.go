@@ -0,0 +1,162 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+)
+
+func newTestRouter(client proto.AuthServiceClient) *chi.Mux {
+	router := chi.NewRouter()
+	router.Route("/auth", (&Gateway{client: client, maxBodySize: 128}).Routes)
+
+	return router
+}
+
+func doRequest(router http.Handler, method, url, body string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(method, url, strings.NewReader(body))
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	return w
+}
+
+func TestNewGateway(t *testing.T) {
+	t.Run("should success create a new gateway", func(t *testing.T) {
+		assert.NotNil(t, NewGateway(&grpc.ClientConn{}))
+	})
+}
+
+func TestRoutes(t *testing.T) {
+	t.Run("should return 200 and keep false values when is authorized", func(t *testing.T) {
+		grpcMock := &proto.Mock{}
+		grpcMock.On("IsAuthorized").Return(&proto.IsAuthorizedResponse{IsAuthorized: false}, nil)
+
+		w := doRequest(newTestRouter(grpcMock), http.MethodPost, "/auth/is-authorized",
+			`{"token": "test", "type": "IsWorkspaceMember", "workspaceID": "test"}`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"isAuthorized":false`)
+	})
+
+	t.Run("should return 200 when is authorized batch", func(t *testing.T) {
+		grpcMock := &proto.Mock{}
+		grpcMock.On("IsAuthorizedBatch").Return(
+			&proto.IsAuthorizedBatchResponse{IsAuthorized: []bool{true, false}}, nil)
+
+		w := doRequest(newTestRouter(grpcMock), http.MethodPost, "/auth/is-authorized-batch",
+			`{"items": [{"type": "IsWorkspaceMember"}, {"type": "IsWorkspaceAdmin"}]}`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"isAuthorized":[true,false]`)
+	})
+
+	t.Run("should return 200 when get account info", func(t *testing.T) {
+		grpcMock := &proto.Mock{}
+		grpcMock.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{AccountID: "test"}, nil)
+
+		w := doRequest(newTestRouter(grpcMock), http.MethodPost, "/auth/account-info", `{"token": "test"}`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"accountID":"test"`)
+	})
+
+	t.Run("should return 200 when list permissions without body", func(t *testing.T) {
+		grpcMock := &proto.Mock{}
+		grpcMock.On("ListPermissions").Return(&proto.ListPermissionsResponse{
+			Workspaces: map[string]string{"test": "admin"},
+		}, nil)
+
+		w := doRequest(newTestRouter(grpcMock), http.MethodPost, "/auth/permissions", "")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"workspaces":{"test":"admin"}`)
+	})
+
+	t.Run("should return 200 when get auth config", func(t *testing.T) {
+		grpcMock := &proto.Mock{}
+		grpcMock.On("GetAuthConfig").Return(&proto.GetAuthConfigResponse{AuthType: "horusec"}, nil)
+
+		w := doRequest(newTestRouter(grpcMock), http.MethodGet, "/auth/config", "")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"authType":"horusec"`)
+	})
+
+	t.Run("should return 400 when invalid body", func(t *testing.T) {
+		w := doRequest(newTestRouter(&proto.Mock{}), http.MethodPost, "/auth/is-authorized", "{")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 413 when the body is too large", func(t *testing.T) {
+		w := doRequest(newTestRouter(&proto.Mock{}), http.MethodPost, "/auth/is-authorized",
+			`{"token": "`+strings.Repeat("a", 128)+`"}`)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	})
+}
+
+func TestWriteError(t *testing.T) {
+	t.Run("should map grpc status codes to http status", func(t *testing.T) {
+		expected := map[codes.Code]int{
+			codes.InvalidArgument:  http.StatusBadRequest,
+			codes.Unauthenticated:  http.StatusUnauthorized,
+			codes.PermissionDenied: http.StatusForbidden,
+			codes.NotFound:         http.StatusNotFound,
+			codes.Unimplemented:    http.StatusMethodNotAllowed,
+			codes.Unavailable:      http.StatusInternalServerError,
+		}
+
+		for code, httpStatus := range expected {
+			w := httptest.NewRecorder()
+
+			writeError(w, status.Error(code, "test"))
+
+			assert.Equal(t, httpStatus, w.Code)
+		}
+	})
+
+	t.Run("should return 500 when unknown error", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		writeError(w, errors.New("test"))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestGetToken(t *testing.T) {
+	t.Run("should return token from header when body token is empty", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/", nil)
+		req.Header.Set("X-Horusec-Authorization", "header")
+
+		assert.Equal(t, "header", getToken(req, ""))
+		assert.Equal(t, "body", getToken(req, "body"))
+	})
+}
@@ -0,0 +1,50 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/enums"
+	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
+)
+
+var (
+	// marshalOptions keeps the false and empty values, otherwise a not authorized response would have an empty body
+	marshalOptions   = protojson.MarshalOptions{EmitUnpopulated: true}
+	unmarshalOptions = protojson.UnmarshalOptions{DiscardUnknown: true}
+)
+
+// writeError maps the grpc status codes to the closest http status, hiding the internal errors details
+func writeError(w http.ResponseWriter, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		httpUtil.StatusBadRequest(w, enums.ErrorGatewayInvalidArgument)
+	case codes.Unauthenticated:
+		httpUtil.StatusUnauthorized(w, enums.ErrorGatewayUnauthenticated)
+	case codes.PermissionDenied:
+		httpUtil.StatusForbidden(w, enums.ErrorGatewayPermissionDenied)
+	case codes.NotFound:
+		httpUtil.StatusNotFound(w, enums.ErrorGatewayNotFound)
+	case codes.Unimplemented:
+		httpUtil.StatusMethodNotAllowed(w, enums.ErrorGatewayUnimplemented)
+	default:
+		httpUtil.StatusInternalServerError(w, err)
+	}
+}
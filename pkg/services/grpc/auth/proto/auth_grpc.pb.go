@@ -1,4 +1,8 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.15.8
+// source: pkg/services/grpc/auth/proto/auth.proto
 
 package proto
 
@@ -22,6 +26,9 @@ type AuthServiceClient interface {
 	IsAuthorized(ctx context.Context, in *IsAuthorizedData, opts ...grpc.CallOption) (*IsAuthorizedResponse, error)
 	GetAccountInfo(ctx context.Context, in *GetAccountData, opts ...grpc.CallOption) (*GetAccountDataResponse, error)
 	GetAuthConfig(ctx context.Context, in *GetAuthConfigData, opts ...grpc.CallOption) (*GetAuthConfigResponse, error)
+	IsAuthorizedBatch(ctx context.Context, in *IsAuthorizedBatchData, opts ...grpc.CallOption) (*IsAuthorizedBatchResponse, error)
+	ListPermissions(ctx context.Context, in *ListPermissionsData, opts ...grpc.CallOption) (*ListPermissionsResponse, error)
+	WatchAuthConfig(ctx context.Context, in *GetAuthConfigData, opts ...grpc.CallOption) (AuthService_WatchAuthConfigClient, error)
 }
 
 type authServiceClient struct {
@@ -59,6 +66,56 @@ func (c *authServiceClient) GetAuthConfig(ctx context.Context, in *GetAuthConfig
 	return out, nil
 }
 
+func (c *authServiceClient) IsAuthorizedBatch(ctx context.Context, in *IsAuthorizedBatchData, opts ...grpc.CallOption) (*IsAuthorizedBatchResponse, error) {
+	out := new(IsAuthorizedBatchResponse)
+	err := c.cc.Invoke(ctx, "/grpc.AuthService/IsAuthorizedBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListPermissions(ctx context.Context, in *ListPermissionsData, opts ...grpc.CallOption) (*ListPermissionsResponse, error) {
+	out := new(ListPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/grpc.AuthService/ListPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) WatchAuthConfig(ctx context.Context, in *GetAuthConfigData, opts ...grpc.CallOption) (AuthService_WatchAuthConfigClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AuthService_ServiceDesc.Streams[0], "/grpc.AuthService/WatchAuthConfig", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &authServiceWatchAuthConfigClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AuthService_WatchAuthConfigClient interface {
+	Recv() (*GetAuthConfigResponse, error)
+	grpc.ClientStream
+}
+
+type authServiceWatchAuthConfigClient struct {
+	grpc.ClientStream
+}
+
+func (x *authServiceWatchAuthConfigClient) Recv() (*GetAuthConfigResponse, error) {
+	m := new(GetAuthConfigResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // AuthServiceServer is the server API for AuthService service.
 // All implementations must embed UnimplementedAuthServiceServer
 // for forward compatibility
@@ -66,6 +123,9 @@ type AuthServiceServer interface {
 	IsAuthorized(context.Context, *IsAuthorizedData) (*IsAuthorizedResponse, error)
 	GetAccountInfo(context.Context, *GetAccountData) (*GetAccountDataResponse, error)
 	GetAuthConfig(context.Context, *GetAuthConfigData) (*GetAuthConfigResponse, error)
+	IsAuthorizedBatch(context.Context, *IsAuthorizedBatchData) (*IsAuthorizedBatchResponse, error)
+	ListPermissions(context.Context, *ListPermissionsData) (*ListPermissionsResponse, error)
+	WatchAuthConfig(*GetAuthConfigData, AuthService_WatchAuthConfigServer) error
 	mustEmbedUnimplementedAuthServiceServer()
 }
 
@@ -82,6 +142,15 @@ func (UnimplementedAuthServiceServer) GetAccountInfo(context.Context, *GetAccoun
 func (UnimplementedAuthServiceServer) GetAuthConfig(context.Context, *GetAuthConfigData) (*GetAuthConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAuthConfig not implemented")
 }
+func (UnimplementedAuthServiceServer) IsAuthorizedBatch(context.Context, *IsAuthorizedBatchData) (*IsAuthorizedBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsAuthorizedBatch not implemented")
+}
+func (UnimplementedAuthServiceServer) ListPermissions(context.Context, *ListPermissionsData) (*ListPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPermissions not implemented")
+}
+func (UnimplementedAuthServiceServer) WatchAuthConfig(*GetAuthConfigData, AuthService_WatchAuthConfigServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchAuthConfig not implemented")
+}
 func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
 
 // UnsafeAuthServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -149,6 +218,63 @@ func _AuthService_GetAuthConfig_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_IsAuthorizedBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsAuthorizedBatchData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).IsAuthorizedBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AuthService/IsAuthorizedBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).IsAuthorizedBatch(ctx, req.(*IsAuthorizedBatchData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPermissionsData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AuthService/ListPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListPermissions(ctx, req.(*ListPermissionsData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_WatchAuthConfig_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetAuthConfigData)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AuthServiceServer).WatchAuthConfig(m, &authServiceWatchAuthConfigServer{stream})
+}
+
+type AuthService_WatchAuthConfigServer interface {
+	Send(*GetAuthConfigResponse) error
+	grpc.ServerStream
+}
+
+type authServiceWatchAuthConfigServer struct {
+	grpc.ServerStream
+}
+
+func (x *authServiceWatchAuthConfigServer) Send(m *GetAuthConfigResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -168,7 +294,21 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAuthConfig",
 			Handler:    _AuthService_GetAuthConfig_Handler,
 		},
+		{
+			MethodName: "IsAuthorizedBatch",
+			Handler:    _AuthService_IsAuthorizedBatch_Handler,
+		},
+		{
+			MethodName: "ListPermissions",
+			Handler:    _AuthService_ListPermissions_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchAuthConfig",
+			Handler:       _AuthService_WatchAuthConfig_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/services/grpc/auth/proto/auth.proto",
 }
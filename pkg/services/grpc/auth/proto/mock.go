@@ -47,3 +47,25 @@ func (m *Mock) GetAuthConfig(_ context.Context, _ *GetAuthConfigData,
 
 	return args.Get(0).(*GetAuthConfigResponse), mockUtils.ReturnNilOrError(args, 1)
 }
+
+func (m *Mock) IsAuthorizedBatch(_ context.Context, _ *IsAuthorizedBatchData,
+	_ ...grpc.CallOption) (*IsAuthorizedBatchResponse, error) {
+	args := m.MethodCalled("IsAuthorizedBatch")
+
+	return args.Get(0).(*IsAuthorizedBatchResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListPermissions(_ context.Context, _ *ListPermissionsData,
+	_ ...grpc.CallOption) (*ListPermissionsResponse, error) {
+	args := m.MethodCalled("ListPermissions")
+
+	return args.Get(0).(*ListPermissionsResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) WatchAuthConfig(_ context.Context, _ *GetAuthConfigData,
+	_ ...grpc.CallOption) (AuthService_WatchAuthConfigClient, error) {
+	args := m.MethodCalled("WatchAuthConfig")
+	stream, _ := args.Get(0).(AuthService_WatchAuthConfigClient)
+
+	return stream, mockUtils.ReturnNilOrError(args, 1)
+}
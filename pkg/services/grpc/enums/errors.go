@@ -0,0 +1,31 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+import "errors"
+
+var (
+	ErrorGRPCPanicRecovered = errors.New("{ERROR_GRPC} internal error while handling request")
+	ErrorInvalidCAFile      = errors.New("{ERROR_GRPC} failed to append certificates from ca file")
+	ErrorInvalidBatchResult = errors.New("{ERROR_GRPC} is authorized batch returned a different number of results")
+
+	ErrorGatewayInvalidBody      = errors.New("{ERROR_GRPC} invalid request body")
+	ErrorGatewayBodyTooLarge     = errors.New("{ERROR_GRPC} request body is too large")
+	ErrorGatewayInvalidArgument  = errors.New("{ERROR_GRPC} auth service rejected the request arguments")
+	ErrorGatewayUnauthenticated  = errors.New("{ERROR_GRPC} missing or invalid authorization token")
+	ErrorGatewayPermissionDenied = errors.New("{ERROR_GRPC} permission denied by auth service")
+	ErrorGatewayNotFound         = errors.New("{ERROR_GRPC} requested resource was not found by auth service")
+	ErrorGatewayUnimplemented    = errors.New("{ERROR_GRPC} auth service does not support this operation")
+)
@@ -15,6 +15,13 @@
 package enums
 
 const (
-	MessageFailedToConnectToAuthGRPC = "grpc connection to horusec auth failed"
-	MessageFailedToGetGRPCCerts      = "failed to get grpc certificates"
+	MessageFailedToConnectToAuthGRPC    = "grpc connection to horusec auth failed"
+	MessageFailedToGetGRPCCerts         = "failed to get grpc certificates"
+	MessageGRPCRequest                  = "grpc request method=%s peer=%s latency=%s code=%s"
+	MessageGRPCRequestFailed            = "grpc request failed"
+	MessageGRPCPanicRecovered           = "recovered from panic while handling grpc request"
+	MessageFailedToWatchAuthConfig      = "failed to watch auth config, retrying"
+	MessageFailedToRedialPoolConnection = "failed to redial unhealthy grpc pool connection"
+	MessageFailedToClosePoolConnection  = "failed to close grpc pool connection"
+	MessagePoolConnectionRedialed       = "unhealthy grpc pool connection replaced by a new one"
 )
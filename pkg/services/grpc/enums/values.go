@@ -18,5 +18,74 @@ const (
 	HorusecGRPCConnectionUsesCerts = "HORUSEC_GRPC_USE_CERTS"
 	HorusecDefaultAuthHost         = "localhost:8007"
 	HorusecAuthGRPCURL             = "HORUSEC_GRPC_AUTH_URL"
+	AuthCircuitBreakerName         = "grpc_auth"
 	HorusecGRPCCertificatePath     = "HORUSEC_GRPC_CERT_PATH"
+	HorusecGRPCTLSCertPath         = "HORUSEC_GRPC_TLS_CERT_PATH"
+	HorusecGRPCTLSKeyPath          = "HORUSEC_GRPC_TLS_KEY_PATH"
+	HorusecGRPCClientCAPath        = "HORUSEC_GRPC_CLIENT_CA_PATH"
+	HorusecGRPCServerNameOverride  = "HORUSEC_GRPC_SERVER_NAME_OVERRIDE"
+
+	MetricsLabelMethod = "method"
+	MetricsLabelCode   = "code"
+
+	HorusecGRPCRetryMaxAttempts    = "HORUSEC_GRPC_RETRY_MAX_ATTEMPTS"
+	HorusecGRPCRetryInitialBackoff = "HORUSEC_GRPC_RETRY_INITIAL_BACKOFF_MS"
+	HorusecGRPCRetryMaxBackoff     = "HORUSEC_GRPC_RETRY_MAX_BACKOFF_MS"
+	HorusecGRPCHedgingDelay        = "HORUSEC_GRPC_HEDGING_DELAY_MS"
+	DefaultRetryMaxAttempts        = 3
+	DefaultRetryInitialBackoff     = 100
+	DefaultRetryMaxBackoff         = 1000
+	DefaultRetryJitter             = 0.2
+	AuthIsAuthorizedMethod         = "/grpc.AuthService/IsAuthorized"
+
+	HealthSubsystemDatabase = "database"
+	HealthSubsystemBroker   = "broker"
+
+	HorusecGRPCKeepaliveTime          = "HORUSEC_GRPC_KEEPALIVE_TIME_SECONDS"
+	HorusecGRPCKeepaliveTimeout       = "HORUSEC_GRPC_KEEPALIVE_TIMEOUT_SECONDS"
+	HorusecGRPCKeepaliveWithoutStream = "HORUSEC_GRPC_KEEPALIVE_PERMIT_WITHOUT_STREAM"
+	HorusecGRPCKeepaliveMinTime       = "HORUSEC_GRPC_KEEPALIVE_MIN_TIME_SECONDS"
+	HorusecGRPCMaxConnectionIdle      = "HORUSEC_GRPC_MAX_CONNECTION_IDLE_SECONDS"
+	HorusecGRPCMaxRecvMsgSize         = "HORUSEC_GRPC_MAX_RECV_MSG_SIZE_BYTES"
+	HorusecGRPCMaxSendMsgSize         = "HORUSEC_GRPC_MAX_SEND_MSG_SIZE_BYTES"
+	HorusecGRPCBackoffBaseDelay       = "HORUSEC_GRPC_BACKOFF_BASE_DELAY_MS"
+	HorusecGRPCBackoffMaxDelay        = "HORUSEC_GRPC_BACKOFF_MAX_DELAY_MS"
+	HorusecGRPCMinConnectTimeout      = "HORUSEC_GRPC_MIN_CONNECT_TIMEOUT_SECONDS"
+	DefaultServerAddress              = ":8007"
+	DefaultKeepaliveTime              = 300
+	DefaultKeepaliveTimeout           = 10
+	DefaultKeepaliveMinTime           = 60
+	DefaultMaxMsgSize                 = 4 * 1024 * 1024
+	DefaultBackoffBaseDelay           = 1000
+	DefaultBackoffMaxDelay            = 120000
+	DefaultMinConnectTimeout          = 20
+
+	HorusecGRPCAccountCacheDuration = "HORUSEC_GRPC_ACCOUNT_CACHE_SECONDS"
+	DefaultAccountCacheDuration     = 30
+	AccountInfoCachePrefix          = "horusec-account-info-"
+	ListPermissionsCachePrefix      = "horusec-list-permissions-"
+
+	HorusecGRPCAuthConfigRetryInterval = "HORUSEC_GRPC_AUTH_CONFIG_RETRY_INTERVAL_SECONDS"
+	DefaultAuthConfigRetryInterval     = 5
+
+	HorusecGRPCPoolSize                = "HORUSEC_GRPC_POOL_SIZE"
+	HorusecGRPCLoadBalancingPolicy     = "HORUSEC_GRPC_LOAD_BALANCING_POLICY"
+	HorusecGRPCPoolHealthCheckInterval = "HORUSEC_GRPC_POOL_HEALTH_CHECK_INTERVAL_SECONDS"
+	HorusecGRPCPoolMaxFailures         = "HORUSEC_GRPC_POOL_MAX_FAILURES"
+	HorusecGRPCPoolDrainTimeout        = "HORUSEC_GRPC_POOL_DRAIN_TIMEOUT_SECONDS"
+	DefaultPoolSize                    = 1
+	DefaultLoadBalancingPolicy         = "round_robin"
+	DefaultPoolHealthCheckInterval     = 10
+	DefaultPoolMaxFailures             = 3
+	DefaultPoolDrainTimeout            = 10
+	LoadBalancingServiceConfig         = `{"loadBalancingConfig":[{"%s":{}}]}`
+	DNSResolverScheme                  = "dns:///"
+
+	GatewayIsAuthorizedRoute      = "/is-authorized"
+	GatewayIsAuthorizedBatchRoute = "/is-authorized-batch"
+	GatewayAccountInfoRoute       = "/account-info"
+	GatewayListPermissionsRoute   = "/permissions"
+	GatewayAuthConfigRoute        = "/config"
+	HorusecGRPCGatewayMaxBodySize = "HORUSEC_GRPC_GATEWAY_MAX_BODY_SIZE_BYTES"
+	DefaultGatewayMaxBodySize     = 1024 * 1024
 )
@@ -15,7 +15,6 @@
 package health
 
 import (
-	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
 )
 
@@ -23,17 +22,29 @@ type ICheckClient interface {
 	IsAvailable() (bool, string)
 }
 
+// IConnection is the state of a grpc connection, implemented by the *grpc.ClientConn and by the connection pool
+type IConnection interface {
+	GetState() connectivity.State
+	Connect()
+}
+
 type CheckClient struct {
-	grpcCon *grpc.ClientConn
+	grpcCon IConnection
 }
 
-func NewHealthCheckGrpcClient(grpcCon *grpc.ClientConn) ICheckClient {
+func NewHealthCheckGrpcClient(grpcCon IConnection) ICheckClient {
 	return &CheckClient{
 		grpcCon: grpcCon,
 	}
 }
 
+// IsAvailable considers an idle connection available, asking it to connect since the idle connections only connect on
+// the first call
 func (c *CheckClient) IsAvailable() (bool, string) {
+	if c.grpcCon.GetState() == connectivity.Idle {
+		c.grpcCon.Connect()
+	}
+
 	if state := c.grpcCon.GetState(); state != connectivity.Idle && state != connectivity.Ready {
 		return false, c.grpcCon.GetState().String()
 	}
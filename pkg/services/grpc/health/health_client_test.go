@@ -15,12 +15,27 @@
 package health
 
 import (
+	"net"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
 )
 
+type connectionStub struct {
+	state     connectivity.State
+	connected bool
+}
+
+func (c *connectionStub) GetState() connectivity.State {
+	return c.state
+}
+
+func (c *connectionStub) Connect() {
+	c.connected = true
+}
+
 func TestNewHealthCheckGrpcClient(t *testing.T) {
 	t.Run("should success create a new health check service", func(t *testing.T) {
 		service := NewHealthCheckGrpcClient(&grpc.ClientConn{})
@@ -31,7 +46,17 @@ func TestNewHealthCheckGrpcClient(t *testing.T) {
 
 func TestIsAvailable(t *testing.T) {
 	t.Run("should return true when healthy", func(t *testing.T) {
-		connection, err := grpc.Dial("localhost:9999", grpc.WithInsecure())
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		assert.NoError(t, err)
+
+		server := grpc.NewServer()
+		defer server.Stop()
+
+		go func() {
+			_ = server.Serve(listener)
+		}()
+
+		connection, err := grpc.Dial(listener.Addr().String(), grpc.WithInsecure(), grpc.WithBlock())
 		assert.NoError(t, err)
 
 		service := NewHealthCheckGrpcClient(connection)
@@ -41,6 +66,16 @@ func TestIsAvailable(t *testing.T) {
 		assert.NotEmpty(t, status)
 	})
 
+	t.Run("should connect an idle connection", func(t *testing.T) {
+		connection := &connectionStub{state: connectivity.Idle}
+
+		isAvailable, status := NewHealthCheckGrpcClient(connection).IsAvailable()
+
+		assert.True(t, isAvailable)
+		assert.Equal(t, connectivity.Idle.String(), status)
+		assert.True(t, connection.connected)
+	})
+
 	t.Run("should return false when unhealthy", func(t *testing.T) {
 		connection, err := grpc.Dial("localhost:9999", grpc.WithInsecure())
 		assert.NoError(t, err)
@@ -0,0 +1,144 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	grpcHealth "google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/enums"
+	healthManager "github.com/ZupIT/horusec-devkit/pkg/services/health"
+	managerEnums "github.com/ZupIT/horusec-devkit/pkg/services/health/enums"
+	httpHealthEnums "github.com/ZupIT/horusec-devkit/pkg/services/http/health/enums"
+)
+
+type IStatusServer interface {
+	SetSubsystemStatus(subsystem string, serving bool)
+	SetDatabaseStatus(serving bool)
+	SetBrokerStatus(serving bool)
+	Monitor(ctx context.Context, subsystem string, isAvailable func() bool, interval time.Duration)
+	MonitorManager(ctx context.Context, manager healthManager.IManager, interval time.Duration)
+	Shutdown()
+}
+
+// StatusServer implements the standard grpc.health.v1 service, where each subsystem can be checked by its name and
+// the empty service name, used by the kubernetes probes, is only serving when every subsystem is serving
+type StatusServer struct {
+	server     *grpcHealth.Server
+	mutex      sync.Mutex
+	subsystems map[string]bool
+}
+
+func RegisterHealthStatusServer(registrar grpc.ServiceRegistrar) IStatusServer {
+	status := &StatusServer{
+		server:     grpcHealth.NewServer(),
+		subsystems: map[string]bool{},
+	}
+
+	grpc_health_v1.RegisterHealthServer(registrar, status.server)
+
+	return status
+}
+
+func (s *StatusServer) SetSubsystemStatus(subsystem string, serving bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.subsystems[subsystem] = serving
+	s.server.SetServingStatus(subsystem, toServingStatus(serving))
+	s.server.SetServingStatus("", toServingStatus(s.isEverySubsystemServing()))
+}
+
+func (s *StatusServer) SetDatabaseStatus(serving bool) {
+	s.SetSubsystemStatus(enums.HealthSubsystemDatabase, serving)
+}
+
+func (s *StatusServer) SetBrokerStatus(serving bool) {
+	s.SetSubsystemStatus(enums.HealthSubsystemBroker, serving)
+}
+
+// Monitor updates the subsystem status with the result of isAvailable at each interval until the context is done
+func (s *StatusServer) Monitor(ctx context.Context, subsystem string, isAvailable func() bool,
+	interval time.Duration) {
+	s.SetSubsystemStatus(subsystem, isAvailable())
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.SetSubsystemStatus(subsystem, isAvailable())
+			}
+		}
+	}()
+}
+
+// MonitorManager sets the status of each readiness check of the manager as a subsystem at each interval until the
+// context is done, so the grpc service reports the same checks of the http endpoints
+func (s *StatusServer) MonitorManager(ctx context.Context, manager healthManager.IManager, interval time.Duration) {
+	s.updateFromManager(ctx, manager)
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.updateFromManager(ctx, manager)
+			}
+		}
+	}()
+}
+
+func (s *StatusServer) updateFromManager(ctx context.Context, manager healthManager.IManager) {
+	for name, check := range manager.Check(ctx, managerEnums.KindReadiness).Checks {
+		s.SetSubsystemStatus(name, check.Status == httpHealthEnums.StatusUp)
+	}
+}
+
+// Shutdown sets every status as not serving and ignores later updates, it should be called on graceful stop
+func (s *StatusServer) Shutdown() {
+	s.server.Shutdown()
+}
+
+func (s *StatusServer) isEverySubsystemServing() bool {
+	for _, serving := range s.subsystems {
+		if !serving {
+			return false
+		}
+	}
+
+	return true
+}
+
+func toServingStatus(serving bool) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	if serving {
+		return grpc_health_v1.HealthCheckResponse_SERVING
+	}
+
+	return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+}
@@ -0,0 +1,131 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/enums"
+	healthManager "github.com/ZupIT/horusec-devkit/pkg/services/health"
+	managerEnums "github.com/ZupIT/horusec-devkit/pkg/services/health/enums"
+)
+
+func getStatus(t *testing.T, status IStatusServer, service string) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	response, err := status.(*StatusServer).server.Check(context.Background(),
+		&grpc_health_v1.HealthCheckRequest{Service: service})
+	assert.NoError(t, err)
+
+	return response.Status
+}
+
+func TestRegisterHealthStatusServer(t *testing.T) {
+	t.Run("should register health service and start serving", func(t *testing.T) {
+		server := grpc.NewServer()
+		status := RegisterHealthStatusServer(server)
+
+		assert.Contains(t, server.GetServiceInfo(), "grpc.health.v1.Health")
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, getStatus(t, status, ""))
+	})
+}
+
+func TestSetSubsystemStatus(t *testing.T) {
+	t.Run("should set overall status as not serving when any subsystem is not serving", func(t *testing.T) {
+		status := RegisterHealthStatusServer(grpc.NewServer())
+
+		status.SetDatabaseStatus(true)
+		status.SetBrokerStatus(false)
+
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING,
+			getStatus(t, status, enums.HealthSubsystemDatabase))
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING,
+			getStatus(t, status, enums.HealthSubsystemBroker))
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, getStatus(t, status, ""))
+
+		status.SetBrokerStatus(true)
+
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, getStatus(t, status, ""))
+	})
+}
+
+func TestMonitor(t *testing.T) {
+	t.Run("should update status at each interval until context is done", func(t *testing.T) {
+		status := RegisterHealthStatusServer(grpc.NewServer())
+		available := int32(1)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		status.Monitor(ctx, enums.HealthSubsystemDatabase, func() bool {
+			return atomic.LoadInt32(&available) == 1
+		}, time.Millisecond)
+
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, getStatus(t, status, ""))
+
+		atomic.StoreInt32(&available, 0)
+
+		assert.Eventually(t, func() bool {
+			return getStatus(t, status, "") == grpc_health_v1.HealthCheckResponse_NOT_SERVING
+		}, time.Second, time.Millisecond)
+	})
+}
+
+func TestMonitorManager(t *testing.T) {
+	t.Run("should set status of each readiness check of the manager", func(t *testing.T) {
+		status := RegisterHealthStatusServer(grpc.NewServer())
+		available := int32(1)
+
+		manager := healthManager.NewManager(&healthManager.Options{Timeout: time.Second})
+		manager.AddCheck(&healthManager.Check{Name: "redis", Kind: managerEnums.KindReadiness,
+			Checker: func(ctx context.Context) error {
+				if atomic.LoadInt32(&available) == 1 {
+					return nil
+				}
+
+				return errors.New("test")
+			}})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		status.MonitorManager(ctx, manager, time.Millisecond)
+
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, getStatus(t, status, "redis"))
+
+		atomic.StoreInt32(&available, 0)
+
+		assert.Eventually(t, func() bool {
+			return getStatus(t, status, "") == grpc_health_v1.HealthCheckResponse_NOT_SERVING
+		}, time.Second, time.Millisecond)
+	})
+}
+
+func TestShutdown(t *testing.T) {
+	t.Run("should set status as not serving and ignore later updates", func(t *testing.T) {
+		status := RegisterHealthStatusServer(grpc.NewServer())
+
+		status.Shutdown()
+		status.SetDatabaseStatus(true)
+
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, getStatus(t, status, ""))
+	})
+}
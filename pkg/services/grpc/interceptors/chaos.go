@@ -0,0 +1,60 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptors
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/chaos"
+	chaosEnums "github.com/ZupIT/horusec-devkit/pkg/services/chaos/enums"
+)
+
+// UnaryClientChaos injects the faults of the grpc target before calling the server, returning the injected errors
+// as Unavailable, so the retry and circuit breaker interceptors handle them like a server failure. It should be the
+// innermost client interceptor.
+func UnaryClientChaos(injector chaos.IInjector) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, conn *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if err := injector.Inject(ctx, chaosEnums.TargetGRPC, method); err != nil {
+			return chaosStatus(err)
+		}
+
+		return invoker(ctx, method, req, reply, conn, opts...)
+	}
+}
+
+func StreamClientChaos(injector chaos.IInjector) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, conn *grpc.ClientConn, method string,
+		streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if err := injector.Inject(ctx, chaosEnums.TargetGRPC, method); err != nil {
+			return nil, chaosStatus(err)
+		}
+
+		return streamer(ctx, desc, conn, method, opts...)
+	}
+}
+
+func chaosStatus(err error) error {
+	if errors.Is(err, chaosEnums.ErrorFaultInjected) {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+
+	return status.FromContextError(err).Err()
+}
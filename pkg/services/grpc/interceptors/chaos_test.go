@@ -0,0 +1,74 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/chaos"
+	chaosEnums "github.com/ZupIT/horusec-devkit/pkg/services/chaos/enums"
+)
+
+func TestUnaryClientChaos(t *testing.T) {
+	t.Run("should return unavailable without calling the server when fault injected", func(t *testing.T) {
+		injector := &chaos.Mock{}
+		injector.On("Inject").Return(chaosEnums.ErrorFaultInjected).Once()
+		injector.On("Inject").Return(context.DeadlineExceeded).Once()
+		injector.On("Inject").Return(nil).Once()
+
+		calls := 0
+		invoker := func(context.Context, string, interface{}, interface{}, *grpc.ClientConn,
+			...grpc.CallOption) error {
+			calls++
+
+			return nil
+		}
+
+		interceptor := UnaryClientChaos(injector)
+
+		err := interceptor(context.Background(), testCheckMethod, nil, nil, nil, invoker)
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+
+		err = interceptor(context.Background(), testCheckMethod, nil, nil, nil, invoker)
+		assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+
+		assert.NoError(t, interceptor(context.Background(), testCheckMethod, nil, nil, nil, invoker))
+		assert.Equal(t, 1, calls)
+	})
+}
+
+func TestStreamClientChaos(t *testing.T) {
+	t.Run("should return unavailable without opening the stream when fault injected", func(t *testing.T) {
+		injector := &chaos.Mock{}
+		injector.On("Inject").Return(chaosEnums.ErrorFaultInjected)
+
+		streamer := func(context.Context, *grpc.StreamDesc, *grpc.ClientConn, string,
+			...grpc.CallOption) (grpc.ClientStream, error) {
+			assert.Fail(t, "should not open the stream")
+
+			return nil, nil
+		}
+
+		_, err := StreamClientChaos(injector)(context.Background(), &grpc.StreamDesc{}, nil, testCheckMethod,
+			streamer)
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+}
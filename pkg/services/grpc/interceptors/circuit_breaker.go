@@ -0,0 +1,56 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptors
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/circuitbreaker"
+	circuitBreakerEnums "github.com/ZupIT/horusec-devkit/pkg/services/circuitbreaker/enums"
+)
+
+// UnaryClientCircuitBreaker returns Unavailable without calling the server while the breaker is open. It should be
+// chained before the retry interceptor, so each call is recorded once with the result of its last attempt.
+func UnaryClientCircuitBreaker(breaker circuitbreaker.ICircuitBreaker) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, conn *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		err := breaker.Execute(ctx, func(ctx context.Context) error {
+			return invoker(ctx, method, req, reply, conn, opts...)
+		})
+
+		if errors.Is(err, circuitBreakerEnums.ErrorCircuitOpen) ||
+			errors.Is(err, circuitBreakerEnums.ErrorTooManyRequests) {
+			return status.Error(codes.Unavailable, err.Error())
+		}
+
+		return err
+	}
+}
+
+// IsCircuitBreakerFailure only counts the codes caused by an unhealthy server, since the other codes, like
+// PermissionDenied, are valid responses
+func IsCircuitBreakerFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Internal, codes.Unknown:
+		return true
+	default:
+		return false
+	}
+}
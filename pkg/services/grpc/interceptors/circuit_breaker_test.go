@@ -0,0 +1,60 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/circuitbreaker"
+)
+
+func TestUnaryClientCircuitBreaker(t *testing.T) {
+	t.Run("should return unavailable without calling the server when breaker is open", func(t *testing.T) {
+		calls := 0
+		interceptor := UnaryClientCircuitBreaker(circuitbreaker.NewCircuitBreaker("test", &circuitbreaker.Options{
+			ConsecutiveFailures: 1, OpenTimeout: time.Minute, IsFailure: IsCircuitBreakerFailure,
+		}))
+		invoker := func(context.Context, string, interface{}, interface{}, *grpc.ClientConn,
+			...grpc.CallOption) error {
+			calls++
+
+			return status.Error(codes.Unavailable, "test")
+		}
+
+		err := interceptor(context.Background(), testCheckMethod, nil, nil, nil, invoker)
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+
+		err = interceptor(context.Background(), testCheckMethod, nil, nil, nil, invoker)
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, 1, calls)
+	})
+}
+
+func TestIsCircuitBreakerFailure(t *testing.T) {
+	t.Run("should only count the codes of an unhealthy server", func(t *testing.T) {
+		assert.True(t, IsCircuitBreakerFailure(status.Error(codes.Unavailable, "test")))
+		assert.True(t, IsCircuitBreakerFailure(errors.New("test")))
+		assert.False(t, IsCircuitBreakerFailure(status.Error(codes.PermissionDenied, "test")))
+		assert.False(t, IsCircuitBreakerFailure(nil))
+	})
+}
@@ -0,0 +1,53 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptors
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	appErrors "github.com/ZupIT/horusec-devkit/pkg/utils/errors"
+)
+
+// UnaryServerErrors converts the app errors returned by the handlers to a status with their grpc code and public
+// message, logging the cause of the internal ones. The other errors are returned as they are.
+func UnaryServerErrors() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+
+		return resp, toStatusError(err)
+	}
+}
+
+func StreamServerErrors() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+		return toStatusError(handler(srv, stream))
+	}
+}
+
+func toStatusError(err error) error {
+	appError, ok := appErrors.As(err)
+	if !ok {
+		return err
+	}
+
+	appErrors.LogInternal(appError)
+
+	return status.Error(appError.GRPCCode, appError.Message)
+}
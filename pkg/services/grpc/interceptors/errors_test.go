@@ -0,0 +1,62 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/errorcode"
+	appErrors "github.com/ZupIT/horusec-devkit/pkg/utils/errors"
+)
+
+func TestUnaryServerErrors(t *testing.T) {
+	t.Run("should convert app error to status with public message", func(t *testing.T) {
+		_, err := UnaryServerErrors()(context.Background(), nil, &grpc.UnaryServerInfo{},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, appErrors.Wrap(errors.New("secret"), errorcode.PermissionDenied, "test")
+			})
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Equal(t, "test", status.Convert(err).Message())
+	})
+
+	t.Run("should return other errors as they are", func(t *testing.T) {
+		expected := status.Error(codes.Aborted, "test")
+
+		_, err := UnaryServerErrors()(context.Background(), nil, &grpc.UnaryServerInfo{},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, expected
+			})
+
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestStreamServerErrors(t *testing.T) {
+	t.Run("should convert app error to status", func(t *testing.T) {
+		err := StreamServerErrors()(nil, nil, &grpc.StreamServerInfo{}, func(interface{}, grpc.ServerStream) error {
+			return appErrors.New(errorcode.NotFound, "test")
+		})
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
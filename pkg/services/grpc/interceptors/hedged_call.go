@@ -0,0 +1,88 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptors
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+type hedgedResult struct {
+	reply proto.Message
+	err   error
+}
+
+type hedgedCall struct {
+	ctx      context.Context
+	call     *unaryCall
+	options  *RetryOptions
+	message  proto.Message
+	results  chan *hedgedResult
+	started  int
+	finished int
+}
+
+func newHedgedCall(ctx context.Context, call *unaryCall, options *RetryOptions,
+	message proto.Message) *hedgedCall {
+	return &hedgedCall{
+		ctx:     ctx,
+		call:    call,
+		options: options,
+		message: message,
+		results: make(chan *hedgedResult, options.MaxAttempts+1),
+	}
+}
+
+func (h *hedgedCall) wait() (result *hedgedResult) {
+	h.start()
+
+	timer := time.NewTimer(h.options.HedgingDelay)
+	defer timer.Stop()
+
+	for h.finished < h.started {
+		select {
+		case <-timer.C:
+			h.startIfAllowed()
+			timer.Reset(h.options.HedgingDelay)
+		case result = <-h.results:
+			if h.finished++; !h.options.isRetryable(result.err) {
+				return result
+			}
+
+			h.startIfAllowed()
+		}
+	}
+
+	return result
+}
+
+func (h *hedgedCall) startIfAllowed() {
+	if h.started < h.options.MaxAttempts {
+		h.start()
+	}
+}
+
+func (h *hedgedCall) start() {
+	attemptReply := proto.Clone(h.message)
+	proto.Reset(attemptReply)
+
+	h.started++
+
+	go func() {
+		h.results <- &hedgedResult{reply: attemptReply, err: h.call.invoke(h.ctx, attemptReply)}
+	}()
+}
@@ -0,0 +1,45 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptors
+
+import (
+	"google.golang.org/grpc"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/chaos"
+)
+
+// ServerOptions returns the interceptors every horusec grpc server should use, with recovery as the innermost one so
+// recovered panics are also logged, and the app errors converted to status before the metrics and logs
+func ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(UnaryServerMetrics(), UnaryServerLogging(), UnaryServerErrors(),
+			UnaryServerRecovery(), UnaryServerPropagation()),
+		grpc.ChainStreamInterceptor(StreamServerMetrics(), StreamServerLogging(), StreamServerErrors(),
+			StreamServerRecovery(), StreamServerPropagation()),
+	}
+}
+
+// DialOptions returns the client interceptors with the chaos one as the innermost, which only injects faults when the
+// chaos hooks are enabled by env
+func DialOptions() []grpc.DialOption {
+	injector := chaos.NewInjector(chaos.NewOptions())
+
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(UnaryClientPropagation(), UnaryClientMetrics(), UnaryClientLogging(),
+			UnaryClientChaos(injector)),
+		grpc.WithChainStreamInterceptor(StreamClientPropagation(), StreamClientMetrics(), StreamClientLogging(),
+			StreamClientChaos(injector)),
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptors
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type testHealthServer struct {
+	grpc_health_v1.UnimplementedHealthServer
+}
+
+func (t *testHealthServer) Check(_ context.Context,
+	req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	if req.Service == "panic" {
+		panic("test")
+	}
+
+	return &grpc_health_v1.HealthCheckResponse{Status: grpc_health_v1.HealthCheckResponse_SERVING}, nil
+}
+
+func (t *testHealthServer) Watch(req *grpc_health_v1.HealthCheckRequest,
+	server grpc_health_v1.Health_WatchServer) error {
+	if req.Service == "panic" {
+		panic("test")
+	}
+
+	return server.Send(&grpc_health_v1.HealthCheckResponse{Status: grpc_health_v1.HealthCheckResponse_SERVING})
+}
+
+func newTestClient(t *testing.T, opts ...grpc.ServerOption) grpc_health_v1.HealthClient {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(opts...)
+	grpc_health_v1.RegisterHealthServer(server, &testHealthServer{})
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	t.Cleanup(server.Stop)
+
+	dialer := func(context.Context, string) (net.Conn, error) {
+		return listener.Dial()
+	}
+
+	conn, err := grpc.Dial("bufnet", append(DialOptions(), grpc.WithContextDialer(dialer),
+		grpc.WithInsecure())...)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return grpc_health_v1.NewHealthClient(conn)
+}
+
+func TestServerOptions(t *testing.T) {
+	t.Run("should return response when unary handler succeeds", func(t *testing.T) {
+		client := newTestClient(t, ServerOptions()...)
+
+		resp, err := client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, resp.Status)
+	})
+
+	t.Run("should return internal error when unary handler panics", func(t *testing.T) {
+		client := newTestClient(t, ServerOptions()...)
+
+		_, err := client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: "panic"})
+
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("should return response when stream handler succeeds", func(t *testing.T) {
+		client := newTestClient(t, ServerOptions()...)
+
+		stream, err := client.Watch(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+		assert.NoError(t, err)
+
+		resp, err := stream.Recv()
+		assert.NoError(t, err)
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, resp.Status)
+	})
+
+	t.Run("should return internal error when stream handler panics", func(t *testing.T) {
+		client := newTestClient(t, ServerOptions()...)
+
+		stream, err := client.Watch(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: "panic"})
+		assert.NoError(t, err)
+
+		_, err = stream.Recv()
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
+
+func TestDialOptions(t *testing.T) {
+	t.Run("should return response when calling with client interceptors", func(t *testing.T) {
+		client := newTestClient(t)
+
+		resp, err := client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, resp.Status)
+	})
+}
@@ -0,0 +1,100 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptors
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+func UnaryServerLogging() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		logRequest(ctx, info.FullMethod, getPeer(ctx), start, err)
+
+		return resp, err
+	}
+}
+
+func StreamServerLogging() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, stream)
+
+		logRequest(stream.Context(), info.FullMethod, getPeer(stream.Context()), start, err)
+
+		return err
+	}
+}
+
+func UnaryClientLogging() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, conn *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, conn, opts...)
+
+		logRequest(ctx, method, conn.Target(), start, err)
+
+		return err
+	}
+}
+
+// StreamClientLogging logs when the stream is established, since the messages are exchanged after the interceptor
+func StreamClientLogging() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, conn *grpc.ClientConn, method string,
+		streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		start := time.Now()
+		stream, err := streamer(ctx, desc, conn, method, opts...)
+
+		logRequest(ctx, method, conn.Target(), start, err)
+
+		return stream, err
+	}
+}
+
+func logRequest(ctx context.Context, method, peerAddress string, start time.Time, err error) {
+	latency := time.Since(start)
+	code := status.Code(err)
+
+	if err != nil {
+		logger.FromContext(ctx).LogError(enums.MessageGRPCRequestFailed, err, map[string]interface{}{
+			"method": method, "peer": peerAddress, "latency": latency.String(), "code": code.String(),
+		})
+
+		return
+	}
+
+	logger.FromContext(ctx).LogInfo(fmt.Sprintf(enums.MessageGRPCRequest, method, peerAddress, latency, code))
+}
+
+func getPeer(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+
+	return ""
+}
@@ -0,0 +1,116 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptors
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/enums"
+	sharedMetrics "github.com/ZupIT/horusec-devkit/pkg/services/metrics"
+)
+
+type metrics struct {
+	serverHandled *prometheus.CounterVec
+	serverLatency *prometheus.HistogramVec
+	clientHandled *prometheus.CounterVec
+	clientLatency *prometheus.HistogramVec
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		serverHandled: newCounter("grpc_server_handled_total", "Total of rpcs completed on the server."),
+		serverLatency: newHistogram("grpc_server_handling_seconds", "Latency of rpcs handled by the server."),
+		clientHandled: newCounter("grpc_client_handled_total", "Total of rpcs completed by the client."),
+		clientLatency: newHistogram("grpc_client_handling_seconds", "Latency of rpcs until response received."),
+	}
+}
+
+func newCounter(name, help string) *prometheus.CounterVec {
+	return sharedMetrics.NewCounterVec(name, help, enums.MetricsLabelMethod, enums.MetricsLabelCode)
+}
+
+func newHistogram(name, help string) *prometheus.HistogramVec {
+	return sharedMetrics.NewHistogramVec(name, help, sharedMetrics.LatencyBuckets, enums.MetricsLabelMethod,
+		enums.MetricsLabelCode)
+}
+
+func (m *metrics) observe(handled *prometheus.CounterVec, latency *prometheus.HistogramVec, method string,
+	start time.Time, err error) {
+	code := status.Code(err).String()
+
+	handled.WithLabelValues(method, code).Inc()
+	latency.WithLabelValues(method, code).Observe(time.Since(start).Seconds())
+}
+
+func UnaryServerMetrics() grpc.UnaryServerInterceptor {
+	m := newMetrics()
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		m.observe(m.serverHandled, m.serverLatency, info.FullMethod, start, err)
+
+		return resp, err
+	}
+}
+
+func StreamServerMetrics() grpc.StreamServerInterceptor {
+	m := newMetrics()
+
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, stream)
+
+		m.observe(m.serverHandled, m.serverLatency, info.FullMethod, start, err)
+
+		return err
+	}
+}
+
+func UnaryClientMetrics() grpc.UnaryClientInterceptor {
+	m := newMetrics()
+
+	return func(ctx context.Context, method string, req, reply interface{}, conn *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, conn, opts...)
+
+		m.observe(m.clientHandled, m.clientLatency, method, start, err)
+
+		return err
+	}
+}
+
+func StreamClientMetrics() grpc.StreamClientInterceptor {
+	m := newMetrics()
+
+	return func(ctx context.Context, desc *grpc.StreamDesc, conn *grpc.ClientConn, method string,
+		streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		start := time.Now()
+		stream, err := streamer(ctx, desc, conn, method, opts...)
+
+		m.observe(m.clientHandled, m.clientLatency, method, start, err)
+
+		return stream, err
+	}
+}
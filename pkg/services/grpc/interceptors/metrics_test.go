@@ -0,0 +1,65 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	sharedMetrics "github.com/ZupIT/horusec-devkit/pkg/services/metrics"
+)
+
+const testCheckMethod = "/grpc.health.v1.Health/Check"
+
+func TestMetrics(t *testing.T) {
+	t.Run("should count server and client rpcs by method and code", func(t *testing.T) {
+		client := newTestClient(t, ServerOptions()...)
+		m := newMetrics()
+		serverBefore := testutil.ToFloat64(m.serverHandled.WithLabelValues(testCheckMethod, "Internal"))
+		clientBefore := testutil.ToFloat64(m.clientHandled.WithLabelValues(testCheckMethod, "Internal"))
+
+		_, err := client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: "panic"})
+		assert.Error(t, err)
+
+		assert.Equal(t, serverBefore+1, testutil.ToFloat64(m.serverHandled.WithLabelValues(testCheckMethod, "Internal")))
+		assert.Equal(t, clientBefore+1, testutil.ToFloat64(m.clientHandled.WithLabelValues(testCheckMethod, "Internal")))
+	})
+
+	t.Run("should observe stream latency", func(t *testing.T) {
+		client := newTestClient(t, ServerOptions()...)
+
+		stream, err := client.Watch(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+		assert.NoError(t, err)
+
+		_, err = stream.Recv()
+		assert.NoError(t, err)
+
+		assert.NotZero(t, testutil.CollectAndCount(newMetrics().serverLatency))
+		assert.NotZero(t, testutil.CollectAndCount(newMetrics().clientLatency))
+	})
+
+	t.Run("should register metrics on the shared registry only once", func(t *testing.T) {
+		assert.Same(t, newMetrics().serverHandled, newMetrics().serverHandled)
+
+		assert.Error(t, sharedMetrics.Registerer().Register(prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "horusec", Name: "grpc_server_handled_total", Help: "test",
+		}, []string{"method", "code"})))
+	})
+}
@@ -0,0 +1,98 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptors
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
+	jwtEnums "github.com/ZupIT/horusec-devkit/pkg/utils/jwt/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/propagation"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/propagation/enums"
+)
+
+type propagatedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (p *propagatedServerStream) Context() context.Context {
+	return p.ctx
+}
+
+func UnaryClientPropagation() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, conn *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(outgoingContext(ctx), method, req, reply, conn, opts...)
+	}
+}
+
+func StreamClientPropagation() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, conn *grpc.ClientConn, method string,
+		streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(outgoingContext(ctx), desc, conn, method, opts...)
+	}
+}
+
+func UnaryServerPropagation() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(incomingContext(ctx), req)
+	}
+}
+
+func StreamServerPropagation() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, _ *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+		return handler(srv, &propagatedServerStream{ServerStream: stream, ctx: incomingContext(stream.Context())})
+	}
+}
+
+// outgoingContext keeps the values already set in the outgoing metadata, since they were explicitly set by the caller
+func outgoingContext(ctx context.Context) context.Context {
+	outgoing, _ := metadata.FromOutgoingContext(ctx)
+
+	for name, value := range propagation.GetValuesFromContext(ctx) {
+		if len(outgoing.Get(name)) == 0 {
+			ctx = metadata.AppendToOutgoingContext(ctx, strings.ToLower(name), value)
+		}
+	}
+
+	return ctx
+}
+
+// incomingContext also sets the account id of a valid horusec token, as the http authorization middleware does
+func incomingContext(ctx context.Context) context.Context {
+	incoming, _ := metadata.FromIncomingContext(ctx)
+	values := map[string]string{}
+
+	for _, name := range enums.Headers {
+		if value := incoming.Get(name); len(value) > 0 {
+			values[http.CanonicalHeaderKey(name)] = value[0]
+		}
+	}
+
+	ctx = propagation.ContextWithValues(ctx, values)
+	if accountID, err := jwt.GetAccountIDByJWTToken(values[jwtEnums.HorusecJWTHeader]); err == nil {
+		return jwt.ContextWithAccountID(ctx, accountID)
+	}
+
+	return ctx
+}
@@ -0,0 +1,124 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptors
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
+	jwtEntities "github.com/ZupIT/horusec-devkit/pkg/utils/jwt/entities"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/propagation"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/propagation/enums"
+)
+
+type propagationHealthServer struct {
+	grpc_health_v1.UnimplementedHealthServer
+	values    map[string]string
+	accountID uuid.UUID
+}
+
+func (p *propagationHealthServer) Check(ctx context.Context,
+	_ *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	p.values = propagation.GetValuesFromContext(ctx)
+	p.accountID, _ = jwt.GetAccountIDFromContext(ctx)
+
+	return &grpc_health_v1.HealthCheckResponse{}, nil
+}
+
+func (p *propagationHealthServer) Watch(_ *grpc_health_v1.HealthCheckRequest,
+	server grpc_health_v1.Health_WatchServer) error {
+	p.values = propagation.GetValuesFromContext(server.Context())
+
+	return server.Send(&grpc_health_v1.HealthCheckResponse{})
+}
+
+func newPropagationTestClient(t *testing.T, server *propagationHealthServer) grpc_health_v1.HealthClient {
+	listener := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer(ServerOptions()...)
+	grpc_health_v1.RegisterHealthServer(grpcServer, server)
+
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.Dial("bufnet", append(DialOptions(), grpc.WithInsecure(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return listener.Dial()
+		}))...)
+	assert.NoError(t, err)
+
+	return grpc_health_v1.NewHealthClient(conn)
+}
+
+func TestPropagation(t *testing.T) {
+	t.Run("should propagate values and account id on unary calls", func(t *testing.T) {
+		accountID := uuid.New()
+		token, _, err := jwt.CreateToken(&jwtEntities.TokenData{AccountID: accountID}, nil)
+		assert.NoError(t, err)
+
+		server := &propagationHealthServer{}
+		client := newPropagationTestClient(t, server)
+
+		ctx := propagation.ContextWithValues(context.Background(), map[string]string{
+			enums.HeaderRequestID: "test", enums.HeaderHorusecAuthorization: token,
+		})
+
+		_, err = client.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+		assert.NoError(t, err)
+
+		assert.Equal(t, "test", server.values[enums.HeaderRequestID])
+		assert.Equal(t, accountID, server.accountID)
+	})
+
+	t.Run("should keep values explicitly set in outgoing metadata", func(t *testing.T) {
+		server := &propagationHealthServer{}
+		client := newPropagationTestClient(t, server)
+
+		ctx := propagation.ContextWithValues(context.Background(), map[string]string{enums.HeaderRequestID: "test"})
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-request-id", "explicit")
+
+		_, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+		assert.NoError(t, err)
+
+		assert.Equal(t, "explicit", server.values[enums.HeaderRequestID])
+		assert.Equal(t, uuid.Nil, server.accountID)
+	})
+
+	t.Run("should propagate values on stream calls", func(t *testing.T) {
+		server := &propagationHealthServer{}
+		client := newPropagationTestClient(t, server)
+
+		ctx := propagation.ContextWithValues(context.Background(), map[string]string{enums.HeaderTraceParent: "test"})
+
+		stream, err := client.Watch(ctx, &grpc_health_v1.HealthCheckRequest{})
+		assert.NoError(t, err)
+
+		_, err = stream.Recv()
+		assert.NoError(t, err)
+
+		assert.Equal(t, "test", server.values[enums.HeaderTraceParent])
+	})
+}
@@ -0,0 +1,128 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptors
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/retry"
+)
+
+type retryOptionsKey struct{}
+
+// RetryOptions configures the client retry interceptor. Hedging is only used for the methods in HedgedMethods when
+// HedgingDelay is greater than zero, so it must be enabled only for idempotent rpcs.
+type RetryOptions struct {
+	MaxAttempts    int
+	Codes          []codes.Code
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Jitter         float64
+	HedgingDelay   time.Duration
+	HedgedMethods  []string
+}
+
+func NewRetryOptions() *RetryOptions {
+	return &RetryOptions{
+		MaxAttempts: env.GetEnvOrDefaultInt(enums.HorusecGRPCRetryMaxAttempts, enums.DefaultRetryMaxAttempts),
+		Codes:       []codes.Code{codes.Unavailable, codes.ResourceExhausted},
+		InitialBackoff: time.Duration(env.GetEnvOrDefaultInt(enums.HorusecGRPCRetryInitialBackoff,
+			enums.DefaultRetryInitialBackoff)) * time.Millisecond,
+		MaxBackoff: time.Duration(env.GetEnvOrDefaultInt(enums.HorusecGRPCRetryMaxBackoff,
+			enums.DefaultRetryMaxBackoff)) * time.Millisecond,
+		Jitter:        enums.DefaultRetryJitter,
+		HedgingDelay:  time.Duration(env.GetEnvOrDefaultInt(enums.HorusecGRPCHedgingDelay, 0)) * time.Millisecond,
+		HedgedMethods: []string{enums.AuthIsAuthorizedMethod},
+	}
+}
+
+// ContextWithRetryOptions overrides the interceptor options for the calls made with the returned context
+func ContextWithRetryOptions(ctx context.Context, options *RetryOptions) context.Context {
+	return context.WithValue(ctx, retryOptionsKey{}, options)
+}
+
+func getRetryOptions(ctx context.Context, defaultOptions *RetryOptions) *RetryOptions {
+	if options, ok := ctx.Value(retryOptionsKey{}).(*RetryOptions); ok && options != nil {
+		return options
+	}
+
+	return defaultOptions
+}
+
+func (r *RetryOptions) isRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	for _, code := range r.Codes {
+		if status.Code(err) == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *RetryOptions) isHedged(method string) bool {
+	if r.HedgingDelay <= 0 {
+		return false
+	}
+
+	for _, hedged := range r.HedgedMethods {
+		if hedged == method {
+			return true
+		}
+	}
+
+	return false
+}
+
+// policy doubles the initial backoff for each attempt, limited by the max backoff, and randomizes it by the jitter
+// fraction to avoid every client retrying at the same time after a server restart
+func (r *RetryOptions) policy(method string) *retry.Policy {
+	policy := retry.NewPolicy(method)
+	policy.MaxAttempts = r.MaxAttempts
+	policy.InitialBackoff = r.InitialBackoff
+	policy.MaxBackoff = r.MaxBackoff
+	policy.Jitter = r.Jitter
+	policy.IsRetryable = r.isRetryable
+
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = 1
+	}
+
+	return policy
+}
+
+func UnaryClientRetry(defaultOptions *RetryOptions) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, conn *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		options := getRetryOptions(ctx, defaultOptions)
+		call := &unaryCall{ctx: ctx, method: method, req: req, conn: conn, invoker: invoker, opts: opts}
+
+		if options.isHedged(method) {
+			return call.invokeHedged(options, reply)
+		}
+
+		return call.invokeWithRetry(options, reply)
+	}
+}
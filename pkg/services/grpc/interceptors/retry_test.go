@@ -0,0 +1,232 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptors
+
+import (
+	"context"
+	"net"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/enums"
+)
+
+type flakyHealthServer struct {
+	grpc_health_v1.UnimplementedHealthServer
+	calls    int32
+	failures int32
+	code     codes.Code
+	delay    time.Duration
+}
+
+func (f *flakyHealthServer) Check(ctx context.Context,
+	_ *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	if call := atomic.AddInt32(&f.calls, 1); call <= f.failures {
+		return nil, status.Error(f.code, "test")
+	} else if call == 1 && f.delay > 0 {
+		select {
+		case <-time.After(f.delay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	return &grpc_health_v1.HealthCheckResponse{Status: grpc_health_v1.HealthCheckResponse_SERVING}, nil
+}
+
+func newRetryTestClient(t *testing.T, server *flakyHealthServer,
+	options *RetryOptions) grpc_health_v1.HealthClient {
+	listener := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(grpcServer, server)
+
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithChainUnaryInterceptor(UnaryClientRetry(options)),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return listener.Dial()
+		}))
+	assert.NoError(t, err)
+
+	return grpc_health_v1.NewHealthClient(conn)
+}
+
+func newTestRetryOptions() *RetryOptions {
+	options := NewRetryOptions()
+	options.InitialBackoff = time.Millisecond
+	options.MaxBackoff = 2 * time.Millisecond
+
+	return options
+}
+
+func TestUnaryClientRetry(t *testing.T) {
+	t.Run("should retry until success when retryable code", func(t *testing.T) {
+		server := &flakyHealthServer{failures: 2, code: codes.Unavailable}
+		client := newRetryTestClient(t, server, newTestRetryOptions())
+
+		resp, err := client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, resp.Status)
+		assert.Equal(t, int32(3), server.calls)
+	})
+
+	t.Run("should return error when max attempts is reached", func(t *testing.T) {
+		server := &flakyHealthServer{failures: 5, code: codes.Unavailable}
+		client := newRetryTestClient(t, server, newTestRetryOptions())
+
+		_, err := client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, int32(3), server.calls)
+	})
+
+	t.Run("should not retry when code is not retryable", func(t *testing.T) {
+		server := &flakyHealthServer{failures: 1, code: codes.PermissionDenied}
+		client := newRetryTestClient(t, server, newTestRetryOptions())
+
+		_, err := client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Equal(t, int32(1), server.calls)
+	})
+
+	t.Run("should use options from context when overridden", func(t *testing.T) {
+		server := &flakyHealthServer{failures: 5, code: codes.Unavailable}
+		client := newRetryTestClient(t, server, newTestRetryOptions())
+		options := newTestRetryOptions()
+		options.MaxAttempts = 1
+
+		_, err := client.Check(ContextWithRetryOptions(context.Background(), options),
+			&grpc_health_v1.HealthCheckRequest{})
+
+		assert.Error(t, err)
+		assert.Equal(t, int32(1), server.calls)
+	})
+
+	t.Run("should stop retrying when context is canceled", func(t *testing.T) {
+		server := &flakyHealthServer{failures: 5, code: codes.Unavailable}
+		options := newTestRetryOptions()
+		options.InitialBackoff = time.Hour
+		options.MaxBackoff = time.Hour
+		client := newRetryTestClient(t, server, options)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		_, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, int32(1), server.calls)
+	})
+}
+
+func TestUnaryClientRetryHedging(t *testing.T) {
+	t.Run("should return hedged response when first attempt is slow", func(t *testing.T) {
+		server := &flakyHealthServer{delay: time.Second}
+		options := newTestRetryOptions()
+		options.HedgingDelay = 10 * time.Millisecond
+		options.HedgedMethods = []string{testCheckMethod}
+		client := newRetryTestClient(t, server, options)
+
+		start := time.Now()
+		resp, err := client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, resp.Status)
+		assert.Less(t, int64(time.Since(start)), int64(time.Second))
+	})
+
+	t.Run("should start new attempt when hedged attempt fails with retryable code", func(t *testing.T) {
+		server := &flakyHealthServer{failures: 2, code: codes.Unavailable}
+		options := newTestRetryOptions()
+		options.HedgingDelay = time.Hour
+		options.HedgedMethods = []string{testCheckMethod}
+		client := newRetryTestClient(t, server, options)
+
+		resp, err := client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, resp.Status)
+		assert.Equal(t, int32(3), server.calls)
+	})
+
+	t.Run("should return last error when every hedged attempt fails", func(t *testing.T) {
+		server := &flakyHealthServer{failures: 5, code: codes.Unavailable}
+		options := newTestRetryOptions()
+		options.HedgingDelay = time.Hour
+		options.HedgedMethods = []string{testCheckMethod}
+		client := newRetryTestClient(t, server, options)
+
+		_, err := client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, int32(3), server.calls)
+	})
+}
+
+func TestNewRetryOptions(t *testing.T) {
+	t.Run("should read options from env", func(t *testing.T) {
+		_ = os.Setenv(enums.HorusecGRPCRetryMaxAttempts, "5")
+		_ = os.Setenv(enums.HorusecGRPCHedgingDelay, "10")
+
+		options := NewRetryOptions()
+
+		assert.Equal(t, 5, options.MaxAttempts)
+		assert.Equal(t, 10*time.Millisecond, options.HedgingDelay)
+		assert.True(t, options.isHedged(enums.AuthIsAuthorizedMethod))
+
+		_ = os.Unsetenv(enums.HorusecGRPCRetryMaxAttempts)
+		_ = os.Unsetenv(enums.HorusecGRPCHedgingDelay)
+	})
+
+	t.Run("should not hedge when delay is zero", func(t *testing.T) {
+		assert.False(t, NewRetryOptions().isHedged(enums.AuthIsAuthorizedMethod))
+	})
+}
+
+func TestPolicy(t *testing.T) {
+	t.Run("should map the retry options to the policy", func(t *testing.T) {
+		options := &RetryOptions{MaxAttempts: 3, InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second,
+			Jitter: 0.2, Codes: []codes.Code{codes.Unavailable}}
+
+		policy := options.policy(testCheckMethod)
+
+		assert.Equal(t, testCheckMethod, policy.Name)
+		assert.Equal(t, 3, policy.MaxAttempts)
+		assert.Equal(t, 100*time.Millisecond, policy.InitialBackoff)
+		assert.Equal(t, time.Second, policy.MaxBackoff)
+		assert.Equal(t, 0.2, policy.Jitter)
+		assert.True(t, policy.IsRetryable(status.Error(codes.Unavailable, "test")))
+		assert.False(t, policy.IsRetryable(status.Error(codes.Internal, "test")))
+	})
+
+	t.Run("should make a single attempt when max attempts is zero", func(t *testing.T) {
+		assert.Equal(t, 1, (&RetryOptions{}).policy(testCheckMethod).MaxAttempts)
+	})
+}
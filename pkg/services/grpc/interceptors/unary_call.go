@@ -0,0 +1,69 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptors
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/retry"
+)
+
+type unaryCall struct {
+	ctx     context.Context
+	method  string
+	req     interface{}
+	conn    *grpc.ClientConn
+	invoker grpc.UnaryInvoker
+	opts    []grpc.CallOption
+}
+
+func (u *unaryCall) invoke(ctx context.Context, reply interface{}) error {
+	return u.invoker(ctx, u.method, u.req, reply, u.conn, u.opts...)
+}
+
+// invokeWithRetry returns the error of the last attempt instead of the context error, so the caller keeps the status
+// code when the context is done while waiting the backoff
+func (u *unaryCall) invokeWithRetry(options *RetryOptions, reply interface{}) (err error) {
+	_ = retry.Do(u.ctx, options.policy(u.method), func(ctx context.Context) error {
+		err = u.invoke(ctx, reply)
+
+		return err
+	})
+
+	return err
+}
+
+// invokeHedged starts a new attempt each hedging delay while no attempt has finished, returning the first
+// successful or non retryable result and canceling the others. Every attempt uses its own reply message.
+func (u *unaryCall) invokeHedged(options *RetryOptions, reply interface{}) error {
+	message, ok := reply.(proto.Message)
+	if !ok {
+		return u.invokeWithRetry(options, reply)
+	}
+
+	ctx, cancel := context.WithCancel(u.ctx)
+	defer cancel()
+
+	result := newHedgedCall(ctx, u, options, message).wait()
+	if result.err == nil {
+		proto.Reset(message)
+		proto.Merge(message, result.reply)
+	}
+
+	return result.err
+}
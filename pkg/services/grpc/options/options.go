@@ -0,0 +1,109 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"net"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/keepalive"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/listener"
+	listenerEnums "github.com/ZupIT/horusec-devkit/pkg/utils/listener/enums"
+)
+
+// Options keeps idle connections alive with pings shorter than the load balancers idle timeout. The defaults ping
+// every 5 minutes only while there are active streams, which is the most a grpc server accepts with its default
+// enforcement policy. The servers created with ServerOptions enforce a min time of 1 minute, so a lower keepalive time
+// or pings without streams must be configured on both sides, otherwise the server closes the connection for too many
+// pings.
+type Options struct {
+	KeepaliveTime       time.Duration
+	KeepaliveTimeout    time.Duration
+	PermitWithoutStream bool
+	KeepaliveMinTime    time.Duration
+	MaxConnectionIdle   time.Duration
+	MaxRecvMsgSize      int
+	MaxSendMsgSize      int
+	BackoffBaseDelay    time.Duration
+	BackoffMaxDelay     time.Duration
+	MinConnectTimeout   time.Duration
+	Listener            *listener.Options
+}
+
+func NewOptions() *Options {
+	return &Options{
+		KeepaliveTime:       getSeconds(enums.HorusecGRPCKeepaliveTime, enums.DefaultKeepaliveTime),
+		KeepaliveTimeout:    getSeconds(enums.HorusecGRPCKeepaliveTimeout, enums.DefaultKeepaliveTimeout),
+		PermitWithoutStream: env.GetEnvOrDefaultBool(enums.HorusecGRPCKeepaliveWithoutStream, false),
+		KeepaliveMinTime:    getSeconds(enums.HorusecGRPCKeepaliveMinTime, enums.DefaultKeepaliveMinTime),
+		MaxConnectionIdle:   getSeconds(enums.HorusecGRPCMaxConnectionIdle, 0),
+		MaxRecvMsgSize:      env.GetEnvOrDefaultInt(enums.HorusecGRPCMaxRecvMsgSize, enums.DefaultMaxMsgSize),
+		MaxSendMsgSize:      env.GetEnvOrDefaultInt(enums.HorusecGRPCMaxSendMsgSize, enums.DefaultMaxMsgSize),
+		BackoffBaseDelay:    getMilliseconds(enums.HorusecGRPCBackoffBaseDelay, enums.DefaultBackoffBaseDelay),
+		BackoffMaxDelay:     getMilliseconds(enums.HorusecGRPCBackoffMaxDelay, enums.DefaultBackoffMaxDelay),
+		MinConnectTimeout:   getSeconds(enums.HorusecGRPCMinConnectTimeout, enums.DefaultMinConnectTimeout),
+		Listener:            listener.NewOptions(listenerEnums.GRPCPrefix, enums.DefaultServerAddress),
+	}
+}
+
+func getSeconds(name string, defaultValue int) time.Duration {
+	return time.Duration(env.GetEnvOrDefaultInt(name, defaultValue)) * time.Second
+}
+
+func getMilliseconds(name string, defaultValue int) time.Duration {
+	return time.Duration(env.GetEnvOrDefaultInt(name, defaultValue)) * time.Millisecond
+}
+
+func (o *Options) DialOptions() []grpc.DialOption {
+	backoffConfig := backoff.DefaultConfig
+	backoffConfig.BaseDelay = o.BackoffBaseDelay
+	backoffConfig.MaxDelay = o.BackoffMaxDelay
+
+	return []grpc.DialOption{
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time: o.KeepaliveTime, Timeout: o.KeepaliveTimeout, PermitWithoutStream: o.PermitWithoutStream,
+		}),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(o.MaxRecvMsgSize), grpc.MaxCallSendMsgSize(o.MaxSendMsgSize)),
+		grpc.WithConnectParams(grpc.ConnectParams{Backoff: backoffConfig, MinConnectTimeout: o.MinConnectTimeout}),
+	}
+}
+
+func (o *Options) ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			MaxConnectionIdle: o.MaxConnectionIdle, Time: o.KeepaliveTime, Timeout: o.KeepaliveTimeout,
+		}),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime: o.KeepaliveMinTime, PermitWithoutStream: o.PermitWithoutStream,
+		}),
+		grpc.MaxRecvMsgSize(o.MaxRecvMsgSize),
+		grpc.MaxSendMsgSize(o.MaxSendMsgSize),
+	}
+}
+
+// Listen creates the listener of the grpc server from the HORUSEC_GRPC_LISTENER_NETWORK envs, so it can listen in a
+// unix or systemd socket like the http server, falling back to tcp when the options were built by hand
+func (o *Options) Listen() (net.Listener, error) {
+	if o.Listener == nil {
+		return net.Listen(listenerEnums.NetworkTCP, enums.DefaultServerAddress)
+	}
+
+	return o.Listener.Listen()
+}
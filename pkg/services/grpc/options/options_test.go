@@ -0,0 +1,132 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/enums"
+)
+
+func TestNewOptions(t *testing.T) {
+	t.Run("should create options with default values", func(t *testing.T) {
+		options := NewOptions()
+
+		assert.Equal(t, 5*time.Minute, options.KeepaliveTime)
+		assert.Equal(t, 10*time.Second, options.KeepaliveTimeout)
+		assert.False(t, options.PermitWithoutStream)
+		assert.Less(t, int64(options.KeepaliveMinTime), int64(options.KeepaliveTime))
+		assert.Equal(t, enums.DefaultMaxMsgSize, options.MaxRecvMsgSize)
+		assert.Equal(t, time.Second, options.BackoffBaseDelay)
+	})
+
+	t.Run("should create options with env values", func(t *testing.T) {
+		_ = os.Setenv(enums.HorusecGRPCKeepaliveTime, "60")
+		_ = os.Setenv(enums.HorusecGRPCKeepaliveWithoutStream, "true")
+		_ = os.Setenv(enums.HorusecGRPCMaxSendMsgSize, "10")
+		_ = os.Setenv(enums.HorusecGRPCBackoffMaxDelay, "500")
+
+		options := NewOptions()
+
+		assert.Equal(t, time.Minute, options.KeepaliveTime)
+		assert.True(t, options.PermitWithoutStream)
+		assert.Equal(t, 10, options.MaxSendMsgSize)
+		assert.Equal(t, 500*time.Millisecond, options.BackoffMaxDelay)
+
+		for _, name := range []string{enums.HorusecGRPCKeepaliveTime, enums.HorusecGRPCKeepaliveWithoutStream,
+			enums.HorusecGRPCMaxSendMsgSize, enums.HorusecGRPCBackoffMaxDelay} {
+			_ = os.Unsetenv(name)
+		}
+	})
+}
+
+func TestDialOptionsAndServerOptions(t *testing.T) {
+	t.Run("should connect using the options and limit message size", func(t *testing.T) {
+		options := NewOptions()
+		options.MaxRecvMsgSize = 10
+
+		listener := bufconn.Listen(1024 * 1024)
+		server := grpc.NewServer(options.ServerOptions()...)
+		grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+
+		go func() {
+			_ = server.Serve(listener)
+		}()
+
+		defer server.Stop()
+
+		conn, err := grpc.Dial("bufnet", append(NewOptions().DialOptions(), grpc.WithInsecure(),
+			grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+				return listener.Dial()
+			}))...)
+		assert.NoError(t, err)
+
+		client := grpc_health_v1.NewHealthClient(conn)
+
+		_, err = client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+		assert.NoError(t, err)
+
+		_, err = client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: "test-service-name"})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+}
+
+func TestListen(t *testing.T) {
+	t.Run("should serve in the unix socket of the envs", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "grpc.sock")
+		t.Setenv("HORUSEC_GRPC_LISTENER_NETWORK", "unix")
+		t.Setenv("HORUSEC_GRPC_UNIX_SOCKET_PATH", path)
+
+		options := NewOptions()
+		listener, err := options.Listen()
+		assert.NoError(t, err)
+
+		server := grpc.NewServer(options.ServerOptions()...)
+		grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+
+		go func() {
+			_ = server.Serve(listener)
+		}()
+
+		defer server.Stop()
+
+		conn, err := grpc.Dial("unix://"+path, append(options.DialOptions(), grpc.WithInsecure())...)
+		assert.NoError(t, err)
+
+		_, err = grpc_health_v1.NewHealthClient(conn).Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when invalid network", func(t *testing.T) {
+		t.Setenv("HORUSEC_GRPC_LISTENER_NETWORK", "invalid")
+
+		_, err := NewOptions().Listen()
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,86 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pool
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// Options configures the connection pool. A connection is re-dialed after MaxFailures consecutive health checks in
+// transient failure, and the replaced connection is only closed after DrainTimeout to finish the in-flight calls.
+type Options struct {
+	Size                int
+	LoadBalancingPolicy string
+	HealthCheckInterval time.Duration
+	MaxFailures         int
+	DrainTimeout        time.Duration
+}
+
+func NewOptions() *Options {
+	return &Options{
+		Size: env.GetEnvOrDefaultInt(enums.HorusecGRPCPoolSize, enums.DefaultPoolSize),
+		LoadBalancingPolicy: env.GetEnvOrDefault(enums.HorusecGRPCLoadBalancingPolicy,
+			enums.DefaultLoadBalancingPolicy),
+		HealthCheckInterval: time.Duration(env.GetEnvOrDefaultInt(enums.HorusecGRPCPoolHealthCheckInterval,
+			enums.DefaultPoolHealthCheckInterval)) * time.Second,
+		MaxFailures: env.GetEnvOrDefaultInt(enums.HorusecGRPCPoolMaxFailures, enums.DefaultPoolMaxFailures),
+		DrainTimeout: time.Duration(env.GetEnvOrDefaultInt(enums.HorusecGRPCPoolDrainTimeout,
+			enums.DefaultPoolDrainTimeout)) * time.Second,
+	}
+}
+
+func (o *Options) getSize() int {
+	if o.Size < 1 {
+		return 1
+	}
+
+	return o.Size
+}
+
+// getMaxFailures returns at least one, so a connection is only re-dialed after a failed health check
+func (o *Options) getMaxFailures() int {
+	if o.MaxFailures < 1 {
+		return 1
+	}
+
+	return o.MaxFailures
+}
+
+func (o *Options) dialOptions() []grpc.DialOption {
+	if o.LoadBalancingPolicy == "" {
+		return nil
+	}
+
+	return []grpc.DialOption{
+		grpc.WithDefaultServiceConfig(fmt.Sprintf(enums.LoadBalancingServiceConfig, o.LoadBalancingPolicy)),
+	}
+}
+
+// getTarget uses the dns resolver for targets without scheme, since the default passthrough resolver only returns a
+// single address and the load balancing policy would have nothing to balance
+func (o *Options) getTarget(target string) string {
+	if o.LoadBalancingPolicy == "" || strings.Contains(target, ":///") {
+		return target
+	}
+
+	return enums.DNSResolverScheme + target
+}
@@ -0,0 +1,214 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pool
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+type IPool interface {
+	grpc.ClientConnInterface
+	IsAvailable() bool
+	GetState() connectivity.State
+	Connect()
+	Close() error
+}
+
+// Pool spreads the calls between its connections in round robin and replaces the connections that stay unhealthy,
+// so a service no longer depends on a single connection created at boot
+type Pool struct {
+	target      string
+	options     *Options
+	dialOptions []grpc.DialOption
+	mutex       sync.RWMutex
+	connections []*grpc.ClientConn
+	failures    []int
+	next        uint32
+	cancel      context.CancelFunc
+	monitoring  sync.WaitGroup
+	closed      bool
+}
+
+func NewPool(target string, options *Options, dialOptions ...grpc.DialOption) (IPool, error) {
+	pool := &Pool{
+		target:      options.getTarget(target),
+		options:     options,
+		dialOptions: append(options.dialOptions(), dialOptions...),
+		connections: make([]*grpc.ClientConn, options.getSize()),
+		failures:    make([]int, options.getSize()),
+	}
+
+	if err := pool.dialAll(); err != nil {
+		return nil, err
+	}
+
+	pool.startMonitor()
+
+	return pool, nil
+}
+
+func (p *Pool) dialAll() error {
+	for index := range p.connections {
+		connection, err := grpc.Dial(p.target, p.dialOptions...)
+		if err != nil {
+			_ = p.Close()
+
+			return err
+		}
+
+		p.connections[index] = connection
+	}
+
+	return nil
+}
+
+func (p *Pool) startMonitor() {
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+
+	if p.options.HealthCheckInterval > 0 {
+		p.monitoring.Add(1)
+
+		go p.monitor(ctx)
+	}
+}
+
+func (p *Pool) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	return p.getConnection().Invoke(ctx, method, args, reply, opts...)
+}
+
+func (p *Pool) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string,
+	opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return p.getConnection().NewStream(ctx, desc, method, opts...)
+}
+
+func (p *Pool) getConnection() *grpc.ClientConn {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	index := atomic.AddUint32(&p.next, 1) % uint32(len(p.connections))
+
+	return p.connections[index]
+}
+
+func (p *Pool) IsAvailable() bool {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	for _, connection := range p.connections {
+		if connection != nil && connection.GetState() == connectivity.Ready {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetState returns the best state between the connections, so the pool is ready while any connection is ready
+func (p *Pool) GetState() connectivity.State {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	best := connectivity.Shutdown
+
+	for _, connection := range p.connections {
+		if connection != nil && stateRank(connection.GetState()) < stateRank(best) {
+			best = connection.GetState()
+		}
+	}
+
+	return best
+}
+
+// stateRank orders the states from the most to the least available
+func stateRank(state connectivity.State) int {
+	switch state {
+	case connectivity.Ready:
+		return 0
+	case connectivity.Connecting:
+		return 1
+	case connectivity.Idle:
+		return 2
+	case connectivity.TransientFailure:
+		return 3
+	default:
+		return 4
+	}
+}
+
+// Connect asks the idle connections to connect
+func (p *Pool) Connect() {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	for _, connection := range p.connections {
+		if connection != nil {
+			connection.Connect()
+		}
+	}
+}
+
+// Close waits for the monitor to stop before closing the connections, so a connection re-dialed by a health check
+// running at the same time is not left open
+func (p *Pool) Close() error {
+	if p.cancel != nil {
+		p.cancel()
+	}
+
+	p.monitoring.Wait()
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.closed {
+		return nil
+	}
+
+	p.closed = true
+
+	var err error
+
+	for _, connection := range p.connections {
+		if connection != nil {
+			if closeErr := connection.Close(); closeErr != nil {
+				err = closeErr
+			}
+		}
+	}
+
+	return err
+}
+
+func (p *Pool) monitor(ctx context.Context) {
+	defer p.monitoring.Done()
+
+	ticker := time.NewTicker(p.options.HealthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.checkConnections()
+		}
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pool
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/enums"
+)
+
+func newTestPool(t *testing.T, options *Options) *Pool {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	t.Cleanup(server.Stop)
+
+	dialer := func(context.Context, string) (net.Conn, error) {
+		return listener.Dial()
+	}
+
+	pool, err := NewPool("passthrough:///bufnet", options, grpc.WithContextDialer(dialer), grpc.WithInsecure())
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = pool.Close()
+	})
+
+	return pool.(*Pool)
+}
+
+func TestNewOptions(t *testing.T) {
+	t.Run("should return options with default values", func(t *testing.T) {
+		options := NewOptions()
+
+		assert.Equal(t, enums.DefaultPoolSize, options.Size)
+		assert.Equal(t, enums.DefaultLoadBalancingPolicy, options.LoadBalancingPolicy)
+		assert.Equal(t, enums.DefaultPoolMaxFailures, options.MaxFailures)
+		assert.Equal(t, 10*time.Second, options.HealthCheckInterval)
+		assert.Equal(t, 10*time.Second, options.DrainTimeout)
+	})
+
+	t.Run("should use dns resolver when target has no scheme", func(t *testing.T) {
+		assert.Equal(t, "dns:///localhost:8007", NewOptions().getTarget("localhost:8007"))
+		assert.Equal(t, "passthrough:///test", NewOptions().getTarget("passthrough:///test"))
+		assert.Equal(t, "localhost:8007", (&Options{}).getTarget("localhost:8007"))
+	})
+
+	t.Run("should use at least one connection", func(t *testing.T) {
+		assert.Equal(t, 1, (&Options{Size: -1}).getSize())
+		assert.Empty(t, (&Options{}).dialOptions())
+	})
+
+	t.Run("should redial after at least one failure", func(t *testing.T) {
+		assert.Equal(t, 1, (&Options{MaxFailures: -1}).getMaxFailures())
+		assert.Equal(t, 3, (&Options{MaxFailures: 3}).getMaxFailures())
+	})
+}
+
+func TestPool(t *testing.T) {
+	t.Run("should make calls using all pool connections", func(t *testing.T) {
+		pool := newTestPool(t, &Options{Size: 2, LoadBalancingPolicy: enums.DefaultLoadBalancingPolicy})
+		client := grpc_health_v1.NewHealthClient(pool)
+
+		for i := 0; i < 2; i++ {
+			_, err := client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+			assert.NoError(t, err)
+		}
+
+		assert.True(t, pool.IsAvailable())
+		assert.Len(t, pool.connections, 2)
+	})
+
+	t.Run("should open streams using pool connections", func(t *testing.T) {
+		client := grpc_health_v1.NewHealthClient(newTestPool(t, &Options{Size: 1}))
+
+		stream, err := client.Watch(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+		assert.NoError(t, err)
+
+		response, err := stream.Recv()
+		assert.NoError(t, err)
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, response.Status)
+	})
+
+	t.Run("should not be available when connections were not used yet", func(t *testing.T) {
+		assert.False(t, newTestPool(t, &Options{Size: 1}).IsAvailable())
+	})
+
+	t.Run("should return the best state between the connections", func(t *testing.T) {
+		pool := newTestPool(t, &Options{Size: 2})
+
+		pool.Connect()
+		assert.Eventually(t, func() bool {
+			return pool.GetState() == connectivity.Ready
+		}, time.Second, time.Millisecond)
+
+		_ = pool.connections[0].Close()
+		assert.Equal(t, connectivity.Ready, pool.GetState())
+
+		_ = pool.connections[1].Close()
+		assert.Equal(t, connectivity.Shutdown, pool.GetState())
+	})
+
+	t.Run("should return error when failed to dial", func(t *testing.T) {
+		_, err := NewPool("test", &Options{})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestCheckConnections(t *testing.T) {
+	t.Run("should redial connection after max failures", func(t *testing.T) {
+		pool := newTestPool(t, &Options{Size: 1, MaxFailures: 2})
+		closed := pool.connections[0]
+		_ = closed.Close()
+
+		pool.checkConnections()
+		assert.Same(t, closed, pool.connections[0])
+
+		pool.checkConnections()
+		assert.NotSame(t, closed, pool.connections[0])
+		assert.Equal(t, 0, pool.failures[0])
+
+		_, err := grpc_health_v1.NewHealthClient(pool).Check(context.Background(),
+			&grpc_health_v1.HealthCheckRequest{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("should keep healthy connections and reset failures", func(t *testing.T) {
+		pool := newTestPool(t, &Options{Size: 1, MaxFailures: 2})
+		connection := pool.connections[0]
+		pool.failures[0] = 1
+
+		pool.checkConnections()
+
+		assert.Same(t, connection, pool.connections[0])
+		assert.Equal(t, 0, pool.failures[0])
+	})
+
+	t.Run("should stop monitor when pool is closed", func(t *testing.T) {
+		pool := newTestPool(t, &Options{Size: 1, HealthCheckInterval: time.Millisecond})
+
+		time.Sleep(5 * time.Millisecond)
+
+		assert.NoError(t, pool.Close())
+		assert.NoError(t, pool.Close())
+	})
+
+	t.Run("should not redial connections after the pool is closed", func(t *testing.T) {
+		pool := newTestPool(t, &Options{Size: 1, MaxFailures: 1})
+		closed := pool.connections[0]
+
+		assert.NoError(t, pool.Close())
+
+		pool.checkConnections()
+
+		assert.Same(t, closed, pool.connections[0])
+	})
+}
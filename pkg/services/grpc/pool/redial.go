@@ -0,0 +1,83 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pool
+
+import (
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+func (p *Pool) checkConnections() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.closed {
+		return
+	}
+
+	for index, connection := range p.connections {
+		if p.isHealthy(connection) {
+			p.failures[index] = 0
+
+			continue
+		}
+
+		p.failures[index]++
+		if p.failures[index] >= p.options.getMaxFailures() {
+			p.redial(index)
+		}
+	}
+}
+
+// isHealthy asks idle connections to reconnect, since grpc only leaves the idle state when a call is made
+func (p *Pool) isHealthy(connection *grpc.ClientConn) bool {
+	switch connection.GetState() {
+	case connectivity.Idle:
+		connection.Connect()
+
+		return true
+	case connectivity.TransientFailure, connectivity.Shutdown:
+		return false
+	default:
+		return true
+	}
+}
+
+func (p *Pool) redial(index int) {
+	connection, err := grpc.Dial(p.target, p.dialOptions...)
+	if err != nil {
+		logger.LogError(enums.MessageFailedToRedialPoolConnection, err)
+
+		return
+	}
+
+	logger.LogInfo(enums.MessagePoolConnectionRedialed)
+
+	go p.drain(p.connections[index])
+
+	p.connections[index] = connection
+	p.failures[index] = 0
+}
+
+func (p *Pool) drain(connection *grpc.ClientConn) {
+	time.Sleep(p.options.DrainTimeout)
+
+	logger.LogError(enums.MessageFailedToClosePoolConnection, connection.Close())
+}
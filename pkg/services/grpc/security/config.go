@@ -0,0 +1,215 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package security
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"path/filepath"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+type IConfig interface {
+	SetUseCerts(useCerts bool)
+	GetUseCerts() bool
+	SetCAPath(caPath string)
+	GetCAPath() string
+	SetCertPath(certPath string)
+	GetCertPath() string
+	SetKeyPath(keyPath string)
+	GetKeyPath() string
+	SetClientCAPath(clientCAPath string)
+	GetClientCAPath() string
+	SetServerNameOverride(serverName string)
+	GetServerNameOverride() string
+	GetDialOption() (grpc.DialOption, error)
+	GetServerOption() (grpc.ServerOption, error)
+}
+
+// Config holds the certificates used by both sides of the connection. The cert and key are the server certificate
+// when serving and the client certificate for mutual tls when dialing.
+type Config struct {
+	useCerts           bool
+	caPath             string
+	certPath           string
+	keyPath            string
+	clientCAPath       string
+	serverNameOverride string
+}
+
+func NewConfig() IConfig {
+	config := &Config{}
+	config.SetUseCerts(env.GetEnvOrDefaultBool(enums.HorusecGRPCConnectionUsesCerts, false))
+	config.SetCAPath(env.GetEnvOrDefault(enums.HorusecGRPCCertificatePath, ""))
+	config.SetCertPath(env.GetEnvOrDefault(enums.HorusecGRPCTLSCertPath, ""))
+	config.SetKeyPath(env.GetEnvOrDefault(enums.HorusecGRPCTLSKeyPath, ""))
+	config.SetClientCAPath(env.GetEnvOrDefault(enums.HorusecGRPCClientCAPath, ""))
+	config.SetServerNameOverride(env.GetEnvOrDefault(enums.HorusecGRPCServerNameOverride, ""))
+
+	return config
+}
+
+func (c *Config) SetUseCerts(useCerts bool) {
+	c.useCerts = useCerts
+}
+
+func (c *Config) GetUseCerts() bool {
+	return c.useCerts
+}
+
+func (c *Config) SetCAPath(caPath string) {
+	c.caPath = caPath
+}
+
+func (c *Config) GetCAPath() string {
+	return c.caPath
+}
+
+func (c *Config) SetCertPath(certPath string) {
+	c.certPath = certPath
+}
+
+func (c *Config) GetCertPath() string {
+	return c.certPath
+}
+
+func (c *Config) SetKeyPath(keyPath string) {
+	c.keyPath = keyPath
+}
+
+func (c *Config) GetKeyPath() string {
+	return c.keyPath
+}
+
+func (c *Config) SetClientCAPath(clientCAPath string) {
+	c.clientCAPath = clientCAPath
+}
+
+func (c *Config) GetClientCAPath() string {
+	return c.clientCAPath
+}
+
+func (c *Config) SetServerNameOverride(serverName string) {
+	c.serverNameOverride = serverName
+}
+
+func (c *Config) GetServerNameOverride() string {
+	return c.serverNameOverride
+}
+
+func (c *Config) GetDialOption() (grpc.DialOption, error) {
+	if !c.useCerts {
+		return grpc.WithInsecure(), nil
+	}
+
+	tlsConfig, err := c.getClientTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)), nil
+}
+
+// GetServerOption requires and verifies the client certificates when the client ca path is set
+func (c *Config) GetServerOption() (grpc.ServerOption, error) {
+	if !c.useCerts {
+		return grpc.EmptyServerOption{}, nil
+	}
+
+	tlsConfig, err := c.getServerTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return grpc.Creds(credentials.NewTLS(tlsConfig)), nil
+}
+
+func (c *Config) getClientTLSConfig() (*tls.Config, error) {
+	if err := validation.Validate(c.caPath, validation.Required); err != nil {
+		return nil, err
+	}
+
+	rootCAs, err := c.loadCertPool(c.caPath)
+	if err != nil {
+		return nil, err
+	}
+
+	certificates, err := c.loadCertificates()
+
+	return &tls.Config{
+		RootCAs: rootCAs, Certificates: certificates, ServerName: c.serverNameOverride, MinVersion: tls.VersionTLS12,
+	}, err
+}
+
+func (c *Config) getServerTLSConfig() (*tls.Config, error) {
+	if err := validation.ValidateStruct(c, validation.Field(&c.certPath, validation.Required),
+		validation.Field(&c.keyPath, validation.Required)); err != nil {
+		return nil, err
+	}
+
+	certificates, err := c.loadCertificates()
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{Certificates: certificates, MinVersion: tls.VersionTLS12}
+
+	return tlsConfig, c.setClientAuth(tlsConfig)
+}
+
+func (c *Config) setClientAuth(tlsConfig *tls.Config) (err error) {
+	if c.clientCAPath == "" {
+		return nil
+	}
+
+	tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	tlsConfig.ClientCAs, err = c.loadCertPool(c.clientCAPath)
+
+	return err
+}
+
+func (c *Config) loadCertificates() ([]tls.Certificate, error) {
+	if c.certPath == "" || c.keyPath == "" {
+		return nil, nil
+	}
+
+	certificate, err := tls.LoadX509KeyPair(c.certPath, c.keyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return []tls.Certificate{certificate}, nil
+}
+
+func (c *Config) loadCertPool(path string) (*x509.CertPool, error) {
+	content, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(content) {
+		return nil, enums.ErrorInvalidCAFile
+	}
+
+	return pool, nil
+}
@@ -0,0 +1,250 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package security
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/enums"
+)
+
+type testCerts struct {
+	caPath   string
+	certPath string
+	keyPath  string
+}
+
+func writePEM(t *testing.T, path, pemType string, content []byte) {
+	assert.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: pemType, Bytes: content}), 0o600))
+}
+
+func newTestCertificate(t *testing.T, template, parent *x509.Certificate, parentKey *ecdsa.PrivateKey,
+	dir, name string) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	if parentKey == nil {
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	assert.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	writePEM(t, filepath.Join(dir, name+".crt"), "CERTIFICATE", der)
+	writePEM(t, filepath.Join(dir, name+".key"), "EC PRIVATE KEY", keyDer)
+
+	return key
+}
+
+func newTestCerts(t *testing.T) *testCerts {
+	dir := t.TempDir()
+	ca := &x509.Certificate{
+		SerialNumber: big.NewInt(1), Subject: pkix.Name{CommonName: "test-ca"}, IsCA: true,
+		NotBefore: time.Now().Add(-time.Hour), NotAfter: time.Now().Add(time.Hour), BasicConstraintsValid: true,
+		KeyUsage: x509.KeyUsageCertSign,
+	}
+	caKey := newTestCertificate(t, ca, nil, nil, dir, "ca")
+
+	newTestCertificate(t, &x509.Certificate{
+		SerialNumber: big.NewInt(2), Subject: pkix.Name{CommonName: "horusec-auth"}, DNSNames: []string{"horusec-auth"},
+		NotBefore: time.Now().Add(-time.Hour), NotAfter: time.Now().Add(time.Hour),
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}, ca, caKey, dir, "leaf")
+
+	return &testCerts{
+		caPath: filepath.Join(dir, "ca.crt"), certPath: filepath.Join(dir, "leaf.crt"),
+		keyPath: filepath.Join(dir, "leaf.key"),
+	}
+}
+
+func newTestConfig(certs *testCerts) IConfig {
+	config := &Config{}
+	config.SetUseCerts(true)
+	config.SetCAPath(certs.caPath)
+	config.SetClientCAPath(certs.caPath)
+	config.SetCertPath(certs.certPath)
+	config.SetKeyPath(certs.keyPath)
+	config.SetServerNameOverride("horusec-auth")
+
+	return config
+}
+
+func checkHealth(t *testing.T, serverConfig, clientConfig IConfig) error {
+	serverOption, err := serverConfig.GetServerOption()
+	assert.NoError(t, err)
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(serverOption)
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	defer server.Stop()
+
+	dialOption, err := clientConfig.GetDialOption()
+	assert.NoError(t, err)
+
+	conn, err := grpc.Dial("bufnet", dialOption, grpc.WithContextDialer(
+		func(context.Context, string) (net.Conn, error) {
+			return listener.Dial()
+		}))
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err = grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+
+	return err
+}
+
+func TestNewConfig(t *testing.T) {
+	t.Run("should read config from env", func(t *testing.T) {
+		_ = os.Setenv(enums.HorusecGRPCConnectionUsesCerts, "true")
+		_ = os.Setenv(enums.HorusecGRPCCertificatePath, "ca.crt")
+		_ = os.Setenv(enums.HorusecGRPCTLSCertPath, "tls.crt")
+		_ = os.Setenv(enums.HorusecGRPCTLSKeyPath, "tls.key")
+		_ = os.Setenv(enums.HorusecGRPCClientCAPath, "client-ca.crt")
+		_ = os.Setenv(enums.HorusecGRPCServerNameOverride, "test")
+
+		config := NewConfig()
+
+		assert.True(t, config.GetUseCerts())
+		assert.Equal(t, "ca.crt", config.GetCAPath())
+		assert.Equal(t, "tls.crt", config.GetCertPath())
+		assert.Equal(t, "tls.key", config.GetKeyPath())
+		assert.Equal(t, "client-ca.crt", config.GetClientCAPath())
+		assert.Equal(t, "test", config.GetServerNameOverride())
+
+		for _, name := range []string{enums.HorusecGRPCConnectionUsesCerts, enums.HorusecGRPCCertificatePath,
+			enums.HorusecGRPCTLSCertPath, enums.HorusecGRPCTLSKeyPath, enums.HorusecGRPCClientCAPath,
+			enums.HorusecGRPCServerNameOverride} {
+			_ = os.Unsetenv(name)
+		}
+	})
+}
+
+func TestGetDialOptionAndGetServerOption(t *testing.T) {
+	t.Run("should connect without certs when use certs is false", func(t *testing.T) {
+		assert.NoError(t, checkHealth(t, &Config{}, &Config{}))
+	})
+
+	t.Run("should connect with mutual tls", func(t *testing.T) {
+		config := newTestConfig(newTestCerts(t))
+
+		assert.NoError(t, checkHealth(t, config, config))
+	})
+
+	t.Run("should connect with server tls only", func(t *testing.T) {
+		certs := newTestCerts(t)
+		serverConfig := newTestConfig(certs)
+		serverConfig.SetClientCAPath("")
+
+		clientConfig := &Config{}
+		clientConfig.SetUseCerts(true)
+		clientConfig.SetCAPath(certs.caPath)
+		clientConfig.SetServerNameOverride("horusec-auth")
+
+		assert.NoError(t, checkHealth(t, serverConfig, clientConfig))
+	})
+
+	t.Run("should fail handshake when client has no certificate and server requires it", func(t *testing.T) {
+		certs := newTestCerts(t)
+		clientConfig := newTestConfig(certs)
+		clientConfig.SetCertPath("")
+
+		assert.Error(t, checkHealth(t, newTestConfig(certs), clientConfig))
+	})
+}
+
+func TestGetDialOption(t *testing.T) {
+	t.Run("should return error when ca path is empty", func(t *testing.T) {
+		_, err := (&Config{useCerts: true}).GetDialOption()
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when ca file is invalid", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "ca.crt")
+		assert.NoError(t, os.WriteFile(path, []byte("test"), 0o600))
+
+		_, err := (&Config{useCerts: true, caPath: path}).GetDialOption()
+
+		assert.ErrorIs(t, err, enums.ErrorInvalidCAFile)
+	})
+
+	t.Run("should return error when ca file does not exist", func(t *testing.T) {
+		_, err := (&Config{useCerts: true, caPath: "test"}).GetDialOption()
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when invalid key pair", func(t *testing.T) {
+		certs := newTestCerts(t)
+		config := newTestConfig(certs)
+		config.SetKeyPath(certs.caPath)
+
+		_, err := config.GetDialOption()
+
+		assert.Error(t, err)
+	})
+}
+
+func TestGetServerOption(t *testing.T) {
+	t.Run("should return error when cert and key are empty", func(t *testing.T) {
+		_, err := (&Config{useCerts: true}).GetServerOption()
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when invalid key pair", func(t *testing.T) {
+		_, err := (&Config{useCerts: true, certPath: "test", keyPath: "test"}).GetServerOption()
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when client ca file does not exist", func(t *testing.T) {
+		config := newTestConfig(newTestCerts(t))
+		config.SetClientCAPath("test")
+
+		_, err := config.GetServerOption()
+
+		assert.Error(t, err)
+	})
+}
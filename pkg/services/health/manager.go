@@ -0,0 +1,189 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/health/entities"
+	httpHealthEnums "github.com/ZupIT/horusec-devkit/pkg/services/http/health/enums"
+)
+
+type Checker func(ctx context.Context) error
+
+// Check is a checker registered by its name and kind, the manager timeout is used when the timeout is zero
+type Check struct {
+	Name    string
+	Kind    string
+	Checker Checker
+	Timeout time.Duration
+}
+
+type IManager interface {
+	AddCheck(check *Check)
+	Check(ctx context.Context, kinds ...string) *entities.Result
+	CheckByName(ctx context.Context, name string) (*entities.CheckResult, bool)
+	Handler(kinds ...string) http.HandlerFunc
+	Collector() prometheus.Collector
+}
+
+type cachedResult struct {
+	result    *entities.CheckResult
+	expiresAt time.Time
+}
+
+// Manager is the single registry of the service checks, used by the http endpoints, the grpc health service and
+// the prometheus metrics. The checks run concurrently and their results are cached for the cache duration.
+type Manager struct {
+	options *Options
+	mutex   sync.RWMutex
+	checks  map[string]*Check
+	cache   sync.Map
+}
+
+func NewManager(options *Options) IManager {
+	return &Manager{options: options, checks: map[string]*Check{}}
+}
+
+func (m *Manager) AddCheck(check *Check) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.checks[check.Name] = check
+	m.cache.Delete(check.Name)
+}
+
+// Check runs the checks of the kinds, or every check when no kind is informed
+func (m *Manager) Check(ctx context.Context, kinds ...string) *entities.Result {
+	checks := m.getChecks(kinds)
+	result := entities.NewResult()
+	mutex := sync.Mutex{}
+	group := sync.WaitGroup{}
+
+	for _, check := range checks {
+		group.Add(1)
+
+		go func(check *Check) {
+			defer group.Done()
+
+			checkResult := m.runCheck(ctx, check)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			result.AddCheck(check.Name, checkResult)
+		}(check)
+	}
+
+	group.Wait()
+
+	return result
+}
+
+func (m *Manager) CheckByName(ctx context.Context, name string) (*entities.CheckResult, bool) {
+	m.mutex.RLock()
+	check, ok := m.checks[name]
+	m.mutex.RUnlock()
+
+	if !ok {
+		return nil, false
+	}
+
+	return m.runCheck(ctx, check), true
+}
+
+func (m *Manager) getChecks(kinds []string) (checks []*Check) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	for _, check := range m.checks {
+		if len(kinds) == 0 || contains(kinds, check.Kind) {
+			checks = append(checks, check)
+		}
+	}
+
+	return checks
+}
+
+func contains(values []string, value string) bool {
+	for _, item := range values {
+		if item == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m *Manager) runCheck(ctx context.Context, check *Check) *entities.CheckResult {
+	if cached, ok := m.cache.Load(check.Name); ok && time.Now().Before(cached.(*cachedResult).expiresAt) {
+		return cached.(*cachedResult).result
+	}
+
+	result := m.runChecker(ctx, check)
+	if m.options.CacheDuration > 0 {
+		m.cache.Store(check.Name, &cachedResult{result: result, expiresAt: time.Now().Add(m.options.CacheDuration)})
+	}
+
+	return result
+}
+
+// runChecker does not wait a checker that ignores the context cancellation for longer than the timeout
+func (m *Manager) runChecker(ctx context.Context, check *Check) *entities.CheckResult {
+	timeout := check.Timeout
+	if timeout <= 0 {
+		timeout = m.options.Timeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	done := make(chan error, 1)
+
+	go func() {
+		done <- check.Checker(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		return entities.NewCheckResult(time.Since(start), err)
+	case <-ctx.Done():
+		return entities.NewCheckResult(time.Since(start), httpHealthEnums.ErrorCheckTimeout)
+	}
+}
+
+// Handler returns the json result of the checks of the kinds, with status 503 when any of them is down
+func (m *Manager) Handler(kinds ...string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		result := m.Check(r.Context(), kinds...)
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if result.IsUp() {
+			w.WriteHeader(http.StatusOK)
+		} else {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+
+		_ = json.NewEncoder(w).Encode(result)
+	}
+}
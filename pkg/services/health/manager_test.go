@@ -0,0 +1,166 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/health/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/health/entities"
+	httpHealthEnums "github.com/ZupIT/horusec-devkit/pkg/services/http/health/enums"
+)
+
+func newTestManager(cacheDuration time.Duration) IManager {
+	manager := NewManager(&Options{Timeout: time.Second, CacheDuration: cacheDuration})
+	manager.AddCheck(&Check{Name: "database", Kind: enums.KindReadiness, Checker: func(ctx context.Context) error {
+		return nil
+	}})
+	manager.AddCheck(&Check{Name: "broker", Kind: enums.KindReadiness, Checker: func(ctx context.Context) error {
+		return errors.New("test")
+	}})
+	manager.AddCheck(&Check{Name: "deadlock", Kind: enums.KindLiveness, Checker: func(ctx context.Context) error {
+		return nil
+	}})
+
+	return manager
+}
+
+func TestNewOptions(t *testing.T) {
+	t.Run("should read options from env", func(t *testing.T) {
+		t.Setenv(httpHealthEnums.HorusecHealthCheckTimeout, "2")
+		t.Setenv(enums.HorusecHealthCacheSeconds, "0")
+
+		options := NewOptions()
+
+		assert.Equal(t, 2*time.Second, options.Timeout)
+		assert.Equal(t, time.Duration(0), options.CacheDuration)
+	})
+}
+
+func TestCheck(t *testing.T) {
+	t.Run("should run every check when no kind is informed", func(t *testing.T) {
+		result := newTestManager(0).Check(context.Background())
+
+		assert.False(t, result.IsUp())
+		assert.Len(t, result.Checks, 3)
+		assert.Equal(t, "test", result.Checks["broker"].Error)
+	})
+
+	t.Run("should only run the checks of the kind", func(t *testing.T) {
+		result := newTestManager(0).Check(context.Background(), enums.KindLiveness)
+
+		assert.True(t, result.IsUp())
+		assert.Len(t, result.Checks, 1)
+	})
+
+	t.Run("should run checks concurrently with their own timeout", func(t *testing.T) {
+		manager := NewManager(&Options{Timeout: time.Second})
+		for _, name := range []string{"first", "second"} {
+			manager.AddCheck(&Check{Name: name, Timeout: 20 * time.Millisecond, Checker: func(ctx context.Context) error {
+				time.Sleep(time.Second)
+
+				return nil
+			}})
+		}
+
+		start := time.Now()
+		result := manager.Check(context.Background())
+
+		assert.Less(t, time.Since(start), 500*time.Millisecond)
+		assert.Equal(t, httpHealthEnums.ErrorCheckTimeout.Error(), result.Checks["first"].Error)
+		assert.Equal(t, httpHealthEnums.ErrorCheckTimeout.Error(), result.Checks["second"].Error)
+	})
+
+	t.Run("should reuse results until cache expires", func(t *testing.T) {
+		calls := int32(0)
+		manager := NewManager(&Options{Timeout: time.Second, CacheDuration: 50 * time.Millisecond})
+		manager.AddCheck(&Check{Name: "test", Checker: func(ctx context.Context) error {
+			atomic.AddInt32(&calls, 1)
+
+			return nil
+		}})
+
+		manager.Check(context.Background())
+		manager.Check(context.Background())
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+		time.Sleep(60 * time.Millisecond)
+		manager.Check(context.Background())
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	})
+}
+
+func TestCheckByName(t *testing.T) {
+	t.Run("should return result of the check", func(t *testing.T) {
+		result, ok := newTestManager(0).CheckByName(context.Background(), "broker")
+
+		assert.True(t, ok)
+		assert.Equal(t, httpHealthEnums.StatusDown, result.Status)
+	})
+
+	t.Run("should return false when check does not exist", func(t *testing.T) {
+		_, ok := newTestManager(0).CheckByName(context.Background(), "test")
+
+		assert.False(t, ok)
+	})
+}
+
+func TestHandler(t *testing.T) {
+	t.Run("should return 503 and json result when a check is down", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		newTestManager(0).Handler(enums.KindReadiness)(w, httptest.NewRequest(http.MethodGet, "/ready", nil))
+
+		result := &entities.Result{}
+		assert.NoError(t, json.NewDecoder(w.Body).Decode(result))
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		assert.Len(t, result.Checks, 2)
+	})
+
+	t.Run("should return 200 when checks are up", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		newTestManager(0).Handler(enums.KindLiveness)(w, httptest.NewRequest(http.MethodGet, "/live", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
+func TestCollector(t *testing.T) {
+	t.Run("should export status of each check", func(t *testing.T) {
+		registry := prometheus.NewRegistry()
+		assert.NoError(t, registry.Register(newTestManager(0).Collector()))
+
+		expected := `
+# HELP horusec_health_check_up Whether the health check is up (1) or down (0).
+# TYPE horusec_health_check_up gauge
+horusec_health_check_up{check="broker",kind="readiness"} 0
+horusec_health_check_up{check="database",kind="readiness"} 1
+horusec_health_check_up{check="deadlock",kind="liveness"} 1
+`
+
+		assert.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(expected), "horusec_health_check_up"))
+	})
+}
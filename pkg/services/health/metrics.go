@@ -0,0 +1,68 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/health/enums"
+	httpHealthEnums "github.com/ZupIT/horusec-devkit/pkg/services/http/health/enums"
+)
+
+// collector runs the checks on each scrape, so it should be registered only once for each manager
+type collector struct {
+	manager *Manager
+	up      *prometheus.Desc
+	latency *prometheus.Desc
+}
+
+func (m *Manager) Collector() prometheus.Collector {
+	labels := []string{enums.MetricsLabelCheck, enums.MetricsLabelKind}
+
+	return &collector{
+		manager: m,
+		up: prometheus.NewDesc(prometheus.BuildFQName(enums.MetricsNamespace, "", enums.MetricsCheckUp),
+			"Whether the health check is up (1) or down (0).", labels, nil),
+		latency: prometheus.NewDesc(prometheus.BuildFQName(enums.MetricsNamespace, "", enums.MetricsCheckLatency),
+			"Latency of the last health check.", labels, nil),
+	}
+}
+
+func (c *collector) Describe(descs chan<- *prometheus.Desc) {
+	descs <- c.up
+	descs <- c.latency
+}
+
+func (c *collector) Collect(metrics chan<- prometheus.Metric) {
+	result := c.manager.Check(context.Background())
+
+	for _, check := range c.manager.getChecks(nil) {
+		checkResult, ok := result.Checks[check.Name]
+		if !ok {
+			continue
+		}
+
+		up := 0.0
+		if checkResult.Status == httpHealthEnums.StatusUp {
+			up = 1
+		}
+
+		metrics <- prometheus.MustNewConstMetric(c.up, prometheus.GaugeValue, up, check.Name, check.Kind)
+		metrics <- prometheus.MustNewConstMetric(c.latency, prometheus.GaugeValue, checkResult.Latency.Seconds(),
+			check.Name, check.Kind)
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+import (
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/health/enums"
+	httpHealthEnums "github.com/ZupIT/horusec-devkit/pkg/services/http/health/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// Options configures the default timeout of the checks and for how long their results are reused, which avoids
+// running every checker again for each probe when the http, grpc and metrics endpoints are requested together
+type Options struct {
+	Timeout       time.Duration
+	CacheDuration time.Duration
+}
+
+func NewOptions() *Options {
+	return &Options{
+		Timeout: time.Duration(env.GetEnvOrDefaultInt(httpHealthEnums.HorusecHealthCheckTimeout,
+			httpHealthEnums.DefaultCheckTimeout)) * time.Second,
+		CacheDuration: time.Duration(env.GetEnvOrDefaultInt(enums.HorusecHealthCacheSeconds,
+			enums.DefaultCacheSeconds)) * time.Second,
+	}
+}
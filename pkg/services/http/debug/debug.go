@@ -0,0 +1,127 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debug
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"runtime/pprof"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/chi/middleware"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/debug/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
+)
+
+type IDebug interface {
+	WithPayloadRecorder(recorder middlewares.IPayloadRecorder) IDebug
+	Routes(router chi.Router)
+}
+
+// Debug exposes pprof, expvar and a full goroutine dump under /debug. The routes are only registered when enabled
+// by env and every request must send the debug token or be made by an application admin. While the application admin
+// is disabled on the auth config, which is the default, only the debug token is accepted. The router timeout also
+// limits the duration of the cpu profiles and traces.
+type Debug struct {
+	enabled       bool
+	token         string
+	authorization func(next http.Handler) http.Handler
+	recorder      middlewares.IPayloadRecorder
+}
+
+// NewDebug only accepts the debug token when the authz middleware is nil
+func NewDebug(authz middlewares.IApplicationAdminAuthzMiddleware) IDebug {
+	debug := &Debug{
+		enabled: env.GetEnvOrDefaultBool(enums.HorusecHTTPDebugEnabled, false),
+		token:   env.GetEnvOrDefault(enums.HorusecHTTPDebugToken, ""),
+	}
+
+	if authz != nil {
+		debug.authorization = authz.RequireApplicationAdmin
+	}
+
+	return debug
+}
+
+// WithPayloadRecorder exposes the recordings of the payload recorder on /debug/payloads, which are cleared by a
+// delete on the same route
+func (d *Debug) WithPayloadRecorder(recorder middlewares.IPayloadRecorder) IDebug {
+	d.recorder = recorder
+
+	return d
+}
+
+func (d *Debug) Routes(router chi.Router) {
+	if !d.enabled {
+		return
+	}
+
+	router.Route(enums.DebugRoute, func(router chi.Router) {
+		router.Use(d.authorize)
+		router.Get(enums.GoroutinesRoute, d.goroutines)
+
+		if d.recorder != nil {
+			router.Get(enums.PayloadsRoute, d.getPayloads)
+			router.Delete(enums.PayloadsRoute, d.clearPayloads)
+		}
+
+		router.Mount("/", middleware.Profiler())
+	})
+}
+
+func (d *Debug) authorize(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if d.isValidToken(r.Header.Get(enums.DebugTokenHeader)) {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		if d.authorization != nil {
+			d.authorization(next).ServeHTTP(w, r)
+
+			return
+		}
+
+		httpUtil.StatusUnauthorized(w, enums.ErrorInvalidDebugToken)
+	})
+}
+
+func (d *Debug) isValidToken(token string) bool {
+	if d.token == "" || token == "" {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(d.token), []byte(token)) == 1
+}
+
+func (d *Debug) goroutines(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+
+	_ = pprof.Lookup("goroutine").WriteTo(w, 2)
+}
+
+func (d *Debug) getPayloads(w http.ResponseWriter, _ *http.Request) {
+	httpUtil.StatusOK(w, d.recorder.GetRecordings())
+}
+
+func (d *Debug) clearPayloads(w http.ResponseWriter, _ *http.Request) {
+	d.recorder.Clear()
+
+	httpUtil.StatusNoContent(w)
+}
@@ -0,0 +1,170 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debug
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/auth"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/authtest"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/debug/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares"
+)
+
+func doRequest(debug IDebug, route, token string) *httptest.ResponseRecorder {
+	router := chi.NewRouter()
+	debug.Routes(router)
+
+	req, _ := http.NewRequest(http.MethodGet, route, nil)
+	req.Header.Set(enums.DebugTokenHeader, token)
+
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	return w
+}
+
+func doRequestWithJWT(debug IDebug, route, token string) *httptest.ResponseRecorder {
+	router := chi.NewRouter()
+	debug.Routes(router)
+
+	req, _ := http.NewRequest(http.MethodGet, route, nil)
+	req.Header.Set("X-Horusec-Authorization", token)
+
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	return w
+}
+
+func denyAll(_ http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+}
+
+func TestNewDebug(t *testing.T) {
+	t.Run("should read debug config from env", func(t *testing.T) {
+		t.Setenv(enums.HorusecHTTPDebugEnabled, "true")
+		t.Setenv(enums.HorusecHTTPDebugToken, "test")
+
+		debug := NewDebug(nil).(*Debug)
+
+		assert.True(t, debug.enabled)
+		assert.Equal(t, "test", debug.token)
+	})
+}
+
+func TestApplicationAdminAuthorization(t *testing.T) {
+	t.Setenv(enums.HorusecHTTPDebugEnabled, "true")
+	t.Setenv(enums.HorusecHTTPDebugToken, "test")
+
+	t.Run("should only accept the debug token when application admin is disabled", func(t *testing.T) {
+		server := authtest.NewServer(t)
+		server.SetIsAuthorized(func(_ *proto.IsAuthorizedData) (bool, error) {
+			return true, nil
+		})
+
+		debug := NewDebug(middlewares.NewAuthzMiddleware(server.Conn()).(middlewares.IApplicationAdminAuthzMiddleware))
+
+		assert.Equal(t, http.StatusUnauthorized, doRequest(debug, "/debug/goroutines", "").Code)
+		assert.Equal(t, http.StatusOK, doRequest(debug, "/debug/goroutines", "test").Code)
+		assert.Empty(t, server.GetIsAuthorizedRequests())
+	})
+
+	t.Run("should accept the application admin when enabled", func(t *testing.T) {
+		server := authtest.NewServer(t)
+		server.SetAuthConfig(&proto.GetAuthConfigResponse{EnableApplicationAdmin: true})
+		server.Authorize("admin", auth.ApplicationAdmin, "", "")
+
+		debug := NewDebug(middlewares.NewAuthzMiddleware(server.Conn()).(middlewares.IApplicationAdminAuthzMiddleware))
+
+		assert.Equal(t, http.StatusOK, doRequestWithJWT(debug, "/debug/goroutines", "admin").Code)
+		assert.Equal(t, http.StatusUnauthorized, doRequestWithJWT(debug, "/debug/goroutines", "member").Code)
+		assert.Equal(t, http.StatusUnauthorized, doRequest(debug, "/debug/goroutines", "").Code)
+	})
+}
+
+func TestRoutes(t *testing.T) {
+	t.Run("should not register routes when disabled", func(t *testing.T) {
+		w := doRequest(&Debug{token: "test"}, "/debug/pprof/", "test")
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return pprof index when valid token", func(t *testing.T) {
+		w := doRequest(&Debug{enabled: true, token: "test", authorization: denyAll}, "/debug/pprof/", "test")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "goroutine")
+	})
+
+	t.Run("should return expvar when valid token", func(t *testing.T) {
+		w := doRequest(&Debug{enabled: true, token: "test"}, "/debug/vars", "test")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "memstats")
+	})
+
+	t.Run("should return goroutines dump when valid token", func(t *testing.T) {
+		w := doRequest(&Debug{enabled: true, token: "test"}, "/debug/goroutines", "test")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "goroutine ")
+	})
+
+	t.Run("should use authorization middleware when invalid token", func(t *testing.T) {
+		w := doRequest(&Debug{enabled: true, token: "test", authorization: denyAll}, "/debug/pprof/", "invalid")
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("should return 401 when invalid token and without authorization", func(t *testing.T) {
+		w := doRequest(&Debug{enabled: true}, "/debug/pprof/", "")
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+	t.Run("should return and clear the payload recordings", func(t *testing.T) {
+		recorder := middlewares.NewPayloadRecorder(&middlewares.PayloadRecorderOptions{Enabled: true,
+			SamplePercent: 100, Capacity: 1, MaxBodySize: 10})
+		recorder.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})).
+			ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/test", nil))
+
+		debug := (&Debug{enabled: true, token: "test"}).WithPayloadRecorder(recorder)
+
+		w := doRequest(debug, "/debug/payloads", "test")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"path":"/test"`)
+
+		router := chi.NewRouter()
+		debug.Routes(router)
+
+		req, _ := http.NewRequest(http.MethodDelete, "/debug/payloads", nil)
+		req.Header.Set(enums.DebugTokenHeader, "test")
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		assert.Empty(t, recorder.GetRecordings())
+	})
+}
@@ -0,0 +1,87 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+import (
+	"context"
+	"errors"
+
+	goredis "github.com/redis/go-redis/v9"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	grpcHealth "github.com/ZupIT/horusec-devkit/pkg/services/grpc/health"
+	httpEnums "github.com/ZupIT/horusec-devkit/pkg/utils/http/enums"
+)
+
+// NewDatabaseChecker returns the error of the first unavailable connection, since the checker must detail why
+// the service is not ready. The connections without a health check only report if they are available
+func NewDatabaseChecker(connection database.IDatabaseRead) Checker {
+	healthChecker, ok := connection.(database.IHealthChecker)
+	if !ok {
+		return func(_ context.Context) error {
+			if !connection.IsAvailable() {
+				return httpEnums.ErrorDatabaseIsNotHealth
+			}
+
+			return nil
+		}
+	}
+
+	return func(ctx context.Context) error {
+		healthCheck := healthChecker.HealthCheck(ctx)
+		if healthCheck.Available {
+			return nil
+		}
+
+		for _, message := range []string{healthCheck.Write.Error, healthCheck.Read.Error} {
+			if message != "" {
+				return errors.New(message)
+			}
+		}
+
+		return httpEnums.ErrorDatabaseIsNotHealth
+	}
+}
+
+func NewBrokerChecker(brokerService broker.IBroker) Checker {
+	return func(_ context.Context) error {
+		if !brokerService.IsAvailable() {
+			return httpEnums.ErrorBrokerIsNotHealth
+		}
+
+		return nil
+	}
+}
+
+func NewGRPCChecker(client grpcHealth.ICheckClient) Checker {
+	return func(_ context.Context) error {
+		if isAvailable, _ := client.IsAvailable(); !isAvailable {
+			return httpEnums.ErrorGrpcIsNotHealth
+		}
+
+		return nil
+	}
+}
+
+func NewRedisChecker(client goredis.UniversalClient) Checker {
+	return func(ctx context.Context) error {
+		if client.Ping(ctx).Err() != nil {
+			return httpEnums.ErrorRedisIsNotHealth
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entities
+
+import (
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/health/enums"
+)
+
+type Result struct {
+	Status string                  `json:"status"`
+	Checks map[string]*CheckResult `json:"checks"`
+}
+
+type CheckResult struct {
+	Status  string        `json:"status"`
+	Latency time.Duration `json:"latency"`
+	Error   string        `json:"error,omitempty"`
+}
+
+func NewResult() *Result {
+	return &Result{
+		Status: enums.StatusUp,
+		Checks: map[string]*CheckResult{},
+	}
+}
+
+func NewCheckResult(latency time.Duration, err error) *CheckResult {
+	if err != nil {
+		return &CheckResult{Status: enums.StatusDown, Latency: latency, Error: err.Error()}
+	}
+
+	return &CheckResult{Status: enums.StatusUp, Latency: latency}
+}
+
+func (r *Result) AddCheck(name string, check *CheckResult) {
+	if check.Status != enums.StatusUp {
+		r.Status = enums.StatusDown
+	}
+
+	r.Checks[name] = check
+}
+
+func (r *Result) IsUp() bool {
+	return r.Status == enums.StatusUp
+}
@@ -0,0 +1,45 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entities
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/health/enums"
+)
+
+func TestResult(t *testing.T) {
+	t.Run("should keep status up when all checks are up", func(t *testing.T) {
+		result := NewResult()
+		result.AddCheck("test", NewCheckResult(time.Millisecond, nil))
+
+		assert.True(t, result.IsUp())
+		assert.Equal(t, time.Millisecond, result.Checks["test"].Latency)
+	})
+
+	t.Run("should set status down when a check is down", func(t *testing.T) {
+		result := NewResult()
+		result.AddCheck("up", NewCheckResult(0, nil))
+		result.AddCheck("down", NewCheckResult(0, errors.New("test")))
+
+		assert.False(t, result.IsUp())
+		assert.Equal(t, enums.StatusDown, result.Checks["down"].Status)
+		assert.Equal(t, "test", result.Checks["down"].Error)
+	})
+}
@@ -0,0 +1,31 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+const (
+	HealthRoute = "/health"
+	ReadyRoute  = "/ready"
+	LiveRoute   = "/live"
+
+	StatusUp   = "up"
+	StatusDown = "down"
+
+	CheckerDatabase = "database"
+	CheckerBroker   = "broker"
+	CheckerGRPCAuth = "grpc-auth"
+
+	HorusecHealthCheckTimeout = "HORUSEC_HEALTH_CHECK_TIMEOUT_SECONDS"
+	DefaultCheckTimeout       = 5
+)
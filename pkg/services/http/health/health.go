@@ -0,0 +1,61 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+import (
+	"github.com/go-chi/chi"
+
+	healthManager "github.com/ZupIT/horusec-devkit/pkg/services/health"
+	managerEnums "github.com/ZupIT/horusec-devkit/pkg/services/health/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/health/enums"
+)
+
+type Checker = healthManager.Checker
+
+type IHealth interface {
+	AddReadinessChecker(name string, checker Checker)
+	AddLivenessChecker(name string, checker Checker)
+	Routes(router chi.Router)
+}
+
+// Health exposes the checks of the manager on the http routes. The liveness checkers should only fail when the
+// service must be restarted, while the readiness checkers, like the database and broker ones, only remove the service
+// from the load balancer until their dependencies are available again.
+type Health struct {
+	manager healthManager.IManager
+}
+
+func NewHealth() IHealth {
+	return NewHealthWithManager(healthManager.NewManager(healthManager.NewOptions()))
+}
+
+// NewHealthWithManager uses the checks of a manager shared with the grpc health service and the metrics
+func NewHealthWithManager(manager healthManager.IManager) IHealth {
+	return &Health{manager: manager}
+}
+
+func (h *Health) AddReadinessChecker(name string, checker Checker) {
+	h.manager.AddCheck(&healthManager.Check{Name: name, Kind: managerEnums.KindReadiness, Checker: checker})
+}
+
+func (h *Health) AddLivenessChecker(name string, checker Checker) {
+	h.manager.AddCheck(&healthManager.Check{Name: name, Kind: managerEnums.KindLiveness, Checker: checker})
+}
+
+func (h *Health) Routes(router chi.Router) {
+	router.Get(enums.HealthRoute, h.manager.Handler())
+	router.Get(enums.ReadyRoute, h.manager.Handler(managerEnums.KindReadiness))
+	router.Get(enums.LiveRoute, h.manager.Handler(managerEnums.KindLiveness))
+}
@@ -0,0 +1,175 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEntities "github.com/ZupIT/horusec-devkit/pkg/services/database/entities"
+	grpcHealth "github.com/ZupIT/horusec-devkit/pkg/services/grpc/health"
+	healthManager "github.com/ZupIT/horusec-devkit/pkg/services/health"
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/health/entities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/health/enums"
+	httpEnums "github.com/ZupIT/horusec-devkit/pkg/utils/http/enums"
+)
+
+func doRequest(t *testing.T, health IHealth, route string) (int, *entities.Result) {
+	router := chi.NewRouter()
+	health.Routes(router)
+
+	req, _ := http.NewRequest(http.MethodGet, route, nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	result := &entities.Result{}
+	assert.NoError(t, json.NewDecoder(w.Body).Decode(result))
+
+	return w.Code, result
+}
+
+func newTestHealth() IHealth {
+	health := NewHealth()
+	health.AddReadinessChecker("database", func(ctx context.Context) error {
+		return nil
+	})
+	health.AddReadinessChecker("broker", func(ctx context.Context) error {
+		return errors.New("test")
+	})
+	health.AddLivenessChecker("deadlock", func(ctx context.Context) error {
+		return nil
+	})
+
+	return health
+}
+
+func TestRoutes(t *testing.T) {
+	t.Run("should return 503 and all checks when health is down", func(t *testing.T) {
+		code, result := doRequest(t, newTestHealth(), enums.HealthRoute)
+
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Equal(t, enums.StatusDown, result.Status)
+		assert.Len(t, result.Checks, 3)
+		assert.Equal(t, "test", result.Checks["broker"].Error)
+		assert.Equal(t, enums.StatusUp, result.Checks["database"].Status)
+	})
+
+	t.Run("should return 503 and only readiness checks when not ready", func(t *testing.T) {
+		code, result := doRequest(t, newTestHealth(), enums.ReadyRoute)
+
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Len(t, result.Checks, 2)
+	})
+
+	t.Run("should return 200 and only liveness checks when live", func(t *testing.T) {
+		code, result := doRequest(t, newTestHealth(), enums.LiveRoute)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, enums.StatusUp, result.Status)
+		assert.Len(t, result.Checks, 1)
+	})
+
+	t.Run("should return 200 when there are no checkers", func(t *testing.T) {
+		code, result := doRequest(t, NewHealth(), enums.ReadyRoute)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Empty(t, result.Checks)
+	})
+
+	t.Run("should return down when checker does not finish before timeout", func(t *testing.T) {
+		health := NewHealthWithManager(healthManager.NewManager(&healthManager.Options{Timeout: 10 * time.Millisecond}))
+		health.AddReadinessChecker("slow", func(ctx context.Context) error {
+			time.Sleep(time.Second)
+
+			return nil
+		})
+
+		code, result := doRequest(t, health, enums.ReadyRoute)
+
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Equal(t, enums.ErrorCheckTimeout.Error(), result.Checks["slow"].Error)
+	})
+}
+
+func TestCheckers(t *testing.T) {
+	t.Run("should return database connection error when unavailable", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("HealthCheck").Return(databaseEntities.NewHealthCheck(
+			databaseEntities.NewConnectionHealth(0, sql.DBStats{}, nil),
+			databaseEntities.NewConnectionHealth(0, sql.DBStats{}, errors.New("test")),
+		))
+
+		assert.EqualError(t, NewDatabaseChecker(databaseMock)(context.Background()), "test")
+	})
+
+	t.Run("should return nil when database is available", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("HealthCheck").Return(databaseEntities.NewHealthCheck(
+			databaseEntities.NewConnectionHealth(0, sql.DBStats{}, nil),
+			databaseEntities.NewConnectionHealth(0, sql.DBStats{}, nil),
+		))
+
+		assert.NoError(t, NewDatabaseChecker(databaseMock)(context.Background()))
+	})
+
+	t.Run("should use is available when the connection has no health check", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("IsAvailable").Return(false)
+
+		connection := struct{ database.IDatabaseRead }{databaseMock}
+
+		assert.Equal(t, httpEnums.ErrorDatabaseIsNotHealth, NewDatabaseChecker(connection)(context.Background()))
+	})
+
+	t.Run("should return error when broker is unavailable", func(t *testing.T) {
+		brokerMock := &broker.Mock{}
+		brokerMock.On("IsAvailable").Return(false)
+
+		assert.Equal(t, httpEnums.ErrorBrokerIsNotHealth, NewBrokerChecker(brokerMock)(context.Background()))
+	})
+
+	t.Run("should return nil when broker is available", func(t *testing.T) {
+		brokerMock := &broker.Mock{}
+		brokerMock.On("IsAvailable").Return(true)
+
+		assert.NoError(t, NewBrokerChecker(brokerMock)(context.Background()))
+	})
+
+	t.Run("should return error when grpc is unavailable", func(t *testing.T) {
+		grpcMock := &grpcHealth.MockHealthCheckClient{}
+		grpcMock.On("IsAvailable").Return(false, "TRANSIENT_FAILURE")
+
+		assert.Equal(t, httpEnums.ErrorGrpcIsNotHealth, NewGRPCChecker(grpcMock)(context.Background()))
+	})
+
+	t.Run("should return nil when grpc is available", func(t *testing.T) {
+		grpcMock := &grpcHealth.MockHealthCheckClient{}
+		grpcMock.On("IsAvailable").Return(true, "READY")
+
+		assert.NoError(t, NewGRPCChecker(grpcMock)(context.Background()))
+	})
+}
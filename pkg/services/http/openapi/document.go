@@ -0,0 +1,109 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/openapi/entities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/openapi/enums"
+)
+
+// pathParamRegex matches the chi url params, which can also have a regular expression like {id:[0-9]+}
+var pathParamRegex = regexp.MustCompile(`{([^}:]+)(:[^}]+)?}`)
+
+type documentBuilder struct {
+	mutex    sync.RWMutex
+	document *entities.Document
+	schemas  *schemaGenerator
+}
+
+func newDocumentBuilder(info *entities.Info) *documentBuilder {
+	document := entities.NewDocument(info)
+
+	return &documentBuilder{
+		document: document,
+		schemas:  newSchemaGenerator(document.Components.Schemas),
+	}
+}
+
+func (d *documentBuilder) addOperation(method, pattern string, route *routeDocumentation) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	path, parameters := convertPath(pattern)
+	route.operation.Parameters = append(parameters, route.operation.Parameters...)
+	route.operation.RequestBody = d.getRequestBody(route.request)
+	d.setResponses(route)
+
+	if _, ok := d.document.Paths[path]; !ok {
+		d.document.Paths[path] = map[string]*entities.Operation{}
+	}
+
+	d.document.Paths[path][strings.ToLower(method)] = route.operation
+}
+
+func (d *documentBuilder) getRequestBody(request interface{}) *entities.RequestBody {
+	if request == nil {
+		return nil
+	}
+
+	return &entities.RequestBody{Required: true, Content: d.getContent(request)}
+}
+
+func (d *documentBuilder) setResponses(route *routeDocumentation) {
+	if len(route.responses) == 0 {
+		route.responses[http.StatusOK] = nil
+	}
+
+	for statusCode, entity := range route.responses {
+		response := &entities.Response{Description: http.StatusText(statusCode)}
+		if entity != nil {
+			response.Content = d.getContent(entity)
+		}
+
+		route.operation.Responses[strconv.Itoa(statusCode)] = response
+	}
+}
+
+func (d *documentBuilder) getContent(entity interface{}) map[string]*entities.MediaType {
+	return map[string]*entities.MediaType{
+		enums.ContentTypeJSON: {Schema: d.schemas.generate(reflect.TypeOf(entity))},
+	}
+}
+
+func (d *documentBuilder) getDocument() *entities.Document {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	return d.document
+}
+
+func convertPath(pattern string) (string, []*entities.Parameter) {
+	var parameters []*entities.Parameter
+
+	for _, match := range pathParamRegex.FindAllStringSubmatch(pattern, -1) {
+		parameters = append(parameters, &entities.Parameter{
+			Name: match[1], In: enums.ParameterInPath, Required: true, Schema: &entities.Schema{Type: "string"},
+		})
+	}
+
+	return pathParamRegex.ReplaceAllString(pattern, "{$1}"), parameters
+}
@@ -0,0 +1,88 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/openapi/entities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/openapi/enums"
+)
+
+type RouteOption func(route *routeDocumentation)
+
+type routeDocumentation struct {
+	operation *entities.Operation
+	request   interface{}
+	responses map[int]interface{}
+}
+
+func newRouteDocumentation(options []RouteOption) *routeDocumentation {
+	route := &routeDocumentation{
+		operation: &entities.Operation{Responses: map[string]*entities.Response{}},
+		responses: map[int]interface{}{},
+	}
+
+	for _, option := range options {
+		option(route)
+	}
+
+	return route
+}
+
+func WithSummary(summary string) RouteOption {
+	return func(route *routeDocumentation) {
+		route.operation.Summary = summary
+	}
+}
+
+func WithDescription(description string) RouteOption {
+	return func(route *routeDocumentation) {
+		route.operation.Description = description
+	}
+}
+
+func WithTags(tags ...string) RouteOption {
+	return func(route *routeDocumentation) {
+		route.operation.Tags = append(route.operation.Tags, tags...)
+	}
+}
+
+func WithDeprecated() RouteOption {
+	return func(route *routeDocumentation) {
+		route.operation.Deprecated = true
+	}
+}
+
+// WithRequest documents the json body of the request using the fields of the given entity
+func WithRequest(entity interface{}) RouteOption {
+	return func(route *routeDocumentation) {
+		route.request = entity
+	}
+}
+
+// WithResponse documents a response status code, where a nil entity means a response without body
+func WithResponse(statusCode int, entity interface{}) RouteOption {
+	return func(route *routeDocumentation) {
+		route.responses[statusCode] = entity
+	}
+}
+
+func WithQueryParameter(name, description string, required bool) RouteOption {
+	return func(route *routeDocumentation) {
+		route.operation.Parameters = append(route.operation.Parameters, &entities.Parameter{
+			Name: name, In: enums.ParameterInQuery, Description: description, Required: required,
+			Schema: &entities.Schema{Type: "string"},
+		})
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/openapi/entities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/openapi/enums"
+	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
+)
+
+type IRouter interface {
+	Get(pattern string, handler http.HandlerFunc, options ...RouteOption)
+	Post(pattern string, handler http.HandlerFunc, options ...RouteOption)
+	Put(pattern string, handler http.HandlerFunc, options ...RouteOption)
+	Patch(pattern string, handler http.HandlerFunc, options ...RouteOption)
+	Delete(pattern string, handler http.HandlerFunc, options ...RouteOption)
+	Method(method, pattern string, handler http.HandlerFunc, options ...RouteOption)
+	Route(pattern string, fn func(router IRouter))
+	With(middlewares ...func(http.Handler) http.Handler) IRouter
+	GetDocument() *entities.Document
+}
+
+// Router registers the routes in the chi router while documenting them, so the document served at /swagger.json
+// is always the same as the routes that were registered
+type Router struct {
+	router   chi.Router
+	prefix   string
+	document *documentBuilder
+}
+
+func NewRouter(router chi.Router, info *entities.Info) IRouter {
+	documented := &Router{
+		router:   router,
+		document: newDocumentBuilder(info),
+	}
+
+	router.Get(enums.SwaggerRoute, documented.serveDocument)
+
+	return documented
+}
+
+func (r *Router) Get(pattern string, handler http.HandlerFunc, options ...RouteOption) {
+	r.Method(http.MethodGet, pattern, handler, options...)
+}
+
+func (r *Router) Post(pattern string, handler http.HandlerFunc, options ...RouteOption) {
+	r.Method(http.MethodPost, pattern, handler, options...)
+}
+
+func (r *Router) Put(pattern string, handler http.HandlerFunc, options ...RouteOption) {
+	r.Method(http.MethodPut, pattern, handler, options...)
+}
+
+func (r *Router) Patch(pattern string, handler http.HandlerFunc, options ...RouteOption) {
+	r.Method(http.MethodPatch, pattern, handler, options...)
+}
+
+func (r *Router) Delete(pattern string, handler http.HandlerFunc, options ...RouteOption) {
+	r.Method(http.MethodDelete, pattern, handler, options...)
+}
+
+func (r *Router) Method(method, pattern string, handler http.HandlerFunc, options ...RouteOption) {
+	r.router.Method(method, pattern, handler)
+	r.document.addOperation(method, r.prefix+pattern, newRouteDocumentation(options))
+}
+
+func (r *Router) Route(pattern string, fn func(router IRouter)) {
+	r.router.Route(pattern, func(router chi.Router) {
+		fn(&Router{router: router, prefix: r.prefix + pattern, document: r.document})
+	})
+}
+
+func (r *Router) With(middlewares ...func(http.Handler) http.Handler) IRouter {
+	return &Router{router: r.router.With(middlewares...), prefix: r.prefix, document: r.document}
+}
+
+func (r *Router) GetDocument() *entities.Document {
+	return r.document.getDocument()
+}
+
+func (r *Router) serveDocument(w http.ResponseWriter, _ *http.Request) {
+	r.document.mutex.RLock()
+	content, err := json.Marshal(r.document.document)
+	r.document.mutex.RUnlock()
+
+	if err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", enums.ContentTypeJSON)
+	_, _ = w.Write(content)
+}
@@ -0,0 +1,155 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/openapi/entities"
+)
+
+type testBase struct {
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type testWorkspace struct {
+	testBase
+	WorkspaceID uuid.UUID      `json:"workspaceID" required:"true" description:"workspace identifier"`
+	Name        string         `json:"name" required:"true" example:"horusec"`
+	Tags        []string       `json:"tags,omitempty"`
+	Labels      map[string]int `json:"labels"`
+	Parent      *testWorkspace `json:"parent"`
+	Ignored     string         `json:"-"`
+	internal    string
+	Anonymous   struct{ ID int64 } `json:"anonymous"`
+}
+
+func emptyHandler(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func newTestRouter() (*chi.Mux, IRouter) {
+	mux := chi.NewRouter()
+	router := NewRouter(mux, &entities.Info{Title: "test", Version: "v1"})
+
+	router.Route("/api/workspaces", func(router IRouter) {
+		router.Post("/", emptyHandler, WithSummary("create"), WithTags("workspace"),
+			WithRequest(testWorkspace{}), WithResponse(http.StatusCreated, &testWorkspace{}))
+		router.Get("/{workspaceID}", emptyHandler, WithDescription("get"), WithDeprecated(),
+			WithQueryParameter("page", "page number", false), WithResponse(http.StatusOK, []testWorkspace{}))
+		router.With(func(next http.Handler) http.Handler { return next }).
+			Delete("/{workspaceID:[a-z0-9-]+}", emptyHandler, WithResponse(http.StatusNoContent, nil))
+	})
+
+	router.Put("/put", emptyHandler)
+	router.Patch("/patch", emptyHandler)
+
+	return mux, router
+}
+
+func TestRouter(t *testing.T) {
+	t.Run("should register routes in the chi router", func(t *testing.T) {
+		mux, _ := newTestRouter()
+
+		for _, path := range []string{"/api/workspaces/test", "/put", "/patch"} {
+			req, _ := http.NewRequest(http.MethodGet, path, nil)
+			w := httptest.NewRecorder()
+
+			mux.ServeHTTP(w, req)
+
+			assert.NotEqual(t, http.StatusNotFound, w.Code, path)
+		}
+	})
+
+	t.Run("should document operations with path params", func(t *testing.T) {
+		_, router := newTestRouter()
+		paths := router.GetDocument().Paths
+
+		assert.Equal(t, "create", paths["/api/workspaces/"]["post"].Summary)
+		assert.Equal(t, []string{"workspace"}, paths["/api/workspaces/"]["post"].Tags)
+		assert.NotNil(t, paths["/api/workspaces/"]["post"].RequestBody)
+		assert.NotNil(t, paths["/api/workspaces/"]["post"].Responses["201"].Content)
+
+		get := paths["/api/workspaces/{workspaceID}"]["get"]
+		assert.True(t, get.Deprecated)
+		assert.Len(t, get.Parameters, 2)
+		assert.Equal(t, "path", get.Parameters[0].In)
+		assert.Equal(t, "query", get.Parameters[1].In)
+		assert.Equal(t, "array", get.Responses["200"].Content["application/json"].Schema.Type)
+
+		assert.Nil(t, paths["/api/workspaces/{workspaceID}"]["delete"].Responses["204"].Content)
+		assert.Equal(t, "OK", paths["/put"]["put"].Responses["200"].Description)
+	})
+
+	t.Run("should document entities as components", func(t *testing.T) {
+		_, router := newTestRouter()
+		schema := router.GetDocument().Components.Schemas["testWorkspace"]
+
+		assert.Equal(t, "object", schema.Type)
+		assert.ElementsMatch(t, []string{"workspaceID", "name"}, schema.Required)
+		assert.Equal(t, "uuid", schema.Properties["workspaceID"].Format)
+		assert.Equal(t, "workspace identifier", schema.Properties["workspaceID"].Description)
+		assert.Equal(t, "horusec", schema.Properties["name"].Example)
+		assert.Equal(t, "date-time", schema.Properties["createdAt"].Format)
+		assert.Equal(t, "string", schema.Properties["tags"].Items.Type)
+		assert.Equal(t, "integer", schema.Properties["labels"].AdditionalProperties.Type)
+		assert.Equal(t, "#/components/schemas/testWorkspace", schema.Properties["parent"].Ref)
+		assert.Equal(t, "int64", schema.Properties["anonymous"].Properties["ID"].Format)
+		assert.NotContains(t, schema.Properties, "Ignored")
+		assert.NotContains(t, schema.Properties, "internal")
+	})
+
+	t.Run("should keep a component for each struct with the same name", func(t *testing.T) {
+		type testWorkspace struct {
+			ID int64 `json:"id"`
+		}
+
+		_, router := newTestRouter()
+		router.Get("/other", emptyHandler, WithResponse(http.StatusOK, testWorkspace{}))
+
+		schemas := router.GetDocument().Components.Schemas
+		ref := router.GetDocument().Paths["/other"]["get"].Responses["200"].Content["application/json"].Schema.Ref
+		name := strings.TrimPrefix(ref, "#/components/schemas/")
+
+		assert.Contains(t, schemas["testWorkspace"].Properties, "workspaceID")
+		assert.NotEqual(t, "testWorkspace", name)
+		assert.Regexp(t, `^[a-zA-Z0-9._-]+$`, name)
+		assert.Contains(t, schemas[name].Properties, "id")
+	})
+
+	t.Run("should serve the generated document", func(t *testing.T) {
+		mux, _ := newTestRouter()
+
+		req, _ := http.NewRequest(http.MethodGet, "/swagger.json", nil)
+		w := httptest.NewRecorder()
+
+		mux.ServeHTTP(w, req)
+
+		document := &entities.Document{}
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NoError(t, json.NewDecoder(w.Body).Decode(document))
+		assert.Equal(t, "3.0.3", document.OpenAPI)
+		assert.Contains(t, document.Paths, "/api/workspaces/{workspaceID}")
+	})
+}
@@ -0,0 +1,198 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/openapi/entities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/openapi/enums"
+)
+
+// knownSchemas are the types that would be wrongly described by their kind, like uuid that is a bytes array
+var knownSchemas = map[reflect.Type]entities.Schema{
+	reflect.TypeOf(time.Time{}):          {Type: "string", Format: "date-time"},
+	reflect.TypeOf(uuid.UUID{}):          {Type: "string", Format: "uuid"},
+	reflect.TypeOf(json.RawMessage{}):    {},
+	reflect.TypeOf(time.Duration(0)):     {Type: "integer", Format: "int64"},
+	reflect.TypeOf([]byte{}):             {Type: "string", Format: "byte"},
+	reflect.TypeOf((*error)(nil)).Elem(): {Type: "string"},
+}
+
+var kindSchemas = map[reflect.Kind]entities.Schema{
+	reflect.Bool:    {Type: "boolean"},
+	reflect.Int:     {Type: "integer"},
+	reflect.Int8:    {Type: "integer", Format: "int32"},
+	reflect.Int16:   {Type: "integer", Format: "int32"},
+	reflect.Int32:   {Type: "integer", Format: "int32"},
+	reflect.Int64:   {Type: "integer", Format: "int64"},
+	reflect.Uint:    {Type: "integer"},
+	reflect.Uint8:   {Type: "integer", Format: "int32"},
+	reflect.Uint16:  {Type: "integer", Format: "int32"},
+	reflect.Uint32:  {Type: "integer", Format: "int64"},
+	reflect.Uint64:  {Type: "integer", Format: "int64"},
+	reflect.Float32: {Type: "number", Format: "float"},
+	reflect.Float64: {Type: "number", Format: "double"},
+	reflect.String:  {Type: "string"},
+}
+
+// invalidComponentChars are the characters not allowed in the components keys, like the slashes of the package paths
+var invalidComponentChars = regexp.MustCompile(`[^a-zA-Z0-9._-]`)
+
+// schemaGenerator describes the go types with reflection, where the named structs are added to the components and
+// referenced by their name, which also avoids an infinite recursion in self referencing structs. The structs with the
+// same name from different packages are keyed by their package path, keeping the short name for the first one
+type schemaGenerator struct {
+	components map[string]*entities.Schema
+	names      map[reflect.Type]string
+}
+
+func newSchemaGenerator(components map[string]*entities.Schema) *schemaGenerator {
+	return &schemaGenerator{components: components, names: map[reflect.Type]string{}}
+}
+
+func (s *schemaGenerator) generate(typeOf reflect.Type) *entities.Schema {
+	for typeOf.Kind() == reflect.Ptr {
+		typeOf = typeOf.Elem()
+	}
+
+	if schema, ok := knownSchemas[typeOf]; ok {
+		return &schema
+	}
+
+	if schema, ok := kindSchemas[typeOf.Kind()]; ok {
+		return &schema
+	}
+
+	return s.generateComposite(typeOf)
+}
+
+func (s *schemaGenerator) generateComposite(typeOf reflect.Type) *entities.Schema {
+	switch typeOf.Kind() {
+	case reflect.Struct:
+		return s.generateReference(typeOf)
+	case reflect.Slice, reflect.Array:
+		return &entities.Schema{Type: "array", Items: s.generate(typeOf.Elem())}
+	case reflect.Map:
+		return &entities.Schema{Type: "object", AdditionalProperties: s.generate(typeOf.Elem())}
+	default:
+		return &entities.Schema{}
+	}
+}
+
+func (s *schemaGenerator) generateReference(typeOf reflect.Type) *entities.Schema {
+	if typeOf.Name() == "" {
+		return s.generateObject(typeOf)
+	}
+
+	name, ok := s.names[typeOf]
+	if !ok {
+		name = s.newComponentName(typeOf)
+		s.names[typeOf] = name
+		s.components[name] = &entities.Schema{}
+		*s.components[name] = *s.generateObject(typeOf)
+	}
+
+	return &entities.Schema{Ref: enums.ComponentsPrefix + name}
+}
+
+func (s *schemaGenerator) newComponentName(typeOf reflect.Type) string {
+	name := invalidComponentChars.ReplaceAllString(typeOf.Name(), "_")
+	if _, ok := s.components[name]; !ok {
+		return name
+	}
+
+	name = invalidComponentChars.ReplaceAllString(typeOf.PkgPath()+"."+typeOf.Name(), "_")
+	for index, base := 2, name; s.components[name] != nil; index++ {
+		name = fmt.Sprintf("%s_%d", base, index)
+	}
+
+	return name
+}
+
+func (s *schemaGenerator) generateObject(typeOf reflect.Type) *entities.Schema {
+	schema := &entities.Schema{Type: "object", Properties: map[string]*entities.Schema{}}
+
+	for index := 0; index < typeOf.NumField(); index++ {
+		s.addField(schema, typeOf.Field(index))
+	}
+
+	return schema
+}
+
+func (s *schemaGenerator) addField(schema *entities.Schema, field reflect.StructField) {
+	name, isEmbedded := getFieldName(field)
+	if name == "" {
+		return
+	}
+
+	if isEmbedded {
+		s.addEmbeddedFields(schema, field.Type)
+
+		return
+	}
+
+	property := s.generate(field.Type)
+	property.Description = field.Tag.Get(enums.TagDescription)
+
+	if example := field.Tag.Get(enums.TagExample); example != "" {
+		property.Example = example
+	}
+
+	if field.Tag.Get(enums.TagRequired) == "true" {
+		schema.Required = append(schema.Required, name)
+	}
+
+	schema.Properties[name] = property
+}
+
+func (s *schemaGenerator) addEmbeddedFields(schema *entities.Schema, typeOf reflect.Type) {
+	embedded := s.generateObject(typeOf)
+	for name, property := range embedded.Properties {
+		schema.Properties[name] = property
+	}
+
+	schema.Required = append(schema.Required, embedded.Required...)
+}
+
+// getFieldName follows the encoding/json rules, where embedded structs without name have their fields promoted
+func getFieldName(field reflect.StructField) (name string, isEmbedded bool) {
+	tag := strings.Split(field.Tag.Get("json"), ",")[0]
+	if tag == "-" || (!field.IsExported() && !field.Anonymous) {
+		return "", false
+	}
+
+	fieldType := field.Type
+	if fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+
+	if field.Anonymous && tag == "" && fieldType.Kind() == reflect.Struct {
+		return fieldType.Name(), true
+	}
+
+	if tag != "" {
+		return tag, false
+	}
+
+	return field.Name, false
+}
@@ -0,0 +1,73 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package presets
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/account"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/auth"
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/presets/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+// StandardMiddlewares returns the middlewares of the route groups, which are added to the ones of the router returned
+// by router.NewHTTPRouter, where the request id, access log, recoverer and timeout already run for every route. The
+// metrics are labeled by the route pattern of the group and the propagation runs before the authorization.
+func StandardMiddlewares() []func(http.Handler) http.Handler {
+	return []func(http.Handler) http.Handler{
+		middlewares.MetricsMiddleware,
+		middlewares.PropagationMiddleware,
+	}
+}
+
+// NewPublicGroup returns a router with the standard middlewares for the routes without authorization. The router
+// must be the mux of router.NewHTTPRouter or a router with the same middlewares.
+func NewPublicGroup(router chi.Router) chi.Router {
+	return router.With(StandardMiddlewares()...)
+}
+
+// NewAuthenticatedGroup returns a router with the standard middlewares followed by the authorization middleware of
+// the given authorization type, which is always the last one to run
+func NewAuthenticatedGroup(router chi.Router, authz middlewares.IAuthzMiddleware,
+	authorizationType auth.AuthorizationType) chi.Router {
+	authorization := getAuthorizationMiddleware(authz, authorizationType)
+	if authorization == nil {
+		logger.LogPanic(enums.MessageInvalidAuthorizationType, enums.ErrorInvalidAuthorizationType)
+	}
+
+	return router.With(append(StandardMiddlewares(), authorization)...)
+}
+
+// NewRoleGroup returns a router with the standard middlewares followed by the middleware of the role, which is checked
+// on the repository or workspace of the route, so the roles created by downstream services can protect their routes
+func NewRoleGroup(router chi.Router, authz middlewares.IRoleAuthzMiddleware, role account.Role) chi.Router {
+	return router.With(append(StandardMiddlewares(), authz.NewRoleMiddleware(role))...)
+}
+
+func getAuthorizationMiddleware(authz middlewares.IAuthzMiddleware,
+	authorizationType auth.AuthorizationType) func(http.Handler) http.Handler {
+	return map[auth.AuthorizationType]func(http.Handler) http.Handler{
+		auth.ApplicationAdmin:     authz.IsApplicationAdmin,
+		auth.WorkspaceAdmin:       authz.IsWorkspaceAdmin,
+		auth.WorkspaceMember:      authz.IsWorkspaceMember,
+		auth.RepositoryAdmin:      authz.IsRepositoryAdmin,
+		auth.RepositorySupervisor: authz.IsRepositorySupervisor,
+		auth.RepositoryMember:     authz.IsRepositoryMember,
+	}[authorizationType]
+}
@@ -0,0 +1,153 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package presets
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/cors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/account"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/auth"
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/router"
+)
+
+type authzStub struct {
+	called auth.AuthorizationType
+}
+
+func (a *authzStub) authorize(authorizationType auth.AuthorizationType,
+	next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a.called = authorizationType
+
+		if middleware.GetReqID(r.Context()) == "" {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (a *authzStub) IsApplicationAdmin(next http.Handler) http.Handler {
+	return a.authorize(auth.ApplicationAdmin, next)
+}
+
+func (a *authzStub) IsWorkspaceMember(next http.Handler) http.Handler {
+	return a.authorize(auth.WorkspaceMember, next)
+}
+
+func (a *authzStub) IsWorkspaceAdmin(next http.Handler) http.Handler {
+	return a.authorize(auth.WorkspaceAdmin, next)
+}
+
+func (a *authzStub) IsRepositoryMember(next http.Handler) http.Handler {
+	return a.authorize(auth.RepositoryMember, next)
+}
+
+func (a *authzStub) IsRepositoryAdmin(next http.Handler) http.Handler {
+	return a.authorize(auth.RepositoryAdmin, next)
+}
+
+func (a *authzStub) IsRepositorySupervisor(next http.Handler) http.Handler {
+	return a.authorize(auth.RepositorySupervisor, next)
+}
+
+func (a *authzStub) NewRoleMiddleware(role account.Role) func(http.Handler) http.Handler {
+	return a.NewAuthorizationTypeMiddleware(auth.NewAuthorizationType(auth.ScopeApplication, role))
+}
+
+func (a *authzStub) NewAuthorizationTypeMiddleware(
+	authorizationType auth.AuthorizationType) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return a.authorize(authorizationType, next)
+	}
+}
+
+func newTestRouter() chi.Router {
+	return router.NewHTTPRouter(&cors.Options{}, "8000").GetMux()
+}
+
+func doRequest(router http.Handler, path string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(http.MethodGet, path, nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	return w
+}
+
+func TestNewAuthenticatedGroup(t *testing.T) {
+	t.Run("should run authorization after the standard middlewares", func(t *testing.T) {
+		for _, authorizationType := range auth.Values() {
+			authz := &authzStub{}
+			mux := newTestRouter()
+
+			NewAuthenticatedGroup(mux, authz, authorizationType).Get("/test", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			assert.Equal(t, http.StatusNoContent, doRequest(mux, "/test").Code)
+			assert.Equal(t, authorizationType, authz.called)
+		}
+	})
+
+	t.Run("should panic when authorization type is invalid", func(t *testing.T) {
+		assert.Panics(t, func() {
+			NewAuthenticatedGroup(chi.NewRouter(), &authzStub{}, "invalid")
+		})
+	})
+}
+
+func TestNewRoleGroup(t *testing.T) {
+	t.Run("should run the role middleware after the standard middlewares", func(t *testing.T) {
+		authz := &authzStub{}
+		mux := newTestRouter()
+
+		NewRoleGroup(mux, authz, "auditor").Get("/test", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		})
+
+		assert.Equal(t, http.StatusNoContent, doRequest(mux, "/test").Code)
+		assert.Equal(t, auth.AuthorizationType("IsApplicationAuditor"), authz.called)
+	})
+}
+
+func TestNewPublicGroup(t *testing.T) {
+	t.Run("should set request id and timeout in public routes", func(t *testing.T) {
+		mux := newTestRouter()
+
+		NewPublicGroup(mux).Get("/test", func(w http.ResponseWriter, r *http.Request) {
+			_, hasDeadline := r.Context().Deadline()
+
+			assert.True(t, hasDeadline)
+			assert.NotEmpty(t, middleware.GetReqID(r.Context()))
+			w.WriteHeader(http.StatusNoContent)
+		})
+
+		assert.Equal(t, http.StatusNoContent, doRequest(mux, "/test").Code)
+	})
+
+	t.Run("should return the standard middlewares", func(t *testing.T) {
+		assert.Len(t, StandardMiddlewares(), 2)
+	})
+}
@@ -23,10 +23,14 @@ import (
 	"github.com/go-chi/chi"
 	"github.com/go-chi/chi/middleware"
 	"github.com/go-chi/cors"
-	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	"github.com/ZupIT/horusec-devkit/pkg/enums/ozzovalidation"
 	"github.com/ZupIT/horusec-devkit/pkg/services/http/router/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/metrics"
+	metricsEnums "github.com/ZupIT/horusec-devkit/pkg/services/metrics/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares"
+	"github.com/ZupIT/horusec-devkit/pkg/services/version"
+	versionEnums "github.com/ZupIT/horusec-devkit/pkg/services/version/enums"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
 )
@@ -45,6 +49,7 @@ type Router struct {
 	router      *chi.Mux
 }
 
+// NewHTTPRouter uses the cors options loaded from the environment by the cors middleware when the cors options are nil
 func NewHTTPRouter(corsOptions *cors.Options, defaultPort string) IRouter {
 	router := &Router{
 		port:        env.GetEnvOrDefault(enums.HorusecPort, defaultPort),
@@ -74,14 +79,15 @@ func (r *Router) GetPort() string {
 }
 
 func (r *Router) setRouterConfig() *Router {
+	r.enableRequestID()
 	r.enableRealIP()
 	r.enableLogger()
 	r.enableRecover()
 	r.enableTimeout()
 	r.enableCompress()
-	r.enableRequestID()
 	r.enableCORS()
 	r.routeMetrics()
+	r.routeVersion()
 
 	return r
 }
@@ -90,8 +96,9 @@ func (r *Router) enableRealIP() {
 	r.router.Use(middleware.RealIP)
 }
 
+// enableLogger runs after the request id, so the access log has the request id of the context
 func (r *Router) enableLogger() {
-	r.router.Use(middleware.Logger)
+	r.router.Use(middlewares.AccessLogMiddleware(middlewares.NewAccessLogOptions()))
 }
 
 func (r *Router) enableRecover() {
@@ -99,23 +106,34 @@ func (r *Router) enableRecover() {
 }
 
 func (r *Router) enableTimeout() {
-	r.router.Use(middleware.Timeout(r.timeout))
+	r.router.Use(middlewares.TimeoutMiddleware(r.timeout))
 }
 
 func (r *Router) enableCompress() {
-	r.router.Use(middleware.Compress(flate.BestCompression))
+	r.router.Use(middlewares.CompressMiddleware(flate.BestCompression))
 }
 
 func (r *Router) enableRequestID() {
-	r.router.Use(middleware.RequestID)
+	r.router.Use(middlewares.RequestIDMiddleware)
 }
 
 func (r *Router) enableCORS() {
+	if r.corsOptions == nil {
+		r.router.Use(middlewares.CORSMiddleware(middlewares.NewCORSOptions()))
+
+		return
+	}
+
 	r.router.Use(r.getCorsHandler)
 }
 
 func (r *Router) routeMetrics() {
-	r.router.Handle("/metrics", promhttp.Handler())
+	r.router.Handle(metricsEnums.MetricsRoute, metrics.Handler())
+}
+
+func (r *Router) routeVersion() {
+	version.RegisterBuildInfoMetric()
+	r.router.Get(versionEnums.VersionRoute, version.Handler())
 }
 
 func (r *Router) getCorsHandler(next http.Handler) http.Handler {
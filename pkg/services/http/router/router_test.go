@@ -15,16 +15,56 @@
 package router
 
 import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/go-chi/cors"
 	"github.com/stretchr/testify/assert"
+
+	middlewaresEnums "github.com/ZupIT/horusec-devkit/pkg/services/middlewares/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
 )
 
 func TestNewHTTPRouter(t *testing.T) {
 	t.Run("should return a new router service with default config", func(t *testing.T) {
 		assert.NotNil(t, NewHTTPRouter(&cors.Options{}, "8000"))
 	})
+
+	t.Run("should use the cors options of the environment when the cors options are nil", func(t *testing.T) {
+		t.Setenv(middlewaresEnums.HorusecCORSAllowedOrigins, "https://horusec.io")
+
+		req, _ := http.NewRequest(http.MethodOptions, "http://test/api", nil)
+		req.Header.Set("Origin", "https://horusec.io")
+		req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+
+		w := httptest.NewRecorder()
+		NewHTTPRouter(nil, "8000").GetMux().ServeHTTP(w, req)
+
+		assert.Equal(t, "https://horusec.io", w.Header().Get("Access-Control-Allow-Origin"))
+	})
+}
+
+func TestAccessLog(t *testing.T) {
+	t.Run("should log the request once with the request id", func(t *testing.T) {
+		output := bytes.NewBufferString("")
+		logger.LogSetOutput(output)
+
+		defer logger.LogSetOutput(os.Stderr)
+
+		mux := NewHTTPRouter(&cors.Options{}, "8000").GetMux()
+		mux.Get("/test", func(w http.ResponseWriter, r *http.Request) {})
+
+		req, _ := http.NewRequest(http.MethodGet, "http://test/test", nil)
+		req.Header.Set("X-Request-Id", "test-id")
+		mux.ServeHTTP(httptest.NewRecorder(), req)
+
+		assert.Equal(t, 1, strings.Count(output.String(), middlewaresEnums.MessageHTTPRequestCompleted))
+		assert.Contains(t, output.String(), "request_id=test-id")
+	})
 }
 
 func TestGetMux(t *testing.T) {
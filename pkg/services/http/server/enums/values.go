@@ -0,0 +1,38 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+const (
+	HorusecHTTPShutdownTimeout   = "HORUSEC_HTTP_SHUTDOWN_TIMEOUT_SECONDS"
+	HorusecHTTPReadTimeout       = "HORUSEC_HTTP_READ_TIMEOUT_SECONDS"
+	HorusecHTTPReadHeaderTimeout = "HORUSEC_HTTP_READ_HEADER_TIMEOUT_SECONDS"
+	HorusecHTTPWriteTimeout      = "HORUSEC_HTTP_WRITE_TIMEOUT_SECONDS"
+	HorusecHTTPIdleTimeout       = "HORUSEC_HTTP_IDLE_TIMEOUT_SECONDS"
+	HorusecHTTPMaxHeaderBytes    = "HORUSEC_HTTP_MAX_HEADER_BYTES"
+	DefaultShutdownTimeout       = 30
+	DefaultReadTimeout           = 30
+	DefaultReadHeaderTimeout     = 10
+	DefaultWriteTimeout          = 60
+	DefaultIdleTimeout           = 120
+	DefaultMaxHeaderBytes        = 1 << 20
+
+	HorusecHTTPTLSCertPath       = "HORUSEC_HTTP_TLS_CERT_PATH"
+	HorusecHTTPTLSKeyPath        = "HORUSEC_HTTP_TLS_KEY_PATH"
+	HorusecHTTPTLSClientCAPath   = "HORUSEC_HTTP_TLS_CLIENT_CA_PATH"
+	HorusecHTTPTLSReloadInterval = "HORUSEC_HTTP_TLS_RELOAD_INTERVAL_SECONDS"
+	DefaultTLSReloadInterval     = 30
+	ProtocolHTTP2                = "h2"
+	ProtocolHTTP1                = "http/1.1"
+)
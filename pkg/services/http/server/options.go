@@ -0,0 +1,100 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	routerEnums "github.com/ZupIT/horusec-devkit/pkg/services/http/router/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/server/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/listener"
+	listenerEnums "github.com/ZupIT/horusec-devkit/pkg/utils/listener/enums"
+)
+
+// Options configures the http server. The shutdown timeout is shared by the connections draining and by the
+// shutdown hooks, so it must be lower than the termination grace period of the orchestrator. The read header timeout
+// protects the server against slow clients holding connections open, and the write timeout must be greater than the
+// router timeout, otherwise the connection is closed before the timeout response is written. The tls certificates are
+// checked for changes at each reload interval, so renewed certificates are used without restarting the service. The
+// listener is read from env on the port when the server starts, unless it was informed.
+type Options struct {
+	Port              string
+	ShutdownTimeout   time.Duration
+	ReadTimeout       time.Duration
+	ReadHeaderTimeout time.Duration
+	WriteTimeout      time.Duration
+	IdleTimeout       time.Duration
+	MaxHeaderBytes    int
+	TLSCertPath       string
+	TLSKeyPath        string
+	TLSClientCAPath   string
+	TLSReloadInterval time.Duration
+	Listener          *listener.Options
+}
+
+func NewOptions(defaultPort string) *Options {
+	return &Options{
+		Port:              env.GetEnvOrDefault(routerEnums.HorusecPort, defaultPort),
+		ShutdownTimeout:   getSeconds(enums.HorusecHTTPShutdownTimeout, enums.DefaultShutdownTimeout),
+		ReadTimeout:       getSeconds(enums.HorusecHTTPReadTimeout, enums.DefaultReadTimeout),
+		ReadHeaderTimeout: getSeconds(enums.HorusecHTTPReadHeaderTimeout, enums.DefaultReadHeaderTimeout),
+		WriteTimeout:      getSeconds(enums.HorusecHTTPWriteTimeout, enums.DefaultWriteTimeout),
+		IdleTimeout:       getSeconds(enums.HorusecHTTPIdleTimeout, enums.DefaultIdleTimeout),
+		MaxHeaderBytes:    env.GetEnvOrDefaultInt(enums.HorusecHTTPMaxHeaderBytes, enums.DefaultMaxHeaderBytes),
+		TLSCertPath:       env.GetEnvOrDefault(enums.HorusecHTTPTLSCertPath, ""),
+		TLSKeyPath:        env.GetEnvOrDefault(enums.HorusecHTTPTLSKeyPath, ""),
+		TLSClientCAPath:   env.GetEnvOrDefault(enums.HorusecHTTPTLSClientCAPath, ""),
+		TLSReloadInterval: getSeconds(enums.HorusecHTTPTLSReloadInterval, enums.DefaultTLSReloadInterval),
+	}
+}
+
+// useTLS enables the tls termination at the service only when both certificate and key are informed
+func (o *Options) useTLS() bool {
+	return o.TLSCertPath != "" && o.TLSKeyPath != ""
+}
+
+func getSeconds(name string, defaultValue int) time.Duration {
+	return time.Duration(env.GetEnvOrDefaultInt(name, defaultValue)) * time.Second
+}
+
+func (o *Options) newHTTPServer(handler http.Handler) *http.Server {
+	return &http.Server{
+		Addr:              o.getAddress(),
+		Handler:           handler,
+		ReadTimeout:       o.ReadTimeout,
+		ReadHeaderTimeout: o.ReadHeaderTimeout,
+		WriteTimeout:      o.WriteTimeout,
+		IdleTimeout:       o.IdleTimeout,
+		MaxHeaderBytes:    o.MaxHeaderBytes,
+	}
+}
+
+func (o *Options) getAddress() string {
+	return fmt.Sprintf(":%s", o.Port)
+}
+
+// listen reads the listener options from env when they were not informed, which is done only here so the address
+// uses the port changed after the options were created
+func (o *Options) listen() (net.Listener, error) {
+	if o.Listener == nil {
+		return listener.NewOptions(listenerEnums.HTTPPrefix, o.getAddress()).Listen()
+	}
+
+	return o.Listener.Listen()
+}
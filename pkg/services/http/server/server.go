@@ -0,0 +1,135 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/server/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+type IServer interface {
+	AddShutdownHook(name string, hook func(ctx context.Context) error)
+	ListenAndServe() error
+	ListenAndServeWithContext(ctx context.Context) error
+	Serve(ctx context.Context, listener net.Listener) error
+}
+
+type shutdownHook struct {
+	name string
+	hook func(ctx context.Context) error
+}
+
+// Server stops accepting new connections when receives a SIGTERM or SIGINT, waits the in-flight requests to finish and
+// then runs the shutdown hooks in the order they were added, like closing the broker and the database connections.
+type Server struct {
+	server  *http.Server
+	options *Options
+	mutex   sync.Mutex
+	hooks   []shutdownHook
+}
+
+func NewServer(handler http.Handler, options *Options) IServer {
+	return &Server{
+		options: options,
+		server:  options.newHTTPServer(handler),
+	}
+}
+
+func (s *Server) AddShutdownHook(name string, hook func(ctx context.Context) error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.hooks = append(s.hooks, shutdownHook{name: name, hook: hook})
+}
+
+func (s *Server) ListenAndServe() error {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	return s.ListenAndServeWithContext(ctx)
+}
+
+func (s *Server) ListenAndServeWithContext(ctx context.Context) error {
+	listener, err := s.options.listen()
+	if err != nil {
+		return err
+	}
+
+	return s.Serve(ctx, listener)
+}
+
+func (s *Server) Serve(ctx context.Context, listener net.Listener) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	serve, err := s.getServeFunc(ctx)
+	if err != nil {
+		_ = listener.Close()
+
+		return err
+	}
+
+	serveErr := make(chan error, 1)
+
+	go func() {
+		logger.LogInfo(fmt.Sprintf(enums.MessageServiceRunningOnPort, s.options.Port))
+		serveErr <- serve(listener)
+	}()
+
+	return s.waitShutdown(ctx, serveErr)
+}
+
+// getServeFunc serves with tls when the certificates are informed, reloading them until the context is done
+func (s *Server) getServeFunc(ctx context.Context) (func(listener net.Listener) error, error) {
+	if !s.options.useTLS() {
+		return s.server.Serve, nil
+	}
+
+	reloader, err := newCertificateReloader(s.options)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.options.TLSReloadInterval > 0 {
+		go reloader.watch(ctx)
+	}
+
+	s.server.TLSConfig = reloader.getTLSConfig()
+
+	return func(listener net.Listener) error {
+		return s.server.ServeTLS(listener, "", "")
+	}, nil
+}
+
+func (s *Server) waitShutdown(ctx context.Context, serveErr chan error) error {
+	select {
+	case err := <-serveErr:
+		if !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+	case <-ctx.Done():
+	}
+
+	return s.shutdown()
+}
@@ -0,0 +1,198 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/listener"
+)
+
+func newTestListener(t *testing.T) net.Listener {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+
+	return listener
+}
+
+func TestNewOptions(t *testing.T) {
+	t.Run("should return options with default values", func(t *testing.T) {
+		options := NewOptions("8000")
+
+		assert.Equal(t, "8000", options.Port)
+		assert.Equal(t, 30*time.Second, options.ShutdownTimeout)
+		assert.Equal(t, ":8000", options.getAddress())
+		assert.Equal(t, 30*time.Second, options.ReadTimeout)
+		assert.Equal(t, 10*time.Second, options.ReadHeaderTimeout)
+		assert.Equal(t, 60*time.Second, options.WriteTimeout)
+		assert.Equal(t, 120*time.Second, options.IdleTimeout)
+		assert.Equal(t, 1<<20, options.MaxHeaderBytes)
+	})
+
+	t.Run("should return options with values from env", func(t *testing.T) {
+		t.Setenv("HORUSEC_HTTP_READ_HEADER_TIMEOUT_SECONDS", "5")
+		t.Setenv("HORUSEC_HTTP_MAX_HEADER_BYTES", "1024")
+
+		server := NewOptions("8000").newHTTPServer(http.NotFoundHandler())
+
+		assert.Equal(t, 5*time.Second, server.ReadHeaderTimeout)
+		assert.Equal(t, 1024, server.MaxHeaderBytes)
+	})
+}
+
+func TestReadHeaderTimeout(t *testing.T) {
+	t.Run("should close connections that do not send the headers in time", func(t *testing.T) {
+		listener := newTestListener(t)
+		ctx, cancel := context.WithCancel(context.Background())
+
+		defer cancel()
+
+		go func() {
+			_ = NewServer(http.NotFoundHandler(), &Options{ReadHeaderTimeout: 50 * time.Millisecond}).
+				Serve(ctx, listener)
+		}()
+
+		conn, err := net.Dial("tcp", listener.Addr().String())
+		assert.NoError(t, err)
+
+		_, _ = conn.Write([]byte("GET / HTTP/1.1\r\nHost: test\r\n"))
+		_ = conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+
+		_, err = conn.Read(make([]byte, 1024))
+		assert.Error(t, err)
+		assert.False(t, errors.Is(err, os.ErrDeadlineExceeded))
+	})
+}
+
+func TestServe(t *testing.T) {
+	t.Run("should finish in-flight requests before shutdown", func(t *testing.T) {
+		started := make(chan struct{})
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			close(started)
+			time.Sleep(100 * time.Millisecond)
+			w.WriteHeader(http.StatusOK)
+		})
+
+		listener := newTestListener(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		served := make(chan error, 1)
+
+		go func() {
+			served <- NewServer(handler, &Options{ShutdownTimeout: time.Second}).Serve(ctx, listener)
+		}()
+
+		responses := make(chan int, 1)
+
+		go func() {
+			response, err := http.Get("http://" + listener.Addr().String())
+			assert.NoError(t, err)
+			responses <- response.StatusCode
+		}()
+
+		<-started
+		cancel()
+
+		assert.Equal(t, http.StatusOK, <-responses)
+		assert.NoError(t, <-served)
+	})
+
+	t.Run("should run shutdown hooks in order", func(t *testing.T) {
+		server := NewServer(http.NotFoundHandler(), &Options{ShutdownTimeout: time.Second})
+
+		var executed []string
+
+		server.AddShutdownHook("broker", func(ctx context.Context) error {
+			executed = append(executed, "broker")
+
+			return errors.New("test")
+		})
+		server.AddShutdownHook("database", func(ctx context.Context) error {
+			executed = append(executed, "database")
+
+			return nil
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		assert.NoError(t, server.Serve(ctx, newTestListener(t)))
+		assert.Equal(t, []string{"broker", "database"}, executed)
+	})
+
+	t.Run("should return error when failed to serve", func(t *testing.T) {
+		listener := newTestListener(t)
+		_ = listener.Close()
+
+		err := NewServer(http.NotFoundHandler(), &Options{}).Serve(context.Background(), listener)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestListenAndServeWithContext(t *testing.T) {
+	t.Run("should return error when invalid port", func(t *testing.T) {
+		err := NewServer(http.NotFoundHandler(), &Options{Port: "invalid"}).
+			ListenAndServeWithContext(context.Background())
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should listen in the port changed after the options were created", func(t *testing.T) {
+		reserved := newTestListener(t)
+		_, port, _ := net.SplitHostPort(reserved.Addr().String())
+		_ = reserved.Close()
+
+		options := NewOptions("8000")
+		options.Port = port
+
+		listener, err := options.listen()
+		assert.NoError(t, err)
+
+		defer listener.Close()
+
+		_, listenerPort, _ := net.SplitHostPort(listener.Addr().String())
+		assert.Equal(t, port, listenerPort)
+	})
+
+	t.Run("should listen in unix socket from listener options", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "test.sock")
+		options := &Options{ShutdownTimeout: time.Second,
+			Listener: &listener.Options{Network: "unix", SocketPath: path, SocketMode: 0o600}}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		assert.NoError(t, NewServer(http.NotFoundHandler(), options).ListenAndServeWithContext(ctx))
+	})
+
+	t.Run("should stop when context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := NewServer(http.NotFoundHandler(), &Options{Port: "0", ShutdownTimeout: time.Second}).
+			ListenAndServeWithContext(ctx)
+
+		assert.NoError(t, err)
+	})
+}
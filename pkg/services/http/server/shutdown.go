@@ -0,0 +1,50 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/server/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+func (s *Server) shutdown() error {
+	logger.LogInfo(enums.MessageShuttingDownServer)
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.options.ShutdownTimeout)
+	defer cancel()
+
+	s.server.SetKeepAlivesEnabled(false)
+
+	err := s.server.Shutdown(ctx)
+	if err != nil {
+		logger.LogError(enums.MessageFailedToShutdownHTTP, err)
+	}
+
+	s.runShutdownHooks(ctx)
+	logger.LogInfo(enums.MessageServerShutdownSuccess)
+
+	return err
+}
+
+func (s *Server) runShutdownHooks(ctx context.Context) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, hook := range s.hooks {
+		logger.LogError(enums.MessageShutdownHookFailed, hook.hook(ctx), map[string]interface{}{"hook": hook.name})
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"sync"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/server/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+// certificateReloader keeps the tls config built from the last valid certificate files. The files are compared by
+// their modification time, which also changes when kubernetes swaps the symlinks of a mounted secret.
+type certificateReloader struct {
+	options *Options
+	mutex   sync.RWMutex
+	config  *tls.Config
+	modTime time.Time
+}
+
+func newCertificateReloader(options *Options) (*certificateReloader, error) {
+	reloader := &certificateReloader{options: options}
+
+	if err := reloader.reload(); err != nil {
+		return nil, err
+	}
+
+	return reloader, nil
+}
+
+func (c *certificateReloader) getTLSConfig() *tls.Config {
+	config := newBaseTLSConfig()
+	config.GetCertificate = c.getCertificate
+	config.GetConfigForClient = c.getConfigForClient
+
+	return config
+}
+
+// newBaseTLSConfig returns the settings shared by the server config and the config returned for each client, which
+// replaces the server one in the handshake, so it must also advertise http2 for the alpn negotiation
+func newBaseTLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: []string{enums.ProtocolHTTP2, enums.ProtocolHTTP1},
+	}
+}
+
+func (c *certificateReloader) getCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return &c.config.Certificates[0], nil
+}
+
+func (c *certificateReloader) getConfigForClient(_ *tls.ClientHelloInfo) (*tls.Config, error) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return c.config, nil
+}
+
+func (c *certificateReloader) watch(ctx context.Context) {
+	ticker := time.NewTicker(c.options.TLSReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if c.hasChanged() {
+				logger.LogError(enums.MessageFailedToReloadCerts, c.reload())
+			}
+		}
+	}
+}
+
+func (c *certificateReloader) hasChanged() bool {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return c.getModTime().After(c.modTime)
+}
+
+func (c *certificateReloader) reload() error {
+	modTime := c.getModTime()
+
+	config, err := c.loadConfig()
+	if err != nil {
+		return err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.config, c.modTime = config, modTime
+	logger.LogInfo(enums.MessageCertificateReloaded)
+
+	return nil
+}
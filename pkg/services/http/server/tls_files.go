@@ -0,0 +1,69 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/server/enums"
+)
+
+func (c *certificateReloader) loadConfig() (*tls.Config, error) {
+	certificate, err := tls.LoadX509KeyPair(c.options.TLSCertPath, c.options.TLSKeyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	config := newBaseTLSConfig()
+	config.Certificates = []tls.Certificate{certificate}
+	if c.options.TLSClientCAPath == "" {
+		return config, nil
+	}
+
+	config.ClientCAs, err = loadCertPool(c.options.TLSClientCAPath)
+	config.ClientAuth = tls.RequireAndVerifyClientCert
+
+	return config, err
+}
+
+func loadCertPool(path string) (*x509.CertPool, error) {
+	content, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(content) {
+		return nil, enums.ErrorInvalidClientCAFile
+	}
+
+	return pool, nil
+}
+
+func (c *certificateReloader) getModTime() time.Time {
+	var modTime time.Time
+
+	for _, path := range []string{c.options.TLSCertPath, c.options.TLSKeyPath, c.options.TLSClientCAPath} {
+		if info, err := os.Stat(path); err == nil && info.ModTime().After(modTime) {
+			modTime = info.ModTime()
+		}
+	}
+
+	return modTime
+}
@@ -0,0 +1,234 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/server/enums"
+)
+
+type testCerts struct {
+	dir    string
+	ca     *x509.Certificate
+	caKey  *ecdsa.PrivateKey
+	caPool *x509.CertPool
+}
+
+func writePEM(t *testing.T, path, pemType string, content []byte) {
+	assert.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: pemType, Bytes: content}), 0o600))
+}
+
+func newTestCerts(t *testing.T) *testCerts {
+	certs := &testCerts{dir: t.TempDir(), ca: &x509.Certificate{
+		SerialNumber: big.NewInt(1), Subject: pkix.Name{CommonName: "test-ca"}, IsCA: true,
+		NotBefore: time.Now().Add(-time.Hour), NotAfter: time.Now().Add(time.Hour), BasicConstraintsValid: true,
+		KeyUsage: x509.KeyUsageCertSign,
+	}}
+
+	certs.caKey = certs.writeCertificate(t, certs.ca, "ca")
+
+	caPool, err := loadCertPool(certs.path("ca.crt"))
+	assert.NoError(t, err)
+
+	certs.caPool = caPool
+
+	return certs
+}
+
+func (c *testCerts) writeCertificate(t *testing.T, template *x509.Certificate, name string) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	parent, parentKey := c.ca, c.caKey
+	if parentKey == nil {
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	assert.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	writePEM(t, c.path(name+".crt"), "CERTIFICATE", der)
+	writePEM(t, c.path(name+".key"), "EC PRIVATE KEY", keyDer)
+
+	return key
+}
+
+func (c *testCerts) writeLeaf(t *testing.T, serial int64, modTime time.Time) {
+	c.writeCertificate(t, &x509.Certificate{
+		SerialNumber: big.NewInt(serial), Subject: pkix.Name{CommonName: "localhost"},
+		IPAddresses: []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:   time.Now().Add(-time.Hour), NotAfter: time.Now().Add(time.Hour),
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}, "leaf")
+
+	assert.NoError(t, os.Chtimes(c.path("leaf.crt"), modTime, modTime))
+	assert.NoError(t, os.Chtimes(c.path("leaf.key"), modTime, modTime))
+}
+
+func (c *testCerts) path(name string) string {
+	return filepath.Join(c.dir, name)
+}
+
+func (c *testCerts) options() *Options {
+	return &Options{ShutdownTimeout: time.Second, TLSCertPath: c.path("leaf.crt"), TLSKeyPath: c.path("leaf.key")}
+}
+
+func startTLSServer(t *testing.T, options *Options) string {
+	listener := newTestListener(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+
+	go func() {
+		served <- NewServer(http.NotFoundHandler(), options).Serve(ctx, listener)
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		assert.NoError(t, <-served)
+	})
+
+	return listener.Addr().String()
+}
+
+func getServerSerial(address string, config *tls.Config) (int64, error) {
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: config, DisableKeepAlives: true}}
+
+	response, err := client.Get("https://" + address)
+	if err != nil {
+		return 0, err
+	}
+
+	defer response.Body.Close()
+
+	return response.TLS.PeerCertificates[0].SerialNumber.Int64(), nil
+}
+
+func TestServeTLS(t *testing.T) {
+	t.Run("should serve with tls certificates", func(t *testing.T) {
+		certs := newTestCerts(t)
+		certs.writeLeaf(t, 2, time.Now())
+
+		serial, err := getServerSerial(startTLSServer(t, certs.options()), &tls.Config{RootCAs: certs.caPool})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), serial)
+	})
+
+	t.Run("should reload certificates when files change", func(t *testing.T) {
+		certs := newTestCerts(t)
+		certs.writeLeaf(t, 2, time.Now().Add(-time.Minute))
+
+		options := certs.options()
+		options.TLSReloadInterval = 10 * time.Millisecond
+		address := startTLSServer(t, options)
+
+		certs.writeLeaf(t, 3, time.Now())
+
+		assert.Eventually(t, func() bool {
+			serial, err := getServerSerial(address, &tls.Config{RootCAs: certs.caPool})
+
+			return err == nil && serial == 3
+		}, 2*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("should require client certificate when client ca is informed", func(t *testing.T) {
+		certs := newTestCerts(t)
+		certs.writeLeaf(t, 2, time.Now())
+
+		options := certs.options()
+		options.TLSClientCAPath = certs.path("ca.crt")
+		address := startTLSServer(t, options)
+
+		_, err := getServerSerial(address, &tls.Config{RootCAs: certs.caPool})
+		assert.Error(t, err)
+
+		certificate, err := tls.LoadX509KeyPair(certs.path("leaf.crt"), certs.path("leaf.key"))
+		assert.NoError(t, err)
+
+		_, err = getServerSerial(address, &tls.Config{RootCAs: certs.caPool, Certificates: []tls.Certificate{certificate}})
+		assert.NoError(t, err)
+	})
+
+	t.Run("should negotiate http2 with the client", func(t *testing.T) {
+		certs := newTestCerts(t)
+		certs.writeLeaf(t, 2, time.Now())
+
+		conn, err := tls.Dial("tcp", startTLSServer(t, certs.options()),
+			&tls.Config{RootCAs: certs.caPool, NextProtos: []string{"h2", "http/1.1"}})
+		assert.NoError(t, err)
+
+		defer conn.Close()
+
+		assert.Equal(t, "h2", conn.ConnectionState().NegotiatedProtocol)
+	})
+
+	t.Run("should return error when invalid certificates", func(t *testing.T) {
+		options := &Options{TLSCertPath: "invalid.crt", TLSKeyPath: "invalid.key"}
+
+		err := NewServer(http.NotFoundHandler(), options).Serve(context.Background(), newTestListener(t))
+
+		assert.Error(t, err)
+	})
+}
+
+func TestCertificateReloader(t *testing.T) {
+	t.Run("should return error when invalid client ca file", func(t *testing.T) {
+		certs := newTestCerts(t)
+		certs.writeLeaf(t, 2, time.Now())
+		assert.NoError(t, os.WriteFile(certs.path("invalid.crt"), []byte("invalid"), 0o600))
+
+		options := certs.options()
+		options.TLSClientCAPath = certs.path("invalid.crt")
+
+		_, err := newCertificateReloader(options)
+		assert.Equal(t, enums.ErrorInvalidClientCAFile, err)
+	})
+
+	t.Run("should keep previous certificate when reload fails", func(t *testing.T) {
+		certs := newTestCerts(t)
+		certs.writeLeaf(t, 2, time.Now())
+
+		reloader, err := newCertificateReloader(certs.options())
+		assert.NoError(t, err)
+
+		assert.NoError(t, os.WriteFile(certs.path("leaf.key"), []byte("invalid"), 0o600))
+		assert.Error(t, reloader.reload())
+
+		certificate, err := reloader.getCertificate(nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, certificate)
+	})
+}
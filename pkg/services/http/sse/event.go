@@ -0,0 +1,74 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sse
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Event is framed following the server sent events spec, where the data that is not a string is sent as json and
+// the retry informs the browser how long to wait before reconnecting
+type Event struct {
+	ID    string
+	Event string
+	Data  interface{}
+	Retry time.Duration
+}
+
+func (e *Event) format() (string, error) {
+	data, err := e.getData()
+	if err != nil {
+		return "", err
+	}
+
+	builder := &strings.Builder{}
+	writeField(builder, "id", e.ID)
+	writeField(builder, "event", e.Event)
+
+	if e.Retry > 0 {
+		writeField(builder, "retry", fmt.Sprint(e.Retry.Milliseconds()))
+	}
+
+	for _, line := range strings.Split(data, "\n") {
+		builder.WriteString(fmt.Sprintf("data: %s\n", line))
+	}
+
+	builder.WriteString("\n")
+
+	return builder.String(), nil
+}
+
+func (e *Event) getData() (string, error) {
+	if data, ok := e.Data.(string); ok {
+		return strings.ReplaceAll(data, "\r\n", "\n"), nil
+	}
+
+	data, err := json.Marshal(e.Data)
+
+	return string(data), err
+}
+
+// writeField removes the line breaks, since they would end the field and break the event framing
+func writeField(builder *strings.Builder, name, value string) {
+	if value == "" {
+		return
+	}
+
+	value = strings.NewReplacer("\r", "", "\n", "").Replace(value)
+	builder.WriteString(fmt.Sprintf("%s: %s\n", name, value))
+}
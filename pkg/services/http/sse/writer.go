@@ -0,0 +1,145 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sse
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/sse/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+type IWriter interface {
+	Send(event *Event) error
+	GetLastEventID() string
+	Done() <-chan struct{}
+	Close()
+}
+
+// Writer streams events until the client disconnects or the handler returns or closes it, sending heartbeat comments
+// to keep the connection open in proxies with idle timeouts. The server write deadline is cleared, but the route must
+// use middlewares.WithoutTimeout, otherwise the stream is still ended by the router timeout.
+type Writer struct {
+	mutex       sync.Mutex
+	writer      http.ResponseWriter
+	flusher     http.Flusher
+	lastEventID string
+	ctx         context.Context
+	cancel      context.CancelFunc
+}
+
+func NewWriter(w http.ResponseWriter, r *http.Request) (IWriter, error) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return nil, enums.ErrorStreamingNotSupported
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	writer := &Writer{writer: w, flusher: flusher, ctx: ctx, cancel: cancel,
+		lastEventID: r.Header.Get(enums.HeaderLastEventID)}
+
+	writer.clearWriteDeadline()
+	writer.setHeaders()
+	writer.startHeartbeat(time.Duration(env.GetEnvOrDefaultInt(enums.HorusecSSEHeartbeatInterval,
+		enums.DefaultHeartbeatInterval)) * time.Second)
+
+	return writer, nil
+}
+
+// clearWriteDeadline removes the write timeout of the server for this response. The middlewares wrapping the writer
+// must unwrap to the server writer, otherwise the deadline is kept and the stream ends after the write timeout.
+func (w *Writer) clearWriteDeadline() {
+	if err := http.NewResponseController(w.writer).SetWriteDeadline(time.Time{}); err != nil {
+		logger.LogError(enums.MessageFailedToClearWriteDeadline, err)
+	}
+}
+
+func (w *Writer) setHeaders() {
+	w.writer.Header().Set("Content-Type", enums.ContentTypeEventStream)
+	w.writer.Header().Set("Cache-Control", "no-cache")
+	w.writer.Header().Set("Connection", "keep-alive")
+	w.writer.Header().Set("X-Accel-Buffering", "no")
+	w.writer.WriteHeader(http.StatusOK)
+	w.flusher.Flush()
+}
+
+// GetLastEventID returns the id sent by the browser when reconnecting, so the stream can resume after it
+func (w *Writer) GetLastEventID() string {
+	return w.lastEventID
+}
+
+func (w *Writer) Send(event *Event) error {
+	content, err := event.format()
+	if err != nil {
+		return err
+	}
+
+	return w.write(content)
+}
+
+func (w *Writer) Done() <-chan struct{} {
+	return w.ctx.Done()
+}
+
+// Close stops the stream before the handler returns. The request context is canceled by the server when the handler
+// returns, which also stops the heartbeat, but deferring Close avoids a heartbeat racing with the handler return.
+func (w *Writer) Close() {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.cancel()
+}
+
+func (w *Writer) write(content string) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.ctx.Err() != nil {
+		return enums.ErrorWriterClosed
+	}
+
+	if _, err := io.WriteString(w.writer, content); err != nil {
+		return err
+	}
+
+	w.flusher.Flush()
+
+	return nil
+}
+
+func (w *Writer) startHeartbeat(interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-w.ctx.Done():
+				return
+			case <-ticker.C:
+				_ = w.write(enums.HeartbeatComment)
+			}
+		}
+	}()
+}
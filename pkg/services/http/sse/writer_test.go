@@ -0,0 +1,214 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sse
+
+import (
+	"bufio"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/cors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/router"
+	routerEnums "github.com/ZupIT/horusec-devkit/pkg/services/http/router/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/sse/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares"
+)
+
+type notFlusherWriter struct {
+	http.ResponseWriter
+}
+
+func TestEventFormat(t *testing.T) {
+	t.Run("should format event with all fields", func(t *testing.T) {
+		content, err := (&Event{ID: "1", Event: "progress", Data: "line1\nline2", Retry: time.Second}).format()
+
+		assert.NoError(t, err)
+		assert.Equal(t, "id: 1\nevent: progress\nretry: 1000\ndata: line1\ndata: line2\n\n", content)
+	})
+
+	t.Run("should format data as json when it is not a string", func(t *testing.T) {
+		content, err := (&Event{Data: map[string]int{"progress": 50}}).format()
+
+		assert.NoError(t, err)
+		assert.Equal(t, "data: {\"progress\":50}\n\n", content)
+	})
+
+	t.Run("should remove line breaks from fields", func(t *testing.T) {
+		content, err := (&Event{Event: "test\nid: 2", Data: ""}).format()
+
+		assert.NoError(t, err)
+		assert.Equal(t, "event: testid: 2\ndata: \n\n", content)
+	})
+
+	t.Run("should return error when failed to marshal data", func(t *testing.T) {
+		_, err := (&Event{Data: make(chan int)}).format()
+
+		assert.Error(t, err)
+	})
+}
+
+func TestNewWriter(t *testing.T) {
+	t.Run("should return error when writer does not support flush", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+		_, err := NewWriter(&notFlusherWriter{httptest.NewRecorder()}, req)
+
+		assert.Equal(t, enums.ErrorStreamingNotSupported, err)
+	})
+
+	t.Run("should set stream headers and last event id", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Last-Event-ID", "10")
+		w := httptest.NewRecorder()
+
+		writer, err := NewWriter(w, req)
+		assert.NoError(t, err)
+
+		defer writer.Close()
+
+		assert.Equal(t, "10", writer.GetLastEventID())
+		assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+		assert.Equal(t, "no-cache", w.Header().Get("Cache-Control"))
+	})
+}
+
+func TestWriter(t *testing.T) {
+	t.Run("should stream events and heartbeats to the client", func(t *testing.T) {
+		t.Setenv(enums.HorusecSSEHeartbeatInterval, "1")
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			writer, err := NewWriter(w, r)
+			assert.NoError(t, err)
+
+			defer writer.Close()
+
+			assert.NoError(t, writer.Send(&Event{ID: "1", Data: "test"}))
+			<-writer.Done()
+		}))
+		defer server.Close()
+
+		response, err := http.Get(server.URL)
+		assert.NoError(t, err)
+
+		defer response.Body.Close()
+
+		reader := bufio.NewReader(response.Body)
+		assert.Equal(t, "id: 1\n", readLine(t, reader))
+		assert.Equal(t, "data: test\n", readLine(t, reader))
+		assert.Equal(t, "\n", readLine(t, reader))
+		assert.Equal(t, ": heartbeat\n", readLine(t, reader))
+	})
+
+	t.Run("should keep streaming after the server write timeout", func(t *testing.T) {
+		t.Setenv(enums.HorusecSSEHeartbeatInterval, "1")
+
+		server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			writer, err := NewWriter(w, r)
+			assert.NoError(t, err)
+
+			defer writer.Close()
+
+			<-writer.Done()
+		}))
+		server.Config.WriteTimeout = 100 * time.Millisecond
+		server.Start()
+		defer server.Close()
+
+		response, err := http.Get(server.URL)
+		assert.NoError(t, err)
+
+		defer response.Body.Close()
+
+		assert.Equal(t, ": heartbeat\n", readLine(t, bufio.NewReader(response.Body)))
+	})
+
+	t.Run("should keep streaming after the server and router timeouts", func(t *testing.T) {
+		t.Setenv(enums.HorusecSSEHeartbeatInterval, "2")
+		t.Setenv(routerEnums.HorusecRouterTimeout, "1")
+
+		mux := router.NewHTTPRouter(&cors.Options{}, "8000").GetMux()
+		mux.With(middlewares.WithoutTimeout).Get("/events", func(w http.ResponseWriter, r *http.Request) {
+			writer, err := NewWriter(w, r)
+			assert.NoError(t, err)
+
+			defer writer.Close()
+
+			<-writer.Done()
+		})
+
+		server := httptest.NewUnstartedServer(mux)
+		server.Config.WriteTimeout = 100 * time.Millisecond
+		server.Start()
+		defer server.Close()
+
+		req, _ := http.NewRequest(http.MethodGet, server.URL+"/events", nil)
+		req.Header.Set("Accept-Encoding", "gzip")
+
+		response, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+
+		defer response.Body.Close()
+
+		assert.Equal(t, ": heartbeat\n", readLine(t, bufio.NewReader(response.Body)))
+	})
+
+	t.Run("should stop the stream when the handler returns without closing", func(t *testing.T) {
+		writers := make(chan IWriter, 1)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			writer, err := NewWriter(w, r)
+			assert.NoError(t, err)
+
+			writers <- writer
+		}))
+		defer server.Close()
+
+		response, err := http.Get(server.URL)
+		assert.NoError(t, err)
+
+		defer response.Body.Close()
+
+		select {
+		case <-(<-writers).Done():
+		case <-time.After(time.Second):
+			assert.Fail(t, "stream was not stopped after the handler returned")
+		}
+	})
+
+	t.Run("should return error when sending after close", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+		writer, err := NewWriter(httptest.NewRecorder(), req)
+		assert.NoError(t, err)
+
+		writer.Close()
+
+		<-writer.Done()
+		assert.True(t, errors.Is(writer.Send(&Event{Data: "test"}), enums.ErrorWriterClosed))
+	})
+}
+
+func readLine(t *testing.T, reader *bufio.Reader) string {
+	line, err := reader.ReadString('\n')
+	assert.NoError(t, err)
+
+	return strings.TrimPrefix(line, "\r")
+}
@@ -0,0 +1,139 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package static
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io/fs"
+	"mime"
+	"net/http"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/static/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// hashedFileRegex matches the files with the content hash in the name, like main.3f2a9c1b.js, which can be cached
+// forever since any change generates a new name
+var hashedFileRegex = regexp.MustCompile(`[.-][0-9a-fA-F]{8,}\.`)
+
+// SPAHandler serves a frontend bundled with embed.FS. The paths without extension that are not files are client side
+// routes, so they receive the index.html, which is never cached to always load the last deployed assets.
+// The precompressed .gz files are used when the client accepts gzip, and the others can be compressed by the router.
+// When the frontend is embedded inside a directory, like build, the files must be given with fs.Sub.
+type SPAHandler struct {
+	files       fs.FS
+	cacheMaxAge int
+}
+
+func NewSPAHandler(files fs.FS) http.Handler {
+	return &SPAHandler{
+		files:       files,
+		cacheMaxAge: env.GetEnvOrDefaultInt(enums.HorusecStaticCacheMaxAge, enums.DefaultCacheMaxAge),
+	}
+}
+
+func (s *SPAHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	name := strings.TrimPrefix(path.Clean("/"+r.URL.Path), "/")
+	if name == "" || s.isDir(name) {
+		name = path.Join(name, enums.IndexFile)
+	}
+
+	if !s.exists(name) {
+		if path.Ext(name) != "" {
+			http.NotFound(w, r)
+
+			return
+		}
+
+		name = enums.IndexFile
+	}
+
+	s.serveFile(w, r, name)
+}
+
+func (s *SPAHandler) serveFile(w http.ResponseWriter, r *http.Request, name string) {
+	content, encoded, err := s.readFile(name, acceptsGzip(r))
+	if err != nil {
+		http.NotFound(w, r)
+
+		return
+	}
+
+	w.Header().Set("Cache-Control", s.getCacheControl(name))
+	w.Header().Set("ETag", fmt.Sprintf(`"%x"`, sha256.Sum256(content)))
+	w.Header().Add("Vary", "Accept-Encoding")
+
+	if encoded {
+		w.Header().Set("Content-Encoding", "gzip")
+	}
+
+	if contentType := mime.TypeByExtension(path.Ext(name)); contentType != "" {
+		w.Header().Set("Content-Type", contentType)
+	}
+
+	http.ServeContent(w, r, name, time.Time{}, bytes.NewReader(content))
+}
+
+func (s *SPAHandler) readFile(name string, gzip bool) (content []byte, encoded bool, err error) {
+	if gzip && s.exists(name+enums.GzipExtension) {
+		content, err = fs.ReadFile(s.files, name+enums.GzipExtension)
+
+		return content, true, err
+	}
+
+	content, err = fs.ReadFile(s.files, name)
+
+	return content, false, err
+}
+
+func (s *SPAHandler) getCacheControl(name string) string {
+	if path.Ext(name) == ".html" {
+		return enums.NoCacheControl
+	}
+
+	if hashedFileRegex.MatchString(path.Base(name)) {
+		return enums.ImmutableCacheControl
+	}
+
+	return fmt.Sprintf(enums.CacheControlWithMaxAge, s.cacheMaxAge)
+}
+
+func (s *SPAHandler) exists(name string) bool {
+	info, err := fs.Stat(s.files, name)
+
+	return err == nil && !info.IsDir()
+}
+
+func (s *SPAHandler) isDir(name string) bool {
+	info, err := fs.Stat(s.files, name)
+
+	return err == nil && info.IsDir()
+}
+
+func acceptsGzip(r *http.Request) bool {
+	for _, encoding := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		if strings.TrimSpace(strings.Split(encoding, ";")[0]) == "gzip" {
+			return true
+		}
+	}
+
+	return false
+}
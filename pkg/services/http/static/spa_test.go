@@ -0,0 +1,113 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package static
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestFiles() fstest.MapFS {
+	return fstest.MapFS{
+		"index.html":                    {Data: []byte("<html>index</html>")},
+		"favicon.ico":                   {Data: []byte("icon")},
+		"static/js/main.3f2a9c1b.js":    {Data: []byte("console.log('main')")},
+		"static/js/main.3f2a9c1b.js.gz": {Data: []byte("gzipped")},
+		"docs/index.html":               {Data: []byte("<html>docs</html>")},
+	}
+}
+
+func doRequest(path string, headers map[string]string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(http.MethodGet, path, nil)
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	w := httptest.NewRecorder()
+
+	NewSPAHandler(newTestFiles()).ServeHTTP(w, req)
+
+	return w
+}
+
+func TestSPAHandler(t *testing.T) {
+	t.Run("should serve index without cache on root", func(t *testing.T) {
+		w := doRequest("/", nil)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "<html>index</html>", w.Body.String())
+		assert.Equal(t, "no-cache", w.Header().Get("Cache-Control"))
+		assert.Contains(t, w.Header().Get("Content-Type"), "text/html")
+	})
+
+	t.Run("should serve index for client side routes", func(t *testing.T) {
+		w := doRequest("/home/workspaces/test", nil)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "<html>index</html>", w.Body.String())
+	})
+
+	t.Run("should serve directory index", func(t *testing.T) {
+		w := doRequest("/docs", nil)
+
+		assert.Equal(t, "<html>docs</html>", w.Body.String())
+	})
+
+	t.Run("should return 404 for missing assets", func(t *testing.T) {
+		w := doRequest("/static/js/missing.js", nil)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should serve hashed assets with immutable cache", func(t *testing.T) {
+		w := doRequest("/static/js/main.3f2a9c1b.js", nil)
+
+		assert.Equal(t, "console.log('main')", w.Body.String())
+		assert.Equal(t, "public, max-age=31536000, immutable", w.Header().Get("Cache-Control"))
+		assert.Empty(t, w.Header().Get("Content-Encoding"))
+	})
+
+	t.Run("should serve precompressed asset when client accepts gzip", func(t *testing.T) {
+		w := doRequest("/static/js/main.3f2a9c1b.js", map[string]string{"Accept-Encoding": "deflate, gzip;q=1.0"})
+
+		assert.Equal(t, "gzipped", w.Body.String())
+		assert.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+		assert.Contains(t, w.Header().Get("Content-Type"), "javascript")
+	})
+
+	t.Run("should serve not hashed assets with max age cache", func(t *testing.T) {
+		w := doRequest("/favicon.ico", nil)
+
+		assert.Equal(t, "public, max-age=3600", w.Header().Get("Cache-Control"))
+	})
+
+	t.Run("should not serve files outside the root", func(t *testing.T) {
+		w := doRequest("/../../etc/passwd", nil)
+
+		assert.Equal(t, "<html>index</html>", w.Body.String())
+	})
+
+	t.Run("should return not modified when etag matches", func(t *testing.T) {
+		etag := doRequest("/", nil).Header().Get("ETag")
+
+		w := doRequest("/", map[string]string{"If-None-Match": etag})
+
+		assert.Equal(t, http.StatusNotModified, w.Code)
+	})
+}
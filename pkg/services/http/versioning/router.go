@@ -0,0 +1,58 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versioning
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi"
+)
+
+type IVersionedRouter interface {
+	Version(version *Version, fn func(router chi.Router)) chi.Router
+}
+
+// VersionedRouter mounts each version in its own route group with the shared middlewares, so the old versions can
+// keep their handlers while the new ones evolve without breaking the clients like the cli
+type VersionedRouter struct {
+	router      chi.Router
+	middlewares []func(http.Handler) http.Handler
+}
+
+func NewVersionedRouter(router chi.Router, middlewares ...func(http.Handler) http.Handler) IVersionedRouter {
+	return &VersionedRouter{
+		router:      router,
+		middlewares: middlewares,
+	}
+}
+
+func (v *VersionedRouter) Version(version *Version, fn func(router chi.Router)) chi.Router {
+	return v.router.Route(version.GetPath(), func(router chi.Router) {
+		router.Use(versionHeaders(version))
+		router.Use(v.middlewares...)
+
+		fn(router)
+	})
+}
+
+func versionHeaders(version *Version) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			version.setHeaders(w.Header())
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
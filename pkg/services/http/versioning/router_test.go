@@ -0,0 +1,94 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versioning
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRouter() *chi.Mux {
+	mux := chi.NewRouter()
+	shared := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Shared", "true")
+			next.ServeHTTP(w, r)
+		})
+	}
+
+	router := NewVersionedRouter(mux, shared)
+	deprecatedAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	sunset := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	router.Version(NewVersion("v1").SetDeprecated(deprecatedAt, sunset, "/api/v2"), func(router chi.Router) {
+		router.Get("/test", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("v1"))
+		})
+	})
+
+	router.Version(NewVersion("v2"), func(router chi.Router) {
+		router.Get("/test", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("v2"))
+		})
+	})
+
+	return mux
+}
+
+func doRequest(path string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(http.MethodGet, path, nil)
+	w := httptest.NewRecorder()
+
+	newTestRouter().ServeHTTP(w, req)
+
+	return w
+}
+
+func TestVersion(t *testing.T) {
+	t.Run("should set deprecation headers for deprecated versions", func(t *testing.T) {
+		w := doRequest("/api/v1/test")
+
+		assert.Equal(t, "v1", w.Body.String())
+		assert.Equal(t, "v1", w.Header().Get("X-Horusec-API-Version"))
+		assert.Equal(t, "Fri, 01 Jan 2021 00:00:00 GMT", w.Header().Get("Deprecation"))
+		assert.Equal(t, "Sat, 01 Jan 2022 00:00:00 GMT", w.Header().Get("Sunset"))
+		assert.Equal(t, `</api/v2>; rel="successor-version"`, w.Header().Get("Link"))
+		assert.Equal(t, "true", w.Header().Get("X-Shared"))
+	})
+
+	t.Run("should not set deprecation headers for current versions", func(t *testing.T) {
+		w := doRequest("/api/v2/test")
+
+		assert.Equal(t, "v2", w.Body.String())
+		assert.Empty(t, w.Header().Get("Deprecation"))
+		assert.Empty(t, w.Header().Get("Sunset"))
+		assert.Equal(t, "true", w.Header().Get("X-Shared"))
+	})
+
+	t.Run("should send deprecation without date when it was not informed", func(t *testing.T) {
+		header := http.Header{}
+
+		NewVersion("v1").SetDeprecated(time.Time{}, time.Time{}, "").setHeaders(header)
+
+		assert.Equal(t, "true", header.Get("Deprecation"))
+		assert.Empty(t, header.Get("Sunset"))
+		assert.Empty(t, header.Get("Link"))
+	})
+}
@@ -0,0 +1,77 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versioning
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/versioning/enums"
+)
+
+// Version describes an api version mounted at /api/{name}. A deprecated version keeps working, but its responses
+// inform the clients about the deprecation date, the sunset date when it will be removed and its successor.
+type Version struct {
+	Name         string
+	Deprecated   bool
+	DeprecatedAt time.Time
+	Sunset       time.Time
+	Successor    string
+}
+
+func NewVersion(name string) *Version {
+	return &Version{Name: name}
+}
+
+// SetDeprecated marks the version as deprecated, where zero dates and empty successor are not sent in the headers
+func (v *Version) SetDeprecated(deprecatedAt, sunset time.Time, successor string) *Version {
+	v.Deprecated = true
+	v.DeprecatedAt = deprecatedAt
+	v.Sunset = sunset
+	v.Successor = successor
+
+	return v
+}
+
+func (v *Version) GetPath() string {
+	return fmt.Sprintf("%s/%s", enums.APIPrefix, v.Name)
+}
+
+func (v *Version) setHeaders(header http.Header) {
+	header.Set(enums.HorusecAPIVersionHeader, v.Name)
+
+	if !v.Deprecated {
+		return
+	}
+
+	header.Set(enums.HeaderDeprecation, v.getDeprecation())
+
+	if !v.Sunset.IsZero() {
+		header.Set(enums.HeaderSunset, v.Sunset.UTC().Format(http.TimeFormat))
+	}
+
+	if v.Successor != "" {
+		header.Add(enums.HeaderLink, fmt.Sprintf(enums.SuccessorVersionLink, v.Successor))
+	}
+}
+
+func (v *Version) getDeprecation() string {
+	if v.DeprecatedAt.IsZero() {
+		return enums.DeprecationWithoutDate
+	}
+
+	return v.DeprecatedAt.UTC().Format(http.TimeFormat)
+}
@@ -0,0 +1,28 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+const (
+	RedisKeyPrefix = "horusec:lock:"
+
+	// RedisReleaseScript only deletes the key when it still has the token of the owner, so a lock that expired and
+	// was acquired by another replica is not released by mistake
+	RedisReleaseScript = `if redis.call("get", KEYS[1]) == ARGV[1] then return redis.call("del", KEYS[1]) end return 0`
+
+	PostgresTryLockQuery = "SELECT pg_try_advisory_lock(hashtext($1))"
+	PostgresUnlockQuery  = "SELECT pg_advisory_unlock(hashtext($1))"
+
+	LogFieldLockName = "lock"
+)
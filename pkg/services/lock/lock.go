@@ -0,0 +1,58 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lock
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/lock/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+// ILocker acquires locks shared by every replica of a service. Acquire does not wait for the lock, returning
+// ErrorLockNotAcquired when it is held by another owner, and the lock is released when the ttl expires even when
+// the owner crashes before releasing it.
+type ILocker interface {
+	Acquire(ctx context.Context, name string, ttl time.Duration) (ILock, error)
+}
+
+type ILock interface {
+	Release(ctx context.Context) error
+}
+
+// RunExclusive runs the job only when the lock is acquired, returning if it ran, so singleton jobs like migrations
+// and scheduled cleanups can be started by every replica. The ttl should be greater than the job duration.
+func RunExclusive(ctx context.Context, locker ILocker, name string, ttl time.Duration,
+	job func(ctx context.Context) error) (bool, error) {
+	lock, err := locker.Acquire(ctx, name, ttl)
+	if err != nil {
+		if errors.Is(err, enums.ErrorLockNotAcquired) {
+			logger.LogInfoWithFields(enums.MessageLockNotAcquired, map[string]interface{}{enums.LogFieldLockName: name})
+
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	defer func() {
+		logger.LogError(enums.MessageFailedToReleaseLock, lock.Release(context.Background()),
+			map[string]interface{}{enums.LogFieldLockName: name})
+	}()
+
+	return true, job(ctx)
+}
@@ -0,0 +1,68 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lock
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/lock/enums"
+)
+
+func TestRunExclusive(t *testing.T) {
+	t.Run("should run job and release lock", func(t *testing.T) {
+		lockMock := &Mock{}
+		lockMock.On("Acquire").Return(lockMock, nil)
+		lockMock.On("Release").Return(nil)
+
+		ran, err := RunExclusive(context.Background(), lockMock, "test", time.Minute, func(ctx context.Context) error {
+			return errors.New("test")
+		})
+
+		assert.True(t, ran)
+		assert.EqualError(t, err, "test")
+		lockMock.AssertCalled(t, "Release")
+	})
+
+	t.Run("should skip job when lock is held by another owner", func(t *testing.T) {
+		lockMock := &Mock{}
+		lockMock.On("Acquire").Return(lockMock, enums.ErrorLockNotAcquired)
+
+		ran, err := RunExclusive(context.Background(), lockMock, "test", time.Minute, func(ctx context.Context) error {
+			t.Fail()
+
+			return nil
+		})
+
+		assert.False(t, ran)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when failed to acquire lock", func(t *testing.T) {
+		lockMock := &Mock{}
+		lockMock.On("Acquire").Return(lockMock, errors.New("test"))
+
+		ran, err := RunExclusive(context.Background(), lockMock, "test", time.Minute, func(ctx context.Context) error {
+			return nil
+		})
+
+		assert.False(t, ran)
+		assert.Error(t, err)
+	})
+}
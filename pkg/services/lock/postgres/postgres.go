@@ -0,0 +1,102 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	databaseConfig "github.com/ZupIT/horusec-devkit/pkg/services/database/config"
+	"github.com/ZupIT/horusec-devkit/pkg/services/lock"
+	"github.com/ZupIT/horusec-devkit/pkg/services/lock/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+type Locker struct {
+	config  databaseConfig.IConfig
+	connect func(ctx context.Context, uri string) (iConnection, error)
+}
+
+// NewLocker uses session advisory locks, which are held by a dedicated connection for each lock and are released
+// by postgres when the connection is closed, so the connection is closed when the ttl expires
+func NewLocker(config databaseConfig.IConfig) (lock.ILocker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Locker{config: config, connect: connect}, nil
+}
+
+func connect(ctx context.Context, uri string) (iConnection, error) {
+	return pgx.Connect(ctx, uri)
+}
+
+func (l *Locker) Acquire(ctx context.Context, name string, ttl time.Duration) (lock.ILock, error) {
+	connection, err := l.connect(ctx, l.config.GetURI())
+	if err != nil {
+		return nil, err
+	}
+
+	var acquired bool
+	if err = connection.QueryRow(ctx, enums.PostgresTryLockQuery, name).Scan(&acquired); err != nil || !acquired {
+		closeConnection(connection)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, enums.ErrorLockNotAcquired
+	}
+
+	held := &Lock{connection: connection, name: name}
+	held.timer = time.AfterFunc(ttl, held.expire)
+
+	return held, nil
+}
+
+type Lock struct {
+	connection iConnection
+	name       string
+	timer      *time.Timer
+	once       sync.Once
+}
+
+func (l *Lock) expire() {
+	l.once.Do(func() {
+		closeConnection(l.connection)
+	})
+}
+
+// Release returns ErrorLockNotHeld when the ttl expired before
+func (l *Lock) Release(ctx context.Context) (err error) {
+	l.timer.Stop()
+
+	err = enums.ErrorLockNotHeld
+
+	l.once.Do(func() {
+		defer closeConnection(l.connection)
+
+		_, err = l.connection.Exec(ctx, enums.PostgresUnlockQuery, l.name)
+	})
+
+	return err
+}
+
+func closeConnection(connection iConnection) {
+	logger.LogError(enums.MessageFailedToCloseConn, connection.Close(context.Background()))
+}
@@ -0,0 +1,107 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/config"
+	"github.com/ZupIT/horusec-devkit/pkg/services/lock/enums"
+)
+
+func newTestLocker(connection iConnection, connectErr error) *Locker {
+	databaseConfig := &config.Config{}
+	databaseConfig.SetURI("test")
+
+	return &Locker{
+		config: databaseConfig,
+		connect: func(ctx context.Context, uri string) (iConnection, error) {
+			return connection, connectErr
+		},
+	}
+}
+
+func TestNewLocker(t *testing.T) {
+	t.Run("should return error when invalid config", func(t *testing.T) {
+		_, err := NewLocker(&config.Config{})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestAcquire(t *testing.T) {
+	t.Run("should acquire and release lock", func(t *testing.T) {
+		connection := &connectionMock{}
+		connection.On("QueryRow").Return(&rowMock{acquired: true})
+		connection.On("Exec").Return(nil)
+		connection.On("Close").Return(nil)
+
+		lock, err := newTestLocker(connection, nil).Acquire(context.Background(), "test", time.Minute)
+
+		assert.NoError(t, err)
+		assert.NoError(t, lock.Release(context.Background()))
+		assert.ErrorIs(t, lock.Release(context.Background()), enums.ErrorLockNotHeld)
+		connection.AssertNumberOfCalls(t, "Close", 1)
+	})
+
+	t.Run("should close connection when ttl expires", func(t *testing.T) {
+		closed := make(chan struct{})
+		connection := &connectionMock{}
+		connection.On("QueryRow").Return(&rowMock{acquired: true})
+		connection.On("Close").Return(nil).Run(func(_ mock.Arguments) {
+			close(closed)
+		})
+
+		lock, err := newTestLocker(connection, nil).Acquire(context.Background(), "test", time.Millisecond)
+		assert.NoError(t, err)
+
+		<-closed
+		assert.ErrorIs(t, lock.Release(context.Background()), enums.ErrorLockNotHeld)
+		connection.AssertNotCalled(t, "Exec")
+	})
+
+	t.Run("should return error when lock is held by another owner", func(t *testing.T) {
+		connection := &connectionMock{}
+		connection.On("QueryRow").Return(&rowMock{acquired: false})
+		connection.On("Close").Return(nil)
+
+		_, err := newTestLocker(connection, nil).Acquire(context.Background(), "test", time.Minute)
+
+		assert.ErrorIs(t, err, enums.ErrorLockNotAcquired)
+		connection.AssertCalled(t, "Close")
+	})
+
+	t.Run("should return error when query fails", func(t *testing.T) {
+		connection := &connectionMock{}
+		connection.On("QueryRow").Return(&rowMock{err: errors.New("test")})
+		connection.On("Close").Return(nil)
+
+		_, err := newTestLocker(connection, nil).Acquire(context.Background(), "test", time.Minute)
+
+		assert.EqualError(t, err, "test")
+	})
+
+	t.Run("should return error when failed to connect", func(t *testing.T) {
+		_, err := newTestLocker(nil, errors.New("test")).Acquire(context.Background(), "test", time.Minute)
+
+		assert.EqualError(t, err, "test")
+	})
+}
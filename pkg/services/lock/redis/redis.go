@@ -0,0 +1,71 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	goredis "github.com/redis/go-redis/v9"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/lock"
+	"github.com/ZupIT/horusec-devkit/pkg/services/lock/enums"
+)
+
+type Locker struct {
+	client goredis.UniversalClient
+}
+
+// NewLocker stores each lock as a key with the token of the owner, expiring it with the lock ttl
+func NewLocker(client goredis.UniversalClient) lock.ILocker {
+	return &Locker{client: client}
+}
+
+func (l *Locker) Acquire(ctx context.Context, name string, ttl time.Duration) (lock.ILock, error) {
+	token := uuid.New().String()
+
+	acquired, err := l.client.SetNX(ctx, enums.RedisKeyPrefix+name, token, ttl).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	if !acquired {
+		return nil, enums.ErrorLockNotAcquired
+	}
+
+	return &Lock{client: l.client, key: enums.RedisKeyPrefix + name, token: token}, nil
+}
+
+type Lock struct {
+	client goredis.UniversalClient
+	key    string
+	token  string
+}
+
+// Release returns ErrorLockNotHeld when the lock expired before, which means another replica could have run the
+// same job at the same time
+func (l *Lock) Release(ctx context.Context) error {
+	deleted, err := l.client.Eval(ctx, enums.RedisReleaseScript, []string{l.key}, l.token).Result()
+	if err != nil {
+		return err
+	}
+
+	if deleted != int64(1) {
+		return enums.ErrorLockNotHeld
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/lock/enums"
+)
+
+func newTestClient(t *testing.T) (*miniredis.Miniredis, goredis.UniversalClient) {
+	server := miniredis.RunT(t)
+	client := goredis.NewClient(&goredis.Options{Addr: server.Addr(), MaxRetries: -1})
+
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	return server, client
+}
+
+func TestAcquire(t *testing.T) {
+	t.Run("should acquire and release lock", func(t *testing.T) {
+		server, client := newTestClient(t)
+
+		lock, err := NewLocker(client).Acquire(context.Background(), "test", time.Minute)
+
+		assert.NoError(t, err)
+		assert.Equal(t, time.Minute, server.TTL(enums.RedisKeyPrefix+"test"))
+		assert.NoError(t, lock.Release(context.Background()))
+		assert.False(t, server.Exists(enums.RedisKeyPrefix+"test"))
+	})
+
+	t.Run("should return error when lock is held by another owner", func(t *testing.T) {
+		_, client := newTestClient(t)
+
+		_, err := NewLocker(client).Acquire(context.Background(), "test", time.Minute)
+		assert.NoError(t, err)
+
+		_, err = NewLocker(client).Acquire(context.Background(), "test", time.Minute)
+		assert.ErrorIs(t, err, enums.ErrorLockNotAcquired)
+	})
+
+	t.Run("should return error when redis fails", func(t *testing.T) {
+		server, client := newTestClient(t)
+		server.Close()
+
+		_, err := NewLocker(client).Acquire(context.Background(), "test", time.Minute)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestRelease(t *testing.T) {
+	t.Run("should return error when lock expired", func(t *testing.T) {
+		_, client := newTestClient(t)
+
+		err := (&Lock{client: client, key: "test", token: "test"}).Release(context.Background())
+
+		assert.ErrorIs(t, err, enums.ErrorLockNotHeld)
+	})
+
+	t.Run("should not release the lock of another owner", func(t *testing.T) {
+		server, client := newTestClient(t)
+		assert.NoError(t, server.Set("test", "other"))
+
+		err := (&Lock{client: client, key: "test", token: "test"}).Release(context.Background())
+
+		assert.ErrorIs(t, err, enums.ErrorLockNotHeld)
+		assert.True(t, server.Exists("test"))
+	})
+
+	t.Run("should return error when redis fails", func(t *testing.T) {
+		server, client := newTestClient(t)
+		server.Close()
+
+		err := (&Lock{client: client, key: "test", token: "test"}).Release(context.Background())
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,28 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+import "errors"
+
+var (
+	ErrorStartTLSNotSupported = errors.New("{ERROR_MAILER} smtp server does not support starttls")
+	ErrorTemplatesNotLoaded   = errors.New("{ERROR_MAILER} mailer has no templates to render the message")
+	ErrorTemplateNotFound     = errors.New("{ERROR_MAILER} template not found")
+	ErrorEmptyRecipients      = errors.New("{ERROR_MAILER} email must have at least one recipient")
+	ErrorInvalidTLSMode       = errors.New("{ERROR_MAILER} smtp tls mode must be starttls, tls or none")
+	ErrorMailerClosed         = errors.New("{ERROR_MAILER} mailer is closed")
+	ErrorInvalidHeaderNewLine = errors.New("{ERROR_MAILER} email address or subject must not have new lines")
+	ErrorInvalidRecipient     = errors.New("{ERROR_MAILER} email recipient must be a valid address")
+)
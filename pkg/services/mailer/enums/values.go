@@ -0,0 +1,50 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+import "time"
+
+const (
+	HorusecSMTPHost               = "HORUSEC_SMTP_HOST"
+	HorusecSMTPPort               = "HORUSEC_SMTP_PORT"
+	HorusecSMTPUsername           = "HORUSEC_SMTP_USERNAME"
+	HorusecSMTPPassword           = "HORUSEC_SMTP_PASSWORD"
+	HorusecSMTPFrom               = "HORUSEC_SMTP_FROM"
+	HorusecSMTPTLSMode            = "HORUSEC_SMTP_TLS_MODE"
+	HorusecSMTPInsecureSkipVerify = "HORUSEC_SMTP_INSECURE_SKIP_VERIFY"
+	HorusecSMTPPoolSize           = "HORUSEC_SMTP_POOL_SIZE"
+	HorusecSMTPTimeoutSeconds     = "HORUSEC_SMTP_TIMEOUT_SECONDS"
+	HorusecSMTPMaxRetries         = "HORUSEC_SMTP_MAX_RETRIES"
+	HorusecSMTPRetryBackoffMillis = "HORUSEC_SMTP_RETRY_BACKOFF_MILLIS"
+
+	TLSModeStartTLS = "starttls"
+	TLSModeTLS      = "tls"
+	TLSModeNone     = "none"
+
+	DefaultSMTPHost               = "localhost"
+	DefaultSMTPPort               = 587
+	DefaultSMTPFrom               = "horusec@zup.com.br"
+	DefaultSMTPPoolSize           = 2
+	DefaultSMTPTimeoutSeconds     = 10
+	DefaultSMTPMaxRetries         = 3
+	DefaultSMTPRetryBackoffMillis = 500
+
+	TemplateHTMLExtension = ".html"
+	TemplateTextExtension = ".txt"
+	TransientReplyMin     = 400
+	TransientReplyMax     = 499
+	RetryBackoffTime      = time.Millisecond
+	RetryOperation        = "mailer_send"
+)
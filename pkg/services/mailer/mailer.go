@@ -0,0 +1,247 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mailer
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"sync"
+	"time"
+
+	emailEntities "github.com/ZupIT/horusec-devkit/pkg/entities/email"
+	"github.com/ZupIT/horusec-devkit/pkg/services/mailer/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/i18n"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/retry"
+)
+
+type IMailer interface {
+	Send(ctx context.Context, email *Email) error
+	SendTemplate(ctx context.Context, message *emailEntities.Message) error
+	Close()
+}
+
+type Mailer struct {
+	options   *Options
+	templates *Templates
+	pool      *pool
+	mutex     sync.RWMutex
+	closed    bool
+}
+
+// NewMailer connects lazily to the smtp server, the templates are only needed to send template messages
+func NewMailer(options *Options, templates *Templates) (IMailer, error) {
+	if err := options.validate(); err != nil {
+		return nil, err
+	}
+
+	mailer := &Mailer{options: options, templates: templates}
+	mailer.pool = newPool(options.PoolSize, mailer.dial)
+
+	return mailer, nil
+}
+
+// SendTemplate renders the template of the message, which is the same message published on the email queue. The
+// translated template and subject are used when the message has a language, the subject is only translated when the
+// message has no subject.
+func (m *Mailer) SendTemplate(ctx context.Context, message *emailEntities.Message) error {
+	if m.templates == nil {
+		return enums.ErrorTemplatesNotLoaded
+	}
+
+	catalog := i18n.Default()
+	tag := catalog.Negotiate(message.Language)
+
+	html, text, err := m.templates.Render(m.templates.localizedName(message.TemplateName.ToString(), tag),
+		message.Data)
+	if err != nil {
+		return err
+	}
+
+	subject := message.Subject
+	if subject == "" {
+		subject = catalog.Translate(tag, i18n.EmailSubjectKey(message.TemplateName.ToString()), message.Data)
+	}
+
+	return m.Send(ctx, &Email{To: []string{message.To}, Subject: subject, HTML: html, Text: text})
+}
+
+// Send retries the transient failures, which are the network errors and the 4xx replies, waiting the backoff
+// doubled on each attempt. The 5xx replies, like an invalid recipient, are returned without retry.
+func (m *Mailer) Send(ctx context.Context, email *Email) error {
+	if email.From == "" {
+		withFrom := *email
+		withFrom.From = m.options.From
+		email = &withFrom
+	}
+
+	if err := email.validate(); err != nil {
+		return err
+	}
+
+	data, err := email.toBytes()
+	if err != nil {
+		return err
+	}
+
+	return m.sendWithRetry(ctx, email, data)
+}
+
+func (m *Mailer) sendWithRetry(ctx context.Context, email *Email, data []byte) error {
+	policy := retry.NewPolicy(enums.RetryOperation)
+	policy.MaxAttempts = m.options.MaxRetries + 1
+	policy.InitialBackoff = m.options.RetryBackoff
+	policy.IsRetryable = isTransient
+	policy.OnRetry = func(_ int, err error, _ time.Duration) {
+		logger.LogError(enums.MessageRetryingSend, err)
+	}
+
+	return retry.Do(ctx, policy, func(ctx context.Context) error {
+		return m.send(ctx, email, data)
+	})
+}
+
+func (m *Mailer) send(ctx context.Context, email *Email, data []byte) error {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	if m.closed {
+		return enums.ErrorMailerClosed
+	}
+
+	current, err := m.pool.get(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = m.sendWithClient(current, email, data)
+	m.pool.put(current, err)
+
+	return err
+}
+
+func (m *Mailer) sendWithClient(current *client, email *Email, data []byte) error {
+	current.setDeadline(m.options.Timeout)
+
+	if err := current.smtp.Mail(email.From); err != nil {
+		return err
+	}
+
+	for _, to := range email.To {
+		if err := current.smtp.Rcpt(to); err != nil {
+			return err
+		}
+	}
+
+	writer, err := current.smtp.Data()
+	if err != nil {
+		return err
+	}
+
+	if _, err := writer.Write(data); err != nil {
+		return err
+	}
+
+	return writer.Close()
+}
+
+func (m *Mailer) dial(ctx context.Context) (*client, error) {
+	address := net.JoinHostPort(m.options.Host, strconv.Itoa(m.options.Port))
+	dialer := &net.Dialer{Timeout: m.options.Timeout}
+
+	var conn net.Conn
+
+	var err error
+
+	if m.options.TLSMode == enums.TLSModeTLS {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: m.tlsConfig()}).DialContext(ctx, "tcp", address)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", address)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	_ = conn.SetDeadline(time.Now().Add(m.options.Timeout))
+
+	smtpClient, err := smtp.NewClient(conn, m.options.Host)
+	if err != nil {
+		_ = conn.Close()
+
+		return nil, err
+	}
+
+	if err := m.setupClient(smtpClient); err != nil {
+		_ = smtpClient.Close()
+
+		return nil, err
+	}
+
+	return &client{conn: conn, smtp: smtpClient}, nil
+}
+
+func (m *Mailer) setupClient(smtpClient *smtp.Client) error {
+	if m.options.TLSMode == enums.TLSModeStartTLS {
+		if ok, _ := smtpClient.Extension("STARTTLS"); !ok {
+			return enums.ErrorStartTLSNotSupported
+		}
+
+		if err := smtpClient.StartTLS(m.tlsConfig()); err != nil {
+			return err
+		}
+	}
+
+	if m.options.Username == "" {
+		return nil
+	}
+
+	return smtpClient.Auth(smtp.PlainAuth("", m.options.Username, m.options.Password, m.options.Host))
+}
+
+func (m *Mailer) tlsConfig() *tls.Config {
+	return &tls.Config{
+		ServerName: m.options.Host,
+		MinVersion: tls.VersionTLS12,
+		// nolint:gosec // allowed only by the env, for smtp servers with self signed certificates
+		InsecureSkipVerify: m.options.InsecureSkipVerify,
+	}
+}
+
+// Close closes the idle connections, the sends after it return error
+func (m *Mailer) Close() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.closed = true
+	m.pool.close()
+}
+
+func isTransient(err error) bool {
+	var protocolErr *textproto.Error
+	if errors.As(err, &protocolErr) {
+		return protocolErr.Code >= enums.TransientReplyMin && protocolErr.Code <= enums.TransientReplyMax
+	}
+
+	var netErr net.Error
+
+	return errors.As(err, &netErr) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
@@ -0,0 +1,232 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mailer
+
+import (
+	"context"
+	"embed"
+	"io/fs"
+	"mime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	emailEntities "github.com/ZupIT/horusec-devkit/pkg/entities/email"
+	emailEnums "github.com/ZupIT/horusec-devkit/pkg/enums/email"
+	"github.com/ZupIT/horusec-devkit/pkg/services/mailer/enums"
+)
+
+//go:embed testdata/templates
+var testTemplates embed.FS
+
+func newTestTemplates(t *testing.T) *Templates {
+	fsys, err := fs.Sub(testTemplates, "testdata/templates")
+	assert.NoError(t, err)
+
+	templates, err := NewTemplates(fsys)
+	assert.NoError(t, err)
+
+	return templates
+}
+
+func TestNewOptions(t *testing.T) {
+	t.Run("should read options from env", func(t *testing.T) {
+		t.Setenv(enums.HorusecSMTPHost, "smtp.test.com")
+		t.Setenv(enums.HorusecSMTPPort, "465")
+		t.Setenv(enums.HorusecSMTPTLSMode, enums.TLSModeTLS)
+		t.Setenv(enums.HorusecSMTPRetryBackoffMillis, "100")
+
+		options := NewOptions()
+
+		assert.Equal(t, "smtp.test.com", options.Host)
+		assert.Equal(t, 465, options.Port)
+		assert.Equal(t, enums.TLSModeTLS, options.TLSMode)
+		assert.Equal(t, 100*time.Millisecond, options.RetryBackoff)
+		assert.Equal(t, enums.DefaultSMTPFrom, options.From)
+		assert.Equal(t, 10*time.Second, options.Timeout)
+	})
+}
+
+func TestNewMailer(t *testing.T) {
+	t.Run("should return error when tls mode is invalid", func(t *testing.T) {
+		_, err := NewMailer(&Options{TLSMode: "test"}, nil)
+
+		assert.ErrorIs(t, err, enums.ErrorInvalidTLSMode)
+	})
+}
+
+func TestSend(t *testing.T) {
+	t.Run("should send multipart email reusing the connection", func(t *testing.T) {
+		server := newFakeSMTPServer(t)
+		mailer, err := NewMailer(server.options(), nil)
+		assert.NoError(t, err)
+
+		defer mailer.Close()
+
+		for i := 0; i < 2; i++ {
+			assert.NoError(t, mailer.Send(context.Background(), &Email{
+				To: []string{"user@test.com", "other@test.com"}, Subject: "Olá", HTML: "<p>test</p>", Text: "test",
+			}))
+		}
+
+		messages := server.getMessages()
+		assert.Len(t, messages, 2)
+		assert.Contains(t, messages[0], "From: horusec@test.com")
+		assert.Contains(t, messages[0], "To: user@test.com, other@test.com")
+		assert.Contains(t, messages[0], "Subject: =?utf-8?q?Ol=C3=A1?=")
+		assert.Contains(t, messages[0], "multipart/alternative")
+		assert.Contains(t, messages[0], "<p>test</p>")
+		assert.Equal(t, 1, server.getConnections())
+	})
+
+	t.Run("should authenticate when username is set", func(t *testing.T) {
+		server := newFakeSMTPServer(t)
+		options := server.options()
+		options.Username, options.Password = "user", "password"
+		mailer, _ := NewMailer(options, nil)
+
+		defer mailer.Close()
+
+		assert.NoError(t, mailer.Send(context.Background(), &Email{To: []string{"user@test.com"}, Text: "test"}))
+		assert.True(t, server.auth)
+	})
+
+	t.Run("should retry transient failures", func(t *testing.T) {
+		server := newFakeSMTPServer(t)
+		server.mailReplies = []string{"451 try again", "421 busy"}
+		mailer, _ := NewMailer(server.options(), nil)
+
+		defer mailer.Close()
+
+		assert.NoError(t, mailer.Send(context.Background(), &Email{To: []string{"user@test.com"}, HTML: "test"}))
+		assert.Len(t, server.getMessages(), 1)
+	})
+
+	t.Run("should not retry permanent failures", func(t *testing.T) {
+		server := newFakeSMTPServer(t)
+		server.mailReplies = []string{"550 rejected"}
+		mailer, _ := NewMailer(server.options(), nil)
+
+		defer mailer.Close()
+
+		assert.Error(t, mailer.Send(context.Background(), &Email{To: []string{"user@test.com"}, Text: "test"}))
+		assert.Empty(t, server.getMessages())
+	})
+
+	t.Run("should stop retrying after max retries", func(t *testing.T) {
+		server := newFakeSMTPServer(t)
+		server.mailReplies = []string{"451 try again", "451 try again", "451 try again"}
+		mailer, _ := NewMailer(server.options(), nil)
+
+		defer mailer.Close()
+
+		assert.Error(t, mailer.Send(context.Background(), &Email{To: []string{"user@test.com"}, Text: "test"}))
+	})
+
+	t.Run("should return error when server does not support starttls", func(t *testing.T) {
+		server := newFakeSMTPServer(t)
+		options := server.options()
+		options.TLSMode = enums.TLSModeStartTLS
+		mailer, _ := NewMailer(options, nil)
+
+		err := mailer.Send(context.Background(), &Email{To: []string{"user@test.com"}, Text: "test"})
+
+		assert.ErrorIs(t, err, enums.ErrorStartTLSNotSupported)
+	})
+
+	t.Run("should return error when server is not available", func(t *testing.T) {
+		options := &Options{Host: "127.0.0.1", Port: 1, TLSMode: enums.TLSModeTLS, Timeout: time.Second,
+			MaxRetries: 1, RetryBackoff: time.Millisecond}
+		mailer, _ := NewMailer(options, nil)
+
+		assert.Error(t, mailer.Send(context.Background(), &Email{To: []string{"user@test.com"}, Text: "test"}))
+	})
+
+	t.Run("should return error when context is canceled while waiting retry", func(t *testing.T) {
+		server := newFakeSMTPServer(t)
+		server.mailReplies = []string{"451 try again"}
+		options := server.options()
+		options.RetryBackoff = time.Minute
+		mailer, _ := NewMailer(options, nil)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+
+		defer cancel()
+
+		err := mailer.Send(ctx, &Email{To: []string{"user@test.com"}, Text: "test"})
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("should return error when email is invalid or mailer is closed", func(t *testing.T) {
+		server := newFakeSMTPServer(t)
+		mailer, _ := NewMailer(server.options(), nil)
+
+		assert.ErrorIs(t, mailer.Send(context.Background(), &Email{}), enums.ErrorEmptyRecipients)
+		assert.ErrorIs(t, mailer.Send(context.Background(), &Email{To: []string{"a@test.com"},
+			Subject: "test\r\nBcc: b@test.com"}), enums.ErrorInvalidHeaderNewLine)
+		assert.ErrorIs(t, mailer.Send(context.Background(), &Email{To: []string{"Test <a@test.com>"}}),
+			enums.ErrorInvalidRecipient)
+
+		mailer.Close()
+
+		assert.ErrorIs(t, mailer.Send(context.Background(), &Email{To: []string{"a@test.com"}}),
+			enums.ErrorMailerClosed)
+	})
+}
+
+func TestSendTemplate(t *testing.T) {
+	t.Run("should render and send the template message", func(t *testing.T) {
+		server := newFakeSMTPServer(t)
+		mailer, _ := NewMailer(server.options(), newTestTemplates(t))
+
+		defer mailer.Close()
+
+		err := mailer.SendTemplate(context.Background(), &emailEntities.Message{
+			To: "user@test.com", Subject: "Confirm", TemplateName: emailEnums.AccountConfirmation,
+			Data: map[string]string{"Username": "test", "URL": "https://horusec.io"},
+		})
+
+		assert.NoError(t, err)
+		assert.Contains(t, server.getMessages()[0], "confirm your account at https://horusec.io")
+	})
+
+	t.Run("should send the translated template and subject of the message language", func(t *testing.T) {
+		server := newFakeSMTPServer(t)
+		mailer, _ := NewMailer(server.options(), newTestTemplates(t))
+
+		defer mailer.Close()
+
+		err := mailer.SendTemplate(context.Background(), &emailEntities.Message{
+			To: "user@test.com", TemplateName: emailEnums.AccountConfirmation, Language: "pt-BR",
+			Data: map[string]string{"Username": "test", "URL": "https://horusec.io"},
+		})
+
+		assert.NoError(t, err)
+		assert.Contains(t, server.getMessages()[0], mime.QEncoding.Encode("utf-8", "Confirme sua conta no Horusec"))
+		assert.Contains(t, server.getMessages()[0], "confirme sua conta em https://horusec.io")
+	})
+
+	t.Run("should return error when templates are not loaded or template is invalid", func(t *testing.T) {
+		mailer, _ := NewMailer(&Options{TLSMode: enums.TLSModeNone}, nil)
+		message := &emailEntities.Message{To: "user@test.com", TemplateName: "test"}
+
+		assert.ErrorIs(t, mailer.SendTemplate(context.Background(), message), enums.ErrorTemplatesNotLoaded)
+
+		mailer, _ = NewMailer(&Options{TLSMode: enums.TLSModeNone}, newTestTemplates(t))
+
+		assert.ErrorIs(t, mailer.SendTemplate(context.Background(), message), enums.ErrorTemplateNotFound)
+	})
+}
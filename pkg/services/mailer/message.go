@@ -0,0 +1,144 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mailer
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/mailer/enums"
+	validationUtils "github.com/ZupIT/horusec-devkit/pkg/utils/validation"
+)
+
+// Email is sent as multipart/alternative when it has both html and text, so clients without html show the text
+type Email struct {
+	From    string
+	To      []string
+	Subject string
+	HTML    string
+	Text    string
+}
+
+func (e *Email) validate() error {
+	if len(e.To) == 0 {
+		return enums.ErrorEmptyRecipients
+	}
+
+	for _, value := range append([]string{e.From, e.Subject}, e.To...) {
+		if strings.ContainsAny(value, "\r\n") {
+			return enums.ErrorInvalidHeaderNewLine
+		}
+	}
+
+	for _, recipient := range e.To {
+		if validationUtils.ValidateEmail(recipient) != nil {
+			return enums.ErrorInvalidRecipient
+		}
+	}
+
+	return nil
+}
+
+func (e *Email) toBytes() ([]byte, error) {
+	buffer := &bytes.Buffer{}
+
+	writeHeader(buffer, "From", e.From)
+	writeHeader(buffer, "To", strings.Join(e.To, ", "))
+	writeHeader(buffer, "Subject", mime.QEncoding.Encode("utf-8", e.Subject))
+	writeHeader(buffer, "Date", time.Now().Format(time.RFC1123Z))
+	writeHeader(buffer, "Message-Id", fmt.Sprintf("<%s@%s>", uuid.New(), domainOf(e.From)))
+	writeHeader(buffer, "Mime-Version", "1.0")
+
+	if e.HTML == "" || e.Text == "" {
+		if err := writeSinglePart(buffer, e); err != nil {
+			return nil, err
+		}
+
+		return buffer.Bytes(), nil
+	}
+
+	writer := multipart.NewWriter(buffer)
+	writeHeader(buffer, "Content-Type", "multipart/alternative; boundary="+writer.Boundary())
+	buffer.WriteString("\r\n")
+
+	if err := writePart(writer, "text/plain", e.Text); err != nil {
+		return nil, err
+	}
+
+	if err := writePart(writer, "text/html", e.HTML); err != nil {
+		return nil, err
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func writeSinglePart(buffer *bytes.Buffer, email *Email) error {
+	contentType, content := "text/plain", email.Text
+	if email.HTML != "" {
+		contentType, content = "text/html", email.HTML
+	}
+
+	writeHeader(buffer, "Content-Type", contentType+"; charset=utf-8")
+	writeHeader(buffer, "Content-Transfer-Encoding", "quoted-printable")
+	buffer.WriteString("\r\n")
+
+	return writeQuotedPrintable(buffer, content)
+}
+
+func writePart(writer *multipart.Writer, contentType, content string) error {
+	part, err := writer.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {contentType + "; charset=utf-8"},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	})
+	if err != nil {
+		return err
+	}
+
+	return writeQuotedPrintable(part, content)
+}
+
+func writeQuotedPrintable(destination io.Writer, content string) error {
+	writer := quotedprintable.NewWriter(destination)
+	if _, err := writer.Write([]byte(content)); err != nil {
+		return err
+	}
+
+	return writer.Close()
+}
+
+func writeHeader(buffer *bytes.Buffer, key, value string) {
+	buffer.WriteString(key + ": " + value + "\r\n")
+}
+
+func domainOf(address string) string {
+	if index := strings.LastIndex(address, "@"); index >= 0 {
+		return strings.Trim(address[index+1:], ">")
+	}
+
+	return "localhost"
+}
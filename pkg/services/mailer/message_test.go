@@ -0,0 +1,37 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mailer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToBytes(t *testing.T) {
+	t.Run("should build single part html message", func(t *testing.T) {
+		data, err := (&Email{From: "Horusec <horusec@test.com>", To: []string{"user@test.com"},
+			HTML: "<p>test</p>"}).toBytes()
+
+		assert.NoError(t, err)
+		assert.Contains(t, string(data), "Content-Type: text/html; charset=utf-8")
+		assert.Contains(t, string(data), "@test.com>")
+		assert.NotContains(t, string(data), "multipart")
+	})
+
+	t.Run("should use localhost on message id when from has no domain", func(t *testing.T) {
+		assert.Equal(t, "localhost", domainOf("horusec"))
+	})
+}
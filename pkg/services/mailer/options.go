@@ -0,0 +1,65 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mailer
+
+import (
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/mailer/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// Options configures the smtp connection. The tls mode starttls upgrades a plain connection, usually on port 587,
+// while tls connects with tls from the start, usually on port 465.
+type Options struct {
+	Host               string
+	Port               int
+	Username           string
+	Password           string
+	From               string
+	TLSMode            string
+	InsecureSkipVerify bool
+	PoolSize           int
+	Timeout            time.Duration
+	MaxRetries         int
+	RetryBackoff       time.Duration
+}
+
+func NewOptions() *Options {
+	return &Options{
+		Host:               env.GetEnvOrDefault(enums.HorusecSMTPHost, enums.DefaultSMTPHost),
+		Port:               env.GetEnvOrDefaultInt(enums.HorusecSMTPPort, enums.DefaultSMTPPort),
+		Username:           env.GetEnvOrDefault(enums.HorusecSMTPUsername, ""),
+		Password:           env.GetEnvOrDefault(enums.HorusecSMTPPassword, ""),
+		From:               env.GetEnvOrDefault(enums.HorusecSMTPFrom, enums.DefaultSMTPFrom),
+		TLSMode:            env.GetEnvOrDefault(enums.HorusecSMTPTLSMode, enums.TLSModeStartTLS),
+		InsecureSkipVerify: env.GetEnvOrDefaultBool(enums.HorusecSMTPInsecureSkipVerify, false),
+		PoolSize:           env.GetEnvOrDefaultInt(enums.HorusecSMTPPoolSize, enums.DefaultSMTPPoolSize),
+		Timeout: time.Duration(env.GetEnvOrDefaultInt(enums.HorusecSMTPTimeoutSeconds,
+			enums.DefaultSMTPTimeoutSeconds)) * time.Second,
+		MaxRetries: env.GetEnvOrDefaultInt(enums.HorusecSMTPMaxRetries, enums.DefaultSMTPMaxRetries),
+		RetryBackoff: time.Duration(env.GetEnvOrDefaultInt(enums.HorusecSMTPRetryBackoffMillis,
+			enums.DefaultSMTPRetryBackoffMillis)) * enums.RetryBackoffTime,
+	}
+}
+
+func (o *Options) validate() error {
+	switch o.TLSMode {
+	case enums.TLSModeStartTLS, enums.TLSModeTLS, enums.TLSModeNone:
+		return nil
+	}
+
+	return enums.ErrorInvalidTLSMode
+}
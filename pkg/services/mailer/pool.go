@@ -0,0 +1,95 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mailer
+
+import (
+	"context"
+	"net"
+	"net/smtp"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/mailer/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+type client struct {
+	conn net.Conn
+	smtp *smtp.Client
+}
+
+func (c *client) setDeadline(timeout time.Duration) {
+	_ = c.conn.SetDeadline(time.Now().Add(timeout))
+}
+
+func (c *client) close() {
+	if err := c.smtp.Quit(); err != nil {
+		logger.LogError(enums.MessageFailedToCloseSMTP, c.smtp.Close())
+	}
+}
+
+// pool keeps up to size idle smtp connections, checking them with a NOOP before reuse since the servers close idle
+// connections after a few minutes. Connections that failed are closed instead of returned.
+type pool struct {
+	idle chan *client
+	dial func(ctx context.Context) (*client, error)
+}
+
+func newPool(size int, dial func(ctx context.Context) (*client, error)) *pool {
+	if size < 1 {
+		size = 1
+	}
+
+	return &pool{idle: make(chan *client, size), dial: dial}
+}
+
+func (p *pool) get(ctx context.Context) (*client, error) {
+	for {
+		select {
+		case idle := <-p.idle:
+			if idle.smtp.Noop() == nil {
+				return idle, nil
+			}
+
+			_ = idle.smtp.Close()
+		default:
+			return p.dial(ctx)
+		}
+	}
+}
+
+func (p *pool) put(idle *client, err error) {
+	if err != nil {
+		_ = idle.smtp.Close()
+
+		return
+	}
+
+	select {
+	case p.idle <- idle:
+	default:
+		idle.close()
+	}
+}
+
+func (p *pool) close() {
+	for {
+		select {
+		case idle := <-p.idle:
+			idle.close()
+		default:
+			return
+		}
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mailer
+
+import (
+	"bufio"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeSMTPServer accepts the commands used by the mailer and records the received messages, the replies in
+// mailReplies are returned to the next MAIL commands before accepting them
+type fakeSMTPServer struct {
+	listener    net.Listener
+	mutex       sync.Mutex
+	messages    []string
+	recipients  []string
+	mailReplies []string
+	auth        bool
+	connections int
+}
+
+func newFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := &fakeSMTPServer{listener: listener}
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+
+	go server.serve()
+
+	return server
+}
+
+func (f *fakeSMTPServer) options() *Options {
+	address := f.listener.Addr().(*net.TCPAddr)
+
+	return &Options{
+		Host: address.IP.String(), Port: address.Port, From: "horusec@test.com", TLSMode: "none", PoolSize: 1,
+		Timeout: 5e9, MaxRetries: 2, RetryBackoff: 1e6,
+	}
+}
+
+func (f *fakeSMTPServer) getMessages() []string {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return append([]string{}, f.messages...)
+}
+
+func (f *fakeSMTPServer) getConnections() int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return f.connections
+}
+
+func (f *fakeSMTPServer) serve() {
+	for {
+		conn, err := f.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		f.mutex.Lock()
+		f.connections++
+		f.mutex.Unlock()
+
+		go f.handle(conn)
+	}
+}
+
+func (f *fakeSMTPServer) handle(conn net.Conn) {
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	write := func(reply string) {
+		_, _ = conn.Write([]byte(reply + "\r\n"))
+	}
+
+	write("220 localhost ready")
+
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+
+		if !f.reply(strings.TrimSpace(line), reader, write) {
+			return
+		}
+	}
+}
+
+func (f *fakeSMTPServer) reply(line string, reader *bufio.Reader, write func(string)) bool {
+	command := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+
+	switch command {
+	case "EHLO":
+		write("250-localhost\r\n250 AUTH PLAIN")
+	case "AUTH":
+		f.mutex.Lock()
+		f.auth = true
+		f.mutex.Unlock()
+		write("235 authenticated")
+	case "MAIL":
+		write(f.nextMailReply())
+	case "RCPT":
+		f.mutex.Lock()
+		f.recipients = append(f.recipients, line)
+		f.mutex.Unlock()
+		write("250 ok")
+	case "DATA":
+		write("354 send data")
+		f.readData(reader)
+		write("250 queued")
+	case "QUIT":
+		write("221 bye")
+
+		return false
+	default:
+		write("250 ok")
+	}
+
+	return true
+}
+
+func (f *fakeSMTPServer) nextMailReply() string {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.mailReplies) == 0 {
+		return "250 ok"
+	}
+
+	reply := f.mailReplies[0]
+	f.mailReplies = f.mailReplies[1:]
+
+	return reply
+}
+
+func (f *fakeSMTPServer) readData(reader *bufio.Reader) {
+	data := strings.Builder{}
+
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil || line == ".\r\n" {
+			break
+		}
+
+		data.WriteString(line)
+	}
+
+	f.mutex.Lock()
+	f.messages = append(f.messages, data.String())
+	f.mutex.Unlock()
+}
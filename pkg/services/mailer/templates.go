@@ -0,0 +1,106 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mailer
+
+import (
+	"bytes"
+	htmlTemplate "html/template"
+	"io/fs"
+	textTemplate "text/template"
+
+	"golang.org/x/text/language"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/mailer/enums"
+)
+
+// Templates renders the html and text versions of an email, loaded from files named as the template with the .html
+// and .txt extensions, like account-confirmation.html. A template can have only one of the versions.
+type Templates struct {
+	html *htmlTemplate.Template
+	text *textTemplate.Template
+}
+
+// NewTemplates parses the templates on the root of the file system, which is usually an embed.FS sub directory
+func NewTemplates(fsys fs.FS) (*Templates, error) {
+	templates := &Templates{html: htmlTemplate.New(""), text: textTemplate.New("")}
+
+	if err := templates.parseHTML(fsys); err != nil {
+		return nil, err
+	}
+
+	return templates, templates.parseText(fsys)
+}
+
+func (t *Templates) parseHTML(fsys fs.FS) (err error) {
+	pattern := "*" + enums.TemplateHTMLExtension
+	if matches, err := fs.Glob(fsys, pattern); err != nil || len(matches) == 0 {
+		return err
+	}
+
+	t.html, err = t.html.ParseFS(fsys, pattern)
+
+	return err
+}
+
+func (t *Templates) parseText(fsys fs.FS) (err error) {
+	pattern := "*" + enums.TemplateTextExtension
+	if matches, err := fs.Glob(fsys, pattern); err != nil || len(matches) == 0 {
+		return err
+	}
+
+	t.text, err = t.text.ParseFS(fsys, pattern)
+
+	return err
+}
+
+// localizedName returns the name of the template translated to the language, like account-confirmation.pt-BR, or
+// the name itself when there is no translated version of the template
+func (t *Templates) localizedName(name string, tag language.Tag) string {
+	localized := name + "." + tag.String()
+	if t.html.Lookup(localized+enums.TemplateHTMLExtension) != nil ||
+		t.text.Lookup(localized+enums.TemplateTextExtension) != nil {
+		return localized
+	}
+
+	return name
+}
+
+// Render returns the html and text versions of the template, the missing version is returned empty
+func (t *Templates) Render(name string, data interface{}) (html, text string, err error) {
+	htmlTmpl := t.html.Lookup(name + enums.TemplateHTMLExtension)
+	textTmpl := t.text.Lookup(name + enums.TemplateTextExtension)
+
+	if htmlTmpl == nil && textTmpl == nil {
+		return "", "", enums.ErrorTemplateNotFound
+	}
+
+	buffer := &bytes.Buffer{}
+	if htmlTmpl != nil {
+		if err := htmlTmpl.Execute(buffer, data); err != nil {
+			return "", "", err
+		}
+	}
+
+	html = buffer.String()
+	buffer.Reset()
+
+	if textTmpl != nil {
+		if err := textTmpl.Execute(buffer, data); err != nil {
+			return "", "", err
+		}
+	}
+
+	return html, buffer.String(), nil
+}
@@ -0,0 +1,59 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mailer
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/mailer/enums"
+)
+
+func TestRender(t *testing.T) {
+	t.Run("should render html escaping the data and text", func(t *testing.T) {
+		html, text, err := newTestTemplates(t).Render("account-confirmation",
+			map[string]string{"Username": "<b>test</b>", "URL": "https://horusec.io"})
+
+		assert.NoError(t, err)
+		assert.Contains(t, html, "&lt;b&gt;test&lt;/b&gt;")
+		assert.Contains(t, text, "Hello <b>test</b>")
+	})
+
+	t.Run("should render template with only text", func(t *testing.T) {
+		html, text, err := newTestTemplates(t).Render("reset-password", map[string]string{"Code": "123456"})
+
+		assert.NoError(t, err)
+		assert.Empty(t, html)
+		assert.Equal(t, "Your code is 123456\n", text)
+	})
+
+	t.Run("should return error when template fails or does not exist", func(t *testing.T) {
+		_, _, err := newTestTemplates(t).Render("invalid-data", struct{}{})
+		assert.Error(t, err)
+
+		_, _, err = newTestTemplates(t).Render("test", nil)
+		assert.ErrorIs(t, err, enums.ErrorTemplateNotFound)
+	})
+
+	t.Run("should return error when template file is invalid", func(t *testing.T) {
+		for name := range map[string]bool{"test.html": true, "test.txt": true} {
+			_, err := NewTemplates(fstest.MapFS{name: {Data: []byte("{{.Test")}})
+
+			assert.Error(t, err, name)
+		}
+	})
+}
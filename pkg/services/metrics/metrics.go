@@ -0,0 +1,107 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/metrics/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+// nolint:gochecknoglobals // the registry is shared by every module of the service
+var (
+	registry     *prometheus.Registry
+	registryOnce sync.Once
+
+	// LatencyBuckets are the buckets in seconds used by every latency histogram, from 5ms until 10s
+	LatencyBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+	// SizeBuckets are the buckets in bytes used by every size histogram, from 256B until 64MB
+	SizeBuckets = prometheus.ExponentialBuckets(enums.DefaultSizeStart, enums.DefaultSizeScale, enums.DefaultSizeCount)
+)
+
+// Registry returns the registry shared by the devkit modules and the services. The go runtime and process metrics
+// are collected by the default registry, which is also served by the Handler.
+func Registry() *prometheus.Registry {
+	registryOnce.Do(func() {
+		registry = prometheus.NewRegistry()
+	})
+
+	return registry
+}
+
+// Registerer adds the standard labels to every metric registered, like the service name read from env
+func Registerer() prometheus.Registerer {
+	labels := prometheus.Labels{}
+	if service := env.GetEnvOrDefault(enums.HorusecMetricsServiceName, ""); service != "" {
+		labels[enums.LabelService] = service
+	}
+
+	return prometheus.WrapRegistererWith(labels, Registry())
+}
+
+// Handler serves the metrics of the shared registry and of the default registry, where the metrics of the libraries
+// are registered, and should be routed on enums.MetricsRoute. A metric collected by both registries is reported as an
+// error without failing the other metrics.
+func Handler() http.Handler {
+	gatherers := prometheus.Gatherers{Registry(), prometheus.DefaultGatherer}
+
+	return promhttp.InstrumentMetricHandler(Registry(), promhttp.HandlerFor(gatherers, promhttp.HandlerOpts{
+		ErrorHandling: promhttp.ContinueOnError,
+	}))
+}
+
+// Register registers the collector on the shared registry, returning the collector registered before when it is
+// already registered, so the modules do not need to keep their collectors on globals
+func Register[C prometheus.Collector](collector C) C {
+	err := Registerer().Register(collector)
+	if err == nil {
+		return collector
+	}
+
+	var registered prometheus.AlreadyRegisteredError
+	if errors.As(err, &registered) {
+		if existing, ok := registered.ExistingCollector.(C); ok {
+			return existing
+		}
+	}
+
+	logger.LogError(enums.MessageFailedToRegisterMetric, err)
+
+	return collector
+}
+
+func NewCounterVec(name, help string, labels ...string) *prometheus.CounterVec {
+	return Register(prometheus.NewCounterVec(prometheus.CounterOpts{Namespace: enums.Namespace, Name: name,
+		Help: help}, labels))
+}
+
+func NewGaugeVec(name, help string, labels ...string) *prometheus.GaugeVec {
+	return Register(prometheus.NewGaugeVec(prometheus.GaugeOpts{Namespace: enums.Namespace, Name: name,
+		Help: help}, labels))
+}
+
+// NewHistogramVec should receive the LatencyBuckets or the SizeBuckets, unless the metric has a different unit
+func NewHistogramVec(name, help string, buckets []float64, labels ...string) *prometheus.HistogramVec {
+	return Register(prometheus.NewHistogramVec(prometheus.HistogramOpts{Namespace: enums.Namespace, Name: name,
+		Help: help, Buckets: buckets}, labels))
+}
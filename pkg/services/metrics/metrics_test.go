@@ -0,0 +1,116 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/metrics/enums"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Run("should return the same registry", func(t *testing.T) {
+		assert.Same(t, Registry(), Registry())
+	})
+}
+
+func TestRegister(t *testing.T) {
+	t.Run("should return the collector registered before", func(t *testing.T) {
+		first := NewCounterVec("test_register_total", "Test.", "label")
+		second := NewCounterVec("test_register_total", "Test.", "label")
+
+		first.WithLabelValues("test").Inc()
+
+		assert.Same(t, first, second)
+		assert.Equal(t, float64(1), testutil.ToFloat64(second.WithLabelValues("test")))
+	})
+
+	t.Run("should return the collector when failed to register", func(t *testing.T) {
+		NewGaugeVec("test_conflict", "Test.", "label")
+
+		collector := NewHistogramVec("test_conflict", "Test.", LatencyBuckets, "label")
+
+		assert.NotNil(t, collector)
+	})
+
+	t.Run("should add standard labels from env", func(t *testing.T) {
+		t.Setenv(enums.HorusecMetricsServiceName, "horusec-api")
+
+		NewGaugeVec("test_standard_labels", "Test.").WithLabelValues().Set(1)
+
+		families, err := Registry().Gather()
+		assert.NoError(t, err)
+
+		for _, family := range families {
+			if family.GetName() == "horusec_test_standard_labels" {
+				assert.Equal(t, enums.LabelService, family.GetMetric()[0].GetLabel()[0].GetName())
+				assert.Equal(t, "horusec-api", family.GetMetric()[0].GetLabel()[0].GetValue())
+
+				return
+			}
+		}
+
+		t.Fail()
+	})
+}
+
+func TestHandler(t *testing.T) {
+	t.Run("should serve metrics of the shared registry", func(t *testing.T) {
+		NewHistogramVec("test_handler_seconds", "Test.", LatencyBuckets).WithLabelValues().Observe(1)
+
+		w := httptest.NewRecorder()
+		Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, enums.MetricsRoute, nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "horusec_test_handler_seconds_bucket")
+		assert.Contains(t, w.Body.String(), "promhttp_metric_handler_requests_total")
+	})
+
+	t.Run("should serve metrics of the default registry with the runtime metrics", func(t *testing.T) {
+		counter := prometheus.NewCounter(prometheus.CounterOpts{Name: "test_default_registry_total", Help: "Test."})
+		assert.NoError(t, prometheus.DefaultRegisterer.Register(counter))
+
+		defer prometheus.DefaultRegisterer.Unregister(counter)
+
+		w := httptest.NewRecorder()
+		Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, enums.MetricsRoute, nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "test_default_registry_total")
+		assert.Contains(t, w.Body.String(), "go_goroutines")
+	})
+
+	t.Run("should serve the other metrics when a metric is in both registries", func(t *testing.T) {
+		opts := prometheus.GaugeOpts{Name: "test_duplicated", Help: "Test."}
+		shared, duplicated := prometheus.NewGauge(opts), prometheus.NewGauge(opts)
+		assert.NoError(t, Registry().Register(shared))
+		assert.NoError(t, prometheus.DefaultRegisterer.Register(duplicated))
+
+		defer Registry().Unregister(shared)
+		defer prometheus.DefaultRegisterer.Unregister(duplicated)
+
+		w := httptest.NewRecorder()
+		Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, enums.MetricsRoute, nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "go_goroutines")
+	})
+}
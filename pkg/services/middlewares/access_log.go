@@ -0,0 +1,152 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middlewares
+
+import (
+	"math/rand"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/middleware"
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+// AccessLogOptions configures the access log middleware. The sample percent is the percentage of successful requests
+// that are logged, which can be overridden by route pattern in the sampled routes, requests with a status code greater
+// or equal than 400 are always logged
+type AccessLogOptions struct {
+	ExcludedPaths []string
+	SamplePercent int
+	SampledRoutes map[string]int
+}
+
+func NewAccessLogOptions() *AccessLogOptions {
+	return &AccessLogOptions{
+		ExcludedPaths: getExcludedPaths(),
+		SamplePercent: env.GetEnvOrDefaultInt(enums.HorusecAccessLogSamplePercent,
+			enums.DefaultAccessLogSamplePercent),
+		SampledRoutes: map[string]int{},
+	}
+}
+
+func getExcludedPaths() []string {
+	return getPathsFromEnv(enums.HorusecAccessLogExcludedPaths, enums.DefaultAccessLogExcludedPaths)
+}
+
+func getPathsFromEnv(name, defaultValue string) (paths []string) {
+	for _, path := range strings.Split(env.GetEnvOrDefault(name, defaultValue), ",") {
+		if path = strings.TrimSpace(path); path != "" {
+			paths = append(paths, path)
+		}
+	}
+
+	return paths
+}
+
+// AccessLogMiddleware logs each completed request with the route pattern instead of the path, to be grouped by the
+// log aggregator, and should run before the recoverer so the panics are logged as internal server errors
+func AccessLogMiddleware(options *AccessLogOptions) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if options.isExcluded(r.URL.Path) {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			start, completed := time.Now(), false
+			writer := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+			defer func() {
+				options.log(r, writer, getStatus(writer, completed), time.Since(start))
+			}()
+
+			next.ServeHTTP(writer, r)
+
+			completed = true
+		})
+	}
+}
+
+func (a *AccessLogOptions) isExcluded(path string) bool {
+	return isExcludedPath(a.ExcludedPaths, path)
+}
+
+func isExcludedPath(excludedPaths []string, path string) bool {
+	for _, excluded := range excludedPaths {
+		if path == excluded || strings.HasPrefix(path, strings.TrimSuffix(excluded, "/")+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (a *AccessLogOptions) log(r *http.Request, writer middleware.WrapResponseWriter, status int,
+	latency time.Duration) {
+	route := getRoutePattern(r)
+	if !a.isSampled(route, status) {
+		return
+	}
+
+	logger.FromContext(r.Context()).LogInfoWithFields(enums.MessageHTTPRequestCompleted, map[string]interface{}{
+		"method":     r.Method,
+		"route":      route,
+		"status":     status,
+		"latency_ms": latency.Milliseconds(),
+		"size":       writer.BytesWritten(),
+		"account_id": getAccessLogAccountID(r),
+	})
+}
+
+func (a *AccessLogOptions) isSampled(route string, status int) bool {
+	if status >= http.StatusBadRequest {
+		return true
+	}
+
+	percent, ok := a.SampledRoutes[route]
+	if !ok {
+		percent = a.SamplePercent
+	}
+
+	// nolint:gosec // sampling does not need a secure random
+	return percent >= 100 || rand.Intn(100) < percent
+}
+
+// getAccessLogAccountID uses the account id set by the authorization middleware when it runs before the access log,
+// otherwise the account id is taken from the jwt token, being empty for the requests without a valid token
+func getAccessLogAccountID(r *http.Request) string {
+	if accountID, ok := jwt.GetAccountIDFromContext(r.Context()); ok {
+		return accountID.String()
+	}
+
+	token := jwt.GetTokenFromRequest(r)
+	if token == "" {
+		return ""
+	}
+
+	accountID, err := jwt.GetAccountIDByJWTToken(token)
+	if err != nil || accountID == uuid.Nil {
+		return ""
+	}
+
+	return accountID.String()
+}
@@ -0,0 +1,114 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middlewares
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+func doAccessLogRequest(t *testing.T, options *AccessLogOptions, path string, status int) string {
+	output := bytes.NewBufferString("")
+	logger.LogSetOutput(output)
+
+	t.Cleanup(func() {
+		logger.LogSetOutput(os.Stderr)
+	})
+
+	router := chi.NewRouter()
+	router.Use(RequestIDMiddleware, AccessLogMiddleware(options))
+	router.Get("/workspaces/{workspaceID}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte("test"))
+	})
+	router.Get("/health", func(w http.ResponseWriter, r *http.Request) {})
+
+	req, _ := http.NewRequest(http.MethodGet, path, nil)
+	req.Header.Set("X-Request-Id", "test-id")
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	return output.String()
+}
+
+func TestNewAccessLogOptions(t *testing.T) {
+	t.Run("should read options from env", func(t *testing.T) {
+		t.Setenv(enums.HorusecAccessLogExcludedPaths, "/health, /metrics,")
+		t.Setenv(enums.HorusecAccessLogSamplePercent, "10")
+
+		options := NewAccessLogOptions()
+
+		assert.Equal(t, []string{"/health", "/metrics"}, options.ExcludedPaths)
+		assert.Equal(t, 10, options.SamplePercent)
+	})
+}
+
+func TestAccessLogMiddleware(t *testing.T) {
+	t.Run("should log request with route pattern, status and size", func(t *testing.T) {
+		output := doAccessLogRequest(t, NewAccessLogOptions(), "/workspaces/1", http.StatusCreated)
+
+		assert.Contains(t, output, enums.MessageHTTPRequestCompleted)
+		assert.Contains(t, output, "route=\"/workspaces/{workspaceID}\"")
+		assert.Contains(t, output, "status=201")
+		assert.Contains(t, output, "size=4")
+		assert.Contains(t, output, "request_id=test-id")
+	})
+
+	t.Run("should not log excluded paths", func(t *testing.T) {
+		assert.Empty(t, doAccessLogRequest(t, NewAccessLogOptions(), "/health", http.StatusOK))
+	})
+
+	t.Run("should not log successful requests when not sampled", func(t *testing.T) {
+		options := NewAccessLogOptions()
+		options.SampledRoutes["/workspaces/{workspaceID}"] = 0
+
+		assert.Empty(t, doAccessLogRequest(t, options, "/workspaces/1", http.StatusOK))
+	})
+
+	t.Run("should always log failed requests", func(t *testing.T) {
+		options := NewAccessLogOptions()
+		options.SamplePercent = 0
+
+		assert.Contains(t, doAccessLogRequest(t, options, "/workspaces/1", http.StatusBadRequest), "status=400")
+	})
+}
+
+func TestGetAccessLogAccountID(t *testing.T) {
+	t.Run("should return account id from context", func(t *testing.T) {
+		accountID := uuid.New()
+		req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+
+		assert.Equal(t, accountID.String(), getAccessLogAccountID(
+			req.WithContext(jwt.ContextWithAccountID(req.Context(), accountID))))
+	})
+
+	t.Run("should return empty when token is missing or invalid", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+		assert.Empty(t, getAccessLogAccountID(req))
+
+		req.Header.Set("X-Horusec-Authorization", "test")
+		assert.Empty(t, getAccessLogAccountID(req))
+	})
+}
@@ -0,0 +1,61 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middlewares
+
+import (
+	"bufio"
+	"net"
+	"net/http"
+
+	"github.com/go-chi/chi/middleware"
+)
+
+// CompressMiddleware compresses the responses like the chi one, but its writer also unwraps to the writer of the
+// server, since the chi writer hides it from the http.ResponseController that clears the write deadline of streams
+func CompressMiddleware(level int, types ...string) func(http.Handler) http.Handler {
+	compressor := middleware.NewCompressor(level, types...)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			compressor.Handler(http.HandlerFunc(func(compressWriter http.ResponseWriter, r *http.Request) {
+				next.ServeHTTP(&compressResponseWriter{ResponseWriter: compressWriter, parent: w}, r)
+			})).ServeHTTP(w, r)
+		})
+	}
+}
+
+// compressResponseWriter writes through the chi writer, which keeps the flush and hijack support of the server writer
+type compressResponseWriter struct {
+	http.ResponseWriter
+	parent http.ResponseWriter
+}
+
+func (c *compressResponseWriter) Unwrap() http.ResponseWriter {
+	return c.parent
+}
+
+func (c *compressResponseWriter) Flush() {
+	if flusher, ok := c.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (c *compressResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	if hijacker, ok := c.ResponseWriter.(http.Hijacker); ok {
+		return hijacker.Hijack()
+	}
+
+	return nil, nil, http.ErrNotSupported
+}
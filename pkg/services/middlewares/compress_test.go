@@ -0,0 +1,46 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middlewares
+
+import (
+	"compress/flate"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompressMiddleware(t *testing.T) {
+	t.Run("should compress the response and unwrap to the server writer", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		handler := CompressMiddleware(flate.BestCompression)(http.HandlerFunc(
+			func(writer http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, w, writer.(interface{ Unwrap() http.ResponseWriter }).Unwrap())
+
+				writer.Header().Set("Content-Type", "application/json")
+				_, _ = writer.Write([]byte(`{"test":"test"}`))
+				writer.(http.Flusher).Flush()
+			}))
+
+		req, _ := http.NewRequest(http.MethodGet, "http://test", nil)
+		req.Header.Set("Accept-Encoding", "gzip")
+
+		handler.ServeHTTP(w, req)
+
+		assert.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+	})
+}
@@ -0,0 +1,98 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/go-chi/cors"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	jwtEnums "github.com/ZupIT/horusec-devkit/pkg/utils/jwt/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+	propagationEnums "github.com/ZupIT/horusec-devkit/pkg/utils/propagation/enums"
+)
+
+// CORSOptions configures the cors middleware shared by the services. The lists are read from the environment as comma
+// separated values, where the origins accept * to allow any origin or a single wildcard by origin, like
+// https://*.horusec.io. The max age is the seconds the browsers can cache the preflight responses.
+type CORSOptions struct {
+	AllowedOrigins   []string
+	AllowedMethods   []string
+	AllowedHeaders   []string
+	ExposedHeaders   []string
+	AllowCredentials bool
+	MaxAge           int
+}
+
+func NewCORSOptions() *CORSOptions {
+	return &CORSOptions{
+		AllowedOrigins:   env.GetStringSlice(enums.HorusecCORSAllowedOrigins, []string{enums.CORSAnyOrigin}),
+		AllowedMethods:   env.GetStringSlice(enums.HorusecCORSAllowedMethods, getDefaultCORSMethods()),
+		AllowedHeaders:   env.GetStringSlice(enums.HorusecCORSAllowedHeaders, getDefaultCORSAllowedHeaders()),
+		ExposedHeaders:   env.GetStringSlice(enums.HorusecCORSExposedHeaders, getDefaultCORSExposedHeaders()),
+		AllowCredentials: env.GetBool(enums.HorusecCORSAllowCredentials, false),
+		MaxAge:           env.GetInt(enums.HorusecCORSMaxAge, enums.DefaultCORSMaxAgeSeconds),
+	}
+}
+
+func getDefaultCORSMethods() []string {
+	return []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete,
+		http.MethodOptions}
+}
+
+func getDefaultCORSAllowedHeaders() []string {
+	return []string{enums.HeaderAccept, enums.HeaderContentType, jwtEnums.AuthorizationHeader,
+		jwtEnums.HorusecJWTHeader, propagationEnums.HeaderRequestID}
+}
+
+// getDefaultCORSExposedHeaders exposes the headers set by the request id and the rate limit middlewares
+func getDefaultCORSExposedHeaders() []string {
+	return []string{propagationEnums.HeaderRequestID, enums.HeaderRetryAfter, enums.HeaderRateLimitLimit,
+		enums.HeaderRateLimitRemaining}
+}
+
+// ToCorsOptions returns the options of the cors handler, used by the router when the service does not inform its own
+func (c *CORSOptions) ToCorsOptions() *cors.Options {
+	return &cors.Options{
+		AllowedOrigins:   c.AllowedOrigins,
+		AllowedMethods:   c.AllowedMethods,
+		AllowedHeaders:   c.AllowedHeaders,
+		ExposedHeaders:   c.ExposedHeaders,
+		AllowCredentials: c.AllowCredentials,
+		MaxAge:           c.MaxAge,
+	}
+}
+
+func (c *CORSOptions) allowsAnyOrigin() bool {
+	for _, origin := range c.AllowedOrigins {
+		if origin == enums.CORSAnyOrigin {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CORSMiddleware answers the preflight requests and sets the cors headers of the allowed origins. The credentials
+// are only accepted by the browsers for explicit origins, so a warning is logged when they are allowed for any origin.
+func CORSMiddleware(options *CORSOptions) func(http.Handler) http.Handler {
+	if options.AllowCredentials && options.allowsAnyOrigin() {
+		logger.LogWarn(enums.MessageCORSCredentialsWithAnyOrigin)
+	}
+
+	return cors.New(*options.ToCorsOptions()).Handler
+}
@@ -0,0 +1,130 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares/enums"
+	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
+	jwtEnums "github.com/ZupIT/horusec-devkit/pkg/utils/jwt/enums"
+	propagationEnums "github.com/ZupIT/horusec-devkit/pkg/utils/propagation/enums"
+)
+
+func newCORSRequest(method, origin string) *http.Request {
+	req, _ := http.NewRequest(method, "http://test/api", nil)
+	req.Header.Set("Origin", origin)
+
+	return req
+}
+
+func TestNewCORSOptions(t *testing.T) {
+	t.Run("should return the default options", func(t *testing.T) {
+		options := NewCORSOptions()
+
+		assert.Equal(t, []string{enums.CORSAnyOrigin}, options.AllowedOrigins)
+		assert.Contains(t, options.AllowedMethods, http.MethodPatch)
+		assert.Contains(t, options.AllowedHeaders, jwtEnums.HorusecJWTHeader)
+		assert.Contains(t, options.ExposedHeaders, propagationEnums.HeaderRequestID)
+		assert.False(t, options.AllowCredentials)
+		assert.Equal(t, enums.DefaultCORSMaxAgeSeconds, options.MaxAge)
+	})
+
+	t.Run("should read the options from the environment", func(t *testing.T) {
+		t.Setenv(enums.HorusecCORSAllowedOrigins, "https://horusec.io, https://*.horusec.io")
+		t.Setenv(enums.HorusecCORSAllowedMethods, "GET,POST")
+		t.Setenv(enums.HorusecCORSAllowedHeaders, "X-Custom")
+		t.Setenv(enums.HorusecCORSExposedHeaders, "X-Exposed")
+		t.Setenv(enums.HorusecCORSAllowCredentials, "true")
+		t.Setenv(enums.HorusecCORSMaxAge, "60")
+
+		options := NewCORSOptions()
+
+		assert.Equal(t, []string{"https://horusec.io", "https://*.horusec.io"}, options.AllowedOrigins)
+		assert.Equal(t, []string{"GET", "POST"}, options.AllowedMethods)
+		assert.Equal(t, []string{"X-Custom"}, options.AllowedHeaders)
+		assert.Equal(t, []string{"X-Exposed"}, options.ExposedHeaders)
+		assert.True(t, options.AllowCredentials)
+		assert.Equal(t, 60, options.MaxAge)
+		assert.Equal(t, options.AllowedOrigins, options.ToCorsOptions().AllowedOrigins)
+	})
+}
+
+func TestCORSMiddleware(t *testing.T) {
+	options := NewCORSOptions()
+	options.AllowedOrigins = []string{"https://*.horusec.io"}
+	options.AllowCredentials = true
+
+	handler := CORSMiddleware(options)(http.HandlerFunc(testHandler))
+
+	t.Run("should answer the preflight of an allowed origin", func(t *testing.T) {
+		req := newCORSRequest(http.MethodOptions, "https://manager.horusec.io")
+		req.Header.Set("Access-Control-Request-Method", http.MethodDelete)
+		req.Header.Set("Access-Control-Request-Headers", jwtEnums.HorusecJWTHeader)
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "https://manager.horusec.io", w.Header().Get("Access-Control-Allow-Origin"))
+		assert.Equal(t, http.MethodDelete, w.Header().Get("Access-Control-Allow-Methods"))
+		assert.Equal(t, jwtEnums.HorusecJWTHeader, w.Header().Get("Access-Control-Allow-Headers"))
+		assert.Equal(t, "true", w.Header().Get("Access-Control-Allow-Credentials"))
+		assert.Equal(t, "300", w.Header().Get("Access-Control-Max-Age"))
+	})
+
+	t.Run("should expose the headers of an allowed origin", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, newCORSRequest(http.MethodGet, "https://manager.horusec.io"))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "https://manager.horusec.io", w.Header().Get("Access-Control-Allow-Origin"))
+		assert.Contains(t, w.Header().Get("Access-Control-Expose-Headers"), propagationEnums.HeaderRequestID)
+	})
+
+	t.Run("should keep the allowed origin on the responses of the http helpers", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		CORSMiddleware(options)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			httpUtil.StatusUnauthorized(w, enums.ErrorUnauthorized)
+		})).ServeHTTP(w, newCORSRequest(http.MethodGet, "https://manager.horusec.io"))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Equal(t, "https://manager.horusec.io", w.Header().Get("Access-Control-Allow-Origin"))
+		assert.Equal(t, "true", w.Header().Get("Access-Control-Allow-Credentials"))
+	})
+
+	t.Run("should not set the cors headers of an origin not allowed", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, newCORSRequest(http.MethodGet, "https://evil.io"))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+	})
+
+	t.Run("should allow any origin with credentials logging a warning", func(t *testing.T) {
+		options := NewCORSOptions()
+		options.AllowCredentials = true
+
+		w := httptest.NewRecorder()
+		CORSMiddleware(options)(http.HandlerFunc(testHandler)).ServeHTTP(w,
+			newCORSRequest(http.MethodGet, "https://evil.io"))
+
+		assert.Equal(t, enums.CORSAnyOrigin, w.Header().Get("Access-Control-Allow-Origin"))
+	})
+}
@@ -20,5 +20,8 @@ var (
 	ErrorUnauthorized          = errors.New("{HORUSEC_MIDDLEWARE} you do not have enough privileges for this action")
 	ErrorFailedToVerifyRequest = errors.New("{HORUSEC_MIDDLEWARE} something went wrong while verifying " +
 		"if request is authorized")
+	ErrorTooManyRequests  = errors.New("{HORUSEC_MIDDLEWARE} too many requests, try again later")
+	ErrorRoleWithoutScope = errors.New("{HORUSEC_MIDDLEWARE} the route has no workspace or repository id to " +
+		"check the role")
 )
 var ErrorWhenGettingAuthConfig = errors.New("{HORUSEC_MIDDLEWARE} failed to get auth config")
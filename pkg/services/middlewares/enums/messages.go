@@ -20,4 +20,10 @@ const (
 		"with method \"%s\" returned unauthorized to \"%s\""
 	MessageFailedToGetAccountID  = "{HORUSEC_MIDDLEWARE} failed to get account id for unauthorized request warning"
 	MessageFailedToGetAuthConfig = "{HORUSEC_MIDDLEWARE} grpc method to get auth config failed"
+	MessageHTTPRequestCompleted  = "{HORUSEC_MIDDLEWARE} http request completed"
+	MessageFailedToTakeRateLimit = "{HORUSEC_MIDDLEWARE} failed to check the rate limit, allowing the request"
+	MessageRoleWithoutScope      = "{HORUSEC_MIDDLEWARE} role \"%s\" rejected in url \"%s\" with method \"%s\", " +
+		"only the application admin role can be checked on routes without workspace or repository id"
+	MessageCORSCredentialsWithAnyOrigin = "{HORUSEC_MIDDLEWARE} cors credentials are allowed for any origin, " +
+		"which browsers reject, set the allowed origins to use credentials"
 )
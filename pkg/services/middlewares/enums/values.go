@@ -17,4 +17,74 @@ package enums
 const (
 	WorkspaceID  = "workspaceID"
 	RepositoryID = "repositoryID"
+
+	MetricsLabelMethod = "method"
+	MetricsLabelRoute  = "route"
+	MetricsLabelCode   = "code"
+	UnknownRoute       = "unknown"
+
+	HorusecAccessLogExcludedPaths = "HORUSEC_ACCESS_LOG_EXCLUDED_PATHS"
+	HorusecAccessLogSamplePercent = "HORUSEC_ACCESS_LOG_SAMPLE_PERCENT"
+	DefaultAccessLogExcludedPaths = "/health,/ready,/live,/metrics"
+	DefaultAccessLogSamplePercent = 100
+
+	HorusecPayloadRecorderEnabled       = "HORUSEC_PAYLOAD_RECORDER_ENABLED"
+	HorusecPayloadRecorderSamplePercent = "HORUSEC_PAYLOAD_RECORDER_SAMPLE_PERCENT"
+	HorusecPayloadRecorderCapacity      = "HORUSEC_PAYLOAD_RECORDER_CAPACITY"
+	HorusecPayloadRecorderMaxBodySize   = "HORUSEC_PAYLOAD_RECORDER_MAX_BODY_SIZE"
+	DefaultPayloadRecorderSamplePercent = 1
+	DefaultPayloadRecorderCapacity      = 100
+	DefaultPayloadRecorderMaxBodySize   = 16 * 1024
+	OmittedBodyFormat                   = "<%d bytes of %s omitted>"
+
+	AuthConfigSingleflightKey = "auth-config"
+
+	HorusecAuthzCacheTTL    = "HORUSEC_AUTHZ_CACHE_TTL"
+	HorusecAuthzCallTimeout = "HORUSEC_AUTHZ_CALL_TIMEOUT"
+	AuthzCacheName          = "authz_decisions"
+	AuthzCacheKeySeparator  = "|"
+
+	MaxRequestIDLength = 200
+
+	HorusecRateLimitEnabled           = "HORUSEC_RATE_LIMIT_ENABLED"
+	HorusecRateLimitRequestsPerMinute = "HORUSEC_RATE_LIMIT_REQUESTS_PER_MINUTE"
+	HorusecRateLimitBurst             = "HORUSEC_RATE_LIMIT_BURST"
+	HorusecRateLimitExcludedPaths     = "HORUSEC_RATE_LIMIT_EXCLUDED_PATHS"
+	DefaultRateLimitRequestsPerMinute = 600
+	DefaultRateLimitBurst             = 100
+	DefaultRateLimitExcludedPaths     = "/health,/ready,/live,/metrics"
+	RateLimitKeyPrefixAccount         = "account:"
+	RateLimitKeyPrefixIP              = "ip:"
+	RateLimitRedisKeyPrefix           = "horusec:rate_limit:"
+	HeaderRetryAfter                  = "Retry-After"
+	HeaderRateLimitLimit              = "X-RateLimit-Limit"
+	HeaderRateLimitRemaining          = "X-RateLimit-Remaining"
+
+	HorusecCORSAllowedOrigins   = "HORUSEC_CORS_ALLOWED_ORIGINS"
+	HorusecCORSAllowedMethods   = "HORUSEC_CORS_ALLOWED_METHODS"
+	HorusecCORSAllowedHeaders   = "HORUSEC_CORS_ALLOWED_HEADERS"
+	HorusecCORSExposedHeaders   = "HORUSEC_CORS_EXPOSED_HEADERS"
+	HorusecCORSAllowCredentials = "HORUSEC_CORS_ALLOW_CREDENTIALS"
+	HorusecCORSMaxAge           = "HORUSEC_CORS_MAX_AGE"
+	DefaultCORSMaxAgeSeconds    = 300
+	CORSAnyOrigin               = "*"
+	HeaderAccept                = "Accept"
+	HeaderContentType           = "Content-Type"
+
+	// RateLimitRedisScript refills the bucket by the milliseconds elapsed since its last update and takes one token
+	// when there is any, expiring the bucket when it would be full again. The tokens are returned as text, since redis
+	// truncates the lua numbers to integers.
+	RateLimitRedisScript = `
+local bucket = redis.call("HMGET", KEYS[1], "tokens", "updated")
+local rate, burst, now = tonumber(ARGV[1]), tonumber(ARGV[2]), tonumber(ARGV[3])
+local tokens, updated = tonumber(bucket[1]) or burst, tonumber(bucket[2]) or now
+tokens = math.min(burst, tokens + math.max(0, now - updated) * rate / 1000)
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "updated", now)
+redis.call("PEXPIRE", KEYS[1], math.ceil((burst - tokens) / rate * 1000) + 1)
+return {allowed, tostring(tokens)}`
 )
@@ -18,14 +18,22 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/go-chi/chi"
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
 
+	"github.com/ZupIT/horusec-devkit/pkg/enums/account"
 	authEnums "github.com/ZupIT/horusec-devkit/pkg/enums/auth"
+	"github.com/ZupIT/horusec-devkit/pkg/services/cache/ttl"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth"
 	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
 	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/singleflight"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
 	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
 	jwtEnums "github.com/ZupIT/horusec-devkit/pkg/utils/jwt/enums"
@@ -41,89 +49,296 @@ type IAuthzMiddleware interface {
 	IsRepositorySupervisor(next http.Handler) http.Handler
 }
 
+// IRoleAuthzMiddleware is implemented by AuthzMiddleware, but is kept out of IAuthzMiddleware so the implementations
+// outside of the devkit keep compiling
+type IRoleAuthzMiddleware interface {
+	NewRoleMiddleware(role account.Role) func(http.Handler) http.Handler
+	NewAuthorizationTypeMiddleware(authorizationType authEnums.AuthorizationType) func(http.Handler) http.Handler
+}
+
+// IApplicationAdminAuthzMiddleware is implemented by AuthzMiddleware, but is kept out of IAuthzMiddleware so the
+// implementations outside of the devkit keep compiling
+type IApplicationAdminAuthzMiddleware interface {
+	RequireApplicationAdmin(next http.Handler) http.Handler
+}
+
+// IAuthorizer decides if the account of the token has the authorization type on the workspace and repository of the
+// data, it replaces the auth service on the authorization checks, like an authorizer of rego policies
+type IAuthorizer interface {
+	IsAuthorized(ctx context.Context, data *proto.IsAuthorizedData) (bool, error)
+}
+
 type AuthzMiddleware struct {
-	grpcClient proto.AuthServiceClient
-	ctx        context.Context
+	grpcClient        proto.AuthServiceClient
+	authorizer        IAuthorizer
+	tokenPrecedence   jwtEnums.HeaderPrecedence
+	authConfigWatcher auth.IAuthConfigWatcher
+	watcherCtx        context.Context
+	authConfigGroup   singleflight.Group[*proto.GetAuthConfigResponse]
+	decisions         ttl.ICache[string, bool]
+	callTimeout       time.Duration
+}
+
+type AuthzOption func(middleware *AuthzMiddleware)
+
+// WithCallTimeout limits each call to the auth service, which also ends when the request is canceled or reaches its
+// deadline. A timeout lower or equal than zero only uses the request context.
+func WithCallTimeout(timeout time.Duration) AuthzOption {
+	return func(middleware *AuthzMiddleware) {
+		middleware.callTimeout = timeout
+	}
+}
+
+// WithAuthorizer checks the authorizations with the authorizer instead of the auth service, which still returns the
+// auth config used to know if the application admin is enabled
+func WithAuthorizer(authorizer IAuthorizer) AuthzOption {
+	return func(middleware *AuthzMiddleware) {
+		middleware.authorizer = authorizer
+	}
+}
+
+// WithTokenPrecedence sets the header checked first when the request has both the X-Horusec-Authorization and the
+// standard Authorization headers, replacing HORUSEC_JWT_HEADER_PRECEDENCE
+func WithTokenPrecedence(precedence jwtEnums.HeaderPrecedence) AuthzOption {
+	return func(middleware *AuthzMiddleware) {
+		middleware.tokenPrecedence = precedence
+	}
 }
 
-func NewAuthzMiddleware(grpcCon grpc.ClientConnInterface) IAuthzMiddleware {
-	return &AuthzMiddleware{
-		grpcClient: proto.NewAuthServiceClient(grpcCon),
-		ctx:        context.Background(),
+// WithAuthConfigWatcher keeps the auth config updated by the stream of the auth service instead of requesting it when
+// the application admin is checked. The watcher runs in background until the context is canceled, which should be
+// done when the service shuts down.
+func WithAuthConfigWatcher(ctx context.Context) AuthzOption {
+	return func(middleware *AuthzMiddleware) {
+		middleware.watcherCtx = ctx
 	}
 }
 
+// NewAuthzMiddleware caches the authorization decisions in memory when HORUSEC_AUTHZ_CACHE_TTL is greater than zero
+// and limits the calls to the auth service by HORUSEC_AUTHZ_CALL_TIMEOUT, unless replaced by the options
+func NewAuthzMiddleware(grpcCon grpc.ClientConnInterface, options ...AuthzOption) IAuthzMiddleware {
+	return NewAuthzMiddlewareWithCache(grpcCon, newDecisionCache(env.GetDuration(enums.HorusecAuthzCacheTTL, 0)),
+		options...)
+}
+
+// NewAuthzMiddlewareWithCache keeps the authorization decisions on the given cache, like the redis cache to share
+// them between replicas, or makes every check on the auth service when it is nil. The decisions are kept until they
+// expire, so a revoked role is still accepted for up to the ttl of the cache, which must be short.
+func NewAuthzMiddlewareWithCache(grpcCon grpc.ClientConnInterface, decisions ttl.ICache[string, bool],
+	options ...AuthzOption) IAuthzMiddleware {
+	middleware := &AuthzMiddleware{
+		grpcClient:      proto.NewAuthServiceClient(grpcCon),
+		decisions:       decisions,
+		callTimeout:     env.GetDuration(enums.HorusecAuthzCallTimeout, 0),
+		tokenPrecedence: jwt.GetHeaderPrecedence(),
+	}
+
+	for _, option := range options {
+		option(middleware)
+	}
+
+	if middleware.watcherCtx != nil {
+		middleware.authConfigWatcher = auth.NewAuthConfigWatcher(grpcCon)
+		middleware.authConfigWatcher.Start(middleware.watcherCtx)
+	}
+
+	return middleware
+}
+
+func newDecisionCache(expiration time.Duration) ttl.ICache[string, bool] {
+	if expiration <= 0 {
+		return nil
+	}
+
+	options := ttl.NewOptions(enums.AuthzCacheName)
+	options.TTL = expiration
+
+	return ttl.NewCache[string, bool](options)
+}
+
 func (a *AuthzMiddleware) IsApplicationAdmin(handler http.Handler) http.Handler {
+	return a.NewAuthorizationTypeMiddleware(authEnums.ApplicationAdmin)(handler)
+}
+
+// RequireApplicationAdmin only serves the application admins validated by the auth service. Unlike IsApplicationAdmin,
+// that allows every account when the application admin is disabled on the auth config, it rejects every request while
+// it is disabled, so it can protect the routes that must never be public, like the debug ones.
+func (a *AuthzMiddleware) RequireApplicationAdmin(handler http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		authConfig, err := a.grpcClient.GetAuthConfig(a.ctx, &proto.GetAuthConfigData{})
-		if a.checkGetConfigResponse(err, w) != nil {
+		authConfig, err := a.getAuthConfig(r.Context())
+		if a.checkGetConfigResponse(err, w, r) != nil {
 			return
 		}
 
-		if authConfig.EnableApplicationAdmin {
-			response, err := a.grpcClient.IsAuthorized(a.ctx, a.setAuthorizedData(r, authEnums.ApplicationAdmin))
-			if a.checkIsAuthorizedResponse(err, response, w, r, authEnums.ApplicationAdmin) != nil {
-				return
-			}
-		}
+		if !authConfig.EnableApplicationAdmin || a.getJWTToken(r) == "" {
+			a.unauthorizedResponse(w, r, authEnums.ApplicationAdmin)
 
-		handler.ServeHTTP(w, r)
-	})
-}
+			return
+		}
 
-func (a *AuthzMiddleware) IsWorkspaceMember(handler http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		response, err := a.grpcClient.IsAuthorized(a.ctx, a.setAuthorizedData(r, authEnums.WorkspaceMember))
-		if a.checkIsAuthorizedResponse(err, response, w, r, authEnums.WorkspaceMember) != nil {
+		response, err := a.isAuthorized(r, authEnums.ApplicationAdmin)
+		if a.checkIsAuthorizedResponse(err, response, w, r, authEnums.ApplicationAdmin) != nil {
 			return
 		}
 
-		handler.ServeHTTP(w, r)
+		handler.ServeHTTP(w, a.setAccountIDInContext(r))
 	})
 }
 
-func (a *AuthzMiddleware) IsWorkspaceAdmin(handler http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		response, err := a.grpcClient.IsAuthorized(a.ctx, a.setAuthorizedData(r, authEnums.WorkspaceAdmin))
-		if a.checkIsAuthorizedResponse(err, response, w, r, authEnums.WorkspaceAdmin) != nil {
-			return
-		}
+func (a *AuthzMiddleware) IsWorkspaceMember(handler http.Handler) http.Handler {
+	return a.NewAuthorizationTypeMiddleware(authEnums.WorkspaceMember)(handler)
+}
 
-		handler.ServeHTTP(w, r)
-	})
+func (a *AuthzMiddleware) IsWorkspaceAdmin(handler http.Handler) http.Handler {
+	return a.NewAuthorizationTypeMiddleware(authEnums.WorkspaceAdmin)(handler)
 }
 
 func (a *AuthzMiddleware) IsRepositoryMember(handler http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		response, err := a.grpcClient.IsAuthorized(a.ctx, a.setAuthorizedData(r, authEnums.RepositoryMember))
-		if a.checkIsAuthorizedResponse(err, response, w, r, authEnums.RepositoryMember) != nil {
+	return a.NewAuthorizationTypeMiddleware(authEnums.RepositoryMember)(handler)
+}
+
+func (a *AuthzMiddleware) IsRepositorySupervisor(handler http.Handler) http.Handler {
+	return a.NewAuthorizationTypeMiddleware(authEnums.RepositorySupervisor)(handler)
+}
+
+func (a *AuthzMiddleware) IsRepositoryAdmin(handler http.Handler) http.Handler {
+	return a.NewAuthorizationTypeMiddleware(authEnums.RepositoryAdmin)(handler)
+}
+
+// NewRoleMiddleware returns the middleware that checks the role on the scope of the route, which is the repository
+// when the route has a repository id and the workspace when it has a workspace id. Any role known by the auth service
+// can be checked, like an auditor role added by a downstream service. Only the application admin role is checked
+// on the routes without ids, the other roles are rejected there since they would be checked as application roles.
+func (a *AuthzMiddleware) NewRoleMiddleware(role account.Role) func(http.Handler) http.Handler {
+	return func(handler http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			scope := a.getScope(r)
+			if scope == authEnums.ScopeApplication && role != account.ApplicationAdmin {
+				a.roleWithoutScopeResponse(w, r, role)
+
+				return
+			}
+
+			a.serveAuthorized(w, r, handler, authEnums.NewAuthorizationType(scope, role))
+		})
+	}
+}
+
+func (a *AuthzMiddleware) roleWithoutScopeResponse(w http.ResponseWriter, r *http.Request, role account.Role) {
+	logger.FromContext(r.Context()).LogError(fmt.Sprintf(enums.MessageRoleWithoutScope, role, r.URL, r.Method),
+		enums.ErrorRoleWithoutScope)
+
+	httpUtil.StatusInternalServerError(w, enums.ErrorFailedToVerifyRequest)
+}
+
+// NewAuthorizationTypeMiddleware returns the middleware that checks the authorization type on the auth service. The
+// application admin is only checked when it is enabled on the auth config, otherwise every account is allowed.
+func (a *AuthzMiddleware) NewAuthorizationTypeMiddleware(
+	authorizationType authEnums.AuthorizationType) func(http.Handler) http.Handler {
+	return func(handler http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			a.serveAuthorized(w, r, handler, authorizationType)
+		})
+	}
+}
+
+func (a *AuthzMiddleware) serveAuthorized(w http.ResponseWriter, r *http.Request, handler http.Handler,
+	authorizationType authEnums.AuthorizationType) {
+	if authorizationType == authEnums.ApplicationAdmin {
+		authConfig, err := a.getAuthConfig(r.Context())
+		if a.checkGetConfigResponse(err, w, r) != nil {
 			return
 		}
 
-		handler.ServeHTTP(w, r)
-	})
-}
+		if !authConfig.EnableApplicationAdmin {
+			handler.ServeHTTP(w, a.setAccountIDInContext(r))
 
-func (a *AuthzMiddleware) IsRepositorySupervisor(handler http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		response, err := a.grpcClient.IsAuthorized(a.ctx, a.setAuthorizedData(r, authEnums.RepositorySupervisor))
-		if a.checkIsAuthorizedResponse(err, response, w, r, authEnums.RepositorySupervisor) != nil {
 			return
 		}
+	}
 
-		handler.ServeHTTP(w, r)
-	})
+	response, err := a.isAuthorized(r, authorizationType)
+	if a.checkIsAuthorizedResponse(err, response, w, r, authorizationType) != nil {
+		return
+	}
+
+	handler.ServeHTTP(w, a.setAccountIDInContext(r))
 }
 
-func (a *AuthzMiddleware) IsRepositoryAdmin(handler http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		response, err := a.grpcClient.IsAuthorized(a.ctx, a.setAuthorizedData(r, authEnums.RepositoryAdmin))
-		if a.checkIsAuthorizedResponse(err, response, w, r, authEnums.RepositoryAdmin) != nil {
-			return
+func (a *AuthzMiddleware) getScope(r *http.Request) authEnums.Scope {
+	switch {
+	case getURLParam(r, enums.RepositoryID) != "":
+		return authEnums.ScopeRepository
+	case getURLParam(r, enums.WorkspaceID) != "":
+		return authEnums.ScopeWorkspace
+	default:
+		return authEnums.ScopeApplication
+	}
+}
+
+// getURLParam ignores the case of the param name, so the routes declaring {workspaceId} are still scoped
+func getURLParam(r *http.Request, name string) string {
+	if value := chi.URLParam(r, name); value != "" {
+		return value
+	}
+
+	routeContext := chi.RouteContext(r.Context())
+	if routeContext == nil {
+		return ""
+	}
+
+	for index, key := range routeContext.URLParams.Keys {
+		if strings.EqualFold(key, name) && index < len(routeContext.URLParams.Values) {
+			return routeContext.URLParams.Values[index]
 		}
+	}
 
-		handler.ServeHTTP(w, r)
+	return ""
+}
+
+// isAuthorized returns the cached decision of the token, role, workspace and repository when there is one, keying the
+// cache by the hash of them, so the tokens are not kept on the cache. The errors of the auth service are not cached.
+// The concurrent requests share one call, which is not canceled by the first request but is limited by the timeout.
+func (a *AuthzMiddleware) isAuthorized(r *http.Request,
+	isAuthorizedType authEnums.AuthorizationType) (*proto.IsAuthorizedResponse, error) {
+	data := a.setAuthorizedData(r, isAuthorizedType)
+	if a.decisions == nil {
+		return a.callIsAuthorized(r.Context(), data)
+	}
+
+	key := crypto.GenerateSHA256(data.GetToken(), enums.AuthzCacheKeySeparator, data.GetType(),
+		enums.AuthzCacheKeySeparator, data.GetWorkspaceID(), enums.AuthzCacheKeySeparator, data.GetRepositoryID())
+
+	isAuthorized, err := a.decisions.GetOrLoad(r.Context(), key, func(ctx context.Context) (bool, error) {
+		response, err := a.callIsAuthorized(ctx, data)
+
+		return response.GetIsAuthorized(), err
 	})
+
+	return &proto.IsAuthorizedResponse{IsAuthorized: isAuthorized}, err
+}
+
+func (a *AuthzMiddleware) callIsAuthorized(ctx context.Context,
+	data *proto.IsAuthorizedData) (*proto.IsAuthorizedResponse, error) {
+	ctx, cancel := a.callContext(outgoingContextWithRequestID(ctx))
+	defer cancel()
+
+	if a.authorizer != nil {
+		isAuthorized, err := a.authorizer.IsAuthorized(ctx, data)
+
+		return &proto.IsAuthorizedResponse{IsAuthorized: isAuthorized}, err
+	}
+
+	return a.grpcClient.IsAuthorized(ctx, data)
+}
+
+// callContext derives the context of a call to the auth service from the request context, limited by the timeout
+func (a *AuthzMiddleware) callContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if a.callTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, a.callTimeout)
 }
 
 func (a *AuthzMiddleware) setAuthorizedData(r *http.Request,
@@ -131,15 +346,15 @@ func (a *AuthzMiddleware) setAuthorizedData(r *http.Request,
 	return &proto.IsAuthorizedData{
 		Token:        a.getJWTToken(r),
 		Type:         isAuthorizedType.ToString(),
-		WorkspaceID:  chi.URLParam(r, enums.WorkspaceID),
-		RepositoryID: chi.URLParam(r, enums.RepositoryID),
+		WorkspaceID:  getURLParam(r, enums.WorkspaceID),
+		RepositoryID: getURLParam(r, enums.RepositoryID),
 	}
 }
 
 func (a *AuthzMiddleware) checkIsAuthorizedResponse(err error, response *proto.IsAuthorizedResponse,
 	w http.ResponseWriter, r *http.Request, isAuthorizedType authEnums.AuthorizationType) error {
 	if err != nil {
-		a.errResponse(w, err)
+		a.errResponse(w, r, err)
 
 		return enums.ErrorFailedToVerifyRequest
 	}
@@ -153,8 +368,8 @@ func (a *AuthzMiddleware) checkIsAuthorizedResponse(err error, response *proto.I
 	return nil
 }
 
-func (a *AuthzMiddleware) errResponse(w http.ResponseWriter, err error) {
-	logger.LogError(enums.MessageIsAuthorizedGRPCRequestError, err)
+func (a *AuthzMiddleware) errResponse(w http.ResponseWriter, r *http.Request, err error) {
+	logger.FromContext(r.Context()).LogError(enums.MessageIsAuthorizedGRPCRequestError, err)
 
 	httpUtil.StatusInternalServerError(w, enums.ErrorFailedToVerifyRequest)
 }
@@ -167,14 +382,14 @@ func (a *AuthzMiddleware) unauthorizedResponse(w http.ResponseWriter, r *http.Re
 }
 
 func (a *AuthzMiddleware) logHTTPRequestError(r *http.Request, isAuthorizedType authEnums.AuthorizationType) {
-	logger.LogWarn(fmt.Sprintf(enums.MessageUnauthorizedHTTPRequest, a.getAccountID(r),
+	logger.FromContext(r.Context()).LogWarn(fmt.Sprintf(enums.MessageUnauthorizedHTTPRequest, a.getAccountID(r),
 		r.URL, r.Method, isAuthorizedType))
 }
 
 func (a *AuthzMiddleware) getAccountID(r *http.Request) string {
 	accountID, err := jwt.GetAccountIDByJWTToken(a.getJWTToken(r))
 	if err != nil {
-		logger.LogError(enums.MessageFailedToGetAccountID, err)
+		logger.FromContext(r.Context()).LogError(enums.MessageFailedToGetAccountID, err)
 
 		return uuid.Nil.String()
 	}
@@ -182,13 +397,43 @@ func (a *AuthzMiddleware) getAccountID(r *http.Request) string {
 	return accountID.String()
 }
 
+// getAuthConfig only requests the auth config when the watcher has not received it yet, collapsing the concurrent
+// requests into one. The request that makes the call does not cancel it for the others waiting, but the call is still
+// limited by the timeout.
+func (a *AuthzMiddleware) getAuthConfig(ctx context.Context) (*proto.GetAuthConfigResponse, error) {
+	if a.authConfigWatcher != nil {
+		if authConfig, ok := a.authConfigWatcher.GetAuthConfig(); ok {
+			return authConfig, nil
+		}
+	}
+
+	authConfig, _, err := a.authConfigGroup.Do(ctx, enums.AuthConfigSingleflightKey,
+		func(ctx context.Context) (*proto.GetAuthConfigResponse, error) {
+			ctx, cancel := a.callContext(ctx)
+			defer cancel()
+
+			return a.grpcClient.GetAuthConfig(ctx, &proto.GetAuthConfigData{})
+		})
+
+	return authConfig, err
+}
+
+func (a *AuthzMiddleware) setAccountIDInContext(r *http.Request) *http.Request {
+	accountID, err := jwt.GetAccountIDByJWTToken(a.getJWTToken(r))
+	if err != nil {
+		return r
+	}
+
+	return r.WithContext(jwt.ContextWithAccountID(r.Context(), accountID))
+}
+
 func (a *AuthzMiddleware) getJWTToken(r *http.Request) string {
-	return r.Header.Get(jwtEnums.HorusecJWTHeader)
+	return jwt.GetTokenFromRequestWithPrecedence(r, a.tokenPrecedence)
 }
 
-func (a *AuthzMiddleware) checkGetConfigResponse(err error, w http.ResponseWriter) error {
+func (a *AuthzMiddleware) checkGetConfigResponse(err error, w http.ResponseWriter, r *http.Request) error {
 	if err != nil {
-		logger.LogError(enums.MessageFailedToGetAuthConfig, err)
+		logger.FromContext(r.Context()).LogError(enums.MessageFailedToGetAuthConfig, err)
 		httpUtil.StatusInternalServerError(w, enums.ErrorWhenGettingAuthConfig)
 
 		return enums.ErrorWhenGettingAuthConfig
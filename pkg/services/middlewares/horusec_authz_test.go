@@ -15,18 +15,25 @@
 package middlewares
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/go-chi/chi"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
+	"github.com/ZupIT/horusec-devkit/pkg/enums/account"
 	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares/enums"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt/entities"
+	jwtEnums "github.com/ZupIT/horusec-devkit/pkg/utils/jwt/enums"
 )
 
 func testHandler(w http.ResponseWriter, _ *http.Request) {
@@ -48,6 +55,22 @@ func TestNewAuthzMiddleware(t *testing.T) {
 	t.Run("should success create a new middleware service", func(t *testing.T) {
 		assert.NotNil(t, NewAuthzMiddleware(&grpc.ClientConn{}))
 	})
+
+	t.Run("should only watch the auth config when the option is informed", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		assert.Nil(t, NewAuthzMiddleware(&grpc.ClientConn{}).(*AuthzMiddleware).authConfigWatcher)
+		assert.NotNil(t, NewAuthzMiddleware(&grpc.ClientConn{},
+			WithAuthConfigWatcher(ctx)).(*AuthzMiddleware).authConfigWatcher)
+	})
+
+	t.Run("should implement the optional authorization interfaces", func(t *testing.T) {
+		middleware := NewAuthzMiddleware(&grpc.ClientConn{})
+
+		assert.Implements(t, (*IRoleAuthzMiddleware)(nil), middleware)
+		assert.Implements(t, (*IApplicationAdminAuthzMiddleware)(nil), middleware)
+	})
 }
 
 func TestIsWorkspaceMember(t *testing.T) {
@@ -505,6 +528,28 @@ func TestIsRepositoryAdmin(t *testing.T) {
 	})
 }
 
+func TestSetAccountIDInContext(t *testing.T) {
+	t.Run("should set account id in the request context when valid token", func(t *testing.T) {
+		accountID := uuid.New()
+		token, _, _ := jwt.CreateToken(&entities.TokenData{AccountID: accountID}, nil)
+
+		req, _ := http.NewRequest("GET", "http://test", nil)
+		req.Header.Add("X-Horusec-Authorization", token)
+
+		result, ok := jwt.GetAccountIDFromContext((&AuthzMiddleware{}).setAccountIDInContext(req).Context())
+
+		assert.True(t, ok)
+		assert.Equal(t, accountID, result)
+	})
+
+	t.Run("should keep request unchanged when invalid token", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "http://test", nil)
+		req.Header.Add("X-Horusec-Authorization", "test")
+
+		assert.Equal(t, req, (&AuthzMiddleware{}).setAccountIDInContext(req))
+	})
+}
+
 func TestIsApplicationAdmin(t *testing.T) {
 	t.Run("should return 200 when valid request", func(t *testing.T) {
 		grpcMock := &proto.Mock{}
@@ -625,3 +670,444 @@ func TestIsApplicationAdmin(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
 	})
 }
+
+func TestRequireApplicationAdmin(t *testing.T) {
+	t.Run("should return 401 when application admin is disabled", func(t *testing.T) {
+		grpcMock := &proto.Mock{}
+		grpcMock.On("GetAuthConfig").Return(&proto.GetAuthConfigResponse{EnableApplicationAdmin: false}, nil)
+
+		handler := (&AuthzMiddleware{grpcClient: grpcMock}).RequireApplicationAdmin(http.HandlerFunc(testHandler))
+
+		req, _ := http.NewRequest("GET", "http://test", nil)
+		req.Header.Add("X-Horusec-Authorization", createValidToken())
+
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		grpcMock.AssertNotCalled(t, "IsAuthorized")
+	})
+
+	t.Run("should return 200 when application admin", func(t *testing.T) {
+		grpcMock := &proto.Mock{}
+		grpcMock.On("GetAuthConfig").Return(&proto.GetAuthConfigResponse{EnableApplicationAdmin: true}, nil)
+		grpcMock.On("IsAuthorized").Return(&proto.IsAuthorizedResponse{IsAuthorized: true}, nil)
+
+		handler := (&AuthzMiddleware{grpcClient: grpcMock}).RequireApplicationAdmin(http.HandlerFunc(testHandler))
+
+		req, _ := http.NewRequest("GET", "http://test", nil)
+		req.Header.Add("X-Horusec-Authorization", createValidToken())
+
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
+type authConfigWatcherStub struct {
+	authConfig *proto.GetAuthConfigResponse
+}
+
+func (a *authConfigWatcherStub) Start(_ context.Context) {}
+
+func (a *authConfigWatcherStub) GetAuthConfig() (*proto.GetAuthConfigResponse, bool) {
+	return a.authConfig, a.authConfig != nil
+}
+
+func TestGetAuthConfig(t *testing.T) {
+	t.Run("should return auth config from watcher without calling auth service", func(t *testing.T) {
+		grpcMock := &proto.Mock{}
+
+		middleware := AuthzMiddleware{
+			grpcClient:        grpcMock,
+			authConfigWatcher: &authConfigWatcherStub{authConfig: &proto.GetAuthConfigResponse{AuthType: "test"}},
+		}
+
+		authConfig, err := middleware.getAuthConfig(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "test", authConfig.AuthType)
+		grpcMock.AssertNotCalled(t, "GetAuthConfig")
+	})
+
+	t.Run("should request auth config when watcher has not received it yet", func(t *testing.T) {
+		grpcMock := &proto.Mock{}
+		grpcMock.On("GetAuthConfig").Return(&proto.GetAuthConfigResponse{AuthType: "ldap"}, nil)
+
+		middleware := AuthzMiddleware{
+			grpcClient:        grpcMock,
+			authConfigWatcher: &authConfigWatcherStub{},
+		}
+
+		authConfig, err := middleware.getAuthConfig(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "ldap", authConfig.AuthType)
+	})
+}
+
+func TestDecisionCache(t *testing.T) {
+	newRequest := func(token, repositoryID string) *http.Request {
+		routeContext := chi.NewRouteContext()
+		routeContext.URLParams.Add(enums.RepositoryID, repositoryID)
+
+		req, _ := http.NewRequest("GET", "http://test", nil)
+		req.Header.Add("X-Horusec-Authorization", token)
+
+		return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, routeContext))
+	}
+
+	t.Run("should reuse cached decision of the same token, role and repository", func(t *testing.T) {
+		grpcMock := &proto.Mock{}
+		grpcMock.On("IsAuthorized").Return(&proto.IsAuthorizedResponse{IsAuthorized: true}, nil)
+
+		middleware := &AuthzMiddleware{grpcClient: grpcMock,
+			decisions: newDecisionCache(time.Minute)}
+		handler := middleware.IsRepositoryMember(http.HandlerFunc(testHandler))
+		token := createValidToken()
+
+		for _, repositoryID := range []string{"first", "first", "second"} {
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, newRequest(token, repositoryID))
+			assert.Equal(t, http.StatusOK, w.Code)
+		}
+
+		grpcMock.AssertNumberOfCalls(t, "IsAuthorized", 2)
+	})
+
+	t.Run("should not cache errors of the auth service", func(t *testing.T) {
+		grpcMock := &proto.Mock{}
+		grpcMock.On("IsAuthorized").Return(&proto.IsAuthorizedResponse{}, errors.New("test"))
+
+		middleware := &AuthzMiddleware{grpcClient: grpcMock,
+			decisions: newDecisionCache(time.Minute)}
+		handler := middleware.IsRepositoryMember(http.HandlerFunc(testHandler))
+		token := createValidToken()
+
+		for i := 0; i < 2; i++ {
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, newRequest(token, "test"))
+			assert.Equal(t, http.StatusInternalServerError, w.Code)
+		}
+
+		grpcMock.AssertNumberOfCalls(t, "IsAuthorized", 2)
+	})
+
+	t.Run("should not fail the waiting requests when the first request is canceled", func(t *testing.T) {
+		release := make(chan struct{})
+		authorizer := &blockingAuthorizer{release: release, err: make(chan error, 1)}
+		middleware := &AuthzMiddleware{authorizer: authorizer, decisions: newDecisionCache(time.Minute)}
+		handler := middleware.IsRepositoryMember(http.HandlerFunc(testHandler))
+		token := createValidToken()
+
+		req := newRequest(token, "test")
+		ctx, cancel := context.WithCancel(req.Context())
+		first := httptest.NewRecorder()
+		served := make(chan struct{})
+
+		go func() {
+			handler.ServeHTTP(first, req.WithContext(ctx))
+			close(served)
+		}()
+
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+
+		select {
+		case <-served:
+		case <-time.After(time.Second):
+			assert.Fail(t, "the canceled request kept waiting for the auth service")
+		}
+
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			close(release)
+		}()
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, newRequest(token, "test"))
+
+		assert.Equal(t, http.StatusInternalServerError, first.Code)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NoError(t, <-authorizer.err)
+	})
+
+	t.Run("should only create cache when ttl is greater than zero", func(t *testing.T) {
+		assert.Nil(t, newDecisionCache(0))
+		assert.NotNil(t, newDecisionCache(time.Second))
+	})
+}
+
+// contextRecorder keeps the context and the data received by the auth service to check what was derived from the
+// request
+type contextRecorder struct {
+	proto.AuthServiceClient
+	ctx  context.Context
+	data *proto.IsAuthorizedData
+}
+
+func (c *contextRecorder) IsAuthorized(ctx context.Context, data *proto.IsAuthorizedData,
+	_ ...grpc.CallOption) (*proto.IsAuthorizedResponse, error) {
+	c.ctx = ctx
+	c.data = data
+
+	return &proto.IsAuthorizedResponse{IsAuthorized: ctx.Err() == nil}, ctx.Err()
+}
+
+type contextKey struct{}
+
+func TestRequestContext(t *testing.T) {
+	newRequest := func(ctx context.Context) *http.Request {
+		req, _ := http.NewRequestWithContext(ctx, "GET", "http://test", nil)
+		req.Header.Add("X-Horusec-Authorization", createValidToken())
+
+		return req
+	}
+
+	t.Run("should call the auth service with the request context and the call timeout", func(t *testing.T) {
+		recorder := &contextRecorder{}
+		middleware := &AuthzMiddleware{grpcClient: recorder}
+		WithCallTimeout(time.Minute)(middleware)
+
+		w := httptest.NewRecorder()
+		ctx := context.WithValue(context.Background(), contextKey{}, "test")
+		middleware.IsWorkspaceMember(http.HandlerFunc(testHandler)).ServeHTTP(w, newRequest(ctx))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "test", recorder.ctx.Value(contextKey{}))
+
+		deadline, ok := recorder.ctx.Deadline()
+		assert.True(t, ok)
+		assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, time.Second)
+	})
+
+	t.Run("should not set a deadline without call timeout", func(t *testing.T) {
+		recorder := &contextRecorder{}
+		middleware := &AuthzMiddleware{grpcClient: recorder, decisions: newDecisionCache(time.Minute)}
+
+		w := httptest.NewRecorder()
+		middleware.IsWorkspaceMember(http.HandlerFunc(testHandler)).ServeHTTP(w, newRequest(context.Background()))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		_, ok := recorder.ctx.Deadline()
+		assert.False(t, ok)
+	})
+
+	t.Run("should propagate the cancellation of the request", func(t *testing.T) {
+		recorder := &contextRecorder{}
+		middleware := &AuthzMiddleware{grpcClient: recorder}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		w := httptest.NewRecorder()
+		middleware.IsWorkspaceMember(http.HandlerFunc(testHandler)).ServeHTTP(w, newRequest(ctx))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.ErrorIs(t, recorder.ctx.Err(), context.Canceled)
+	})
+}
+
+func TestNewRoleMiddleware(t *testing.T) {
+	newRequest := func(params map[string]string) *http.Request {
+		routeContext := chi.NewRouteContext()
+		for key, value := range params {
+			routeContext.URLParams.Add(key, value)
+		}
+
+		req, _ := http.NewRequest("GET", "http://test", nil)
+		req.Header.Add("X-Horusec-Authorization", createValidToken())
+
+		return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, routeContext))
+	}
+
+	t.Run("should check the role on the scope of the route", func(t *testing.T) {
+		for expected, params := range map[string]map[string]string{
+			"IsRepositoryAuditor": {enums.WorkspaceID: "workspace", enums.RepositoryID: "repository"},
+			"IsWorkspaceAuditor":  {enums.WorkspaceID: "workspace"},
+		} {
+			recorder := &contextRecorder{}
+			middleware := &AuthzMiddleware{grpcClient: recorder}
+
+			w := httptest.NewRecorder()
+			middleware.NewRoleMiddleware("auditor")(http.HandlerFunc(testHandler)).ServeHTTP(w, newRequest(params))
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, expected, recorder.data.GetType())
+		}
+	})
+
+	t.Run("should ignore the case of the route params", func(t *testing.T) {
+		recorder := &contextRecorder{}
+		middleware := &AuthzMiddleware{grpcClient: recorder}
+
+		w := httptest.NewRecorder()
+		middleware.NewRoleMiddleware(account.Admin)(http.HandlerFunc(testHandler)).
+			ServeHTTP(w, newRequest(map[string]string{"workspaceId": "workspace"}))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "IsWorkspaceAdmin", recorder.data.GetType())
+		assert.Equal(t, "workspace", recorder.data.GetWorkspaceID())
+	})
+
+	t.Run("should reject the roles that fall back to the application scope", func(t *testing.T) {
+		grpcMock := &proto.Mock{}
+		grpcMock.On("GetAuthConfig").Return(&proto.GetAuthConfigResponse{EnableApplicationAdmin: false}, nil)
+
+		middleware := &AuthzMiddleware{grpcClient: grpcMock}
+
+		for _, role := range []account.Role{account.Admin, "auditor"} {
+			w := httptest.NewRecorder()
+			middleware.NewRoleMiddleware(role)(http.HandlerFunc(testHandler)).ServeHTTP(w, newRequest(nil))
+
+			assert.Equal(t, http.StatusInternalServerError, w.Code)
+		}
+
+		grpcMock.AssertNotCalled(t, "GetAuthConfig")
+		grpcMock.AssertNotCalled(t, "IsAuthorized")
+	})
+
+	t.Run("should allow the application admin role when it is disabled", func(t *testing.T) {
+		grpcMock := &proto.Mock{}
+		grpcMock.On("GetAuthConfig").Return(&proto.GetAuthConfigResponse{EnableApplicationAdmin: false}, nil)
+
+		middleware := &AuthzMiddleware{grpcClient: grpcMock}
+
+		w := httptest.NewRecorder()
+		middleware.NewRoleMiddleware(account.ApplicationAdmin)(http.HandlerFunc(testHandler)).
+			ServeHTTP(w, newRequest(nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		grpcMock.AssertNotCalled(t, "IsAuthorized")
+	})
+
+	t.Run("should return unauthorized when the role is not authorized", func(t *testing.T) {
+		grpcMock := &proto.Mock{}
+		grpcMock.On("IsAuthorized").Return(&proto.IsAuthorizedResponse{IsAuthorized: false}, nil)
+
+		middleware := &AuthzMiddleware{grpcClient: grpcMock}
+
+		w := httptest.NewRecorder()
+		middleware.NewAuthorizationTypeMiddleware("IsWorkspaceAuditor")(http.HandlerFunc(testHandler)).
+			ServeHTTP(w, newRequest(map[string]string{enums.WorkspaceID: "workspace"}))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+type blockingAuthorizer struct {
+	release chan struct{}
+	err     chan error
+}
+
+func (b *blockingAuthorizer) IsAuthorized(ctx context.Context, _ *proto.IsAuthorizedData) (bool, error) {
+	<-b.release
+	b.err <- ctx.Err()
+
+	return true, nil
+}
+
+type authorizerStub struct {
+	data *proto.IsAuthorizedData
+	err  error
+}
+
+func (a *authorizerStub) IsAuthorized(_ context.Context, data *proto.IsAuthorizedData) (bool, error) {
+	a.data = data
+
+	return data.GetType() == "IsWorkspaceMember", a.err
+}
+
+func TestWithAuthorizer(t *testing.T) {
+	newRequest := func() *http.Request {
+		req, _ := http.NewRequest("GET", "http://test", nil)
+		req.Header.Add("X-Horusec-Authorization", createValidToken())
+
+		return req
+	}
+
+	t.Run("should check the authorization with the authorizer instead of the auth service", func(t *testing.T) {
+		grpcMock := &proto.Mock{}
+		authorizer := &authorizerStub{}
+		middleware := &AuthzMiddleware{grpcClient: grpcMock}
+		WithAuthorizer(authorizer)(middleware)
+
+		w := httptest.NewRecorder()
+		middleware.IsWorkspaceMember(http.HandlerFunc(testHandler)).ServeHTTP(w, newRequest())
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		w = httptest.NewRecorder()
+		middleware.IsWorkspaceAdmin(http.HandlerFunc(testHandler)).ServeHTTP(w, newRequest())
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+		assert.Equal(t, "IsWorkspaceAdmin", authorizer.data.GetType())
+		grpcMock.AssertNotCalled(t, "IsAuthorized")
+	})
+
+	t.Run("should return internal server error when the authorizer fails", func(t *testing.T) {
+		middleware := &AuthzMiddleware{grpcClient: &proto.Mock{}}
+		WithAuthorizer(&authorizerStub{err: errors.New("test")})(middleware)
+
+		w := httptest.NewRecorder()
+		middleware.IsWorkspaceMember(http.HandlerFunc(testHandler)).ServeHTTP(w, newRequest())
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestWithTokenPrecedence(t *testing.T) {
+	t.Run("should send the token of the standard authorization header to the auth service", func(t *testing.T) {
+		token := createValidToken()
+		recorder := &contextRecorder{}
+		middleware := &AuthzMiddleware{grpcClient: recorder}
+
+		req, _ := http.NewRequest("GET", "http://test", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		middleware.IsWorkspaceMember(http.HandlerFunc(testHandler)).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, token, recorder.data.GetToken())
+	})
+
+	t.Run("should check the header of the precedence first", func(t *testing.T) {
+		token := createValidToken()
+		recorder := &contextRecorder{}
+		middleware := &AuthzMiddleware{grpcClient: recorder}
+		WithTokenPrecedence(jwtEnums.PrecedenceAuthorizationHeader)(middleware)
+
+		req, _ := http.NewRequest("GET", "http://test", nil)
+		req.Header.Add("X-Horusec-Authorization", createValidToken())
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		middleware.IsWorkspaceMember(http.HandlerFunc(testHandler)).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, token, recorder.data.GetToken())
+	})
+}
+
+func TestRequestIDPropagation(t *testing.T) {
+	t.Run("should send the request id to the auth service", func(t *testing.T) {
+		recorder := &contextRecorder{}
+		middleware := &AuthzMiddleware{grpcClient: recorder}
+
+		req, _ := http.NewRequest("GET", "http://test", nil)
+		req.Header.Add("X-Horusec-Authorization", createValidToken())
+		req.Header.Add("X-Request-Id", "test")
+
+		w := httptest.NewRecorder()
+		RequestIDMiddleware(middleware.IsWorkspaceMember(http.HandlerFunc(testHandler))).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		outgoing, _ := metadata.FromOutgoingContext(recorder.ctx)
+		assert.Equal(t, []string{"test"}, outgoing.Get("x-request-id"))
+	})
+}
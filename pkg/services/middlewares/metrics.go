@@ -0,0 +1,86 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middlewares
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/chi/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+
+	sharedMetrics "github.com/ZupIT/horusec-devkit/pkg/services/metrics"
+	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares/enums"
+)
+
+type httpMetrics struct {
+	handled *prometheus.CounterVec
+	latency *prometheus.HistogramVec
+}
+
+func newHTTPMetrics() *httpMetrics {
+	labels := []string{enums.MetricsLabelMethod, enums.MetricsLabelRoute, enums.MetricsLabelCode}
+
+	return &httpMetrics{
+		handled: sharedMetrics.NewCounterVec("http_requests_total", "Total of http requests completed by the server.",
+			labels...),
+		latency: sharedMetrics.NewHistogramVec("http_request_duration_seconds",
+			"Latency of http requests handled by the server.", sharedMetrics.LatencyBuckets, labels...),
+	}
+}
+
+// MetricsMiddleware labels the requests by the route pattern instead of the path, since the path params would create
+// a new time series for each workspace and repository
+func MetricsMiddleware(next http.Handler) http.Handler {
+	metrics := newHTTPMetrics()
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start, completed := time.Now(), false
+		writer := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+		defer func() {
+			labels := []string{r.Method, getRoutePattern(r), strconv.Itoa(getStatus(writer, completed))}
+			metrics.handled.WithLabelValues(labels...).Inc()
+			metrics.latency.WithLabelValues(labels...).Observe(time.Since(start).Seconds())
+		}()
+
+		next.ServeHTTP(writer, r)
+
+		completed = true
+	})
+}
+
+func getRoutePattern(r *http.Request) string {
+	if routeContext := chi.RouteContext(r.Context()); routeContext != nil && routeContext.RoutePattern() != "" {
+		return routeContext.RoutePattern()
+	}
+
+	return enums.UnknownRoute
+}
+
+// getStatus returns internal server error when the handler panics before writing the response, and ok when the
+// handler returns without writing, which is the status sent by the http server
+func getStatus(writer middleware.WrapResponseWriter, completed bool) int {
+	switch {
+	case writer.Status() != 0:
+		return writer.Status()
+	case completed:
+		return http.StatusOK
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsMiddleware(t *testing.T) {
+	t.Run("should count requests by route pattern and status", func(t *testing.T) {
+		router := chi.NewRouter()
+		router.Use(MetricsMiddleware)
+		router.Get("/workspaces/{workspaceID}", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		})
+		router.Get("/empty", func(w http.ResponseWriter, r *http.Request) {})
+
+		for _, path := range []string{"/workspaces/1", "/workspaces/2", "/empty"} {
+			req, _ := http.NewRequest(http.MethodGet, path, nil)
+			router.ServeHTTP(httptest.NewRecorder(), req)
+		}
+
+		handled := newHTTPMetrics().handled
+
+		assert.Equal(t, float64(2), testutil.ToFloat64(
+			handled.WithLabelValues(http.MethodGet, "/workspaces/{workspaceID}", "204")))
+		assert.Equal(t, float64(1), testutil.ToFloat64(handled.WithLabelValues(http.MethodGet, "/empty", "200")))
+	})
+
+	t.Run("should count panics as internal server error", func(t *testing.T) {
+		handler := MetricsMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			panic("test")
+		}))
+
+		req, _ := http.NewRequest(http.MethodPost, "/panic", nil)
+		assert.Panics(t, func() {
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+		})
+
+		assert.Equal(t, float64(1), testutil.ToFloat64(
+			newHTTPMetrics().handled.WithLabelValues(http.MethodPost, "unknown", "500")))
+	})
+
+	t.Run("should return the metrics already registered", func(t *testing.T) {
+		assert.Same(t, newHTTPMetrics().handled, newHTTPMetrics().handled)
+		assert.Same(t, newHTTPMetrics().latency, newHTTPMetrics().latency)
+	})
+}
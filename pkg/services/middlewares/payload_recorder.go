@@ -0,0 +1,301 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middlewares
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/middleware"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/sanitize"
+	sanitizeEnums "github.com/ZupIT/horusec-devkit/pkg/utils/sanitize/enums"
+	uuidUtils "github.com/ZupIT/horusec-devkit/pkg/utils/uuid"
+)
+
+// PayloadRecorderOptions configures the payload recorder, which is disabled by default. The sample percent is the
+// percentage of successful requests recorded, while the requests with a status code greater or equal than 400 are
+// always recorded, and only the first max body size bytes of each body are kept.
+type PayloadRecorderOptions struct {
+	Enabled       bool
+	SamplePercent int
+	Capacity      int
+	MaxBodySize   int
+	ExcludedPaths []string
+}
+
+func NewPayloadRecorderOptions() *PayloadRecorderOptions {
+	return &PayloadRecorderOptions{
+		Enabled: env.GetEnvOrDefaultBool(enums.HorusecPayloadRecorderEnabled, false),
+		SamplePercent: env.GetEnvOrDefaultInt(enums.HorusecPayloadRecorderSamplePercent,
+			enums.DefaultPayloadRecorderSamplePercent),
+		Capacity: env.GetEnvOrDefaultInt(enums.HorusecPayloadRecorderCapacity, enums.DefaultPayloadRecorderCapacity),
+		MaxBodySize: env.GetEnvOrDefaultInt(enums.HorusecPayloadRecorderMaxBodySize,
+			enums.DefaultPayloadRecorderMaxBodySize),
+		ExcludedPaths: getExcludedPaths(),
+	}
+}
+
+// PayloadRecording has the sanitized request and response of a recorded request. The secret fields of json and form
+// bodies, the secret headers and the secret query parameters are masked, and the bodies of other content types
+// than json, forms and text are omitted.
+type PayloadRecording struct {
+	ID                string            `json:"id"`
+	RecordedAt        time.Time         `json:"recordedAt"`
+	Method            string            `json:"method"`
+	Route             string            `json:"route"`
+	Path              string            `json:"path"`
+	Query             string            `json:"query"`
+	Status            int               `json:"status"`
+	LatencyMs         int64             `json:"latencyMs"`
+	RequestID         string            `json:"requestID"`
+	RequestHeaders    map[string]string `json:"requestHeaders"`
+	RequestBody       string            `json:"requestBody"`
+	RequestTruncated  bool              `json:"requestTruncated"`
+	ResponseHeaders   map[string]string `json:"responseHeaders"`
+	ResponseBody      string            `json:"responseBody"`
+	ResponseTruncated bool              `json:"responseTruncated"`
+}
+
+type IPayloadRecorder interface {
+	Middleware(next http.Handler) http.Handler
+	GetRecordings() []*PayloadRecording
+	Clear()
+}
+
+// PayloadRecorder keeps the last recordings on a ring buffer, so the memory used is limited by the capacity and the
+// max body size
+type PayloadRecorder struct {
+	options    *PayloadRecorderOptions
+	mutex      sync.RWMutex
+	recordings []*PayloadRecording
+	next       int
+}
+
+func NewPayloadRecorder(options *PayloadRecorderOptions) IPayloadRecorder {
+	return &PayloadRecorder{options: options, recordings: make([]*PayloadRecording, 0, options.Capacity)}
+}
+
+// Middleware should run after the request id middleware and before the handlers that read the body, it does nothing
+// when the recorder is disabled
+func (p *PayloadRecorder) Middleware(next http.Handler) http.Handler {
+	if !p.options.Enabled || p.options.Capacity <= 0 {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isExcludedPath(p.options.ExcludedPaths, r.URL.Path) {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		start, sampled := time.Now(), p.isSampled()
+		request, response := newCappedBuffer(p.options.MaxBodySize), newCappedBuffer(p.options.MaxBodySize)
+		r.Body = &teeBody{Reader: io.TeeReader(r.Body, request), Closer: r.Body}
+		writer := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		writer.Tee(response)
+
+		next.ServeHTTP(writer, r)
+
+		if status := getStatus(writer, true); sampled || status >= http.StatusBadRequest {
+			p.add(p.newRecording(r, writer, request, response, time.Since(start)))
+		}
+	})
+}
+
+// GetRecordings returns the recordings from the most recent to the oldest
+func (p *PayloadRecorder) GetRecordings() []*PayloadRecording {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	recordings := make([]*PayloadRecording, 0, len(p.recordings))
+	for index := 1; index <= len(p.recordings); index++ {
+		recordings = append(recordings, p.recordings[(p.next-index+len(p.recordings))%len(p.recordings)])
+	}
+
+	return recordings
+}
+
+func (p *PayloadRecorder) Clear() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.recordings, p.next = p.recordings[:0], 0
+}
+
+func (p *PayloadRecorder) add(recording *PayloadRecording) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if len(p.recordings) < p.options.Capacity {
+		p.recordings = append(p.recordings, recording)
+	} else {
+		p.recordings[p.next] = recording
+	}
+
+	p.next = (p.next + 1) % p.options.Capacity
+}
+
+func (p *PayloadRecorder) isSampled() bool {
+	// nolint:gosec // sampling does not need a secure random
+	return p.options.SamplePercent >= 100 || rand.Intn(100) < p.options.SamplePercent
+}
+
+func (p *PayloadRecorder) newRecording(r *http.Request, writer middleware.WrapResponseWriter,
+	request, response *cappedBuffer, latency time.Duration) *PayloadRecording {
+	return &PayloadRecording{
+		ID:                uuidUtils.New().String(),
+		RecordedAt:        time.Now(),
+		Method:            r.Method,
+		Route:             getRoutePattern(r),
+		Path:              r.URL.Path,
+		Query:             sanitizeQuery(r.URL.Query()),
+		Status:            getStatus(writer, true),
+		LatencyMs:         latency.Milliseconds(),
+		RequestID:         middleware.GetReqID(r.Context()),
+		RequestHeaders:    sanitizeHeaders(r.Header),
+		RequestBody:       sanitizeBody(r.Header.Get("Content-Type"), request),
+		RequestTruncated:  request.truncated,
+		ResponseHeaders:   sanitizeHeaders(writer.Header()),
+		ResponseBody:      sanitizeBody(writer.Header().Get("Content-Type"), response),
+		ResponseTruncated: response.truncated,
+	}
+}
+
+type teeBody struct {
+	io.Reader
+	io.Closer
+}
+
+// cappedBuffer keeps only the first bytes written, while accepting every write so the request and response are not
+// interrupted
+type cappedBuffer struct {
+	buffer    bytes.Buffer
+	max       int
+	truncated bool
+}
+
+func newCappedBuffer(max int) *cappedBuffer {
+	return &cappedBuffer{max: max}
+}
+
+// Write returns the length of the whole data, since a shorter length without error breaks the io.Writer contract
+func (c *cappedBuffer) Write(data []byte) (int, error) {
+	written := len(data)
+
+	if remaining := c.max - c.buffer.Len(); remaining < len(data) {
+		c.truncated = true
+		data = data[:max(remaining, 0)]
+	}
+
+	c.buffer.Write(data)
+
+	return written, nil
+}
+
+func sanitizeHeaders(headers http.Header) map[string]string {
+	sanitized := make(map[string]string, len(headers))
+
+	for name, values := range headers {
+		if sanitize.IsSecretName(name) {
+			sanitized[name] = sanitizeEnums.MaskedValue
+		} else {
+			sanitized[name] = sanitize.StripControlCharacters(strings.Join(values, ", "))
+		}
+	}
+
+	return sanitized
+}
+
+func sanitizeQuery(values url.Values) string {
+	for name := range values {
+		if sanitize.IsSecretName(name) {
+			values[name] = []string{sanitizeEnums.MaskedValue}
+		}
+	}
+
+	return values.Encode()
+}
+
+// sanitizeBody masks the secrets of json and form bodies, the json bodies that can not be decoded, like the truncated
+// ones, are handled as text
+func sanitizeBody(contentType string, body *cappedBuffer) string {
+	if body.buffer.Len() == 0 {
+		return ""
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+
+	switch {
+	case strings.HasSuffix(mediaType, "json"):
+		return sanitizeJSON(mediaType, body)
+	case mediaType == "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(body.buffer.String())
+		if err != nil {
+			return omittedBody(mediaType, body)
+		}
+
+		return sanitizeQuery(values)
+	case strings.HasPrefix(mediaType, "text/"), mediaType == "":
+		return sanitizeText(mediaType, body)
+	default:
+		return omittedBody(mediaType, body)
+	}
+}
+
+func sanitizeJSON(mediaType string, body *cappedBuffer) string {
+	var decoded interface{}
+	if err := json.Unmarshal(body.buffer.Bytes(), &decoded); err != nil {
+		return sanitizeText(mediaType, body)
+	}
+
+	encoded, err := json.Marshal(sanitize.MaskSecrets(decoded))
+	if err != nil {
+		return omittedBody(mediaType, body)
+	}
+
+	return string(encoded)
+}
+
+// sanitizeText omits the bodies with any secret name, since their values can not be found to be masked
+func sanitizeText(mediaType string, body *cappedBuffer) string {
+	for _, word := range strings.FieldsFunc(body.buffer.String(), isNotNameRune) {
+		if sanitize.IsSecretName(word) {
+			return omittedBody(mediaType, body)
+		}
+	}
+
+	return body.buffer.String()
+}
+
+func isNotNameRune(r rune) bool {
+	return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' || r == '-')
+}
+
+func omittedBody(mediaType string, body *cappedBuffer) string {
+	return fmt.Sprintf(enums.OmittedBodyFormat, body.buffer.Len(), mediaType)
+}
@@ -0,0 +1,168 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middlewares
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares/enums"
+	sanitizeEnums "github.com/ZupIT/horusec-devkit/pkg/utils/sanitize/enums"
+)
+
+func newTestPayloadRecorder(samplePercent, capacity int) IPayloadRecorder {
+	return NewPayloadRecorder(&PayloadRecorderOptions{Enabled: true, SamplePercent: samplePercent,
+		Capacity: capacity, MaxBodySize: 64, ExcludedPaths: []string{"/health"}})
+}
+
+func doPayloadRequest(recorder IPayloadRecorder, path, contentType, body string, status int) string {
+	router := chi.NewRouter()
+	router.Use(recorder.Middleware)
+	router.Post("/analysis/{analysisID}", func(w http.ResponseWriter, r *http.Request) {
+		received, _ := io.ReadAll(r.Body)
+
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Set-Cookie", "session=test")
+		w.WriteHeader(status)
+		_, _ = w.Write(received)
+	})
+	router.Post("/health", func(w http.ResponseWriter, r *http.Request) {})
+
+	req, _ := http.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("X-Horusec-Authorization", "test")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	return w.Body.String()
+}
+
+func TestNewPayloadRecorderOptions(t *testing.T) {
+	t.Run("should be disabled by default", func(t *testing.T) {
+		options := NewPayloadRecorderOptions()
+
+		assert.False(t, options.Enabled)
+		assert.Equal(t, enums.DefaultPayloadRecorderCapacity, options.Capacity)
+	})
+
+	t.Run("should read options from env", func(t *testing.T) {
+		t.Setenv(enums.HorusecPayloadRecorderEnabled, "true")
+		t.Setenv(enums.HorusecPayloadRecorderSamplePercent, "50")
+		t.Setenv(enums.HorusecPayloadRecorderMaxBodySize, "1024")
+
+		options := NewPayloadRecorderOptions()
+
+		assert.True(t, options.Enabled)
+		assert.Equal(t, 50, options.SamplePercent)
+		assert.Equal(t, 1024, options.MaxBodySize)
+	})
+}
+
+func TestPayloadRecorderMiddleware(t *testing.T) {
+	t.Run("should record sanitized json payloads without changing the request", func(t *testing.T) {
+		recorder := newTestPayloadRecorder(100, 10)
+
+		body := doPayloadRequest(recorder, "/analysis/1?token=test&page=1", "application/json",
+			`{"repository": "test", "password": "test"}`, http.StatusCreated)
+
+		assert.Equal(t, `{"repository": "test", "password": "test"}`, body)
+
+		recordings := recorder.GetRecordings()
+		assert.Len(t, recordings, 1)
+		assert.Equal(t, "/analysis/{analysisID}", recordings[0].Route)
+		assert.Equal(t, http.StatusCreated, recordings[0].Status)
+		assert.Equal(t, "page=1&token=%2A%2A%2A%2A%2A%2A", recordings[0].Query)
+		assert.Equal(t, sanitizeEnums.MaskedValue, recordings[0].RequestHeaders["X-Horusec-Authorization"])
+		assert.Equal(t, sanitizeEnums.MaskedValue, recordings[0].ResponseHeaders["Set-Cookie"])
+		assert.JSONEq(t, `{"repository": "test", "password": "******"}`, recordings[0].RequestBody)
+		assert.JSONEq(t, `{"repository": "test", "password": "******"}`, recordings[0].ResponseBody)
+	})
+
+	t.Run("should truncate bodies and omit the ones that can not be masked", func(t *testing.T) {
+		recorder := newTestPayloadRecorder(100, 10)
+
+		body := `{"repository": "` + strings.Repeat("a", 100) + `"}`
+		assert.Equal(t, body, doPayloadRequest(recorder, "/analysis/1", "application/json", body, http.StatusOK))
+
+		doPayloadRequest(recorder, "/analysis/1", "text/plain", "client_secret=test", http.StatusOK)
+		doPayloadRequest(recorder, "/analysis/1", "application/x-www-form-urlencoded", "a=1&password=test",
+			http.StatusOK)
+		doPayloadRequest(recorder, "/analysis/1", "application/zip", "test", http.StatusOK)
+
+		recordings := recorder.GetRecordings()
+		assert.Equal(t, "<4 bytes of application/zip omitted>", recordings[0].RequestBody)
+		assert.Equal(t, "a=1&password=%2A%2A%2A%2A%2A%2A", recordings[1].RequestBody)
+		assert.Equal(t, "<18 bytes of text/plain omitted>", recordings[2].RequestBody)
+		assert.Equal(t, body[:64], recordings[3].RequestBody)
+		assert.True(t, recordings[3].RequestTruncated)
+		assert.True(t, recordings[3].ResponseTruncated)
+	})
+
+	t.Run("should record only errors when not sampled and skip excluded paths", func(t *testing.T) {
+		recorder := newTestPayloadRecorder(0, 10)
+
+		doPayloadRequest(recorder, "/analysis/1", "application/json", "{}", http.StatusOK)
+		doPayloadRequest(recorder, "/analysis/1", "application/json", "{", http.StatusBadRequest)
+		doPayloadRequest(recorder, "/health", "application/json", "{}", http.StatusOK)
+
+		recordings := recorder.GetRecordings()
+		assert.Len(t, recordings, 1)
+		assert.Equal(t, "{", recordings[0].RequestBody)
+	})
+
+	t.Run("should keep only the last recordings", func(t *testing.T) {
+		recorder := newTestPayloadRecorder(100, 2)
+
+		for _, body := range []string{"1", "2", "3"} {
+			doPayloadRequest(recorder, "/analysis/1", "text/plain", body, http.StatusOK)
+		}
+
+		recordings := recorder.GetRecordings()
+		assert.Len(t, recordings, 2)
+		assert.Equal(t, "3", recordings[0].RequestBody)
+		assert.Equal(t, "2", recordings[1].RequestBody)
+
+		recorder.Clear()
+		assert.Empty(t, recorder.GetRecordings())
+	})
+
+	t.Run("should not record when disabled", func(t *testing.T) {
+		recorder := NewPayloadRecorder(&PayloadRecorderOptions{Capacity: 10})
+
+		doPayloadRequest(recorder, "/analysis/1", "text/plain", "test", http.StatusBadRequest)
+
+		assert.Empty(t, recorder.GetRecordings())
+	})
+}
+
+func TestCappedBuffer(t *testing.T) {
+	t.Run("should accept the whole data when it is truncated", func(t *testing.T) {
+		buffer := newCappedBuffer(4)
+
+		written, err := io.MultiWriter(io.Discard, buffer).Write([]byte("payload"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, 7, written)
+		assert.Equal(t, "payl", buffer.buffer.String())
+		assert.True(t, buffer.truncated)
+	})
+}
@@ -0,0 +1,45 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/propagation"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/propagation/enums"
+)
+
+func TestPropagationMiddleware(t *testing.T) {
+	t.Run("should set propagated headers in request context", func(t *testing.T) {
+		var values map[string]string
+
+		handler := PropagationMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			values = propagation.GetValuesFromContext(r.Context())
+		}))
+
+		r := httptest.NewRequest(http.MethodGet, "/test", nil)
+		r.Header.Set(enums.HeaderRequestID, "test")
+		r.Header.Set(enums.HeaderHorusecAuthorization, "token")
+
+		handler.ServeHTTP(httptest.NewRecorder(), r)
+
+		assert.Equal(t, map[string]string{enums.HeaderRequestID: "test", enums.HeaderHorusecAuthorization: "token"},
+			values)
+	})
+}
@@ -0,0 +1,124 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middlewares
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+// RateLimitOptions configures the rate limit middleware, which is disabled by default. Each account can make the
+// requests per minute on average and up to burst requests at once, while the requests without a valid token are
+// limited by the client ip. The buckets are kept in memory unless the store is replaced, like by the redis store to
+// share them between the replicas.
+type RateLimitOptions struct {
+	Enabled           bool
+	RequestsPerMinute int
+	Burst             int
+	ExcludedPaths     []string
+	Store             IRateLimitStore
+}
+
+func NewRateLimitOptions() *RateLimitOptions {
+	return &RateLimitOptions{
+		Enabled: env.GetEnvOrDefaultBool(enums.HorusecRateLimitEnabled, false),
+		RequestsPerMinute: env.GetEnvOrDefaultInt(enums.HorusecRateLimitRequestsPerMinute,
+			enums.DefaultRateLimitRequestsPerMinute),
+		Burst:         env.GetEnvOrDefaultInt(enums.HorusecRateLimitBurst, enums.DefaultRateLimitBurst),
+		ExcludedPaths: getPathsFromEnv(enums.HorusecRateLimitExcludedPaths, enums.DefaultRateLimitExcludedPaths),
+		Store:         NewMemoryRateLimitStore(nil),
+	}
+}
+
+func (r *RateLimitOptions) getRateLimit() RateLimit {
+	return RateLimit{Rate: float64(r.RequestsPerMinute) / time.Minute.Seconds(), Burst: r.Burst}
+}
+
+// RateLimitMiddleware answers too many requests with the time to retry when the bucket of the request is empty. The
+// requests are allowed when the store fails, so an unavailable redis does not stop the services.
+func RateLimitMiddleware(options *RateLimitOptions) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if !options.Enabled || options.RequestsPerMinute <= 0 || options.Burst <= 0 {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if isExcludedPath(options.ExcludedPaths, r.URL.Path) {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			result, err := options.Store.Take(r.Context(), getRateLimitKey(r), options.getRateLimit())
+			if err != nil {
+				logger.FromContext(r.Context()).LogError(enums.MessageFailedToTakeRateLimit, err)
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			options.setHeaders(w, result)
+
+			if !result.Allowed {
+				httpUtil.StatusTooManyRequests(w, enums.ErrorTooManyRequests)
+
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func (r *RateLimitOptions) setHeaders(w http.ResponseWriter, result *RateLimitResult) {
+	w.Header().Set(enums.HeaderRateLimitLimit, strconv.Itoa(r.Burst))
+	w.Header().Set(enums.HeaderRateLimitRemaining, strconv.Itoa(result.Remaining))
+
+	if !result.Allowed {
+		w.Header().Set(enums.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(result.RetryAfter.Seconds()))))
+	}
+}
+
+// getRateLimitKey uses the account id set by the authorization middleware or of a valid token, falling back to the
+// client ip, which is the one of the forwarded headers when the real ip middleware runs before
+func getRateLimitKey(r *http.Request) string {
+	if accountID, ok := jwt.GetAccountIDFromContext(r.Context()); ok {
+		return enums.RateLimitKeyPrefixAccount + accountID.String()
+	}
+
+	if token := jwt.GetTokenFromRequest(r); token != "" {
+		if accountID, err := jwt.GetAccountIDByJWTToken(token); err == nil && accountID != uuid.Nil {
+			return enums.RateLimitKeyPrefixAccount + accountID.String()
+		}
+	}
+
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+
+	return enums.RateLimitKeyPrefixIP + ip
+}
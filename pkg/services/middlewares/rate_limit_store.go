@@ -0,0 +1,171 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middlewares
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares/enums"
+	redisEnums "github.com/ZupIT/horusec-devkit/pkg/services/redis/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+)
+
+// RateLimit is a token bucket that holds up to burst tokens and is refilled by rate tokens per second
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// RateLimitResult reports if the request took a token, the tokens left and how long until the next one is available
+type RateLimitResult struct {
+	Allowed    bool
+	Remaining  int
+	RetryAfter time.Duration
+}
+
+// IRateLimitStore keeps the token buckets, taking one token of the bucket of the key on each request
+type IRateLimitStore interface {
+	Take(ctx context.Context, key string, limit RateLimit) (*RateLimitResult, error)
+}
+
+func newRateLimitResult(allowed bool, tokens float64, limit RateLimit) *RateLimitResult {
+	result := &RateLimitResult{Allowed: allowed, Remaining: int(math.Floor(tokens))}
+	if !allowed {
+		result.RetryAfter = time.Duration((1 - tokens) / limit.Rate * float64(time.Second))
+	}
+
+	return result
+}
+
+type memoryBucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// MemoryRateLimitStore keeps the buckets of each replica in memory, removing the buckets that are full again
+type MemoryRateLimitStore struct {
+	mutex   sync.Mutex
+	clock   clock.IClock
+	buckets map[string]*memoryBucket
+	swept   time.Time
+}
+
+func NewMemoryRateLimitStore(clk clock.IClock) IRateLimitStore {
+	clk = clock.OrDefault(clk)
+
+	return &MemoryRateLimitStore{clock: clk, buckets: map[string]*memoryBucket{}, swept: clk.Now()}
+}
+
+func (m *MemoryRateLimitStore) Take(_ context.Context, key string, limit RateLimit) (*RateLimitResult, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	now := m.clock.Now()
+	m.sweep(now, limit)
+
+	bucket, ok := m.buckets[key]
+	if !ok {
+		bucket = &memoryBucket{tokens: float64(limit.Burst), updated: now}
+		m.buckets[key] = bucket
+	}
+
+	bucket.tokens = refill(bucket, now, limit)
+	bucket.updated = now
+
+	allowed := bucket.tokens >= 1
+	if allowed {
+		bucket.tokens--
+	}
+
+	return newRateLimitResult(allowed, bucket.tokens, limit), nil
+}
+
+// sweep removes the full buckets once each time a bucket takes to refill, since they are the same as a new bucket
+func (m *MemoryRateLimitStore) sweep(now time.Time, limit RateLimit) {
+	refillTime := time.Duration(float64(limit.Burst) / limit.Rate * float64(time.Second))
+	if now.Sub(m.swept) < refillTime {
+		return
+	}
+
+	for key, bucket := range m.buckets {
+		if refill(bucket, now, limit) >= float64(limit.Burst) {
+			delete(m.buckets, key)
+		}
+	}
+
+	m.swept = now
+}
+
+func refill(bucket *memoryBucket, now time.Time, limit RateLimit) float64 {
+	elapsed := now.Sub(bucket.updated).Seconds()
+	if elapsed < 0 {
+		elapsed = 0
+	}
+
+	return math.Min(float64(limit.Burst), bucket.tokens+elapsed*limit.Rate)
+}
+
+// RedisRateLimitStore shares the buckets between the replicas, updating them atomically with a script. The time of
+// the replicas is used to refill the buckets, so their clocks should be synchronized.
+type RedisRateLimitStore struct {
+	client goredis.UniversalClient
+	clock  clock.IClock
+}
+
+func NewRedisRateLimitStore(client goredis.UniversalClient, clk clock.IClock) IRateLimitStore {
+	return &RedisRateLimitStore{client: client, clock: clock.OrDefault(clk)}
+}
+
+func (r *RedisRateLimitStore) Take(ctx context.Context, key string, limit RateLimit) (*RateLimitResult, error) {
+	reply, err := r.client.Eval(ctx, enums.RateLimitRedisScript, []string{enums.RateLimitRedisKeyPrefix + key},
+		strconv.FormatFloat(limit.Rate, 'f', -1, 64), limit.Burst, r.clock.Now().UnixMilli()).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	allowed, tokens, err := parseRateLimitReply(reply)
+	if err != nil {
+		return nil, err
+	}
+
+	return newRateLimitResult(allowed, tokens, limit), nil
+}
+
+func parseRateLimitReply(reply interface{}) (bool, float64, error) {
+	items, ok := reply.([]interface{})
+	if !ok || len(items) != 2 {
+		return false, 0, redisEnums.ErrorInvalidReply
+	}
+
+	allowed, ok := items[0].(int64)
+	content, contentOK := items[1].(string)
+
+	if !ok || !contentOK {
+		return false, 0, redisEnums.ErrorInvalidReply
+	}
+
+	tokens, err := strconv.ParseFloat(content, 64)
+	if err != nil {
+		return false, 0, redisEnums.ErrorInvalidReply
+	}
+
+	return allowed == 1, tokens, nil
+}
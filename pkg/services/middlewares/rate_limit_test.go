@@ -0,0 +1,238 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middlewares
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares/enums"
+	redisEnums "github.com/ZupIT/horusec-devkit/pkg/services/redis/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
+)
+
+type rateLimitStoreStub struct {
+	keys   []string
+	result *RateLimitResult
+	err    error
+}
+
+func (r *rateLimitStoreStub) Take(_ context.Context, key string, _ RateLimit) (*RateLimitResult, error) {
+	r.keys = append(r.keys, key)
+
+	return r.result, r.err
+}
+
+func newRateLimitOptions(store IRateLimitStore) *RateLimitOptions {
+	options := NewRateLimitOptions()
+	options.Enabled = true
+	options.RequestsPerMinute = 60
+	options.Burst = 2
+	options.Store = store
+
+	return options
+}
+
+func TestNewRateLimitOptions(t *testing.T) {
+	t.Run("should read the options from the environment", func(t *testing.T) {
+		t.Setenv(enums.HorusecRateLimitEnabled, "true")
+		t.Setenv(enums.HorusecRateLimitRequestsPerMinute, "120")
+		t.Setenv(enums.HorusecRateLimitExcludedPaths, "/health")
+
+		options := NewRateLimitOptions()
+
+		assert.True(t, options.Enabled)
+		assert.Equal(t, RateLimit{Rate: 2, Burst: enums.DefaultRateLimitBurst}, options.getRateLimit())
+		assert.Equal(t, []string{"/health"}, options.ExcludedPaths)
+		assert.NotNil(t, options.Store)
+	})
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	newRequest := func(remoteAddr string) *http.Request {
+		req, _ := http.NewRequest("GET", "http://test/api", nil)
+		req.RemoteAddr = remoteAddr
+
+		return req
+	}
+
+	t.Run("should answer too many requests when the bucket is empty", func(t *testing.T) {
+		handler := RateLimitMiddleware(newRateLimitOptions(NewMemoryRateLimitStore(
+			clock.NewFakeClock(time.Now()))))(http.HandlerFunc(testHandler))
+
+		for _, expected := range []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests} {
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, newRequest("127.0.0.1:8000"))
+
+			assert.Equal(t, expected, w.Code)
+			assert.Equal(t, "2", w.Header().Get(enums.HeaderRateLimitLimit))
+		}
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, newRequest("127.0.0.1:8000"))
+
+		assert.Equal(t, "0", w.Header().Get(enums.HeaderRateLimitRemaining))
+		assert.Equal(t, "1", w.Header().Get(enums.HeaderRetryAfter))
+
+		w = httptest.NewRecorder()
+		handler.ServeHTTP(w, newRequest("127.0.0.2:8000"))
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should limit by the account of the token or the client ip", func(t *testing.T) {
+		store := &rateLimitStoreStub{result: &RateLimitResult{Allowed: true}}
+		handler := RateLimitMiddleware(newRateLimitOptions(store))(http.HandlerFunc(testHandler))
+
+		accountID := uuid.New()
+		withContext := newRequest("127.0.0.1:8000")
+		withContext = withContext.WithContext(jwt.ContextWithAccountID(withContext.Context(), accountID))
+
+		withToken := newRequest("127.0.0.1:8000")
+		withToken.Header.Set("Authorization", "Bearer "+createValidToken())
+
+		withInvalidToken := newRequest("127.0.0.1")
+		withInvalidToken.Header.Set("X-Horusec-Authorization", "invalid")
+
+		for _, req := range []*http.Request{withContext, withToken, withInvalidToken} {
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+		}
+
+		assert.Equal(t, "account:"+accountID.String(), store.keys[0])
+		assert.Contains(t, store.keys[1], "account:")
+		assert.NotEqual(t, "account:"+uuid.Nil.String(), store.keys[1])
+		assert.Equal(t, "ip:127.0.0.1", store.keys[2])
+	})
+
+	t.Run("should allow the request when the store fails", func(t *testing.T) {
+		handler := RateLimitMiddleware(newRateLimitOptions(&rateLimitStoreStub{err: errors.New("test")}))(
+			http.HandlerFunc(testHandler))
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, newRequest("127.0.0.1:8000"))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should not limit the excluded paths or when disabled", func(t *testing.T) {
+		store := &rateLimitStoreStub{}
+		options := newRateLimitOptions(store)
+
+		req, _ := http.NewRequest("GET", "http://test/health", nil)
+		RateLimitMiddleware(options)(http.HandlerFunc(testHandler)).ServeHTTP(httptest.NewRecorder(), req)
+
+		options.Enabled = false
+		RateLimitMiddleware(options)(http.HandlerFunc(testHandler)).ServeHTTP(httptest.NewRecorder(),
+			newRequest("127.0.0.1:8000"))
+
+		assert.Empty(t, store.keys)
+	})
+}
+
+func TestMemoryRateLimitStore(t *testing.T) {
+	limit := RateLimit{Rate: 1, Burst: 2}
+
+	t.Run("should refill the bucket by the elapsed time", func(t *testing.T) {
+		fakeClock := clock.NewFakeClock(time.Now())
+		store := NewMemoryRateLimitStore(fakeClock)
+
+		for _, expected := range []bool{true, true, false} {
+			result, err := store.Take(context.Background(), "test", limit)
+
+			assert.NoError(t, err)
+			assert.Equal(t, expected, result.Allowed)
+		}
+
+		fakeClock.Advance(500 * time.Millisecond)
+
+		result, _ := store.Take(context.Background(), "test", limit)
+		assert.False(t, result.Allowed)
+		assert.Equal(t, 500*time.Millisecond, result.RetryAfter)
+
+		fakeClock.Advance(time.Minute)
+
+		result, _ = store.Take(context.Background(), "test", limit)
+		assert.True(t, result.Allowed)
+		assert.Equal(t, 1, result.Remaining)
+	})
+
+	t.Run("should remove the full buckets", func(t *testing.T) {
+		fakeClock := clock.NewFakeClock(time.Now())
+		store := NewMemoryRateLimitStore(fakeClock).(*MemoryRateLimitStore)
+
+		_, _ = store.Take(context.Background(), "first", limit)
+		fakeClock.Advance(3 * time.Second)
+		_, _ = store.Take(context.Background(), "second", limit)
+
+		assert.Len(t, store.buckets, 1)
+		assert.Contains(t, store.buckets, "second")
+	})
+}
+
+func TestRedisRateLimitStore(t *testing.T) {
+	limit := RateLimit{Rate: 0.5, Burst: 2}
+
+	newStore := func(t *testing.T) (*miniredis.Miniredis, IRateLimitStore) {
+		server := miniredis.RunT(t)
+		client := goredis.NewClient(&goredis.Options{Addr: server.Addr(), MaxRetries: -1})
+
+		t.Cleanup(func() {
+			_ = client.Close()
+		})
+
+		return server, NewRedisRateLimitStore(client, clock.NewFakeClock(time.Now()))
+	}
+
+	t.Run("should take the tokens of the bucket", func(t *testing.T) {
+		_, store := newStore(t)
+
+		result, err := store.Take(context.Background(), "test", limit)
+		assert.NoError(t, err)
+		assert.Equal(t, &RateLimitResult{Allowed: true, Remaining: 1, RetryAfter: 0}, result)
+
+		_, _ = store.Take(context.Background(), "test", limit)
+
+		result, err = store.Take(context.Background(), "test", limit)
+		assert.NoError(t, err)
+		assert.Equal(t, &RateLimitResult{Allowed: false, Remaining: 0, RetryAfter: 2 * time.Second}, result)
+	})
+
+	t.Run("should return error when the reply is invalid", func(t *testing.T) {
+		for _, reply := range []interface{}{nil, []interface{}{int64(1)}, []interface{}{int64(1), "a"},
+			[]interface{}{"1", "1"}} {
+			_, _, err := parseRateLimitReply(reply)
+
+			assert.ErrorIs(t, err, redisEnums.ErrorInvalidReply)
+		}
+	})
+
+	t.Run("should return error when redis fails", func(t *testing.T) {
+		server, store := newStore(t)
+		server.Close()
+
+		_, err := store.Take(context.Background(), "test", limit)
+
+		assert.Error(t, err)
+	})
+}
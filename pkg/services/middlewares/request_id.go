@@ -0,0 +1,82 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/middleware"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/propagation"
+	propagationEnums "github.com/ZupIT/horusec-devkit/pkg/utils/propagation/enums"
+)
+
+// RequestIDMiddleware keeps the X-Request-Id of the request or generates a new one when it is missing or is not a
+// safe identifier. The id is returned on the response and stored in the request context for the context loggers,
+// the grpc propagation and the chi request id, so it replaces the request id middleware of chi.
+func RequestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(propagationEnums.HeaderRequestID)
+		if !isValidRequestID(requestID) {
+			requestID = uuid.New().String()
+		}
+
+		r.Header.Set(propagationEnums.HeaderRequestID, requestID)
+		w.Header().Set(propagationEnums.HeaderRequestID, requestID)
+
+		ctx := context.WithValue(r.Context(), middleware.RequestIDKey, requestID)
+
+		next.ServeHTTP(w, r.WithContext(propagation.ContextWithRequestID(ctx, requestID)))
+	})
+}
+
+func isValidRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > enums.MaxRequestIDLength {
+		return false
+	}
+
+	for _, char := range requestID {
+		if !isRequestIDChar(char) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isRequestIDChar(char rune) bool {
+	return 'a' <= char && char <= 'z' || 'A' <= char && char <= 'Z' || '0' <= char && char <= '9' ||
+		strings.ContainsRune("-_.:/+=", char)
+}
+
+// outgoingContextWithRequestID sends the request id to the grpc services even when the connection has no
+// propagation interceptor, keeping the one already set in the outgoing metadata
+func outgoingContextWithRequestID(ctx context.Context) context.Context {
+	requestID := propagation.GetRequestID(ctx)
+	if requestID == "" {
+		return ctx
+	}
+
+	if outgoing, _ := metadata.FromOutgoingContext(ctx); len(outgoing.Get(propagationEnums.HeaderRequestID)) > 0 {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, strings.ToLower(propagationEnums.HeaderRequestID), requestID)
+}
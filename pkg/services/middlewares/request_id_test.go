@@ -0,0 +1,90 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/middleware"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/propagation"
+)
+
+func TestRequestIDMiddleware(t *testing.T) {
+	serve := func(requestID string) (*httptest.ResponseRecorder, context.Context) {
+		var ctx context.Context
+
+		handler := RequestIDMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx = r.Context()
+			assert.Equal(t, propagation.GetRequestID(ctx), r.Header.Get("X-Request-Id"))
+		}))
+
+		req, _ := http.NewRequest("GET", "http://test", nil)
+		if requestID != "" {
+			req.Header.Set("X-Request-Id", requestID)
+		}
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+
+		return w, ctx
+	}
+
+	t.Run("should keep the request id of the request", func(t *testing.T) {
+		w, ctx := serve("test-id_1")
+
+		assert.Equal(t, "test-id_1", w.Header().Get("X-Request-Id"))
+		assert.Equal(t, "test-id_1", propagation.GetRequestID(ctx))
+		assert.Equal(t, "test-id_1", middleware.GetReqID(ctx))
+	})
+
+	t.Run("should generate the request id when it is missing or invalid", func(t *testing.T) {
+		for _, requestID := range []string{"", "test\nforged", strings.Repeat("a", 201)} {
+			w, ctx := serve(requestID)
+
+			assert.Len(t, w.Header().Get("X-Request-Id"), 36)
+			assert.Equal(t, w.Header().Get("X-Request-Id"), propagation.GetRequestID(ctx))
+		}
+	})
+}
+
+func TestOutgoingContextWithRequestID(t *testing.T) {
+	t.Run("should append the request id to the outgoing metadata", func(t *testing.T) {
+		ctx := outgoingContextWithRequestID(propagation.ContextWithRequestID(context.Background(), "test"))
+
+		outgoing, _ := metadata.FromOutgoingContext(ctx)
+		assert.Equal(t, []string{"test"}, outgoing.Get("x-request-id"))
+	})
+
+	t.Run("should keep the request id already in the outgoing metadata", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "x-request-id", "explicit")
+		ctx = outgoingContextWithRequestID(propagation.ContextWithRequestID(ctx, "test"))
+
+		outgoing, _ := metadata.FromOutgoingContext(ctx)
+		assert.Equal(t, []string{"explicit"}, outgoing.Get("x-request-id"))
+	})
+
+	t.Run("should not change the context without request id", func(t *testing.T) {
+		ctx := context.Background()
+
+		assert.Equal(t, ctx, outgoingContextWithRequestID(ctx))
+	})
+}
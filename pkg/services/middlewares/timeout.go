@@ -0,0 +1,82 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middlewares
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+type timeoutContextKey string
+
+const routerTimeoutContextKey timeoutContextKey = "horusec-router-timeout"
+
+// routerTimeout keeps the context before the timeout, so the routes without timeout are only canceled by the client
+type routerTimeout struct {
+	parent  context.Context
+	removed bool
+}
+
+// TimeoutMiddleware cancels the request context after the timeout and answers 504 when the handler returns after it,
+// like the chi one, unless the route removes the timeout with WithoutTimeout
+func TimeoutMiddleware(timeout time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			routerTimeout := &routerTimeout{parent: r.Context()}
+
+			ctx, cancel := context.WithTimeout(context.WithValue(r.Context(), routerTimeoutContextKey, routerTimeout),
+				timeout)
+			defer func() {
+				cancel()
+
+				if !routerTimeout.removed && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+					w.WriteHeader(http.StatusGatewayTimeout)
+				}
+			}()
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// WithoutTimeout removes the timeout of TimeoutMiddleware from the routes kept open, like the server sent events,
+// keeping the values set on the context by the middlewares. It must be registered on the routes, so the clients are
+// not able to skip the timeout of the other routes.
+func WithoutTimeout(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		routerTimeout, ok := r.Context().Value(routerTimeoutContextKey).(*routerTimeout)
+		if !ok {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		routerTimeout.removed = true
+
+		next.ServeHTTP(w, r.WithContext(&withoutTimeoutContext{Context: routerTimeout.parent, values: r.Context()}))
+	})
+}
+
+// withoutTimeoutContext is canceled with the context before the timeout, but has the values of the request context
+type withoutTimeoutContext struct {
+	context.Context
+	values context.Context
+}
+
+func (w *withoutTimeoutContext) Value(key interface{}) interface{} {
+	return w.values.Value(key)
+}
@@ -0,0 +1,94 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type timeoutTestKey struct{}
+
+func TestTimeoutMiddleware(t *testing.T) {
+	serve := func(accept string, routeMiddlewares ...func(http.Handler) http.Handler) context.Context {
+		var ctx context.Context
+
+		var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx = r.Context()
+		})
+
+		for _, middleware := range routeMiddlewares {
+			handler = middleware(handler)
+		}
+
+		handler = TimeoutMiddleware(time.Millisecond)(handler)
+
+		req, _ := http.NewRequest(http.MethodGet, "http://test", nil)
+		req.Header.Set("Accept", accept)
+
+		handler.ServeHTTP(httptest.NewRecorder(), req.WithContext(
+			context.WithValue(req.Context(), timeoutTestKey{}, "test")))
+
+		return ctx
+	}
+
+	t.Run("should set the deadline of the request context", func(t *testing.T) {
+		_, ok := serve("application/json").Deadline()
+
+		assert.True(t, ok)
+	})
+
+	t.Run("should keep the deadline of clients accepting event streams", func(t *testing.T) {
+		_, ok := serve("text/event-stream").Deadline()
+
+		assert.True(t, ok)
+	})
+
+	t.Run("should not set the deadline of routes without timeout", func(t *testing.T) {
+		ctx := serve("application/json", WithoutTimeout)
+		_, ok := ctx.Deadline()
+
+		assert.False(t, ok)
+		assert.Equal(t, "test", ctx.Value(timeoutTestKey{}))
+	})
+
+	t.Run("should return 504 when the handler returns after the timeout", func(t *testing.T) {
+		handler := TimeoutMiddleware(time.Millisecond)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-r.Context().Done()
+		}))
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		assert.Equal(t, http.StatusGatewayTimeout, w.Code)
+	})
+
+	t.Run("should not return 504 for routes without timeout", func(t *testing.T) {
+		handler := TimeoutMiddleware(time.Millisecond)(WithoutTimeout(http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				time.Sleep(10 * time.Millisecond)
+			})))
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
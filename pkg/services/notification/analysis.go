@@ -0,0 +1,141 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"text/template"
+
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/notification/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/reports"
+)
+
+// AnalysisData is the data of the analysis templates, with every field of the report summary
+type AnalysisData struct {
+	*reports.Summary
+	Analysis *analysisEntities.Analysis
+	Severity severities.Severity
+}
+
+// NotifyAnalysis sends the analysis message when it has a vulnerability with at least the min severity of the
+// options, returning if it was sent
+func NotifyAnalysis(ctx context.Context, notifier INotifier, analysis *analysisEntities.Analysis,
+	options *Options) (bool, error) {
+	message, err := NewAnalysisMessage(analysis, options)
+	if err != nil || !isSevereEnough(message.Severity, options.MinSeverity) {
+		return false, err
+	}
+
+	return true, notifier.Notify(ctx, message)
+}
+
+// NewAnalysisMessage renders the title, text and url templates of the options, the fields of the message are the
+// analysis information and the total of each severity found
+func NewAnalysisMessage(analysis *analysisEntities.Analysis, options *Options) (*Message, error) {
+	if analysis == nil {
+		return nil, enums.ErrorNilAnalysis
+	}
+
+	reportOptions := reports.NewOptions()
+	reportOptions.MaxVulnerabilities = options.MaxVulnerabilities
+	reportOptions.IncludeCode = false
+
+	summary := reports.NewSummary(analysis, reportOptions)
+	data := &AnalysisData{Summary: summary, Analysis: analysis, Severity: maxSeverity(summary)}
+	message := &Message{Severity: data.Severity, Fields: analysisFields(summary)}
+
+	for _, rendered := range []struct {
+		target *string
+		text   string
+	}{
+		{target: &message.Title, text: options.TitleTemplate},
+		{target: &message.Text, text: options.TextTemplate},
+		{target: &message.URL, text: options.AnalysisURL},
+	} {
+		value, err := render(rendered.text, data)
+		if err != nil {
+			return nil, err
+		}
+
+		*rendered.target = value
+	}
+
+	return message, nil
+}
+
+func render(text string, data *AnalysisData) (string, error) {
+	tmpl, err := template.New("").Funcs(template.FuncMap{
+		"location": reports.Location,
+		"metadata": reports.MetadataLine,
+	}).Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", enums.ErrorInvalidTemplate, err.Error())
+	}
+
+	buffer := &bytes.Buffer{}
+	if err := tmpl.Execute(buffer, data); err != nil {
+		return "", fmt.Errorf("%w: %s", enums.ErrorInvalidTemplate, err.Error())
+	}
+
+	return strings.TrimSpace(buffer.String()), nil
+}
+
+func analysisFields(summary *reports.Summary) []Field {
+	fields := make([]Field, 0, len(summary.Fields())+len(summary.BySeverity))
+	for _, field := range summary.Fields() {
+		fields = append(fields, Field{Name: field.Name, Value: field.Value})
+	}
+
+	for _, count := range summary.BySeverity {
+		if count.Total > 0 {
+			fields = append(fields, Field{Name: count.Name, Value: fmt.Sprint(count.Total)})
+		}
+	}
+
+	return fields
+}
+
+// maxSeverity returns the most severe severity found, or an empty severity when the analysis has no vulnerabilities
+func maxSeverity(summary *reports.Summary) severities.Severity {
+	for _, count := range summary.BySeverity {
+		if count.Total > 0 {
+			return severities.Severity(count.Name)
+		}
+	}
+
+	return ""
+}
+
+func isSevereEnough(severity, minSeverity severities.Severity) bool {
+	if severity == "" {
+		return false
+	}
+
+	for _, value := range severities.Values() {
+		switch value {
+		case severity:
+			return true
+		case minSeverity:
+			return false
+		}
+	}
+
+	return false
+}
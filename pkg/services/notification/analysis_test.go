@@ -0,0 +1,125 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/notification/enums"
+)
+
+func newTestAnalysis(severityList ...severities.Severity) *analysisEntities.Analysis {
+	analysis := &analysisEntities.Analysis{
+		ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"), RepositoryName: "repository",
+	}
+
+	for _, severity := range severityList {
+		analysis.AnalysisVulnerabilities = append(analysis.AnalysisVulnerabilities,
+			analysisEntities.AnalysisVulnerabilities{Vulnerability: vulnerability.Vulnerability{
+				File: "main.go", Line: "10", Severity: severity, Language: "Go",
+			}})
+	}
+
+	return analysis
+}
+
+func newTestOptions() *Options {
+	return &Options{
+		MinSeverity: severities.High, TitleTemplate: enums.DefaultTitleTemplate, TextTemplate: enums.DefaultTextTemplate,
+		AnalysisURL: "https://horusec.io/analysis/{{.Analysis.ID}}", MaxVulnerabilities: 1,
+	}
+}
+
+func TestNewOptions(t *testing.T) {
+	t.Run("should read options from env", func(t *testing.T) {
+		t.Setenv(enums.HorusecNotificationSlackWebhookURL, "https://hooks.slack.com/test")
+		t.Setenv(enums.HorusecNotificationMinSeverity, "MEDIUM")
+
+		options := NewOptions()
+
+		assert.Equal(t, "https://hooks.slack.com/test", options.SlackWebhookURL)
+		assert.Equal(t, severities.Medium, options.MinSeverity)
+		assert.Equal(t, enums.DefaultMaxAttempts, options.MaxAttempts)
+	})
+}
+
+func TestNewAnalysisMessage(t *testing.T) {
+	t.Run("should render analysis message", func(t *testing.T) {
+		message, err := NewAnalysisMessage(newTestAnalysis(severities.Low, severities.Critical), newTestOptions())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Horusec found 2 vulnerabilities in repository", message.Title)
+		assert.Equal(t, "[CRITICAL] main.go:10 (Language: Go)\nand 1 more vulnerabilities", message.Text)
+		assert.Equal(t, "https://horusec.io/analysis/00000000-0000-0000-0000-000000000001", message.URL)
+		assert.Equal(t, severities.Critical, message.Severity)
+		assert.Contains(t, message.Fields, Field{Name: "CRITICAL", Value: "1"})
+		assert.Contains(t, message.Fields, Field{Name: "Repository", Value: "repository"})
+		assert.NotContains(t, message.Fields, Field{Name: "HIGH", Value: "0"})
+	})
+
+	t.Run("should return error when invalid template", func(t *testing.T) {
+		options := newTestOptions()
+		options.TextTemplate = "{{.Invalid"
+
+		_, err := NewAnalysisMessage(newTestAnalysis(), options)
+		assert.ErrorIs(t, err, enums.ErrorInvalidTemplate)
+
+		options.TextTemplate = "{{.Invalid}}"
+
+		_, err = NewAnalysisMessage(newTestAnalysis(), options)
+		assert.ErrorIs(t, err, enums.ErrorInvalidTemplate)
+	})
+
+	t.Run("should return error when nil analysis", func(t *testing.T) {
+		_, err := NewAnalysisMessage(nil, newTestOptions())
+
+		assert.ErrorIs(t, err, enums.ErrorNilAnalysis)
+	})
+}
+
+func TestNotifyAnalysis(t *testing.T) {
+	t.Run("should notify when analysis has vulnerability with min severity", func(t *testing.T) {
+		notifier := &Mock{}
+		notifier.On("Notify").Return(nil)
+
+		sent, err := NotifyAnalysis(context.Background(), notifier, newTestAnalysis(severities.High), newTestOptions())
+
+		assert.NoError(t, err)
+		assert.True(t, sent)
+		notifier.AssertCalled(t, "Notify")
+	})
+
+	t.Run("should not notify when vulnerabilities are less severe than min severity", func(t *testing.T) {
+		notifier := &Mock{}
+
+		for _, analysis := range []*analysisEntities.Analysis{
+			newTestAnalysis(severities.Medium, severities.Info), newTestAnalysis(),
+		} {
+			sent, err := NotifyAnalysis(context.Background(), notifier, analysis, newTestOptions())
+
+			assert.NoError(t, err)
+			assert.False(t, sent)
+		}
+
+		notifier.AssertNotCalled(t, "Notify")
+	})
+}
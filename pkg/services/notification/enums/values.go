@@ -0,0 +1,51 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+const (
+	HorusecNotificationSlackWebhookURL     = "HORUSEC_NOTIFICATION_SLACK_WEBHOOK_URL"
+	HorusecNotificationTeamsWebhookURL     = "HORUSEC_NOTIFICATION_TEAMS_WEBHOOK_URL"
+	HorusecNotificationMinSeverity         = "HORUSEC_NOTIFICATION_MIN_SEVERITY"
+	HorusecNotificationAnalysisURL         = "HORUSEC_NOTIFICATION_ANALYSIS_URL"
+	HorusecNotificationMaxVulnerabilities  = "HORUSEC_NOTIFICATION_MAX_VULNERABILITIES"
+	HorusecNotificationTimeoutSeconds      = "HORUSEC_NOTIFICATION_TIMEOUT_SECONDS"
+	HorusecNotificationMaxAttempts         = "HORUSEC_NOTIFICATION_MAX_ATTEMPTS"
+	HorusecNotificationInitialBackoffMilli = "HORUSEC_NOTIFICATION_INITIAL_BACKOFF_MILLIS"
+
+	DefaultMinSeverity          = "CRITICAL"
+	DefaultMaxVulnerabilities   = 5
+	DefaultTimeoutSeconds       = 10
+	DefaultMaxAttempts          = 3
+	DefaultInitialBackoffMillis = 1000
+	DefaultMaxBackoff           = 30
+	RetryOperation              = "notification_delivery"
+
+	HeaderContentType = "Content-Type"
+	HeaderRetryAfter  = "Retry-After"
+	ContentTypeJSON   = "application/json"
+
+	TeamsCardType      = "MessageCard"
+	TeamsCardContext   = "https://schema.org/extensions"
+	TeamsActionOpenURI = "OpenUri"
+	TeamsOSDefault     = "default"
+	LabelOpenURL       = "Open in Horusec"
+)
+
+const (
+	DefaultTitleTemplate = "Horusec found {{.Total}} vulnerabilities in {{.RepositoryName}}"
+	DefaultTextTemplate  = "{{range .Groups}}{{range .Vulnerabilities}}" +
+		"[{{.Severity}}] {{location .}} ({{metadata .}})\n{{end}}{{end}}" +
+		"{{if .Omitted}}and {{.Omitted}} more vulnerabilities\n{{end}}"
+)
@@ -0,0 +1,40 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+)
+
+// Message is the content of a notification, each notifier formats it as its service expects. The severity is used
+// as the color of the message and the url as a link to open the notified resource.
+type Message struct {
+	Title    string
+	Text     string
+	Severity severities.Severity
+	URL      string
+	Fields   []Field
+}
+
+type Field struct {
+	Name  string
+	Value string
+}
+
+type INotifier interface {
+	Notify(ctx context.Context, message *Message) error
+}
@@ -0,0 +1,48 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"errors"
+)
+
+// Notifiers sends each message to every notifier, even when some of them fail
+type Notifiers []INotifier
+
+// NewNotifiers returns the notifiers with the webhook url set on the options, a notifier without any of them does
+// not send the messages, so the services can always notify and leave the channels to the configuration
+func NewNotifiers(options *Options) Notifiers {
+	notifiers := Notifiers{}
+
+	if options.SlackWebhookURL != "" {
+		notifiers = append(notifiers, NewSlackNotifier(options.SlackWebhookURL, options))
+	}
+
+	if options.TeamsWebhookURL != "" {
+		notifiers = append(notifiers, NewTeamsNotifier(options.TeamsWebhookURL, options))
+	}
+
+	return notifiers
+}
+
+func (n Notifiers) Notify(ctx context.Context, message *Message) error {
+	errs := make([]error, 0, len(n))
+	for _, notifier := range n {
+		errs = append(errs, notifier.Notify(ctx, message))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,146 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/notification/enums"
+)
+
+func newTestMessage() *Message {
+	return &Message{
+		Title: "title", Text: "first\nsecond", Severity: severities.Critical, URL: "https://horusec.io",
+		Fields: []Field{{Name: "CRITICAL", Value: "1"}},
+	}
+}
+
+func newTestSenderOptions() *Options {
+	return &Options{Timeout: time.Second, MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+}
+
+func newTestServer(t *testing.T, statusCodes ...int) (*httptest.Server, *atomic.Int32, *map[string]interface{}) {
+	calls, body := &atomic.Int32{}, &map[string]interface{}{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := int(calls.Add(1)) - 1
+		assert.Equal(t, enums.ContentTypeJSON, r.Header.Get(enums.HeaderContentType))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(body))
+
+		if call < len(statusCodes) {
+			w.WriteHeader(statusCodes[call])
+		}
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server, calls, body
+}
+
+func TestSlackNotifier(t *testing.T) {
+	t.Run("should send message as slack attachment", func(t *testing.T) {
+		server, _, body := newTestServer(t)
+
+		assert.NoError(t, NewSlackNotifier(server.URL, newTestSenderOptions()).Notify(context.Background(),
+			newTestMessage()))
+
+		assert.Equal(t, "title", (*body)["text"])
+		assert.Equal(t, map[string]interface{}{
+			"color": "#8c0d1a", "title": "title", "title_link": "https://horusec.io", "text": "first\nsecond",
+			"fields": []interface{}{map[string]interface{}{"title": "CRITICAL", "value": "1", "short": true}},
+		}, (*body)["attachments"].([]interface{})[0])
+	})
+
+	t.Run("should retry server errors", func(t *testing.T) {
+		server, calls, _ := newTestServer(t, http.StatusInternalServerError, http.StatusTooManyRequests)
+
+		assert.NoError(t, NewSlackNotifier(server.URL, newTestSenderOptions()).Notify(context.Background(),
+			newTestMessage()))
+		assert.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("should not retry client errors", func(t *testing.T) {
+		server, calls, _ := newTestServer(t, http.StatusNotFound)
+
+		err := NewSlackNotifier(server.URL, newTestSenderOptions()).Notify(context.Background(), newTestMessage())
+
+		assert.ErrorIs(t, err, enums.ErrorUnexpectedStatusCode)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+}
+
+func TestTeamsNotifier(t *testing.T) {
+	t.Run("should send message as teams card", func(t *testing.T) {
+		server, _, body := newTestServer(t)
+
+		assert.NoError(t, NewTeamsNotifier(server.URL, newTestSenderOptions()).Notify(context.Background(),
+			newTestMessage()))
+
+		assert.Equal(t, enums.TeamsCardType, (*body)["@type"])
+		assert.Equal(t, "8c0d1a", (*body)["themeColor"])
+		assert.Equal(t, "first  \nsecond", (*body)["text"])
+		assert.Equal(t, []interface{}{map[string]interface{}{"facts": []interface{}{
+			map[string]interface{}{"name": "CRITICAL", "value": "1"},
+		}}}, (*body)["sections"])
+
+		actions, err := json.Marshal((*body)["potentialAction"])
+		assert.NoError(t, err)
+		assert.JSONEq(t, `[{"@type": "OpenUri", "name": "Open in Horusec",
+			"targets": [{"os": "default", "uri": "https://horusec.io"}]}]`, string(actions))
+	})
+
+	t.Run("should omit optional fields", func(t *testing.T) {
+		server, _, body := newTestServer(t)
+
+		assert.NoError(t, NewTeamsNotifier(server.URL, newTestSenderOptions()).Notify(context.Background(),
+			&Message{Title: "title"}))
+
+		assert.NotContains(t, *body, "themeColor")
+		assert.NotContains(t, *body, "sections")
+		assert.NotContains(t, *body, "potentialAction")
+	})
+}
+
+func TestNotifiers(t *testing.T) {
+	t.Run("should create notifiers with webhook url", func(t *testing.T) {
+		assert.Empty(t, NewNotifiers(&Options{}))
+		assert.Len(t, NewNotifiers(&Options{SlackWebhookURL: "slack", TeamsWebhookURL: "teams"}), 2)
+	})
+
+	t.Run("should notify every notifier and join errors", func(t *testing.T) {
+		failing, succeeding := &Mock{}, &Mock{}
+		failing.On("Notify").Return(errors.New("test"))
+		succeeding.On("Notify").Return(nil)
+
+		err := Notifiers{failing, succeeding}.Notify(context.Background(), newTestMessage())
+
+		assert.EqualError(t, err, "test")
+		succeeding.AssertCalled(t, "Notify")
+	})
+
+	t.Run("should not fail without notifiers", func(t *testing.T) {
+		assert.NoError(t, NewNotifiers(&Options{}).Notify(context.Background(), newTestMessage()))
+	})
+}
@@ -0,0 +1,60 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/notification/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// Options configures the notifiers and the analysis messages. A notifier is only created when its webhook url is
+// set and an analysis is only notified when it has a vulnerability with at least the min severity. The templates
+// use the text/template syntax with the analysis summary as data.
+type Options struct {
+	SlackWebhookURL    string
+	TeamsWebhookURL    string
+	MinSeverity        severities.Severity
+	TitleTemplate      string
+	TextTemplate       string
+	AnalysisURL        string
+	MaxVulnerabilities int
+	Timeout            time.Duration
+	MaxAttempts        int
+	InitialBackoff     time.Duration
+	MaxBackoff         time.Duration
+}
+
+func NewOptions() *Options {
+	return &Options{
+		SlackWebhookURL: env.GetEnvOrDefault(enums.HorusecNotificationSlackWebhookURL, ""),
+		TeamsWebhookURL: env.GetEnvOrDefault(enums.HorusecNotificationTeamsWebhookURL, ""),
+		MinSeverity: severities.GetSeverityByString(env.GetEnvOrDefault(enums.HorusecNotificationMinSeverity,
+			enums.DefaultMinSeverity)),
+		TitleTemplate: enums.DefaultTitleTemplate,
+		TextTemplate:  enums.DefaultTextTemplate,
+		AnalysisURL:   env.GetEnvOrDefault(enums.HorusecNotificationAnalysisURL, ""),
+		MaxVulnerabilities: env.GetEnvOrDefaultInt(enums.HorusecNotificationMaxVulnerabilities,
+			enums.DefaultMaxVulnerabilities),
+		Timeout: time.Duration(env.GetEnvOrDefaultInt(enums.HorusecNotificationTimeoutSeconds,
+			enums.DefaultTimeoutSeconds)) * time.Second,
+		MaxAttempts: env.GetEnvOrDefaultInt(enums.HorusecNotificationMaxAttempts, enums.DefaultMaxAttempts),
+		InitialBackoff: time.Duration(env.GetEnvOrDefaultInt(enums.HorusecNotificationInitialBackoffMilli,
+			enums.DefaultInitialBackoffMillis)) * time.Millisecond,
+		MaxBackoff: enums.DefaultMaxBackoff * time.Second,
+	}
+}
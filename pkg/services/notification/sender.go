@@ -0,0 +1,107 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/notification/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/retry"
+)
+
+// sender posts the json payloads of the notifiers, retrying the network errors, 408, 429 and 5xx responses
+type sender struct {
+	options *Options
+	client  *http.Client
+}
+
+func newSender(options *Options) *sender {
+	return &sender{options: options, client: &http.Client{Timeout: options.Timeout}}
+}
+
+func (s *sender) post(ctx context.Context, url string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	return retry.Do(ctx, s.retryPolicy(), func(ctx context.Context) error {
+		statusCode, retryAfter, err := s.doPost(ctx, url, body)
+		if err != nil && !isRetryable(statusCode) {
+			return retry.Permanent(err)
+		}
+
+		return retry.After(err, retryAfter)
+	})
+}
+
+func (s *sender) retryPolicy() *retry.Policy {
+	policy := retry.NewPolicy(enums.RetryOperation)
+	policy.MaxAttempts = s.options.MaxAttempts
+	policy.InitialBackoff = s.options.InitialBackoff
+	policy.MaxBackoff = s.options.MaxBackoff
+	policy.OnRetry = func(_ int, err error, _ time.Duration) {
+		logger.LogError(enums.MessageFailedToNotify, err)
+	}
+
+	return policy
+}
+
+func (s *sender) doPost(ctx context.Context, url string, body []byte) (int, time.Duration, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return http.StatusBadRequest, 0, err
+	}
+
+	request.Header.Set(enums.HeaderContentType, enums.ContentTypeJSON)
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	defer response.Body.Close()
+
+	_, _ = io.Copy(io.Discard, response.Body)
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return response.StatusCode, parseRetryAfter(response.Header.Get(enums.HeaderRetryAfter)),
+			fmt.Errorf("%w: %d", enums.ErrorUnexpectedStatusCode, response.StatusCode)
+	}
+
+	return response.StatusCode, 0, nil
+}
+
+func isRetryable(statusCode int) bool {
+	return statusCode == 0 || statusCode == http.StatusRequestTimeout || statusCode == http.StatusTooManyRequests ||
+		statusCode >= http.StatusInternalServerError
+}
+
+func parseRetryAfter(value string) time.Duration {
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds < 0 {
+		return 0
+	}
+
+	return time.Duration(seconds) * time.Second
+}
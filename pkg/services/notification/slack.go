@@ -0,0 +1,67 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/reports"
+)
+
+type slackPayload struct {
+	Text        string            `json:"text"`
+	Attachments []slackAttachment `json:"attachments,omitempty"`
+}
+
+type slackAttachment struct {
+	Color     string       `json:"color,omitempty"`
+	Title     string       `json:"title,omitempty"`
+	TitleLink string       `json:"title_link,omitempty"`
+	Text      string       `json:"text,omitempty"`
+	Fields    []slackField `json:"fields,omitempty"`
+}
+
+type slackField struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+	Short bool   `json:"short"`
+}
+
+// SlackNotifier sends the messages to a slack incoming webhook, as an attachment colored by the severity
+type SlackNotifier struct {
+	webhookURL string
+	sender     *sender
+}
+
+func NewSlackNotifier(webhookURL string, options *Options) INotifier {
+	return &SlackNotifier{webhookURL: webhookURL, sender: newSender(options)}
+}
+
+func (s *SlackNotifier) Notify(ctx context.Context, message *Message) error {
+	return s.sender.post(ctx, s.webhookURL, newSlackPayload(message))
+}
+
+func newSlackPayload(message *Message) *slackPayload {
+	attachment := slackAttachment{Title: message.Title, TitleLink: message.URL, Text: message.Text}
+	if message.Severity != "" {
+		attachment.Color = reports.SeverityHexColor(message.Severity)
+	}
+
+	for _, field := range message.Fields {
+		attachment.Fields = append(attachment.Fields, slackField{Title: field.Name, Value: field.Value, Short: true})
+	}
+
+	return &slackPayload{Text: message.Title, Attachments: []slackAttachment{attachment}}
+}
@@ -0,0 +1,96 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/notification/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/reports"
+)
+
+type teamsCard struct {
+	Type       string         `json:"@type"`
+	Context    string         `json:"@context"`
+	ThemeColor string         `json:"themeColor,omitempty"`
+	Summary    string         `json:"summary"`
+	Title      string         `json:"title"`
+	Text       string         `json:"text,omitempty"`
+	Sections   []teamsSection `json:"sections,omitempty"`
+	Actions    []teamsAction  `json:"potentialAction,omitempty"`
+}
+
+type teamsSection struct {
+	Facts []teamsFact `json:"facts"`
+}
+
+type teamsFact struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type teamsAction struct {
+	Type    string        `json:"@type"`
+	Name    string        `json:"name"`
+	Targets []teamsTarget `json:"targets"`
+}
+
+type teamsTarget struct {
+	OS  string `json:"os"`
+	URI string `json:"uri"`
+}
+
+// TeamsNotifier sends the messages to a microsoft teams incoming webhook connector as a message card
+type TeamsNotifier struct {
+	webhookURL string
+	sender     *sender
+}
+
+func NewTeamsNotifier(webhookURL string, options *Options) INotifier {
+	return &TeamsNotifier{webhookURL: webhookURL, sender: newSender(options)}
+}
+
+func (t *TeamsNotifier) Notify(ctx context.Context, message *Message) error {
+	return t.sender.post(ctx, t.webhookURL, newTeamsCard(message))
+}
+
+// newTeamsCard breaks the text lines with two spaces, since the card text is markdown and ignores single new lines
+func newTeamsCard(message *Message) *teamsCard {
+	card := &teamsCard{
+		Type: enums.TeamsCardType, Context: enums.TeamsCardContext, Summary: message.Title, Title: message.Title,
+		Text: strings.ReplaceAll(strings.TrimSpace(message.Text), "\n", "  \n"),
+	}
+
+	if message.Severity != "" {
+		card.ThemeColor = strings.TrimPrefix(reports.SeverityHexColor(message.Severity), "#")
+	}
+
+	if len(message.Fields) > 0 {
+		section := teamsSection{}
+		for _, field := range message.Fields {
+			section.Facts = append(section.Facts, teamsFact{Name: field.Name, Value: field.Value})
+		}
+
+		card.Sections = []teamsSection{section}
+	}
+
+	if message.URL != "" {
+		card.Actions = []teamsAction{{Type: enums.TeamsActionOpenURI, Name: enums.LabelOpenURL,
+			Targets: []teamsTarget{{OS: enums.TeamsOSDefault, URI: message.URL}}}}
+	}
+
+	return card
+}
@@ -0,0 +1,127 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observability
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	sdkMetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdkTrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.41.0"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/observability/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+type IObservability interface {
+	Shutdown(ctx context.Context) error
+}
+
+type Observability struct {
+	tracerProvider *sdkTrace.TracerProvider
+	meterProvider  *sdkMetric.MeterProvider
+}
+
+// Setup configures the global otel providers with the options from env and should be called once at the service
+// start, deferring the shutdown, which exports the pending spans and metrics
+func Setup(ctx context.Context) (IObservability, error) {
+	return SetupWithOptions(ctx, NewOptions())
+}
+
+func SetupWithOptions(ctx context.Context, options *Options) (IObservability, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{},
+		propagation.Baggage{}))
+
+	if options.Endpoint == "" {
+		logger.LogInfo(enums.MessageExporterDisabled)
+
+		return &Observability{}, nil
+	}
+
+	serviceResource, err := newResource(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	observability := &Observability{}
+	if observability.tracerProvider, err = newTracerProvider(ctx, options, serviceResource); err != nil {
+		return nil, err
+	}
+
+	if observability.meterProvider, err = newMeterProvider(ctx, options, serviceResource); err != nil {
+		return nil, errors.Join(err, observability.tracerProvider.Shutdown(ctx))
+	}
+
+	otel.SetTracerProvider(observability.tracerProvider)
+	otel.SetMeterProvider(observability.meterProvider)
+	logger.LogInfoWithFields(enums.MessageExporterEnabled, map[string]interface{}{"endpoint": options.Endpoint})
+
+	return observability, nil
+}
+
+// newResource also reads the OTEL_RESOURCE_ATTRIBUTES env, like the deployment environment set by the helm charts
+func newResource(ctx context.Context, options *Options) (*resource.Resource, error) {
+	return resource.New(ctx, resource.WithFromEnv(), resource.WithTelemetrySDK(), resource.WithHost(),
+		resource.WithAttributes(semconv.ServiceName(options.ServiceName),
+			semconv.ServiceVersion(options.ServiceVersion)))
+}
+
+func newTracerProvider(ctx context.Context, options *Options,
+	serviceResource *resource.Resource) (*sdkTrace.TracerProvider, error) {
+	exporterOptions := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(options.Endpoint)}
+	if options.Insecure {
+		exporterOptions = append(exporterOptions, otlptracegrpc.WithInsecure())
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, exporterOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdkTrace.NewTracerProvider(sdkTrace.WithBatcher(exporter), sdkTrace.WithResource(serviceResource),
+		sdkTrace.WithSampler(sdkTrace.ParentBased(
+			sdkTrace.TraceIDRatioBased(float64(options.TraceSamplePercent)/100)))), nil
+}
+
+func newMeterProvider(ctx context.Context, options *Options,
+	serviceResource *resource.Resource) (*sdkMetric.MeterProvider, error) {
+	exporterOptions := []otlpmetricgrpc.Option{otlpmetricgrpc.WithEndpoint(options.Endpoint)}
+	if options.Insecure {
+		exporterOptions = append(exporterOptions, otlpmetricgrpc.WithInsecure())
+	}
+
+	exporter, err := otlpmetricgrpc.New(ctx, exporterOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdkMetric.NewMeterProvider(sdkMetric.WithResource(serviceResource), sdkMetric.WithReader(
+		sdkMetric.NewPeriodicReader(exporter, sdkMetric.WithInterval(options.MetricsInterval)))), nil
+}
+
+// Shutdown flushes the spans and metrics not exported yet, waiting until the context is done
+func (o *Observability) Shutdown(ctx context.Context) error {
+	if o.tracerProvider == nil {
+		return nil
+	}
+
+	return errors.Join(o.tracerProvider.Shutdown(ctx), o.meterProvider.Shutdown(ctx))
+}
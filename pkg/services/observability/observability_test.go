@@ -0,0 +1,82 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observability
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	sdkTrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.41.0"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/observability/enums"
+)
+
+func TestNewOptions(t *testing.T) {
+	t.Run("should read options from env", func(t *testing.T) {
+		t.Setenv(enums.HorusecOtelServiceName, "horusec-api")
+		t.Setenv(enums.HorusecOtelExporterEndpoint, "collector:4317")
+		t.Setenv(enums.HorusecOtelExporterInsecure, "true")
+		t.Setenv(enums.HorusecOtelTraceSamplePercent, "10")
+
+		options := NewOptions()
+
+		assert.Equal(t, "horusec-api", options.ServiceName)
+		assert.Equal(t, "collector:4317", options.Endpoint)
+		assert.True(t, options.Insecure)
+		assert.Equal(t, 10, options.TraceSamplePercent)
+		assert.Equal(t, enums.DefaultMetricsIntervalSeconds*time.Second, options.MetricsInterval)
+	})
+
+	t.Run("should use build info as default service name and version", func(t *testing.T) {
+		options := NewOptions()
+
+		assert.NotEmpty(t, options.ServiceName)
+		assert.NotEmpty(t, options.ServiceVersion)
+	})
+}
+
+func TestSetup(t *testing.T) {
+	t.Run("should not configure providers when endpoint is empty", func(t *testing.T) {
+		observability, err := Setup(context.Background())
+
+		assert.NoError(t, err)
+		assert.Contains(t, otel.GetTextMapPropagator().Fields(), "traceparent")
+		assert.NoError(t, observability.Shutdown(context.Background()))
+	})
+
+	t.Run("should configure global providers with service resource", func(t *testing.T) {
+		observability, err := SetupWithOptions(context.Background(), &Options{
+			ServiceName: "horusec-api", ServiceVersion: "v2.0.0", Endpoint: "127.0.0.1:1", Insecure: true,
+			TraceSamplePercent: 100, MetricsInterval: time.Minute,
+		})
+		assert.NoError(t, err)
+
+		_, span := otel.Tracer("test").Start(context.Background(), "test")
+		span.End()
+
+		assert.True(t, span.SpanContext().IsSampled())
+		assert.Contains(t, span.(sdkTrace.ReadOnlySpan).Resource().Attributes(), semconv.ServiceName("horusec-api"))
+		assert.Contains(t, span.(sdkTrace.ReadOnlySpan).Resource().Attributes(), semconv.ServiceVersion("v2.0.0"))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		_ = observability.Shutdown(ctx)
+	})
+}
@@ -0,0 +1,49 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observability
+
+import (
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/observability/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/version"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// Options configures the otlp grpc exporters. When the endpoint is empty nothing is exported, but the trace context
+// is still propagated, so a service without a collector does not break the traces of the other ones.
+type Options struct {
+	ServiceName        string
+	ServiceVersion     string
+	Endpoint           string
+	Insecure           bool
+	TraceSamplePercent int
+	MetricsInterval    time.Duration
+}
+
+// NewOptions uses the build info as the default service name and version
+func NewOptions() *Options {
+	info := version.Get()
+
+	return &Options{
+		ServiceName:        env.GetEnvOrDefault(enums.HorusecOtelServiceName, info.Name),
+		ServiceVersion:     env.GetEnvOrDefault(enums.HorusecOtelServiceVersion, info.Version),
+		Endpoint:           env.GetEnvOrDefault(enums.HorusecOtelExporterEndpoint, ""),
+		Insecure:           env.GetEnvOrDefaultBool(enums.HorusecOtelExporterInsecure, false),
+		TraceSamplePercent: env.GetEnvOrDefaultInt(enums.HorusecOtelTraceSamplePercent, enums.DefaultTraceSamplePercent),
+		MetricsInterval: time.Duration(env.GetEnvOrDefaultInt(enums.HorusecOtelMetricsIntervalSeconds,
+			enums.DefaultMetricsIntervalSeconds)) * time.Second,
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"net/http"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/oidc/enums"
+)
+
+// providerMetadata has the endpoints of the provider read from its discovery document
+type providerMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserInfoEndpoint      string `json:"userinfo_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+	EndSessionEndpoint    string `json:"end_session_endpoint"`
+}
+
+type jsonWebKey struct {
+	KeyID    string `json:"kid"`
+	KeyType  string `json:"kty"`
+	Use      string `json:"use"`
+	Modulus  string `json:"n"`
+	Exponent string `json:"e"`
+	Curve    string `json:"crv"`
+	X        string `json:"x"`
+	Y        string `json:"y"`
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+// keySet has the public keys of the provider by their key id
+type keySet map[string]interface{}
+
+func (c *Client) metadata(ctx context.Context) (*providerMetadata, error) {
+	return c.metadataCache.GetOrLoad(ctx, c.options.Issuer, func(ctx context.Context) (*providerMetadata, error) {
+		metadata := &providerMetadata{}
+
+		err := c.getJSON(ctx, strings.TrimSuffix(c.options.Issuer, "/")+enums.DiscoveryPath, "", metadata,
+			enums.ErrorProviderRequest)
+		if err != nil {
+			return nil, err
+		}
+
+		return metadata, metadata.validate(c.options.Issuer)
+	})
+}
+
+func (p *providerMetadata) validate(issuer string) error {
+	if strings.TrimSuffix(p.Issuer, "/") != strings.TrimSuffix(issuer, "/") {
+		return enums.ErrorIssuerMismatch
+	}
+
+	if p.AuthorizationEndpoint == "" || p.TokenEndpoint == "" || p.JWKSURI == "" {
+		return enums.ErrorInvalidMetadata
+	}
+
+	return nil
+}
+
+// keys returns the key set of the provider, reloading it when refresh is true, which is used when a token is signed
+// by an unknown key, since the providers rotate their keys without notice
+func (c *Client) keys(ctx context.Context, uri string, refresh bool) (keySet, error) {
+	if refresh {
+		c.keySetCache.Delete(uri)
+	}
+
+	return c.keySetCache.GetOrLoad(ctx, uri, func(ctx context.Context) (keySet, error) {
+		set := &jsonWebKeySet{}
+		if err := c.getJSON(ctx, uri, "", set, enums.ErrorProviderRequest); err != nil {
+			return nil, err
+		}
+
+		return parseKeySet(set)
+	})
+}
+
+func (c *Client) getJSON(ctx context.Context, url, accessToken string, result interface{}, failure error) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set(enums.HeaderAccept, enums.ContentTypeJSON)
+
+	if accessToken != "" {
+		req.Header.Set(enums.HeaderAuthorization, "Bearer "+accessToken)
+	}
+
+	return c.do(req, result, failure)
+}
+
+// parseKeySet ignores the keys that are not used for signatures or that have an unsupported type
+func parseKeySet(set *jsonWebKeySet) (keySet, error) {
+	keys := keySet{}
+
+	for index := range set.Keys {
+		if set.Keys[index].Use != "" && set.Keys[index].Use != enums.KeyUseSig {
+			continue
+		}
+
+		if key, err := set.Keys[index].publicKey(); err == nil {
+			keys[set.Keys[index].KeyID] = key
+		}
+	}
+
+	if len(keys) == 0 {
+		return nil, enums.ErrorKeyNotFound
+	}
+
+	return keys, nil
+}
+
+func (j *jsonWebKey) publicKey() (interface{}, error) {
+	switch j.KeyType {
+	case enums.KeyTypeRSA:
+		return j.rsaPublicKey()
+	case enums.KeyTypeEC:
+		return j.ecdsaPublicKey()
+	default:
+		return nil, enums.ErrorUnsupportedKey
+	}
+}
+
+func (j *jsonWebKey) rsaPublicKey() (*rsa.PublicKey, error) {
+	modulus, err := decodeBigInt(j.Modulus)
+	if err != nil {
+		return nil, err
+	}
+
+	exponent, err := decodeBigInt(j.Exponent)
+	if err != nil || !exponent.IsInt64() {
+		return nil, enums.ErrorUnsupportedKey
+	}
+
+	return &rsa.PublicKey{N: modulus, E: int(exponent.Int64())}, nil
+}
+
+func (j *jsonWebKey) ecdsaPublicKey() (*ecdsa.PublicKey, error) {
+	curves := map[string]elliptic.Curve{
+		enums.CurveP256: elliptic.P256(), enums.CurveP384: elliptic.P384(), enums.CurveP521: elliptic.P521(),
+	}
+
+	curve, ok := curves[j.Curve]
+	if !ok {
+		return nil, enums.ErrorUnsupportedKey
+	}
+
+	x, err := decodeBigInt(j.X)
+	if err != nil {
+		return nil, err
+	}
+
+	y, err := decodeBigInt(j.Y)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
+	if err != nil || len(data) == 0 {
+		return nil, enums.ErrorUnsupportedKey
+	}
+
+	return new(big.Int).SetBytes(data), nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entities
+
+import (
+	"encoding/json"
+)
+
+// Audience accepts the aud claim as a string or as an array, since the providers use both formats
+type Audience []string
+
+func (a *Audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = Audience{single}
+
+		return nil
+	}
+
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return err
+	}
+
+	*a = multiple
+
+	return nil
+}
+
+func (a Audience) Contains(value string) bool {
+	for _, audience := range a {
+		if audience == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+type RealmAccess struct {
+	Roles []string `json:"roles"`
+}
+
+// Profile has the standard claims of the user, shared by the id token and the userinfo response. The realm access
+// is only sent by keycloak, with the realm roles of the user.
+type Profile struct {
+	Subject           string      `json:"sub"`
+	Email             string      `json:"email,omitempty"`
+	EmailVerified     bool        `json:"email_verified,omitempty"`
+	Name              string      `json:"name,omitempty"`
+	PreferredUsername string      `json:"preferred_username,omitempty"`
+	Groups            []string    `json:"groups,omitempty"`
+	RealmAccess       RealmAccess `json:"realm_access,omitempty"`
+}
+
+// IDTokenClaims are the claims of a verified id token, the validation is done by the client, so Valid always
+// returns nil to let the jwt parser only check the signature
+type IDTokenClaims struct {
+	Profile
+	Issuer          string   `json:"iss"`
+	Audience        Audience `json:"aud"`
+	AuthorizedParty string   `json:"azp,omitempty"`
+	ExpiresAt       int64    `json:"exp"`
+	IssuedAt        int64    `json:"iat"`
+	Nonce           string   `json:"nonce,omitempty"`
+}
+
+func (c *IDTokenClaims) Valid() error {
+	return nil
+}
+
+// UserInfo is the response of the userinfo endpoint, the raw claims have the provider specific ones
+type UserInfo struct {
+	Profile
+	Raw map[string]interface{} `json:"-"`
+}
@@ -0,0 +1,61 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entities
+
+import "time"
+
+// AuthorizationRequest has the values of a login started by AuthorizationURL, the state, nonce and code verifier
+// are kept by the client until the callback, so the caller only redirects the user to the url
+type AuthorizationRequest struct {
+	URL          string    `json:"url"`
+	State        string    `json:"state"`
+	Nonce        string    `json:"-"`
+	CodeVerifier string    `json:"-"`
+	RedirectTo   string    `json:"-"`
+	ExpiresAt    time.Time `json:"expiresAt"`
+}
+
+// Tokens is the response of the token endpoint, the expiry is computed from the expires in when it is received
+type Tokens struct {
+	AccessToken  string    `json:"access_token"`
+	TokenType    string    `json:"token_type"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	IDToken      string    `json:"id_token,omitempty"`
+	Scope        string    `json:"scope,omitempty"`
+	ExpiresIn    int       `json:"expires_in,omitempty"`
+	Expiry       time.Time `json:"-"`
+}
+
+// LoginResult is returned by the callback handling with the tokens, the verified id token claims and the path
+// informed when the login was started
+type LoginResult struct {
+	Tokens     *Tokens
+	Claims     *IDTokenClaims
+	RedirectTo string
+}
+
+// TokenError is the error response of the token endpoint
+type TokenError struct {
+	Error       string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+func (t *TokenError) String() string {
+	if t.Description == "" {
+		return t.Error
+	}
+
+	return t.Error + ": " + t.Description
+}
@@ -0,0 +1,35 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+import "errors"
+
+var (
+	ErrorMissingIssuer       = errors.New("{ERROR_OIDC} issuer is required, set it or the keycloak base path and realm")
+	ErrorProviderRequest     = errors.New("{ERROR_OIDC} request to the provider failed")
+	ErrorInvalidMetadata     = errors.New("{ERROR_OIDC} provider metadata is missing required endpoints")
+	ErrorIssuerMismatch      = errors.New("{ERROR_OIDC} issuer of the provider metadata does not match")
+	ErrorAuthorizationDenied = errors.New("{ERROR_OIDC} authorization denied by the provider")
+	ErrorInvalidState        = errors.New("{ERROR_OIDC} invalid or expired state")
+	ErrorMissingCode         = errors.New("{ERROR_OIDC} authorization code is missing on the callback")
+	ErrorInvalidCallbackIss  = errors.New("{ERROR_OIDC} issuer of the callback does not match")
+	ErrorTokenRequest        = errors.New("{ERROR_OIDC} token request failed")
+	ErrorMissingIDToken      = errors.New("{ERROR_OIDC} token response does not have an id token")
+	ErrorInvalidIDToken      = errors.New("{ERROR_OIDC} invalid id token")
+	ErrorKeyNotFound         = errors.New("{ERROR_OIDC} signing key not found on the provider key set")
+	ErrorUnsupportedKey      = errors.New("{ERROR_OIDC} unsupported key on the provider key set")
+	ErrorUserInfoRequest     = errors.New("{ERROR_OIDC} userinfo request failed")
+	ErrorMissingUserInfo     = errors.New("{ERROR_OIDC} provider does not have an userinfo endpoint")
+)
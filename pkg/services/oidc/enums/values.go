@@ -0,0 +1,79 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+import "time"
+
+const (
+	HorusecOIDCIssuer          = "HORUSEC_OIDC_ISSUER"
+	HorusecOIDCClientID        = "HORUSEC_OIDC_CLIENT_ID"
+	HorusecOIDCClientSecret    = "HORUSEC_OIDC_CLIENT_SECRET"
+	HorusecOIDCRedirectURL     = "HORUSEC_OIDC_REDIRECT_URL"
+	HorusecOIDCScopes          = "HORUSEC_OIDC_SCOPES"
+	HorusecOIDCTimeoutSeconds  = "HORUSEC_OIDC_TIMEOUT_SECONDS"
+	HorusecOIDCStateTTL        = "HORUSEC_OIDC_STATE_TTL"
+	HorusecOIDCClockSkew       = "HORUSEC_OIDC_CLOCK_SKEW"
+	HorusecKeycloakBasePath    = "HORUSEC_KEYCLOAK_BASE_PATH"
+	HorusecKeycloakRealm       = "HORUSEC_KEYCLOAK_REALM"
+	DefaultTimeoutSeconds      = 10
+	DefaultStateTTL            = 10 * time.Minute
+	DefaultClockSkew           = time.Minute
+	DefaultMetadataTTL         = time.Hour
+	DefaultStateMaxEntries     = 10000
+	KeycloakIssuerFormat       = "%s/realms/%s"
+	DiscoveryPath              = "/.well-known/openid-configuration"
+	RandomValueBytes           = 32
+	CodeChallengeMethodS256    = "S256"
+	ResponseTypeCode           = "code"
+	GrantTypeAuthorizationCode = "authorization_code"
+	GrantTypeRefreshToken      = "refresh_token"
+	ScopeOpenID                = "openid"
+	HeaderAuthorization        = "Authorization"
+	HeaderContentType          = "Content-Type"
+	HeaderAccept               = "Accept"
+	ContentTypeForm            = "application/x-www-form-urlencoded"
+	ContentTypeJSON            = "application/json"
+	MetadataCacheName          = "oidc_metadata"
+	KeySetCacheName            = "oidc_key_set"
+	StateCacheName             = "oidc_state"
+)
+
+const (
+	ParamResponseType        = "response_type"
+	ParamClientID            = "client_id"
+	ParamClientSecret        = "client_secret"
+	ParamRedirectURI         = "redirect_uri"
+	ParamScope               = "scope"
+	ParamState               = "state"
+	ParamNonce               = "nonce"
+	ParamCodeChallenge       = "code_challenge"
+	ParamCodeChallengeMethod = "code_challenge_method"
+	ParamCodeVerifier        = "code_verifier"
+	ParamCode                = "code"
+	ParamGrantType           = "grant_type"
+	ParamRefreshToken        = "refresh_token"
+	ParamError               = "error"
+	ParamErrorDescription    = "error_description"
+	ParamIssuer              = "iss"
+)
+
+const (
+	KeyTypeRSA = "RSA"
+	KeyTypeEC  = "EC"
+	KeyUseSig  = "sig"
+	CurveP256  = "P-256"
+	CurveP384  = "P-384"
+	CurveP521  = "P-521"
+)
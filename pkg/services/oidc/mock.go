@@ -0,0 +1,65 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/oidc/entities"
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) AuthorizationURL(_ context.Context, _ string) (*entities.AuthorizationRequest, error) {
+	args := m.MethodCalled("AuthorizationURL")
+
+	return args.Get(0).(*entities.AuthorizationRequest), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) HandleCallback(_ context.Context, _ url.Values) (*entities.LoginResult, error) {
+	args := m.MethodCalled("HandleCallback")
+
+	return args.Get(0).(*entities.LoginResult), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) Exchange(_ context.Context, _, _ string) (*entities.Tokens, error) {
+	args := m.MethodCalled("Exchange")
+
+	return args.Get(0).(*entities.Tokens), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) Refresh(_ context.Context, _ string) (*entities.Tokens, error) {
+	args := m.MethodCalled("Refresh")
+
+	return args.Get(0).(*entities.Tokens), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) VerifyIDToken(_ context.Context, _, _ string) (*entities.IDTokenClaims, error) {
+	args := m.MethodCalled("VerifyIDToken")
+
+	return args.Get(0).(*entities.IDTokenClaims), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) UserInfo(_ context.Context, _ string) (*entities.UserInfo, error) {
+	args := m.MethodCalled("UserInfo")
+
+	return args.Get(0).(*entities.UserInfo), mockUtils.ReturnNilOrError(args, 1)
+}
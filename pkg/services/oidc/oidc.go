@@ -0,0 +1,372 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/cache/ttl"
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/request"
+	"github.com/ZupIT/horusec-devkit/pkg/services/oidc/entities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/oidc/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+)
+
+type IClient interface {
+	AuthorizationURL(ctx context.Context, redirectTo string) (*entities.AuthorizationRequest, error)
+	HandleCallback(ctx context.Context, query url.Values) (*entities.LoginResult, error)
+	Exchange(ctx context.Context, code, codeVerifier string) (*entities.Tokens, error)
+	Refresh(ctx context.Context, refreshToken string) (*entities.Tokens, error)
+	VerifyIDToken(ctx context.Context, rawIDToken, nonce string) (*entities.IDTokenClaims, error)
+	UserInfo(ctx context.Context, accessToken string) (*entities.UserInfo, error)
+}
+
+// Client implements the authorization code flow with pkce of an openid connect provider, like keycloak. The
+// discovery document and the key set of the provider are loaded on the first use and cached.
+type Client struct {
+	options       *Options
+	request       request.IRequest
+	states        IStateStore
+	clock         clock.IClock
+	metadataCache ttl.ICache[string, *providerMetadata]
+	keySetCache   ttl.ICache[string, keySet]
+}
+
+// NewClient returns a client for the provider of the options, the states are kept in memory when the store is nil
+func NewClient(options *Options, states IStateStore) (IClient, error) {
+	if options.Issuer == "" {
+		return nil, enums.ErrorMissingIssuer
+	}
+
+	clk := clock.OrDefault(options.Clock)
+	if states == nil {
+		states = NewMemoryStateStore(options.StateTTL, clk)
+	}
+
+	return &Client{
+		options:       options,
+		request:       request.NewHTTPRequestService(options.Timeout),
+		states:        states,
+		clock:         clk,
+		metadataCache: newCache[*providerMetadata](enums.MetadataCacheName, clk),
+		keySetCache:   newCache[keySet](enums.KeySetCacheName, clk),
+	}, nil
+}
+
+func newCache[V any](name string, clk clock.IClock) ttl.ICache[string, V] {
+	options := ttl.NewOptions(name)
+	options.TTL = enums.DefaultMetadataTTL
+	options.Clock = clk
+
+	return ttl.NewCache[string, V](options)
+}
+
+// AuthorizationURL starts a login, saving the state, nonce and code verifier of the request on the state store.
+// The redirect to is returned on the callback and must be validated by the caller before being used.
+func (c *Client) AuthorizationURL(ctx context.Context, redirectTo string) (*entities.AuthorizationRequest, error) {
+	metadata, err := c.metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	authorization, err := c.newAuthorizationRequest(redirectTo)
+	if err != nil {
+		return nil, err
+	}
+
+	authorization.URL = metadata.AuthorizationEndpoint + querySeparator(metadata.AuthorizationEndpoint) +
+		url.Values{
+			enums.ParamResponseType:        {enums.ResponseTypeCode},
+			enums.ParamClientID:            {c.options.ClientID},
+			enums.ParamRedirectURI:         {c.options.RedirectURL},
+			enums.ParamScope:               {c.options.scopes()},
+			enums.ParamState:               {authorization.State},
+			enums.ParamNonce:               {authorization.Nonce},
+			enums.ParamCodeChallenge:       {codeChallenge(authorization.CodeVerifier)},
+			enums.ParamCodeChallengeMethod: {enums.CodeChallengeMethodS256},
+		}.Encode()
+
+	return authorization, c.states.Save(ctx, authorization)
+}
+
+func (c *Client) newAuthorizationRequest(redirectTo string) (*entities.AuthorizationRequest, error) {
+	values := make([]string, 3) // nolint:gomnd // state, nonce and code verifier
+
+	for index := range values {
+		value, err := randomValue()
+		if err != nil {
+			return nil, err
+		}
+
+		values[index] = value
+	}
+
+	return &entities.AuthorizationRequest{
+		State:        values[0],
+		Nonce:        values[1],
+		CodeVerifier: values[2],
+		RedirectTo:   redirectTo,
+		ExpiresAt:    c.clock.Now().Add(c.options.StateTTL),
+	}, nil
+}
+
+// HandleCallback validates the query of the redirect uri, consuming its state, exchanges the code by the tokens and
+// verifies the id token with the nonce of the authorization request
+func (c *Client) HandleCallback(ctx context.Context, query url.Values) (*entities.LoginResult, error) {
+	authorization, err := c.validateCallback(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := c.Exchange(ctx, query.Get(enums.ParamCode), authorization.CodeVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	if tokens.IDToken == "" {
+		return nil, enums.ErrorMissingIDToken
+	}
+
+	claims, err := c.VerifyIDToken(ctx, tokens.IDToken, authorization.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.LoginResult{Tokens: tokens, Claims: claims, RedirectTo: authorization.RedirectTo}, nil
+}
+
+// validateCallback always consumes the state, so a denied or invalid callback can not be retried with it
+func (c *Client) validateCallback(ctx context.Context, query url.Values) (*entities.AuthorizationRequest, error) {
+	authorization, err := c.states.Consume(ctx, query.Get(enums.ParamState))
+
+	if query.Get(enums.ParamError) != "" {
+		return nil, fmt.Errorf("%w: %s", enums.ErrorAuthorizationDenied, (&entities.TokenError{
+			Error: query.Get(enums.ParamError), Description: query.Get(enums.ParamErrorDescription),
+		}).String())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.validateCallbackIssuer(ctx, query.Get(enums.ParamIssuer)); err != nil {
+		return nil, err
+	}
+
+	if query.Get(enums.ParamCode) == "" {
+		return nil, enums.ErrorMissingCode
+	}
+
+	return authorization, nil
+}
+
+// validateCallbackIssuer checks the iss parameter sent by the providers that support the mix-up attack mitigation
+func (c *Client) validateCallbackIssuer(ctx context.Context, issuer string) error {
+	if issuer == "" {
+		return nil
+	}
+
+	metadata, err := c.metadata(ctx)
+	if err != nil {
+		return err
+	}
+
+	if issuer != metadata.Issuer {
+		return enums.ErrorInvalidCallbackIss
+	}
+
+	return nil
+}
+
+func (c *Client) Exchange(ctx context.Context, code, codeVerifier string) (*entities.Tokens, error) {
+	return c.tokenRequest(ctx, url.Values{
+		enums.ParamGrantType:    {enums.GrantTypeAuthorizationCode},
+		enums.ParamCode:         {code},
+		enums.ParamRedirectURI:  {c.options.RedirectURL},
+		enums.ParamCodeVerifier: {codeVerifier},
+	})
+}
+
+func (c *Client) Refresh(ctx context.Context, refreshToken string) (*entities.Tokens, error) {
+	return c.tokenRequest(ctx, url.Values{
+		enums.ParamGrantType:    {enums.GrantTypeRefreshToken},
+		enums.ParamRefreshToken: {refreshToken},
+	})
+}
+
+// tokenRequest authenticates with the client secret basic method when there is a secret, otherwise the client id is
+// sent on the body as a public client
+func (c *Client) tokenRequest(ctx context.Context, form url.Values) (*entities.Tokens, error) {
+	metadata, err := c.metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.options.ClientSecret == "" {
+		form.Set(enums.ParamClientID, c.options.ClientID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, metadata.TokenEndpoint,
+		strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set(enums.HeaderContentType, enums.ContentTypeForm)
+	req.Header.Set(enums.HeaderAccept, enums.ContentTypeJSON)
+
+	if c.options.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(c.options.ClientID), url.QueryEscape(c.options.ClientSecret))
+	}
+
+	tokens := &entities.Tokens{}
+	if err := c.do(req, tokens, enums.ErrorTokenRequest); err != nil {
+		return nil, err
+	}
+
+	if tokens.ExpiresIn > 0 {
+		tokens.Expiry = c.clock.Now().Add(time.Duration(tokens.ExpiresIn) * time.Second)
+	}
+
+	return tokens, nil
+}
+
+// VerifyIDToken checks the signature of the id token with the provider keys and validates its claims. The nonce is
+// only compared when it is not empty, since the id tokens of a refresh do not have it.
+func (c *Client) VerifyIDToken(ctx context.Context, rawIDToken, nonce string) (*entities.IDTokenClaims, error) {
+	metadata, err := c.metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := &jwt.Parser{ValidMethods: []string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"},
+		SkipClaimsValidation: true}
+
+	claims := &entities.IDTokenClaims{}
+	if _, err := parser.ParseWithClaims(rawIDToken, claims, func(token *jwt.Token) (interface{}, error) {
+		return c.signingKey(ctx, metadata.JWKSURI, token)
+	}); err != nil {
+		return nil, fmt.Errorf("%w: %s", enums.ErrorInvalidIDToken, err.Error())
+	}
+
+	if message := c.validateClaims(claims, metadata.Issuer, nonce); message != "" {
+		return nil, fmt.Errorf("%w: %s", enums.ErrorInvalidIDToken, message)
+	}
+
+	return claims, nil
+}
+
+func (c *Client) signingKey(ctx context.Context, uri string, token *jwt.Token) (interface{}, error) {
+	keyID, _ := token.Header["kid"].(string)
+
+	for _, refresh := range []bool{false, true} {
+		keys, err := c.keys(ctx, uri, refresh)
+		if err != nil {
+			return nil, err
+		}
+
+		if key, ok := keys[keyID]; ok {
+			return key, nil
+		}
+	}
+
+	return nil, enums.ErrorKeyNotFound
+}
+
+// validateClaims returns the message of the first invalid claim, or empty when every claim is valid
+func (c *Client) validateClaims(claims *entities.IDTokenClaims, issuer, nonce string) string {
+	now := c.clock.Now()
+
+	switch {
+	case claims.Issuer != issuer:
+		return enums.MessageInvalidIssuer
+	case !claims.Audience.Contains(c.options.ClientID):
+		return enums.MessageInvalidAudience
+	case (len(claims.Audience) > 1 || claims.AuthorizedParty != "") && claims.AuthorizedParty != c.options.ClientID:
+		return enums.MessageInvalidParty
+	case claims.Subject == "":
+		return enums.MessageMissingSubject
+	case now.Add(-c.options.ClockSkew).After(time.Unix(claims.ExpiresAt, 0)):
+		return enums.MessageTokenExpired
+	case now.Add(c.options.ClockSkew).Before(time.Unix(claims.IssuedAt, 0)):
+		return enums.MessageTokenNotIssued
+	case nonce != "" && claims.Nonce != nonce:
+		return enums.MessageInvalidNonce
+	}
+
+	return ""
+}
+
+// UserInfo returns the claims of the userinfo endpoint, including the provider specific ones on the raw claims
+func (c *Client) UserInfo(ctx context.Context, accessToken string) (*entities.UserInfo, error) {
+	metadata, err := c.metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if metadata.UserInfoEndpoint == "" {
+		return nil, enums.ErrorMissingUserInfo
+	}
+
+	raw := json.RawMessage{}
+	if err := c.getJSON(ctx, metadata.UserInfoEndpoint, accessToken, &raw, enums.ErrorUserInfoRequest); err != nil {
+		return nil, err
+	}
+
+	userInfo := &entities.UserInfo{}
+	if err := json.Unmarshal(raw, userInfo); err != nil {
+		return nil, err
+	}
+
+	return userInfo, json.Unmarshal(raw, &userInfo.Raw)
+}
+
+// do sends the request and decodes the json response, the error responses are returned wrapped by the failure
+// error with the error and description sent by the provider
+func (c *Client) do(req *http.Request, result interface{}, failure error) error {
+	response, err := c.request.DoRequest(req, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %s", failure, err.Error())
+	}
+
+	defer response.CloseBody()
+
+	if response.GetStatusCode() >= http.StatusBadRequest {
+		tokenError := &entities.TokenError{}
+		if json.NewDecoder(response.Body).Decode(tokenError) != nil || tokenError.Error == "" {
+			tokenError.Error = response.GetStatusCodeString()
+		}
+
+		return fmt.Errorf("%w: %s", failure, tokenError.String())
+	}
+
+	return json.NewDecoder(response.Body).Decode(result)
+}
+
+func querySeparator(endpoint string) string {
+	if strings.Contains(endpoint, "?") {
+		return "&"
+	}
+
+	return "?"
+}
@@ -0,0 +1,412 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/oidc/entities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/oidc/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+)
+
+type testProvider struct {
+	server     *httptest.Server
+	mutex      sync.Mutex
+	key        *rsa.PrivateKey
+	keyID      string
+	challenges map[string]string
+	nonces     map[string]string
+	basicAuth  string
+}
+
+func newTestProvider(t *testing.T) *testProvider {
+	provider := &testProvider{keyID: "key-1", challenges: map[string]string{}, nonces: map[string]string{}}
+	provider.rotateKey(t, "key-1")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(enums.DiscoveryPath, provider.discovery)
+	mux.HandleFunc("/keys", provider.keys)
+	mux.HandleFunc("/token", provider.token)
+	mux.HandleFunc("/userinfo", provider.userInfo)
+
+	provider.server = httptest.NewServer(mux)
+	t.Cleanup(provider.server.Close)
+
+	return provider
+}
+
+func (p *testProvider) rotateKey(t *testing.T, keyID string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.key, p.keyID = key, keyID
+}
+
+func (p *testProvider) discovery(w http.ResponseWriter, _ *http.Request) {
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"issuer":                 p.server.URL,
+		"authorization_endpoint": p.server.URL + "/auth",
+		"token_endpoint":         p.server.URL + "/token",
+		"userinfo_endpoint":      p.server.URL + "/userinfo",
+		"jwks_uri":               p.server.URL + "/keys",
+	})
+}
+
+func (p *testProvider) keys(w http.ResponseWriter, _ *http.Request) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"keys": []map[string]string{
+		{"kty": "RSA", "use": "enc", "kid": "encryption", "n": "AQAB", "e": "AQAB"},
+		{"kty": "RSA", "use": "sig", "kid": p.keyID, "e": "AQAB",
+			"n": base64.RawURLEncoding.EncodeToString(p.key.N.Bytes())},
+	}})
+}
+
+func (p *testProvider) authorize(t *testing.T, authorizationURL, code string) {
+	parsed, err := url.Parse(authorizationURL)
+	assert.NoError(t, err)
+	assert.Equal(t, enums.CodeChallengeMethodS256, parsed.Query().Get(enums.ParamCodeChallengeMethod))
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.challenges[code] = parsed.Query().Get(enums.ParamCodeChallenge)
+	p.nonces[code] = parsed.Query().Get(enums.ParamNonce)
+}
+
+func (p *testProvider) token(w http.ResponseWriter, r *http.Request) {
+	_ = r.ParseForm()
+
+	p.mutex.Lock()
+	p.basicAuth = r.Header.Get(enums.HeaderAuthorization)
+	challenge, ok := p.challenges[r.Form.Get(enums.ParamCode)]
+	nonce := p.nonces[r.Form.Get(enums.ParamCode)]
+	p.mutex.Unlock()
+
+	if r.Form.Get(enums.ParamGrantType) == enums.GrantTypeAuthorizationCode &&
+		(!ok || codeChallenge(r.Form.Get(enums.ParamCodeVerifier)) != challenge) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"invalid_grant","error_description":"invalid code"}`))
+
+		return
+	}
+
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"access_token": "access", "token_type": "Bearer", "refresh_token": "refresh", "expires_in": 300,
+		"id_token": p.sign(jwt.MapClaims{"nonce": nonce}),
+	})
+}
+
+func (p *testProvider) userInfo(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get(enums.HeaderAuthorization) != "Bearer access" {
+		w.WriteHeader(http.StatusUnauthorized)
+
+		return
+	}
+
+	_, _ = w.Write([]byte(`{"sub":"user-id","email":"user@horusec.io","realm_access":{"roles":["admin"]},"x":"y"}`))
+}
+
+// sign returns an id token with valid claims, replaced by the informed ones
+func (p *testProvider) sign(claims jwt.MapClaims) string {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	values := jwt.MapClaims{
+		"iss": p.server.URL, "aud": "horusec", "sub": "user-id", "email": "user@horusec.io",
+		"iat": time.Now().Unix(), "exp": time.Now().Add(time.Hour).Unix(),
+	}
+	for key, value := range claims {
+		values[key] = value
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, values)
+	token.Header["kid"] = p.keyID
+
+	signed, _ := token.SignedString(p.key)
+
+	return signed
+}
+
+func newTestClient(t *testing.T, provider *testProvider, clk clock.IClock) IClient {
+	options := NewOptions()
+	options.Issuer = provider.server.URL
+	options.ClientID = "horusec"
+	options.RedirectURL = "http://localhost/callback"
+	options.Clock = clk
+
+	client, err := NewClient(options, nil)
+	assert.NoError(t, err)
+
+	return client
+}
+
+func startLogin(t *testing.T, provider *testProvider, client IClient, code string) url.Values {
+	authorization, err := client.AuthorizationURL(context.Background(), "/home")
+	assert.NoError(t, err)
+
+	provider.authorize(t, authorization.URL, code)
+
+	return url.Values{enums.ParamState: {authorization.State}, enums.ParamCode: {code}}
+}
+
+func TestNewClient(t *testing.T) {
+	t.Run("should return error when issuer is empty", func(t *testing.T) {
+		_, err := NewClient(&Options{}, nil)
+
+		assert.ErrorIs(t, err, enums.ErrorMissingIssuer)
+	})
+}
+
+func TestNewOptions(t *testing.T) {
+	t.Run("should use keycloak realm issuer when issuer is empty", func(t *testing.T) {
+		t.Setenv(enums.HorusecKeycloakBasePath, "http://keycloak:8080/")
+		t.Setenv(enums.HorusecKeycloakRealm, "horusec")
+
+		assert.Equal(t, "http://keycloak:8080/realms/horusec", NewOptions().Issuer)
+	})
+
+	t.Run("should always request openid scope", func(t *testing.T) {
+		assert.Equal(t, "openid email", (&Options{Scopes: []string{"email"}}).scopes())
+		assert.Equal(t, "email openid", (&Options{Scopes: []string{"email", "openid"}}).scopes())
+	})
+}
+
+func TestAuthorizationURL(t *testing.T) {
+	t.Run("should return authorization url with pkce state and nonce", func(t *testing.T) {
+		provider := newTestProvider(t)
+
+		authorization, err := newTestClient(t, provider, nil).AuthorizationURL(context.Background(), "/home")
+		assert.NoError(t, err)
+
+		parsed, _ := url.Parse(authorization.URL)
+		assert.Equal(t, provider.server.URL+"/auth", parsed.Scheme+"://"+parsed.Host+parsed.Path)
+		assert.Equal(t, authorization.State, parsed.Query().Get(enums.ParamState))
+		assert.Equal(t, authorization.Nonce, parsed.Query().Get(enums.ParamNonce))
+		assert.Equal(t, codeChallenge(authorization.CodeVerifier), parsed.Query().Get(enums.ParamCodeChallenge))
+		assert.Equal(t, "code", parsed.Query().Get(enums.ParamResponseType))
+		assert.Len(t, authorization.CodeVerifier, 43)
+	})
+}
+
+func TestHandleCallback(t *testing.T) {
+	t.Run("should exchange code and verify id token", func(t *testing.T) {
+		provider := newTestProvider(t)
+		client := newTestClient(t, provider, nil)
+
+		result, err := client.HandleCallback(context.Background(), startLogin(t, provider, client, "code-1"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "access", result.Tokens.AccessToken)
+		assert.False(t, result.Tokens.Expiry.IsZero())
+		assert.Equal(t, "user@horusec.io", result.Claims.Email)
+		assert.Equal(t, "/home", result.RedirectTo)
+		assert.Empty(t, provider.basicAuth)
+	})
+
+	t.Run("should return error when state is replayed", func(t *testing.T) {
+		provider := newTestProvider(t)
+		client := newTestClient(t, provider, nil)
+		query := startLogin(t, provider, client, "code-1")
+
+		_, err := client.HandleCallback(context.Background(), query)
+		assert.NoError(t, err)
+
+		_, err = client.HandleCallback(context.Background(), query)
+		assert.ErrorIs(t, err, enums.ErrorInvalidState)
+	})
+
+	t.Run("should return error when state is expired", func(t *testing.T) {
+		provider := newTestProvider(t)
+		fakeClock := clock.NewFakeClock(time.Now())
+		client := newTestClient(t, provider, fakeClock)
+		query := startLogin(t, provider, client, "code-1")
+
+		fakeClock.Advance(enums.DefaultStateTTL + time.Second)
+
+		_, err := client.HandleCallback(context.Background(), query)
+		assert.ErrorIs(t, err, enums.ErrorInvalidState)
+	})
+
+	t.Run("should return error when provider denied the authorization", func(t *testing.T) {
+		provider := newTestProvider(t)
+		client := newTestClient(t, provider, nil)
+		query := startLogin(t, provider, client, "code-1")
+		query.Set(enums.ParamError, "access_denied")
+
+		_, err := client.HandleCallback(context.Background(), query)
+		assert.ErrorIs(t, err, enums.ErrorAuthorizationDenied)
+		assert.Contains(t, err.Error(), "access_denied")
+	})
+
+	t.Run("should return error when callback issuer does not match", func(t *testing.T) {
+		provider := newTestProvider(t)
+		client := newTestClient(t, provider, nil)
+		query := startLogin(t, provider, client, "code-1")
+		query.Set(enums.ParamIssuer, "http://attacker")
+
+		_, err := client.HandleCallback(context.Background(), query)
+		assert.ErrorIs(t, err, enums.ErrorInvalidCallbackIss)
+	})
+
+	t.Run("should return error when code is missing", func(t *testing.T) {
+		provider := newTestProvider(t)
+		client := newTestClient(t, provider, nil)
+		query := startLogin(t, provider, client, "code-1")
+		query.Del(enums.ParamCode)
+
+		_, err := client.HandleCallback(context.Background(), query)
+		assert.ErrorIs(t, err, enums.ErrorMissingCode)
+	})
+
+	t.Run("should return token error when code verifier is invalid", func(t *testing.T) {
+		provider := newTestProvider(t)
+		client := newTestClient(t, provider, nil)
+
+		_, err := client.Exchange(context.Background(), "code-1", "invalid")
+		assert.ErrorIs(t, err, enums.ErrorTokenRequest)
+		assert.Contains(t, err.Error(), "invalid_grant: invalid code")
+	})
+}
+
+func TestRefresh(t *testing.T) {
+	t.Run("should authenticate with client secret basic", func(t *testing.T) {
+		provider := newTestProvider(t)
+		options := NewOptions()
+		options.Issuer, options.ClientID, options.ClientSecret = provider.server.URL, "horusec", "secret"
+
+		client, err := NewClient(options, nil)
+		assert.NoError(t, err)
+
+		tokens, err := client.Refresh(context.Background(), "refresh")
+		assert.NoError(t, err)
+		assert.Equal(t, "refresh", tokens.RefreshToken)
+		assert.Equal(t, "Basic "+base64.StdEncoding.EncodeToString([]byte("horusec:secret")), provider.basicAuth)
+	})
+}
+
+func TestVerifyIDToken(t *testing.T) {
+	provider := newTestProvider(t)
+
+	t.Run("should return error when claims are invalid", func(t *testing.T) {
+		client := newTestClient(t, provider, nil)
+
+		for message, claims := range map[string]jwt.MapClaims{
+			enums.MessageInvalidIssuer:   {"iss": "http://other"},
+			enums.MessageInvalidAudience: {"aud": []string{"other"}},
+			enums.MessageInvalidParty:    {"aud": []string{"horusec", "other"}},
+			enums.MessageMissingSubject:  {"sub": ""},
+			enums.MessageTokenExpired:    {"exp": time.Now().Add(-time.Hour).Unix()},
+			enums.MessageTokenNotIssued:  {"iat": time.Now().Add(time.Hour).Unix()},
+			enums.MessageInvalidNonce:    {"nonce": "other"},
+		} {
+			_, err := client.VerifyIDToken(context.Background(), provider.sign(claims), "nonce")
+
+			assert.ErrorIs(t, err, enums.ErrorInvalidIDToken)
+			assert.Contains(t, err.Error(), message)
+		}
+	})
+
+	t.Run("should accept audience array with authorized party", func(t *testing.T) {
+		claims, err := newTestClient(t, provider, nil).VerifyIDToken(context.Background(),
+			provider.sign(jwt.MapClaims{"aud": []string{"horusec", "other"}, "azp": "horusec"}), "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, entities.Audience{"horusec", "other"}, claims.Audience)
+	})
+
+	t.Run("should reload keys when token is signed by a new key", func(t *testing.T) {
+		client := newTestClient(t, provider, nil)
+
+		_, err := client.VerifyIDToken(context.Background(), provider.sign(nil), "")
+		assert.NoError(t, err)
+
+		provider.rotateKey(t, "key-2")
+
+		_, err = client.VerifyIDToken(context.Background(), provider.sign(nil), "")
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when signature is invalid", func(t *testing.T) {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"iss": provider.server.URL})
+		signed, _ := token.SignedString([]byte("secret"))
+
+		_, err := newTestClient(t, provider, nil).VerifyIDToken(context.Background(), signed, "")
+
+		assert.ErrorIs(t, err, enums.ErrorInvalidIDToken)
+	})
+}
+
+func TestUserInfo(t *testing.T) {
+	t.Run("should return userinfo with raw claims", func(t *testing.T) {
+		userInfo, err := newTestClient(t, newTestProvider(t), nil).UserInfo(context.Background(), "access")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "user-id", userInfo.Subject)
+		assert.Equal(t, []string{"admin"}, userInfo.RealmAccess.Roles)
+		assert.Equal(t, "y", userInfo.Raw["x"])
+	})
+
+	t.Run("should return error when access token is invalid", func(t *testing.T) {
+		_, err := newTestClient(t, newTestProvider(t), nil).UserInfo(context.Background(), "invalid")
+
+		assert.ErrorIs(t, err, enums.ErrorUserInfoRequest)
+	})
+}
+
+func TestParseKeySet(t *testing.T) {
+	t.Run("should parse elliptic curve keys", func(t *testing.T) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		assert.NoError(t, err)
+
+		keys, err := parseKeySet(&jsonWebKeySet{Keys: []jsonWebKey{{
+			KeyID: "ec", KeyType: "EC", Curve: "P-256",
+			X: base64.RawURLEncoding.EncodeToString(key.X.Bytes()),
+			Y: base64.RawURLEncoding.EncodeToString(key.Y.Bytes()),
+		}}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, &key.PublicKey, keys["ec"])
+	})
+
+	t.Run("should return error when there is no supported key", func(t *testing.T) {
+		_, err := parseKeySet(&jsonWebKeySet{Keys: []jsonWebKey{{KeyType: "oct"}, {KeyType: "RSA", Modulus: "!"},
+			{KeyType: "RSA", Modulus: "AQAB", Exponent: base64.RawURLEncoding.EncodeToString(big.NewInt(1).
+				Lsh(big.NewInt(1), 70).Bytes())}}})
+
+		assert.ErrorIs(t, err, enums.ErrorKeyNotFound)
+	})
+}
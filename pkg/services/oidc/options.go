@@ -0,0 +1,80 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/oidc/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// Options configures the login with an openid connect provider. When the issuer is empty and the keycloak base path
+// and realm are set, the issuer of the keycloak realm is used, so the auth service keeps its keycloak variables.
+// The clock skew is tolerated on the time claims of the id token.
+type Options struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+	Timeout      int
+	StateTTL     time.Duration
+	ClockSkew    time.Duration
+	Clock        clock.IClock
+}
+
+func NewOptions() *Options {
+	issuer := env.GetEnvOrDefault(enums.HorusecOIDCIssuer, "")
+	if issuer == "" {
+		issuer = KeycloakIssuer(env.GetEnvOrDefault(enums.HorusecKeycloakBasePath, ""),
+			env.GetEnvOrDefault(enums.HorusecKeycloakRealm, ""))
+	}
+
+	return &Options{
+		Issuer:       issuer,
+		ClientID:     env.GetEnvOrDefault(enums.HorusecOIDCClientID, ""),
+		ClientSecret: env.GetEnvOrDefault(enums.HorusecOIDCClientSecret, ""),
+		RedirectURL:  env.GetEnvOrDefault(enums.HorusecOIDCRedirectURL, ""),
+		Scopes:       env.GetStringSlice(enums.HorusecOIDCScopes, []string{enums.ScopeOpenID, "profile", "email"}),
+		Timeout:      env.GetEnvOrDefaultInt(enums.HorusecOIDCTimeoutSeconds, enums.DefaultTimeoutSeconds),
+		StateTTL:     env.GetDuration(enums.HorusecOIDCStateTTL, enums.DefaultStateTTL),
+		ClockSkew:    env.GetDuration(enums.HorusecOIDCClockSkew, enums.DefaultClockSkew),
+		Clock:        clock.NewClock(),
+	}
+}
+
+// KeycloakIssuer returns the issuer of a keycloak realm, or empty when the base path or the realm are empty
+func KeycloakIssuer(basePath, realm string) string {
+	if basePath == "" || realm == "" {
+		return ""
+	}
+
+	return fmt.Sprintf(enums.KeycloakIssuerFormat, strings.TrimSuffix(basePath, "/"), realm)
+}
+
+// scopes returns the scopes always including openid, which is required to receive the id token
+func (o *Options) scopes() string {
+	for _, scope := range o.Scopes {
+		if scope == enums.ScopeOpenID {
+			return strings.Join(o.Scopes, " ")
+		}
+	}
+
+	return strings.Join(append([]string{enums.ScopeOpenID}, o.Scopes...), " ")
+}
@@ -0,0 +1,70 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/cache/ttl"
+	"github.com/ZupIT/horusec-devkit/pkg/services/oidc/entities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/oidc/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+)
+
+// IStateStore keeps the authorization requests until the callback. Consume must return each request only once, so a
+// callback can not be replayed, and services with more than one replica should use a shared store.
+type IStateStore interface {
+	Save(ctx context.Context, request *entities.AuthorizationRequest) error
+	Consume(ctx context.Context, state string) (*entities.AuthorizationRequest, error)
+}
+
+type memoryStateStore struct {
+	mutex    sync.Mutex
+	requests ttl.ICache[string, *entities.AuthorizationRequest]
+	clock    clock.IClock
+}
+
+// NewMemoryStateStore returns a store that keeps the requests in memory, which only works with a single replica
+func NewMemoryStateStore(stateTTL time.Duration, clk clock.IClock) IStateStore {
+	options := ttl.NewOptions(enums.StateCacheName)
+	options.TTL = stateTTL
+	options.MaxEntries = enums.DefaultStateMaxEntries
+	options.Clock = clock.OrDefault(clk)
+
+	return &memoryStateStore{requests: ttl.NewCache[string, *entities.AuthorizationRequest](options),
+		clock: options.Clock}
+}
+
+func (m *memoryStateStore) Save(_ context.Context, request *entities.AuthorizationRequest) error {
+	m.requests.SetWithTTL(request.State, request, request.ExpiresAt.Sub(m.clock.Now()))
+
+	return nil
+}
+
+func (m *memoryStateStore) Consume(_ context.Context, state string) (*entities.AuthorizationRequest, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	request, ok := m.requests.Get(state)
+	if !ok {
+		return nil, enums.ErrorInvalidState
+	}
+
+	m.requests.Delete(state)
+
+	return request, nil
+}
@@ -0,0 +1,39 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/onetimetoken/enums"
+)
+
+// Token is the stored data of an issued token, only the sha256 hash of the token is kept, so a leaked store can not
+// be used to verify emails or reset passwords. The email is the one the token was sent to, which must be compared
+// with the current email of the account before confirming it.
+type Token struct {
+	Hash      string        `json:"hash"`
+	AccountID uuid.UUID     `json:"accountID"`
+	Email     string        `json:"email"`
+	Purpose   enums.Purpose `json:"purpose"`
+	CreatedAt time.Time     `json:"createdAt"`
+	ExpiresAt time.Time     `json:"expiresAt"`
+}
+
+func (t *Token) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
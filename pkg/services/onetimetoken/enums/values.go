@@ -0,0 +1,33 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+const (
+	HorusecEmailVerificationTTLMinutes = "HORUSEC_EMAIL_VERIFICATION_TOKEN_TTL_MINUTES"
+	HorusecPasswordResetTTLMinutes     = "HORUSEC_PASSWORD_RESET_TOKEN_TTL_MINUTES"
+	HorusecTokenMaxIssues              = "HORUSEC_ONE_TIME_TOKEN_MAX_ISSUES"
+	HorusecTokenRateLimitWindowMinutes = "HORUSEC_ONE_TIME_TOKEN_RATE_LIMIT_WINDOW_MINUTES"
+
+	DefaultEmailVerificationTTLMinutes = 1440
+	DefaultPasswordResetTTLMinutes     = 30
+	DefaultMaxIssues                   = 3
+	DefaultRateLimitWindowMinutes      = 15
+	DefaultMaxEntries                  = 100000
+	TokenLength                        = 32
+
+	TokensCacheName = "one_time_tokens"
+	LatestCacheName = "one_time_tokens_latest"
+	IssuesCacheName = "one_time_tokens_issues"
+)
@@ -0,0 +1,42 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package onetimetoken
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/onetimetoken/entities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/onetimetoken/enums"
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) Issue(_ context.Context, _ uuid.UUID, _ string, _ enums.Purpose) (string, error) {
+	args := m.MethodCalled("Issue")
+
+	return args.String(0), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) Verify(_ context.Context, _ string, _ enums.Purpose) (*entities.Token, error) {
+	args := m.MethodCalled("Verify")
+
+	return args.Get(0).(*entities.Token), mockUtils.ReturnNilOrError(args, 1)
+}
@@ -0,0 +1,106 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package onetimetoken
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/onetimetoken/entities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/onetimetoken/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto"
+)
+
+type IManager interface {
+	Issue(ctx context.Context, accountID uuid.UUID, email string, purpose enums.Purpose) (string, error)
+	Verify(ctx context.Context, token string, purpose enums.Purpose) (*entities.Token, error)
+}
+
+// Manager issues the single use tokens sent by email to confirm the email of an account or reset its password. Each
+// new token invalidates the previous one of the same account and purpose.
+type Manager struct {
+	options *Options
+	store   IStore
+	clock   clock.IClock
+}
+
+func NewManager(options *Options, store IStore) IManager {
+	return &Manager{options: options, store: store, clock: clock.OrDefault(options.Clock)}
+}
+
+// Issue returns a new url safe token for the account, or ErrorRateLimited when the account already requested the
+// max tokens of the purpose on the current window. The email is normalized to lower case.
+func (m *Manager) Issue(ctx context.Context, accountID uuid.UUID, email string,
+	purpose enums.Purpose) (string, error) {
+	if !purpose.IsValid() {
+		return "", enums.ErrorInvalidPurpose
+	}
+
+	if err := m.checkRateLimit(ctx, accountID, purpose); err != nil {
+		return "", err
+	}
+
+	value, err := crypto.GenerateToken(enums.TokenLength)
+	if err != nil {
+		return "", err
+	}
+
+	now := m.clock.Now()
+	token := &entities.Token{
+		Hash: crypto.GenerateSHA256(value), AccountID: accountID, Email: strings.ToLower(strings.TrimSpace(email)),
+		Purpose: purpose, CreatedAt: now, ExpiresAt: now.Add(m.options.ttl(purpose)),
+	}
+
+	return value, m.store.Save(ctx, token, m.options.ttl(purpose))
+}
+
+func (m *Manager) checkRateLimit(ctx context.Context, accountID uuid.UUID, purpose enums.Purpose) error {
+	if m.options.MaxIssues <= 0 || m.options.RateLimitWindow <= 0 {
+		return nil
+	}
+
+	count, err := m.store.CountIssue(ctx, accountID, purpose, m.options.RateLimitWindow)
+	if err != nil {
+		return err
+	}
+
+	if count > m.options.MaxIssues {
+		return enums.ErrorRateLimited
+	}
+
+	return nil
+}
+
+// Verify consumes the token, so it can not be used again even when the action that required it fails. Unknown,
+// expired, already used and replaced tokens, or tokens of another purpose, return ErrorInvalidToken.
+func (m *Manager) Verify(ctx context.Context, token string, purpose enums.Purpose) (*entities.Token, error) {
+	if token == "" {
+		return nil, enums.ErrorInvalidToken
+	}
+
+	stored, err := m.store.Consume(ctx, crypto.GenerateSHA256(token), purpose)
+	if err != nil {
+		return nil, err
+	}
+
+	if stored.IsExpired(m.clock.Now()) {
+		return nil, enums.ErrorInvalidToken
+	}
+
+	return stored, nil
+}
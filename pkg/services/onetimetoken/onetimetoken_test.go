@@ -0,0 +1,191 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package onetimetoken
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/onetimetoken/entities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/onetimetoken/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto"
+)
+
+type errorStore struct {
+	IStore
+}
+
+func (e *errorStore) CountIssue(context.Context, uuid.UUID, enums.Purpose, time.Duration) (int, error) {
+	return 0, errors.New("test")
+}
+
+func newTestManager() (IManager, *clock.FakeClock) {
+	fakeClock := clock.NewFakeClock(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+	options := &Options{
+		EmailVerificationTTL: time.Hour, PasswordResetTTL: 10 * time.Minute, MaxIssues: 3,
+		RateLimitWindow: 15 * time.Minute, Clock: fakeClock,
+	}
+
+	return NewManager(options, NewMemoryStore(fakeClock)), fakeClock
+}
+
+func TestNewOptions(t *testing.T) {
+	t.Run("should read options from env", func(t *testing.T) {
+		t.Setenv(enums.HorusecPasswordResetTTLMinutes, "5")
+		t.Setenv(enums.HorusecTokenMaxIssues, "10")
+
+		options := NewOptions()
+
+		assert.Equal(t, 5*time.Minute, options.PasswordResetTTL)
+		assert.Equal(t, 24*time.Hour, options.EmailVerificationTTL)
+		assert.Equal(t, 10, options.MaxIssues)
+	})
+}
+
+func TestIssueAndVerify(t *testing.T) {
+	t.Run("should verify issued token only once", func(t *testing.T) {
+		manager, _ := newTestManager()
+		accountID := uuid.New()
+
+		value, err := manager.Issue(context.Background(), accountID, " Test@Horusec.io", enums.EmailVerification)
+		assert.NoError(t, err)
+		assert.Len(t, value, 43)
+
+		token, err := manager.Verify(context.Background(), value, enums.EmailVerification)
+		assert.NoError(t, err)
+		assert.Equal(t, accountID, token.AccountID)
+		assert.Equal(t, "test@horusec.io", token.Email)
+		assert.Equal(t, crypto.GenerateSHA256(value), token.Hash)
+
+		_, err = manager.Verify(context.Background(), value, enums.EmailVerification)
+		assert.ErrorIs(t, err, enums.ErrorInvalidToken)
+	})
+
+	t.Run("should return invalid token when token is expired", func(t *testing.T) {
+		manager, fakeClock := newTestManager()
+
+		value, err := manager.Issue(context.Background(), uuid.New(), "test@horusec.io", enums.PasswordReset)
+		assert.NoError(t, err)
+
+		fakeClock.Advance(10 * time.Minute)
+
+		_, err = manager.Verify(context.Background(), value, enums.PasswordReset)
+		assert.ErrorIs(t, err, enums.ErrorInvalidToken)
+	})
+
+	t.Run("should return invalid token when purpose is different", func(t *testing.T) {
+		manager, _ := newTestManager()
+
+		value, err := manager.Issue(context.Background(), uuid.New(), "test@horusec.io", enums.EmailVerification)
+		assert.NoError(t, err)
+
+		_, err = manager.Verify(context.Background(), value, enums.PasswordReset)
+		assert.ErrorIs(t, err, enums.ErrorInvalidToken)
+
+		_, err = manager.Verify(context.Background(), value, enums.EmailVerification)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should invalidate previous token of the same account and purpose", func(t *testing.T) {
+		manager, _ := newTestManager()
+		accountID := uuid.New()
+
+		first, err := manager.Issue(context.Background(), accountID, "test@horusec.io", enums.PasswordReset)
+		assert.NoError(t, err)
+
+		second, err := manager.Issue(context.Background(), accountID, "test@horusec.io", enums.PasswordReset)
+		assert.NoError(t, err)
+
+		_, err = manager.Verify(context.Background(), first, enums.PasswordReset)
+		assert.ErrorIs(t, err, enums.ErrorInvalidToken)
+
+		_, err = manager.Verify(context.Background(), second, enums.PasswordReset)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return invalid token when token is empty or unknown", func(t *testing.T) {
+		manager, _ := newTestManager()
+
+		for _, value := range []string{"", "test"} {
+			_, err := manager.Verify(context.Background(), value, enums.PasswordReset)
+			assert.ErrorIs(t, err, enums.ErrorInvalidToken)
+		}
+	})
+
+	t.Run("should return error when invalid purpose", func(t *testing.T) {
+		manager, _ := newTestManager()
+
+		_, err := manager.Issue(context.Background(), uuid.New(), "test@horusec.io", "test")
+		assert.ErrorIs(t, err, enums.ErrorInvalidPurpose)
+	})
+}
+
+func TestRateLimit(t *testing.T) {
+	t.Run("should limit tokens issued by account and purpose on each window", func(t *testing.T) {
+		manager, fakeClock := newTestManager()
+		accountID := uuid.New()
+
+		for index := 0; index < 3; index++ {
+			_, err := manager.Issue(context.Background(), accountID, "test@horusec.io", enums.PasswordReset)
+			assert.NoError(t, err)
+		}
+
+		_, err := manager.Issue(context.Background(), accountID, "test@horusec.io", enums.PasswordReset)
+		assert.ErrorIs(t, err, enums.ErrorRateLimited)
+
+		_, err = manager.Issue(context.Background(), accountID, "test@horusec.io", enums.EmailVerification)
+		assert.NoError(t, err)
+
+		_, err = manager.Issue(context.Background(), uuid.New(), "test@horusec.io", enums.PasswordReset)
+		assert.NoError(t, err)
+
+		fakeClock.Advance(15 * time.Minute)
+
+		_, err = manager.Issue(context.Background(), accountID, "test@horusec.io", enums.PasswordReset)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not limit when max issues is zero", func(t *testing.T) {
+		manager := NewManager(&Options{EmailVerificationTTL: time.Hour}, NewMemoryStore(nil))
+
+		for index := 0; index < 5; index++ {
+			_, err := manager.Issue(context.Background(), uuid.New(), "test@horusec.io", enums.EmailVerification)
+			assert.NoError(t, err)
+		}
+	})
+
+	t.Run("should return error when failed to count issues", func(t *testing.T) {
+		manager := NewManager(&Options{MaxIssues: 1, RateLimitWindow: time.Minute}, &errorStore{})
+
+		_, err := manager.Issue(context.Background(), uuid.New(), "test@horusec.io", enums.EmailVerification)
+		assert.EqualError(t, err, "test")
+	})
+}
+
+func TestToken(t *testing.T) {
+	t.Run("should be expired on expiration date", func(t *testing.T) {
+		now := time.Now()
+		token := &entities.Token{ExpiresAt: now}
+
+		assert.True(t, token.IsExpired(now))
+		assert.False(t, token.IsExpired(now.Add(-time.Second)))
+	})
+}
@@ -0,0 +1,54 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package onetimetoken
+
+import (
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/onetimetoken/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// Options configures the lifetime of the tokens of each purpose and the rate limit of each account, which allows
+// MaxIssues tokens of the same purpose for each RateLimitWindow
+type Options struct {
+	EmailVerificationTTL time.Duration
+	PasswordResetTTL     time.Duration
+	MaxIssues            int
+	RateLimitWindow      time.Duration
+	Clock                clock.IClock
+}
+
+func NewOptions() *Options {
+	return &Options{
+		EmailVerificationTTL: time.Duration(env.GetEnvOrDefaultInt(enums.HorusecEmailVerificationTTLMinutes,
+			enums.DefaultEmailVerificationTTLMinutes)) * time.Minute,
+		PasswordResetTTL: time.Duration(env.GetEnvOrDefaultInt(enums.HorusecPasswordResetTTLMinutes,
+			enums.DefaultPasswordResetTTLMinutes)) * time.Minute,
+		MaxIssues: env.GetEnvOrDefaultInt(enums.HorusecTokenMaxIssues, enums.DefaultMaxIssues),
+		RateLimitWindow: time.Duration(env.GetEnvOrDefaultInt(enums.HorusecTokenRateLimitWindowMinutes,
+			enums.DefaultRateLimitWindowMinutes)) * time.Minute,
+		Clock: clock.NewClock(),
+	}
+}
+
+func (o *Options) ttl(purpose enums.Purpose) time.Duration {
+	if purpose == enums.PasswordReset {
+		return o.PasswordResetTTL
+	}
+
+	return o.EmailVerificationTTL
+}
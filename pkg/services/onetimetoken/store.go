@@ -0,0 +1,122 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package onetimetoken
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/cache/ttl"
+	"github.com/ZupIT/horusec-devkit/pkg/services/onetimetoken/entities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/onetimetoken/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+)
+
+// IStore keeps the issued tokens. Save replaces the previous token of the same account and purpose, Consume must
+// return each token only once and CountIssue returns how many tokens of the account and purpose were issued on the
+// current rate limit window, including the one being issued.
+type IStore interface {
+	Save(ctx context.Context, token *entities.Token, ttl time.Duration) error
+	Consume(ctx context.Context, hash string, purpose enums.Purpose) (*entities.Token, error)
+	CountIssue(ctx context.Context, accountID uuid.UUID, purpose enums.Purpose, window time.Duration) (int, error)
+}
+
+type cacheStore struct {
+	mutex  sync.Mutex
+	tokens ttl.ICache[string, *entities.Token]
+	latest ttl.ICache[string, string]
+	issues ttl.ICache[string, int]
+	clock  clock.IClock
+}
+
+// NewCacheStore returns a store backed by the caches, where latest keeps the hash of the last token of each account
+// and purpose and issues the rate limit counters. Using the redis cache allows sharing the tokens between replicas.
+func NewCacheStore(tokens ttl.ICache[string, *entities.Token], latest ttl.ICache[string, string],
+	issues ttl.ICache[string, int], clk clock.IClock) IStore {
+	return &cacheStore{tokens: tokens, latest: latest, issues: issues, clock: clock.OrDefault(clk)}
+}
+
+// NewMemoryStore returns a store that keeps the tokens in memory, which only works with a single replica and loses
+// the tokens on restarts
+func NewMemoryStore(clk clock.IClock) IStore {
+	return NewCacheStore(newMemoryCache[*entities.Token](enums.TokensCacheName, clk),
+		newMemoryCache[string](enums.LatestCacheName, clk), newMemoryCache[int](enums.IssuesCacheName, clk), clk)
+}
+
+func newMemoryCache[V any](name string, clk clock.IClock) ttl.ICache[string, V] {
+	options := ttl.NewOptions(name)
+	options.MaxEntries = enums.DefaultMaxEntries
+	options.Clock = clock.OrDefault(clk)
+
+	return ttl.NewCache[string, V](options)
+}
+
+func (c *cacheStore) Save(_ context.Context, token *entities.Token, expiration time.Duration) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	key := latestKey(token.AccountID, token.Purpose)
+	if previous, ok := c.latest.Get(key); ok {
+		c.tokens.Delete(previous)
+	}
+
+	c.tokens.SetWithTTL(token.Hash, token, expiration)
+	c.latest.SetWithTTL(key, token.Hash, expiration)
+
+	return nil
+}
+
+func (c *cacheStore) Consume(_ context.Context, hash string, purpose enums.Purpose) (*entities.Token, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	token, ok := c.tokens.Get(hash)
+	if !ok || token.Purpose != purpose {
+		return nil, enums.ErrorInvalidToken
+	}
+
+	c.tokens.Delete(hash)
+
+	if latest, ok := c.latest.Get(latestKey(token.AccountID, token.Purpose)); ok && latest == hash {
+		c.latest.Delete(latestKey(token.AccountID, token.Purpose))
+	}
+
+	return token, nil
+}
+
+// CountIssue uses fixed windows, so an account can issue up to twice the max in a window starting on the middle of
+// two fixed windows, which is fine to avoid flooding the mailbox of the account
+func (c *cacheStore) CountIssue(_ context.Context, accountID uuid.UUID, purpose enums.Purpose,
+	window time.Duration) (int, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	start := c.clock.Now().Truncate(window)
+	key := latestKey(accountID, purpose) + ":" + start.Format(time.RFC3339)
+
+	count, _ := c.issues.Get(key)
+	count++
+
+	c.issues.SetWithTTL(key, count, start.Add(window).Sub(c.clock.Now()))
+
+	return count, nil
+}
+
+func latestKey(accountID uuid.UUID, purpose enums.Purpose) string {
+	return purpose.ToString() + ":" + accountID.String()
+}
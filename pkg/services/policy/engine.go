@@ -0,0 +1,202 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	analysisEnums "github.com/ZupIT/horusec-devkit/pkg/enums/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/tools"
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/services/policy/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+)
+
+// Input is the analysis to evaluate with the data that is not kept on it, the branch that was analyzed and the
+// tools that were skipped
+type Input struct {
+	Analysis     *analysisEntities.Analysis
+	Branch       string
+	SkippedTools []tools.Tool
+}
+
+// Decision is the result of an evaluation, it is returned by the api endpoints and sent on the webhook events, so
+// the clients of both receive the same reasons
+type Decision struct {
+	AnalysisID  string    `json:"analysisID"`
+	Policy      string    `json:"policy"`
+	Branch      string    `json:"branch"`
+	Passed      bool      `json:"passed"`
+	Reasons     []*Reason `json:"reasons"`
+	EvaluatedAt time.Time `json:"evaluatedAt"`
+}
+
+// Reason is a check of a rule that failed the analysis
+type Reason struct {
+	Rule    string      `json:"rule"`
+	Check   enums.Check `json:"check"`
+	Message string      `json:"message"`
+}
+
+type IEngine interface {
+	Evaluate(input *Input) (*Decision, error)
+}
+
+type Engine struct {
+	policy *Policy
+	clock  clock.IClock
+}
+
+func NewEngine(policy *Policy, clk clock.IClock) (IEngine, error) {
+	if policy == nil {
+		policy = &Policy{}
+	}
+
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Engine{
+		policy: policy,
+		clock:  clock.OrDefault(clk),
+	}, nil
+}
+
+// NewEngineFromOptions loads the policy of the options path, or uses a policy without rules when it is empty
+func NewEngineFromOptions(options *Options) (IEngine, error) {
+	if options.Path == "" {
+		return NewEngine(nil, options.Clock)
+	}
+
+	policy, err := LoadPolicy(options.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewEngine(policy, options.Clock)
+}
+
+// Evaluate runs every rule that applies to the branch, the analysis passes when none of them has a failed check
+func (e *Engine) Evaluate(input *Input) (*Decision, error) {
+	if input == nil || input.Analysis == nil {
+		return nil, enums.ErrorNilAnalysis
+	}
+
+	decision := &Decision{
+		AnalysisID:  input.Analysis.GetIDString(),
+		Policy:      e.policy.Name,
+		Branch:      input.Branch,
+		Reasons:     []*Reason{},
+		EvaluatedAt: e.clock.Now(),
+	}
+
+	counts := input.ActiveBySeverity()
+
+	for _, rule := range e.policy.Rules {
+		if rule.AppliesTo(input.Branch) {
+			decision.Reasons = append(decision.Reasons, e.evaluateRule(rule, input, counts)...)
+		}
+	}
+
+	decision.Passed = len(decision.Reasons) == 0
+
+	return decision, nil
+}
+
+func (e *Engine) evaluateRule(rule *Rule, input *Input, counts map[severities.Severity]int) (reasons []*Reason) {
+	reasons = append(reasons, e.checkMaxBySeverity(rule, counts)...)
+	reasons = append(reasons, e.checkSeverityThreshold(rule, counts)...)
+	reasons = append(reasons, e.checkSkippedTools(rule, input.SkippedTools)...)
+
+	if rule.FailOnAnalysisError && (input.Analysis.Status == analysisEnums.Error || input.Analysis.HasErrors()) {
+		reasons = append(reasons, &Reason{Rule: rule.Name, Check: enums.CheckAnalysisError,
+			Message: enums.MessageAnalysisError})
+	}
+
+	return reasons
+}
+
+// checkMaxBySeverity follows the order of the severities, so the reasons are the same on every evaluation
+func (e *Engine) checkMaxBySeverity(rule *Rule, counts map[severities.Severity]int) (reasons []*Reason) {
+	for _, severity := range severities.Values() {
+		maxAllowed, ok := rule.MaxBySeverity[severity]
+		if ok && counts[severity] > maxAllowed {
+			reasons = append(reasons, &Reason{Rule: rule.Name, Check: enums.CheckMaxBySeverity,
+				Message: fmt.Sprintf(enums.MessageMaxBySeverity, counts[severity], severity, maxAllowed)})
+		}
+	}
+
+	return reasons
+}
+
+func (e *Engine) checkSeverityThreshold(rule *Rule, counts map[severities.Severity]int) []*Reason {
+	if rule.SeverityThreshold == "" {
+		return nil
+	}
+
+	total := 0
+
+	for _, severity := range severities.Values() {
+		total += counts[severity]
+
+		if severity == rule.SeverityThreshold {
+			break
+		}
+	}
+
+	if total == 0 {
+		return nil
+	}
+
+	return []*Reason{{Rule: rule.Name, Check: enums.CheckSeverityThreshold,
+		Message: fmt.Sprintf(enums.MessageSeverityThreshold, total, rule.SeverityThreshold)}}
+}
+
+func (e *Engine) checkSkippedTools(rule *Rule, skippedTools []tools.Tool) (reasons []*Reason) {
+	skipped := map[tools.Tool]bool{}
+	for _, tool := range skippedTools {
+		skipped[tool] = true
+	}
+
+	for _, tool := range rule.DisallowedSkippedTools {
+		if skipped[tool] {
+			reasons = append(reasons, &Reason{Rule: rule.Name, Check: enums.CheckSkippedTool,
+				Message: fmt.Sprintf(enums.MessageSkippedTool, tool)})
+		}
+	}
+
+	return reasons
+}
+
+// ActiveBySeverity counts the vulnerabilities that were not marked as false positive, risk accepted or corrected,
+// the invalid severities are counted as unknown
+func (i *Input) ActiveBySeverity() map[severities.Severity]int {
+	counts := map[severities.Severity]int{}
+
+	for index := range i.Analysis.AnalysisVulnerabilities {
+		vuln := &i.Analysis.AnalysisVulnerabilities[index].Vulnerability
+		if vuln.Type != "" && vuln.Type != vulnerabilityEnums.Vulnerability {
+			continue
+		}
+
+		counts[severities.GetSeverityByString(strings.ToUpper(vuln.Severity.ToString()))]++
+	}
+
+	return counts
+}
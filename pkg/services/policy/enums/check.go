@@ -0,0 +1,50 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+// Check is the verification of a rule that failed an analysis
+type Check string
+
+const (
+	CheckMaxBySeverity     Check = "max_by_severity"
+	CheckSeverityThreshold Check = "severity_threshold"
+	CheckSkippedTool       Check = "skipped_tool"
+	CheckAnalysisError     Check = "analysis_error"
+	CheckRego              Check = "rego"
+)
+
+func CheckValues() []Check {
+	return []Check{
+		CheckMaxBySeverity,
+		CheckSeverityThreshold,
+		CheckSkippedTool,
+		CheckAnalysisError,
+		CheckRego,
+	}
+}
+
+func (c Check) ToString() string {
+	return string(c)
+}
+
+func (c Check) IsValid() bool {
+	for _, value := range CheckValues() {
+		if c == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,22 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+const (
+	MessageMaxBySeverity     = "found %d vulnerabilities with severity %s, the maximum allowed is %d"
+	MessageSeverityThreshold = "found %d vulnerabilities with severity %s or higher"
+	MessageSkippedTool       = "the tool %s was skipped and it is required"
+	MessageAnalysisError     = "the analysis finished with errors"
+)
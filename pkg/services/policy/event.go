@@ -0,0 +1,45 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/services/policy/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/webhook"
+	uuidUtils "github.com/ZupIT/horusec-devkit/pkg/utils/uuid"
+)
+
+// ToWebhookEvent returns the event sent to the webhooks with the decision as data, created at the evaluation time
+func (d *Decision) ToWebhookEvent() *webhook.Event {
+	return &webhook.Event{
+		ID:        uuidUtils.New(),
+		Type:      enums.EventAnalysisGated,
+		CreatedAt: d.EvaluatedAt,
+		Data:      d,
+	}
+}
+
+// FailedChecks returns the checks that failed the analysis without duplicates
+func (d *Decision) FailedChecks() (checks []enums.Check) {
+	seen := map[enums.Check]bool{}
+
+	for _, reason := range d.Reasons {
+		if !seen[reason.Check] {
+			seen[reason.Check] = true
+			checks = append(checks, reason.Check)
+		}
+	}
+
+	return checks
+}
@@ -0,0 +1,150 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/tools"
+	"github.com/ZupIT/horusec-devkit/pkg/services/policy/enums"
+)
+
+// Policy is the set of rules that an analysis must follow to pass, a policy without rules passes every analysis
+type Policy struct {
+	Name  string  `json:"name"`
+	Rules []*Rule `json:"rules"`
+}
+
+// Rule applies to the branches matching any of its patterns, or to every branch when there is none, and fails the
+// analysis for each of its checks that is set:
+//   - max by severity: more active vulnerabilities of a severity than allowed, like {"CRITICAL": 0}
+//   - severity threshold: any active vulnerability with the severity or a higher one
+//   - disallowed skipped tools: any of the tools was skipped by the analysis
+//   - fail on analysis error: the analysis finished with errors
+//
+// The active vulnerabilities are the ones not marked as false positive, risk accepted or corrected.
+type Rule struct {
+	Name                   string                      `json:"name"`
+	Branches               []string                    `json:"branches,omitempty"`
+	MaxBySeverity          map[severities.Severity]int `json:"maxBySeverity,omitempty"`
+	SeverityThreshold      severities.Severity         `json:"severityThreshold,omitempty"`
+	DisallowedSkippedTools []tools.Tool                `json:"disallowedSkippedTools,omitempty"`
+	FailOnAnalysisError    bool                        `json:"failOnAnalysisError,omitempty"`
+}
+
+// ParsePolicy returns the policy of a json document, validating its rules
+func ParsePolicy(content []byte) (*Policy, error) {
+	policy := &Policy{}
+	if err := json.Unmarshal(content, policy); err != nil {
+		return nil, fmt.Errorf("%w: %s", enums.ErrorInvalidPolicy, err.Error())
+	}
+
+	return policy, policy.Validate()
+}
+
+// LoadPolicy returns the policy of a json file, validating its rules
+func LoadPolicy(filePath string) (*Policy, error) {
+	content, err := os.ReadFile(filepath.Clean(filePath))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", enums.ErrorInvalidPolicy, err.Error())
+	}
+
+	return ParsePolicy(content)
+}
+
+func (p *Policy) Validate() error {
+	for index, rule := range p.Rules {
+		if rule == nil {
+			return fmt.Errorf("%w: rule %d is empty", enums.ErrorInvalidPolicy, index)
+		}
+
+		if err := rule.Validate(); err != nil {
+			return fmt.Errorf("%w: rule %d %q: %s", enums.ErrorInvalidPolicy, index, rule.Name, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (r *Rule) Validate() error {
+	for _, pattern := range r.Branches {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid branch pattern %q", pattern)
+		}
+	}
+
+	for severity, maxAllowed := range r.MaxBySeverity {
+		if !isSeverity(severity) || maxAllowed < 0 {
+			return fmt.Errorf("invalid max %d of severity %q", maxAllowed, severity)
+		}
+	}
+
+	if r.SeverityThreshold != "" && !isSeverity(r.SeverityThreshold) {
+		return fmt.Errorf("invalid severity threshold %q", r.SeverityThreshold)
+	}
+
+	for _, tool := range r.DisallowedSkippedTools {
+		if !isTool(tool) {
+			return fmt.Errorf("invalid tool %q", tool)
+		}
+	}
+
+	return nil
+}
+
+// AppliesTo returns true when the rule has no branch patterns or the branch matches any of them, the patterns use
+// the syntax of path.Match, so release/* matches release/1.0 but not release/1.0/hotfix
+func (r *Rule) AppliesTo(branch string) bool {
+	if len(r.Branches) == 0 {
+		return true
+	}
+
+	for _, pattern := range r.Branches {
+		if pattern == enums.AllBranches {
+			return true
+		}
+
+		if matched, _ := path.Match(pattern, branch); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isSeverity(severity severities.Severity) bool {
+	for _, value := range severities.Values() {
+		if severity == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isTool(tool tools.Tool) bool {
+	for _, value := range tools.Values() {
+		if tool == value {
+			return true
+		}
+	}
+
+	return false
+}
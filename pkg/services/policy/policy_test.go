@@ -0,0 +1,211 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	analysisEnums "github.com/ZupIT/horusec-devkit/pkg/enums/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/tools"
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/services/policy/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+)
+
+const testPolicy = `{
+	"name": "default",
+	"rules": [
+		{"name": "no-criticals", "maxBySeverity": {"CRITICAL": 0}},
+		{"name": "main", "branches": ["main", "release/*"], "severityThreshold": "HIGH",
+			"disallowedSkippedTools": ["GoSec"], "failOnAnalysisError": true}
+	]
+}`
+
+func newTestAnalysis(vulnerabilities ...vulnerability.Vulnerability) *analysisEntities.Analysis {
+	analysis := &analysisEntities.Analysis{Status: analysisEnums.Success}
+	for _, vuln := range vulnerabilities {
+		analysis.AnalysisVulnerabilities = append(analysis.AnalysisVulnerabilities,
+			analysisEntities.AnalysisVulnerabilities{Vulnerability: vuln})
+	}
+
+	return analysis
+}
+
+func newTestEngine(t *testing.T) IEngine {
+	policy, err := ParsePolicy([]byte(testPolicy))
+	assert.NoError(t, err)
+
+	engine, err := NewEngine(policy, clock.NewFakeClock(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)))
+	assert.NoError(t, err)
+
+	return engine
+}
+
+func TestParsePolicy(t *testing.T) {
+	t.Run("should parse a valid policy", func(t *testing.T) {
+		policy, err := ParsePolicy([]byte(testPolicy))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "default", policy.Name)
+		assert.Len(t, policy.Rules, 2)
+		assert.Equal(t, 0, policy.Rules[0].MaxBySeverity[severities.Critical])
+		assert.Equal(t, []tools.Tool{tools.GoSec}, policy.Rules[1].DisallowedSkippedTools)
+	})
+
+	t.Run("should return error when the policy is invalid", func(t *testing.T) {
+		for _, content := range []string{
+			`invalid`,
+			`{"rules": [null]}`,
+			`{"rules": [{"branches": ["["]}]}`,
+			`{"rules": [{"maxBySeverity": {"SEVERE": 0}}]}`,
+			`{"rules": [{"maxBySeverity": {"HIGH": -1}}]}`,
+			`{"rules": [{"severityThreshold": "high"}]}`,
+			`{"rules": [{"disallowedSkippedTools": ["Unknown"]}]}`,
+		} {
+			_, err := ParsePolicy([]byte(content))
+			assert.ErrorIs(t, err, enums.ErrorInvalidPolicy, content)
+		}
+	})
+}
+
+func TestLoadPolicy(t *testing.T) {
+	t.Run("should load the policy of a file", func(t *testing.T) {
+		filePath := filepath.Join(t.TempDir(), "policy.json")
+		assert.NoError(t, os.WriteFile(filePath, []byte(testPolicy), 0o600))
+
+		policy, err := LoadPolicy(filePath)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "default", policy.Name)
+	})
+
+	t.Run("should return error when the file does not exist", func(t *testing.T) {
+		_, err := LoadPolicy(filepath.Join(t.TempDir(), "missing.json"))
+
+		assert.ErrorIs(t, err, enums.ErrorInvalidPolicy)
+	})
+}
+
+func TestAppliesTo(t *testing.T) {
+	t.Run("should match the branch patterns", func(t *testing.T) {
+		rule := &Rule{Branches: []string{"main", "release/*"}}
+
+		assert.True(t, rule.AppliesTo("main"))
+		assert.True(t, rule.AppliesTo("release/1.0"))
+		assert.False(t, rule.AppliesTo("release/1.0/hotfix"))
+		assert.False(t, rule.AppliesTo("feature/test"))
+		assert.True(t, (&Rule{}).AppliesTo("feature/test"))
+		assert.True(t, (&Rule{Branches: []string{"*"}}).AppliesTo("feature/test"))
+	})
+}
+
+func TestEvaluate(t *testing.T) {
+	t.Run("should pass an analysis without active vulnerabilities", func(t *testing.T) {
+		analysis := newTestAnalysis(
+			vulnerability.Vulnerability{Severity: severities.Critical, Type: vulnerabilityEnums.FalsePositive},
+			vulnerability.Vulnerability{Severity: severities.High, Type: vulnerabilityEnums.RiskAccepted},
+			vulnerability.Vulnerability{Severity: severities.Medium, Type: vulnerabilityEnums.Vulnerability},
+		)
+
+		decision, err := newTestEngine(t).Evaluate(&Input{Analysis: analysis, Branch: "main"})
+
+		assert.NoError(t, err)
+		assert.True(t, decision.Passed)
+		assert.Empty(t, decision.Reasons)
+		assert.Equal(t, "default", decision.Policy)
+		assert.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), decision.EvaluatedAt)
+	})
+
+	t.Run("should fail with the reasons of every rule of the branch", func(t *testing.T) {
+		analysis := newTestAnalysis(
+			vulnerability.Vulnerability{Severity: severities.Critical},
+			vulnerability.Vulnerability{Severity: "high", Type: vulnerabilityEnums.Vulnerability},
+		)
+		analysis.Status = analysisEnums.Error
+
+		decision, err := newTestEngine(t).Evaluate(&Input{Analysis: analysis, Branch: "release/2.0",
+			SkippedTools: []tools.Tool{tools.GoSec, tools.Bandit}})
+
+		assert.NoError(t, err)
+		assert.False(t, decision.Passed)
+		assert.Equal(t, []enums.Check{enums.CheckMaxBySeverity, enums.CheckSeverityThreshold,
+			enums.CheckSkippedTool, enums.CheckAnalysisError}, decision.FailedChecks())
+		assert.Equal(t, "found 1 vulnerabilities with severity CRITICAL, the maximum allowed is 0",
+			decision.Reasons[0].Message)
+		assert.Equal(t, "found 2 vulnerabilities with severity HIGH or higher", decision.Reasons[1].Message)
+		assert.Equal(t, "main", decision.Reasons[1].Rule)
+	})
+
+	t.Run("should only run the rules of the branch", func(t *testing.T) {
+		analysis := newTestAnalysis(vulnerability.Vulnerability{Severity: severities.High})
+
+		decision, err := newTestEngine(t).Evaluate(&Input{Analysis: analysis, Branch: "feature/test",
+			SkippedTools: []tools.Tool{tools.GoSec}})
+
+		assert.NoError(t, err)
+		assert.True(t, decision.Passed)
+	})
+
+	t.Run("should return error without analysis", func(t *testing.T) {
+		_, err := newTestEngine(t).Evaluate(&Input{})
+
+		assert.ErrorIs(t, err, enums.ErrorNilAnalysis)
+	})
+}
+
+func TestNewEngineFromOptions(t *testing.T) {
+	t.Run("should pass every analysis without policy path", func(t *testing.T) {
+		options := NewOptions()
+		options.Path = ""
+
+		engine, err := NewEngineFromOptions(options)
+		assert.NoError(t, err)
+
+		decision, err := engine.Evaluate(&Input{Analysis: newTestAnalysis(
+			vulnerability.Vulnerability{Severity: severities.Critical})})
+		assert.NoError(t, err)
+		assert.True(t, decision.Passed)
+	})
+
+	t.Run("should return error when the policy file does not exist", func(t *testing.T) {
+		options := NewOptions()
+		options.Path = filepath.Join(t.TempDir(), "missing.json")
+
+		_, err := NewEngineFromOptions(options)
+
+		assert.ErrorIs(t, err, enums.ErrorInvalidPolicy)
+	})
+}
+
+func TestToWebhookEvent(t *testing.T) {
+	t.Run("should return the event with the decision", func(t *testing.T) {
+		decision := &Decision{Passed: true, EvaluatedAt: time.Now()}
+
+		event := decision.ToWebhookEvent()
+
+		assert.Equal(t, enums.EventAnalysisGated, event.Type)
+		assert.Equal(t, decision.EvaluatedAt, event.CreatedAt)
+		assert.Equal(t, decision, event.Data)
+		assert.NotEmpty(t, event.ID)
+	})
+}
@@ -0,0 +1,46 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+// Action is what the anonymization does with the rows that reference the account
+type Action string
+
+const (
+	ActionAnonymize Action = "anonymize"
+	ActionDelete    Action = "delete"
+	ActionKeep      Action = "keep"
+)
+
+func Values() []Action {
+	return []Action{
+		ActionAnonymize,
+		ActionDelete,
+		ActionKeep,
+	}
+}
+
+func (a Action) ToString() string {
+	return string(a)
+}
+
+func (a Action) IsValid() bool {
+	for _, value := range Values() {
+		if a == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,162 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package privacy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/privacy/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+type IManager interface {
+	Export(ctx context.Context, subject *Subject, writer io.Writer) (*Bundle, error)
+	Anonymize(ctx context.Context, subject *Subject) (*Report, error)
+}
+
+// Bundle has every row that references the subject, grouped by table, which is sent to the person on an access
+// request
+type Bundle struct {
+	Subject   *Subject                            `json:"subject"`
+	CreatedAt time.Time                           `json:"createdAt"`
+	Tables    map[string][]map[string]interface{} `json:"tables"`
+}
+
+// Report counts the rows changed by the anonymization of each table
+type Report struct {
+	Subject     *Subject       `json:"subject"`
+	Anonymized  map[string]int `json:"anonymized"`
+	Deleted     map[string]int `json:"deleted"`
+	CompletedAt time.Time      `json:"completedAt"`
+}
+
+// Manager handles the privacy requests of the accounts with the rules of the tables that have personal data
+type Manager struct {
+	connection *database.Connection
+	rules      []*Rule
+	clock      clock.IClock
+}
+
+// NewManager returns ErrorInvalidRule when any rule is invalid, since the tables and columns of the rules are written
+// on the queries
+func NewManager(connection *database.Connection, rules []*Rule, clk clock.IClock) (IManager, error) {
+	for _, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Manager{connection: connection, rules: rules, clock: clock.OrDefault(clk)}, nil
+}
+
+// Export collects the rows of every table and writes the bundle as json to the writer
+func (m *Manager) Export(ctx context.Context, subject *Subject, writer io.Writer) (*Bundle, error) {
+	if err := subject.Validate(); err != nil {
+		return nil, err
+	}
+
+	bundle := &Bundle{Subject: subject, CreatedAt: m.clock.Now().UTC(), Tables: map[string][]map[string]interface{}{}}
+
+	for _, rule := range m.rules {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		rows, err := m.collect(rule, subject)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(rows) > 0 {
+			bundle.Tables[rule.Table] = append(bundle.Tables[rule.Table], rows...)
+		}
+	}
+
+	return bundle, json.NewEncoder(writer).Encode(bundle)
+}
+
+func (m *Manager) collect(rule *Rule, subject *Subject) ([]map[string]interface{}, error) {
+	where, values, ok := rule.getWhere(subject)
+	if !ok {
+		return nil, nil
+	}
+
+	var rows []map[string]interface{}
+
+	err := m.connection.Read.Raw(fmt.Sprintf(enums.QuerySelect, rule.Table, where), &rows,
+		values...).GetErrorExceptNotFound()
+
+	return rows, err
+}
+
+// Anonymize applies the rules on a single transaction and on the given order, so the rows are deleted before the
+// rows they reference and a failure does not leave the account half anonymized
+func (m *Manager) Anonymize(ctx context.Context, subject *Subject) (*Report, error) {
+	if err := subject.Validate(); err != nil {
+		return nil, err
+	}
+
+	report := &Report{Subject: subject, Anonymized: map[string]int{}, Deleted: map[string]int{}}
+	transaction := database.WithContext(ctx, m.connection.Write).StartTransaction()
+
+	for _, rule := range m.rules {
+		if err := m.apply(transaction, rule, subject, report); err != nil {
+			logger.LogError(enums.MessageFailedToRollbackAnonymization, transaction.RollbackTransaction().GetError())
+
+			return nil, err
+		}
+	}
+
+	if err := transaction.CommitTransaction().GetError(); err != nil {
+		return nil, err
+	}
+
+	report.CompletedAt = m.clock.Now().UTC()
+	logger.LogInfoWithFields(enums.MessageAccountAnonymized, map[string]interface{}{
+		enums.LogFieldAccountID: subject.AccountID, enums.LogFieldTables: len(m.rules),
+	})
+
+	return report, nil
+}
+
+func (m *Manager) apply(transaction database.IDatabaseWrite, rule *Rule, subject *Subject, report *Report) error {
+	where, values, ok := rule.getWhere(subject)
+	if !ok {
+		return nil
+	}
+
+	switch rule.Action {
+	case enums.ActionDelete:
+		response := database.Exec(transaction, fmt.Sprintf(enums.QueryDelete, rule.Table, where), values...)
+		report.Deleted[rule.Table] += response.GetRowsAffected()
+
+		return response.GetError()
+	case enums.ActionAnonymize:
+		assignments, assignmentValues := rule.getAssignments(subject)
+		response := database.Exec(transaction, fmt.Sprintf(enums.QueryUpdate, rule.Table, assignments, where),
+			append(assignmentValues, values...)...)
+		report.Anonymized[rule.Table] += response.GetRowsAffected()
+
+		return response.GetError()
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build cgo
+
+package privacy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/sqlite"
+	"github.com/ZupIT/horusec-devkit/pkg/services/privacy/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+)
+
+func newTestConnection(t *testing.T, accountID uuid.UUID) *database.Connection {
+	connection, err := sqlite.NewInMemoryDatabase()
+	require.NoError(t, err)
+
+	for _, query := range []string{
+		"CREATE TABLE accounts (account_id TEXT PRIMARY KEY, email TEXT, username TEXT)",
+		"CREATE TABLE sessions (session_id TEXT PRIMARY KEY, account_id TEXT REFERENCES accounts(account_id))",
+		"CREATE TABLE vulnerabilities (vulnerability_id TEXT PRIMARY KEY, commit_author TEXT, commit_email TEXT)",
+		"CREATE TABLE audit_events (event_id TEXT PRIMARY KEY, actor_id TEXT REFERENCES accounts(account_id), " +
+			"actor_email TEXT, ip TEXT, user_agent TEXT)",
+	} {
+		assert.NoError(t, database.Exec(connection.Write, query).GetError())
+	}
+
+	assert.NoError(t, database.Exec(connection.Write, "INSERT INTO accounts VALUES (?, ?, ?), (?, ?, ?)", accountID,
+		"test@horusec.io", "test", uuid.New(), "other@horusec.io", "other").GetError())
+	assert.NoError(t, database.Exec(connection.Write, "INSERT INTO sessions VALUES (?, ?)", uuid.New(),
+		accountID).GetError())
+	assert.NoError(t, database.Exec(connection.Write, "INSERT INTO vulnerabilities VALUES (?, ?, ?), (?, ?, ?), (?, ?, ?)",
+		uuid.New(), "Test", "Test@Horusec.io", uuid.New(), "Test", "test@horusec.io", uuid.New(), "Other",
+		"other@horusec.io").GetError())
+	assert.NoError(t, database.Exec(connection.Write, "INSERT INTO audit_events VALUES (?, ?, ?, ?, ?)", uuid.New(),
+		accountID, "test@horusec.io", "127.0.0.1", "test").GetError())
+
+	return connection
+}
+
+func newTestRules() []*Rule {
+	return append([]*Rule{{Table: "sessions", AccountColumns: []string{"account_id"}, Action: enums.ActionDelete}},
+		append(DefaultRules(), NewAccountRule())...)
+}
+
+func TestExport(t *testing.T) {
+	t.Run("should export every row of the subject", func(t *testing.T) {
+		accountID := uuid.New()
+		manager, err := NewManager(newTestConnection(t, accountID), newTestRules(), clock.NewFakeClock(time.Now()))
+		assert.NoError(t, err)
+
+		buffer := &bytes.Buffer{}
+		bundle, err := manager.Export(context.Background(), &Subject{AccountID: accountID,
+			Email: " TEST@horusec.io"}, buffer)
+
+		assert.NoError(t, err)
+		assert.Len(t, bundle.Tables["vulnerabilities"], 2)
+		assert.Len(t, bundle.Tables["audit_events"], 1)
+		assert.Len(t, bundle.Tables["accounts"], 1)
+		assert.Len(t, bundle.Tables["sessions"], 1)
+
+		decoded := &Bundle{}
+		assert.NoError(t, json.Unmarshal(buffer.Bytes(), decoded))
+		assert.Equal(t, "test", decoded.Tables["accounts"][0]["username"])
+	})
+
+	t.Run("should return error when subject is empty", func(t *testing.T) {
+		manager, err := NewManager(newTestConnection(t, uuid.New()), newTestRules(), nil)
+		assert.NoError(t, err)
+
+		_, err = manager.Export(context.Background(), &Subject{}, &bytes.Buffer{})
+		assert.ErrorIs(t, err, enums.ErrorInvalidSubject)
+
+		_, err = manager.Anonymize(context.Background(), &Subject{})
+		assert.ErrorIs(t, err, enums.ErrorInvalidSubject)
+	})
+}
+
+func TestAnonymize(t *testing.T) {
+	t.Run("should anonymize and delete the rows of the account keeping the references", func(t *testing.T) {
+		accountID := uuid.New()
+		connection := newTestConnection(t, accountID)
+		manager, err := NewManager(connection, newTestRules(), nil)
+		assert.NoError(t, err)
+
+		report, err := manager.Anonymize(context.Background(), &Subject{AccountID: accountID,
+			Email: "test@horusec.io"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, report.Deleted["sessions"])
+		assert.Equal(t, 2, report.Anonymized["vulnerabilities"])
+		assert.Equal(t, 1, report.Anonymized["audit_events"])
+		assert.Equal(t, 1, report.Anonymized["accounts"])
+
+		bundle, err := manager.Export(context.Background(), &Subject{AccountID: accountID}, &bytes.Buffer{})
+		assert.NoError(t, err)
+
+		pseudonym := (&Subject{AccountID: accountID}).getPseudonym()
+		assert.Equal(t, "anonymous-"+pseudonym+"@anonymized.horusec.io", bundle.Tables["accounts"][0]["email"])
+		assert.Equal(t, "anonymous-"+pseudonym, bundle.Tables["accounts"][0]["username"])
+		assert.Equal(t, "", bundle.Tables["audit_events"][0]["ip"])
+		assert.Empty(t, bundle.Tables["sessions"])
+
+		var rows []map[string]interface{}
+		assert.NoError(t, connection.Read.Raw("SELECT * FROM vulnerabilities WHERE commit_author = ?", &rows,
+			"anonymous-"+pseudonym).GetError())
+		assert.Len(t, rows, 2)
+
+		var accounts []map[string]interface{}
+		assert.NoError(t, connection.Read.Raw("SELECT * FROM accounts WHERE username = ?", &accounts, "other").
+			GetError())
+		assert.Len(t, accounts, 1)
+	})
+
+	t.Run("should anonymize commit authors with only the email", func(t *testing.T) {
+		manager, err := NewManager(newTestConnection(t, uuid.New()), DefaultRules(), nil)
+		assert.NoError(t, err)
+
+		report, err := manager.Anonymize(context.Background(), &Subject{Email: "other@horusec.io"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, report.Anonymized["vulnerabilities"])
+		assert.Zero(t, report.Anonymized["audit_events"])
+	})
+
+	t.Run("should rollback every table when a rule fails", func(t *testing.T) {
+		accountID := uuid.New()
+		connection := newTestConnection(t, accountID)
+		rules := append(newTestRules(), &Rule{Table: "unknown", AccountColumns: []string{"account_id"},
+			Action: enums.ActionDelete})
+
+		manager, err := NewManager(connection, rules, nil)
+		assert.NoError(t, err)
+
+		_, err = manager.Anonymize(context.Background(), &Subject{AccountID: accountID})
+		assert.Error(t, err)
+
+		var rows []map[string]interface{}
+		assert.NoError(t, connection.Read.Raw("SELECT * FROM sessions", &rows).GetError())
+		assert.Len(t, rows, 1)
+	})
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("should reject rules that are not plain identifiers or have nothing to anonymize", func(t *testing.T) {
+		for _, rule := range []*Rule{
+			{Table: "accounts; DROP TABLE accounts", AccountColumns: []string{"account_id"},
+				Action: enums.ActionDelete},
+			{Table: "accounts", AccountColumns: []string{"account_id = account_id"}, Action: enums.ActionDelete},
+			{Table: "accounts", Action: enums.ActionDelete},
+			{Table: "accounts", AccountColumns: []string{"account_id"}, Action: enums.ActionAnonymize},
+			{Table: "accounts", AccountColumns: []string{"account_id"}, Action: "test"},
+		} {
+			_, err := NewManager(nil, []*Rule{rule}, nil)
+
+			assert.ErrorIs(t, err, enums.ErrorInvalidRule, rule.Table)
+		}
+	})
+}
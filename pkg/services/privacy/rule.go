@@ -0,0 +1,148 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package privacy
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/privacy/enums"
+)
+
+// Rule describes a table with data of the accounts, where the account columns have the account id and the email
+// columns the email, which is compared ignoring the case. The anonymized columns are replaced and the other ones are
+// kept, so the foreign keys that point to the row remain valid.
+type Rule struct {
+	Table          string
+	AccountColumns []string
+	EmailColumns   []string
+	Action         enums.Action
+	Anonymize      map[string]enums.Replacement
+}
+
+// DefaultRules returns the rules of the tables of the devkit entities, anonymizing the commit authors of the
+// vulnerabilities and the actors of the audit events, which are kept as the history of the workspaces. The services
+// append the rules of their own tables, like NewAccountRule.
+func DefaultRules() []*Rule {
+	return []*Rule{
+		{
+			Table:        enums.VulnerabilitiesTable,
+			EmailColumns: []string{"commit_email"},
+			Action:       enums.ActionAnonymize,
+			Anonymize: map[string]enums.Replacement{
+				"commit_email": enums.ReplacementEmail, "commit_author": enums.ReplacementName,
+			},
+		},
+		{
+			Table:          enums.AuditEventsTable,
+			AccountColumns: []string{"actor_id"},
+			EmailColumns:   []string{"actor_email"},
+			Action:         enums.ActionAnonymize,
+			Anonymize: map[string]enums.Replacement{
+				"actor_email": enums.ReplacementEmail, "ip": enums.ReplacementEmpty,
+				"user_agent": enums.ReplacementEmpty,
+			},
+		},
+	}
+}
+
+// NewAccountRule returns the rule of the accounts table of the auth service, which keeps the row, since other
+// tables reference it, replacing its email and username
+func NewAccountRule() *Rule {
+	return &Rule{
+		Table:          enums.AccountsTable,
+		AccountColumns: []string{"account_id"},
+		Action:         enums.ActionAnonymize,
+		Anonymize: map[string]enums.Replacement{
+			"email": enums.ReplacementEmail, "username": enums.ReplacementName,
+		},
+	}
+}
+
+// nolint:gochecknoglobals // compiled once, since the rules are validated on every request
+var identifierRegexp = regexp.MustCompile(enums.IdentifierPattern)
+
+// Validate only accepts plain identifiers, since the table and columns are written on the queries
+func (r *Rule) Validate() error {
+	if !identifierRegexp.MatchString(r.Table) || !r.Action.IsValid() ||
+		len(r.AccountColumns)+len(r.EmailColumns) == 0 {
+		return fmt.Errorf("%w: %s", enums.ErrorInvalidRule, r.Table)
+	}
+
+	for _, column := range append(append(r.getAnonymizedColumns(), r.AccountColumns...), r.EmailColumns...) {
+		if !identifierRegexp.MatchString(column) {
+			return fmt.Errorf("%w: %s.%s", enums.ErrorInvalidRule, r.Table, column)
+		}
+	}
+
+	if r.Action == enums.ActionAnonymize && len(r.Anonymize) == 0 {
+		return fmt.Errorf("%w: %s has no anonymized columns", enums.ErrorInvalidRule, r.Table)
+	}
+
+	return nil
+}
+
+// getWhere returns the condition that matches the rows of the subject, or false when the subject has neither the
+// account id nor the email used by the rule
+func (r *Rule) getWhere(subject *Subject) (string, []interface{}, bool) {
+	var conditions []string
+
+	var values []interface{}
+
+	if subject.AccountID != uuid.Nil {
+		for _, column := range r.AccountColumns {
+			conditions = append(conditions, fmt.Sprintf(enums.ConditionEquals, column))
+			values = append(values, subject.AccountID)
+		}
+	}
+
+	if subject.getEmail() != "" {
+		for _, column := range r.EmailColumns {
+			conditions = append(conditions, fmt.Sprintf(enums.ConditionEmail, column))
+			values = append(values, subject.getEmail())
+		}
+	}
+
+	return strings.Join(conditions, enums.OrSeparator), values, len(conditions) > 0
+}
+
+// getAssignments returns the columns sorted, so the same rule always writes the same query
+func (r *Rule) getAssignments(subject *Subject) (string, []interface{}) {
+	columns := r.getAnonymizedColumns()
+	assignments := make([]string, 0, len(columns))
+	values := make([]interface{}, 0, len(columns))
+
+	for _, column := range columns {
+		assignments = append(assignments, fmt.Sprintf(enums.AssignmentValue, column))
+		values = append(values, subject.getReplacement(r.Anonymize[column]))
+	}
+
+	return strings.Join(assignments, enums.ListSeparator), values
+}
+
+func (r *Rule) getAnonymizedColumns() []string {
+	columns := make([]string, 0, len(r.Anonymize))
+	for column := range r.Anonymize {
+		columns = append(columns, column)
+	}
+
+	sort.Strings(columns)
+
+	return columns
+}
@@ -0,0 +1,73 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package privacy
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/privacy/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto"
+)
+
+// Subject is the person of a privacy request, identified by the account id, the email or both, since the commit
+// authors of the vulnerabilities only have the email
+type Subject struct {
+	AccountID uuid.UUID `json:"accountID"`
+	Email     string    `json:"email"`
+	pseudonym string
+}
+
+func (s *Subject) Validate() error {
+	if s.AccountID == uuid.Nil && s.getEmail() == "" {
+		return enums.ErrorInvalidSubject
+	}
+
+	return nil
+}
+
+func (s *Subject) getEmail() string {
+	return strings.ToLower(strings.TrimSpace(s.Email))
+}
+
+// getPseudonym derives the pseudonym from the account id, which is random and keeps the pseudonym of an account the
+// same between requests, while the subjects with only the email receive a random pseudonym, since deriving it from
+// the email would allow finding the email by hashing a list of known ones
+func (s *Subject) getPseudonym() string {
+	if s.pseudonym != "" {
+		return s.pseudonym
+	}
+
+	seed := s.AccountID
+	if seed == uuid.Nil {
+		seed = uuid.New()
+	}
+
+	s.pseudonym = crypto.GenerateSHA256(seed.String())[:enums.PseudonymLength]
+
+	return s.pseudonym
+}
+
+func (s *Subject) getReplacement(replacement enums.Replacement) string {
+	switch replacement {
+	case enums.ReplacementEmail:
+		return enums.PseudonymNamePrefix + s.getPseudonym() + enums.PseudonymEmailDomain
+	case enums.ReplacementName:
+		return enums.PseudonymNamePrefix + s.getPseudonym()
+	default:
+		return ""
+	}
+}
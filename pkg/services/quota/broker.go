@@ -0,0 +1,70 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"context"
+	"errors"
+
+	brokerPacket "github.com/ZupIT/horusec-devkit/pkg/services/broker/packet"
+	"github.com/ZupIT/horusec-devkit/pkg/services/quota/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+// BrokerHandler wraps a broker handler consuming one unit of the metric for each message, where getID returns the
+// account or workspace of the message. The messages that exceed the quota are acked without calling the handler,
+// since requeueing them would only redeliver them until the window resets, while the failures to get the id or to
+// check the quota are logged and the message is handled anyway.
+func BrokerHandler(manager IManager, scope enums.Scope, metric string,
+	getID func(packet brokerPacket.IPacket) (string, error),
+	handler func(packet brokerPacket.IPacket)) func(packet brokerPacket.IPacket) {
+	return func(packet brokerPacket.IPacket) {
+		if !manager.IsEnabled() {
+			handler(packet)
+
+			return
+		}
+
+		id, err := getID(packet)
+		if err != nil {
+			logger.LogError(enums.MessageFailedToGetQuotaID, err)
+			handler(packet)
+
+			return
+		}
+
+		if err := consumePacket(manager, scope, id, metric); err != nil {
+			logger.LogError(enums.MessageQuotaExceededDropped, err)
+			logger.LogError(enums.MessageFailedAckExceeded, packet.Ack())
+
+			return
+		}
+
+		handler(packet)
+	}
+}
+
+func consumePacket(manager IManager, scope enums.Scope, id, metric string) error {
+	_, err := manager.Consume(context.Background(), scope, id, metric, 1)
+	if errors.Is(err, enums.ErrorQuotaExceeded) {
+		return err
+	}
+
+	if err != nil {
+		logger.LogError(enums.MessageFailedToCheckQuota, err)
+	}
+
+	return nil
+}
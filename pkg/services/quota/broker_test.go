@@ -0,0 +1,92 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	brokerPacket "github.com/ZupIT/horusec-devkit/pkg/services/broker/packet"
+	"github.com/ZupIT/horusec-devkit/pkg/services/quota/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+)
+
+type fakePacket struct {
+	body  []byte
+	acked bool
+}
+
+func (f *fakePacket) Ack() error {
+	f.acked = true
+
+	return nil
+}
+
+func (f *fakePacket) Nack() error {
+	return nil
+}
+
+func (f *fakePacket) GetBody() []byte {
+	return f.body
+}
+
+func (f *fakePacket) SetBody(body []byte) {
+	f.body = body
+}
+
+func TestBrokerHandler(t *testing.T) {
+	getID := func(packet brokerPacket.IPacket) (string, error) {
+		return string(packet.GetBody()), nil
+	}
+
+	t.Run("should ack messages that exceed the quota without handling them", func(t *testing.T) {
+		manager := newTestManager(clock.NewFakeClock(time.Now()),
+			&Limit{Scope: enums.ScopeWorkspace, Metric: "analyses", Max: 1, Window: time.Hour})
+
+		handled := 0
+		handler := BrokerHandler(manager, enums.ScopeWorkspace, "analyses", getID,
+			func(packet brokerPacket.IPacket) { handled++ })
+
+		first := &fakePacket{body: []byte("test")}
+		handler(first)
+
+		second := &fakePacket{body: []byte("test")}
+		handler(second)
+
+		assert.Equal(t, 1, handled)
+		assert.False(t, first.acked)
+		assert.True(t, second.acked)
+	})
+
+	t.Run("should handle messages when id or store fails", func(t *testing.T) {
+		limit := &Limit{Scope: enums.ScopeWorkspace, Metric: "analyses", Max: 0}
+
+		handled := 0
+		handle := func(packet brokerPacket.IPacket) { handled++ }
+
+		BrokerHandler(NewManager(&Options{Enabled: true, Limits: []*Limit{limit}}, NewMemoryStore(nil)),
+			enums.ScopeWorkspace, "analyses", func(packet brokerPacket.IPacket) (string, error) {
+				return "", errors.New("test")
+			}, handle)(&fakePacket{})
+
+		BrokerHandler(NewManager(&Options{Enabled: true, Limits: []*Limit{limit}}, &errorStore{}),
+			enums.ScopeWorkspace, "analyses", getID, handle)(&fakePacket{})
+
+		assert.Equal(t, 2, handled)
+	})
+}
@@ -0,0 +1,24 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+const (
+	MessageQuotaExceeded        = "quota of %s exceeded, used %d of %d"
+	MessageFailedToParseLimit   = "{ERROR_QUOTA} failed to parse quota limit, ignoring it"
+	MessageFailedToCheckQuota   = "{ERROR_QUOTA} failed to check quota, allowing the operation"
+	MessageFailedToGetQuotaID   = "{ERROR_QUOTA} failed to get the quota id of the broker message, allowing it"
+	MessageQuotaExceededDropped = "{ERROR_QUOTA} quota exceeded, dropping the broker message"
+	MessageFailedAckExceeded    = "{ERROR_QUOTA} failed to ack the broker message that exceeded the quota"
+)
@@ -0,0 +1,43 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+const (
+	HorusecQuotaEnabled = "HORUSEC_QUOTA_ENABLED"
+	HorusecQuotaLimits  = "HORUSEC_QUOTA_LIMITS"
+
+	Unlimited = -1
+
+	KeyPrefix      = "horusec:quota:"
+	LimitSeparator = "="
+	ScopeSeparator = ":"
+	WindowPrefix   = "/"
+
+	// RedisIncrementScript increments the counter without letting it go below zero, setting the ttl of the fixed
+	// window counters, which are the ones with a ttl greater than zero
+	RedisIncrementScript = `local value = redis.call("incrby", KEYS[1], ARGV[1])
+if value < 0 then redis.call("set", KEYS[1], 0) value = 0 end
+if tonumber(ARGV[2]) > 0 then redis.call("pexpire", KEYS[1], ARGV[2]) end
+return value`
+
+	HeaderRetryAfter = "Retry-After"
+
+	MetadataScope   = "scope"
+	MetadataID      = "id"
+	MetadataMetric  = "metric"
+	MetadataLimit   = "limit"
+	MetadataUsed    = "used"
+	MetadataResetAt = "resetAt"
+)
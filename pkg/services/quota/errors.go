@@ -0,0 +1,64 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/errorcode"
+	"github.com/ZupIT/horusec-devkit/pkg/services/quota/enums"
+	appErrors "github.com/ZupIT/horusec-devkit/pkg/utils/errors"
+)
+
+// ExceededError is returned when an operation would exceed a quota, matching enums.ErrorQuotaExceeded with
+// errors.Is. The reset time is zero for absolute counters, which are only freed when the resources are removed.
+type ExceededError struct {
+	Usage
+	retryAfter time.Duration
+}
+
+func (e *ExceededError) Error() string {
+	return fmt.Sprintf("%s: %s", enums.ErrorQuotaExceeded.Error(), e.message())
+}
+
+func (e *ExceededError) Is(target error) bool {
+	return target == enums.ErrorQuotaExceeded // nolint:errorlint // only the sentinel itself is compared
+}
+
+// AppError returns the error as a too many requests app error, keeping the usage as metadata
+func (e *ExceededError) AppError() *appErrors.AppError {
+	appError := appErrors.New(errorcode.TooManyRequests, e.message()).WithCause(e).
+		WithMetadata(enums.MetadataScope, e.Scope).
+		WithMetadata(enums.MetadataID, e.ID).
+		WithMetadata(enums.MetadataMetric, e.Metric).
+		WithMetadata(enums.MetadataLimit, e.Limit).
+		WithMetadata(enums.MetadataUsed, e.Used)
+
+	if !e.ResetAt.IsZero() {
+		appError.WithMetadata(enums.MetadataResetAt, e.ResetAt)
+	}
+
+	return appError
+}
+
+// RetryAfter returns how long until the quota window resets, or zero for absolute counters
+func (e *ExceededError) RetryAfter() time.Duration {
+	return e.retryAfter
+}
+
+func (e *ExceededError) message() string {
+	return fmt.Sprintf(enums.MessageQuotaExceeded, e.Scope.ToString()+enums.ScopeSeparator+e.Metric, e.Used, e.Limit)
+}
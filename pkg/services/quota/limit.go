@@ -0,0 +1,97 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/quota/enums"
+)
+
+// Limit caps a metric of each account or workspace. A window greater than zero makes it a fixed window counter, like
+// analyses per day, while a zero window makes it an absolute counter, like vulnerabilities stored, which must be
+// released when the counted resources are removed.
+type Limit struct {
+	Scope  enums.Scope
+	Metric string
+	Max    int64
+	Window time.Duration
+}
+
+// ParseLimit parses a limit on the scope:metric=max/window format, where the window is optional and accepts any go
+// duration, like workspace:analyses=1000/24h or workspace:vulnerabilities=100000
+func ParseLimit(value string) (*Limit, error) {
+	name, quota, ok := strings.Cut(strings.TrimSpace(value), enums.LimitSeparator)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", enums.ErrorInvalidLimit, value)
+	}
+
+	scope, metric, ok := strings.Cut(name, enums.ScopeSeparator)
+	if !ok || !enums.Scope(scope).IsValid() || metric == "" {
+		return nil, fmt.Errorf("%w: %s", enums.ErrorInvalidLimit, value)
+	}
+
+	limit := &Limit{Scope: enums.Scope(scope), Metric: metric}
+
+	return limit, limit.parseQuota(value, quota)
+}
+
+func (l *Limit) parseQuota(value, quota string) (err error) {
+	maxValue, window, hasWindow := strings.Cut(quota, enums.WindowPrefix)
+
+	if l.Max, err = strconv.ParseInt(maxValue, 10, 64); err != nil || l.Max < 0 {
+		return fmt.Errorf("%w: %s", enums.ErrorInvalidLimit, value)
+	}
+
+	if !hasWindow {
+		return nil
+	}
+
+	if l.Window, err = time.ParseDuration(window); err != nil || l.Window <= 0 {
+		return fmt.Errorf("%w: %s", enums.ErrorInvalidLimit, value)
+	}
+
+	return nil
+}
+
+// IsWindowed reports if the counter is reset at the end of each window
+func (l *Limit) IsWindowed() bool {
+	return l.Window > 0
+}
+
+func (l *Limit) key(id string, now time.Time) string {
+	key := enums.KeyPrefix + l.Scope.ToString() + ":" + id + ":" + l.Metric
+	if l.IsWindowed() {
+		key += ":" + strconv.FormatInt(l.windowStart(now).Unix(), 10)
+	}
+
+	return key
+}
+
+func (l *Limit) windowStart(now time.Time) time.Time {
+	return now.Truncate(l.Window)
+}
+
+// resetAt returns when the current window ends, or the zero time for absolute counters
+func (l *Limit) resetAt(now time.Time) time.Time {
+	if !l.IsWindowed() {
+		return time.Time{}
+	}
+
+	return l.windowStart(now).Add(l.Window)
+}
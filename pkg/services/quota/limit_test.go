@@ -0,0 +1,77 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/quota/enums"
+)
+
+func TestParseLimit(t *testing.T) {
+	t.Run("should parse limit with window", func(t *testing.T) {
+		limit, err := ParseLimit(" workspace:analyses=1000/24h ")
+
+		assert.NoError(t, err)
+		assert.Equal(t, &Limit{Scope: enums.ScopeWorkspace, Metric: "analyses", Max: 1000, Window: 24 * time.Hour}, limit)
+		assert.True(t, limit.IsWindowed())
+	})
+
+	t.Run("should parse absolute limit", func(t *testing.T) {
+		limit, err := ParseLimit("account:vulnerabilities=100000")
+
+		assert.NoError(t, err)
+		assert.Equal(t, &Limit{Scope: enums.ScopeAccount, Metric: "vulnerabilities", Max: 100000}, limit)
+		assert.False(t, limit.IsWindowed())
+	})
+
+	t.Run("should return error when limit is invalid", func(t *testing.T) {
+		for _, value := range []string{"workspace:analyses", "test:analyses=1", "workspace:=1", "workspace=1",
+			"workspace:analyses=test", "workspace:analyses=-1", "workspace:analyses=1/test", "workspace:analyses=1/0s"} {
+			_, err := ParseLimit(value)
+
+			assert.ErrorIs(t, err, enums.ErrorInvalidLimit, value)
+		}
+	})
+}
+
+func TestNewOptions(t *testing.T) {
+	t.Run("should get limits from environment ignoring the invalid ones", func(t *testing.T) {
+		t.Setenv(enums.HorusecQuotaEnabled, "true")
+		t.Setenv(enums.HorusecQuotaLimits, "workspace:analyses=10/1h,test,account:repositories=5")
+
+		options := NewOptions()
+
+		assert.True(t, options.Enabled)
+		assert.Len(t, options.Limits, 2)
+
+		limit, ok := options.getLimit(enums.ScopeAccount, "repositories")
+		assert.True(t, ok)
+		assert.Equal(t, int64(5), limit.Max)
+
+		_, ok = options.getLimit(enums.ScopeAccount, "analyses")
+		assert.False(t, ok)
+	})
+
+	t.Run("should be disabled by default", func(t *testing.T) {
+		options := NewOptions()
+
+		assert.False(t, options.Enabled)
+		assert.Empty(t, options.Limits)
+	})
+}
@@ -0,0 +1,97 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+
+	middlewaresEnums "github.com/ZupIT/horusec-devkit/pkg/services/middlewares/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/quota/enums"
+	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+// Middleware consumes one unit of the metric for each request, taking the workspace id from the url param and the
+// account id from the context set by the authz middleware, which must run before it. The requests that exceed the
+// quota receive too many requests with the Retry-After header when the counter has a window, while the failures of
+// the store are logged and allow the request, so the quotas do not take the service down with them.
+func Middleware(manager IManager, scope enums.Scope, metric string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !manager.IsEnabled() {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			id := getRequestID(r, scope)
+			if id == "" {
+				httpUtil.StatusBadRequest(w, enums.ErrorMissingQuotaID)
+
+				return
+			}
+
+			if err := consume(r, manager, scope, id, metric); err != nil {
+				writeExceeded(w, err)
+
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func getRequestID(r *http.Request, scope enums.Scope) string {
+	if scope == enums.ScopeWorkspace {
+		return chi.URLParam(r, middlewaresEnums.WorkspaceID)
+	}
+
+	if accountID, ok := jwt.GetAccountIDFromContext(r.Context()); ok {
+		return accountID.String()
+	}
+
+	return ""
+}
+
+// consume only returns the exceeded errors, logging any other one
+func consume(r *http.Request, manager IManager, scope enums.Scope, id, metric string) *ExceededError {
+	_, err := manager.Consume(r.Context(), scope, id, metric, 1)
+
+	var exceeded *ExceededError
+	if errors.As(err, &exceeded) {
+		return exceeded
+	}
+
+	if err != nil {
+		logger.FromContext(r.Context()).LogError(enums.MessageFailedToCheckQuota, err)
+	}
+
+	return nil
+}
+
+func writeExceeded(w http.ResponseWriter, err *ExceededError) {
+	if retryAfter := err.RetryAfter(); retryAfter > 0 {
+		w.Header().Set(enums.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	}
+
+	httpUtil.StatusError(w, err.AppError())
+}
@@ -0,0 +1,96 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	middlewaresEnums "github.com/ZupIT/horusec-devkit/pkg/services/middlewares/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/quota/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
+)
+
+func newWorkspaceRequest(workspaceID string) *http.Request {
+	routeContext := chi.NewRouteContext()
+	routeContext.URLParams.Add(middlewaresEnums.WorkspaceID, workspaceID)
+
+	request := httptest.NewRequest(http.MethodPost, "/test", nil)
+
+	return request.WithContext(context.WithValue(request.Context(), chi.RouteCtxKey, routeContext))
+}
+
+func TestMiddleware(t *testing.T) {
+	okHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) })
+
+	t.Run("should reject requests that exceed the workspace quota", func(t *testing.T) {
+		fakeClock := clock.NewFakeClock(time.Date(2021, 1, 1, 10, 0, 30, 0, time.UTC))
+		manager := newTestManager(fakeClock,
+			&Limit{Scope: enums.ScopeWorkspace, Metric: "analyses", Max: 1, Window: time.Minute})
+		handler := Middleware(manager, enums.ScopeWorkspace, "analyses")(okHandler)
+
+		first := httptest.NewRecorder()
+		handler.ServeHTTP(first, newWorkspaceRequest("test"))
+		assert.Equal(t, http.StatusOK, first.Code)
+
+		second := httptest.NewRecorder()
+		handler.ServeHTTP(second, newWorkspaceRequest("test"))
+		assert.Equal(t, http.StatusTooManyRequests, second.Code)
+		assert.Equal(t, "30", second.Header().Get(enums.HeaderRetryAfter))
+	})
+
+	t.Run("should use the account of the context", func(t *testing.T) {
+		manager := newTestManager(clock.NewFakeClock(time.Now()),
+			&Limit{Scope: enums.ScopeAccount, Metric: "repositories", Max: 0})
+		handler := Middleware(manager, enums.ScopeAccount, "repositories")(okHandler)
+
+		request := httptest.NewRequest(http.MethodPost, "/test", nil)
+		request = request.WithContext(jwt.ContextWithAccountID(request.Context(), uuid.New()))
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, request)
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+		assert.Empty(t, w.Header().Get(enums.HeaderRetryAfter))
+	})
+
+	t.Run("should return bad request when id is missing", func(t *testing.T) {
+		handler := Middleware(newTestManager(clock.NewFakeClock(time.Now())), enums.ScopeAccount, "test")(okHandler)
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/test", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should allow requests when disabled or when store fails", func(t *testing.T) {
+		limit := &Limit{Scope: enums.ScopeWorkspace, Metric: "analyses", Max: 0}
+
+		for _, manager := range []IManager{
+			NewManager(&Options{Limits: []*Limit{limit}}, NewMemoryStore(nil)),
+			NewManager(&Options{Enabled: true, Limits: []*Limit{limit}}, &errorStore{}),
+		} {
+			w := httptest.NewRecorder()
+			Middleware(manager, enums.ScopeWorkspace, "analyses")(okHandler).ServeHTTP(w, newWorkspaceRequest("test"))
+			assert.Equal(t, http.StatusOK, w.Code)
+		}
+	})
+}
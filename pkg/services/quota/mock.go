@@ -0,0 +1,58 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/quota/enums"
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) IsEnabled() bool {
+	args := m.MethodCalled("IsEnabled")
+
+	return mockUtils.ReturnBool(args, 0)
+}
+
+func (m *Mock) Consume(_ context.Context, _ enums.Scope, _, _ string, _ int64) (*Usage, error) {
+	args := m.MethodCalled("Consume")
+
+	return args.Get(0).(*Usage), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) Release(_ context.Context, _ enums.Scope, _, _ string, _ int64) error {
+	args := m.MethodCalled("Release")
+
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) Sync(_ context.Context, _ enums.Scope, _, _ string, _ int64) error {
+	args := m.MethodCalled("Sync")
+
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) GetUsage(_ context.Context, _ enums.Scope, _, _ string) (*Usage, error) {
+	args := m.MethodCalled("GetUsage")
+
+	return args.Get(0).(*Usage), mockUtils.ReturnNilOrError(args, 1)
+}
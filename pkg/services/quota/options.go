@@ -0,0 +1,65 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/services/quota/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+// Options configures the limits enforced when the quotas are enabled. Metrics without a limit are not capped, and
+// the invalid limits of the environment are logged and ignored, so a typo does not stop the service.
+type Options struct {
+	Enabled bool
+	Limits  []*Limit
+	Clock   clock.IClock
+}
+
+func NewOptions() *Options {
+	return &Options{
+		Enabled: env.GetEnvOrDefaultBool(enums.HorusecQuotaEnabled, false),
+		Limits:  getLimits(env.GetStringSlice(enums.HorusecQuotaLimits, nil)),
+		Clock:   clock.NewClock(),
+	}
+}
+
+func getLimits(values []string) []*Limit {
+	limits := make([]*Limit, 0, len(values))
+
+	for _, value := range values {
+		limit, err := ParseLimit(value)
+		if err != nil {
+			logger.LogError(enums.MessageFailedToParseLimit, err)
+
+			continue
+		}
+
+		limits = append(limits, limit)
+	}
+
+	return limits
+}
+
+func (o *Options) getLimit(scope enums.Scope, metric string) (*Limit, bool) {
+	for _, limit := range o.Limits {
+		if limit.Scope == scope && limit.Metric == metric {
+			return limit, true
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,175 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"context"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/quota/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+)
+
+type IManager interface {
+	IsEnabled() bool
+	Consume(ctx context.Context, scope enums.Scope, id, metric string, amount int64) (*Usage, error)
+	Release(ctx context.Context, scope enums.Scope, id, metric string, amount int64) error
+	Sync(ctx context.Context, scope enums.Scope, id, metric string, used int64) error
+	GetUsage(ctx context.Context, scope enums.Scope, id, metric string) (*Usage, error)
+}
+
+// Usage is the state of the counter of a metric of an account or workspace, where an unlimited limit means that the
+// metric is not capped and a zero reset time that it is an absolute counter
+type Usage struct {
+	Scope   enums.Scope `json:"scope"`
+	ID      string      `json:"id"`
+	Metric  string      `json:"metric"`
+	Used    int64       `json:"used"`
+	Limit   int64       `json:"limit"`
+	ResetAt time.Time   `json:"resetAt,omitempty"`
+}
+
+// Remaining returns how much of the quota is left, or enums.Unlimited when the metric is not capped
+func (u *Usage) Remaining() int64 {
+	if u.Limit == enums.Unlimited {
+		return enums.Unlimited
+	}
+
+	if u.Used >= u.Limit {
+		return 0
+	}
+
+	return u.Limit - u.Used
+}
+
+// Manager enforces the quotas of the accounts and workspaces, like the analyses per day or the vulnerabilities stored
+// of each workspace. The counters use fixed windows, so up to twice the max can be consumed on a window starting on
+// the middle of two fixed windows.
+type Manager struct {
+	options *Options
+	store   IStore
+	clock   clock.IClock
+}
+
+func NewManager(options *Options, store IStore) IManager {
+	return &Manager{options: options, store: store, clock: clock.OrDefault(options.Clock)}
+}
+
+func (m *Manager) IsEnabled() bool {
+	return m.options.Enabled
+}
+
+// Consume adds the amount to the counter, returning an ExceededError without consuming anything when it would exceed
+// the limit. Disabled quotas and metrics without a limit are never exceeded and are not counted.
+func (m *Manager) Consume(ctx context.Context, scope enums.Scope, id, metric string, amount int64) (*Usage, error) {
+	limit, ok := m.getLimit(scope, metric)
+	if !ok {
+		return &Usage{Scope: scope, ID: id, Metric: metric, Limit: enums.Unlimited}, nil
+	}
+
+	now := m.clock.Now()
+
+	used, err := m.store.Increment(ctx, limit.key(id, now), amount, m.ttl(limit, now))
+	if err != nil {
+		return nil, err
+	}
+
+	usage := m.newUsage(limit, id, used, now)
+	if used <= limit.Max {
+		return usage, nil
+	}
+
+	return m.rollback(ctx, limit, usage, amount, now)
+}
+
+// rollback returns the amount consumed by an operation that exceeded the limit, so the rejected operations do not
+// keep the counter above the limit
+func (m *Manager) rollback(ctx context.Context, limit *Limit, usage *Usage, amount int64, now time.Time) (*Usage,
+	error) {
+	used, err := m.store.Increment(ctx, limit.key(usage.ID, now), -amount, m.ttl(limit, now))
+	if err != nil {
+		return nil, err
+	}
+
+	usage.Used = used
+
+	return usage, &ExceededError{Usage: *usage, retryAfter: m.ttl(limit, now)}
+}
+
+// Release frees the amount of an absolute counter when the counted resources are removed
+func (m *Manager) Release(ctx context.Context, scope enums.Scope, id, metric string, amount int64) error {
+	limit, ok := m.getLimit(scope, metric)
+	if !ok {
+		return nil
+	}
+
+	now := m.clock.Now()
+
+	_, err := m.store.Increment(ctx, limit.key(id, now), -amount, m.ttl(limit, now))
+
+	return err
+}
+
+// Sync replaces the counter with the real usage, like the count of vulnerabilities stored on the database, fixing
+// the drift of absolute counters caused by failures between the operation and its consume or release
+func (m *Manager) Sync(ctx context.Context, scope enums.Scope, id, metric string, used int64) error {
+	limit, ok := m.getLimit(scope, metric)
+	if !ok {
+		return nil
+	}
+
+	now := m.clock.Now()
+
+	return m.store.Set(ctx, limit.key(id, now), used, m.ttl(limit, now))
+}
+
+func (m *Manager) GetUsage(ctx context.Context, scope enums.Scope, id, metric string) (*Usage, error) {
+	limit, ok := m.getLimit(scope, metric)
+	if !ok {
+		return &Usage{Scope: scope, ID: id, Metric: metric, Limit: enums.Unlimited}, nil
+	}
+
+	now := m.clock.Now()
+
+	used, err := m.store.Get(ctx, limit.key(id, now))
+	if err != nil {
+		return nil, err
+	}
+
+	return m.newUsage(limit, id, used, now), nil
+}
+
+func (m *Manager) getLimit(scope enums.Scope, metric string) (*Limit, bool) {
+	if !m.options.Enabled {
+		return nil, false
+	}
+
+	return m.options.getLimit(scope, metric)
+}
+
+// ttl keeps the fixed window counters until the end of their window, while the absolute counters never expire
+func (m *Manager) ttl(limit *Limit, now time.Time) time.Duration {
+	if !limit.IsWindowed() {
+		return 0
+	}
+
+	return limit.resetAt(now).Sub(now)
+}
+
+func (m *Manager) newUsage(limit *Limit, id string, used int64, now time.Time) *Usage {
+	return &Usage{
+		Scope: limit.Scope, ID: id, Metric: limit.Metric, Used: used, Limit: limit.Max, ResetAt: limit.resetAt(now),
+	}
+}
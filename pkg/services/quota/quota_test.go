@@ -0,0 +1,156 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/errorcode"
+	"github.com/ZupIT/horusec-devkit/pkg/services/quota/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+)
+
+func newTestManager(fakeClock *clock.FakeClock, limits ...*Limit) IManager {
+	return NewManager(&Options{Enabled: true, Limits: limits, Clock: fakeClock}, NewMemoryStore(fakeClock))
+}
+
+func TestConsume(t *testing.T) {
+	limit := &Limit{Scope: enums.ScopeWorkspace, Metric: "analyses", Max: 2, Window: time.Hour}
+
+	t.Run("should consume until the limit and reset on the next window", func(t *testing.T) {
+		fakeClock := clock.NewFakeClock(time.Date(2021, 1, 1, 10, 15, 0, 0, time.UTC))
+		manager := newTestManager(fakeClock, limit)
+
+		usage, err := manager.Consume(context.Background(), enums.ScopeWorkspace, "test", "analyses", 2)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), usage.Used)
+		assert.Equal(t, int64(0), usage.Remaining())
+		assert.Equal(t, time.Date(2021, 1, 1, 11, 0, 0, 0, time.UTC), usage.ResetAt)
+
+		_, err = manager.Consume(context.Background(), enums.ScopeWorkspace, "test", "analyses", 1)
+
+		var exceeded *ExceededError
+		assert.ErrorIs(t, err, enums.ErrorQuotaExceeded)
+		assert.True(t, errors.As(err, &exceeded))
+		assert.Equal(t, int64(2), exceeded.Used)
+		assert.Equal(t, 45*time.Minute, exceeded.RetryAfter())
+
+		fakeClock.Advance(45 * time.Minute)
+
+		usage, err = manager.Consume(context.Background(), enums.ScopeWorkspace, "test", "analyses", 1)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), usage.Used)
+	})
+
+	t.Run("should count each workspace apart", func(t *testing.T) {
+		manager := newTestManager(clock.NewFakeClock(time.Now()), limit)
+
+		_, err := manager.Consume(context.Background(), enums.ScopeWorkspace, "first", "analyses", 2)
+		assert.NoError(t, err)
+
+		_, err = manager.Consume(context.Background(), enums.ScopeWorkspace, "second", "analyses", 2)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not count metrics without limit or when disabled", func(t *testing.T) {
+		fakeClock := clock.NewFakeClock(time.Now())
+		manager := NewManager(&Options{Limits: []*Limit{limit}, Clock: fakeClock}, NewMemoryStore(fakeClock))
+
+		usage, err := manager.Consume(context.Background(), enums.ScopeWorkspace, "test", "analyses", 10)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(enums.Unlimited), usage.Remaining())
+
+		usage, err = newTestManager(fakeClock, limit).Consume(context.Background(), enums.ScopeAccount, "test",
+			"analyses", 10)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), usage.Used)
+	})
+
+	t.Run("should return error when store fails", func(t *testing.T) {
+		manager := NewManager(&Options{Enabled: true, Limits: []*Limit{limit}}, &errorStore{})
+
+		_, err := manager.Consume(context.Background(), enums.ScopeWorkspace, "test", "analyses", 1)
+
+		assert.EqualError(t, err, "test")
+	})
+}
+
+func TestReleaseAndSync(t *testing.T) {
+	limit := &Limit{Scope: enums.ScopeWorkspace, Metric: "vulnerabilities", Max: 10}
+
+	t.Run("should release and sync absolute counters", func(t *testing.T) {
+		fakeClock := clock.NewFakeClock(time.Now())
+		manager := newTestManager(fakeClock, limit)
+
+		_, err := manager.Consume(context.Background(), enums.ScopeWorkspace, "test", "vulnerabilities", 8)
+		assert.NoError(t, err)
+		assert.NoError(t, manager.Release(context.Background(), enums.ScopeWorkspace, "test", "vulnerabilities", 5))
+
+		fakeClock.Advance(24 * time.Hour)
+
+		usage, err := manager.GetUsage(context.Background(), enums.ScopeWorkspace, "test", "vulnerabilities")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), usage.Used)
+		assert.True(t, usage.ResetAt.IsZero())
+
+		assert.NoError(t, manager.Sync(context.Background(), enums.ScopeWorkspace, "test", "vulnerabilities", 10))
+
+		_, err = manager.Consume(context.Background(), enums.ScopeWorkspace, "test", "vulnerabilities", 1)
+		assert.ErrorIs(t, err, enums.ErrorQuotaExceeded)
+	})
+
+	t.Run("should not release below zero", func(t *testing.T) {
+		manager := newTestManager(clock.NewFakeClock(time.Now()), limit)
+
+		assert.NoError(t, manager.Release(context.Background(), enums.ScopeWorkspace, "test", "vulnerabilities", 5))
+
+		usage, err := manager.GetUsage(context.Background(), enums.ScopeWorkspace, "test", "vulnerabilities")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), usage.Used)
+	})
+}
+
+func TestExceededError(t *testing.T) {
+	t.Run("should convert to too many requests app error", func(t *testing.T) {
+		err := &ExceededError{Usage: Usage{Scope: enums.ScopeWorkspace, ID: "test", Metric: "analyses", Used: 2,
+			Limit: 2}}
+
+		appError := err.AppError()
+
+		assert.Equal(t, errorcode.TooManyRequests, appError.Code)
+		assert.Equal(t, "quota of workspace:analyses exceeded, used 2 of 2", appError.Message)
+		assert.Equal(t, "test", appError.Metadata[enums.MetadataID])
+		assert.ErrorIs(t, appError, enums.ErrorQuotaExceeded)
+	})
+}
+
+type errorStore struct{}
+
+func (e *errorStore) Increment(_ context.Context, _ string, _ int64, _ time.Duration) (int64, error) {
+	return 0, errors.New("test")
+}
+
+func (e *errorStore) Get(_ context.Context, _ string) (int64, error) {
+	return 0, errors.New("test")
+}
+
+func (e *errorStore) Set(_ context.Context, _ string, _ int64, _ time.Duration) error {
+	return errors.New("test")
+}
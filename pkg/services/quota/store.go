@@ -0,0 +1,155 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/quota/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+)
+
+// IStore keeps the quota counters. Increment adds the amount, which is negative to release, and returns the new value
+// without letting it go below zero, setting the ttl of the counter when it is greater than zero. Get returns zero for
+// unknown counters and Set replaces the value, which is used to sync absolute counters with the counted resources.
+type IStore interface {
+	Increment(ctx context.Context, key string, amount int64, ttl time.Duration) (int64, error)
+	Get(ctx context.Context, key string) (int64, error)
+	Set(ctx context.Context, key string, value int64, ttl time.Duration) error
+}
+
+type counter struct {
+	value     int64
+	expiresAt time.Time
+}
+
+type memoryStore struct {
+	mutex    sync.Mutex
+	counters map[string]*counter
+	clock    clock.IClock
+}
+
+// NewMemoryStore returns a store that keeps the counters in memory, which only works with a single replica and loses
+// the counters on restarts. The expired counters are removed when they are accessed.
+func NewMemoryStore(clk clock.IClock) IStore {
+	return &memoryStore{counters: map[string]*counter{}, clock: clock.OrDefault(clk)}
+}
+
+func (m *memoryStore) Increment(_ context.Context, key string, amount int64, ttl time.Duration) (int64, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	current := m.get(key)
+	current.value += amount
+
+	if current.value < 0 {
+		current.value = 0
+	}
+
+	m.set(key, current, ttl)
+
+	return current.value, nil
+}
+
+func (m *memoryStore) Get(_ context.Context, key string) (int64, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.get(key).value, nil
+}
+
+func (m *memoryStore) Set(_ context.Context, key string, value int64, ttl time.Duration) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.set(key, &counter{value: value}, ttl)
+
+	return nil
+}
+
+func (m *memoryStore) get(key string) *counter {
+	current, ok := m.counters[key]
+	if !ok {
+		return &counter{}
+	}
+
+	if !current.expiresAt.IsZero() && !m.clock.Now().Before(current.expiresAt) {
+		delete(m.counters, key)
+
+		return &counter{}
+	}
+
+	return current
+}
+
+func (m *memoryStore) set(key string, current *counter, ttl time.Duration) {
+	if ttl > 0 {
+		current.expiresAt = m.clock.Now().Add(ttl)
+	}
+
+	m.counters[key] = current
+}
+
+type redisStore struct {
+	client goredis.UniversalClient
+}
+
+// NewRedisStore returns a store that keeps the counters on redis, sharing them between the replicas
+func NewRedisStore(client goredis.UniversalClient) IStore {
+	return &redisStore{client: client}
+}
+
+func (r *redisStore) Increment(ctx context.Context, key string, amount int64, ttl time.Duration) (int64, error) {
+	value, err := r.client.Eval(ctx, enums.RedisIncrementScript, []string{key}, amount, ttl.Milliseconds()).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	count, ok := value.(int64)
+	if !ok {
+		return 0, fmt.Errorf("%w: %v", enums.ErrorInvalidCounter, value)
+	}
+
+	return count, nil
+}
+
+func (r *redisStore) Get(ctx context.Context, key string) (int64, error) {
+	value, err := r.client.Get(ctx, key).Result()
+	if errors.Is(err, goredis.Nil) {
+		return 0, nil
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", enums.ErrorInvalidCounter, err.Error())
+	}
+
+	return count, nil
+}
+
+func (r *redisStore) Set(ctx context.Context, key string, value int64, ttl time.Duration) error {
+	return r.client.Set(ctx, key, strconv.FormatInt(value, 10), ttl).Err()
+}
@@ -0,0 +1,113 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/quota/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+)
+
+func TestMemoryStore(t *testing.T) {
+	t.Run("should expire counters with ttl", func(t *testing.T) {
+		fakeClock := clock.NewFakeClock(time.Now())
+		store := NewMemoryStore(fakeClock)
+
+		value, err := store.Increment(context.Background(), "test", 2, time.Minute)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), value)
+
+		fakeClock.Advance(time.Minute)
+
+		value, err = store.Get(context.Background(), "test")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), value)
+	})
+}
+
+func newTestRedis(t *testing.T) (*miniredis.Miniredis, goredis.UniversalClient) {
+	server := miniredis.RunT(t)
+	client := goredis.NewClient(&goredis.Options{Addr: server.Addr(), MaxRetries: -1})
+
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	return server, client
+}
+
+func TestRedisStore(t *testing.T) {
+	t.Run("should increment with script without going below zero", func(t *testing.T) {
+		server, client := newTestRedis(t)
+		store := NewRedisStore(client)
+
+		value, err := store.Increment(context.Background(), "test", 3, time.Minute)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), value)
+		assert.Equal(t, time.Minute, server.TTL("test"))
+
+		value, err = store.Increment(context.Background(), "test", -5, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), value)
+	})
+
+	t.Run("should return error when counter is invalid", func(t *testing.T) {
+		server, client := newTestRedis(t)
+		assert.NoError(t, server.Set("test", "test"))
+
+		_, err := NewRedisStore(client).Get(context.Background(), "test")
+
+		assert.ErrorIs(t, err, enums.ErrorInvalidCounter)
+	})
+
+	t.Run("should get and set counters", func(t *testing.T) {
+		_, client := newTestRedis(t)
+		store := NewRedisStore(client)
+
+		assert.NoError(t, store.Set(context.Background(), "test", 5, 0))
+
+		value, err := store.Get(context.Background(), "test")
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), value)
+	})
+
+	t.Run("should return zero when counter does not exist", func(t *testing.T) {
+		_, client := newTestRedis(t)
+
+		value, err := NewRedisStore(client).Get(context.Background(), "test")
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), value)
+	})
+
+	t.Run("should return error when redis fails", func(t *testing.T) {
+		server, client := newTestRedis(t)
+		server.Close()
+
+		_, err := NewRedisStore(client).Get(context.Background(), "test")
+		assert.Error(t, err)
+
+		_, err = NewRedisStore(client).Increment(context.Background(), "test", 1, 0)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,163 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/cache/ttl"
+	"github.com/ZupIT/horusec-devkit/pkg/services/redis/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+// Cache stores the values as json in redis, so the same entries are shared between every replica. It implements the
+// same interface of the memory cache, so it can be used as the backing store of the rate limit, dedup and decision
+// caches without changing them. Redis failures are logged and handled as a cache miss.
+type Cache[V any] struct {
+	client     goredis.UniversalClient
+	prefix     string
+	expiration time.Duration
+}
+
+func NewCache[V any](client goredis.UniversalClient, prefix string, expiration time.Duration) ttl.ICache[string, V] {
+	return &Cache[V]{client: client, prefix: prefix, expiration: expiration}
+}
+
+func (c *Cache[V]) Get(key string) (value V, ok bool) {
+	content, err := c.client.Get(context.Background(), c.prefix+key).Bytes()
+	if err != nil {
+		if !errors.Is(err, goredis.Nil) {
+			logger.LogError(enums.MessageFailedToReadCache, err)
+		}
+
+		return value, false
+	}
+
+	if err := json.Unmarshal(content, &value); err != nil {
+		logger.LogError(enums.MessageFailedToReadCache, err)
+
+		return value, false
+	}
+
+	return value, true
+}
+
+// GetOrLoad does not deduplicate the loads between replicas, only the first value stored is kept until it expires
+func (c *Cache[V]) GetOrLoad(ctx context.Context, key string, load func(ctx context.Context) (V, error)) (V, error) {
+	if value, ok := c.Get(key); ok {
+		return value, nil
+	}
+
+	value, err := load(ctx)
+	if err != nil {
+		return value, err
+	}
+
+	c.Set(key, value)
+
+	return value, nil
+}
+
+func (c *Cache[V]) Set(key string, value V) {
+	c.SetWithTTL(key, value, c.expiration)
+}
+
+// SetWithTTL stores the value without expiration when the expiration is lower or equal than zero
+func (c *Cache[V]) SetWithTTL(key string, value V, expiration time.Duration) {
+	content, err := json.Marshal(value)
+	if err != nil {
+		logger.LogError(enums.MessageFailedToWriteCache, err)
+
+		return
+	}
+
+	if expiration < 0 {
+		expiration = 0
+	}
+
+	logger.LogError(enums.MessageFailedToWriteCache,
+		c.client.Set(context.Background(), c.prefix+key, content, expiration).Err())
+}
+
+func (c *Cache[V]) Delete(key string) {
+	logger.LogError(enums.MessageFailedToWriteCache, c.client.Del(context.Background(), c.prefix+key).Err())
+}
+
+// Clear deletes the keys one by one, since the keys of a cluster can be in different slots
+func (c *Cache[V]) Clear() {
+	keys, err := c.scan(context.Background())
+	if err != nil || len(keys) == 0 {
+		logger.LogError(enums.MessageFailedToWriteCache, err)
+
+		return
+	}
+
+	_, err = c.client.Pipelined(context.Background(), func(pipeline goredis.Pipeliner) error {
+		for _, key := range keys {
+			pipeline.Del(context.Background(), key)
+		}
+
+		return nil
+	})
+
+	logger.LogError(enums.MessageFailedToWriteCache, err)
+}
+
+// Len returns zero when redis is not available
+func (c *Cache[V]) Len() int {
+	keys, err := c.scan(context.Background())
+	logger.LogError(enums.MessageFailedToReadCache, err)
+
+	return len(keys)
+}
+
+// scan returns the keys of the prefix, reading every master when the client is a cluster client
+func (c *Cache[V]) scan(ctx context.Context) ([]string, error) {
+	cluster, ok := c.client.(*goredis.ClusterClient)
+	if !ok {
+		return scanNode(ctx, c.client, c.prefix+"*")
+	}
+
+	var mutex sync.Mutex
+
+	keys := []string{}
+	err := cluster.ForEachMaster(ctx, func(ctx context.Context, node *goredis.Client) error {
+		nodeKeys, err := scanNode(ctx, node, c.prefix+"*")
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		keys = append(keys, nodeKeys...)
+
+		return err
+	})
+
+	return keys, err
+}
+
+func scanNode(ctx context.Context, client goredis.Cmdable, pattern string) (keys []string, err error) {
+	iterator := client.Scan(ctx, 0, pattern, enums.ScanCount).Iterator()
+	for iterator.Next(ctx) {
+		keys = append(keys, iterator.Val())
+	}
+
+	return keys, iterator.Err()
+}
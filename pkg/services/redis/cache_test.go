@@ -0,0 +1,100 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+type cacheValue struct {
+	Name string
+}
+
+func TestCache(t *testing.T) {
+	t.Run("should store values as json with the prefix", func(t *testing.T) {
+		server := miniredis.RunT(t)
+		cache := NewCache[*cacheValue](newTestClient(t, newTestOptions(server.Addr())), "decisions:", time.Minute)
+
+		cache.Set("key", &cacheValue{Name: "test"})
+
+		value, ok := cache.Get("key")
+		assert.True(t, ok)
+		assert.Equal(t, "test", value.Name)
+		server.CheckGet(t, "decisions:key", `{"Name":"test"}`)
+		assert.Equal(t, time.Minute, server.TTL("decisions:key"))
+		assert.Equal(t, 1, cache.Len())
+
+		cache.Delete("key")
+
+		_, ok = cache.Get("key")
+		assert.False(t, ok)
+	})
+
+	t.Run("should load only when value is not cached", func(t *testing.T) {
+		cache := NewCache[int](newTestClient(t, newTestOptions(miniredis.RunT(t).Addr())), "dedup:", time.Minute)
+		loads := 0
+		load := func(ctx context.Context) (int, error) {
+			loads++
+
+			return 10, nil
+		}
+
+		for i := 0; i < 2; i++ {
+			value, err := cache.GetOrLoad(context.Background(), "key", load)
+			assert.NoError(t, err)
+			assert.Equal(t, 10, value)
+		}
+
+		assert.Equal(t, 1, loads)
+
+		_, err := cache.GetOrLoad(context.Background(), "other", func(ctx context.Context) (int, error) {
+			return 0, errors.New("test")
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("should clear only the keys of the prefix", func(t *testing.T) {
+		server := miniredis.RunT(t)
+		client := newTestClient(t, newTestOptions(server.Addr()))
+		cache := NewCache[string](client, "rate:", time.Minute)
+
+		cache.Set("1", "test")
+		cache.Set("2", "test")
+		assert.NoError(t, client.Set(context.Background(), "other", "test", 0).Err())
+
+		cache.Clear()
+
+		assert.Equal(t, 0, cache.Len())
+		assert.True(t, server.Exists("other"))
+	})
+
+	t.Run("should handle redis failures as a miss", func(t *testing.T) {
+		server := miniredis.RunT(t)
+		cache := NewCache[string](newTestClient(t, newTestOptions(server.Addr())), "test:", time.Minute)
+
+		cache.Set("key", "test")
+		server.Close()
+
+		_, ok := cache.Get("key")
+		assert.False(t, ok)
+		assert.Equal(t, 0, cache.Len())
+	})
+}
@@ -0,0 +1,34 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+const (
+	HorusecRedisAddress           = "HORUSEC_REDIS_ADDRESS"
+	HorusecRedisUsername          = "HORUSEC_REDIS_USERNAME"
+	HorusecRedisPassword          = "HORUSEC_REDIS_PASSWORD"
+	HorusecRedisDatabase          = "HORUSEC_REDIS_DATABASE"
+	HorusecRedisTLSEnabled        = "HORUSEC_REDIS_TLS_ENABLED"
+	HorusecRedisTLSCAPath         = "HORUSEC_REDIS_TLS_CA_PATH"
+	HorusecRedisSentinelAddresses = "HORUSEC_REDIS_SENTINEL_ADDRESSES"
+	HorusecRedisSentinelMaster    = "HORUSEC_REDIS_SENTINEL_MASTER"
+	HorusecRedisClusterAddresses  = "HORUSEC_REDIS_CLUSTER_ADDRESSES"
+	HorusecRedisPoolSize          = "HORUSEC_REDIS_POOL_SIZE"
+	HorusecRedisTimeoutSeconds    = "HORUSEC_REDIS_TIMEOUT_SECONDS"
+
+	DefaultRedisAddress        = "localhost:6379"
+	DefaultRedisPoolSize       = 10
+	DefaultRedisTimeoutSeconds = 5
+	ScanCount                  = 100
+)
@@ -0,0 +1,112 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"crypto/tls"
+	"strings"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/redis/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// Options configures the redis connections. When the sentinel addresses are informed the address is ignored and the
+// master is resolved by the sentinels, and when the cluster addresses are informed they are used to discover the
+// nodes of the cluster, which does not support the database option.
+type Options struct {
+	Address           string
+	Username          string
+	Password          string
+	Database          int
+	TLSEnabled        bool
+	TLSCAPath         string
+	SentinelAddresses []string
+	SentinelMaster    string
+	ClusterAddresses  []string
+	PoolSize          int
+	Timeout           time.Duration
+}
+
+func NewOptions() *Options {
+	return &Options{
+		Address:           env.GetEnvOrDefault(enums.HorusecRedisAddress, enums.DefaultRedisAddress),
+		Username:          env.GetEnvOrDefault(enums.HorusecRedisUsername, ""),
+		Password:          env.GetEnvOrDefault(enums.HorusecRedisPassword, ""),
+		Database:          env.GetEnvOrDefaultInt(enums.HorusecRedisDatabase, 0),
+		TLSEnabled:        env.GetEnvOrDefaultBool(enums.HorusecRedisTLSEnabled, false),
+		TLSCAPath:         env.GetEnvOrDefault(enums.HorusecRedisTLSCAPath, ""),
+		SentinelAddresses: getAddresses(enums.HorusecRedisSentinelAddresses),
+		SentinelMaster:    env.GetEnvOrDefault(enums.HorusecRedisSentinelMaster, ""),
+		ClusterAddresses:  getAddresses(enums.HorusecRedisClusterAddresses),
+		PoolSize:          env.GetEnvOrDefaultInt(enums.HorusecRedisPoolSize, enums.DefaultRedisPoolSize),
+		Timeout: time.Duration(env.GetEnvOrDefaultInt(enums.HorusecRedisTimeoutSeconds,
+			enums.DefaultRedisTimeoutSeconds)) * time.Second,
+	}
+}
+
+func getAddresses(key string) (addresses []string) {
+	for _, address := range strings.Split(env.GetEnvOrDefault(key, ""), ",") {
+		if address = strings.TrimSpace(address); address != "" {
+			addresses = append(addresses, address)
+		}
+	}
+
+	return addresses
+}
+
+func (o *Options) toOptions(tlsConfig *tls.Config) *goredis.Options {
+	return &goredis.Options{
+		Addr:         o.Address,
+		Username:     o.Username,
+		Password:     o.Password,
+		DB:           o.Database,
+		TLSConfig:    tlsConfig,
+		PoolSize:     o.PoolSize,
+		DialTimeout:  o.Timeout,
+		ReadTimeout:  o.Timeout,
+		WriteTimeout: o.Timeout,
+	}
+}
+
+func (o *Options) toFailoverOptions(tlsConfig *tls.Config) *goredis.FailoverOptions {
+	return &goredis.FailoverOptions{
+		MasterName:    o.SentinelMaster,
+		SentinelAddrs: o.SentinelAddresses,
+		Username:      o.Username,
+		Password:      o.Password,
+		DB:            o.Database,
+		TLSConfig:     tlsConfig,
+		PoolSize:      o.PoolSize,
+		DialTimeout:   o.Timeout,
+		ReadTimeout:   o.Timeout,
+		WriteTimeout:  o.Timeout,
+	}
+}
+
+func (o *Options) toClusterOptions(tlsConfig *tls.Config) *goredis.ClusterOptions {
+	return &goredis.ClusterOptions{
+		Addrs:        o.ClusterAddresses,
+		Username:     o.Username,
+		Password:     o.Password,
+		TLSConfig:    tlsConfig,
+		PoolSize:     o.PoolSize,
+		DialTimeout:  o.Timeout,
+		ReadTimeout:  o.Timeout,
+		WriteTimeout: o.Timeout,
+	}
+}
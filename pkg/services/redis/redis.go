@@ -0,0 +1,68 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"path/filepath"
+
+	goredis "github.com/redis/go-redis/v9"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/redis/enums"
+)
+
+// NewRedis returns a client of the master resolved by the sentinels when they are configured, a cluster client when
+// the cluster addresses are configured, or a client of the address otherwise. The connections are opened on demand,
+// so it does not fail when redis is not available yet.
+func NewRedis(options *Options) (goredis.UniversalClient, error) {
+	tlsConfig, err := newTLSConfig(options)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case len(options.SentinelAddresses) > 0:
+		return goredis.NewFailoverClient(options.toFailoverOptions(tlsConfig)), nil
+	case len(options.ClusterAddresses) > 0:
+		return goredis.NewClusterClient(options.toClusterOptions(tlsConfig)), nil
+	default:
+		return goredis.NewClient(options.toOptions(tlsConfig)), nil
+	}
+}
+
+func newTLSConfig(options *Options) (*tls.Config, error) {
+	if !options.TLSEnabled {
+		return nil, nil
+	}
+
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	if options.TLSCAPath == "" {
+		return config, nil
+	}
+
+	content, err := os.ReadFile(filepath.Clean(options.TLSCAPath))
+	if err != nil {
+		return nil, err
+	}
+
+	config.RootCAs = x509.NewCertPool()
+	if !config.RootCAs.AppendCertsFromPEM(content) {
+		return nil, enums.ErrorInvalidCAFile
+	}
+
+	return config, nil
+}
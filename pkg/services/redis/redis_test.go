@@ -0,0 +1,103 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/redis/enums"
+)
+
+func newTestClient(t *testing.T, options *Options) goredis.UniversalClient {
+	client, err := NewRedis(options)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	return client
+}
+
+func newTestOptions(address string) *Options {
+	return &Options{Address: address, PoolSize: 2, Timeout: time.Second}
+}
+
+func TestNewOptions(t *testing.T) {
+	t.Run("should read options from env", func(t *testing.T) {
+		t.Setenv(enums.HorusecRedisAddress, "redis:6379")
+		t.Setenv(enums.HorusecRedisDatabase, "2")
+		t.Setenv(enums.HorusecRedisSentinelAddresses, "sentinel-1:26379, sentinel-2:26379,")
+		t.Setenv(enums.HorusecRedisClusterAddresses, "node-1:6379")
+		t.Setenv(enums.HorusecRedisTimeoutSeconds, "3")
+
+		options := NewOptions()
+
+		assert.Equal(t, "redis:6379", options.Address)
+		assert.Equal(t, 2, options.Database)
+		assert.Equal(t, []string{"sentinel-1:26379", "sentinel-2:26379"}, options.SentinelAddresses)
+		assert.Equal(t, []string{"node-1:6379"}, options.ClusterAddresses)
+		assert.Equal(t, 3*time.Second, options.Timeout)
+		assert.Equal(t, enums.DefaultRedisPoolSize, options.PoolSize)
+	})
+}
+
+func TestNewRedis(t *testing.T) {
+	t.Run("should return error when ca file is invalid", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "ca.crt")
+		assert.NoError(t, os.WriteFile(path, []byte("test"), 0o600))
+
+		_, err := NewRedis(&Options{TLSEnabled: true, TLSCAPath: path})
+
+		assert.ErrorIs(t, err, enums.ErrorInvalidCAFile)
+	})
+
+	t.Run("should create client without connecting", func(t *testing.T) {
+		client := newTestClient(t, newTestOptions("127.0.0.1:1"))
+
+		assert.Error(t, client.Ping(context.Background()).Err())
+	})
+
+	t.Run("should authenticate and select database", func(t *testing.T) {
+		server := miniredis.RunT(t)
+		server.RequireUserAuth("user", "secret")
+
+		options := newTestOptions(server.Addr())
+		options.Username, options.Password, options.Database = "user", "secret", 3
+
+		assert.NoError(t, newTestClient(t, options).Set(context.Background(), "key", "value", 0).Err())
+		assert.True(t, server.DB(3).Exists("key"))
+	})
+
+	t.Run("should return sentinel and cluster clients", func(t *testing.T) {
+		options := newTestOptions("")
+		options.SentinelAddresses, options.SentinelMaster = []string{"127.0.0.1:1"}, "mymaster"
+
+		assert.IsType(t, &goredis.Client{}, newTestClient(t, options))
+
+		options = newTestOptions("")
+		options.ClusterAddresses = []string{"127.0.0.1:1"}
+
+		assert.IsType(t, &goredis.ClusterClient{}, newTestClient(t, options))
+	})
+}
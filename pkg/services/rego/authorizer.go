@@ -0,0 +1,77 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rego
+
+import (
+	"context"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
+)
+
+// AuthorizationInput is the input of the authorization query, the account is empty when the token is invalid, so
+// the policies can deny those requests by checking the account id
+type AuthorizationInput struct {
+	Type         string  `json:"type"`
+	WorkspaceID  string  `json:"workspaceID"`
+	RepositoryID string  `json:"repositoryID"`
+	Account      Account `json:"account"`
+}
+
+type Account struct {
+	ID          string   `json:"id"`
+	Email       string   `json:"email"`
+	Username    string   `json:"username"`
+	Permissions []string `json:"permissions"`
+}
+
+// Authorizer evaluates the authorization query of the options, it replaces the auth service on the authz middleware
+// with the middlewares.WithAuthorizer option, so the roles can be bound by the rego policies and their data
+type Authorizer struct {
+	query *Query
+}
+
+func NewAuthorizer(ctx context.Context, options *Options) (middlewares.IAuthorizer, error) {
+	query, err := NewQuery(ctx, options, options.AuthzQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Authorizer{query: query}, nil
+}
+
+func (a *Authorizer) IsAuthorized(ctx context.Context, data *proto.IsAuthorizedData) (bool, error) {
+	return a.query.Allowed(ctx, &AuthorizationInput{
+		Type:         data.GetType(),
+		WorkspaceID:  data.GetWorkspaceID(),
+		RepositoryID: data.GetRepositoryID(),
+		Account:      newAccount(data.GetToken()),
+	})
+}
+
+func newAccount(token string) Account {
+	claims, err := jwt.DecodeToken(token)
+	if err != nil {
+		return Account{}
+	}
+
+	return Account{
+		ID:          claims.Subject,
+		Email:       claims.Email,
+		Username:    claims.Username,
+		Permissions: claims.Permissions,
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+const (
+	HorusecRegoPaths       = "HORUSEC_REGO_PATHS"
+	HorusecRegoAuthzQuery  = "HORUSEC_REGO_AUTHZ_QUERY"
+	HorusecRegoPolicyQuery = "HORUSEC_REGO_POLICY_QUERY"
+
+	// DefaultAuthzQuery must be a boolean rule, the request is only authorized when it is true
+	DefaultAuthzQuery = "data.horusec.authz.allow"
+	// DefaultPolicyQuery must be a set of strings or of objects with rule and message, the analysis fails when it is
+	// not empty
+	DefaultPolicyQuery = "data.horusec.policy.deny"
+
+	ReasonFieldRule    = "rule"
+	ReasonFieldMessage = "message"
+	DefaultReasonRule  = "rego"
+)
@@ -0,0 +1,45 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rego
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/services/rego/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// Options configures the rego policies, the paths are files or directories with .rego modules and json or yaml
+// data documents, while the modules and data are used to embed the policies on the services. The data documents of
+// the paths are loaded under their directories, so horusec/roles/data.json is data.horusec.roles and it is merged
+// with the data of the options.
+type Options struct {
+	Paths       []string
+	Modules     map[string]string
+	Data        map[string]interface{}
+	AuthzQuery  string
+	PolicyQuery string
+	Clock       clock.IClock
+}
+
+func NewOptions() *Options {
+	return &Options{
+		Paths:       env.GetStringSlice(enums.HorusecRegoPaths, nil),
+		Modules:     map[string]string{},
+		Data:        map[string]interface{}{},
+		AuthzQuery:  env.GetEnvOrDefault(enums.HorusecRegoAuthzQuery, enums.DefaultAuthzQuery),
+		PolicyQuery: env.GetEnvOrDefault(enums.HorusecRegoPolicyQuery, enums.DefaultPolicyQuery),
+		Clock:       clock.NewClock(),
+	}
+}
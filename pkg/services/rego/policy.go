@@ -0,0 +1,126 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rego
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/tools"
+	"github.com/ZupIT/horusec-devkit/pkg/services/policy"
+	policyEnums "github.com/ZupIT/horusec-devkit/pkg/services/policy/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/rego/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+)
+
+// PolicyInput is the input of the policy query, with the active vulnerabilities by severity already counted
+type PolicyInput struct {
+	Analysis         *analysis.Analysis          `json:"analysis"`
+	Branch           string                      `json:"branch"`
+	SkippedTools     []tools.Tool                `json:"skippedTools"`
+	ActiveBySeverity map[severities.Severity]int `json:"activeBySeverity"`
+}
+
+// PolicyEngine is a backend of the analysis policy engine that evaluates the policy query of the options, each
+// value of the query is a reason that fails the analysis
+type PolicyEngine struct {
+	query *Query
+	clock clock.IClock
+}
+
+func NewPolicyEngine(ctx context.Context, options *Options) (policy.IEngine, error) {
+	query, err := NewQuery(ctx, options, options.PolicyQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PolicyEngine{query: query, clock: clock.OrDefault(options.Clock)}, nil
+}
+
+// Evaluate is not canceled by the callers, since the policy engine interface does not receive a context, so the
+// policies must not make network calls
+func (p *PolicyEngine) Evaluate(input *policy.Input) (*policy.Decision, error) {
+	if input == nil || input.Analysis == nil {
+		return nil, policyEnums.ErrorNilAnalysis
+	}
+
+	value, err := p.query.Eval(context.Background(), &PolicyInput{Analysis: input.Analysis, Branch: input.Branch,
+		SkippedTools: input.SkippedTools, ActiveBySeverity: input.ActiveBySeverity()})
+	if err != nil {
+		return nil, err
+	}
+
+	reasons, err := p.parseReasons(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy.Decision{
+		AnalysisID:  input.Analysis.GetIDString(),
+		Policy:      p.query.query,
+		Branch:      input.Branch,
+		Passed:      len(reasons) == 0,
+		Reasons:     reasons,
+		EvaluatedAt: p.clock.Now(),
+	}, nil
+}
+
+// parseReasons accepts a set of messages or of objects with the rule and the message, an undefined query has no
+// reasons
+func (p *PolicyEngine) parseReasons(value interface{}) ([]*policy.Reason, error) {
+	reasons := []*policy.Reason{}
+	if value == nil {
+		return reasons, nil
+	}
+
+	values, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%w: %s is not a set", enums.ErrorInvalidResult, p.query.query)
+	}
+
+	for _, item := range values {
+		reason, err := p.parseReason(item)
+		if err != nil {
+			return nil, err
+		}
+
+		reasons = append(reasons, reason)
+	}
+
+	return reasons, nil
+}
+
+func (p *PolicyEngine) parseReason(item interface{}) (*policy.Reason, error) {
+	reason := &policy.Reason{Rule: enums.DefaultReasonRule, Check: policyEnums.CheckRego}
+
+	switch value := item.(type) {
+	case string:
+		reason.Message = value
+	case map[string]interface{}:
+		message, _ := value[enums.ReasonFieldMessage].(string)
+		if rule, ok := value[enums.ReasonFieldRule].(string); ok && rule != "" {
+			reason.Rule = rule
+		}
+
+		reason.Message = message
+	default:
+		return nil, fmt.Errorf("%w: %s has a reason that is not a string or object", enums.ErrorInvalidResult,
+			p.query.query)
+	}
+
+	return reason, nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rego
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/open-policy-agent/opa/v1/loader"
+	"github.com/open-policy-agent/opa/v1/rego"
+	"github.com/open-policy-agent/opa/v1/storage/inmem"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/rego/enums"
+)
+
+// Query is a rego query compiled once with the modules and data of the options, it is safe for concurrent use
+type Query struct {
+	query    string
+	prepared rego.PreparedEvalQuery
+}
+
+func NewQuery(ctx context.Context, options *Options, query string) (*Query, error) {
+	loaded, err := loader.NewFileLoader().All(options.Paths)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", enums.ErrorPrepareQuery, err.Error())
+	}
+
+	arguments := []func(*rego.Rego){
+		rego.Query(query),
+		rego.Store(inmem.NewFromObject(mergeDocuments(loaded.Documents, options.Data))),
+	}
+
+	for _, module := range loaded.ParsedModules() {
+		arguments = append(arguments, rego.ParsedModule(module))
+	}
+
+	for name, module := range options.Modules {
+		arguments = append(arguments, rego.Module(name, module))
+	}
+
+	prepared, err := rego.New(arguments...).PrepareForEval(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", enums.ErrorPrepareQuery, err.Error())
+	}
+
+	return &Query{query: query, prepared: prepared}, nil
+}
+
+// Eval returns the value of the query for the input, or nil when the query is undefined for it
+func (q *Query) Eval(ctx context.Context, input interface{}) (interface{}, error) {
+	results, err := q.prepared.Eval(ctx, rego.EvalInput(input))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", enums.ErrorEvaluation, err.Error())
+	}
+
+	if len(results) == 0 || len(results[0].Expressions) == 0 {
+		return nil, nil
+	}
+
+	return results[0].Expressions[0].Value, nil
+}
+
+// Allowed returns true only when the query is the boolean true for the input, an undefined query is not allowed
+func (q *Query) Allowed(ctx context.Context, input interface{}) (bool, error) {
+	value, err := q.Eval(ctx, input)
+	if err != nil || value == nil {
+		return false, err
+	}
+
+	allowed, ok := value.(bool)
+	if !ok {
+		return false, fmt.Errorf("%w: %s is not a boolean", enums.ErrorInvalidResult, q.query)
+	}
+
+	return allowed, nil
+}
+
+// mergeDocuments merges the data of the options over the documents loaded from the paths, keeping the keys of both
+// when they are objects
+func mergeDocuments(loaded, data map[string]interface{}) map[string]interface{} {
+	merged := map[string]interface{}{}
+	for key, value := range loaded {
+		merged[key] = value
+	}
+
+	for key, value := range data {
+		loadedObject, isLoadedObject := merged[key].(map[string]interface{})
+		object, isObject := value.(map[string]interface{})
+
+		if isLoadedObject && isObject {
+			merged[key] = mergeDocuments(loadedObject, object)
+		} else {
+			merged[key] = value
+		}
+	}
+
+	return merged
+}
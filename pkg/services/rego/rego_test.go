@@ -0,0 +1,263 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rego
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/tools"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+	"github.com/ZupIT/horusec-devkit/pkg/services/policy"
+	policyEnums "github.com/ZupIT/horusec-devkit/pkg/services/policy/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/rego/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
+	jwtEntities "github.com/ZupIT/horusec-devkit/pkg/utils/jwt/entities"
+)
+
+const testAuthzModule = `package horusec.authz
+
+import rego.v1
+
+default allow := false
+
+allow if {
+	input.type == "IsWorkspaceMember"
+	input.account.email in data.horusec.members[input.workspaceID]
+}
+
+allow if {
+	"admin" in input.account.permissions
+}
+`
+
+const testPolicyModule = `package horusec.policy
+
+import rego.v1
+
+deny contains "critical vulnerabilities are not allowed" if {
+	input.activeBySeverity.CRITICAL > 0
+}
+
+deny contains {"rule": "tools", "message": sprintf("%s was skipped", [tool])} if {
+	input.branch == "main"
+	some tool in input.skippedTools
+}
+`
+
+func newTestOptions(modules map[string]string) *Options {
+	options := NewOptions()
+	options.Modules = modules
+	options.Data = map[string]interface{}{
+		"horusec": map[string]interface{}{
+			"members": map[string]interface{}{"workspace": []interface{}{"test@test.com"}},
+		},
+	}
+	options.Clock = clock.NewFakeClock(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+
+	return options
+}
+
+func newTestToken(permissions []string) string {
+	token, _, _ := jwt.CreateToken(&jwtEntities.TokenData{
+		Email:     "test@test.com",
+		Username:  "test",
+		AccountID: uuid.New(),
+	}, permissions)
+
+	return token
+}
+
+func newTestAnalysis(severity severities.Severity) *analysisEntities.Analysis {
+	return &analysisEntities.Analysis{
+		ID: uuid.New(),
+		AnalysisVulnerabilities: []analysisEntities.AnalysisVulnerabilities{
+			{Vulnerability: vulnerability.Vulnerability{Severity: severity}},
+		},
+	}
+}
+
+func TestNewQuery(t *testing.T) {
+	t.Run("should return error when the modules are invalid", func(t *testing.T) {
+		_, err := NewQuery(context.Background(), newTestOptions(map[string]string{"test.rego": "invalid"}),
+			enums.DefaultAuthzQuery)
+
+		assert.ErrorIs(t, err, enums.ErrorPrepareQuery)
+	})
+
+	t.Run("should load the modules and data of the paths", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "horusec")
+		assert.NoError(t, os.MkdirAll(path, 0o700))
+		assert.NoError(t, os.WriteFile(filepath.Join(path, "authz.rego"), []byte(testAuthzModule), 0o600))
+		assert.NoError(t, os.MkdirAll(filepath.Join(path, "members"), 0o700))
+		assert.NoError(t, os.WriteFile(filepath.Join(path, "members", "data.json"),
+			[]byte(`{"workspace": ["test@test.com"]}`), 0o600))
+
+		options := newTestOptions(nil)
+		options.Data = map[string]interface{}{"horusec": map[string]interface{}{"other": true}}
+		options.Paths = []string{filepath.Dir(path)}
+
+		query, err := NewQuery(context.Background(), options, enums.DefaultAuthzQuery)
+		assert.NoError(t, err)
+
+		allowed, err := query.Allowed(context.Background(), &AuthorizationInput{Type: "IsWorkspaceMember",
+			WorkspaceID: "workspace", Account: Account{Email: "test@test.com"}})
+		assert.NoError(t, err)
+		assert.True(t, allowed)
+
+		query, err = NewQuery(context.Background(), options, "data.horusec.other")
+		assert.NoError(t, err)
+
+		other, err := query.Eval(context.Background(), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, true, other)
+	})
+}
+
+func TestQueryAllowed(t *testing.T) {
+	t.Run("should not allow when the query is undefined", func(t *testing.T) {
+		query, err := NewQuery(context.Background(), newTestOptions(nil), "data.horusec.undefined")
+		assert.NoError(t, err)
+
+		allowed, err := query.Allowed(context.Background(), nil)
+		assert.NoError(t, err)
+		assert.False(t, allowed)
+	})
+
+	t.Run("should return error when the query is not a boolean", func(t *testing.T) {
+		query, err := NewQuery(context.Background(), newTestOptions(nil), "data.horusec.members")
+		assert.NoError(t, err)
+
+		_, err = query.Allowed(context.Background(), nil)
+		assert.ErrorIs(t, err, enums.ErrorInvalidResult)
+	})
+
+	t.Run("should return error when the evaluation fails", func(t *testing.T) {
+		module := "package test\n\nimport rego.v1\n\nallow := true if input.a\n\nallow := false if input.b\n"
+		query, err := NewQuery(context.Background(), newTestOptions(map[string]string{"test.rego": module}),
+			"data.test.allow")
+		assert.NoError(t, err)
+
+		_, err = query.Allowed(context.Background(), map[string]interface{}{"a": true, "b": true})
+		assert.ErrorIs(t, err, enums.ErrorEvaluation)
+	})
+}
+
+func TestAuthorizer(t *testing.T) {
+	authorizer, err := NewAuthorizer(context.Background(),
+		newTestOptions(map[string]string{"authz.rego": testAuthzModule}))
+	assert.NoError(t, err)
+
+	t.Run("should authorize with the account of the token and the data", func(t *testing.T) {
+		allowed, err := authorizer.IsAuthorized(context.Background(), &proto.IsAuthorizedData{
+			Token: newTestToken(nil), Type: "IsWorkspaceMember", WorkspaceID: "workspace"})
+
+		assert.NoError(t, err)
+		assert.True(t, allowed)
+	})
+
+	t.Run("should authorize with the permissions of the token", func(t *testing.T) {
+		allowed, err := authorizer.IsAuthorized(context.Background(), &proto.IsAuthorizedData{
+			Token: newTestToken([]string{"admin"}), Type: "IsRepositoryAdmin"})
+
+		assert.NoError(t, err)
+		assert.True(t, allowed)
+	})
+
+	t.Run("should not authorize when the rules are not satisfied", func(t *testing.T) {
+		for _, data := range []*proto.IsAuthorizedData{
+			{Token: newTestToken(nil), Type: "IsWorkspaceAdmin", WorkspaceID: "workspace"},
+			{Token: newTestToken(nil), Type: "IsWorkspaceMember", WorkspaceID: "other"},
+			{Token: "invalid", Type: "IsWorkspaceMember", WorkspaceID: "workspace"},
+		} {
+			allowed, err := authorizer.IsAuthorized(context.Background(), data)
+
+			assert.NoError(t, err)
+			assert.False(t, allowed)
+		}
+	})
+
+	t.Run("should return error when the query is invalid", func(t *testing.T) {
+		options := newTestOptions(nil)
+		options.AuthzQuery = "data.horusec["
+
+		_, err := NewAuthorizer(context.Background(), options)
+		assert.ErrorIs(t, err, enums.ErrorPrepareQuery)
+	})
+}
+
+func TestPolicyEngine(t *testing.T) {
+	engine, err := NewPolicyEngine(context.Background(),
+		newTestOptions(map[string]string{"policy.rego": testPolicyModule}))
+	assert.NoError(t, err)
+
+	t.Run("should pass when the policies do not deny the analysis", func(t *testing.T) {
+		analysis := newTestAnalysis(severities.High)
+
+		decision, err := engine.Evaluate(&policy.Input{Analysis: analysis, Branch: "main"})
+
+		assert.NoError(t, err)
+		assert.True(t, decision.Passed)
+		assert.Empty(t, decision.Reasons)
+		assert.Equal(t, analysis.GetIDString(), decision.AnalysisID)
+		assert.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), decision.EvaluatedAt)
+	})
+
+	t.Run("should fail with the reasons of the policies", func(t *testing.T) {
+		decision, err := engine.Evaluate(&policy.Input{Analysis: newTestAnalysis(severities.Critical),
+			Branch: "main", SkippedTools: []tools.Tool{tools.GoSec}})
+
+		assert.NoError(t, err)
+		assert.False(t, decision.Passed)
+		assert.ElementsMatch(t, []*policy.Reason{
+			{Rule: enums.DefaultReasonRule, Check: policyEnums.CheckRego,
+				Message: "critical vulnerabilities are not allowed"},
+			{Rule: "tools", Check: policyEnums.CheckRego, Message: "GoSec was skipped"},
+		}, decision.Reasons)
+	})
+
+	t.Run("should return error when the analysis is nil", func(t *testing.T) {
+		_, err := engine.Evaluate(&policy.Input{})
+
+		assert.ErrorIs(t, err, policyEnums.ErrorNilAnalysis)
+	})
+
+	t.Run("should return error when the reasons are invalid", func(t *testing.T) {
+		for query, module := range map[string]string{
+			"data.test.deny": "package test\n\ndeny := true",
+			"data.test.list": "package test\n\nlist := [1]",
+		} {
+			options := newTestOptions(map[string]string{"test.rego": module})
+			options.PolicyQuery = query
+
+			engine, err := NewPolicyEngine(context.Background(), options)
+			assert.NoError(t, err)
+
+			_, err = engine.Evaluate(&policy.Input{Analysis: newTestAnalysis(severities.Low)})
+			assert.True(t, errors.Is(err, enums.ErrorInvalidResult))
+		}
+	})
+}
@@ -0,0 +1,56 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+const (
+	HorusecRetentionKeepDays    = "HORUSEC_RETENTION_KEEP_DAYS"
+	HorusecRetentionMaxAnalyses = "HORUSEC_RETENTION_MAX_ANALYSES"
+	HorusecRetentionBatchSize   = "HORUSEC_RETENTION_BATCH_SIZE"
+	HorusecRetentionDryRun      = "HORUSEC_RETENTION_DRY_RUN"
+	HorusecRetentionSchedule    = "HORUSEC_RETENTION_SCHEDULE"
+
+	DefaultBatchSize = 500
+	DefaultSchedule  = "@daily"
+	JobName          = "retention-purge"
+
+	// QuerySelectExpired ranks the analyses of each repository from the newest, so the ones beyond the max analyses
+	// are found on a single query. The analyses are paginated by id, since the dry run does not remove them.
+	QuerySelectExpired = "SELECT analysis_id FROM (SELECT analysis_id, created_at, ROW_NUMBER() OVER " +
+		"(PARTITION BY repository_id ORDER BY created_at DESC) AS position FROM analysis%s) ranked " +
+		"WHERE analysis_id > ? AND (%s) ORDER BY analysis_id LIMIT ?"
+	QuerySelectVulnerabilities = "SELECT DISTINCT vulnerability_id FROM analysis_vulnerabilities " +
+		"WHERE analysis_id IN ?"
+	QueryDeleteAnalysisVulnerabilities = "DELETE FROM analysis_vulnerabilities WHERE analysis_id IN ?"
+	QueryDeleteOrphanVulnerabilities   = "DELETE FROM vulnerabilities WHERE vulnerability_id IN ? AND NOT EXISTS " +
+		"(SELECT 1 FROM analysis_vulnerabilities WHERE analysis_vulnerabilities.vulnerability_id = " +
+		"vulnerabilities.vulnerability_id)"
+	QueryDeleteAnalyses = "DELETE FROM analysis WHERE analysis_id IN ?"
+
+	FilterWorkspace  = "workspace_id = ?"
+	FilterRepository = "repository_id = ?"
+	RuleCutoff       = "created_at < ?"
+	RuleMaxAnalyses  = "position > ?"
+	WhereKeyword     = " WHERE "
+	AndKeyword       = " AND "
+	OrKeyword        = " OR "
+
+	LogFieldPolicyID        = "policyID"
+	LogFieldWorkspaceID     = "workspaceID"
+	LogFieldRepositoryID    = "repositoryID"
+	LogFieldBatches         = "batches"
+	LogFieldAnalyses        = "analyses"
+	LogFieldVulnerabilities = "vulnerabilities"
+	LogFieldDryRun          = "dryRun"
+)
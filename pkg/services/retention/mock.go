@@ -0,0 +1,47 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retention
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/retention"
+	"github.com/ZupIT/horusec-devkit/pkg/services/scheduler"
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) Purge(_ context.Context, _ *retention.Policy) (*Progress, error) {
+	args := m.MethodCalled("Purge")
+
+	return args.Get(0).(*Progress), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) PurgeAll(_ context.Context, _ []*retention.Policy) ([]*Progress, error) {
+	args := m.MethodCalled("PurgeAll")
+
+	return args.Get(0).([]*Progress), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) NewJob(_ func(ctx context.Context) ([]*retention.Policy, error)) *scheduler.Job {
+	args := m.MethodCalled("NewJob")
+
+	return args.Get(0).(*scheduler.Job)
+}
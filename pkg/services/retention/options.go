@@ -0,0 +1,55 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retention
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/entities/retention"
+	"github.com/ZupIT/horusec-devkit/pkg/services/retention/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// Options configures the default policy, which applies to every repository, and how the analyses are purged. The
+// dry run rolls back each batch, reporting what would be removed without removing anything, and the progress
+// function is called after each batch.
+type Options struct {
+	DefaultPolicy *retention.Policy
+	BatchSize     int
+	DryRun        bool
+	Schedule      string
+	OnProgress    func(progress *Progress)
+	Clock         clock.IClock
+}
+
+func NewOptions() *Options {
+	return &Options{
+		DefaultPolicy: &retention.Policy{
+			KeepDays:    env.GetEnvOrDefaultInt(enums.HorusecRetentionKeepDays, 0),
+			MaxAnalyses: env.GetEnvOrDefaultInt(enums.HorusecRetentionMaxAnalyses, 0),
+		},
+		BatchSize: env.GetEnvOrDefaultInt(enums.HorusecRetentionBatchSize, enums.DefaultBatchSize),
+		DryRun:    env.GetEnvOrDefaultBool(enums.HorusecRetentionDryRun, false),
+		Schedule:  env.GetEnvOrDefault(enums.HorusecRetentionSchedule, enums.DefaultSchedule),
+		Clock:     clock.NewClock(),
+	}
+}
+
+func (o *Options) getBatchSize() int {
+	if o.BatchSize <= 0 {
+		return enums.DefaultBatchSize
+	}
+
+	return o.BatchSize
+}
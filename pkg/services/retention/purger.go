@@ -0,0 +1,309 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retention
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/retention"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/retention/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/scheduler"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+// Progress reports what a policy purged so far, or what it would purge on the dry run
+type Progress struct {
+	Policy          *retention.Policy `json:"policy"`
+	Batches         int               `json:"batches"`
+	Analyses        int               `json:"analyses"`
+	Vulnerabilities int               `json:"vulnerabilities"`
+	DryRun          bool              `json:"dryRun"`
+}
+
+type IPurger interface {
+	Purge(ctx context.Context, policy *retention.Policy) (*Progress, error)
+	PurgeAll(ctx context.Context, policies []*retention.Policy) ([]*Progress, error)
+	NewJob(getPolicies func(ctx context.Context) ([]*retention.Policy, error)) *scheduler.Job
+}
+
+type expiredAnalysis struct {
+	AnalysisID uuid.UUID `gorm:"Column:analysis_id"`
+}
+
+type analysisVulnerability struct {
+	VulnerabilityID uuid.UUID `gorm:"Column:vulnerability_id"`
+}
+
+// Purger removes the expired analyses with their vulnerabilities in batches, each one on its own transaction, so a
+// purge of millions of rows does not lock the tables for long and can be resumed after a failure. The vulnerabilities
+// are only removed when no remaining analysis references them.
+type Purger struct {
+	options    *Options
+	connection *database.Connection
+	clock      clock.IClock
+}
+
+func NewPurger(options *Options, connection *database.Connection) IPurger {
+	return &Purger{options: options, connection: connection, clock: clock.OrDefault(options.Clock)}
+}
+
+// Purge applies a single policy, stopping between batches when the context is done. The dry run counts the
+// vulnerabilities of each batch apart, so a vulnerability shared by analyses of different batches is not counted.
+func (p *Purger) Purge(ctx context.Context, policy *retention.Policy) (*Progress, error) {
+	if !policy.IsValid() {
+		return nil, enums.ErrorInvalidPolicy
+	}
+
+	progress := &Progress{Policy: policy, DryRun: p.options.DryRun}
+	if !policy.IsEnabled() {
+		return progress, nil
+	}
+
+	query := p.getExpiredQuery(policy)
+
+	for last := uuid.Nil; ; {
+		if err := ctx.Err(); err != nil {
+			return progress, err
+		}
+
+		ids, err := p.selectExpired(query, last)
+		if err != nil || len(ids) == 0 {
+			return p.finish(progress, err)
+		}
+
+		if err := p.purgeBatch(ctx, ids, progress); err != nil {
+			return p.finish(progress, err)
+		}
+
+		if len(ids) < p.options.getBatchSize() {
+			return p.finish(progress, nil)
+		}
+
+		last = ids[len(ids)-1]
+	}
+}
+
+// PurgeAll applies the policies independently, so the strictest of the policies of a repository wins
+func (p *Purger) PurgeAll(ctx context.Context, policies []*retention.Policy) ([]*Progress, error) {
+	results := make([]*Progress, 0, len(policies))
+
+	for _, policy := range policies {
+		if policy == nil {
+			continue
+		}
+
+		progress, err := p.Purge(ctx, policy)
+		if err != nil {
+			return results, err
+		}
+
+		results = append(results, progress)
+	}
+
+	return results, nil
+}
+
+// NewJob returns a scheduler job that applies the default policy and the ones returned by get policies, like the
+// policies of each workspace stored on the database, which can be nil when only the default policy is used
+func (p *Purger) NewJob(getPolicies func(ctx context.Context) ([]*retention.Policy, error)) *scheduler.Job {
+	return &scheduler.Job{
+		Name:     enums.JobName,
+		Schedule: p.options.Schedule,
+		Run: func(ctx context.Context) error {
+			policies := []*retention.Policy{p.options.DefaultPolicy}
+
+			if getPolicies != nil {
+				stored, err := getPolicies(ctx)
+				if err != nil {
+					return err
+				}
+
+				policies = append(policies, stored...)
+			}
+
+			_, err := p.PurgeAll(ctx, policies)
+
+			return err
+		},
+	}
+}
+
+// expiredQuery keeps the values of the scope filters apart from the values of the rules, since the last purged id
+// goes between them
+type expiredQuery struct {
+	sql          string
+	filterValues []interface{}
+	ruleValues   []interface{}
+}
+
+func (e *expiredQuery) arguments(last uuid.UUID, limit int) []interface{} {
+	arguments := append(append([]interface{}{}, e.filterValues...), last)
+	arguments = append(arguments, e.ruleValues...)
+
+	return append(arguments, limit)
+}
+
+func (p *Purger) getExpiredQuery(policy *retention.Policy) *expiredQuery {
+	query := &expiredQuery{}
+
+	var filters, rules []string
+
+	if policy.WorkspaceID != uuid.Nil {
+		filters, query.filterValues = append(filters, enums.FilterWorkspace), append(query.filterValues,
+			policy.WorkspaceID)
+	}
+
+	if policy.RepositoryID != uuid.Nil {
+		filters, query.filterValues = append(filters, enums.FilterRepository), append(query.filterValues,
+			policy.RepositoryID)
+	}
+
+	if cutoff := policy.GetCutoff(p.clock.Now()); !cutoff.IsZero() {
+		rules, query.ruleValues = append(rules, enums.RuleCutoff), append(query.ruleValues, cutoff)
+	}
+
+	if policy.MaxAnalyses > 0 {
+		rules, query.ruleValues = append(rules, enums.RuleMaxAnalyses), append(query.ruleValues, policy.MaxAnalyses)
+	}
+
+	where := ""
+	if len(filters) > 0 {
+		where = enums.WhereKeyword + strings.Join(filters, enums.AndKeyword)
+	}
+
+	query.sql = fmt.Sprintf(enums.QuerySelectExpired, where, strings.Join(rules, enums.OrKeyword))
+
+	return query
+}
+
+func (p *Purger) selectExpired(query *expiredQuery, last uuid.UUID) ([]uuid.UUID, error) {
+	var rows []expiredAnalysis
+
+	response := p.connection.Read.Raw(query.sql, &rows, query.arguments(last, p.options.getBatchSize())...)
+	if err := response.GetErrorExceptNotFound(); err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.AnalysisID)
+	}
+
+	return ids, nil
+}
+
+func (p *Purger) selectVulnerabilities(ids []uuid.UUID) ([]uuid.UUID, error) {
+	var rows []analysisVulnerability
+
+	if err := p.connection.Read.Raw(enums.QuerySelectVulnerabilities, &rows,
+		ids).GetErrorExceptNotFound(); err != nil {
+		return nil, err
+	}
+
+	vulnerabilityIDs := make([]uuid.UUID, 0, len(rows))
+	for _, row := range rows {
+		vulnerabilityIDs = append(vulnerabilityIDs, row.VulnerabilityID)
+	}
+
+	return vulnerabilityIDs, nil
+}
+
+// purgeBatch rolls back the transaction on the dry run, after counting the rows that would be removed
+func (p *Purger) purgeBatch(ctx context.Context, ids []uuid.UUID, progress *Progress) error {
+	vulnerabilityIDs, err := p.selectVulnerabilities(ids)
+	if err != nil {
+		return err
+	}
+
+	transaction := database.WithContext(ctx, p.connection.Write).StartTransaction()
+
+	analyses, vulnerabilities, err := p.deleteBatch(transaction, ids, vulnerabilityIDs)
+	if err != nil {
+		logger.LogError(enums.MessageFailedToRollbackPurge, transaction.RollbackTransaction().GetError())
+
+		return err
+	}
+
+	if p.options.DryRun {
+		err = transaction.RollbackTransaction().GetError()
+	} else {
+		err = transaction.CommitTransaction().GetError()
+	}
+
+	if err != nil {
+		return err
+	}
+
+	progress.Batches++
+	progress.Analyses += analyses
+	progress.Vulnerabilities += vulnerabilities
+	p.report(enums.MessagePurgeProgress, progress)
+
+	if p.options.OnProgress != nil {
+		p.options.OnProgress(progress)
+	}
+
+	return nil
+}
+
+func (p *Purger) deleteBatch(transaction database.IDatabaseWrite, ids,
+	vulnerabilityIDs []uuid.UUID) (analyses, vulnerabilities int, err error) {
+	if err := database.Exec(transaction, enums.QueryDeleteAnalysisVulnerabilities, ids).GetError(); err != nil {
+		return 0, 0, err
+	}
+
+	if len(vulnerabilityIDs) > 0 {
+		response := database.Exec(transaction, enums.QueryDeleteOrphanVulnerabilities, vulnerabilityIDs)
+		if err := response.GetError(); err != nil {
+			return 0, 0, err
+		}
+
+		vulnerabilities = response.GetRowsAffected()
+	}
+
+	response := database.Exec(transaction, enums.QueryDeleteAnalyses, ids)
+
+	return response.GetRowsAffected(), vulnerabilities, response.GetError()
+}
+
+func (p *Purger) finish(progress *Progress, err error) (*Progress, error) {
+	if err != nil {
+		return progress, fmt.Errorf("%w: %s", enums.ErrorPurgeFailed, err.Error())
+	}
+
+	if progress.Batches > 0 {
+		p.report(enums.MessagePurgeFinished, progress)
+	}
+
+	return progress, nil
+}
+
+func (p *Purger) report(message string, progress *Progress) {
+	logger.LogInfoWithFields(message, map[string]interface{}{
+		enums.LogFieldPolicyID:        progress.Policy.PolicyID,
+		enums.LogFieldWorkspaceID:     progress.Policy.WorkspaceID,
+		enums.LogFieldRepositoryID:    progress.Policy.RepositoryID,
+		enums.LogFieldBatches:         progress.Batches,
+		enums.LogFieldAnalyses:        progress.Analyses,
+		enums.LogFieldVulnerabilities: progress.Vulnerabilities,
+		enums.LogFieldDryRun:          progress.DryRun,
+	})
+}
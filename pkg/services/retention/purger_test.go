@@ -0,0 +1,256 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build cgo
+
+package retention
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/retention"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/sqlite"
+	"github.com/ZupIT/horusec-devkit/pkg/services/retention/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+)
+
+var testNow = time.Date(2021, 6, 30, 12, 0, 0, 0, time.UTC)
+
+func newTestConnection(t *testing.T) *database.Connection {
+	connection, err := sqlite.NewInMemoryDatabase()
+	require.NoError(t, err)
+
+	assert.NoError(t, database.Exec(connection.Write, "CREATE TABLE analysis (analysis_id TEXT PRIMARY KEY, "+
+		"workspace_id TEXT, repository_id TEXT, created_at DATETIME)").GetError())
+	assert.NoError(t, database.Exec(connection.Write, "CREATE TABLE vulnerabilities (vulnerability_id TEXT PRIMARY KEY)").
+		GetError())
+	assert.NoError(t, database.Exec(connection.Write, "CREATE TABLE analysis_vulnerabilities (analysis_id TEXT, "+
+		"vulnerability_id TEXT)").GetError())
+
+	return connection
+}
+
+// createAnalysis creates an analysis with its own vulnerability and with the shared ones
+func createAnalysis(t *testing.T, connection *database.Connection, workspaceID, repositoryID uuid.UUID, days int,
+	shared ...uuid.UUID) uuid.UUID {
+	analysisID, vulnerabilityID := uuid.New(), uuid.New()
+
+	assert.NoError(t, database.Exec(connection.Write, "INSERT INTO analysis VALUES (?, ?, ?, ?)", analysisID, workspaceID,
+		repositoryID, testNow.AddDate(0, 0, -days)).GetError())
+	assert.NoError(t, database.Exec(connection.Write, "INSERT INTO vulnerabilities VALUES (?)",
+		vulnerabilityID).GetError())
+
+	for _, id := range append(shared, vulnerabilityID) {
+		assert.NoError(t, database.Exec(connection.Write, "INSERT INTO analysis_vulnerabilities VALUES (?, ?)", analysisID,
+			id).GetError())
+	}
+
+	return analysisID
+}
+
+func countRows(t *testing.T, connection *database.Connection, table string) int {
+	var rows []map[string]interface{}
+
+	assert.NoError(t, connection.Read.Find(&rows, map[string]interface{}{}, table).GetErrorExceptNotFound())
+
+	return len(rows)
+}
+
+func newTestPurger(connection *database.Connection, batchSize int, dryRun bool) IPurger {
+	return NewPurger(&Options{BatchSize: batchSize, DryRun: dryRun, Clock: clock.NewFakeClock(testNow)}, connection)
+}
+
+func TestPurge(t *testing.T) {
+	t.Run("should purge analyses older than keep days in batches", func(t *testing.T) {
+		connection := newTestConnection(t)
+		workspaceID, repositoryID := uuid.New(), uuid.New()
+
+		for _, days := range []int{1, 10, 40, 50, 60} {
+			createAnalysis(t, connection, workspaceID, repositoryID, days)
+		}
+
+		var batches []int
+		purger := NewPurger(&Options{BatchSize: 2, Clock: clock.NewFakeClock(testNow),
+			OnProgress: func(progress *Progress) { batches = append(batches, progress.Analyses) }}, connection)
+
+		progress, err := purger.Purge(context.Background(), &retention.Policy{KeepDays: 30})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, progress.Analyses)
+		assert.Equal(t, 3, progress.Vulnerabilities)
+		assert.Equal(t, 2, progress.Batches)
+		assert.Equal(t, []int{2, 3}, batches)
+		assert.Equal(t, 2, countRows(t, connection, "analysis"))
+		assert.Equal(t, 2, countRows(t, connection, "vulnerabilities"))
+		assert.Equal(t, 2, countRows(t, connection, "analysis_vulnerabilities"))
+	})
+
+	t.Run("should keep only the newest analyses of each repository", func(t *testing.T) {
+		connection := newTestConnection(t)
+		workspaceID, first, second := uuid.New(), uuid.New(), uuid.New()
+
+		newest := createAnalysis(t, connection, workspaceID, first, 1)
+		for _, days := range []int{2, 3} {
+			createAnalysis(t, connection, workspaceID, first, days)
+		}
+
+		createAnalysis(t, connection, workspaceID, second, 5)
+
+		progress, err := newTestPurger(connection, 10, false).Purge(context.Background(),
+			&retention.Policy{MaxAnalyses: 1})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, progress.Analyses)
+		assert.Equal(t, 2, countRows(t, connection, "analysis"))
+
+		var rows []expiredAnalysis
+		assert.NoError(t, connection.Read.Raw("SELECT analysis_id FROM analysis WHERE repository_id = ?", &rows,
+			first).GetError())
+		assert.Equal(t, []expiredAnalysis{{AnalysisID: newest}}, rows)
+	})
+
+	t.Run("should only purge analyses of the policy scope", func(t *testing.T) {
+		connection := newTestConnection(t)
+		workspaceID, repositoryID := uuid.New(), uuid.New()
+
+		createAnalysis(t, connection, workspaceID, repositoryID, 40)
+		createAnalysis(t, connection, workspaceID, uuid.New(), 40)
+		createAnalysis(t, connection, uuid.New(), uuid.New(), 40)
+
+		progress, err := newTestPurger(connection, 10, false).Purge(context.Background(),
+			&retention.Policy{WorkspaceID: workspaceID, RepositoryID: repositoryID, KeepDays: 30})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, progress.Analyses)
+
+		progress, err = newTestPurger(connection, 10, false).Purge(context.Background(),
+			&retention.Policy{WorkspaceID: workspaceID, KeepDays: 30})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, progress.Analyses)
+		assert.Equal(t, 1, countRows(t, connection, "analysis"))
+	})
+
+	t.Run("should keep vulnerabilities referenced by remaining analyses", func(t *testing.T) {
+		connection := newTestConnection(t)
+		workspaceID, repositoryID, shared := uuid.New(), uuid.New(), uuid.New()
+
+		assert.NoError(t, database.Exec(connection.Write, "INSERT INTO vulnerabilities VALUES (?)", shared).GetError())
+		createAnalysis(t, connection, workspaceID, repositoryID, 40, shared)
+		createAnalysis(t, connection, workspaceID, repositoryID, 1, shared)
+
+		progress, err := newTestPurger(connection, 10, false).Purge(context.Background(),
+			&retention.Policy{KeepDays: 30})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, progress.Vulnerabilities)
+		assert.Equal(t, 2, countRows(t, connection, "vulnerabilities"))
+	})
+
+	t.Run("should report without removing on dry run", func(t *testing.T) {
+		connection := newTestConnection(t)
+
+		for _, days := range []int{40, 50, 60} {
+			createAnalysis(t, connection, uuid.New(), uuid.New(), days)
+		}
+
+		progress, err := newTestPurger(connection, 2, true).Purge(context.Background(), &retention.Policy{KeepDays: 30})
+
+		assert.NoError(t, err)
+		assert.True(t, progress.DryRun)
+		assert.Equal(t, 3, progress.Analyses)
+		assert.Equal(t, 3, progress.Vulnerabilities)
+		assert.Equal(t, 2, progress.Batches)
+		assert.Equal(t, 3, countRows(t, connection, "analysis"))
+		assert.Equal(t, 3, countRows(t, connection, "vulnerabilities"))
+	})
+
+	t.Run("should not purge when policy is disabled or invalid", func(t *testing.T) {
+		connection := newTestConnection(t)
+		createAnalysis(t, connection, uuid.New(), uuid.New(), 40)
+
+		progress, err := newTestPurger(connection, 10, false).Purge(context.Background(), &retention.Policy{})
+		assert.NoError(t, err)
+		assert.Equal(t, 0, progress.Analyses)
+
+		_, err = newTestPurger(connection, 10, false).Purge(context.Background(), &retention.Policy{KeepDays: -1})
+		assert.ErrorIs(t, err, enums.ErrorInvalidPolicy)
+	})
+
+	t.Run("should return error when context is done or query fails", func(t *testing.T) {
+		connection := newTestConnection(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := newTestPurger(connection, 10, false).Purge(ctx, &retention.Policy{KeepDays: 30})
+		assert.ErrorIs(t, err, context.Canceled)
+
+		assert.NoError(t, database.Exec(connection.Write, "DROP TABLE analysis").GetError())
+
+		_, err = newTestPurger(connection, 10, false).Purge(context.Background(), &retention.Policy{KeepDays: 30})
+		assert.ErrorIs(t, err, enums.ErrorPurgeFailed)
+	})
+}
+
+func TestNewJob(t *testing.T) {
+	t.Run("should apply the default and stored policies", func(t *testing.T) {
+		connection := newTestConnection(t)
+		workspaceID := uuid.New()
+
+		createAnalysis(t, connection, uuid.New(), uuid.New(), 100)
+		createAnalysis(t, connection, workspaceID, uuid.New(), 40)
+		createAnalysis(t, connection, uuid.New(), uuid.New(), 40)
+
+		purger := NewPurger(&Options{DefaultPolicy: &retention.Policy{KeepDays: 90}, Schedule: "@daily",
+			Clock: clock.NewFakeClock(testNow)}, connection)
+
+		job := purger.NewJob(func(ctx context.Context) ([]*retention.Policy, error) {
+			return []*retention.Policy{{WorkspaceID: workspaceID, KeepDays: 30}}, nil
+		})
+
+		assert.Equal(t, enums.JobName, job.Name)
+		assert.NoError(t, job.Run(context.Background()))
+		assert.Equal(t, 1, countRows(t, connection, "analysis"))
+	})
+
+	t.Run("should return error when policies can not be loaded", func(t *testing.T) {
+		job := NewPurger(&Options{}, newTestConnection(t)).NewJob(
+			func(ctx context.Context) ([]*retention.Policy, error) {
+				return nil, errors.New("test")
+			})
+
+		assert.EqualError(t, job.Run(context.Background()), "test")
+	})
+}
+
+func TestNewOptions(t *testing.T) {
+	t.Run("should get default policy from environment", func(t *testing.T) {
+		t.Setenv(enums.HorusecRetentionKeepDays, "90")
+		t.Setenv(enums.HorusecRetentionDryRun, "true")
+
+		options := NewOptions()
+
+		assert.Equal(t, 90, options.DefaultPolicy.KeepDays)
+		assert.True(t, options.DryRun)
+		assert.Equal(t, enums.DefaultBatchSize, options.BatchSize)
+		assert.Equal(t, enums.DefaultSchedule, options.Schedule)
+	})
+}
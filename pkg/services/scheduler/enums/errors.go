@@ -0,0 +1,26 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+import "errors"
+
+var (
+	ErrorInvalidJob       = errors.New("{ERROR_SCHEDULER} job must have a name and a run function")
+	ErrorDuplicatedJob    = errors.New("{ERROR_SCHEDULER} job with the same name already added")
+	ErrorInvalidSchedule  = errors.New("{ERROR_SCHEDULER} invalid cron expression")
+	ErrorSchedulerStarted = errors.New("{ERROR_SCHEDULER} jobs can not be added after the scheduler started")
+	ErrorJobPanic         = errors.New("{ERROR_SCHEDULER} job panicked")
+	ErrorInvalidTimezone  = errors.New("{ERROR_SCHEDULER} invalid scheduler timezone")
+)
@@ -0,0 +1,35 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+const (
+	HorusecSchedulerLockTTLSeconds = "HORUSEC_SCHEDULER_LOCK_TTL_SECONDS"
+	HorusecSchedulerTimezone       = "HORUSEC_SCHEDULER_TIMEZONE"
+
+	DefaultLockTTLSeconds = 300
+	DefaultTimezone       = "UTC"
+	LockPrefix            = "scheduler:"
+
+	MetricsJobRuns        = "scheduler_job_runs_total"
+	MetricsJobDuration    = "scheduler_job_duration_seconds"
+	MetricsJobLastSuccess = "scheduler_job_last_success_timestamp_seconds"
+	MetricsLabelJob       = "job"
+	MetricsLabelResult    = "result"
+	ResultSuccess         = "success"
+	ResultError           = "error"
+	ResultSkipped         = "skipped"
+
+	LogFieldJob = "job"
+)
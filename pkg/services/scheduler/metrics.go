@@ -0,0 +1,55 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/metrics"
+	"github.com/ZupIT/horusec-devkit/pkg/services/scheduler/enums"
+)
+
+type jobMetrics struct {
+	runs        *prometheus.CounterVec
+	duration    *prometheus.HistogramVec
+	lastSuccess *prometheus.GaugeVec
+}
+
+func newJobMetrics() *jobMetrics {
+	return &jobMetrics{
+		runs: metrics.NewCounterVec(enums.MetricsJobRuns, "Total of scheduled job runs by result.",
+			enums.MetricsLabelJob, enums.MetricsLabelResult),
+		duration: metrics.NewHistogramVec(enums.MetricsJobDuration, "Duration of the scheduled job runs.",
+			prometheus.ExponentialBuckets(0.1, 4, 10), enums.MetricsLabelJob),
+		lastSuccess: metrics.NewGaugeVec(enums.MetricsJobLastSuccess, "Unix time of the last successful job run.",
+			enums.MetricsLabelJob),
+	}
+}
+
+func (m *jobMetrics) observe(job, result string, start, end time.Time) {
+	m.runs.WithLabelValues(job, result).Inc()
+
+	if result == enums.ResultSkipped {
+		return
+	}
+
+	m.duration.WithLabelValues(job).Observe(end.Sub(start).Seconds())
+
+	if result == enums.ResultSuccess {
+		m.lastSuccess.WithLabelValues(job).Set(float64(end.Unix()))
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/scheduler/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// Options configures the timezone of the cron expressions and the ttl of the job locks, which is used for the jobs
+// without timeout and must be greater than their duration. The clock is replaced on tests to run the jobs without
+// waiting their schedule.
+type Options struct {
+	LockTTL  time.Duration
+	Timezone string
+	Clock    clock.IClock
+}
+
+func NewOptions() *Options {
+	return &Options{
+		LockTTL: time.Duration(env.GetEnvOrDefaultInt(enums.HorusecSchedulerLockTTLSeconds,
+			enums.DefaultLockTTLSeconds)) * time.Second,
+		Timezone: env.GetEnvOrDefault(enums.HorusecSchedulerTimezone, enums.DefaultTimezone),
+		Clock:    clock.NewClock(),
+	}
+}
@@ -0,0 +1,255 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/lock"
+	lockEnums "github.com/ZupIT/horusec-devkit/pkg/services/lock/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/scheduler/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+// Job runs on the cron schedule, which accepts the standard five fields, an optional seconds field and descriptors
+// like @daily or @every 1h. The jitter adds a random delay to each run, so the replicas of different services do not
+// hit the database at the same second, and the timeout cancels the context given to run.
+type Job struct {
+	Name     string
+	Schedule string
+	Jitter   time.Duration
+	Timeout  time.Duration
+	Run      func(ctx context.Context) error
+}
+
+type IScheduler interface {
+	AddJob(job *Job) error
+	Start(ctx context.Context)
+	Stop()
+}
+
+type scheduledJob struct {
+	*Job
+	schedule cron.Schedule
+}
+
+type Scheduler struct {
+	options  *Options
+	locker   lock.ILocker
+	location *time.Location
+	clock    clock.IClock
+	metrics  *jobMetrics
+	mutex    sync.Mutex
+	jobs     map[string]*scheduledJob
+	started  bool
+	cancel   context.CancelFunc
+	wait     sync.WaitGroup
+}
+
+// NewScheduler runs each tick of a job only on the replica that acquires its lock when the locker is not nil,
+// otherwise the jobs run on every replica
+func NewScheduler(options *Options, locker lock.ILocker) (IScheduler, error) {
+	location, err := time.LoadLocation(options.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", enums.ErrorInvalidTimezone, err.Error())
+	}
+
+	return &Scheduler{
+		options:  options,
+		locker:   locker,
+		location: location,
+		clock:    clock.OrDefault(options.Clock),
+		metrics:  newJobMetrics(),
+		jobs:     map[string]*scheduledJob{},
+	}, nil
+}
+
+func (s *Scheduler) AddJob(job *Job) error {
+	if job.Name == "" || job.Run == nil {
+		return enums.ErrorInvalidJob
+	}
+
+	schedule, err := cron.NewParser(cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month |
+		cron.Dow | cron.Descriptor).Parse(job.Schedule)
+	if err != nil {
+		return fmt.Errorf("%w: %s", enums.ErrorInvalidSchedule, err.Error())
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.started {
+		return enums.ErrorSchedulerStarted
+	}
+
+	if _, ok := s.jobs[job.Name]; ok {
+		return enums.ErrorDuplicatedJob
+	}
+
+	s.jobs[job.Name] = &scheduledJob{Job: job, schedule: schedule}
+
+	return nil
+}
+
+// Start runs each job on its own goroutine until the context is done or the scheduler is stopped
+func (s *Scheduler) Start(ctx context.Context) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.started {
+		return
+	}
+
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.started = true
+
+	for _, job := range s.jobs {
+		s.wait.Add(1)
+
+		go s.loop(ctx, job)
+	}
+}
+
+// Stop cancels the running jobs and waits for them to return, it should be called on graceful shutdown
+func (s *Scheduler) Stop() {
+	s.mutex.Lock()
+	cancel := s.cancel
+	s.mutex.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+
+	s.wait.Wait()
+}
+
+func (s *Scheduler) loop(ctx context.Context, job *scheduledJob) {
+	defer s.wait.Done()
+
+	for {
+		tick := job.schedule.Next(s.clock.Now().In(s.location))
+		if !s.sleep(ctx, s.clock.Until(tick)+randomJitter(job.Jitter)) {
+			return
+		}
+
+		s.run(ctx, job, tick)
+	}
+}
+
+func (s *Scheduler) run(ctx context.Context, job *scheduledJob, tick time.Time) {
+	start, result := s.clock.Now(), enums.ResultSuccess
+	fields := map[string]interface{}{enums.LogFieldJob: job.Name}
+
+	ran, err := s.runExclusive(ctx, job, tick)
+
+	switch {
+	case err != nil:
+		result = enums.ResultError
+		logger.LogError(enums.MessageJobFailed, err, fields)
+	case !ran:
+		result = enums.ResultSkipped
+		logger.LogInfoWithFields(enums.MessageJobSkipped, fields)
+	}
+
+	s.metrics.observe(job.Name, result, start, s.clock.Now())
+}
+
+// runExclusive locks each tick of the job instead of using lock.RunExclusive, since a replica with a greater jitter
+// would run the same tick again after the lock is released. The lock is only released after the jitter window.
+func (s *Scheduler) runExclusive(ctx context.Context, job *scheduledJob, tick time.Time) (bool, error) {
+	if job.Timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, job.Timeout)
+		defer cancel()
+	}
+
+	if s.locker == nil {
+		return true, runWithRecover(ctx, job.Run)
+	}
+
+	held, err := s.locker.Acquire(ctx, fmt.Sprintf("%s%s:%d", enums.LockPrefix, job.Name, tick.Unix()),
+		s.getLockTTL(job))
+	if err != nil {
+		if errors.Is(err, lockEnums.ErrorLockNotAcquired) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	defer s.releaseAfter(held, s.clock.Until(tick.Add(job.Jitter)))
+
+	return true, runWithRecover(ctx, job.Run)
+}
+
+func (s *Scheduler) getLockTTL(job *scheduledJob) time.Duration {
+	ttl := s.options.LockTTL
+	if job.Timeout > 0 {
+		ttl = job.Timeout
+	}
+
+	return ttl + job.Jitter
+}
+
+func (s *Scheduler) releaseAfter(held lock.ILock, delay time.Duration) {
+	release := func() {
+		logger.LogError(enums.MessageFailedToReleaseLock, held.Release(context.Background()))
+	}
+
+	if delay <= 0 {
+		release()
+
+		return
+	}
+
+	s.clock.AfterFunc(delay, release)
+}
+
+func runWithRecover(ctx context.Context, run func(ctx context.Context) error) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("%w: %v", enums.ErrorJobPanic, recovered)
+		}
+	}()
+
+	return run(ctx)
+}
+
+func randomJitter(jitter time.Duration) time.Duration {
+	if jitter <= 0 {
+		return 0
+	}
+
+	// nolint:gosec // jitter does not need a secure random
+	return time.Duration(rand.Int63n(int64(jitter)))
+}
+
+func (s *Scheduler) sleep(ctx context.Context, delay time.Duration) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-s.clock.After(delay):
+		return true
+	}
+}
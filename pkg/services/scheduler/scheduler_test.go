@@ -0,0 +1,255 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/lock"
+	lockEnums "github.com/ZupIT/horusec-devkit/pkg/services/lock/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/scheduler/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+)
+
+type memoryLocker struct {
+	mutex sync.Mutex
+	held  map[string]bool
+}
+
+func (m *memoryLocker) Acquire(_ context.Context, name string, _ time.Duration) (lock.ILock, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.held[name] {
+		return nil, lockEnums.ErrorLockNotAcquired
+	}
+
+	m.held[name] = true
+
+	lockMock := &lock.Mock{}
+	lockMock.On("Release").Return(nil)
+
+	return lockMock, nil
+}
+
+func newTestScheduler(t *testing.T, locker lock.ILocker) IScheduler {
+	scheduler, err := NewScheduler(&Options{LockTTL: time.Minute, Timezone: "UTC"}, locker)
+	assert.NoError(t, err)
+
+	return scheduler
+}
+
+func TestNewOptions(t *testing.T) {
+	t.Run("should read options from env", func(t *testing.T) {
+		t.Setenv(enums.HorusecSchedulerLockTTLSeconds, "10")
+		t.Setenv(enums.HorusecSchedulerTimezone, "America/Sao_Paulo")
+
+		options := NewOptions()
+
+		assert.Equal(t, 10*time.Second, options.LockTTL)
+		assert.Equal(t, "America/Sao_Paulo", options.Timezone)
+	})
+}
+
+func TestNewScheduler(t *testing.T) {
+	t.Run("should return error when invalid timezone", func(t *testing.T) {
+		_, err := NewScheduler(&Options{Timezone: "test"}, nil)
+
+		assert.ErrorIs(t, err, enums.ErrorInvalidTimezone)
+	})
+}
+
+func TestAddJob(t *testing.T) {
+	run := func(ctx context.Context) error { return nil }
+
+	t.Run("should add jobs with standard, seconds and descriptor schedules", func(t *testing.T) {
+		scheduler := newTestScheduler(t, nil)
+
+		assert.NoError(t, scheduler.AddJob(&Job{Name: "1", Schedule: "0 3 * * *", Run: run}))
+		assert.NoError(t, scheduler.AddJob(&Job{Name: "2", Schedule: "*/30 * * * * *", Run: run}))
+		assert.NoError(t, scheduler.AddJob(&Job{Name: "3", Schedule: "@daily", Run: run}))
+	})
+
+	t.Run("should return error when invalid job", func(t *testing.T) {
+		scheduler := newTestScheduler(t, nil)
+
+		assert.ErrorIs(t, scheduler.AddJob(&Job{Schedule: "@daily", Run: run}), enums.ErrorInvalidJob)
+		assert.ErrorIs(t, scheduler.AddJob(&Job{Name: "test", Schedule: "@daily"}), enums.ErrorInvalidJob)
+	})
+
+	t.Run("should return error when invalid schedule", func(t *testing.T) {
+		err := newTestScheduler(t, nil).AddJob(&Job{Name: "test", Schedule: "test", Run: run})
+
+		assert.ErrorIs(t, err, enums.ErrorInvalidSchedule)
+	})
+
+	t.Run("should return error when duplicated job", func(t *testing.T) {
+		scheduler := newTestScheduler(t, nil)
+
+		assert.NoError(t, scheduler.AddJob(&Job{Name: "test", Schedule: "@daily", Run: run}))
+		assert.ErrorIs(t, scheduler.AddJob(&Job{Name: "test", Schedule: "@hourly", Run: run}),
+			enums.ErrorDuplicatedJob)
+	})
+
+	t.Run("should return error when scheduler started", func(t *testing.T) {
+		scheduler := newTestScheduler(t, nil)
+		scheduler.Start(context.Background())
+		defer scheduler.Stop()
+
+		assert.ErrorIs(t, scheduler.AddJob(&Job{Name: "test", Schedule: "@daily", Run: run}),
+			enums.ErrorSchedulerStarted)
+	})
+}
+
+func TestStart(t *testing.T) {
+	t.Run("should run the job on each tick until stopped", func(t *testing.T) {
+		var runs int32
+
+		fakeClock := clock.NewFakeClock(time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC))
+		scheduler, err := NewScheduler(&Options{LockTTL: time.Minute, Timezone: "UTC", Clock: fakeClock}, nil)
+		assert.NoError(t, err)
+		assert.NoError(t, scheduler.AddJob(&Job{Name: "test", Schedule: "@hourly",
+			Run: func(ctx context.Context) error {
+				atomic.AddInt32(&runs, 1)
+
+				return nil
+			}}))
+
+		scheduler.Start(context.Background())
+
+		for i := 0; i < 3; i++ {
+			fakeClock.BlockUntil(1)
+			fakeClock.Advance(time.Hour)
+		}
+
+		fakeClock.BlockUntil(1)
+		scheduler.Stop()
+		assert.Equal(t, int32(3), atomic.LoadInt32(&runs))
+
+		fakeClock.Advance(time.Hour)
+		assert.Equal(t, int32(3), atomic.LoadInt32(&runs))
+	})
+
+	t.Run("should run each tick only on the replica that acquires the lock", func(t *testing.T) {
+		var runs int32
+
+		locker := &memoryLocker{held: map[string]bool{}}
+		job := &Job{Name: "test", Schedule: "* * * * * *", Jitter: 200 * time.Millisecond,
+			Run: func(ctx context.Context) error {
+				atomic.AddInt32(&runs, 1)
+
+				return nil
+			}}
+
+		first, second := newTestScheduler(t, locker), newTestScheduler(t, locker)
+		assert.NoError(t, first.AddJob(job))
+		assert.NoError(t, second.AddJob(job))
+
+		first.Start(context.Background())
+		second.Start(context.Background())
+		time.Sleep(1500 * time.Millisecond)
+		first.Stop()
+		second.Stop()
+
+		locker.mutex.Lock()
+		defer locker.mutex.Unlock()
+
+		assert.Equal(t, int32(len(locker.held)), atomic.LoadInt32(&runs))
+	})
+
+	t.Run("should recover from panic and keep running the job", func(t *testing.T) {
+		var runs int32
+
+		scheduler := newTestScheduler(t, nil)
+		assert.NoError(t, scheduler.AddJob(&Job{Name: "test", Schedule: "* * * * * *",
+			Run: func(ctx context.Context) error {
+				atomic.AddInt32(&runs, 1)
+
+				panic("test")
+			}}))
+
+		scheduler.Start(context.Background())
+		time.Sleep(2100 * time.Millisecond)
+		scheduler.Stop()
+
+		assert.GreaterOrEqual(t, atomic.LoadInt32(&runs), int32(2))
+	})
+
+	t.Run("should cancel the job context after the timeout", func(t *testing.T) {
+		errs := make(chan error, 1)
+
+		scheduler := newTestScheduler(t, nil)
+		assert.NoError(t, scheduler.AddJob(&Job{Name: "test", Schedule: "* * * * * *", Timeout: 10 * time.Millisecond,
+			Run: func(ctx context.Context) error {
+				<-ctx.Done()
+
+				select {
+				case errs <- ctx.Err():
+				default:
+				}
+
+				return ctx.Err()
+			}}))
+
+		scheduler.Start(context.Background())
+		defer scheduler.Stop()
+
+		select {
+		case err := <-errs:
+			assert.ErrorIs(t, err, context.DeadlineExceeded)
+		case <-time.After(2 * time.Second):
+			t.Fatal("job did not run")
+		}
+	})
+
+	t.Run("should skip the tick when failed to acquire the lock", func(t *testing.T) {
+		var runs int32
+
+		lockerMock := &lock.Mock{}
+		lockerMock.On("Acquire").Return(&lock.Mock{}, errors.New("test"))
+
+		scheduler := newTestScheduler(t, lockerMock)
+		assert.NoError(t, scheduler.AddJob(&Job{Name: "test", Schedule: "* * * * * *",
+			Run: func(ctx context.Context) error {
+				atomic.AddInt32(&runs, 1)
+
+				return nil
+			}}))
+
+		scheduler.Start(context.Background())
+		time.Sleep(1100 * time.Millisecond)
+		scheduler.Stop()
+
+		assert.Zero(t, atomic.LoadInt32(&runs))
+		lockerMock.AssertCalled(t, "Acquire")
+	})
+}
+
+func TestRunWithRecover(t *testing.T) {
+	t.Run("should return error when job panics", func(t *testing.T) {
+		err := runWithRecover(context.Background(), func(ctx context.Context) error {
+			panic("test")
+		})
+
+		assert.ErrorIs(t, err, enums.ErrorJobPanic)
+	})
+}
@@ -0,0 +1,31 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+import "errors"
+
+var (
+	ErrorResourceNotFound = errors.New("{ERROR_SCIM} resource not found")
+	ErrorUniqueness       = errors.New("{ERROR_SCIM} resource already exists")
+	ErrorMutability       = errors.New("{ERROR_SCIM} attribute can not be changed")
+	ErrorInvalidFilter    = errors.New("{ERROR_SCIM} invalid filter")
+	ErrorInvalidPath      = errors.New("{ERROR_SCIM} invalid path")
+	ErrorNoTarget         = errors.New("{ERROR_SCIM} path did not match any value")
+	ErrorInvalidValue     = errors.New("{ERROR_SCIM} invalid value")
+	ErrorInvalidSyntax    = errors.New("{ERROR_SCIM} invalid request body")
+	ErrorTooMany          = errors.New("{ERROR_SCIM} too many results")
+	ErrorUnauthorized     = errors.New("{ERROR_SCIM} invalid scim token")
+	ErrorNotImplemented   = errors.New("{ERROR_SCIM} operation not implemented")
+)
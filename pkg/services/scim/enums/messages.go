@@ -0,0 +1,26 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+const (
+	MessageUnexpectedEnd       = "unexpected end of filter"
+	MessageUnexpectedToken     = "unexpected token %q at position %d"
+	MessageUnterminatedString  = "unterminated string at position %d"
+	MessageInvalidOperator     = "invalid operator %q"
+	MessageInvalidComparison   = "invalid comparison value %q"
+	MessageInvalidOperation    = "invalid patch operation %q"
+	MessageFailedToHandleSCIM  = "{SCIM} failed to handle scim request"
+	MessageInternalServerError = "internal server error"
+)
@@ -0,0 +1,48 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+const (
+	HorusecSCIMToken          = "HORUSEC_SCIM_TOKEN"
+	HorusecSCIMBaseURL        = "HORUSEC_SCIM_BASE_URL"
+	HorusecSCIMMaxResults     = "HORUSEC_SCIM_MAX_RESULTS"
+	DefaultMaxResults         = 100
+	MaxBodyBytes              = 1 << 20
+	ContentType               = "application/scim+json"
+	HeaderContentType         = "Content-Type"
+	HeaderAuthorization       = "Authorization"
+	BearerPrefix              = "Bearer "
+	BasePath                  = "/scim/v2"
+	UsersRoute                = "/Users"
+	GroupsRoute               = "/Groups"
+	ServiceProviderRoute      = "/ServiceProviderConfig"
+	ResourceTypesRoute        = "/ResourceTypes"
+	IDParam                   = "id"
+	ResourceTypeUser          = "User"
+	ResourceTypeGroup         = "Group"
+	QueryFilter               = "filter"
+	QueryStartIndex           = "startIndex"
+	QueryCount                = "count"
+	QuerySortBy               = "sortBy"
+	QuerySortOrder            = "sortOrder"
+	SortOrderDescending       = "descending"
+	AuthenticationSchemeToken = "oauthbearertoken"
+)
+
+const (
+	LogicalAnd = "and"
+	LogicalOr  = "or"
+	LogicalNot = "not"
+)
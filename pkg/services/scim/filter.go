@@ -0,0 +1,360 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	scimEnums "github.com/ZupIT/horusec-devkit/pkg/enums/scim"
+	"github.com/ZupIT/horusec-devkit/pkg/services/scim/enums"
+)
+
+// Filter is a parsed scim filter, that the providers translate to their queries by checking the type of each node
+type Filter interface {
+	String() string
+}
+
+// AttributeExpression compares the attribute with the value, which is a string, float64, bool or nil, and has no
+// value with the present operator
+type AttributeExpression struct {
+	Path     string
+	Operator scimEnums.FilterOperator
+	Value    interface{}
+}
+
+type LogicalExpression struct {
+	Operator string
+	Left     Filter
+	Right    Filter
+}
+
+type NotExpression struct {
+	Filter Filter
+}
+
+// ValuePathExpression filters the items of a multi valued attribute, like emails[type eq "work"]
+type ValuePathExpression struct {
+	Path   string
+	Filter Filter
+}
+
+func (a *AttributeExpression) String() string {
+	if a.Operator == scimEnums.Present {
+		return a.Path + " " + a.Operator.ToString()
+	}
+
+	value, _ := json.Marshal(a.Value)
+
+	return fmt.Sprintf("%s %s %s", a.Path, a.Operator, value)
+}
+
+func (l *LogicalExpression) String() string {
+	return fmt.Sprintf("(%s %s %s)", l.Left, l.Operator, l.Right)
+}
+
+func (n *NotExpression) String() string {
+	return fmt.Sprintf("not (%s)", n.Filter)
+}
+
+func (v *ValuePathExpression) String() string {
+	return fmt.Sprintf("%s[%s]", v.Path, v.Filter)
+}
+
+// ParseFilter parses the filter query parameter, where not has the highest precedence, followed by and, and or. The
+// operators and logical keywords are case insensitive, while the attribute paths are kept as sent.
+func ParseFilter(filter string) (Filter, error) {
+	tokens, err := tokenize(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := &filterParser{tokens: tokens}
+
+	parsed, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if token, ok := parser.peek(); ok {
+		return nil, parser.unexpected(token)
+	}
+
+	return parsed, nil
+}
+
+type tokenKind int
+
+const (
+	tokenWord tokenKind = iota
+	tokenString
+	tokenSymbol
+)
+
+type token struct {
+	kind     tokenKind
+	value    string
+	position int
+}
+
+func tokenize(filter string) ([]token, error) {
+	var tokens []token
+
+	for position := 0; position < len(filter); {
+		switch char := filter[position]; {
+		case char == ' ' || char == '\t':
+			position++
+		case strings.ContainsRune("()[]", rune(char)):
+			tokens = append(tokens, token{kind: tokenSymbol, value: string(char), position: position})
+			position++
+		case char == '"':
+			end, err := stringEnd(filter, position)
+			if err != nil {
+				return nil, err
+			}
+
+			tokens = append(tokens, token{kind: tokenString, value: filter[position:end], position: position})
+			position = end
+		default:
+			end := position
+			for end < len(filter) && !strings.ContainsRune(" \t()[]\"", rune(filter[end])) {
+				end++
+			}
+
+			tokens = append(tokens, token{kind: tokenWord, value: filter[position:end], position: position})
+			position = end
+		}
+	}
+
+	return tokens, nil
+}
+
+// stringEnd returns the position after the closing quote of the string starting at the position
+func stringEnd(filter string, position int) (int, error) {
+	for end := position + 1; end < len(filter); end++ {
+		switch filter[end] {
+		case '\\':
+			end++
+		case '"':
+			return end + 1, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %s", enums.ErrorInvalidFilter, fmt.Sprintf(enums.MessageUnterminatedString, position))
+}
+
+type filterParser struct {
+	tokens   []token
+	position int
+}
+
+func (p *filterParser) peek() (token, bool) {
+	if p.position >= len(p.tokens) {
+		return token{}, false
+	}
+
+	return p.tokens[p.position], true
+}
+
+func (p *filterParser) next() (token, error) {
+	current, ok := p.peek()
+	if !ok {
+		return token{}, fmt.Errorf("%w: %s", enums.ErrorInvalidFilter, enums.MessageUnexpectedEnd)
+	}
+
+	p.position++
+
+	return current, nil
+}
+
+func (p *filterParser) unexpected(current token) error {
+	return fmt.Errorf("%w: %s", enums.ErrorInvalidFilter,
+		fmt.Sprintf(enums.MessageUnexpectedToken, current.value, current.position))
+}
+
+func (p *filterParser) isKeyword(keyword string) bool {
+	current, ok := p.peek()
+
+	return ok && current.kind == tokenWord && strings.EqualFold(current.value, keyword)
+}
+
+func (p *filterParser) isSymbol(symbol string) bool {
+	current, ok := p.peek()
+
+	return ok && current.kind == tokenSymbol && current.value == symbol
+}
+
+func (p *filterParser) expectSymbol(symbol string) error {
+	current, err := p.next()
+	if err != nil {
+		return err
+	}
+
+	if current.kind != tokenSymbol || current.value != symbol {
+		return p.unexpected(current)
+	}
+
+	return nil
+}
+
+func (p *filterParser) parseOr() (Filter, error) {
+	return p.parseLogical(enums.LogicalOr, p.parseAnd)
+}
+
+func (p *filterParser) parseAnd() (Filter, error) {
+	return p.parseLogical(enums.LogicalAnd, p.parseNot)
+}
+
+func (p *filterParser) parseLogical(operator string, operand func() (Filter, error)) (Filter, error) {
+	left, err := operand()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isKeyword(operator) {
+		p.position++
+
+		right, err := operand()
+		if err != nil {
+			return nil, err
+		}
+
+		left = &LogicalExpression{Operator: operator, Left: left, Right: right}
+	}
+
+	return left, nil
+}
+
+func (p *filterParser) parseNot() (Filter, error) {
+	if !p.isKeyword(enums.LogicalNot) {
+		return p.parsePrimary()
+	}
+
+	p.position++
+
+	if !p.isSymbol("(") {
+		current, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, p.unexpected(current)
+	}
+
+	filter, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	return &NotExpression{Filter: filter}, nil
+}
+
+func (p *filterParser) parsePrimary() (Filter, error) {
+	if p.isSymbol("(") {
+		return p.parseGroup("(", ")")
+	}
+
+	path, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+
+	if path.kind != tokenWord {
+		return nil, p.unexpected(path)
+	}
+
+	if p.isSymbol("[") {
+		filter, err := p.parseGroup("[", "]")
+		if err != nil {
+			return nil, err
+		}
+
+		return &ValuePathExpression{Path: path.value, Filter: filter}, nil
+	}
+
+	return p.parseComparison(path.value)
+}
+
+func (p *filterParser) parseGroup(open, closing string) (Filter, error) {
+	if err := p.expectSymbol(open); err != nil {
+		return nil, err
+	}
+
+	filter, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	return filter, p.expectSymbol(closing)
+}
+
+func (p *filterParser) parseComparison(path string) (Filter, error) {
+	current, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+
+	operator := scimEnums.ParseFilterOperator(current.value)
+	if current.kind != tokenWord || operator == "" {
+		return nil, fmt.Errorf("%w: %s", enums.ErrorInvalidFilter,
+			fmt.Sprintf(enums.MessageInvalidOperator, current.value))
+	}
+
+	if operator == scimEnums.Present {
+		return &AttributeExpression{Path: path, Operator: operator}, nil
+	}
+
+	if current, err = p.next(); err != nil {
+		return nil, err
+	}
+
+	value, err := parseComparisonValue(current)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AttributeExpression{Path: path, Operator: operator, Value: value}, nil
+}
+
+// parseComparisonValue accepts json strings, numbers, booleans and null
+func parseComparisonValue(current token) (interface{}, error) {
+	if current.kind == tokenString {
+		var value string
+		if err := json.Unmarshal([]byte(current.value), &value); err != nil {
+			return nil, fmt.Errorf("%w: %s", enums.ErrorInvalidFilter,
+				fmt.Sprintf(enums.MessageInvalidComparison, current.value))
+		}
+
+		return value, nil
+	}
+
+	switch strings.ToLower(current.value) {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+
+	if value, err := strconv.ParseFloat(current.value, 64); err == nil && current.kind == tokenWord {
+		return value, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s", enums.ErrorInvalidFilter,
+		fmt.Sprintf(enums.MessageInvalidComparison, current.value))
+}
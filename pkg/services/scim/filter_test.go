@@ -0,0 +1,129 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	scimEnums "github.com/ZupIT/horusec-devkit/pkg/enums/scim"
+	"github.com/ZupIT/horusec-devkit/pkg/services/scim/enums"
+)
+
+func TestParseFilter(t *testing.T) {
+	t.Run("should parse an attribute expression", func(t *testing.T) {
+		filter, err := ParseFilter(`userName eq "bjensen@example.com"`)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &AttributeExpression{
+			Path: "userName", Operator: scimEnums.Equal, Value: "bjensen@example.com",
+		}, filter)
+	})
+
+	t.Run("should parse the operators case insensitive and the values by their type", func(t *testing.T) {
+		for raw, expected := range map[string]string{
+			`active EQ true`:                    "active eq true",
+			`meta.lastModified gt "2021-01-01"`: `meta.lastModified gt "2021-01-01"`,
+			`externalId eq null`:                "externalId eq null",
+			`age le 10.5`:                       "age le 10.5",
+			`title pr`:                          "title pr",
+			`urn:ietf:params:scim:schemas:core:2.0:User:userName sw "j"`: "urn:ietf:params:scim:schemas:core:2.0:User:" +
+				`userName sw "j"`,
+		} {
+			filter, err := ParseFilter(raw)
+
+			assert.NoError(t, err, raw)
+			assert.Equal(t, expected, filter.String())
+		}
+	})
+
+	t.Run("should parse not before and before or", func(t *testing.T) {
+		filter, err := ParseFilter(`a eq 1 or not (b eq 2) and c pr`)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "(a eq 1 or (not (b eq 2) and c pr))", filter.String())
+	})
+
+	t.Run("should parse grouped expressions", func(t *testing.T) {
+		filter, err := ParseFilter(`(a eq 1 or b eq 2) and c pr`)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "((a eq 1 or b eq 2) and c pr)", filter.String())
+	})
+
+	t.Run("should parse value path expressions", func(t *testing.T) {
+		filter, err := ParseFilter(`emails[type eq "work" and value co "@example.com"]`)
+
+		assert.NoError(t, err)
+		assert.IsType(t, &ValuePathExpression{}, filter)
+		assert.Equal(t, `emails[(type eq "work" and value co "@example.com")]`, filter.String())
+	})
+
+	t.Run("should parse escaped strings", func(t *testing.T) {
+		filter, err := ParseFilter(`displayName eq "say \"hi\""`)
+
+		assert.NoError(t, err)
+		assert.Equal(t, `say "hi"`, filter.(*AttributeExpression).Value)
+	})
+
+	t.Run("should return error when invalid filter", func(t *testing.T) {
+		for _, raw := range []string{
+			"", `userName`, `userName xx "a"`, `userName eq`, `userName eq "a`, `(userName eq "a"`,
+			`userName eq "a" and`, `userName eq "a")`, `emails[type eq "work"`, `userName eq unknown`,
+			`not userName eq "a"`,
+		} {
+			_, err := ParseFilter(raw)
+
+			assert.ErrorIs(t, err, enums.ErrorInvalidFilter, raw)
+		}
+	})
+}
+
+func TestParsePath(t *testing.T) {
+	t.Run("should return nil when empty path", func(t *testing.T) {
+		path, err := ParsePath(" ")
+
+		assert.NoError(t, err)
+		assert.Nil(t, path)
+	})
+
+	t.Run("should parse an attribute path", func(t *testing.T) {
+		path, err := ParsePath("name.givenName")
+
+		assert.NoError(t, err)
+		assert.Equal(t, &Path{Attribute: "name.givenName"}, path)
+	})
+
+	t.Run("should parse a path with filter and sub attribute", func(t *testing.T) {
+		path, err := ParsePath(`members[value eq "2819c223"].display`)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "members", path.Attribute)
+		assert.Equal(t, `value eq "2819c223"`, path.Filter.String())
+		assert.Equal(t, "display", path.SubAttribute)
+	})
+
+	t.Run("should return error when invalid path", func(t *testing.T) {
+		for _, raw := range []string{
+			`[value eq "a"]`, `members[value eq "a"`, `members[value xx "a"]`, `members[value eq "a"]display`,
+			`members[value eq "a"].`, `user name`,
+		} {
+			_, err := ParsePath(raw)
+
+			assert.ErrorIs(t, err, enums.ErrorInvalidPath, raw)
+		}
+	})
+}
@@ -0,0 +1,190 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scim
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/scim"
+	scimEnums "github.com/ZupIT/horusec-devkit/pkg/enums/scim"
+	"github.com/ZupIT/horusec-devkit/pkg/services/scim/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/sanitize"
+)
+
+type IHandler interface {
+	Routes(router chi.Router)
+}
+
+// Handler exposes the scim 2.0 users and groups endpoints under /scim/v2, delegating the storage to the providers.
+// The routes are only registered when a token or an authorization middleware is configured, and the resources of a
+// nil provider are not registered, so a service can provision only the users.
+type Handler struct {
+	options *Options
+	users   *resourceHandler[scim.User, *scim.User]
+	groups  *resourceHandler[scim.Group, *scim.Group]
+}
+
+func NewHandler(options *Options, users IUserProvider, groups IGroupProvider) IHandler {
+	handler := &Handler{options: options}
+
+	if users != nil {
+		handler.users = &resourceHandler[scim.User, *scim.User]{options: options, provider: users,
+			resourceType: enums.ResourceTypeUser, route: enums.UsersRoute}
+	}
+
+	if groups != nil {
+		handler.groups = &resourceHandler[scim.Group, *scim.Group]{options: options, provider: groups,
+			resourceType: enums.ResourceTypeGroup, route: enums.GroupsRoute}
+	}
+
+	return handler
+}
+
+func (h *Handler) Routes(router chi.Router) {
+	if h.options.Token == "" && h.options.Authorization == nil {
+		return
+	}
+
+	router.Route(enums.BasePath, func(router chi.Router) {
+		router.Use(h.authorize)
+		router.Get(enums.ServiceProviderRoute, h.serviceProviderConfig)
+		router.Get(enums.ResourceTypesRoute, h.resourceTypes)
+
+		if h.users != nil {
+			h.users.routes(router)
+		}
+
+		if h.groups != nil {
+			h.groups.routes(router)
+		}
+	})
+}
+
+func (h *Handler) authorize(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if h.isValidToken(r.Header.Get(enums.HeaderAuthorization)) {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		if h.options.Authorization != nil {
+			h.options.Authorization(next).ServeHTTP(w, r)
+
+			return
+		}
+
+		writeError(w, enums.ErrorUnauthorized)
+	})
+}
+
+func (h *Handler) isValidToken(authorization string) bool {
+	token := strings.TrimPrefix(authorization, enums.BearerPrefix)
+	if h.options.Token == "" || token == "" || token == authorization {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(h.options.Token), []byte(token)) == 1
+}
+
+func (h *Handler) serviceProviderConfig(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"schemas":        []scimEnums.Schema{scimEnums.ServiceProviderConfigSchema},
+		"patch":          map[string]bool{"supported": true},
+		"bulk":           map[string]interface{}{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]interface{}{"supported": true, "maxResults": h.options.MaxResults},
+		"changePassword": map[string]bool{"supported": false},
+		"sort":           map[string]bool{"supported": true},
+		"etag":           map[string]bool{"supported": false},
+		"authenticationSchemes": []map[string]string{{
+			"type": enums.AuthenticationSchemeToken, "name": "OAuth Bearer Token",
+			"description": "Authentication with the scim token as a bearer token",
+		}},
+	})
+}
+
+func (h *Handler) resourceTypes(w http.ResponseWriter, _ *http.Request) {
+	var resourceTypes []map[string]interface{}
+
+	for _, resource := range []struct {
+		enabled bool
+		name    string
+		route   string
+		schema  scimEnums.Schema
+	}{
+		{enabled: h.users != nil, name: enums.ResourceTypeUser, route: enums.UsersRoute, schema: scimEnums.UserSchema},
+		{enabled: h.groups != nil, name: enums.ResourceTypeGroup, route: enums.GroupsRoute,
+			schema: scimEnums.GroupSchema},
+	} {
+		if resource.enabled {
+			resourceTypes = append(resourceTypes, map[string]interface{}{
+				"schemas": []scimEnums.Schema{scimEnums.ResourceTypeSchema}, "id": resource.name,
+				"name": resource.name, "endpoint": resource.route, "schema": resource.schema,
+			})
+		}
+	}
+
+	writeJSON(w, http.StatusOK, scim.NewListResponse(resourceTypes, len(resourceTypes), 1, len(resourceTypes)))
+}
+
+func writeJSON(w http.ResponseWriter, status int, content interface{}) {
+	w.Header().Set(enums.HeaderContentType, enums.ContentType)
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(content)
+}
+
+// nolint:gochecknoglobals // status and scim type of the errors returned by the providers and the handlers
+var errorResponses = []struct {
+	err      error
+	status   int
+	scimType scimEnums.ErrorType
+}{
+	{err: enums.ErrorResourceNotFound, status: http.StatusNotFound},
+	{err: enums.ErrorUniqueness, status: http.StatusConflict, scimType: scimEnums.Uniqueness},
+	{err: enums.ErrorMutability, status: http.StatusBadRequest, scimType: scimEnums.Mutability},
+	{err: enums.ErrorInvalidFilter, status: http.StatusBadRequest, scimType: scimEnums.InvalidFilter},
+	{err: enums.ErrorInvalidPath, status: http.StatusBadRequest, scimType: scimEnums.InvalidPath},
+	{err: enums.ErrorNoTarget, status: http.StatusBadRequest, scimType: scimEnums.NoTarget},
+	{err: enums.ErrorInvalidValue, status: http.StatusBadRequest, scimType: scimEnums.InvalidValue},
+	{err: enums.ErrorInvalidSyntax, status: http.StatusBadRequest, scimType: scimEnums.InvalidSyntax},
+	{err: enums.ErrorTooMany, status: http.StatusBadRequest, scimType: scimEnums.TooMany},
+	{err: enums.ErrorUnauthorized, status: http.StatusUnauthorized},
+	{err: enums.ErrorNotImplemented, status: http.StatusNotImplemented},
+}
+
+// writeError responds with the scim error of the known errors, the other ones are logged and their message is not
+// sent to the identity provider
+func writeError(w http.ResponseWriter, err error) {
+	for _, response := range errorResponses {
+		if errors.Is(err, response.err) {
+			writeJSON(w, response.status, scim.NewError(response.status, response.scimType,
+				sanitize.ForResponse(err.Error())))
+
+			return
+		}
+	}
+
+	logger.LogError(enums.MessageFailedToHandleSCIM, err)
+	writeJSON(w, http.StatusInternalServerError,
+		scim.NewError(http.StatusInternalServerError, "", enums.MessageInternalServerError))
+}
@@ -0,0 +1,306 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scim
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/scim"
+	scimEnums "github.com/ZupIT/horusec-devkit/pkg/enums/scim"
+	"github.com/ZupIT/horusec-devkit/pkg/services/scim/enums"
+)
+
+func newTestRouter(options *Options, users IUserProvider, groups IGroupProvider) chi.Router {
+	router := chi.NewRouter()
+	NewHandler(options, users, groups).Routes(router)
+
+	return router
+}
+
+func doRequest(router chi.Router, method, path, body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, path, strings.NewReader(body))
+	r.Header.Set(enums.HeaderAuthorization, enums.BearerPrefix+"test")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, r)
+
+	return w
+}
+
+func decodeError(t *testing.T, w *httptest.ResponseRecorder) *scim.Error {
+	response := &scim.Error{}
+	assert.NoError(t, json.NewDecoder(w.Body).Decode(response))
+
+	return response
+}
+
+func newTestOptions() *Options {
+	return &Options{Token: "test", BaseURL: "https://horusec.io/", MaxResults: 10}
+}
+
+func TestNewOptions(t *testing.T) {
+	t.Run("should read options from env", func(t *testing.T) {
+		t.Setenv(enums.HorusecSCIMToken, "test")
+		t.Setenv(enums.HorusecSCIMMaxResults, "5")
+
+		options := NewOptions()
+
+		assert.Equal(t, "test", options.Token)
+		assert.Equal(t, 5, options.MaxResults)
+	})
+}
+
+func TestRoutes(t *testing.T) {
+	t.Run("should not register routes when no token or authorization", func(t *testing.T) {
+		router := newTestRouter(&Options{}, &ProviderMock[scim.User]{}, nil)
+
+		assert.Equal(t, http.StatusNotFound, doRequest(router, http.MethodGet, "/scim/v2/Users", "").Code)
+	})
+
+	t.Run("should return unauthorized when invalid token", func(t *testing.T) {
+		router := newTestRouter(newTestOptions(), &ProviderMock[scim.User]{}, nil)
+
+		r := httptest.NewRequest(http.MethodGet, "/scim/v2/Users", nil)
+		r.Header.Set(enums.HeaderAuthorization, enums.BearerPrefix+"invalid")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Equal(t, enums.ContentType, w.Header().Get(enums.HeaderContentType))
+		assert.Equal(t, "401", decodeError(t, w).Status)
+	})
+
+	t.Run("should use the authorization middleware when token is not valid", func(t *testing.T) {
+		options := newTestOptions()
+		options.Token = ""
+		options.Authorization = func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusForbidden)
+			})
+		}
+
+		router := newTestRouter(options, &ProviderMock[scim.User]{}, nil)
+
+		assert.Equal(t, http.StatusForbidden, doRequest(router, http.MethodGet, "/scim/v2/Users", "").Code)
+	})
+
+	t.Run("should return service provider config and resource types", func(t *testing.T) {
+		router := newTestRouter(newTestOptions(), &ProviderMock[scim.User]{}, nil)
+
+		w := doRequest(router, http.MethodGet, "/scim/v2/ServiceProviderConfig", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"maxResults":10`)
+
+		w = doRequest(router, http.MethodGet, "/scim/v2/ResourceTypes", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"totalResults":1`)
+		assert.Contains(t, w.Body.String(), `"endpoint":"/Users"`)
+		assert.NotContains(t, w.Body.String(), `"endpoint":"/Groups"`)
+	})
+}
+
+func TestUserHandlers(t *testing.T) {
+	t.Run("should get user with meta", func(t *testing.T) {
+		provider := &ProviderMock[scim.User]{}
+		provider.On("Get").Return(&scim.User{ID: "1", UserName: "test@horusec.io"}, nil)
+
+		w := doRequest(newTestRouter(newTestOptions(), provider, nil), http.MethodGet, "/scim/v2/Users/1", "")
+
+		user := &scim.User{}
+		assert.NoError(t, json.NewDecoder(w.Body).Decode(user))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "https://horusec.io/scim/v2/Users/1", user.Meta.Location)
+		assert.Equal(t, enums.ResourceTypeUser, user.Meta.ResourceType)
+		assert.Equal(t, []scimEnums.Schema{scimEnums.UserSchema}, user.Schemas)
+	})
+
+	t.Run("should return not found when provider returns not found", func(t *testing.T) {
+		provider := &ProviderMock[scim.User]{}
+		provider.On("Get").Return((*scim.User)(nil), enums.ErrorResourceNotFound)
+
+		w := doRequest(newTestRouter(newTestOptions(), provider, nil), http.MethodGet, "/scim/v2/Users/1", "")
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return internal server error without the error message", func(t *testing.T) {
+		provider := &ProviderMock[scim.User]{}
+		provider.On("Get").Return((*scim.User)(nil), errors.New("database password"))
+
+		w := doRequest(newTestRouter(newTestOptions(), provider, nil), http.MethodGet, "/scim/v2/Users/1", "")
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.NotContains(t, w.Body.String(), "password")
+	})
+
+	t.Run("should list users", func(t *testing.T) {
+		provider := &ProviderMock[scim.User]{}
+		provider.On("List").Return([]*scim.User{{ID: "1", UserName: "test"}}, 20, nil)
+
+		w := doRequest(newTestRouter(newTestOptions(), provider, nil), http.MethodGet,
+			`/scim/v2/Users?filter=userName+eq+"test"&startIndex=3`, "")
+
+		response := &scim.ListResponse{}
+		assert.NoError(t, json.NewDecoder(w.Body).Decode(response))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 20, response.TotalResults)
+		assert.Equal(t, 3, response.StartIndex)
+		assert.Equal(t, 1, response.ItemsPerPage)
+	})
+
+	t.Run("should return invalid filter when list filter is invalid", func(t *testing.T) {
+		w := doRequest(newTestRouter(newTestOptions(), &ProviderMock[scim.User]{}, nil), http.MethodGet,
+			`/scim/v2/Users?filter=userName+xx+"test"`, "")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, scimEnums.InvalidFilter, decodeError(t, w).ScimType)
+	})
+
+	t.Run("should create user and set location header", func(t *testing.T) {
+		provider := &ProviderMock[scim.User]{}
+		provider.On("Create").Return(&scim.User{ID: "1", UserName: "test@horusec.io"}, nil)
+
+		w := doRequest(newTestRouter(newTestOptions(), provider, nil), http.MethodPost, "/scim/v2/Users",
+			`{"userName": "test@horusec.io"}`)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, "https://horusec.io/scim/v2/Users/1", w.Header().Get("Location"))
+	})
+
+	t.Run("should return conflict when user already exists", func(t *testing.T) {
+		provider := &ProviderMock[scim.User]{}
+		provider.On("Create").Return((*scim.User)(nil), enums.ErrorUniqueness)
+
+		w := doRequest(newTestRouter(newTestOptions(), provider, nil), http.MethodPost, "/scim/v2/Users",
+			`{"userName": "test@horusec.io"}`)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Equal(t, scimEnums.Uniqueness, decodeError(t, w).ScimType)
+	})
+
+	t.Run("should return invalid value when user is invalid", func(t *testing.T) {
+		w := doRequest(newTestRouter(newTestOptions(), &ProviderMock[scim.User]{}, nil), http.MethodPut,
+			"/scim/v2/Users/1", `{"userName": ""}`)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, scimEnums.InvalidValue, decodeError(t, w).ScimType)
+	})
+
+	t.Run("should return invalid syntax when invalid body", func(t *testing.T) {
+		w := doRequest(newTestRouter(newTestOptions(), &ProviderMock[scim.User]{}, nil), http.MethodPost,
+			"/scim/v2/Users", `{`)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, scimEnums.InvalidSyntax, decodeError(t, w).ScimType)
+	})
+
+	t.Run("should delete user", func(t *testing.T) {
+		provider := &ProviderMock[scim.User]{}
+		provider.On("Delete").Return(nil)
+
+		w := doRequest(newTestRouter(newTestOptions(), provider, nil), http.MethodDelete, "/scim/v2/Users/1", "")
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+}
+
+func TestGroupHandlers(t *testing.T) {
+	t.Run("should patch group", func(t *testing.T) {
+		provider := &ProviderMock[scim.Group]{}
+		provider.On("Patch").Return(&scim.Group{ID: "1", DisplayName: "test"}, nil)
+
+		w := doRequest(newTestRouter(newTestOptions(), nil, provider), http.MethodPatch, "/scim/v2/Groups/1",
+			`{"Operations": [{"op": "Add", "path": "members", "value": [{"value": "1"}]}]}`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"location":"https://horusec.io/scim/v2/Groups/1"`)
+	})
+
+	t.Run("should return no content when patch returns nil group", func(t *testing.T) {
+		provider := &ProviderMock[scim.Group]{}
+		provider.On("Patch").Return((*scim.Group)(nil), nil)
+
+		w := doRequest(newTestRouter(newTestOptions(), nil, provider), http.MethodPatch, "/scim/v2/Groups/1",
+			`{"Operations": [{"op": "remove", "path": "members[value eq \"1\"]"}]}`)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return error when invalid patch operations", func(t *testing.T) {
+		router := newTestRouter(newTestOptions(), nil, &ProviderMock[scim.Group]{})
+
+		for body, scimType := range map[string]scimEnums.ErrorType{
+			`{"Operations": [{"op": "move", "path": "members"}]}`: scimEnums.InvalidValue,
+			`{"Operations": [{"op": "add", "path": "members["}]}`: scimEnums.InvalidPath,
+			`{"Operations": [{"op": "remove"}]}`:                  scimEnums.NoTarget,
+			`{"Operations": [{"op": "replace", "path": "a b"}]}`:  scimEnums.InvalidPath,
+		} {
+			w := doRequest(router, http.MethodPatch, "/scim/v2/Groups/1", body)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code, body)
+			assert.Equal(t, scimType, decodeError(t, w).ScimType, body)
+		}
+	})
+
+	t.Run("should return not found when users are not provisioned", func(t *testing.T) {
+		router := newTestRouter(newTestOptions(), nil, &ProviderMock[scim.Group]{})
+
+		assert.Equal(t, http.StatusNotFound, doRequest(router, http.MethodGet, "/scim/v2/Users/1", "").Code)
+	})
+}
+
+func TestNormalizeOperations(t *testing.T) {
+	t.Run("should lower case the operations", func(t *testing.T) {
+		operations := []scim.PatchOperation{{Op: "Replace", Path: "active"}, {Op: "ADD"}}
+
+		assert.NoError(t, normalizeOperations(operations))
+		assert.Equal(t, scimEnums.Replace, operations[0].Op)
+		assert.Equal(t, scimEnums.Add, operations[1].Op)
+	})
+}
+
+func TestListQuery(t *testing.T) {
+	t.Run("should limit count to max results", func(t *testing.T) {
+		handler := &resourceHandler[scim.User, *scim.User]{options: newTestOptions()}
+
+		for raw, expected := range map[string]int{"": 10, "5": 5, "50": 10, "-1": 0, "invalid": 10} {
+			query, err := handler.listQuery(httptest.NewRequest(http.MethodGet, "/Users?count="+raw, nil))
+
+			assert.NoError(t, err)
+			assert.Equal(t, expected, query.Count, raw)
+			assert.Equal(t, 1, query.StartIndex)
+		}
+	})
+
+	t.Run("should read sort order", func(t *testing.T) {
+		handler := &resourceHandler[scim.User, *scim.User]{options: newTestOptions()}
+
+		query, err := handler.listQuery(httptest.NewRequest(http.MethodGet,
+			"/Users?sortBy=userName&sortOrder=descending", nil))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "userName", query.SortBy)
+		assert.True(t, query.Descending)
+	})
+}
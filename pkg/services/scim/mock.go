@@ -0,0 +1,64 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scim
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/scim"
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+)
+
+type ProviderMock[T any] struct {
+	mock.Mock
+}
+
+func (m *ProviderMock[T]) Get(_ context.Context, _ string) (*T, error) {
+	args := m.MethodCalled("Get")
+
+	return args.Get(0).(*T), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *ProviderMock[T]) List(_ context.Context, _ *ListQuery) ([]*T, int, error) {
+	args := m.MethodCalled("List")
+
+	return args.Get(0).([]*T), args.Int(1), mockUtils.ReturnNilOrError(args, 2)
+}
+
+func (m *ProviderMock[T]) Create(_ context.Context, _ *T) (*T, error) {
+	args := m.MethodCalled("Create")
+
+	return args.Get(0).(*T), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *ProviderMock[T]) Replace(_ context.Context, _ string, _ *T) (*T, error) {
+	args := m.MethodCalled("Replace")
+
+	return args.Get(0).(*T), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *ProviderMock[T]) Patch(_ context.Context, _ string, _ []scim.PatchOperation) (*T, error) {
+	args := m.MethodCalled("Patch")
+
+	return args.Get(0).(*T), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *ProviderMock[T]) Delete(_ context.Context, _ string) error {
+	args := m.MethodCalled("Delete")
+
+	return mockUtils.ReturnNilOrError(args, 0)
+}
@@ -0,0 +1,40 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scim
+
+import (
+	"net/http"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/scim/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// Options configures the scim routes. The identity provider must send the token as a bearer token, or pass
+// through the authorization middleware when it is set, and the base url is used on the location of the resources,
+// which is relative when it is empty.
+type Options struct {
+	Token         string
+	BaseURL       string
+	MaxResults    int
+	Authorization func(next http.Handler) http.Handler
+}
+
+func NewOptions() *Options {
+	return &Options{
+		Token:      env.GetEnvOrDefault(enums.HorusecSCIMToken, ""),
+		BaseURL:    env.GetEnvOrDefault(enums.HorusecSCIMBaseURL, ""),
+		MaxResults: env.GetEnvOrDefaultInt(enums.HorusecSCIMMaxResults, enums.DefaultMaxResults),
+	}
+}
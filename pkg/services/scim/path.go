@@ -0,0 +1,74 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scim
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/scim/enums"
+)
+
+// Path is the parsed path of a patch operation, like members[value eq "2819c223"].display, where the filter and the
+// sub attribute are optional. Attributes of the extensions keep their schema, since it contains dots.
+type Path struct {
+	Attribute    string
+	Filter       Filter
+	SubAttribute string
+}
+
+// ParsePath parses the path of a patch operation, an empty path is valid for the add and replace operations and
+// returns nil
+func ParsePath(path string) (*Path, error) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return nil, nil
+	}
+
+	open := strings.Index(path, "[")
+	if open < 0 {
+		return &Path{Attribute: path}, validatePathAttribute(path)
+	}
+
+	closing := strings.LastIndex(path, "]")
+	if closing < open || open == 0 {
+		return nil, fmt.Errorf("%w: %s", enums.ErrorInvalidPath, path)
+	}
+
+	filter, err := ParseFilter(path[open+1 : closing])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", enums.ErrorInvalidPath, err.Error())
+	}
+
+	parsed := &Path{Attribute: path[:open], Filter: filter}
+
+	if rest := path[closing+1:]; rest != "" {
+		if !strings.HasPrefix(rest, ".") || len(rest) == 1 {
+			return nil, fmt.Errorf("%w: %s", enums.ErrorInvalidPath, path)
+		}
+
+		parsed.SubAttribute = rest[1:]
+	}
+
+	return parsed, validatePathAttribute(parsed.Attribute)
+}
+
+func validatePathAttribute(attribute string) error {
+	if strings.ContainsAny(attribute, " \t\"()[]") {
+		return fmt.Errorf("%w: %s", enums.ErrorInvalidPath, attribute)
+	}
+
+	return nil
+}
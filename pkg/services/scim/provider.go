@@ -0,0 +1,46 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scim
+
+import (
+	"context"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/scim"
+)
+
+// ListQuery has the parameters of a list request, the filter is nil when not sent and the start index is one based
+type ListQuery struct {
+	Filter     Filter
+	StartIndex int
+	Count      int
+	SortBy     string
+	Descending bool
+}
+
+// IProvider stores the provisioned resources, usually the accounts as users and the workspace memberships as
+// groups. The errors of the enums package are returned to the identity provider with their scim status, like
+// ErrorResourceNotFound and ErrorUniqueness, and any other error as an internal server error.
+type IProvider[T any] interface {
+	Get(ctx context.Context, id string) (*T, error)
+	List(ctx context.Context, query *ListQuery) (resources []*T, total int, err error)
+	Create(ctx context.Context, resource *T) (*T, error)
+	Replace(ctx context.Context, id string, resource *T) (*T, error)
+	Patch(ctx context.Context, id string, operations []scim.PatchOperation) (*T, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type IUserProvider = IProvider[scim.User]
+
+type IGroupProvider = IProvider[scim.Group]
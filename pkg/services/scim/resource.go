@@ -0,0 +1,238 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/scim"
+	scimEnums "github.com/ZupIT/horusec-devkit/pkg/enums/scim"
+	"github.com/ZupIT/horusec-devkit/pkg/services/scim/enums"
+)
+
+// resource is the pointer to a scim resource, so the handlers can create the resources and call their methods
+type resource[T any] interface {
+	*T
+	scim.Resource
+}
+
+type resourceHandler[T any, P resource[T]] struct {
+	options      *Options
+	provider     IProvider[T]
+	resourceType string
+	route        string
+}
+
+func (h *resourceHandler[T, P]) routes(router chi.Router) {
+	router.Route(h.route, func(router chi.Router) {
+		router.Get("/", h.list)
+		router.Post("/", h.create)
+		router.Get("/{id}", h.get)
+		router.Put("/{id}", h.replace)
+		router.Patch("/{id}", h.patch)
+		router.Delete("/{id}", h.delete)
+	})
+}
+
+func (h *resourceHandler[T, P]) get(w http.ResponseWriter, r *http.Request) {
+	value, err := h.provider.Get(r.Context(), chi.URLParam(r, enums.IDParam))
+	h.respond(w, http.StatusOK, value, err)
+}
+
+func (h *resourceHandler[T, P]) list(w http.ResponseWriter, r *http.Request) {
+	query, err := h.listQuery(r)
+	if err != nil {
+		writeError(w, err)
+
+		return
+	}
+
+	values, total, err := h.provider.List(r.Context(), query)
+	if err != nil {
+		writeError(w, err)
+
+		return
+	}
+
+	for _, value := range values {
+		h.setMeta(value)
+	}
+
+	writeJSON(w, http.StatusOK, scim.NewListResponse(values, total, query.StartIndex, len(values)))
+}
+
+func (h *resourceHandler[T, P]) create(w http.ResponseWriter, r *http.Request) {
+	value, err := h.decodeResource(w, r)
+	if err != nil {
+		writeError(w, err)
+
+		return
+	}
+
+	created, err := h.provider.Create(r.Context(), value)
+	if err == nil {
+		w.Header().Set("Location", h.location(P(created).GetID()))
+	}
+
+	h.respond(w, http.StatusCreated, created, err)
+}
+
+func (h *resourceHandler[T, P]) replace(w http.ResponseWriter, r *http.Request) {
+	value, err := h.decodeResource(w, r)
+	if err != nil {
+		writeError(w, err)
+
+		return
+	}
+
+	replaced, err := h.provider.Replace(r.Context(), chi.URLParam(r, enums.IDParam), value)
+	h.respond(w, http.StatusOK, replaced, err)
+}
+
+func (h *resourceHandler[T, P]) patch(w http.ResponseWriter, r *http.Request) {
+	request := &scim.PatchRequest{}
+	if err := decode(w, r, request); err != nil {
+		writeError(w, err)
+
+		return
+	}
+
+	if err := normalizeOperations(request.Operations); err != nil {
+		writeError(w, err)
+
+		return
+	}
+
+	patched, err := h.provider.Patch(r.Context(), chi.URLParam(r, enums.IDParam), request.Operations)
+	if err == nil && patched == nil {
+		w.WriteHeader(http.StatusNoContent)
+
+		return
+	}
+
+	h.respond(w, http.StatusOK, patched, err)
+}
+
+func (h *resourceHandler[T, P]) delete(w http.ResponseWriter, r *http.Request) {
+	if err := h.provider.Delete(r.Context(), chi.URLParam(r, enums.IDParam)); err != nil {
+		writeError(w, err)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *resourceHandler[T, P]) respond(w http.ResponseWriter, status int, value *T, err error) {
+	if err != nil {
+		writeError(w, err)
+
+		return
+	}
+
+	if value == nil {
+		writeError(w, enums.ErrorResourceNotFound)
+
+		return
+	}
+
+	h.setMeta(value)
+	writeJSON(w, status, value)
+}
+
+func (h *resourceHandler[T, P]) setMeta(value *T) {
+	P(value).SetMeta(h.resourceType, h.location(P(value).GetID()))
+}
+
+func (h *resourceHandler[T, P]) location(id string) string {
+	return strings.TrimSuffix(h.options.BaseURL, "/") + enums.BasePath + h.route + "/" + id
+}
+
+func (h *resourceHandler[T, P]) decodeResource(w http.ResponseWriter, r *http.Request) (*T, error) {
+	value := new(T)
+	if err := decode(w, r, value); err != nil {
+		return nil, err
+	}
+
+	if err := P(value).Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %s", enums.ErrorInvalidValue, err.Error())
+	}
+
+	return value, nil
+}
+
+// listQuery reads the pagination of the request, where a count greater than the max results is reduced to it
+func (h *resourceHandler[T, P]) listQuery(r *http.Request) (query *ListQuery, err error) {
+	query = &ListQuery{
+		StartIndex: 1,
+		Count:      h.options.MaxResults,
+		SortBy:     r.URL.Query().Get(enums.QuerySortBy),
+		Descending: r.URL.Query().Get(enums.QuerySortOrder) == enums.SortOrderDescending,
+	}
+
+	if filter := r.URL.Query().Get(enums.QueryFilter); filter != "" {
+		if query.Filter, err = ParseFilter(filter); err != nil {
+			return nil, err
+		}
+	}
+
+	if startIndex, err := strconv.Atoi(r.URL.Query().Get(enums.QueryStartIndex)); err == nil && startIndex > 1 {
+		query.StartIndex = startIndex
+	}
+
+	if count, err := strconv.Atoi(r.URL.Query().Get(enums.QueryCount)); err == nil && count < query.Count {
+		query.Count = max(count, 0)
+	}
+
+	return query, nil
+}
+
+func decode(w http.ResponseWriter, r *http.Request, value interface{}) error {
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, enums.MaxBodyBytes)).Decode(value); err != nil {
+		return fmt.Errorf("%w: %s", enums.ErrorInvalidSyntax, err.Error())
+	}
+
+	return nil
+}
+
+// normalizeOperations lower cases the operations, validates their paths and requires the path on remove operations
+func normalizeOperations(operations []scim.PatchOperation) error {
+	for index := range operations {
+		operation := scimEnums.ParseOperation(operations[index].Op.ToString())
+		if operation == "" {
+			return fmt.Errorf("%w: %s", enums.ErrorInvalidValue,
+				fmt.Sprintf(enums.MessageInvalidOperation, operations[index].Op))
+		}
+
+		path, err := ParsePath(operations[index].Path)
+		if err != nil {
+			return err
+		}
+
+		if operation == scimEnums.Remove && path == nil {
+			return enums.ErrorNoTarget
+		}
+
+		operations[index].Op = operation
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	awsSDK "github.com/aws/aws-sdk-go-v2/aws"
+	awsConfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/secrets"
+	secretsEnums "github.com/ZupIT/horusec-devkit/pkg/services/secrets/enums"
+)
+
+type AWS struct {
+	options *Options
+	client  *secretsmanager.Client
+}
+
+// NewAWS returns a provider for the aws secrets manager, where the secrets must be stored as json key values. The
+// credentials are resolved by the default chain of the aws sdk, like the env variables, the shared config files, the
+// web identity of the eks service accounts and the instance role.
+func NewAWS(options *Options) (secrets.IProvider, error) {
+	config, err := awsConfig.LoadDefaultConfig(context.Background(), options.getConfigOptions()...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AWS{options: options, client: secretsmanager.NewFromConfig(config, options.setClientOptions)}, nil
+}
+
+// GetSecret reads the key of the secret with the path as name or arn, env variables in it are expanded
+func (a *AWS) GetSecret(ctx context.Context, path, key string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(a.options.Timeout)*time.Second)
+	defer cancel()
+
+	output, err := a.client.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId: awsSDK.String(os.ExpandEnv(path)),
+	})
+	if err != nil {
+		var notFound *types.ResourceNotFoundException
+		if errors.As(err, &notFound) {
+			return "", secretsEnums.ErrorSecretNotFound
+		}
+
+		return "", err
+	}
+
+	return secrets.GetKeyFromJSON(awsSDK.ToString(output.SecretString), key)
+}
@@ -0,0 +1,106 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/secrets/aws/enums"
+	secretsEnums "github.com/ZupIT/horusec-devkit/pkg/services/secrets/enums"
+)
+
+func setTestCredentials(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "AKIDEXAMPLE")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY")
+	t.Setenv("AWS_SESSION_TOKEN", "token")
+	t.Setenv("AWS_CONFIG_FILE", filepath.Join(t.TempDir(), "config"))
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", filepath.Join(t.TempDir(), "credentials"))
+}
+
+func TestNewOptions(t *testing.T) {
+	t.Run("should read options from aws env", func(t *testing.T) {
+		t.Setenv(enums.AWSDefaultRegion, "sa-east-1")
+		t.Setenv(enums.HorusecAWSSecretsEndpoint, "http://localhost:4566")
+
+		options := NewOptions()
+
+		assert.Equal(t, "sa-east-1", options.Region)
+		assert.Equal(t, "http://localhost:4566", options.Endpoint)
+	})
+}
+
+func TestGetSecret(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]string{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+
+		if r.Header.Get("X-Amz-Target") != "secretsmanager.GetSecretValue" ||
+			!strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/") ||
+			r.Header.Get("X-Amz-Security-Token") != "token" {
+			w.WriteHeader(http.StatusForbidden)
+
+			return
+		}
+
+		if body["SecretId"] != "horusec/test/database" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"__type": "ResourceNotFoundException", "message": "not found"}`))
+
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]string{"SecretString": `{"password": "test"}`})
+	}))
+	defer server.Close()
+
+	newProvider := func(t *testing.T) *AWS {
+		setTestCredentials(t)
+
+		provider, err := NewAWS(&Options{Region: "us-east-1", Endpoint: server.URL, Timeout: 10})
+		assert.NoError(t, err)
+
+		return provider.(*AWS)
+	}
+
+	t.Run("should return secret key", func(t *testing.T) {
+		t.Setenv("HORUSEC_ENVIRONMENT", "test")
+
+		secret, err := newProvider(t).GetSecret(context.Background(), "horusec/${HORUSEC_ENVIRONMENT}/database",
+			"password")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "test", secret)
+	})
+
+	t.Run("should return error when secret or key is not found", func(t *testing.T) {
+		provider := newProvider(t)
+
+		_, err := provider.GetSecret(context.Background(), "horusec/database", "password")
+		assert.ErrorIs(t, err, secretsEnums.ErrorSecretNotFound)
+
+		_, err = provider.GetSecret(context.Background(), "horusec/test/database", "test")
+		assert.ErrorIs(t, err, secretsEnums.ErrorSecretKeyNotFound)
+	})
+}
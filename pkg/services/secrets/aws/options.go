@@ -0,0 +1,56 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	awsSDK "github.com/aws/aws-sdk-go-v2/aws"
+	awsConfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/secrets/aws/enums"
+	secretsEnums "github.com/ZupIT/horusec-devkit/pkg/services/secrets/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// Options keeps the region of the standard aws env variables, also reading the AWS_DEFAULT_REGION which is not read
+// by the sdk. The endpoint only needs to be informed for vpc endpoints or local emulators.
+type Options struct {
+	Region   string
+	Endpoint string
+	Timeout  int
+}
+
+func NewOptions() *Options {
+	return &Options{
+		Region:   env.GetEnvOrDefault(enums.AWSRegion, env.GetEnvOrDefault(enums.AWSDefaultRegion, "")),
+		Endpoint: env.GetEnvOrDefault(enums.HorusecAWSSecretsEndpoint, ""),
+		Timeout: env.GetEnvOrDefaultInt(secretsEnums.HorusecSecretsTimeoutSeconds,
+			secretsEnums.DefaultSecretsTimeoutSeconds),
+	}
+}
+
+func (o *Options) getConfigOptions() (configOptions []func(*awsConfig.LoadOptions) error) {
+	if o.Region != "" {
+		configOptions = append(configOptions, awsConfig.WithRegion(o.Region))
+	}
+
+	return configOptions
+}
+
+func (o *Options) setClientOptions(clientOptions *secretsmanager.Options) {
+	if o.Endpoint != "" {
+		clientOptions.BaseEndpoint = awsSDK.String(o.Endpoint)
+	}
+}
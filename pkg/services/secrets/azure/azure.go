@@ -0,0 +1,79 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/security/keyvault/azsecrets"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/secrets"
+	secretsEnums "github.com/ZupIT/horusec-devkit/pkg/services/secrets/enums"
+)
+
+type Azure struct {
+	options *Options
+	client  *azsecrets.Client
+}
+
+// NewAzure returns a provider for the azure key vault, where the secrets must be stored as json key values. The
+// credentials are resolved by the default azure credential, like the AZURE_* env variables, the workload identity and
+// the managed identity, where the AZURE_CLIENT_ID selects a user assigned identity.
+func NewAzure(options *Options) (secrets.IProvider, error) {
+	credential, err := azidentity.NewDefaultAzureCredential(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return newAzure(options, credential, nil)
+}
+
+func newAzure(options *Options, credential azcore.TokenCredential,
+	clientOptions *azsecrets.ClientOptions) (secrets.IProvider, error) {
+	client, err := azsecrets.NewClient(options.KeyVaultURL, credential, clientOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Azure{options: options, client: client}, nil
+}
+
+// GetSecret reads the key of the latest version of the secret with the path as name, env variables in it are expanded
+func (a *Azure) GetSecret(ctx context.Context, path, key string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(a.options.Timeout)*time.Second)
+	defer cancel()
+
+	response, err := a.client.GetSecret(ctx, os.ExpandEnv(path), "", nil)
+	if err != nil {
+		var responseError *azcore.ResponseError
+		if errors.As(err, &responseError) && responseError.StatusCode == http.StatusNotFound {
+			return "", secretsEnums.ErrorSecretNotFound
+		}
+
+		return "", err
+	}
+
+	if response.Value == nil {
+		return "", secretsEnums.ErrorSecretKeyNotFound
+	}
+
+	return secrets.GetKeyFromJSON(*response.Value, key)
+}
@@ -0,0 +1,99 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/security/keyvault/azsecrets"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/secrets/azure/enums"
+	secretsEnums "github.com/ZupIT/horusec-devkit/pkg/services/secrets/enums"
+)
+
+type credentialStub struct{}
+
+func (c *credentialStub) GetToken(_ context.Context, _ policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	return azcore.AccessToken{Token: "token", ExpiresOn: time.Now().Add(time.Hour)}, nil
+}
+
+// newTestProvider answers the first request with the authentication challenge of the key vault, which is how the
+// client discovers the tenant and the scope of the token
+func newTestProvider(t *testing.T) *Azure {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.Header().Set("WWW-Authenticate", `Bearer authorization="https://login.microsoftonline.com/tenant", `+
+				`resource="https://vault.azure.net"`)
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		if r.URL.Path != "/secrets/horusec-database/" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error": {"code": "SecretNotFound", "message": "not found"}}`))
+
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]string{"value": `{"password": "test"}`})
+	}))
+	t.Cleanup(server.Close)
+
+	provider, err := newAzure(&Options{KeyVaultURL: server.URL, Timeout: 10}, &credentialStub{},
+		&azsecrets.ClientOptions{ClientOptions: policy.ClientOptions{Transport: server.Client()},
+			DisableChallengeResourceVerification: true})
+	assert.NoError(t, err)
+
+	return provider.(*Azure)
+}
+
+func TestNewOptions(t *testing.T) {
+	t.Run("should read options from env", func(t *testing.T) {
+		t.Setenv(enums.HorusecAzureKeyVaultURL, "https://horusec.vault.azure.net")
+
+		options := NewOptions()
+
+		assert.Equal(t, "https://horusec.vault.azure.net", options.KeyVaultURL)
+		assert.Equal(t, secretsEnums.DefaultSecretsTimeoutSeconds, options.Timeout)
+	})
+}
+
+func TestGetSecret(t *testing.T) {
+	t.Run("should return secret key", func(t *testing.T) {
+		secret, err := newTestProvider(t).GetSecret(context.Background(), "horusec-database", "password")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "test", secret)
+	})
+
+	t.Run("should return error when secret or key is not found", func(t *testing.T) {
+		provider := newTestProvider(t)
+
+		_, err := provider.GetSecret(context.Background(), "test", "password")
+		assert.ErrorIs(t, err, secretsEnums.ErrorSecretNotFound)
+
+		_, err = provider.GetSecret(context.Background(), "horusec-database", "test")
+		assert.ErrorIs(t, err, secretsEnums.ErrorSecretKeyNotFound)
+	})
+}
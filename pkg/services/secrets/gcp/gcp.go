@@ -0,0 +1,73 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	secretmanager "cloud.google.com/go/secretmanager/apiv1"
+	"cloud.google.com/go/secretmanager/apiv1/secretmanagerpb"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/secrets"
+	secretsEnums "github.com/ZupIT/horusec-devkit/pkg/services/secrets/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/secrets/gcp/enums"
+)
+
+type GCP struct {
+	options *Options
+	client  *secretmanager.Client
+}
+
+// NewGCP returns a provider for the gcp secret manager, which always reads the latest version of the secrets. The
+// secrets must be stored as json key values. The credentials are resolved by the application default credentials,
+// like the GOOGLE_APPLICATION_CREDENTIALS file and the service account of the workload, which also works with gke
+// workload identity.
+func NewGCP(options *Options) (secrets.IProvider, error) {
+	return newGCP(options, options.getClientOptions()...)
+}
+
+func newGCP(options *Options, clientOptions ...option.ClientOption) (secrets.IProvider, error) {
+	client, err := secretmanager.NewClient(context.Background(), clientOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GCP{options: options, client: client}, nil
+}
+
+// GetSecret reads the key of the secret with the path as name, env variables in it are expanded
+func (g *GCP) GetSecret(ctx context.Context, path, key string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(g.options.Timeout)*time.Second)
+	defer cancel()
+
+	response, err := g.client.AccessSecretVersion(ctx, &secretmanagerpb.AccessSecretVersionRequest{
+		Name: fmt.Sprintf(enums.SecretVersionName, g.options.ProjectID, os.ExpandEnv(path)),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return "", secretsEnums.ErrorSecretNotFound
+		}
+
+		return "", err
+	}
+
+	return secrets.GetKeyFromJSON(string(response.GetPayload().GetData()), key)
+}
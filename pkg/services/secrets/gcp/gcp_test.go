@@ -0,0 +1,98 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"cloud.google.com/go/secretmanager/apiv1/secretmanagerpb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	secretsEnums "github.com/ZupIT/horusec-devkit/pkg/services/secrets/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/secrets/gcp/enums"
+)
+
+type secretManagerServer struct {
+	secretmanagerpb.UnimplementedSecretManagerServiceServer
+}
+
+func (s *secretManagerServer) AccessSecretVersion(_ context.Context,
+	req *secretmanagerpb.AccessSecretVersionRequest) (*secretmanagerpb.AccessSecretVersionResponse, error) {
+	if req.GetName() != "projects/horusec/secrets/database/versions/latest" {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &secretmanagerpb.AccessSecretVersionResponse{Name: req.GetName(),
+		Payload: &secretmanagerpb.SecretPayload{Data: []byte(`{"password": "test"}`)}}, nil
+}
+
+func newTestProvider(t *testing.T) *GCP {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+
+	server := grpc.NewServer()
+	secretmanagerpb.RegisterSecretManagerServiceServer(server, &secretManagerServer{})
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	t.Cleanup(server.Stop)
+
+	provider, err := newGCP(&Options{ProjectID: "horusec", Timeout: 10}, option.WithEndpoint(listener.Addr().String()),
+		option.WithoutAuthentication(), option.WithGRPCDialOption(grpc.WithTransportCredentials(
+			insecure.NewCredentials())))
+	assert.NoError(t, err)
+
+	return provider.(*GCP)
+}
+
+func TestNewOptions(t *testing.T) {
+	t.Run("should read options from env", func(t *testing.T) {
+		t.Setenv(enums.HorusecGCPProjectID, "horusec")
+		t.Setenv(enums.HorusecGCPSecretsEndpoint, "localhost:8085")
+
+		options := NewOptions()
+
+		assert.Equal(t, "horusec", options.ProjectID)
+		assert.Len(t, options.getClientOptions(), 1)
+	})
+}
+
+func TestGetSecret(t *testing.T) {
+	t.Run("should return secret key", func(t *testing.T) {
+		secret, err := newTestProvider(t).GetSecret(context.Background(), "database", "password")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "test", secret)
+	})
+
+	t.Run("should return error when secret or key is not found", func(t *testing.T) {
+		provider := newTestProvider(t)
+
+		_, err := provider.GetSecret(context.Background(), "test", "password")
+		assert.ErrorIs(t, err, secretsEnums.ErrorSecretNotFound)
+
+		_, err = provider.GetSecret(context.Background(), "database", "test")
+		assert.ErrorIs(t, err, secretsEnums.ErrorSecretKeyNotFound)
+	})
+}
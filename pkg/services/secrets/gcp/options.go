@@ -0,0 +1,48 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp
+
+import (
+	"google.golang.org/api/option"
+
+	secretsEnums "github.com/ZupIT/horusec-devkit/pkg/services/secrets/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/secrets/gcp/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// Options configures the gcp secret manager client, the endpoint only needs to be informed for private service
+// connect endpoints or local emulators
+type Options struct {
+	ProjectID string
+	Endpoint  string
+	Timeout   int
+}
+
+func NewOptions() *Options {
+	return &Options{
+		ProjectID: env.GetEnvOrDefault(enums.HorusecGCPProjectID, ""),
+		Endpoint:  env.GetEnvOrDefault(enums.HorusecGCPSecretsEndpoint, ""),
+		Timeout: env.GetEnvOrDefaultInt(secretsEnums.HorusecSecretsTimeoutSeconds,
+			secretsEnums.DefaultSecretsTimeoutSeconds),
+	}
+}
+
+func (o *Options) getClientOptions() (clientOptions []option.ClientOption) {
+	if o.Endpoint != "" {
+		clientOptions = append(clientOptions, option.WithEndpoint(o.Endpoint))
+	}
+
+	return clientOptions
+}
@@ -0,0 +1,46 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/secrets"
+	"github.com/ZupIT/horusec-devkit/pkg/services/secrets/aws"
+	"github.com/ZupIT/horusec-devkit/pkg/services/secrets/azure"
+	"github.com/ZupIT/horusec-devkit/pkg/services/secrets/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/secrets/gcp"
+	"github.com/ZupIT/horusec-devkit/pkg/services/secrets/vault"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// NewProvider returns the secrets provider selected by the HORUSEC_SECRETS_PROVIDER env, each one configured by its
+// own env variables. It returns nil without error when no provider is selected, since the secrets are optional.
+func NewProvider() (secrets.IProvider, error) {
+	switch strings.ToLower(env.GetEnvOrDefault(enums.HorusecSecretsProvider, "")) {
+	case "":
+		return nil, nil
+	case enums.ProviderVault:
+		return vault.NewVault(vault.NewOptions())
+	case enums.ProviderAWS:
+		return aws.NewAWS(aws.NewOptions())
+	case enums.ProviderGCP:
+		return gcp.NewGCP(gcp.NewOptions())
+	case enums.ProviderAzure:
+		return azure.NewAzure(azure.NewOptions())
+	default:
+		return nil, enums.ErrorInvalidProvider
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/secrets/aws"
+	"github.com/ZupIT/horusec-devkit/pkg/services/secrets/azure"
+	"github.com/ZupIT/horusec-devkit/pkg/services/secrets/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/secrets/gcp"
+)
+
+func TestNewProvider(t *testing.T) {
+	t.Run("should return nil when provider is not selected", func(t *testing.T) {
+		provider, err := NewProvider()
+
+		assert.NoError(t, err)
+		assert.Nil(t, provider)
+	})
+
+	t.Run("should return provider selected by env", func(t *testing.T) {
+		credentials := filepath.Join(t.TempDir(), "credentials.json")
+		assert.NoError(t, os.WriteFile(credentials, []byte(`{"type": "authorized_user", "client_id": "test", `+
+			`"client_secret": "test", "refresh_token": "test"}`), 0o600))
+		t.Setenv("GOOGLE_APPLICATION_CREDENTIALS", credentials)
+
+		for name, expected := range map[string]interface{}{
+			"AWS": &aws.AWS{}, enums.ProviderGCP: &gcp.GCP{}, enums.ProviderAzure: &azure.Azure{},
+		} {
+			t.Setenv(enums.HorusecSecretsProvider, name)
+
+			provider, err := NewProvider()
+
+			assert.NoError(t, err)
+			assert.IsType(t, expected, provider)
+		}
+	})
+
+	t.Run("should return error when vault authentication fails", func(t *testing.T) {
+		t.Setenv(enums.HorusecSecretsProvider, enums.ProviderVault)
+		t.Setenv("HORUSEC_VAULT_ADDRESS", "http://localhost:0")
+
+		_, err := NewProvider()
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when provider is invalid", func(t *testing.T) {
+		t.Setenv(enums.HorusecSecretsProvider, "test")
+
+		_, err := NewProvider()
+
+		assert.ErrorIs(t, err, enums.ErrorInvalidProvider)
+	})
+}
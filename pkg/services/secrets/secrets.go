@@ -0,0 +1,66 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/request"
+	"github.com/ZupIT/horusec-devkit/pkg/services/secrets/enums"
+)
+
+// IProvider reads a key of a secret stored in an external secrets manager. The path is the secret name, which can
+// reference env variables like horusec/${HORUSEC_ENVIRONMENT}/database, and the key is the field inside the secret
+type IProvider interface {
+	GetSecret(ctx context.Context, path, key string) (string, error)
+}
+
+// DoRequest sends the request to the secrets manager and decodes the json response in the result, returning
+// ErrorSecretNotFound when the secrets manager responds with not found
+func DoRequest(service request.IRequest, req *http.Request, result interface{}) error {
+	response, err := service.DoRequest(req, nil)
+	if err != nil {
+		return err
+	}
+
+	defer response.CloseBody()
+
+	if response.GetStatusCode() == http.StatusNotFound {
+		return enums.ErrorSecretNotFound
+	}
+
+	if response.GetStatusCode() >= http.StatusBadRequest {
+		return response.ErrorByStatusCode()
+	}
+
+	return json.NewDecoder(response.Body).Decode(result)
+}
+
+// GetKeyFromJSON returns the key of secrets stored as a json object, which is how the cloud secret managers keep
+// more than one value in the same secret
+func GetKeyFromJSON(content, key string) (string, error) {
+	values := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(content), &values); err != nil {
+		return "", enums.ErrorSecretKeyNotFound
+	}
+
+	if value, ok := values[key].(string); ok {
+		return value, nil
+	}
+
+	return "", enums.ErrorSecretKeyNotFound
+}
@@ -0,0 +1,168 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/secrets/vault/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+type secretResponse struct {
+	Data interface{} `json:"data"`
+}
+
+type authResponse struct {
+	Auth struct {
+		ClientToken   string `json:"client_token"`
+		LeaseDuration int    `json:"lease_duration"`
+		Renewable     bool   `json:"renewable"`
+	} `json:"auth"`
+}
+
+type lookupResponse struct {
+	Data struct {
+		TTL       int  `json:"ttl"`
+		Renewable bool `json:"renewable"`
+	} `json:"data"`
+}
+
+func (v *Vault) login(ctx context.Context) error {
+	switch v.options.AuthMethod {
+	case enums.AuthMethodToken:
+		return v.loginWithToken(ctx)
+	case enums.AuthMethodKubernetes:
+		return v.loginWithKubernetes(ctx)
+	default:
+		return enums.ErrorInvalidAuthMethod
+	}
+}
+
+// loginWithToken looks up the informed token to know if it expires and can be renewed
+func (v *Vault) loginWithToken(ctx context.Context) error {
+	v.setToken(v.options.Token, false, 0)
+
+	response := &lookupResponse{}
+	if err := v.do(ctx, http.MethodGet, "auth/token/lookup-self", nil, response); err != nil {
+		return err
+	}
+
+	v.setToken(v.options.Token, response.Data.Renewable, response.Data.TTL)
+
+	return nil
+}
+
+func (v *Vault) loginWithKubernetes(ctx context.Context) error {
+	jwt, err := os.ReadFile(filepath.Clean(v.options.KubernetesTokenPath))
+	if err != nil {
+		return err
+	}
+
+	v.setToken("", false, 0)
+
+	response := &authResponse{}
+	if err := v.do(ctx, http.MethodPost, fmt.Sprintf("auth/%s/login", v.options.KubernetesMount),
+		map[string]string{"role": v.options.KubernetesRole, "jwt": strings.TrimSpace(string(jwt))},
+		response); err != nil {
+		return err
+	}
+
+	return v.setAuth(response)
+}
+
+func (v *Vault) renew(ctx context.Context) error {
+	response := &authResponse{}
+	if err := v.do(ctx, http.MethodPost, "auth/token/renew-self", nil, response); err != nil {
+		return err
+	}
+
+	return v.setAuth(response)
+}
+
+func (v *Vault) setAuth(response *authResponse) error {
+	if response.Auth.ClientToken == "" {
+		return enums.ErrorEmptyToken
+	}
+
+	v.setToken(response.Auth.ClientToken, response.Auth.Renewable, response.Auth.LeaseDuration)
+
+	return nil
+}
+
+func (v *Vault) setToken(token string, renewable bool, ttlSeconds int) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	v.token, v.renewable, v.ttl = token, renewable, time.Duration(ttlSeconds)*time.Second
+}
+
+// Start renews the token when half of its ttl has passed, authenticating again when it can not be renewed, until the
+// context is done. Tokens without ttl never expire and are not renewed.
+func (v *Vault) Start(ctx context.Context) {
+	go func() {
+		for {
+			interval, expires := v.getRenewInterval()
+			if !expires {
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(interval):
+				v.refresh(ctx)
+			}
+		}
+	}()
+}
+
+func (v *Vault) getRenewInterval() (time.Duration, bool) {
+	v.mutex.RLock()
+	defer v.mutex.RUnlock()
+
+	if v.ttl <= 0 {
+		return 0, false
+	}
+
+	if v.ttl/2 < enums.MinRenewInterval {
+		return enums.MinRenewInterval, true
+	}
+
+	return v.ttl / 2, true
+}
+
+func (v *Vault) refresh(ctx context.Context) {
+	v.mutex.RLock()
+	renewable := v.renewable
+	v.mutex.RUnlock()
+
+	if renewable {
+		err := v.renew(ctx)
+		if err == nil {
+			return
+		}
+
+		logger.LogError(enums.MessageFailedToRenewToken, err)
+	}
+
+	logger.LogError(enums.MessageFailedToLogin, v.login(ctx))
+}
@@ -0,0 +1,44 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+import "time"
+
+const (
+	HorusecVaultAddress             = "HORUSEC_VAULT_ADDRESS"
+	HorusecVaultToken               = "HORUSEC_VAULT_TOKEN"
+	HorusecVaultNamespace           = "HORUSEC_VAULT_NAMESPACE"
+	HorusecVaultAuthMethod          = "HORUSEC_VAULT_AUTH_METHOD"
+	HorusecVaultKubernetesRole      = "HORUSEC_VAULT_KUBERNETES_ROLE"
+	HorusecVaultKubernetesMount     = "HORUSEC_VAULT_KUBERNETES_MOUNT"
+	HorusecVaultKubernetesTokenPath = "HORUSEC_VAULT_KUBERNETES_TOKEN_PATH"
+	HorusecVaultSecretsMount        = "HORUSEC_VAULT_SECRETS_MOUNT"
+	HorusecVaultKVVersion           = "HORUSEC_VAULT_KV_VERSION"
+	HorusecVaultTimeoutSeconds      = "HORUSEC_VAULT_TIMEOUT_SECONDS"
+
+	DefaultVaultAddress             = "http://localhost:8200"
+	DefaultVaultKubernetesMount     = "kubernetes"
+	DefaultVaultKubernetesTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	DefaultVaultSecretsMount        = "secret"
+	DefaultVaultKVVersion           = 2
+	DefaultVaultTimeoutSeconds      = 10
+	MinRenewInterval                = 5 * time.Second
+
+	AuthMethodToken      = "token"
+	AuthMethodKubernetes = "kubernetes"
+
+	HeaderVaultToken     = "X-Vault-Token"
+	HeaderVaultNamespace = "X-Vault-Namespace"
+)
@@ -0,0 +1,51 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vault
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/services/secrets/vault/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// Options configures the vault client. With the kubernetes auth method the service account token is exchanged by a
+// vault token using the role, otherwise the informed token is used. Only the kv secrets engine is supported.
+type Options struct {
+	Address             string
+	Token               string
+	Namespace           string
+	AuthMethod          string
+	KubernetesRole      string
+	KubernetesMount     string
+	KubernetesTokenPath string
+	SecretsMount        string
+	KVVersion           int
+	Timeout             int
+}
+
+func NewOptions() *Options {
+	return &Options{
+		Address:         env.GetEnvOrDefault(enums.HorusecVaultAddress, enums.DefaultVaultAddress),
+		Token:           env.GetEnvOrDefault(enums.HorusecVaultToken, ""),
+		Namespace:       env.GetEnvOrDefault(enums.HorusecVaultNamespace, ""),
+		AuthMethod:      env.GetEnvOrDefault(enums.HorusecVaultAuthMethod, enums.AuthMethodToken),
+		KubernetesRole:  env.GetEnvOrDefault(enums.HorusecVaultKubernetesRole, ""),
+		KubernetesMount: env.GetEnvOrDefault(enums.HorusecVaultKubernetesMount, enums.DefaultVaultKubernetesMount),
+		KubernetesTokenPath: env.GetEnvOrDefault(enums.HorusecVaultKubernetesTokenPath,
+			enums.DefaultVaultKubernetesTokenPath),
+		SecretsMount: env.GetEnvOrDefault(enums.HorusecVaultSecretsMount, enums.DefaultVaultSecretsMount),
+		KVVersion:    env.GetEnvOrDefaultInt(enums.HorusecVaultKVVersion, enums.DefaultVaultKVVersion),
+		Timeout:      env.GetEnvOrDefaultInt(enums.HorusecVaultTimeoutSeconds, enums.DefaultVaultTimeoutSeconds),
+	}
+}
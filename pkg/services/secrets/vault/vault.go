@@ -0,0 +1,102 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/http/request"
+	"github.com/ZupIT/horusec-devkit/pkg/services/secrets"
+	secretsEnums "github.com/ZupIT/horusec-devkit/pkg/services/secrets/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/secrets/vault/enums"
+)
+
+type IVault interface {
+	secrets.IProvider
+	Start(ctx context.Context)
+}
+
+type Vault struct {
+	options   *Options
+	request   request.IRequest
+	mutex     sync.RWMutex
+	token     string
+	renewable bool
+	ttl       time.Duration
+}
+
+// NewVault authenticates in vault, the token renewal only starts after calling Start
+func NewVault(options *Options) (IVault, error) {
+	vault := &Vault{options: options, request: request.NewHTTPRequestService(options.Timeout)}
+
+	if err := vault.login(context.Background()); err != nil {
+		return nil, err
+	}
+
+	return vault, nil
+}
+
+// GetSecret reads the key of a kv secret, the path is relative to the secrets mount and env variables in it are
+// expanded, so the same configuration can be used in every environment
+func (v *Vault) GetSecret(ctx context.Context, path, key string) (string, error) {
+	data := map[string]interface{}{}
+	if err := v.do(ctx, http.MethodGet, v.getSecretPath(path), nil, &secretResponse{Data: &data}); err != nil {
+		return "", err
+	}
+
+	if v.options.KVVersion != 1 {
+		data, _ = data["data"].(map[string]interface{})
+	}
+
+	if value, ok := data[key].(string); ok {
+		return value, nil
+	}
+
+	return "", secretsEnums.ErrorSecretKeyNotFound
+}
+
+func (v *Vault) getSecretPath(path string) string {
+	path = strings.Trim(os.ExpandEnv(path), "/")
+	if v.options.KVVersion == 1 {
+		return fmt.Sprintf("%s/%s", v.options.SecretsMount, path)
+	}
+
+	return fmt.Sprintf("%s/data/%s", v.options.SecretsMount, path)
+}
+
+func (v *Vault) getToken() string {
+	v.mutex.RLock()
+	defer v.mutex.RUnlock()
+
+	return v.token
+}
+
+func (v *Vault) do(ctx context.Context, method, path string, body, result interface{}) error {
+	req, err := v.request.NewHTTPRequest(method, fmt.Sprintf("%s/v1/%s", strings.TrimSuffix(v.options.Address, "/"),
+		path), body, map[string]string{
+		enums.HeaderVaultToken: v.getToken(), enums.HeaderVaultNamespace: v.options.Namespace,
+	})
+	if err != nil {
+		return err
+	}
+
+	return secrets.DoRequest(v.request, req.WithContext(ctx), result)
+}
@@ -0,0 +1,214 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	secretsEnums "github.com/ZupIT/horusec-devkit/pkg/services/secrets/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/secrets/vault/enums"
+)
+
+type vaultServer struct {
+	*httptest.Server
+	renewed   int
+	loginRole string
+}
+
+func newVaultServer(t *testing.T) *vaultServer {
+	server := &vaultServer{}
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/v1/auth/token/lookup-self", func(w http.ResponseWriter, r *http.Request) {
+		server.write(w, r, map[string]interface{}{"data": map[string]interface{}{"ttl": 60, "renewable": true}})
+	})
+	mux.HandleFunc("/v1/auth/token/renew-self", func(w http.ResponseWriter, r *http.Request) {
+		server.renewed++
+		server.write(w, r, map[string]interface{}{"auth": map[string]interface{}{
+			"client_token": "token", "lease_duration": 120, "renewable": true,
+		}})
+	})
+	mux.HandleFunc("/v1/auth/kubernetes/login", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]string{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		server.loginRole = body["role"]
+
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"auth": map[string]interface{}{
+			"client_token": "token", "lease_duration": 30, "renewable": false,
+		}})
+	})
+	mux.HandleFunc("/v1/secret/data/horusec/test/database", func(w http.ResponseWriter, r *http.Request) {
+		server.write(w, r, map[string]interface{}{"data": map[string]interface{}{
+			"data": map[string]interface{}{"password": "test", "port": 5432},
+		}})
+	})
+	mux.HandleFunc("/v1/kv/horusec/database", func(w http.ResponseWriter, r *http.Request) {
+		server.write(w, r, map[string]interface{}{"data": map[string]interface{}{"password": "test"}})
+	})
+
+	server.Server = httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func (v *vaultServer) write(w http.ResponseWriter, r *http.Request, content interface{}) {
+	if r.Header.Get(enums.HeaderVaultToken) != "token" {
+		w.WriteHeader(http.StatusForbidden)
+
+		return
+	}
+
+	_ = json.NewEncoder(w).Encode(content)
+}
+
+func newTestOptions(address string) *Options {
+	options := NewOptions()
+	options.Address, options.Token = address, "token"
+
+	return options
+}
+
+func TestNewOptions(t *testing.T) {
+	t.Run("should read options from env", func(t *testing.T) {
+		t.Setenv(enums.HorusecVaultAuthMethod, enums.AuthMethodKubernetes)
+		t.Setenv(enums.HorusecVaultKVVersion, "1")
+
+		options := NewOptions()
+
+		assert.Equal(t, enums.AuthMethodKubernetes, options.AuthMethod)
+		assert.Equal(t, 1, options.KVVersion)
+		assert.Equal(t, enums.DefaultVaultAddress, options.Address)
+	})
+}
+
+func TestNewVault(t *testing.T) {
+	server := newVaultServer(t)
+
+	t.Run("should authenticate with token", func(t *testing.T) {
+		vault, err := NewVault(newTestOptions(server.URL))
+
+		assert.NoError(t, err)
+		assert.Equal(t, time.Minute, vault.(*Vault).ttl)
+		assert.True(t, vault.(*Vault).renewable)
+	})
+
+	t.Run("should authenticate with kubernetes service account", func(t *testing.T) {
+		options := newTestOptions(server.URL)
+		options.AuthMethod, options.Token, options.KubernetesRole = enums.AuthMethodKubernetes, "", "horusec"
+		options.KubernetesTokenPath = filepath.Join(t.TempDir(), "token")
+		assert.NoError(t, os.WriteFile(options.KubernetesTokenPath, []byte("jwt"), 0o600))
+
+		vault, err := NewVault(options)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "token", vault.(*Vault).getToken())
+		assert.Equal(t, "horusec", server.loginRole)
+	})
+
+	t.Run("should return error when token is invalid", func(t *testing.T) {
+		options := newTestOptions(server.URL)
+		options.Token = "test"
+
+		_, err := NewVault(options)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when auth method is invalid", func(t *testing.T) {
+		options := newTestOptions(server.URL)
+		options.AuthMethod = "test"
+
+		_, err := NewVault(options)
+
+		assert.ErrorIs(t, err, enums.ErrorInvalidAuthMethod)
+	})
+}
+
+func TestGetSecret(t *testing.T) {
+	server := newVaultServer(t)
+
+	t.Run("should read secret key from kv version 2 expanding env in path", func(t *testing.T) {
+		t.Setenv("HORUSEC_ENVIRONMENT", "test")
+
+		vault, _ := NewVault(newTestOptions(server.URL))
+
+		secret, err := vault.GetSecret(context.Background(), "horusec/${HORUSEC_ENVIRONMENT}/database", "password")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "test", secret)
+	})
+
+	t.Run("should read secret key from kv version 1", func(t *testing.T) {
+		options := newTestOptions(server.URL)
+		options.KVVersion, options.SecretsMount = 1, "kv"
+
+		vault, _ := NewVault(options)
+
+		secret, err := vault.GetSecret(context.Background(), "/horusec/database", "password")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "test", secret)
+	})
+
+	t.Run("should return error when secret or key is not found", func(t *testing.T) {
+		t.Setenv("HORUSEC_ENVIRONMENT", "test")
+
+		vault, _ := NewVault(newTestOptions(server.URL))
+
+		_, err := vault.GetSecret(context.Background(), "horusec/test", "password")
+		assert.ErrorIs(t, err, secretsEnums.ErrorSecretNotFound)
+
+		_, err = vault.GetSecret(context.Background(), "horusec/test/database", "port")
+		assert.ErrorIs(t, err, secretsEnums.ErrorSecretKeyNotFound)
+	})
+}
+
+func TestRefresh(t *testing.T) {
+	server := newVaultServer(t)
+
+	t.Run("should renew renewable token", func(t *testing.T) {
+		vault, _ := NewVault(newTestOptions(server.URL))
+
+		vault.(*Vault).refresh(context.Background())
+
+		assert.Equal(t, 1, server.renewed)
+		assert.Equal(t, 2*time.Minute, vault.(*Vault).ttl)
+
+		interval, expires := vault.(*Vault).getRenewInterval()
+		assert.True(t, expires)
+		assert.Equal(t, time.Minute, interval)
+	})
+
+	t.Run("should not renew tokens without ttl", func(t *testing.T) {
+		vault := &Vault{}
+
+		_, expires := vault.getRenewInterval()
+		assert.False(t, expires)
+
+		assert.NotPanics(t, func() {
+			vault.Start(context.Background())
+		})
+	})
+}
@@ -0,0 +1,72 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entities
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/session/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/sanitize"
+)
+
+// Device identifies where the session is used, shown to the user on the sessions list
+type Device struct {
+	UserAgent string `json:"userAgent"`
+	IP        string `json:"ip"`
+}
+
+// NewDevice reads the device of the request, it should be called after the real ip middleware, otherwise the ip is
+// the one of the proxy
+func NewDevice(r *http.Request) Device {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+
+	return Device{UserAgent: sanitize.Truncate(r.UserAgent(), enums.MaxUserAgentLength), IP: ip}
+}
+
+// Session is a login of an account, kept active by rotating its refresh token. Every refresh token issued for the
+// session belongs to the same family, identified by the session id, so reusing any of them revokes the session.
+type Session struct {
+	ID         uuid.UUID `json:"id"`
+	AccountID  uuid.UUID `json:"accountID"`
+	Device     Device    `json:"device"`
+	CreatedAt  time.Time `json:"createdAt"`
+	LastSeenAt time.Time `json:"lastSeenAt"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+}
+
+// IsActive returns false when the session reached its absolute expiration or was not used for the idle timeout, an
+// idle timeout lower or equal than zero disables the idle check
+func (s *Session) IsActive(now time.Time, idleTimeout time.Duration) bool {
+	if !now.Before(s.ExpiresAt) {
+		return false
+	}
+
+	return idleTimeout <= 0 || now.Before(s.LastSeenAt.Add(idleTimeout))
+}
+
+// RefreshToken keeps only the hash of the token, the used tokens are kept until the session expires to detect reuses
+type RefreshToken struct {
+	Hash      string    `json:"hash"`
+	SessionID uuid.UUID `json:"sessionID"`
+	Used      bool      `json:"used"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
@@ -0,0 +1,65 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/session/entities"
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) Create(_ context.Context, _ uuid.UUID, _ entities.Device) (*entities.Session, string, error) {
+	args := m.MethodCalled("Create")
+
+	return args.Get(0).(*entities.Session), args.String(1), mockUtils.ReturnNilOrError(args, 2)
+}
+
+func (m *Mock) Refresh(_ context.Context, _ string, _ entities.Device) (*entities.Session, string, error) {
+	args := m.MethodCalled("Refresh")
+
+	return args.Get(0).(*entities.Session), args.String(1), mockUtils.ReturnNilOrError(args, 2)
+}
+
+func (m *Mock) Get(_ context.Context, _ uuid.UUID) (*entities.Session, error) {
+	args := m.MethodCalled("Get")
+
+	return args.Get(0).(*entities.Session), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) List(_ context.Context, _ uuid.UUID) ([]*entities.Session, error) {
+	args := m.MethodCalled("List")
+
+	return args.Get(0).([]*entities.Session), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) Revoke(_ context.Context, _, _ uuid.UUID) error {
+	args := m.MethodCalled("Revoke")
+
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) RevokeAll(_ context.Context, _, _ uuid.UUID) error {
+	args := m.MethodCalled("RevokeAll")
+
+	return mockUtils.ReturnNilOrError(args, 0)
+}
@@ -0,0 +1,41 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/session/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// Options configures the absolute lifetime of the sessions and the idle timeout, after which a session that was not
+// refreshed can not be refreshed anymore
+type Options struct {
+	SessionTTL  time.Duration
+	IdleTimeout time.Duration
+	Clock       clock.IClock
+}
+
+func NewOptions() *Options {
+	return &Options{
+		SessionTTL: time.Duration(env.GetEnvOrDefaultInt(enums.HorusecSessionTTLHours,
+			enums.DefaultSessionTTLHours)) * time.Hour,
+		IdleTimeout: time.Duration(env.GetEnvOrDefaultInt(enums.HorusecSessionIdleTimeoutHours,
+			enums.DefaultSessionIdleTimeoutHours)) * time.Hour,
+		Clock: clock.NewClock(),
+	}
+}
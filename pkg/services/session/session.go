@@ -0,0 +1,196 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/session/entities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/session/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+	uuidUtils "github.com/ZupIT/horusec-devkit/pkg/utils/uuid"
+)
+
+type IManager interface {
+	Create(ctx context.Context, accountID uuid.UUID, device entities.Device) (*entities.Session, string, error)
+	Refresh(ctx context.Context, refreshToken string, device entities.Device) (*entities.Session, string, error)
+	Get(ctx context.Context, sessionID uuid.UUID) (*entities.Session, error)
+	List(ctx context.Context, accountID uuid.UUID) ([]*entities.Session, error)
+	Revoke(ctx context.Context, accountID, sessionID uuid.UUID) error
+	RevokeAll(ctx context.Context, accountID, exceptSessionID uuid.UUID) error
+}
+
+// Manager tracks the active sessions of the accounts. Each refresh returns a new refresh token and invalidates the
+// used one, when a used token is sent again it was probably stolen, so the whole session is revoked.
+type Manager struct {
+	options *Options
+	store   IStore
+	clock   clock.IClock
+}
+
+func NewManager(options *Options, store IStore) IManager {
+	return &Manager{options: options, store: store, clock: clock.OrDefault(options.Clock)}
+}
+
+// Create starts a session for the account, returning it with its first refresh token
+func (m *Manager) Create(ctx context.Context, accountID uuid.UUID,
+	device entities.Device) (*entities.Session, string, error) {
+	now := m.clock.Now()
+	session := &entities.Session{
+		ID: uuidUtils.New(), AccountID: accountID, Device: device, CreatedAt: now, LastSeenAt: now,
+		ExpiresAt: now.Add(m.options.SessionTTL),
+	}
+
+	if err := m.store.SaveSession(ctx, session); err != nil {
+		return nil, "", err
+	}
+
+	refreshToken, err := m.issueRefreshToken(ctx, session)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return session, refreshToken, nil
+}
+
+// Refresh rotates the refresh token, updating the last seen date and device of the session. It returns
+// ErrorRefreshTokenReused and revokes the session when the token was already used.
+func (m *Manager) Refresh(ctx context.Context, refreshToken string,
+	device entities.Device) (*entities.Session, string, error) {
+	token, err := m.store.UseRefreshToken(ctx, crypto.GenerateSHA256(refreshToken))
+	if err != nil {
+		return nil, "", err
+	}
+
+	session, err := m.Get(ctx, token.SessionID)
+	if err != nil {
+		return nil, "", toRefreshError(err)
+	}
+
+	if token.Used {
+		logger.FromContext(ctx).LogWarn(enums.MessageRefreshTokenReused, session.ID.String(), session.AccountID.String())
+
+		return nil, "", errors.Join(enums.ErrorRefreshTokenReused, m.store.DeleteSession(ctx, session.ID))
+	}
+
+	session.LastSeenAt, session.Device = m.clock.Now(), device
+	if err := m.store.SaveSession(ctx, session); err != nil {
+		return nil, "", err
+	}
+
+	newToken, err := m.issueRefreshToken(ctx, session)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return session, newToken, nil
+}
+
+func toRefreshError(err error) error {
+	if errors.Is(err, enums.ErrorSessionNotFound) {
+		return enums.ErrorInvalidRefreshToken
+	}
+
+	return err
+}
+
+func (m *Manager) issueRefreshToken(ctx context.Context, session *entities.Session) (string, error) {
+	value, err := crypto.GenerateToken(enums.RefreshTokenLength)
+	if err != nil {
+		return "", err
+	}
+
+	return value, m.store.SaveRefreshToken(ctx, &entities.RefreshToken{
+		Hash: crypto.GenerateSHA256(value), SessionID: session.ID, ExpiresAt: session.ExpiresAt,
+	})
+}
+
+// Get returns the session when it is active, it should be checked on the requests authenticated by tokens that
+// contain the session id, so a revoked session stops working before its access tokens expire
+func (m *Manager) Get(ctx context.Context, sessionID uuid.UUID) (*entities.Session, error) {
+	session, err := m.store.GetSession(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !session.IsActive(m.clock.Now(), m.options.IdleTimeout) {
+		return nil, enums.ErrorSessionNotFound
+	}
+
+	return session, nil
+}
+
+// List returns the active sessions of the account, the most recently used first
+func (m *Manager) List(ctx context.Context, accountID uuid.UUID) ([]*entities.Session, error) {
+	sessions, err := m.store.ListSessions(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	active := make([]*entities.Session, 0, len(sessions))
+
+	for _, session := range sessions {
+		if session.IsActive(m.clock.Now(), m.options.IdleTimeout) {
+			active = append(active, session)
+		}
+	}
+
+	sort.Slice(active, func(i, j int) bool {
+		return active[i].LastSeenAt.After(active[j].LastSeenAt)
+	})
+
+	return active, nil
+}
+
+// Revoke ends a session of the account, returning ErrorSessionNotFound when it belongs to another account
+func (m *Manager) Revoke(ctx context.Context, accountID, sessionID uuid.UUID) error {
+	session, err := m.store.GetSession(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+
+	if session.AccountID != accountID {
+		return enums.ErrorSessionNotFound
+	}
+
+	return m.store.DeleteSession(ctx, sessionID)
+}
+
+// RevokeAll ends every session of the account except the given one, which is usually the current session of the
+// request, so the user can log out the other devices. A nil uuid revokes every session, like on password changes.
+func (m *Manager) RevokeAll(ctx context.Context, accountID, exceptSessionID uuid.UUID) error {
+	sessions, err := m.store.ListSessions(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	for _, session := range sessions {
+		if session.ID == exceptSessionID {
+			continue
+		}
+
+		if err := m.store.DeleteSession(ctx, session.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
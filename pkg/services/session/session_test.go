@@ -0,0 +1,194 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/session/entities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/session/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+)
+
+func newTestManager() (IManager, *clock.FakeClock) {
+	fakeClock := clock.NewFakeClock(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+	options := &Options{SessionTTL: 24 * time.Hour, IdleTimeout: time.Hour, Clock: fakeClock}
+
+	return NewManager(options, NewMemoryStore(fakeClock)), fakeClock
+}
+
+func TestNewOptions(t *testing.T) {
+	t.Run("should read options from env", func(t *testing.T) {
+		t.Setenv(enums.HorusecSessionIdleTimeoutHours, "2")
+
+		options := NewOptions()
+
+		assert.Equal(t, 2*time.Hour, options.IdleTimeout)
+		assert.Equal(t, 720*time.Hour, options.SessionTTL)
+	})
+}
+
+func TestNewDevice(t *testing.T) {
+	t.Run("should read ip and user agent of request", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "/", nil)
+		request.RemoteAddr = "10.0.0.1:1234"
+		request.Header.Set("User-Agent", "test")
+
+		assert.Equal(t, entities.Device{UserAgent: "test", IP: "10.0.0.1"}, entities.NewDevice(request))
+	})
+}
+
+func TestCreateAndRefresh(t *testing.T) {
+	t.Run("should rotate refresh token and update session", func(t *testing.T) {
+		manager, fakeClock := newTestManager()
+		accountID := uuid.New()
+
+		session, first, err := manager.Create(context.Background(), accountID, entities.Device{IP: "10.0.0.1"})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, first)
+
+		fakeClock.Advance(30 * time.Minute)
+
+		refreshed, second, err := manager.Refresh(context.Background(), first, entities.Device{IP: "10.0.0.2"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, first, second)
+		assert.Equal(t, session.ID, refreshed.ID)
+		assert.Equal(t, "10.0.0.2", refreshed.Device.IP)
+		assert.Equal(t, fakeClock.Now(), refreshed.LastSeenAt)
+
+		_, _, err = manager.Refresh(context.Background(), second, entities.Device{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("should revoke session when refresh token is reused", func(t *testing.T) {
+		manager, _ := newTestManager()
+
+		session, first, err := manager.Create(context.Background(), uuid.New(), entities.Device{})
+		assert.NoError(t, err)
+
+		_, second, err := manager.Refresh(context.Background(), first, entities.Device{})
+		assert.NoError(t, err)
+
+		_, _, err = manager.Refresh(context.Background(), first, entities.Device{})
+		assert.ErrorIs(t, err, enums.ErrorRefreshTokenReused)
+
+		_, _, err = manager.Refresh(context.Background(), second, entities.Device{})
+		assert.ErrorIs(t, err, enums.ErrorInvalidRefreshToken)
+
+		_, err = manager.Get(context.Background(), session.ID)
+		assert.ErrorIs(t, err, enums.ErrorSessionNotFound)
+	})
+
+	t.Run("should not refresh idle or expired sessions", func(t *testing.T) {
+		manager, fakeClock := newTestManager()
+
+		_, token, err := manager.Create(context.Background(), uuid.New(), entities.Device{})
+		assert.NoError(t, err)
+
+		fakeClock.Advance(time.Hour)
+
+		_, _, err = manager.Refresh(context.Background(), token, entities.Device{})
+		assert.ErrorIs(t, err, enums.ErrorInvalidRefreshToken)
+
+		_, token, err = manager.Create(context.Background(), uuid.New(), entities.Device{})
+		assert.NoError(t, err)
+
+		for index := 0; index < 30; index++ {
+			fakeClock.Advance(59 * time.Minute)
+
+			if _, token, err = manager.Refresh(context.Background(), token, entities.Device{}); err != nil {
+				break
+			}
+		}
+
+		assert.ErrorIs(t, err, enums.ErrorInvalidRefreshToken)
+	})
+
+	t.Run("should return error when unknown refresh token", func(t *testing.T) {
+		manager, _ := newTestManager()
+
+		_, _, err := manager.Refresh(context.Background(), "test", entities.Device{})
+		assert.ErrorIs(t, err, enums.ErrorInvalidRefreshToken)
+	})
+}
+
+func TestListAndRevoke(t *testing.T) {
+	t.Run("should list active sessions of account by last seen", func(t *testing.T) {
+		manager, fakeClock := newTestManager()
+		accountID := uuid.New()
+
+		idle, _, _ := manager.Create(context.Background(), accountID, entities.Device{})
+		fakeClock.Advance(30 * time.Minute)
+		older, _, _ := manager.Create(context.Background(), accountID, entities.Device{})
+		fakeClock.Advance(time.Minute)
+		newer, _, _ := manager.Create(context.Background(), accountID, entities.Device{})
+		_, _, _ = manager.Create(context.Background(), uuid.New(), entities.Device{})
+		fakeClock.Advance(30 * time.Minute)
+
+		sessions, err := manager.List(context.Background(), accountID)
+		assert.NoError(t, err)
+		assert.Len(t, sessions, 2)
+		assert.Equal(t, newer.ID, sessions[0].ID)
+		assert.Equal(t, older.ID, sessions[1].ID)
+		assert.NotContains(t, []uuid.UUID{sessions[0].ID, sessions[1].ID}, idle.ID)
+	})
+
+	t.Run("should revoke only sessions of the account", func(t *testing.T) {
+		manager, _ := newTestManager()
+		accountID := uuid.New()
+
+		session, token, _ := manager.Create(context.Background(), accountID, entities.Device{})
+
+		assert.ErrorIs(t, manager.Revoke(context.Background(), uuid.New(), session.ID), enums.ErrorSessionNotFound)
+		assert.NoError(t, manager.Revoke(context.Background(), accountID, session.ID))
+		assert.ErrorIs(t, manager.Revoke(context.Background(), accountID, session.ID), enums.ErrorSessionNotFound)
+
+		_, _, err := manager.Refresh(context.Background(), token, entities.Device{})
+		assert.ErrorIs(t, err, enums.ErrorInvalidRefreshToken)
+	})
+
+	t.Run("should revoke every session except the current one", func(t *testing.T) {
+		manager, _ := newTestManager()
+		accountID, otherAccountID := uuid.New(), uuid.New()
+
+		current, _, _ := manager.Create(context.Background(), accountID, entities.Device{})
+		_, _, _ = manager.Create(context.Background(), accountID, entities.Device{})
+		_, _, _ = manager.Create(context.Background(), otherAccountID, entities.Device{})
+
+		assert.NoError(t, manager.RevokeAll(context.Background(), accountID, current.ID))
+
+		sessions, err := manager.List(context.Background(), accountID)
+		assert.NoError(t, err)
+		assert.Len(t, sessions, 1)
+		assert.Equal(t, current.ID, sessions[0].ID)
+
+		sessions, err = manager.List(context.Background(), otherAccountID)
+		assert.NoError(t, err)
+		assert.Len(t, sessions, 1)
+
+		assert.NoError(t, manager.RevokeAll(context.Background(), accountID, uuid.Nil))
+
+		sessions, err = manager.List(context.Background(), accountID)
+		assert.NoError(t, err)
+		assert.Empty(t, sessions)
+	})
+}
@@ -0,0 +1,145 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/session/entities"
+	"github.com/ZupIT/horusec-devkit/pkg/services/session/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+)
+
+// IStore keeps the sessions and their refresh tokens. UseRefreshToken must mark the token as used atomically and
+// return it as it was before, so two concurrent refreshes with the same token are detected as a reuse. Deleting a
+// session must also delete its refresh tokens.
+type IStore interface {
+	SaveSession(ctx context.Context, session *entities.Session) error
+	GetSession(ctx context.Context, id uuid.UUID) (*entities.Session, error)
+	ListSessions(ctx context.Context, accountID uuid.UUID) ([]*entities.Session, error)
+	DeleteSession(ctx context.Context, id uuid.UUID) error
+	SaveRefreshToken(ctx context.Context, token *entities.RefreshToken) error
+	UseRefreshToken(ctx context.Context, hash string) (*entities.RefreshToken, error)
+}
+
+type memoryStore struct {
+	mutex    sync.Mutex
+	sessions map[uuid.UUID]*entities.Session
+	tokens   map[string]*entities.RefreshToken
+	clock    clock.IClock
+}
+
+// NewMemoryStore returns a store that keeps the sessions in memory, which only works with a single replica and loses
+// the sessions on restarts. The expired sessions are removed when the sessions of the account are listed.
+func NewMemoryStore(clk clock.IClock) IStore {
+	return &memoryStore{
+		sessions: map[uuid.UUID]*entities.Session{},
+		tokens:   map[string]*entities.RefreshToken{},
+		clock:    clock.OrDefault(clk),
+	}
+}
+
+func (m *memoryStore) SaveSession(_ context.Context, session *entities.Session) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	saved := *session
+	m.sessions[session.ID] = &saved
+
+	return nil
+}
+
+func (m *memoryStore) GetSession(_ context.Context, id uuid.UUID) (*entities.Session, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	session, ok := m.sessions[id]
+	if !ok {
+		return nil, enums.ErrorSessionNotFound
+	}
+
+	found := *session
+
+	return &found, nil
+}
+
+func (m *memoryStore) ListSessions(_ context.Context, accountID uuid.UUID) ([]*entities.Session, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	sessions := []*entities.Session{}
+
+	for id, session := range m.sessions {
+		if !m.clock.Now().Before(session.ExpiresAt) {
+			m.deleteSession(id)
+
+			continue
+		}
+
+		if session.AccountID == accountID {
+			found := *session
+			sessions = append(sessions, &found)
+		}
+	}
+
+	return sessions, nil
+}
+
+func (m *memoryStore) DeleteSession(_ context.Context, id uuid.UUID) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.deleteSession(id)
+
+	return nil
+}
+
+func (m *memoryStore) deleteSession(id uuid.UUID) {
+	delete(m.sessions, id)
+
+	for hash, token := range m.tokens {
+		if token.SessionID == id {
+			delete(m.tokens, hash)
+		}
+	}
+}
+
+func (m *memoryStore) SaveRefreshToken(_ context.Context, token *entities.RefreshToken) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	saved := *token
+	m.tokens[token.Hash] = &saved
+
+	return nil
+}
+
+func (m *memoryStore) UseRefreshToken(_ context.Context, hash string) (*entities.RefreshToken, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	token, ok := m.tokens[hash]
+	if !ok || !m.clock.Now().Before(token.ExpiresAt) {
+		return nil, enums.ErrorInvalidRefreshToken
+	}
+
+	previous := *token
+	token.Used = true
+
+	return &previous, nil
+}
@@ -0,0 +1,157 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package singleflight
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/singleflight/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+)
+
+type call[V any] struct {
+	done      chan struct{}
+	value     V
+	err       error
+	expiresAt time.Time
+}
+
+// Group collapses the concurrent calls with the same key into a single call, sharing its result with every caller
+// and, when the ttl is greater than zero, with the callers until the ttl expires. Errors are never kept after the
+// call. The zero value only collapses the concurrent calls and a group must not be copied after the first use.
+type Group[V any] struct {
+	mutex     sync.Mutex
+	ttl       time.Duration
+	calls     map[string]*call[V]
+	lastSweep time.Time
+	clock     clock.IClock
+}
+
+func NewGroup[V any](ttl time.Duration) *Group[V] {
+	return &Group[V]{ttl: ttl}
+}
+
+// NewGroupWithClock uses the given clock to expire the results, it is used on tests to not wait the ttl
+func NewGroupWithClock[V any](ttl time.Duration, timer clock.IClock) *Group[V] {
+	return &Group[V]{ttl: ttl, clock: timer}
+}
+
+// Do returns the result of the call in flight or kept for the key, otherwise calls fn, returning if the result was
+// shared by another call. The fn context keeps the values of the first caller without its cancellation, so a
+// caller giving up does not fail the others, while each caller stops waiting when its own context is done.
+func (g *Group[V]) Do(ctx context.Context, key string, fn func(ctx context.Context) (V, error)) (V, bool, error) {
+	now := g.now()
+
+	g.mutex.Lock()
+	g.sweep(now)
+
+	if current, ok := g.calls[key]; ok && !isExpired(current, now) {
+		g.mutex.Unlock()
+
+		return wait(ctx, current)
+	}
+
+	current := &call[V]{done: make(chan struct{})}
+	g.calls[key] = current
+	g.mutex.Unlock()
+
+	go g.run(context.WithoutCancel(ctx), key, current, fn)
+
+	value, _, err := wait(ctx, current)
+
+	return value, false, err
+}
+
+// Forget drops the result kept for the key, so the next call is made even when the ttl has not expired
+func (g *Group[V]) Forget(key string) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if current, ok := g.calls[key]; ok && isDone(current) {
+		delete(g.calls, key)
+	}
+}
+
+func (g *Group[V]) run(ctx context.Context, key string, current *call[V], fn func(ctx context.Context) (V, error)) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			current.err = fmt.Errorf("%w: %v", enums.ErrorCallPanic, recovered)
+		}
+
+		g.finish(key, current)
+	}()
+
+	current.value, current.err = fn(ctx)
+}
+
+func (g *Group[V]) finish(key string, current *call[V]) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	current.expiresAt = g.now().Add(g.ttl)
+	if (current.err != nil || g.ttl <= 0) && g.calls[key] == current {
+		delete(g.calls, key)
+	}
+
+	close(current.done)
+}
+
+func (g *Group[V]) now() time.Time {
+	return clock.OrDefault(g.clock).Now()
+}
+
+// sweep drops the expired results at most once each ttl, so the keys that are not requested again do not stay
+func (g *Group[V]) sweep(now time.Time) {
+	if g.calls == nil {
+		g.calls = map[string]*call[V]{}
+	}
+
+	if g.ttl <= 0 || now.Sub(g.lastSweep) < g.ttl {
+		return
+	}
+
+	g.lastSweep = now
+
+	for key, current := range g.calls {
+		if isExpired(current, now) {
+			delete(g.calls, key)
+		}
+	}
+}
+
+func isDone[V any](current *call[V]) bool {
+	select {
+	case <-current.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func isExpired[V any](current *call[V], now time.Time) bool {
+	return isDone(current) && now.After(current.expiresAt)
+}
+
+func wait[V any](ctx context.Context, current *call[V]) (value V, shared bool, err error) {
+	select {
+	case <-current.done:
+		return current.value, true, current.err
+	case <-ctx.Done():
+		return value, false, ctx.Err()
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package singleflight
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/singleflight/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+)
+
+func TestDo(t *testing.T) {
+	t.Run("should collapse concurrent calls with the same key", func(t *testing.T) {
+		var calls int32
+
+		group := &Group[string]{}
+		release := make(chan struct{})
+		wait := sync.WaitGroup{}
+
+		for i := 0; i < 10; i++ {
+			wait.Add(1)
+
+			go func() {
+				defer wait.Done()
+
+				value, _, err := group.Do(context.Background(), "test", func(ctx context.Context) (string, error) {
+					atomic.AddInt32(&calls, 1)
+					<-release
+
+					return "value", nil
+				})
+
+				assert.NoError(t, err)
+				assert.Equal(t, "value", value)
+			}()
+		}
+
+		time.Sleep(20 * time.Millisecond)
+		close(release)
+		wait.Wait()
+
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("should keep the result until the ttl expires", func(t *testing.T) {
+		calls := 0
+		fakeClock := clock.NewFakeClock(time.Now())
+		group := NewGroupWithClock[int](time.Minute, fakeClock)
+		fn := func(ctx context.Context) (int, error) {
+			calls++
+
+			return calls, nil
+		}
+
+		value, shared, _ := group.Do(context.Background(), "test", fn)
+		assert.Equal(t, 1, value)
+		assert.False(t, shared)
+
+		value, shared, _ = group.Do(context.Background(), "test", fn)
+		assert.Equal(t, 1, value)
+		assert.True(t, shared)
+
+		fakeClock.Advance(time.Minute + time.Nanosecond)
+
+		value, _, _ = group.Do(context.Background(), "test", fn)
+		assert.Equal(t, 2, value)
+	})
+
+	t.Run("should not keep errors and call again", func(t *testing.T) {
+		calls := 0
+		group := NewGroup[int](time.Minute)
+		fn := func(ctx context.Context) (int, error) {
+			calls++
+
+			return 0, errors.New("test")
+		}
+
+		_, _, err := group.Do(context.Background(), "test", fn)
+		assert.Error(t, err)
+
+		_, _, err = group.Do(context.Background(), "test", fn)
+		assert.Error(t, err)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("should call again after forget", func(t *testing.T) {
+		calls := 0
+		group := NewGroup[int](time.Minute)
+		fn := func(ctx context.Context) (int, error) {
+			calls++
+
+			return calls, nil
+		}
+
+		_, _, _ = group.Do(context.Background(), "test", fn)
+		group.Forget("test")
+
+		value, _, _ := group.Do(context.Background(), "test", fn)
+		assert.Equal(t, 2, value)
+	})
+
+	t.Run("should stop waiting when context is done without canceling the call", func(t *testing.T) {
+		group := NewGroup[string](time.Minute)
+		release := make(chan struct{})
+		results := make(chan error, 1)
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		go func() {
+			_, _, err := group.Do(ctx, "test", func(ctx context.Context) (string, error) {
+				<-release
+
+				return "value", ctx.Err()
+			})
+
+			results <- err
+		}()
+
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+		assert.ErrorIs(t, <-results, context.Canceled)
+
+		go close(release)
+
+		value, shared, err := group.Do(context.Background(), "test", func(ctx context.Context) (string, error) {
+			return "other", nil
+		})
+
+		assert.NoError(t, err)
+		assert.True(t, shared)
+		assert.Equal(t, "value", value)
+	})
+
+	t.Run("should return error when call panics", func(t *testing.T) {
+		_, _, err := (&Group[string]{}).Do(context.Background(), "test", func(ctx context.Context) (string, error) {
+			panic("test")
+		})
+
+		assert.ErrorIs(t, err, enums.ErrorCallPanic)
+	})
+}
+
+func TestSweep(t *testing.T) {
+	t.Run("should drop expired results", func(t *testing.T) {
+		group := NewGroup[int](10 * time.Millisecond)
+
+		_, _, _ = group.Do(context.Background(), "test", func(ctx context.Context) (int, error) {
+			return 1, nil
+		})
+
+		group.mutex.Lock()
+		group.sweep(time.Now().Add(time.Minute))
+		assert.Empty(t, group.calls)
+		group.mutex.Unlock()
+	})
+}
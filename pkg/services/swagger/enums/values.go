@@ -17,6 +17,7 @@ package enums
 const (
 	SwaggerRoute          = "/swagger/*"
 	SwaggerURL            = "http://%s:%s/swagger/doc.json"
+	GeneratedSwaggerURL   = "http://%s:%s/swagger.json"
 	EnvHorusecPort        = "HORUSEC_PORT"
 	EnvHorusecSwaggerHost = "HORUSEC_SWAGGER_HOST"
 )
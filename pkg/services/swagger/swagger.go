@@ -27,6 +27,7 @@ import (
 
 type ISwagger interface {
 	SetupSwagger()
+	SetupGeneratedSwagger()
 	GetSwaggerHost() string
 }
 
@@ -50,8 +51,19 @@ func (s *Swagger) SetupSwagger() {
 	logger.LogInfo(fmt.Sprintf(enums.MessageSwaggerURL, s.GetSwaggerHost()))
 }
 
+// SetupGeneratedSwagger serves the ui with the document generated from the routes registered by the openapi router
+func (s *Swagger) SetupGeneratedSwagger() {
+	s.routerSwaggerWithURL(enums.GeneratedSwaggerURL)
+
+	logger.LogInfo(fmt.Sprintf(enums.MessageSwaggerURL, s.GetSwaggerHost()))
+}
+
 func (s *Swagger) routerSwagger() {
-	swaggerConfig := httpSwagger.URL(fmt.Sprintf(enums.SwaggerURL, s.host, s.port))
+	s.routerSwaggerWithURL(enums.SwaggerURL)
+}
+
+func (s *Swagger) routerSwaggerWithURL(url string) {
+	swaggerConfig := httpSwagger.URL(fmt.Sprintf(url, s.host, s.port))
 
 	s.router.Get(enums.SwaggerRoute, httpSwagger.Handler(swaggerConfig))
 }
@@ -38,6 +38,16 @@ func TestSetupSwagger(t *testing.T) {
 	})
 }
 
+func TestSetupGeneratedSwagger(t *testing.T) {
+	t.Run("should success setup swagger with generated document", func(t *testing.T) {
+		swaggerService := NewSwagger(chi.NewRouter(), "9999")
+
+		assert.NotPanics(t, func() {
+			swaggerService.SetupGeneratedSwagger()
+		})
+	})
+}
+
 func TestGetSwaggerHost(t *testing.T) {
 	t.Run("should success get swagger host", func(t *testing.T) {
 		swaggerService := NewSwagger(&chi.Mux{}, "9999")
@@ -0,0 +1,127 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package version
+
+import (
+	"encoding/json"
+	"net/http"
+	"path"
+	"runtime"
+	"runtime/debug"
+	"sync"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/metrics"
+	"github.com/ZupIT/horusec-devkit/pkg/services/version/enums"
+)
+
+// The build values are set with ldflags, like -ldflags "-X github.com/ZupIT/horusec-devkit/pkg/services/version.
+// Version=v2.17.0 -X github.com/ZupIT/horusec-devkit/pkg/services/version.Commit=$(git rev-parse HEAD)". When they
+// are empty the values of the go build info are used.
+// nolint:gochecknoglobals // values replaced by the linker
+var (
+	Version   string
+	Commit    string
+	BuildDate string
+
+	buildInfoOnce sync.Once
+)
+
+type Info struct {
+	Name          string `json:"name"`
+	Version       string `json:"version"`
+	Commit        string `json:"commit"`
+	BuildDate     string `json:"buildDate"`
+	GoVersion     string `json:"goVersion"`
+	DevkitVersion string `json:"devkitVersion"`
+}
+
+func Get() *Info {
+	info := &Info{
+		Name: enums.DefaultUnknown, Version: Version, Commit: Commit, BuildDate: BuildDate,
+		GoVersion: runtime.Version(), DevkitVersion: enums.DefaultUnknown,
+	}
+
+	if buildInfo, ok := debug.ReadBuildInfo(); ok {
+		info.setFromBuildInfo(buildInfo)
+	}
+
+	info.setDefaults()
+
+	return info
+}
+
+func (i *Info) setFromBuildInfo(buildInfo *debug.BuildInfo) {
+	if buildInfo.Main.Path != "" {
+		i.Name = path.Base(buildInfo.Main.Path)
+	}
+
+	if i.Version == "" && buildInfo.Main.Version != enums.DevelVersion {
+		i.Version = buildInfo.Main.Version
+	}
+
+	for _, setting := range buildInfo.Settings {
+		switch {
+		case setting.Key == enums.SettingRevision && i.Commit == "":
+			i.Commit = setting.Value
+		case setting.Key == enums.SettingTime && i.BuildDate == "":
+			i.BuildDate = setting.Value
+		}
+	}
+
+	i.DevkitVersion = getDevkitVersion(buildInfo)
+}
+
+// getDevkitVersion returns the version of the devkit dependency, or of the main module when it is the devkit itself
+func getDevkitVersion(buildInfo *debug.BuildInfo) string {
+	for _, module := range append([]*debug.Module{&buildInfo.Main}, buildInfo.Deps...) {
+		if module.Path == enums.DevkitModulePath && module.Version != "" && module.Version != enums.DevelVersion {
+			return module.Version
+		}
+	}
+
+	return enums.DefaultUnknown
+}
+
+func (i *Info) setDefaults() {
+	for _, value := range []*string{&i.Version, &i.Commit, &i.BuildDate} {
+		if *value == "" {
+			*value = enums.DefaultUnknown
+		}
+	}
+}
+
+// Handler returns the build info as json and should be routed on enums.VersionRoute
+func Handler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		_ = json.NewEncoder(w).Encode(Get())
+	}
+}
+
+// RegisterBuildInfoMetric exports the build info as the labels of a gauge always set to 1, so dashboards can show
+// which build of each service is running. It only registers the metric once.
+func RegisterBuildInfoMetric() {
+	buildInfoOnce.Do(func() {
+		info := Get()
+
+		metrics.NewGaugeVec(enums.MetricsBuildInfo, "Build info of the running service, always set to 1.",
+			enums.MetricsLabelName, enums.MetricsLabelVersion, enums.MetricsLabelCommit, enums.MetricsLabelDate,
+			enums.MetricsLabelGo, enums.MetricsLabelDevkit).
+			WithLabelValues(info.Name, info.Version, info.Commit, info.BuildDate, info.GoVersion, info.DevkitVersion).
+			Set(1)
+	})
+}
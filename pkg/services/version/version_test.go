@@ -0,0 +1,104 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package version
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/metrics"
+	"github.com/ZupIT/horusec-devkit/pkg/services/version/enums"
+)
+
+func TestGet(t *testing.T) {
+	t.Run("should return the values set by the linker", func(t *testing.T) {
+		Version, Commit, BuildDate = "v2.17.0", "a1b2c3", "2026-10-17T00:00:00Z"
+		defer func() {
+			Version, Commit, BuildDate = "", "", ""
+		}()
+
+		info := Get()
+
+		assert.Equal(t, "v2.17.0", info.Version)
+		assert.Equal(t, "a1b2c3", info.Commit)
+		assert.Equal(t, "2026-10-17T00:00:00Z", info.BuildDate)
+		assert.Equal(t, runtime.Version(), info.GoVersion)
+	})
+
+	t.Run("should return unknown when values are not set", func(t *testing.T) {
+		info := Get()
+
+		assert.NotEmpty(t, info.Name)
+		assert.NotEmpty(t, info.Version)
+		assert.NotEmpty(t, info.Commit)
+	})
+
+	t.Run("should use build info when values are not set", func(t *testing.T) {
+		info := &Info{}
+		info.setFromBuildInfo(&debug.BuildInfo{
+			Main: debug.Module{Path: "github.com/ZupIT/horusec-platform/api", Version: "v2.1.0"},
+			Deps: []*debug.Module{{Path: enums.DevkitModulePath, Version: "v1.0.22"}},
+			Settings: []debug.BuildSetting{
+				{Key: enums.SettingRevision, Value: "a1b2c3"}, {Key: enums.SettingTime, Value: "2026-10-17T00:00:00Z"},
+			},
+		})
+
+		assert.Equal(t, &Info{Name: "api", Version: "v2.1.0", Commit: "a1b2c3", BuildDate: "2026-10-17T00:00:00Z",
+			DevkitVersion: "v1.0.22"}, info)
+	})
+}
+
+func TestHandler(t *testing.T) {
+	t.Run("should return build info as json", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		Handler()(w, httptest.NewRequest(http.MethodGet, enums.VersionRoute, nil))
+
+		info := &Info{}
+		assert.NoError(t, json.NewDecoder(w.Body).Decode(info))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, runtime.Version(), info.GoVersion)
+	})
+}
+
+func TestRegisterBuildInfoMetric(t *testing.T) {
+	t.Run("should register build info gauge only once", func(t *testing.T) {
+		RegisterBuildInfoMetric()
+		RegisterBuildInfoMetric()
+
+		count, err := testutil.GatherAndCount(metrics.Registry(), "horusec_build_info")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("should export go version as label", func(t *testing.T) {
+		families, err := metrics.Registry().Gather()
+		assert.NoError(t, err)
+
+		for _, family := range families {
+			if family.GetName() == "horusec_build_info" {
+				assert.True(t, strings.Contains(family.GetMetric()[0].String(), runtime.Version()))
+			}
+		}
+	})
+}
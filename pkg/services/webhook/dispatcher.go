@@ -0,0 +1,247 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	webhookEntities "github.com/ZupIT/horusec-devkit/pkg/entities/webhook"
+	circuitBreakerEnums "github.com/ZupIT/horusec-devkit/pkg/enums/circuitbreaker"
+	webhookEnums "github.com/ZupIT/horusec-devkit/pkg/enums/webhook"
+	"github.com/ZupIT/horusec-devkit/pkg/services/circuitbreaker"
+	"github.com/ZupIT/horusec-devkit/pkg/services/webhook/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto"
+	cryptoEnums "github.com/ZupIT/horusec-devkit/pkg/utils/crypto/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/retry"
+	uuidUtils "github.com/ZupIT/horusec-devkit/pkg/utils/uuid"
+)
+
+// Endpoint is where the events are posted, the timeout overrides the default one of the dispatcher when set
+type Endpoint struct {
+	ID      uuid.UUID
+	URL     string
+	Secret  []byte
+	Headers map[string]string
+	Timeout time.Duration
+}
+
+// Event is posted as json with the id, type, creation date and data, the same id is sent on every retry so the
+// receivers can ignore duplicated deliveries
+type Event struct {
+	ID        uuid.UUID   `json:"id"`
+	Type      string      `json:"type"`
+	CreatedAt time.Time   `json:"createdAt"`
+	Data      interface{} `json:"data"`
+}
+
+type IDispatcher interface {
+	Dispatch(ctx context.Context, endpoint *Endpoint, event *Event) *webhookEntities.Delivery
+}
+
+type Dispatcher struct {
+	options  *Options
+	client   *http.Client
+	breakers sync.Map
+}
+
+func NewDispatcher(options *Options) IDispatcher {
+	return &Dispatcher{options: options, client: &http.Client{}}
+}
+
+// Dispatch posts the signed event retrying the network errors, 408, 429 and 5xx responses with exponential backoff,
+// which uses the Retry-After header when it is longer. It always returns the delivery, even when the context is
+// canceled or the breaker of the endpoint is open, so it can be persisted as is.
+func (d *Dispatcher) Dispatch(ctx context.Context, endpoint *Endpoint, event *Event) *webhookEntities.Delivery {
+	delivery := &webhookEntities.Delivery{
+		DeliveryID: uuidUtils.New(), WebhookID: endpoint.ID, EventID: event.ID, EventType: event.Type,
+		URL: endpoint.URL, CreatedAt: time.Now(),
+	}
+
+	defer func() {
+		delivery.FinishedAt = time.Now()
+	}()
+
+	done, err := d.getBreaker(endpoint).Allow()
+	if err != nil {
+		delivery.Status = webhookEnums.CircuitOpen
+		delivery.SetError(enums.ErrorCircuitOpen)
+
+		return delivery
+	}
+
+	done(d.dispatchWithRetry(ctx, endpoint, event, delivery))
+
+	return delivery
+}
+
+// getBreaker keeps a breaker for each endpoint url, named by its host so the metrics do not expose the url
+func (d *Dispatcher) getBreaker(endpoint *Endpoint) circuitbreaker.ICircuitBreaker {
+	if value, ok := d.breakers.Load(endpoint.URL); ok {
+		return value.(circuitbreaker.ICircuitBreaker)
+	}
+
+	value, _ := d.breakers.LoadOrStore(endpoint.URL, circuitbreaker.NewCircuitBreaker(
+		enums.BreakerPrefix+getHost(endpoint.URL), d.breakerOptions()))
+
+	return value.(circuitbreaker.ICircuitBreaker)
+}
+
+// breakerOptions opens the breaker after BreakerFailures deliveries in a row and allows a single delivery after
+// the cooldown, closing it again on success or restarting the cooldown on failure
+func (d *Dispatcher) breakerOptions() *circuitbreaker.Options {
+	return &circuitbreaker.Options{
+		ConsecutiveFailures: d.options.BreakerFailures,
+		OpenTimeout:         d.options.BreakerCooldown,
+		HalfOpenRequests:    1,
+		OnStateChange: func(name string, _, to circuitBreakerEnums.State) {
+			if to == circuitBreakerEnums.Open {
+				logger.LogWarn(enums.MessageCircuitOpened, name)
+			}
+		},
+	}
+}
+
+func (d *Dispatcher) dispatchWithRetry(ctx context.Context, endpoint *Endpoint, event *Event,
+	delivery *webhookEntities.Delivery) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return d.setResult(delivery, err)
+	}
+
+	return d.setResult(delivery, retry.Do(ctx, d.retryPolicy(), func(ctx context.Context) error {
+		delivery.Attempts++
+
+		retryAfter, err := d.post(ctx, endpoint, event, delivery, body)
+		if err != nil && !isRetryable(delivery.StatusCode) {
+			return retry.Permanent(err)
+		}
+
+		return retry.After(err, retryAfter)
+	}))
+}
+
+func (d *Dispatcher) retryPolicy() *retry.Policy {
+	policy := retry.NewPolicy(enums.RetryOperation)
+	policy.MaxAttempts = d.options.MaxAttempts
+	policy.InitialBackoff = d.options.InitialBackoff
+	policy.MaxBackoff = d.options.MaxBackoff
+	policy.OnRetry = func(_ int, err error, _ time.Duration) {
+		logger.LogError(enums.MessageFailedToDeliver, err)
+	}
+
+	return policy
+}
+
+func (d *Dispatcher) post(ctx context.Context, endpoint *Endpoint, event *Event, delivery *webhookEntities.Delivery,
+	body []byte) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.getTimeout(endpoint))
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	d.setHeaders(request, endpoint, event, delivery, body)
+
+	response, err := d.client.Do(request)
+	if err != nil {
+		delivery.StatusCode = 0
+
+		return 0, err
+	}
+
+	defer response.Body.Close()
+
+	snippet, _ := io.ReadAll(io.LimitReader(response.Body, enums.ResponseSnippetSize))
+	delivery.StatusCode, delivery.ResponseSnippet = response.StatusCode, string(snippet)
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return parseRetryAfter(response.Header.Get(enums.HeaderRetryAfter)),
+			fmt.Errorf("%w: %d", enums.ErrorUnexpectedStatusCode, response.StatusCode)
+	}
+
+	return 0, nil
+}
+
+func (d *Dispatcher) setHeaders(request *http.Request, endpoint *Endpoint, event *Event,
+	delivery *webhookEntities.Delivery, body []byte) {
+	for key, value := range endpoint.Headers {
+		request.Header.Set(key, value)
+	}
+
+	request.Header.Set(enums.HeaderContentType, enums.ContentTypeJSON)
+	request.Header.Set(enums.HeaderUserAgent, enums.UserAgent)
+	request.Header.Set(enums.HeaderEvent, event.Type)
+	request.Header.Set(enums.HeaderDelivery, delivery.DeliveryID.String())
+
+	if len(endpoint.Secret) > 0 {
+		request.Header.Set(cryptoEnums.SignatureHeader, crypto.SignPayload(endpoint.Secret, body, time.Now()))
+	}
+}
+
+func (d *Dispatcher) setResult(delivery *webhookEntities.Delivery, err error) error {
+	delivery.Status = webhookEnums.Success
+	if err != nil {
+		delivery.Status = webhookEnums.Failed
+		delivery.SetError(err)
+	}
+
+	return err
+}
+
+func (d *Dispatcher) getTimeout(endpoint *Endpoint) time.Duration {
+	if endpoint.Timeout > 0 {
+		return endpoint.Timeout
+	}
+
+	return d.options.Timeout
+}
+
+// isRetryable is called only after a failure, where the status code zero means a network error or timeout
+func isRetryable(statusCode int) bool {
+	return statusCode == 0 || statusCode == http.StatusRequestTimeout || statusCode == http.StatusTooManyRequests ||
+		statusCode >= http.StatusInternalServerError
+}
+
+func getHost(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+
+	return parsed.Host
+}
+
+func parseRetryAfter(value string) time.Duration {
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds < 0 {
+		return 0
+	}
+
+	return time.Duration(seconds) * time.Second
+}
@@ -0,0 +1,245 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	webhookEnums "github.com/ZupIT/horusec-devkit/pkg/enums/webhook"
+	"github.com/ZupIT/horusec-devkit/pkg/services/webhook/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto"
+)
+
+func newTestOptions() *Options {
+	return &Options{
+		MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond, Timeout: time.Second,
+		BreakerFailures: 2, BreakerCooldown: time.Minute,
+	}
+}
+
+func newTestServer(t *testing.T, statusCodes ...int) (*httptest.Server, *int32) {
+	calls := int32(0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := atomic.AddInt32(&calls, 1)
+		statusCode := statusCodes[len(statusCodes)-1]
+
+		if int(call) <= len(statusCodes) {
+			statusCode = statusCodes[call-1]
+		}
+
+		w.WriteHeader(statusCode)
+		_, _ = w.Write([]byte(strings.Repeat("a", 2000)))
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server, &calls
+}
+
+func newTestEvent() *Event {
+	return &Event{ID: uuid.New(), Type: "analysis.finished", CreatedAt: time.Now(), Data: map[string]string{"a": "b"}}
+}
+
+func TestNewOptions(t *testing.T) {
+	t.Run("should read options from env", func(t *testing.T) {
+		t.Setenv(enums.HorusecWebhookMaxAttempts, "2")
+		t.Setenv(enums.HorusecWebhookTimeoutSeconds, "3")
+
+		options := NewOptions()
+
+		assert.Equal(t, 2, options.MaxAttempts)
+		assert.Equal(t, 3*time.Second, options.Timeout)
+		assert.Equal(t, time.Second, options.InitialBackoff)
+		assert.Equal(t, time.Minute, options.MaxBackoff)
+		assert.Equal(t, enums.DefaultBreakerFailures, options.BreakerFailures)
+	})
+}
+
+func TestDispatch(t *testing.T) {
+	t.Run("should post the signed event with the horusec headers", func(t *testing.T) {
+		event := newTestEvent()
+		secret := []byte("secret")
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			payload, err := crypto.VerifyRequest(r, secret, time.Minute)
+			assert.NoError(t, err)
+
+			received := &Event{}
+			assert.NoError(t, json.Unmarshal(payload, received))
+			assert.Equal(t, event.ID, received.ID)
+			assert.Equal(t, "analysis.finished", r.Header.Get(enums.HeaderEvent))
+			assert.NotEmpty(t, r.Header.Get(enums.HeaderDelivery))
+			assert.Equal(t, "value", r.Header.Get("X-Custom"))
+			assert.Equal(t, enums.ContentTypeJSON, r.Header.Get(enums.HeaderContentType))
+
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		delivery := NewDispatcher(newTestOptions()).Dispatch(context.Background(), &Endpoint{
+			ID: uuid.New(), URL: server.URL, Secret: secret, Headers: map[string]string{"X-Custom": "value"},
+		}, event)
+
+		assert.Equal(t, webhookEnums.Success, delivery.Status)
+		assert.Equal(t, http.StatusNoContent, delivery.StatusCode)
+		assert.Equal(t, 1, delivery.Attempts)
+		assert.Equal(t, event.ID, delivery.EventID)
+		assert.False(t, delivery.FinishedAt.IsZero())
+	})
+
+	t.Run("should retry server errors until success", func(t *testing.T) {
+		server, calls := newTestServer(t, http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK)
+
+		delivery := NewDispatcher(newTestOptions()).Dispatch(context.Background(), &Endpoint{URL: server.URL},
+			newTestEvent())
+
+		assert.Equal(t, webhookEnums.Success, delivery.Status)
+		assert.Equal(t, 3, delivery.Attempts)
+		assert.Equal(t, int32(3), atomic.LoadInt32(calls))
+		assert.Len(t, delivery.ResponseSnippet, enums.ResponseSnippetSize)
+		assert.Empty(t, delivery.Error)
+	})
+
+	t.Run("should not retry client errors", func(t *testing.T) {
+		server, calls := newTestServer(t, http.StatusBadRequest)
+
+		delivery := NewDispatcher(newTestOptions()).Dispatch(context.Background(), &Endpoint{URL: server.URL},
+			newTestEvent())
+
+		assert.Equal(t, webhookEnums.Failed, delivery.Status)
+		assert.Equal(t, http.StatusBadRequest, delivery.StatusCode)
+		assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+		assert.Contains(t, delivery.Error, "400")
+	})
+
+	t.Run("should stop after max attempts and open the breaker", func(t *testing.T) {
+		server, calls := newTestServer(t, http.StatusInternalServerError)
+		dispatcher := NewDispatcher(newTestOptions())
+		endpoint := &Endpoint{URL: server.URL}
+
+		for i := 0; i < 2; i++ {
+			delivery := dispatcher.Dispatch(context.Background(), endpoint, newTestEvent())
+
+			assert.Equal(t, webhookEnums.Failed, delivery.Status)
+			assert.Equal(t, 3, delivery.Attempts)
+		}
+
+		delivery := dispatcher.Dispatch(context.Background(), endpoint, newTestEvent())
+
+		assert.Equal(t, webhookEnums.CircuitOpen, delivery.Status)
+		assert.Equal(t, 0, delivery.Attempts)
+		assert.Equal(t, int32(6), atomic.LoadInt32(calls))
+	})
+
+	t.Run("should allow one delivery after the cooldown and close the breaker on success", func(t *testing.T) {
+		server, _ := newTestServer(t, http.StatusInternalServerError, http.StatusOK)
+		options := newTestOptions()
+		options.MaxAttempts, options.BreakerFailures, options.BreakerCooldown = 1, 1, 10*time.Millisecond
+		dispatcher := NewDispatcher(options)
+		endpoint := &Endpoint{URL: server.URL}
+
+		assert.Equal(t, webhookEnums.Failed, dispatcher.Dispatch(context.Background(), endpoint,
+			newTestEvent()).Status)
+		assert.Equal(t, webhookEnums.CircuitOpen, dispatcher.Dispatch(context.Background(), endpoint,
+			newTestEvent()).Status)
+
+		time.Sleep(20 * time.Millisecond)
+
+		assert.Equal(t, webhookEnums.Success, dispatcher.Dispatch(context.Background(), endpoint,
+			newTestEvent()).Status)
+		assert.Equal(t, webhookEnums.Success, dispatcher.Dispatch(context.Background(), endpoint,
+			newTestEvent()).Status)
+	})
+
+	t.Run("should fail on endpoint timeout and network errors", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(100 * time.Millisecond)
+		}))
+		defer server.Close()
+
+		options := newTestOptions()
+		options.MaxAttempts = 2
+
+		delivery := NewDispatcher(options).Dispatch(context.Background(),
+			&Endpoint{URL: server.URL, Timeout: 10 * time.Millisecond}, newTestEvent())
+
+		assert.Equal(t, webhookEnums.Failed, delivery.Status)
+		assert.Equal(t, 2, delivery.Attempts)
+		assert.Equal(t, 0, delivery.StatusCode)
+
+		delivery = NewDispatcher(options).Dispatch(context.Background(), &Endpoint{URL: "://invalid"},
+			newTestEvent())
+
+		assert.Equal(t, webhookEnums.Failed, delivery.Status)
+	})
+
+	t.Run("should stop retrying when context is canceled", func(t *testing.T) {
+		server, _ := newTestServer(t, http.StatusInternalServerError)
+		options := newTestOptions()
+		options.InitialBackoff, options.MaxBackoff = time.Minute, time.Minute
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+
+		defer cancel()
+
+		delivery := NewDispatcher(options).Dispatch(ctx, &Endpoint{URL: server.URL}, newTestEvent())
+
+		assert.Equal(t, webhookEnums.Failed, delivery.Status)
+		assert.Equal(t, context.DeadlineExceeded.Error(), delivery.Error)
+	})
+
+	t.Run("should fail when event data can not be encoded", func(t *testing.T) {
+		event := newTestEvent()
+		event.Data = func() {}
+
+		delivery := NewDispatcher(newTestOptions()).Dispatch(context.Background(), &Endpoint{URL: "test"}, event)
+
+		assert.Equal(t, webhookEnums.Failed, delivery.Status)
+		assert.Equal(t, 0, delivery.Attempts)
+	})
+}
+
+func TestRetryPolicy(t *testing.T) {
+	t.Run("should use the dispatcher options on the retry policy", func(t *testing.T) {
+		options := newTestOptions()
+		policy := (&Dispatcher{options: options}).retryPolicy()
+
+		assert.Equal(t, options.MaxAttempts, policy.MaxAttempts)
+		assert.Equal(t, options.InitialBackoff, policy.InitialBackoff)
+		assert.Equal(t, options.MaxBackoff, policy.MaxBackoff)
+	})
+
+	t.Run("should parse retry after seconds", func(t *testing.T) {
+		assert.Equal(t, time.Second, parseRetryAfter("1"))
+		assert.Equal(t, time.Duration(0), parseRetryAfter("test"))
+	})
+}
+
+func TestEventBody(t *testing.T) {
+	t.Run("should encode event fields", func(t *testing.T) {
+		data, _ := json.Marshal(newTestEvent())
+
+		assert.Contains(t, string(data), `"type":"analysis.finished"`)
+	})
+}
@@ -0,0 +1,42 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+const (
+	HorusecWebhookMaxAttempts           = "HORUSEC_WEBHOOK_MAX_ATTEMPTS"
+	HorusecWebhookInitialBackoffMillis  = "HORUSEC_WEBHOOK_INITIAL_BACKOFF_MILLIS"
+	HorusecWebhookMaxBackoffSeconds     = "HORUSEC_WEBHOOK_MAX_BACKOFF_SECONDS"
+	HorusecWebhookTimeoutSeconds        = "HORUSEC_WEBHOOK_TIMEOUT_SECONDS"
+	HorusecWebhookBreakerFailures       = "HORUSEC_WEBHOOK_BREAKER_FAILURES"
+	HorusecWebhookBreakerCooldownSecond = "HORUSEC_WEBHOOK_BREAKER_COOLDOWN_SECONDS"
+
+	DefaultMaxAttempts           = 5
+	DefaultInitialBackoffMillis  = 1000
+	DefaultMaxBackoffSeconds     = 60
+	DefaultTimeoutSeconds        = 10
+	DefaultBreakerFailures       = 5
+	DefaultBreakerCooldownSecond = 60
+	ResponseSnippetSize          = 1024
+	RetryOperation               = "webhook_delivery"
+	BreakerPrefix                = "webhook:"
+
+	HeaderContentType = "Content-Type"
+	HeaderUserAgent   = "User-Agent"
+	HeaderEvent       = "X-Horusec-Event"
+	HeaderDelivery    = "X-Horusec-Delivery"
+	HeaderRetryAfter  = "Retry-After"
+	ContentTypeJSON   = "application/json"
+	UserAgent         = "Horusec-Webhook"
+)
@@ -0,0 +1,48 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/webhook/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// Options configures the retries of each delivery and the breaker of each endpoint, which pauses the deliveries for
+// the cooldown after the endpoint fails on BreakerFailures deliveries in a row
+type Options struct {
+	MaxAttempts     int
+	InitialBackoff  time.Duration
+	MaxBackoff      time.Duration
+	Timeout         time.Duration
+	BreakerFailures int
+	BreakerCooldown time.Duration
+}
+
+func NewOptions() *Options {
+	return &Options{
+		MaxAttempts: env.GetEnvOrDefaultInt(enums.HorusecWebhookMaxAttempts, enums.DefaultMaxAttempts),
+		InitialBackoff: time.Duration(env.GetEnvOrDefaultInt(enums.HorusecWebhookInitialBackoffMillis,
+			enums.DefaultInitialBackoffMillis)) * time.Millisecond,
+		MaxBackoff: time.Duration(env.GetEnvOrDefaultInt(enums.HorusecWebhookMaxBackoffSeconds,
+			enums.DefaultMaxBackoffSeconds)) * time.Second,
+		Timeout: time.Duration(env.GetEnvOrDefaultInt(enums.HorusecWebhookTimeoutSeconds,
+			enums.DefaultTimeoutSeconds)) * time.Second,
+		BreakerFailures: env.GetEnvOrDefaultInt(enums.HorusecWebhookBreakerFailures, enums.DefaultBreakerFailures),
+		BreakerCooldown: time.Duration(env.GetEnvOrDefaultInt(enums.HorusecWebhookBreakerCooldownSecond,
+			enums.DefaultBreakerCooldownSecond)) * time.Second,
+	}
+}
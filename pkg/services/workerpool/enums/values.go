@@ -0,0 +1,38 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+const (
+	HorusecWorkerPoolWorkers            = "HORUSEC_WORKER_POOL_WORKERS"
+	HorusecWorkerPoolQueueSize          = "HORUSEC_WORKER_POOL_QUEUE_SIZE"
+	HorusecWorkerPoolTaskTimeoutSeconds = "HORUSEC_WORKER_POOL_TASK_TIMEOUT_SECONDS"
+
+	DefaultWorkers            = 10
+	DefaultQueueSize          = 100
+	DefaultTaskTimeoutSeconds = 300
+
+	MetricsTasks         = "worker_pool_tasks_total"
+	MetricsTaskDuration  = "worker_pool_task_duration_seconds"
+	MetricsQueueLength   = "worker_pool_queue_length"
+	MetricsActiveWorkers = "worker_pool_active_workers"
+	MetricsLabelPool     = "pool"
+	MetricsLabelResult   = "result"
+	ResultSuccess        = "success"
+	ResultError          = "error"
+	ResultTimeout        = "timeout"
+	ResultRejected       = "rejected"
+
+	LogFieldPool = "pool"
+)
@@ -0,0 +1,66 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workerpool
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/metrics"
+	"github.com/ZupIT/horusec-devkit/pkg/services/workerpool/enums"
+)
+
+type poolMetrics struct {
+	name          string
+	tasks         *prometheus.CounterVec
+	duration      prometheus.Observer
+	queueLength   prometheus.Gauge
+	activeWorkers prometheus.Gauge
+}
+
+func newPoolMetrics(name string) *poolMetrics {
+	return &poolMetrics{
+		name: name,
+		tasks: metrics.NewCounterVec(enums.MetricsTasks, "Total of worker pool tasks by result.",
+			enums.MetricsLabelPool, enums.MetricsLabelResult),
+		duration: metrics.NewHistogramVec(enums.MetricsTaskDuration, "Duration of the worker pool tasks.",
+			metrics.LatencyBuckets, enums.MetricsLabelPool).WithLabelValues(name),
+		queueLength: metrics.NewGaugeVec(enums.MetricsQueueLength, "Tasks waiting for a free worker.",
+			enums.MetricsLabelPool).WithLabelValues(name),
+		activeWorkers: metrics.NewGaugeVec(enums.MetricsActiveWorkers, "Workers running a task.",
+			enums.MetricsLabelPool).WithLabelValues(name),
+	}
+}
+
+func (m *poolMetrics) rejected() {
+	m.tasks.WithLabelValues(m.name, enums.ResultRejected).Inc()
+}
+
+func (m *poolMetrics) observe(start time.Time, err error) {
+	result := enums.ResultSuccess
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		result = enums.ResultTimeout
+	case err != nil:
+		result = enums.ResultError
+	}
+
+	m.tasks.WithLabelValues(m.name, result).Inc()
+	m.duration.Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,55 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workerpool
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+)
+
+// Mock runs the submitted tasks synchronously when the submit returns no error, so the tests of the callers can
+// assert the effects of the tasks
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) Submit(ctx context.Context, task Task) error {
+	args := m.MethodCalled("Submit")
+
+	return runIfNil(ctx, task, mockUtils.ReturnNilOrError(args, 0))
+}
+
+func (m *Mock) TrySubmit(ctx context.Context, task Task) error {
+	args := m.MethodCalled("TrySubmit")
+
+	return runIfNil(ctx, task, mockUtils.ReturnNilOrError(args, 0))
+}
+
+func (m *Mock) Shutdown(_ context.Context) error {
+	args := m.MethodCalled("Shutdown")
+
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func runIfNil(ctx context.Context, task Task, err error) error {
+	if err == nil {
+		_ = task(ctx)
+	}
+
+	return err
+}
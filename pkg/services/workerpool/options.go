@@ -0,0 +1,39 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workerpool
+
+import (
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/workerpool/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// Options configures the number of tasks running at the same time, how many tasks wait for a free worker before the
+// submits are rejected or blocked, and the timeout of each task, which is disabled when zero
+type Options struct {
+	Workers     int
+	QueueSize   int
+	TaskTimeout time.Duration
+}
+
+func NewOptions() *Options {
+	return &Options{
+		Workers:   env.GetEnvOrDefaultInt(enums.HorusecWorkerPoolWorkers, enums.DefaultWorkers),
+		QueueSize: env.GetEnvOrDefaultInt(enums.HorusecWorkerPoolQueueSize, enums.DefaultQueueSize),
+		TaskTimeout: time.Duration(env.GetEnvOrDefaultInt(enums.HorusecWorkerPoolTaskTimeoutSeconds,
+			enums.DefaultTaskTimeoutSeconds)) * time.Second,
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workerpool
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/workerpool/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+type Task func(ctx context.Context) error
+
+// IPool runs tasks in background with bounded concurrency. Submit waits for a free slot on the queue until the
+// context is done, while TrySubmit returns ErrorPoolFull right away, which suits broker consumers and http handlers
+// respectively. The context given to the submit is only used for its values, so the task is not canceled when the
+// request that submitted it ends.
+type IPool interface {
+	Submit(ctx context.Context, task Task) error
+	TrySubmit(ctx context.Context, task Task) error
+	Shutdown(ctx context.Context) error
+}
+
+type queuedTask struct {
+	ctx  context.Context
+	task Task
+}
+
+type Pool struct {
+	options *Options
+	metrics *poolMetrics
+	fields  map[string]interface{}
+	queue   chan *queuedTask
+	ctx     context.Context
+	cancel  context.CancelFunc
+	mutex   sync.RWMutex
+	closed  bool
+	wait    sync.WaitGroup
+}
+
+// NewPool starts the workers of the pool, where the name identifies the pool on the metrics and logs
+func NewPool(name string, options *Options) IPool {
+	ctx, cancel := context.WithCancel(context.Background())
+	pool := &Pool{
+		options: options,
+		metrics: newPoolMetrics(name),
+		fields:  map[string]interface{}{enums.LogFieldPool: name},
+		queue:   make(chan *queuedTask, options.QueueSize),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+
+	for i := 0; i < options.Workers; i++ {
+		pool.wait.Add(1)
+
+		go pool.work()
+	}
+
+	return pool
+}
+
+func (p *Pool) Submit(ctx context.Context, task Task) error {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	if p.closed {
+		return enums.ErrorPoolClosed
+	}
+
+	select {
+	case p.queue <- &queuedTask{ctx: context.WithoutCancel(ctx), task: task}:
+		p.metrics.queueLength.Inc()
+
+		return nil
+	case <-ctx.Done():
+		p.metrics.rejected()
+
+		return ctx.Err()
+	}
+}
+
+func (p *Pool) TrySubmit(ctx context.Context, task Task) error {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	if p.closed {
+		return enums.ErrorPoolClosed
+	}
+
+	select {
+	case p.queue <- &queuedTask{ctx: context.WithoutCancel(ctx), task: task}:
+		p.metrics.queueLength.Inc()
+
+		return nil
+	default:
+		p.metrics.rejected()
+
+		return enums.ErrorPoolFull
+	}
+}
+
+// Shutdown rejects new tasks and waits for the queued and running tasks to finish. When the context is done before,
+// the context of the running tasks is canceled and the remaining queued tasks are dropped.
+func (p *Pool) Shutdown(ctx context.Context) error {
+	p.mutex.Lock()
+	if !p.closed {
+		p.closed = true
+		close(p.queue)
+	}
+	p.mutex.Unlock()
+
+	drained := make(chan struct{})
+
+	go func() {
+		p.wait.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		p.cancel()
+
+		return nil
+	case <-ctx.Done():
+		p.cancel()
+		<-drained
+
+		return ctx.Err()
+	}
+}
+
+func (p *Pool) work() {
+	defer p.wait.Done()
+
+	for queued := range p.queue {
+		p.metrics.queueLength.Dec()
+
+		if p.ctx.Err() != nil {
+			continue
+		}
+
+		p.run(queued)
+	}
+}
+
+func (p *Pool) run(queued *queuedTask) {
+	p.metrics.activeWorkers.Inc()
+	defer p.metrics.activeWorkers.Dec()
+
+	ctx, cancel := p.taskContext(queued.ctx)
+	defer cancel()
+
+	start := time.Now()
+	err := runWithRecover(ctx, queued.task)
+
+	logger.LogError(enums.MessageTaskFailed, err, p.fields)
+	p.metrics.observe(start, err)
+}
+
+// taskContext keeps the values of the submit context, like the request id and trace span, and is canceled by the
+// task timeout or when the shutdown context is done before the pool is drained
+func (p *Pool) taskContext(parent context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(parent)
+	stop := context.AfterFunc(p.ctx, cancel)
+
+	if p.options.TaskTimeout > 0 {
+		var cancelTimeout context.CancelFunc
+
+		ctx, cancelTimeout = context.WithTimeout(ctx, p.options.TaskTimeout)
+
+		return ctx, func() {
+			cancelTimeout()
+			stop()
+			cancel()
+		}
+	}
+
+	return ctx, func() {
+		stop()
+		cancel()
+	}
+}
+
+func runWithRecover(ctx context.Context, task Task) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("%w: %v", enums.ErrorTaskPanic, recovered)
+		}
+	}()
+
+	return task(ctx)
+}
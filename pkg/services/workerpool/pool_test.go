@@ -0,0 +1,210 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workerpool
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/workerpool/enums"
+)
+
+type contextKey struct{}
+
+func TestNewOptions(t *testing.T) {
+	t.Run("should read options from env", func(t *testing.T) {
+		t.Setenv(enums.HorusecWorkerPoolWorkers, "2")
+		t.Setenv(enums.HorusecWorkerPoolQueueSize, "3")
+		t.Setenv(enums.HorusecWorkerPoolTaskTimeoutSeconds, "4")
+
+		options := NewOptions()
+
+		assert.Equal(t, 2, options.Workers)
+		assert.Equal(t, 3, options.QueueSize)
+		assert.Equal(t, 4*time.Second, options.TaskTimeout)
+	})
+}
+
+func TestSubmit(t *testing.T) {
+	t.Run("should run the tasks with bounded concurrency", func(t *testing.T) {
+		var running, maxRunning, done int32
+
+		pool := NewPool("test", &Options{Workers: 2, QueueSize: 10})
+
+		for i := 0; i < 10; i++ {
+			assert.NoError(t, pool.Submit(context.Background(), func(ctx context.Context) error {
+				current := atomic.AddInt32(&running, 1)
+				for {
+					previous := atomic.LoadInt32(&maxRunning)
+					if current <= previous || atomic.CompareAndSwapInt32(&maxRunning, previous, current) {
+						break
+					}
+				}
+
+				time.Sleep(10 * time.Millisecond)
+				atomic.AddInt32(&running, -1)
+				atomic.AddInt32(&done, 1)
+
+				return nil
+			}))
+		}
+
+		assert.NoError(t, pool.Shutdown(context.Background()))
+		assert.Equal(t, int32(10), atomic.LoadInt32(&done))
+		assert.Equal(t, int32(2), atomic.LoadInt32(&maxRunning))
+	})
+
+	t.Run("should keep the context values without the cancellation", func(t *testing.T) {
+		values := make(chan interface{}, 1)
+		pool := NewPool("test", &Options{Workers: 1, QueueSize: 1})
+
+		ctx, cancel := context.WithCancel(context.WithValue(context.Background(), contextKey{}, "test"))
+		assert.NoError(t, pool.Submit(ctx, func(ctx context.Context) error {
+			time.Sleep(10 * time.Millisecond)
+			values <- ctx.Value(contextKey{})
+
+			return ctx.Err()
+		}))
+		cancel()
+
+		assert.NoError(t, pool.Shutdown(context.Background()))
+		assert.Equal(t, "test", <-values)
+	})
+
+	t.Run("should return error when context is done before a free slot", func(t *testing.T) {
+		release := make(chan struct{})
+		pool := NewPool("test", &Options{Workers: 1, QueueSize: 0})
+
+		assert.NoError(t, pool.Submit(context.Background(), func(ctx context.Context) error {
+			<-release
+
+			return nil
+		}))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		assert.ErrorIs(t, pool.Submit(ctx, func(ctx context.Context) error { return nil }), context.DeadlineExceeded)
+
+		close(release)
+		assert.NoError(t, pool.Shutdown(context.Background()))
+	})
+
+	t.Run("should return error when pool is closed", func(t *testing.T) {
+		pool := NewPool("test", &Options{Workers: 1})
+		assert.NoError(t, pool.Shutdown(context.Background()))
+
+		assert.ErrorIs(t, pool.Submit(context.Background(), func(ctx context.Context) error { return nil }),
+			enums.ErrorPoolClosed)
+		assert.ErrorIs(t, pool.TrySubmit(context.Background(), func(ctx context.Context) error { return nil }),
+			enums.ErrorPoolClosed)
+	})
+
+	t.Run("should cancel the task context after the timeout and recover from panic", func(t *testing.T) {
+		errs := make(chan error, 1)
+		pool := NewPool("test", &Options{Workers: 1, QueueSize: 2, TaskTimeout: 10 * time.Millisecond})
+
+		assert.NoError(t, pool.Submit(context.Background(), func(ctx context.Context) error {
+			panic("test")
+		}))
+		assert.NoError(t, pool.Submit(context.Background(), func(ctx context.Context) error {
+			<-ctx.Done()
+			errs <- ctx.Err()
+
+			return ctx.Err()
+		}))
+
+		assert.NoError(t, pool.Shutdown(context.Background()))
+		assert.ErrorIs(t, <-errs, context.DeadlineExceeded)
+	})
+}
+
+func TestTrySubmit(t *testing.T) {
+	t.Run("should return error when queue is full", func(t *testing.T) {
+		release := make(chan struct{})
+		pool := NewPool("test", &Options{Workers: 1, QueueSize: 1})
+		task := func(ctx context.Context) error {
+			<-release
+
+			return nil
+		}
+
+		assert.NoError(t, pool.TrySubmit(context.Background(), task))
+		assert.Eventually(t, func() bool {
+			return pool.TrySubmit(context.Background(), task) == nil
+		}, time.Second, time.Millisecond)
+		assert.ErrorIs(t, pool.TrySubmit(context.Background(), task), enums.ErrorPoolFull)
+
+		close(release)
+		assert.NoError(t, pool.Shutdown(context.Background()))
+	})
+}
+
+func TestShutdown(t *testing.T) {
+	t.Run("should cancel running tasks and drop queued tasks when context is done", func(t *testing.T) {
+		var ran int32
+
+		errs := make(chan error, 1)
+		pool := NewPool("test", &Options{Workers: 1, QueueSize: 1})
+
+		assert.NoError(t, pool.Submit(context.Background(), func(ctx context.Context) error {
+			<-ctx.Done()
+			errs <- ctx.Err()
+
+			return ctx.Err()
+		}))
+		assert.NoError(t, pool.Submit(context.Background(), func(ctx context.Context) error {
+			atomic.AddInt32(&ran, 1)
+
+			return nil
+		}))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		assert.ErrorIs(t, pool.Shutdown(ctx), context.DeadlineExceeded)
+		assert.ErrorIs(t, <-errs, context.Canceled)
+		assert.Zero(t, atomic.LoadInt32(&ran))
+	})
+}
+
+func TestMock(t *testing.T) {
+	t.Run("should run the task when submit returns no error", func(t *testing.T) {
+		var ran bool
+
+		poolMock := &Mock{}
+		poolMock.On("Submit").Return(nil)
+		poolMock.On("TrySubmit").Return(errors.New("test"))
+
+		assert.Error(t, poolMock.TrySubmit(context.Background(), func(ctx context.Context) error {
+			ran = true
+
+			return nil
+		}))
+		assert.False(t, ran)
+
+		assert.NoError(t, poolMock.Submit(context.Background(), func(ctx context.Context) error {
+			ran = true
+
+			return nil
+		}))
+		assert.True(t, ran)
+	})
+}
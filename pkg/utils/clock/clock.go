@@ -0,0 +1,95 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clock
+
+import (
+	"time"
+)
+
+// IClock wraps the time functions, so the code that depends on time can be tested with a fake clock instead of
+// waiting the real durations
+type IClock interface {
+	Now() time.Time
+	Since(t time.Time) time.Duration
+	Until(t time.Time) time.Duration
+	After(d time.Duration) <-chan time.Time
+	AfterFunc(d time.Duration, f func()) ITimer
+	NewTicker(d time.Duration) ITicker
+}
+
+type ITimer interface {
+	Stop() bool
+}
+
+type ITicker interface {
+	C() <-chan time.Time
+	Reset(d time.Duration)
+	Stop()
+}
+
+type Clock struct{}
+
+func NewClock() IClock {
+	return &Clock{}
+}
+
+// OrDefault returns the real clock when the given one is nil, so the options without a clock keep working
+func OrDefault(clock IClock) IClock {
+	if clock == nil {
+		return NewClock()
+	}
+
+	return clock
+}
+
+func (c *Clock) Now() time.Time {
+	return time.Now()
+}
+
+func (c *Clock) Since(t time.Time) time.Duration {
+	return time.Since(t)
+}
+
+func (c *Clock) Until(t time.Time) time.Duration {
+	return time.Until(t)
+}
+
+func (c *Clock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+func (c *Clock) AfterFunc(d time.Duration, f func()) ITimer {
+	return time.AfterFunc(d, f)
+}
+
+func (c *Clock) NewTicker(d time.Duration) ITicker {
+	return &ticker{ticker: time.NewTicker(d)}
+}
+
+type ticker struct {
+	ticker *time.Ticker
+}
+
+func (t *ticker) C() <-chan time.Time {
+	return t.ticker.C
+}
+
+func (t *ticker) Reset(d time.Duration) {
+	t.ticker.Reset(d)
+}
+
+func (t *ticker) Stop() {
+	t.ticker.Stop()
+}
@@ -0,0 +1,134 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClock(t *testing.T) {
+	t.Run("should use the real time", func(t *testing.T) {
+		clock := NewClock()
+		start := clock.Now()
+
+		<-clock.After(time.Millisecond)
+
+		assert.GreaterOrEqual(t, clock.Since(start), time.Millisecond)
+		assert.Less(t, clock.Until(start), time.Duration(0))
+	})
+
+	t.Run("should call the function after the duration", func(t *testing.T) {
+		done := make(chan struct{})
+
+		NewClock().AfterFunc(time.Millisecond, func() {
+			close(done)
+		})
+
+		<-done
+	})
+
+	t.Run("should tick until stopped", func(t *testing.T) {
+		ticker := NewClock().NewTicker(time.Millisecond)
+		defer ticker.Stop()
+
+		<-ticker.C()
+		ticker.Reset(time.Millisecond)
+		<-ticker.C()
+	})
+
+	t.Run("should return the real clock when nil", func(t *testing.T) {
+		fake := NewFakeClock(time.Now())
+
+		assert.IsType(t, &Clock{}, OrDefault(nil))
+		assert.Equal(t, fake, OrDefault(fake))
+	})
+}
+
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("should only move when advanced", func(t *testing.T) {
+		clock := NewFakeClock(start)
+
+		clock.Advance(time.Hour)
+
+		assert.Equal(t, start.Add(time.Hour), clock.Now())
+		assert.Equal(t, time.Hour, clock.Since(start))
+		assert.Equal(t, time.Hour, clock.Until(start.Add(2*time.Hour)))
+	})
+
+	t.Run("should fire timers in the order of their deadlines", func(t *testing.T) {
+		clock := NewFakeClock(start)
+		calls := make([]int, 0)
+
+		clock.AfterFunc(2*time.Second, func() { calls = append(calls, 2) })
+		clock.AfterFunc(time.Second, func() { calls = append(calls, 1) })
+		after := clock.After(3 * time.Second)
+
+		clock.Advance(2 * time.Second)
+		assert.Equal(t, []int{1, 2}, calls)
+		assert.Len(t, after, 0)
+
+		clock.Advance(time.Second)
+		assert.Equal(t, start.Add(3*time.Second), <-after)
+		assert.Equal(t, 0, clock.Waiters())
+	})
+
+	t.Run("should not fire stopped timers", func(t *testing.T) {
+		clock := NewFakeClock(start)
+		timer := clock.AfterFunc(time.Second, func() { t.Fail() })
+
+		assert.True(t, timer.Stop())
+		assert.False(t, timer.Stop())
+
+		clock.Advance(time.Second)
+	})
+
+	t.Run("should tick on each period and drop ticks when the channel is full", func(t *testing.T) {
+		clock := NewFakeClock(start)
+		ticker := clock.NewTicker(time.Second)
+
+		clock.Advance(time.Second)
+		assert.Equal(t, start.Add(time.Second), <-ticker.C())
+
+		clock.Advance(3 * time.Second)
+		assert.Equal(t, start.Add(2*time.Second), <-ticker.C())
+		assert.Len(t, ticker.C(), 0)
+
+		ticker.Reset(time.Minute)
+		clock.Advance(time.Minute)
+		assert.Equal(t, start.Add(4*time.Second+time.Minute), <-ticker.C())
+
+		ticker.Stop()
+		assert.Equal(t, 0, clock.Waiters())
+	})
+
+	t.Run("should block until the waiters are added", func(t *testing.T) {
+		clock := NewFakeClock(start)
+		done := make(chan time.Time)
+
+		go func() {
+			done <- <-clock.After(time.Second)
+		}()
+
+		clock.BlockUntil(1)
+		clock.Advance(time.Second)
+
+		assert.Equal(t, start.Add(time.Second), <-done)
+	})
+}
@@ -0,0 +1,208 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clock
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// FakeClock only moves when Advance or Set is called, firing the timers and tickers that expired in the order of
+// their deadlines. It should only be used on tests.
+type FakeClock struct {
+	mutex   sync.Mutex
+	cond    *sync.Cond
+	now     time.Time
+	waiters []*fakeWaiter
+}
+
+type fakeWaiter struct {
+	clock    *FakeClock
+	deadline time.Time
+	period   time.Duration
+	channel  chan time.Time
+	function func()
+}
+
+func NewFakeClock(now time.Time) *FakeClock {
+	clock := &FakeClock{now: now}
+	clock.cond = sync.NewCond(&clock.mutex)
+
+	return clock
+}
+
+func (f *FakeClock) Now() time.Time {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return f.now
+}
+
+func (f *FakeClock) Since(t time.Time) time.Duration {
+	return f.Now().Sub(t)
+}
+
+func (f *FakeClock) Until(t time.Time) time.Duration {
+	return t.Sub(f.Now())
+}
+
+func (f *FakeClock) After(d time.Duration) <-chan time.Time {
+	return f.addWaiter(&fakeWaiter{deadline: f.Now().Add(d), channel: make(chan time.Time, 1)}).channel
+}
+
+func (f *FakeClock) AfterFunc(d time.Duration, function func()) ITimer {
+	return f.addWaiter(&fakeWaiter{deadline: f.Now().Add(d), function: function})
+}
+
+func (f *FakeClock) NewTicker(d time.Duration) ITicker {
+	return &fakeTicker{
+		waiter: f.addWaiter(&fakeWaiter{deadline: f.Now().Add(d), period: d, channel: make(chan time.Time, 1)}),
+	}
+}
+
+// Advance moves the clock forward by the duration, firing every timer and ticker until the new time
+func (f *FakeClock) Advance(d time.Duration) {
+	f.Set(f.Now().Add(d))
+}
+
+// Set moves the clock to the given time, the timers are fired without holding the lock, so the after functions
+// can use the clock
+func (f *FakeClock) Set(now time.Time) {
+	for {
+		f.mutex.Lock()
+
+		waiter := f.nextExpired(now)
+		if waiter == nil {
+			f.now = now
+			f.mutex.Unlock()
+
+			return
+		}
+
+		f.now = waiter.deadline
+		f.mutex.Unlock()
+
+		waiter.fire()
+	}
+}
+
+// BlockUntil waits until the given number of timers and tickers are waiting on the clock, it is used to advance the
+// clock only after a goroutine started to wait
+func (f *FakeClock) BlockUntil(waiters int) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for len(f.waiters) < waiters {
+		f.cond.Wait()
+	}
+}
+
+// Waiters returns the number of timers and tickers that are waiting on the clock
+func (f *FakeClock) Waiters() int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return len(f.waiters)
+}
+
+func (f *FakeClock) addWaiter(waiter *fakeWaiter) *fakeWaiter {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	waiter.clock = f
+	f.waiters = append(f.waiters, waiter)
+	f.cond.Broadcast()
+
+	return waiter
+}
+
+// nextExpired returns the expired waiter with the earliest deadline, removing the timers and moving the tickers to
+// their next deadline
+func (f *FakeClock) nextExpired(now time.Time) *fakeWaiter {
+	sort.SliceStable(f.waiters, func(i, j int) bool {
+		return f.waiters[i].deadline.Before(f.waiters[j].deadline)
+	})
+
+	if len(f.waiters) == 0 || f.waiters[0].deadline.After(now) {
+		return nil
+	}
+
+	waiter := f.waiters[0]
+	expired := &fakeWaiter{deadline: waiter.deadline, channel: waiter.channel, function: waiter.function}
+
+	if waiter.period > 0 {
+		waiter.deadline = waiter.deadline.Add(waiter.period)
+	} else {
+		f.waiters = f.waiters[1:]
+	}
+
+	return expired
+}
+
+func (f *FakeClock) removeWaiter(waiter *fakeWaiter) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for index, current := range f.waiters {
+		if current == waiter {
+			f.waiters = append(f.waiters[:index], f.waiters[index+1:]...)
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// fire drops the tick when the channel is full, like the tickers of the time package. The after functions run on
+// the goroutine that advanced the clock, so they are done when Advance returns.
+func (w *fakeWaiter) fire() {
+	if w.function != nil {
+		w.function()
+
+		return
+	}
+
+	select {
+	case w.channel <- w.deadline:
+	default:
+	}
+}
+
+func (w *fakeWaiter) Stop() bool {
+	return w.clock.removeWaiter(w)
+}
+
+type fakeTicker struct {
+	waiter *fakeWaiter
+}
+
+func (t *fakeTicker) C() <-chan time.Time {
+	return t.waiter.channel
+}
+
+// Reset changes the period of the ticker starting from the current time of the clock, a stopped ticker starts again
+func (t *fakeTicker) Reset(d time.Duration) {
+	clock := t.waiter.clock
+	clock.removeWaiter(t.waiter)
+
+	t.waiter.deadline, t.waiter.period = clock.Now().Add(d), d
+	clock.addWaiter(t.waiter)
+}
+
+func (t *fakeTicker) Stop() {
+	t.waiter.clock.removeWaiter(t.waiter)
+}
@@ -0,0 +1,128 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/config/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+type fieldVisitor func(field reflect.Value, fieldTag *tag)
+
+// Load populates the struct pointed by target from the env tags, like `env:"HORUSEC_PORT,default=8000,required"`.
+// Nested structs and pointers to structs without a tag are loaded recursively, and every missing or invalid variable
+// is returned at once as an env.ValidationError
+func Load(target interface{}) error {
+	return LoadWithFile("", target)
+}
+
+// LoadWithFile works like Load, but the values of the yaml or json file are applied between the tag defaults and the
+// env variables, so the env always wins. When the path is empty the HORUSEC_CONFIG_FILE env is used, and the file is
+// optional when none of them are informed
+func LoadWithFile(path string, target interface{}) error {
+	return LoadWithSecrets(path, target, nil)
+}
+
+// LoadWithSecrets works like LoadWithFile and then replaces the string values with a secret://path#key reference by
+// the secret read from the provider, so the passwords and keys do not need to be in the env or in the config file
+func LoadWithSecrets(path string, target interface{}, provider ISecretsProvider) error {
+	value := reflect.ValueOf(target)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return enums.ErrorInvalidTarget
+	}
+
+	problems := map[string]error{}
+	walkStruct(value.Elem(), applyDefault(problems))
+
+	if err := loadFile(getFilePath(path), target); err != nil {
+		return err
+	}
+
+	walkStruct(value.Elem(), applyEnv(problems))
+	walkStruct(value.Elem(), applySecrets(provider, problems))
+
+	if len(problems) > 0 {
+		return &env.ValidationError{Errors: problems}
+	}
+
+	return nil
+}
+
+func walkStruct(value reflect.Value, visitor fieldVisitor) {
+	for index := 0; index < value.NumField(); index++ {
+		field, structField := value.Field(index), value.Type().Field(index)
+		if !field.CanSet() {
+			continue
+		}
+
+		tagValue, ok := structField.Tag.Lookup(enums.TagName)
+		if !ok {
+			walkNested(field, visitor)
+
+			continue
+		}
+
+		if tagValue != "-" {
+			visitor(field, parseTag(tagValue))
+		}
+	}
+}
+
+func walkNested(field reflect.Value, visitor fieldVisitor) {
+	switch {
+	case field.Kind() == reflect.Struct:
+		walkStruct(field, visitor)
+	case field.Kind() == reflect.Ptr && field.Type().Elem().Kind() == reflect.Struct:
+		if field.IsNil() {
+			field.Set(reflect.New(field.Type().Elem()))
+		}
+
+		walkStruct(field.Elem(), visitor)
+	}
+}
+
+func applyDefault(problems map[string]error) fieldVisitor {
+	return func(field reflect.Value, fieldTag *tag) {
+		if !fieldTag.hasDefault {
+			return
+		}
+
+		if err := setValue(field, fieldTag.defaultValue); err != nil {
+			problems[fieldTag.name] = enums.ErrorInvalidDefaultTag
+		}
+	}
+}
+
+// applyEnv only reports a required variable as missing when it was not informed by the config file either
+func applyEnv(problems map[string]error) fieldVisitor {
+	return func(field reflect.Value, fieldTag *tag) {
+		value := strings.TrimSpace(os.Getenv(fieldTag.name))
+		if value != "" {
+			if err := setValue(field, value); err != nil {
+				problems[fieldTag.name] = err
+			}
+
+			return
+		}
+
+		if fieldTag.required && field.IsZero() {
+			problems[fieldTag.name] = enums.ErrorRequiredFieldValue
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/config/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+type testDatabase struct {
+	URI     string        `env:"TEST_DATABASE_URI,required"`
+	Timeout time.Duration `env:"TEST_DATABASE_TIMEOUT,default=5s"`
+}
+
+type testBroker struct {
+	Port uint16 `env:"TEST_BROKER_PORT,default=5672"`
+}
+
+type testConfig struct {
+	Port     int      `env:"TEST_PORT,default=8000"`
+	Debug    bool     `env:"TEST_DEBUG"`
+	Ratio    float64  `env:"TEST_RATIO,default=0.5"`
+	Origins  []string `env:"TEST_ORIGINS,default=a,b"`
+	Name     *string  `env:"TEST_NAME"`
+	Ignored  string   `env:"-"`
+	Database testDatabase
+	Broker   *testBroker
+	private  string
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("should load env values, defaults and nested structs", func(t *testing.T) {
+		t.Setenv("TEST_DATABASE_URI", "postgresql://localhost")
+		t.Setenv("TEST_DEBUG", "true")
+		t.Setenv("TEST_NAME", "test")
+		t.Setenv("TEST_DATABASE_TIMEOUT", "30")
+
+		config := &testConfig{}
+
+		assert.NoError(t, Load(config))
+		assert.Equal(t, 8000, config.Port)
+		assert.True(t, config.Debug)
+		assert.Equal(t, 0.5, config.Ratio)
+		assert.Equal(t, []string{"a", "b"}, config.Origins)
+		assert.Equal(t, "test", *config.Name)
+		assert.Equal(t, "postgresql://localhost", config.Database.URI)
+		assert.Equal(t, 30*time.Second, config.Database.Timeout)
+		assert.Equal(t, uint16(5672), config.Broker.Port)
+		assert.Empty(t, config.private)
+	})
+
+	t.Run("should return every missing and invalid variable", func(t *testing.T) {
+		t.Setenv("TEST_PORT", "test")
+		t.Setenv("TEST_BROKER_PORT", "70000")
+
+		err := Load(&testConfig{})
+
+		assert.ErrorIs(t, err, enums.ErrorRequiredFieldValue)
+		assert.ErrorIs(t, err, enums.ErrorInvalidFieldValue)
+		assert.Len(t, err.(*env.ValidationError).Errors, 3)
+	})
+
+	t.Run("should return error when target is not a struct pointer", func(t *testing.T) {
+		assert.ErrorIs(t, Load(testConfig{}), enums.ErrorInvalidTarget)
+		assert.ErrorIs(t, Load((*testConfig)(nil)), enums.ErrorInvalidTarget)
+	})
+
+	t.Run("should return error when type is not supported or default is invalid", func(t *testing.T) {
+		assert.ErrorIs(t, Load(&struct {
+			Value map[string]string `env:"TEST_MAP,default=test"`
+		}{}), enums.ErrorInvalidDefaultTag)
+
+		t.Setenv("TEST_MAP", "test")
+		assert.ErrorIs(t, Load(&struct {
+			Value map[string]string `env:"TEST_MAP"`
+		}{}), enums.ErrorUnsupportedType)
+	})
+}
+
+func TestParseTag(t *testing.T) {
+	t.Run("should parse name, default and required", func(t *testing.T) {
+		result := parseTag("TEST,default=a,b,required")
+
+		assert.Equal(t, "TEST", result.name)
+		assert.Equal(t, "a,b", result.defaultValue)
+		assert.True(t, result.required)
+	})
+}
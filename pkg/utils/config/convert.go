@@ -0,0 +1,170 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/config/enums"
+)
+
+// nolint:gochecknoglobals // types with a custom conversion, checked before the kind
+var (
+	durationType = reflect.TypeOf(time.Duration(0))
+	urlType      = reflect.TypeOf(url.URL{})
+)
+
+func setValue(field reflect.Value, value string) error {
+	switch field.Type() {
+	case durationType:
+		return setDuration(field, value)
+	case urlType:
+		return setURL(field, value)
+	}
+
+	return setValueByKind(field, value)
+}
+
+func setValueByKind(field reflect.Value, value string) error {
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Bool:
+		return setBool(field, value)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return setInt(field, value)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return setUint(field, value)
+	case reflect.Float32, reflect.Float64:
+		return setFloat(field, value)
+	case reflect.Slice:
+		return setSlice(field, value)
+	case reflect.Ptr:
+		return setPointer(field, value)
+	default:
+		return enums.ErrorUnsupportedType
+	}
+
+	return nil
+}
+
+func setBool(field reflect.Value, value string) error {
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return enums.ErrorInvalidFieldValue
+	}
+
+	field.SetBool(parsed)
+
+	return nil
+}
+
+func setInt(field reflect.Value, value string) error {
+	parsed, err := strconv.ParseInt(value, 10, field.Type().Bits())
+	if err != nil {
+		return enums.ErrorInvalidFieldValue
+	}
+
+	field.SetInt(parsed)
+
+	return nil
+}
+
+func setUint(field reflect.Value, value string) error {
+	parsed, err := strconv.ParseUint(value, 10, field.Type().Bits())
+	if err != nil {
+		return enums.ErrorInvalidFieldValue
+	}
+
+	field.SetUint(parsed)
+
+	return nil
+}
+
+func setFloat(field reflect.Value, value string) error {
+	parsed, err := strconv.ParseFloat(value, field.Type().Bits())
+	if err != nil {
+		return enums.ErrorInvalidFieldValue
+	}
+
+	field.SetFloat(parsed)
+
+	return nil
+}
+
+// setDuration accepts integers as seconds, like the env getters, to keep the variables ending with _SECONDS
+func setDuration(field reflect.Value, value string) error {
+	if seconds, err := strconv.Atoi(value); err == nil {
+		field.SetInt(int64(time.Duration(seconds) * time.Second))
+
+		return nil
+	}
+
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		return enums.ErrorInvalidFieldValue
+	}
+
+	field.SetInt(int64(parsed))
+
+	return nil
+}
+
+func setURL(field reflect.Value, value string) error {
+	parsed, err := url.Parse(value)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return enums.ErrorInvalidFieldValue
+	}
+
+	field.Set(reflect.ValueOf(*parsed))
+
+	return nil
+}
+
+func setSlice(field reflect.Value, value string) error {
+	var items []string
+
+	for _, item := range strings.Split(value, enums.SliceSeparator) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	slice := reflect.MakeSlice(field.Type(), len(items), len(items))
+	for index, item := range items {
+		if err := setValue(slice.Index(index), item); err != nil {
+			return err
+		}
+	}
+
+	field.Set(slice)
+
+	return nil
+}
+
+func setPointer(field reflect.Value, value string) error {
+	pointer := reflect.New(field.Type().Elem())
+	if err := setValue(pointer.Elem(), value); err != nil {
+		return err
+	}
+
+	field.Set(pointer)
+
+	return nil
+}
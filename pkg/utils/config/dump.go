@@ -0,0 +1,117 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/config/enums"
+)
+
+// nolint:gochecknoglobals // names that are always masked, even without the secret option in the env tag
+var secretNames = []string{"PASSWORD", "SECRET", "TOKEN", "PRIVATE_KEY"}
+
+// DumpEffectiveConfig writes the loaded config as yaml keyed by the env variable names, which helps operators to check
+// the result of the defaults, file and env merge. Fields with the secret option or a secret like name are masked
+func DumpEffectiveConfig(writer io.Writer, target interface{}) error {
+	value := reflect.ValueOf(target)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return enums.ErrorInvalidTarget
+	}
+
+	effective := map[string]string{}
+
+	walkStruct(value.Elem(), func(field reflect.Value, fieldTag *tag) {
+		if effective[fieldTag.name] = formatValue(field, true); effective[fieldTag.name] != "" && isSecret(fieldTag) {
+			effective[fieldTag.name] = enums.MaskedSecret
+		}
+	})
+
+	encoder := yaml.NewEncoder(writer)
+	defer encoder.Close()
+
+	return encoder.Encode(effective)
+}
+
+// GetValues returns the loaded config keyed by the env variable names without masking the secrets, so it can be used
+// to compare two loads of the same config
+func GetValues(target interface{}) (map[string]string, error) {
+	value := reflect.ValueOf(target)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return nil, enums.ErrorInvalidTarget
+	}
+
+	values := map[string]string{}
+
+	walkStruct(value.Elem(), func(field reflect.Value, fieldTag *tag) {
+		values[fieldTag.name] = formatValue(field, false)
+	})
+
+	return values, nil
+}
+
+// formatValue redacts the password of urls when the value is going to be shown
+func formatValue(field reflect.Value, redact bool) string {
+	if field.IsZero() {
+		return ""
+	}
+
+	if field.Kind() == reflect.Ptr {
+		field = field.Elem()
+	}
+
+	switch value := field.Interface().(type) {
+	case time.Duration:
+		return value.String()
+	case url.URL:
+		if redact {
+			return value.Redacted()
+		}
+
+		return value.String()
+	}
+
+	if field.Kind() == reflect.Slice {
+		items := make([]string, 0, field.Len())
+		for index := 0; index < field.Len(); index++ {
+			items = append(items, fmt.Sprint(field.Index(index).Interface()))
+		}
+
+		return strings.Join(items, enums.SliceSeparator)
+	}
+
+	return fmt.Sprint(field.Interface())
+}
+
+func isSecret(fieldTag *tag) bool {
+	if fieldTag.secret {
+		return true
+	}
+
+	for _, name := range secretNames {
+		if strings.Contains(strings.ToUpper(fieldTag.name), name) {
+			return true
+		}
+	}
+
+	return false
+}
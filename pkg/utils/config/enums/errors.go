@@ -0,0 +1,28 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+import "errors"
+
+var (
+	ErrorInvalidTarget          = errors.New("{ERROR_CONFIG} config target must be a non nil pointer to a struct")
+	ErrorUnsupportedType        = errors.New("{ERROR_CONFIG} field type is not supported by env tags")
+	ErrorInvalidFieldValue      = errors.New("{ERROR_CONFIG} value can not be converted to the field type")
+	ErrorInvalidDefaultTag      = errors.New("{ERROR_CONFIG} default value of env tag is invalid")
+	ErrorRequiredFieldValue     = errors.New("{ERROR_CONFIG} required variable is not set")
+	ErrorUnsupportedFileType    = errors.New("{ERROR_CONFIG} config file must have the yaml, yml or json extension")
+	ErrorInvalidSecretReference = errors.New("{ERROR_CONFIG} secret reference must be like secret://path#key")
+	ErrorSecretsProviderMissing = errors.New("{ERROR_CONFIG} secret reference found without a secrets provider")
+)
@@ -0,0 +1,55 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/config/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+func getFilePath(path string) string {
+	if path != "" {
+		return path
+	}
+
+	return env.GetEnvOrDefault(enums.HorusecConfigFile, "")
+}
+
+func loadFile(path string, target interface{}) error {
+	if path == "" {
+		return nil
+	}
+
+	content, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return yaml.Unmarshal(content, target)
+	case ".json":
+		return json.Unmarshal(content, target)
+	default:
+		return enums.ErrorUnsupportedFileType
+	}
+}
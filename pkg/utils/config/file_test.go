@@ -0,0 +1,94 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/config/enums"
+)
+
+type testFileConfig struct {
+	Port     int           `env:"TEST_FILE_PORT,default=8000" yaml:"port" json:"port"`
+	URI      string        `env:"TEST_FILE_URI,required" yaml:"uri" json:"uri"`
+	Timeout  time.Duration `env:"TEST_FILE_TIMEOUT,default=5s" yaml:"timeout"`
+	Password string        `env:"TEST_FILE_PASSWORD" yaml:"password"`
+	Key      string        `env:"TEST_FILE_KEY,secret" yaml:"key"`
+	Origins  []string      `env:"TEST_FILE_ORIGINS" yaml:"origins"`
+}
+
+func writeConfigFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+func TestLoadWithFile(t *testing.T) {
+	t.Run("should load yaml file over defaults and env over file", func(t *testing.T) {
+		path := writeConfigFile(t, "config.yaml", "port: 9000\nuri: file\ntimeout: 1m\n")
+		t.Setenv("TEST_FILE_URI", "env")
+
+		config := &testFileConfig{}
+
+		assert.NoError(t, LoadWithFile(path, config))
+		assert.Equal(t, 9000, config.Port)
+		assert.Equal(t, "env", config.URI)
+		assert.Equal(t, time.Minute, config.Timeout)
+	})
+
+	t.Run("should load json file from env path and accept required value from file", func(t *testing.T) {
+		t.Setenv(enums.HorusecConfigFile, writeConfigFile(t, "config.json", `{"uri": "file"}`))
+
+		config := &testFileConfig{}
+
+		assert.NoError(t, Load(config))
+		assert.Equal(t, "file", config.URI)
+		assert.Equal(t, 8000, config.Port)
+	})
+
+	t.Run("should return error when file is invalid", func(t *testing.T) {
+		assert.ErrorIs(t, LoadWithFile(writeConfigFile(t, "config.toml", ""), &testFileConfig{}),
+			enums.ErrorUnsupportedFileType)
+		assert.Error(t, LoadWithFile(writeConfigFile(t, "config.yaml", "port: test"), &testFileConfig{}))
+		assert.Error(t, LoadWithFile(filepath.Join(t.TempDir(), "config.yaml"), &testFileConfig{}))
+	})
+}
+
+func TestDumpEffectiveConfig(t *testing.T) {
+	t.Run("should write effective config with secrets masked", func(t *testing.T) {
+		output := bytes.NewBufferString("")
+		config := &testFileConfig{Port: 8000, URI: "uri", Timeout: time.Second, Password: "test", Key: "test",
+			Origins: []string{"a", "b"}}
+
+		assert.NoError(t, DumpEffectiveConfig(output, config))
+		assert.Contains(t, output.String(), "TEST_FILE_PORT: \"8000\"")
+		assert.Contains(t, output.String(), "TEST_FILE_TIMEOUT: 1s")
+		assert.Contains(t, output.String(), "TEST_FILE_ORIGINS: a,b")
+		assert.Contains(t, output.String(), "TEST_FILE_PASSWORD: '******'")
+		assert.Contains(t, output.String(), "TEST_FILE_KEY: '******'")
+		assert.NotContains(t, output.String(), "test")
+	})
+
+	t.Run("should return error when target is invalid", func(t *testing.T) {
+		assert.ErrorIs(t, DumpEffectiveConfig(bytes.NewBufferString(""), testFileConfig{}), enums.ErrorInvalidTarget)
+	})
+}
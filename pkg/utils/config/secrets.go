@@ -0,0 +1,73 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/config/enums"
+)
+
+// ISecretsProvider is implemented by the providers of the secrets service, it is declared here to resolve the secret
+// references without depending on the services
+type ISecretsProvider interface {
+	GetSecret(ctx context.Context, path, key string) (string, error)
+}
+
+func applySecrets(provider ISecretsProvider, problems map[string]error) fieldVisitor {
+	return func(field reflect.Value, fieldTag *tag) {
+		if field.Kind() == reflect.Ptr && !field.IsNil() {
+			field = field.Elem()
+		}
+
+		if field.Kind() != reflect.String || !strings.HasPrefix(field.String(), enums.SecretReferencePrefix) {
+			return
+		}
+
+		secret, err := resolveSecret(provider, field.String())
+		if err != nil {
+			problems[fieldTag.name] = err
+
+			return
+		}
+
+		field.SetString(secret)
+	}
+}
+
+func resolveSecret(provider ISecretsProvider, reference string) (string, error) {
+	if provider == nil {
+		return "", enums.ErrorSecretsProviderMissing
+	}
+
+	path, key, err := parseSecretReference(reference)
+	if err != nil {
+		return "", err
+	}
+
+	return provider.GetSecret(context.Background(), path, key)
+}
+
+func parseSecretReference(reference string) (path, key string, err error) {
+	parts := strings.SplitN(strings.TrimPrefix(reference, enums.SecretReferencePrefix),
+		enums.SecretReferenceSeparator, 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", enums.ErrorInvalidSecretReference
+	}
+
+	return parts[0], parts[1], nil
+}
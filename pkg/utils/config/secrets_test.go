@@ -0,0 +1,77 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/config/enums"
+)
+
+type secretsProviderStub struct {
+	secrets map[string]string
+}
+
+func (s *secretsProviderStub) GetSecret(_ context.Context, path, key string) (string, error) {
+	if secret, ok := s.secrets[path+"#"+key]; ok {
+		return secret, nil
+	}
+
+	return "", errors.New("test")
+}
+
+type testSecretsConfig struct {
+	Password string  `env:"TEST_SECRETS_PASSWORD"`
+	JWTKey   *string `env:"TEST_SECRETS_JWT_KEY"`
+	Port     int     `env:"TEST_SECRETS_PORT,default=8000"`
+}
+
+func TestLoadWithSecrets(t *testing.T) {
+	provider := &secretsProviderStub{secrets: map[string]string{
+		"horusec/database#password": "database-password",
+		"horusec/jwt#key":           "jwt-key",
+	}}
+
+	t.Run("should resolve secret references", func(t *testing.T) {
+		t.Setenv("TEST_SECRETS_PASSWORD", "secret://horusec/database#password")
+		t.Setenv("TEST_SECRETS_JWT_KEY", "secret://horusec/jwt#key")
+
+		config := &testSecretsConfig{}
+
+		assert.NoError(t, LoadWithSecrets("", config, provider))
+		assert.Equal(t, "database-password", config.Password)
+		assert.Equal(t, "jwt-key", *config.JWTKey)
+	})
+
+	t.Run("should return error when reference is invalid or secret is not found", func(t *testing.T) {
+		t.Setenv("TEST_SECRETS_PASSWORD", "secret://horusec/database")
+		t.Setenv("TEST_SECRETS_JWT_KEY", "secret://horusec/jwt#test")
+
+		err := LoadWithSecrets("", &testSecretsConfig{}, provider)
+
+		assert.ErrorIs(t, err, enums.ErrorInvalidSecretReference)
+		assert.Contains(t, err.Error(), "TEST_SECRETS_JWT_KEY")
+	})
+
+	t.Run("should return error when there is no provider", func(t *testing.T) {
+		t.Setenv("TEST_SECRETS_PASSWORD", "secret://horusec/database#password")
+
+		assert.ErrorIs(t, Load(&testSecretsConfig{}), enums.ErrorSecretsProviderMissing)
+	})
+}
@@ -0,0 +1,51 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/config/enums"
+)
+
+type tag struct {
+	name         string
+	defaultValue string
+	hasDefault   bool
+	required     bool
+	secret       bool
+}
+
+// parseTag reads tags like `env:"NAME,default=a,b,required,secret"`, the items after the default without a known option
+// are part of the default value, so slices can have a default with more than one item
+func parseTag(value string) *tag {
+	parts := strings.Split(value, enums.TagSeparator)
+	result := &tag{name: strings.TrimSpace(parts[0])}
+
+	for _, part := range parts[1:] {
+		switch {
+		case part == enums.TagOptionRequire:
+			result.required = true
+		case part == enums.TagOptionSecret:
+			result.secret = true
+		case strings.HasPrefix(part, enums.TagOptionDefault):
+			result.defaultValue, result.hasDefault = strings.TrimPrefix(part, enums.TagOptionDefault), true
+		case result.hasDefault:
+			result.defaultValue += enums.TagSeparator + part
+		}
+	}
+
+	return result
+}
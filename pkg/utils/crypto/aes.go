@@ -0,0 +1,73 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"io"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto/enums"
+)
+
+// EncryptAESGCM encrypts the plaintext using a random nonce, which is prepended to the returned ciphertext.
+func EncryptAESGCM(key, plaintext []byte) ([]byte, error) {
+	return EncryptAESGCMWithData(key, plaintext, nil)
+}
+
+// EncryptAESGCMWithData also authenticates the associated data, which is not encrypted but must be the same on the
+// decryption, binding the ciphertext to a context like a table column or a key id.
+func EncryptAESGCMWithData(key, plaintext, associatedData []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	return gcm.Seal(nonce, nonce, plaintext, associatedData), nil
+}
+
+func DecryptAESGCM(key, ciphertext []byte) ([]byte, error) {
+	return DecryptAESGCMWithData(key, ciphertext, nil)
+}
+
+func DecryptAESGCMWithData(key, ciphertext, associatedData []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, enums.ErrorCiphertextTooShort
+	}
+
+	nonce, sealed := ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():]
+
+	return gcm.Open(nil, nonce, sealed, associatedData)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, enums.ErrorInvalidKeySize
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,72 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto/enums"
+)
+
+var testKey = []byte("0123456789abcdef0123456789abcdef")
+
+func TestEncryptAESGCM(t *testing.T) {
+	t.Run("should encrypt and decrypt with the same key", func(t *testing.T) {
+		ciphertext, err := EncryptAESGCM(testKey, []byte("test"))
+		assert.NoError(t, err)
+
+		plaintext, err := DecryptAESGCM(testKey, ciphertext)
+		assert.NoError(t, err)
+		assert.Equal(t, "test", string(plaintext))
+	})
+
+	t.Run("should generate different ciphertexts for the same plaintext", func(t *testing.T) {
+		first, _ := EncryptAESGCM(testKey, []byte("test"))
+		second, _ := EncryptAESGCM(testKey, []byte("test"))
+
+		assert.NotEqual(t, first, second)
+	})
+
+	t.Run("should return error when invalid key size", func(t *testing.T) {
+		_, err := EncryptAESGCM([]byte("test"), []byte("test"))
+
+		assert.ErrorIs(t, err, enums.ErrorInvalidKeySize)
+	})
+}
+
+func TestDecryptAESGCM(t *testing.T) {
+	t.Run("should return error when ciphertext was tampered", func(t *testing.T) {
+		ciphertext, _ := EncryptAESGCM(testKey, []byte("test"))
+		ciphertext[len(ciphertext)-1] ^= 1
+
+		_, err := DecryptAESGCM(testKey, ciphertext)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when ciphertext is too short", func(t *testing.T) {
+		_, err := DecryptAESGCM(testKey, []byte("test"))
+
+		assert.ErrorIs(t, err, enums.ErrorCiphertextTooShort)
+	})
+
+	t.Run("should return error when invalid key size", func(t *testing.T) {
+		_, err := DecryptAESGCM([]byte("test"), []byte("test"))
+
+		assert.ErrorIs(t, err, enums.ErrorInvalidKeySize)
+	})
+}
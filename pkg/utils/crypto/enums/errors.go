@@ -0,0 +1,34 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+import "errors"
+
+var (
+	ErrorInvalidKeySize         = errors.New("{ERROR_CRYPTO} encryption key must have 16, 24 or 32 bytes")
+	ErrorCiphertextTooShort     = errors.New("{ERROR_CRYPTO} ciphertext is shorter than the nonce size")
+	ErrorUnknownHashAlgorithm   = errors.New("{ERROR_CRYPTO} password hash algorithm is unknown")
+	ErrorInvalidHash            = errors.New("{ERROR_CRYPTO} password hash is invalid")
+	ErrorInvalidSignatureHeader = errors.New("{ERROR_CRYPTO} signature header is missing or invalid")
+	ErrorSignatureMismatch      = errors.New("{ERROR_CRYPTO} signature does not match the payload")
+	ErrorSignatureExpired       = errors.New("{ERROR_CRYPTO} signature timestamp is outside the tolerance")
+	ErrorEmptyKeyring           = errors.New("{ERROR_CRYPTO} keyring must have at least one key")
+	ErrorInvalidKeyID           = errors.New("{ERROR_CRYPTO} key id must not be empty or contain ':' or ','")
+	ErrorPrimaryKeyNotFound     = errors.New("{ERROR_CRYPTO} primary key id is not in the keyring")
+	ErrorKeyNotFound            = errors.New("{ERROR_CRYPTO} key used to encrypt the value is not in the keyring")
+	ErrorInvalidEncryptedValue  = errors.New("{ERROR_CRYPTO} encrypted value format is invalid")
+	ErrorKeyUsageLimitReached   = errors.New("{ERROR_CRYPTO} primary key reached its encryption limit, rotate it")
+	ErrorInvalidRandomLength    = errors.New("{ERROR_CRYPTO} random length must be greater than zero")
+)
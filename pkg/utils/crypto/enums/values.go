@@ -0,0 +1,62 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+import "time"
+
+const (
+	HorusecPasswordHashAlgorithm = "HORUSEC_PASSWORD_HASH_ALGORITHM"
+	HorusecPasswordBcryptCost    = "HORUSEC_PASSWORD_BCRYPT_COST"
+	HorusecPasswordArgon2Time    = "HORUSEC_PASSWORD_ARGON2_TIME"
+	HorusecPasswordArgon2Memory  = "HORUSEC_PASSWORD_ARGON2_MEMORY"
+	HorusecPasswordArgon2Threads = "HORUSEC_PASSWORD_ARGON2_THREADS"
+
+	AlgorithmArgon2id = "argon2id"
+	AlgorithmBcrypt   = "bcrypt"
+
+	DefaultBcryptCost      = 12
+	DefaultArgon2Time      = 3
+	DefaultArgon2Memory    = 64 * 1024
+	DefaultArgon2Threads   = 4
+	DefaultArgon2KeyLength = 32
+	DefaultSaltLength      = 16
+
+	Argon2HashFormat = "$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s"
+	Argon2Params     = "m=%d,t=%d,p=%d"
+	Argon2HashParts  = 6
+
+	SignatureHeader           = "X-Horusec-Signature"
+	SignatureVersion          = "v1"
+	SignatureTimestamp        = "t"
+	SignatureHeaderFormat     = "t=%d,v1=%s"
+	DefaultSignatureTolerance = 5 * time.Minute
+
+	HorusecEncryptionKeys         = "HORUSEC_ENCRYPTION_KEYS"
+	HorusecEncryptionPrimaryKeyID = "HORUSEC_ENCRYPTION_PRIMARY_KEY_ID"
+	EncryptedValueVersion         = "v1"
+	EncryptedValueSeparator       = ":"
+	EncryptedValueParts           = 3
+	KeyringEntrySeparator         = ","
+	MaxEncryptionsPerKey          = 1 << 32
+
+	DefaultTokenLength   = 32
+	DefaultCodeDigits    = 6
+	MaxCodeDigits        = 18
+	APIKeyPrefix         = "hsc"
+	APIKeySeparator      = "_"
+	APIKeyRandomLength   = 30
+	APIKeyChecksumLength = 6
+	Base62Alphabet       = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+)
@@ -0,0 +1,162 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"encoding/base64"
+	"strings"
+	"sync/atomic"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// Keyring encrypts with the primary key and decrypts with any of its keys, so a key can be rotated by adding the new
+// key as primary and keeping the old one until every value is encrypted again. The key id is stored with the value
+// and authenticated with the associated data, so a value can not be moved to another key or context unnoticed.
+type Keyring struct {
+	primaryID   string
+	keys        map[string][]byte
+	encryptions uint64
+}
+
+func NewKeyring(primaryID string, keys map[string][]byte) (*Keyring, error) {
+	if len(keys) == 0 {
+		return nil, enums.ErrorEmptyKeyring
+	}
+
+	for id, key := range keys {
+		if id == "" || strings.ContainsAny(id, enums.EncryptedValueSeparator+enums.KeyringEntrySeparator) {
+			return nil, enums.ErrorInvalidKeyID
+		}
+
+		if _, err := newGCM(key); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, ok := keys[primaryID]; !ok {
+		return nil, enums.ErrorPrimaryKeyNotFound
+	}
+
+	return &Keyring{primaryID: primaryID, keys: keys}, nil
+}
+
+// NewKeyringFromEnv reads the keys as id:base64key entries separated by comma, like "2021:a2V5,2022:a2V5", using
+// the primary key id env or the last key when it is empty
+func NewKeyringFromEnv() (*Keyring, error) {
+	return ParseKeyring(env.GetEnvOrDefault(enums.HorusecEncryptionPrimaryKeyID, ""),
+		env.GetEnvOrDefault(enums.HorusecEncryptionKeys, ""))
+}
+
+func ParseKeyring(primaryID, value string) (*Keyring, error) {
+	keys, lastID := map[string][]byte{}, ""
+
+	for _, entry := range strings.Split(value, enums.KeyringEntrySeparator) {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+
+		id, encoded, found := strings.Cut(strings.TrimSpace(entry), enums.EncryptedValueSeparator)
+		if !found {
+			return nil, enums.ErrorInvalidKeyID
+		}
+
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, err
+		}
+
+		keys[id], lastID = key, id
+	}
+
+	if primaryID == "" {
+		primaryID = lastID
+	}
+
+	return NewKeyring(primaryID, keys)
+}
+
+func (k *Keyring) PrimaryKeyID() string {
+	return k.primaryID
+}
+
+// Encrypt returns the value as v1:keyID:base64, where the base64 has the random nonce and the ciphertext. The nonce
+// is always generated from crypto/rand and each key is limited to 2^32 encryptions on this process, keeping the
+// chance of a repeated nonce negligible.
+func (k *Keyring) Encrypt(plaintext, associatedData []byte) (string, error) {
+	if atomic.AddUint64(&k.encryptions, 1) > enums.MaxEncryptionsPerKey {
+		return "", enums.ErrorKeyUsageLimitReached
+	}
+
+	prefix := enums.EncryptedValueVersion + enums.EncryptedValueSeparator + k.primaryID +
+		enums.EncryptedValueSeparator
+
+	ciphertext, err := EncryptAESGCMWithData(k.keys[k.primaryID], plaintext, append([]byte(prefix),
+		associatedData...))
+	if err != nil {
+		return "", err
+	}
+
+	return prefix + base64.RawStdEncoding.EncodeToString(ciphertext), nil
+}
+
+// Decrypt opens the value with the key that encrypted it, failing when the associated data is not the same
+func (k *Keyring) Decrypt(value string, associatedData []byte) ([]byte, error) {
+	keyID, ciphertext, err := parseEncryptedValue(value)
+	if err != nil {
+		return nil, err
+	}
+
+	key, ok := k.keys[keyID]
+	if !ok {
+		return nil, enums.ErrorKeyNotFound
+	}
+
+	prefix := enums.EncryptedValueVersion + enums.EncryptedValueSeparator + keyID + enums.EncryptedValueSeparator
+
+	return DecryptAESGCMWithData(key, ciphertext, append([]byte(prefix), associatedData...))
+}
+
+// NeedsReencrypt returns true when the value was not encrypted with the primary key
+func (k *Keyring) NeedsReencrypt(value string) bool {
+	keyID, _, err := parseEncryptedValue(value)
+
+	return err != nil || keyID != k.primaryID
+}
+
+// Reencrypt decrypts the value and encrypts it again with the primary key, used by rotation jobs
+func (k *Keyring) Reencrypt(value string, associatedData []byte) (string, error) {
+	plaintext, err := k.Decrypt(value, associatedData)
+	if err != nil {
+		return "", err
+	}
+
+	return k.Encrypt(plaintext, associatedData)
+}
+
+func parseEncryptedValue(value string) (string, []byte, error) {
+	parts := strings.SplitN(value, enums.EncryptedValueSeparator, enums.EncryptedValueParts)
+	if len(parts) != enums.EncryptedValueParts || parts[0] != enums.EncryptedValueVersion {
+		return "", nil, enums.ErrorInvalidEncryptedValue
+	}
+
+	ciphertext, err := base64.RawStdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", nil, enums.ErrorInvalidEncryptedValue
+	}
+
+	return parts[1], ciphertext, nil
+}
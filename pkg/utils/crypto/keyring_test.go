@@ -0,0 +1,150 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto/enums"
+)
+
+func newTestKeyring(t *testing.T, primaryID string) *Keyring {
+	keyring, err := NewKeyring(primaryID, map[string][]byte{
+		"old": []byte("0123456789abcdef"), "new": []byte("0123456789abcdef0123456789abcdef"),
+	})
+	assert.NoError(t, err)
+
+	return keyring
+}
+
+func TestNewKeyring(t *testing.T) {
+	t.Run("should return error when keys are invalid", func(t *testing.T) {
+		key := []byte("0123456789abcdef")
+
+		for expected, keys := range map[error]map[string][]byte{
+			enums.ErrorEmptyKeyring:       {},
+			enums.ErrorInvalidKeyID:       {"a:b": key},
+			enums.ErrorInvalidKeySize:     {"test": []byte("test")},
+			enums.ErrorPrimaryKeyNotFound: {"other": key},
+		} {
+			_, err := NewKeyring("test", keys)
+
+			assert.ErrorIs(t, err, expected)
+		}
+	})
+}
+
+func TestParseKeyring(t *testing.T) {
+	first := base64.StdEncoding.EncodeToString([]byte("0123456789abcdef"))
+	second := base64.StdEncoding.EncodeToString([]byte("fedcba9876543210"))
+
+	t.Run("should use the last key as primary when primary id is empty", func(t *testing.T) {
+		keyring, err := ParseKeyring("", "2021:"+first+", 2022:"+second+",")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "2022", keyring.PrimaryKeyID())
+	})
+
+	t.Run("should read keyring from env", func(t *testing.T) {
+		t.Setenv(enums.HorusecEncryptionKeys, "2021:"+first+",2022:"+second)
+		t.Setenv(enums.HorusecEncryptionPrimaryKeyID, "2021")
+
+		keyring, err := NewKeyringFromEnv()
+
+		assert.NoError(t, err)
+		assert.Equal(t, "2021", keyring.PrimaryKeyID())
+	})
+
+	t.Run("should return error when entries are invalid", func(t *testing.T) {
+		for primaryID, value := range map[string]string{"": "test", "2021": "2021:!", "2022": "2021:" + first} {
+			_, err := ParseKeyring(primaryID, value)
+
+			assert.Error(t, err, value)
+		}
+	})
+}
+
+func TestKeyringEncryptDecrypt(t *testing.T) {
+	t.Run("should encrypt with primary key and decrypt after rotation", func(t *testing.T) {
+		oldKeyring := newTestKeyring(t, "old")
+
+		value, err := oldKeyring.Encrypt([]byte("secret"), []byte("webhook.headers"))
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(value, "v1:old:"))
+
+		keyring := newTestKeyring(t, "new")
+		assert.True(t, keyring.NeedsReencrypt(value))
+
+		plaintext, err := keyring.Decrypt(value, []byte("webhook.headers"))
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("secret"), plaintext)
+
+		value, err = keyring.Reencrypt(value, []byte("webhook.headers"))
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(value, "v1:new:"))
+		assert.False(t, keyring.NeedsReencrypt(value))
+	})
+
+	t.Run("should use a new nonce on each encryption", func(t *testing.T) {
+		keyring := newTestKeyring(t, "new")
+
+		first, _ := keyring.Encrypt([]byte("secret"), nil)
+		second, _ := keyring.Encrypt([]byte("secret"), nil)
+
+		assert.NotEqual(t, first, second)
+	})
+
+	t.Run("should fail when associated data or key id changed", func(t *testing.T) {
+		keyring := newTestKeyring(t, "new")
+		value, _ := keyring.Encrypt([]byte("secret"), []byte("ldap"))
+
+		_, err := keyring.Decrypt(value, []byte("webhook"))
+		assert.Error(t, err)
+
+		_, err = keyring.Decrypt(strings.Replace(value, "v1:new:", "v1:old:", 1), []byte("ldap"))
+		assert.Error(t, err)
+
+		_, err = keyring.Reencrypt(value, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when value is invalid or key is unknown", func(t *testing.T) {
+		keyring := newTestKeyring(t, "new")
+
+		for value, expected := range map[string]error{
+			"test":         enums.ErrorInvalidEncryptedValue,
+			"v2:new:dGVzd": enums.ErrorInvalidEncryptedValue,
+			"v1:new:!":     enums.ErrorInvalidEncryptedValue,
+			"v1:test:dGVz": enums.ErrorKeyNotFound,
+		} {
+			_, err := keyring.Decrypt(value, nil)
+
+			assert.ErrorIs(t, err, expected, value)
+		}
+	})
+
+	t.Run("should return error when key reached the encryption limit", func(t *testing.T) {
+		keyring := newTestKeyring(t, "new")
+		keyring.encryptions = enums.MaxEncryptionsPerKey
+
+		_, err := keyring.Encrypt([]byte("secret"), nil)
+
+		assert.ErrorIs(t, err, enums.ErrorKeyUsageLimitReached)
+	})
+}
@@ -0,0 +1,187 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+)
+
+// PasswordOptions has the algorithm used for new hashes and its cost parameters. The hashes keep the algorithm and
+// parameters used to create them, so changing the options does not break the verification of the stored hashes.
+type PasswordOptions struct {
+	Algorithm     string
+	BcryptCost    int
+	Argon2Time    uint32
+	Argon2Memory  uint32
+	Argon2Threads uint8
+}
+
+func NewPasswordOptions() *PasswordOptions {
+	// nolint:gosec // cost parameters are small positive numbers
+	return &PasswordOptions{
+		Algorithm:  env.GetEnvOrDefault(enums.HorusecPasswordHashAlgorithm, enums.AlgorithmArgon2id),
+		BcryptCost: env.GetEnvOrDefaultInt(enums.HorusecPasswordBcryptCost, enums.DefaultBcryptCost),
+		Argon2Time: uint32(env.GetEnvOrDefaultInt(enums.HorusecPasswordArgon2Time, enums.DefaultArgon2Time)),
+		Argon2Memory: uint32(env.GetEnvOrDefaultInt(enums.HorusecPasswordArgon2Memory,
+			enums.DefaultArgon2Memory)),
+		Argon2Threads: uint8(env.GetEnvOrDefaultInt(enums.HorusecPasswordArgon2Threads,
+			enums.DefaultArgon2Threads)),
+	}
+}
+
+type argon2Hash struct {
+	memory  uint32
+	time    uint32
+	threads uint8
+	salt    []byte
+	key     []byte
+}
+
+// HashPassword hashes the password with the options from the environment
+func HashPassword(password string) (string, error) {
+	return HashPasswordWithOptions(password, NewPasswordOptions())
+}
+
+// HashPasswordWithOptions returns the hash in the PHC string format for argon2id, like
+// $argon2id$v=19$m=65536,t=3,p=4$salt$key, or in the modular crypt format for bcrypt, like $2a$12$...
+func HashPasswordWithOptions(password string, options *PasswordOptions) (string, error) {
+	switch options.Algorithm {
+	case enums.AlgorithmArgon2id:
+		return hashArgon2id(password, options)
+	case enums.AlgorithmBcrypt:
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), options.BcryptCost)
+
+		return string(hash), err
+	}
+
+	return "", enums.ErrorUnknownHashAlgorithm
+}
+
+func hashArgon2id(password string, options *PasswordOptions) (string, error) {
+	salt := make([]byte, enums.DefaultSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	key := argon2.IDKey([]byte(password), salt, options.Argon2Time, options.Argon2Memory, options.Argon2Threads,
+		enums.DefaultArgon2KeyLength)
+
+	return fmt.Sprintf(enums.Argon2HashFormat, argon2.Version, options.Argon2Memory, options.Argon2Time,
+		options.Argon2Threads, base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+// VerifyPassword checks the password against a hash created by any of the supported algorithms
+func VerifyPassword(password, hash string) (bool, error) {
+	if isBcryptHash(hash) {
+		err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, nil
+		}
+
+		return err == nil, err
+	}
+
+	parsed, err := parseArgon2Hash(hash)
+	if err != nil {
+		return false, err
+	}
+
+	key := argon2.IDKey([]byte(password), parsed.salt, parsed.time, parsed.memory, parsed.threads,
+		uint32(len(parsed.key)))
+
+	return subtle.ConstantTimeCompare(key, parsed.key) == 1, nil
+}
+
+// VerifyPasswordAndUpgrade calls upgrade with a new hash when the password is valid but its hash was not created
+// with the current options, so the stored hashes move to the new algorithm or cost as the users log in
+func VerifyPasswordAndUpgrade(password, hash string, options *PasswordOptions,
+	upgrade func(newHash string) error) (bool, error) {
+	valid, err := VerifyPassword(password, hash)
+	if err != nil || !valid || !NeedsRehash(hash, options) {
+		return valid, err
+	}
+
+	newHash, err := HashPasswordWithOptions(password, options)
+	if err != nil {
+		return true, err
+	}
+
+	return true, upgrade(newHash)
+}
+
+// NeedsRehash returns true when the hash algorithm or cost parameters are different from the options
+func NeedsRehash(hash string, options *PasswordOptions) bool {
+	if isBcryptHash(hash) {
+		cost, err := bcrypt.Cost([]byte(hash))
+
+		return options.Algorithm != enums.AlgorithmBcrypt || err != nil || cost != options.BcryptCost
+	}
+
+	parsed, err := parseArgon2Hash(hash)
+	if err != nil || options.Algorithm != enums.AlgorithmArgon2id {
+		return true
+	}
+
+	return parsed.time != options.Argon2Time || parsed.memory != options.Argon2Memory ||
+		parsed.threads != options.Argon2Threads
+}
+
+func isBcryptHash(hash string) bool {
+	return strings.HasPrefix(hash, "$2a$") || strings.HasPrefix(hash, "$2b$") || strings.HasPrefix(hash, "$2y$")
+}
+
+func parseArgon2Hash(hash string) (*argon2Hash, error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != enums.Argon2HashParts || parts[1] != enums.AlgorithmArgon2id {
+		return nil, enums.ErrorUnknownHashAlgorithm
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return nil, enums.ErrorInvalidHash
+	}
+
+	parsed := &argon2Hash{}
+	if _, err := fmt.Sscanf(parts[3], enums.Argon2Params, &parsed.memory, &parsed.time,
+		&parsed.threads); err != nil {
+		return nil, enums.ErrorInvalidHash
+	}
+
+	return parsed, parsed.decode(parts[4], parts[5])
+}
+
+func (a *argon2Hash) decode(salt, key string) (err error) {
+	if a.salt, err = base64.RawStdEncoding.DecodeString(salt); err != nil {
+		return enums.ErrorInvalidHash
+	}
+
+	if a.key, err = base64.RawStdEncoding.DecodeString(key); err != nil || len(a.key) == 0 {
+		return enums.ErrorInvalidHash
+	}
+
+	return nil
+}
@@ -0,0 +1,205 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto/enums"
+)
+
+func newTestPasswordOptions(algorithm string) *PasswordOptions {
+	return &PasswordOptions{
+		Algorithm: algorithm, BcryptCost: bcrypt.MinCost, Argon2Time: 1, Argon2Memory: 1024, Argon2Threads: 1,
+	}
+}
+
+func TestNewPasswordOptions(t *testing.T) {
+	t.Run("should read options from env", func(t *testing.T) {
+		t.Setenv(enums.HorusecPasswordHashAlgorithm, enums.AlgorithmBcrypt)
+		t.Setenv(enums.HorusecPasswordBcryptCost, "10")
+		t.Setenv(enums.HorusecPasswordArgon2Memory, "2048")
+
+		options := NewPasswordOptions()
+
+		assert.Equal(t, enums.AlgorithmBcrypt, options.Algorithm)
+		assert.Equal(t, 10, options.BcryptCost)
+		assert.Equal(t, uint32(2048), options.Argon2Memory)
+		assert.Equal(t, uint32(enums.DefaultArgon2Time), options.Argon2Time)
+		assert.Equal(t, uint8(enums.DefaultArgon2Threads), options.Argon2Threads)
+	})
+}
+
+func TestHashPasswordWithOptions(t *testing.T) {
+	t.Run("should hash and verify with argon2id", func(t *testing.T) {
+		hash, err := HashPasswordWithOptions("test", newTestPasswordOptions(enums.AlgorithmArgon2id))
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=1024,t=1,p=1$"))
+
+		valid, err := VerifyPassword("test", hash)
+		assert.NoError(t, err)
+		assert.True(t, valid)
+
+		valid, err = VerifyPassword("other", hash)
+		assert.NoError(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("should hash and verify with bcrypt", func(t *testing.T) {
+		hash, err := HashPasswordWithOptions("test", newTestPasswordOptions(enums.AlgorithmBcrypt))
+		assert.NoError(t, err)
+
+		valid, err := VerifyPassword("test", hash)
+		assert.NoError(t, err)
+		assert.True(t, valid)
+
+		valid, err = VerifyPassword("other", hash)
+		assert.NoError(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("should use options from env", func(t *testing.T) {
+		t.Setenv(enums.HorusecPasswordHashAlgorithm, enums.AlgorithmBcrypt)
+		t.Setenv(enums.HorusecPasswordBcryptCost, "4")
+
+		hash, err := HashPassword("test")
+
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(hash, "$2a$04$"))
+	})
+
+	t.Run("should return error when algorithm is unknown", func(t *testing.T) {
+		_, err := HashPasswordWithOptions("test", newTestPasswordOptions("test"))
+
+		assert.ErrorIs(t, err, enums.ErrorUnknownHashAlgorithm)
+	})
+}
+
+func TestVerifyPassword(t *testing.T) {
+	t.Run("should return error when hash is invalid", func(t *testing.T) {
+		for hash, expected := range map[string]error{
+			"test":                                    enums.ErrorUnknownHashAlgorithm,
+			"$argon2i$v=19$m=1,t=1,p=1$c2FsdA$a2V5":   enums.ErrorUnknownHashAlgorithm,
+			"$argon2id$v=18$m=1,t=1,p=1$c2FsdA$a2V5":  enums.ErrorInvalidHash,
+			"$argon2id$v=19$m=1,t=1$c2FsdA$a2V5":      enums.ErrorInvalidHash,
+			"$argon2id$v=19$m=1,t=1,p=1$!$a2V5":       enums.ErrorInvalidHash,
+			"$argon2id$v=19$m=1,t=1,p=1$c2FsdA$!":     enums.ErrorInvalidHash,
+			"$argon2id$v=19$m=1,t=1,p=1$c2FsdA$":      enums.ErrorInvalidHash,
+			"$argon2id$v=test$m=1,t=1,p=1$c2FsdA$a2V": enums.ErrorInvalidHash,
+		} {
+			_, err := VerifyPassword("test", hash)
+
+			assert.ErrorIs(t, err, expected, hash)
+		}
+	})
+
+	t.Run("should return error when bcrypt hash is invalid", func(t *testing.T) {
+		_, err := VerifyPassword("test", "$2a$10$test")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestVerifyPasswordAndUpgrade(t *testing.T) {
+	t.Run("should upgrade bcrypt hash to argon2id", func(t *testing.T) {
+		hash, _ := HashPasswordWithOptions("test", newTestPasswordOptions(enums.AlgorithmBcrypt))
+		upgraded := ""
+
+		valid, err := VerifyPasswordAndUpgrade("test", hash, newTestPasswordOptions(enums.AlgorithmArgon2id),
+			func(newHash string) error {
+				upgraded = newHash
+
+				return nil
+			})
+
+		assert.NoError(t, err)
+		assert.True(t, valid)
+		assert.True(t, strings.HasPrefix(upgraded, "$argon2id$"))
+		assert.False(t, NeedsRehash(upgraded, newTestPasswordOptions(enums.AlgorithmArgon2id)))
+	})
+
+	t.Run("should not upgrade when hash uses the current options or password is invalid", func(t *testing.T) {
+		options := newTestPasswordOptions(enums.AlgorithmArgon2id)
+		hash, _ := HashPasswordWithOptions("test", options)
+		upgrade := func(string) error {
+			t.Fail()
+
+			return nil
+		}
+
+		valid, err := VerifyPasswordAndUpgrade("test", hash, options, upgrade)
+		assert.NoError(t, err)
+		assert.True(t, valid)
+
+		valid, err = VerifyPasswordAndUpgrade("other", hash, newTestPasswordOptions(enums.AlgorithmBcrypt), upgrade)
+		assert.NoError(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("should return error of the upgrade", func(t *testing.T) {
+		hash, _ := HashPasswordWithOptions("test", newTestPasswordOptions(enums.AlgorithmBcrypt))
+
+		valid, err := VerifyPasswordAndUpgrade("test", hash, newTestPasswordOptions(enums.AlgorithmArgon2id),
+			func(string) error {
+				return errors.New("test")
+			})
+
+		assert.Error(t, err)
+		assert.True(t, valid)
+	})
+
+	t.Run("should return error when new options are invalid", func(t *testing.T) {
+		hash, _ := HashPasswordWithOptions("test", newTestPasswordOptions(enums.AlgorithmBcrypt))
+
+		valid, err := VerifyPasswordAndUpgrade("test", hash, newTestPasswordOptions("test"), nil)
+
+		assert.ErrorIs(t, err, enums.ErrorUnknownHashAlgorithm)
+		assert.True(t, valid)
+	})
+}
+
+func TestNeedsRehash(t *testing.T) {
+	t.Run("should need rehash when cost parameters changed", func(t *testing.T) {
+		options := newTestPasswordOptions(enums.AlgorithmArgon2id)
+		hash, _ := HashPasswordWithOptions("test", options)
+		bcryptOptions := newTestPasswordOptions(enums.AlgorithmBcrypt)
+		bcryptHash, _ := HashPasswordWithOptions("test", bcryptOptions)
+
+		assert.False(t, NeedsRehash(bcryptHash, bcryptOptions))
+		assert.True(t, NeedsRehash(hash, bcryptOptions))
+		assert.True(t, NeedsRehash("test", options))
+
+		options.Argon2Memory = 2048
+		bcryptOptions.BcryptCost = 5
+
+		assert.True(t, NeedsRehash(hash, options))
+		assert.True(t, NeedsRehash(bcryptHash, bcryptOptions))
+	})
+
+	t.Run("should verify hashes created by the legacy bcrypt helper", func(t *testing.T) {
+		hash, _ := HashPasswordBcrypt("test")
+
+		valid, err := VerifyPassword("test", hash)
+
+		assert.NoError(t, err)
+		assert.True(t, valid)
+		assert.True(t, NeedsRehash(hash, newTestPasswordOptions(enums.AlgorithmArgon2id)))
+	})
+}
@@ -0,0 +1,111 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"hash/crc32"
+	"math/big"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto/enums"
+)
+
+// GenerateRandomBytes returns the length of bytes read from crypto/rand
+func GenerateRandomBytes(length int) ([]byte, error) {
+	if length <= 0 {
+		return nil, enums.ErrorInvalidRandomLength
+	}
+
+	bytes := make([]byte, length)
+	if _, err := rand.Read(bytes); err != nil {
+		return nil, err
+	}
+
+	return bytes, nil
+}
+
+// GenerateToken returns a url safe token with the length of random bytes, like the ones sent on confirmation emails
+func GenerateToken(length int) (string, error) {
+	bytes, err := GenerateRandomBytes(length)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+// GenerateNumericCode returns a one time code with the number of digits, keeping the leading zeros
+func GenerateNumericCode(digits int) (string, error) {
+	if digits <= 0 || digits > enums.MaxCodeDigits {
+		return "", enums.ErrorInvalidRandomLength
+	}
+
+	value, err := rand.Int(rand.Reader, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(digits)), nil))
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%0*d", digits, value), nil
+}
+
+// GenerateAPIKey returns a key like hsc_<30 random base62 chars><6 chars checksum>. The prefix makes leaked keys
+// easy to find by secret scanners and the crc32 checksum allows rejecting mistyped keys without a database lookup.
+func GenerateAPIKey(prefix string) (string, error) {
+	random, err := generateBase62(enums.APIKeyRandomLength)
+	if err != nil {
+		return "", err
+	}
+
+	key := prefix + enums.APIKeySeparator + random
+
+	return key + apiKeyChecksum(key), nil
+}
+
+// ValidateAPIKey checks the prefix, the length and the checksum of the key, it does not check if the key exists
+func ValidateAPIKey(key, prefix string) bool {
+	start := prefix + enums.APIKeySeparator
+	if !strings.HasPrefix(key, start) || len(key) != len(start)+enums.APIKeyRandomLength+enums.APIKeyChecksumLength {
+		return false
+	}
+
+	body, checksum := key[:len(key)-enums.APIKeyChecksumLength], key[len(key)-enums.APIKeyChecksumLength:]
+
+	return apiKeyChecksum(body) == checksum
+}
+
+func generateBase62(length int) (string, error) {
+	alphabetSize := big.NewInt(int64(len(enums.Base62Alphabet)))
+	builder := strings.Builder{}
+
+	for i := 0; i < length; i++ {
+		index, err := rand.Int(rand.Reader, alphabetSize)
+		if err != nil {
+			return "", err
+		}
+
+		builder.WriteByte(enums.Base62Alphabet[index.Int64()])
+	}
+
+	return builder.String(), nil
+}
+
+func apiKeyChecksum(value string) string {
+	checksum := big.NewInt(int64(crc32.ChecksumIEEE([]byte(value)))).Text(len(enums.Base62Alphabet))
+
+	return strings.Repeat("0", enums.APIKeyChecksumLength-len(checksum)) + checksum
+}
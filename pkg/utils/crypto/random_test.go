@@ -0,0 +1,90 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"encoding/base64"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto/enums"
+)
+
+func TestGenerateToken(t *testing.T) {
+	t.Run("should generate different url safe tokens", func(t *testing.T) {
+		first, err := GenerateToken(enums.DefaultTokenLength)
+		assert.NoError(t, err)
+
+		second, _ := GenerateToken(enums.DefaultTokenLength)
+
+		decoded, err := base64.RawURLEncoding.DecodeString(first)
+		assert.NoError(t, err)
+		assert.Len(t, decoded, enums.DefaultTokenLength)
+		assert.NotEqual(t, first, second)
+	})
+
+	t.Run("should return error when length is invalid", func(t *testing.T) {
+		_, err := GenerateToken(0)
+
+		assert.ErrorIs(t, err, enums.ErrorInvalidRandomLength)
+	})
+}
+
+func TestGenerateNumericCode(t *testing.T) {
+	t.Run("should generate code with the number of digits", func(t *testing.T) {
+		for i := 0; i < 100; i++ {
+			code, err := GenerateNumericCode(enums.DefaultCodeDigits)
+
+			assert.NoError(t, err)
+			assert.Regexp(t, regexp.MustCompile(`^\d{6}$`), code)
+		}
+	})
+
+	t.Run("should return error when digits are invalid", func(t *testing.T) {
+		for _, digits := range []int{0, enums.MaxCodeDigits + 1} {
+			_, err := GenerateNumericCode(digits)
+
+			assert.ErrorIs(t, err, enums.ErrorInvalidRandomLength)
+		}
+	})
+}
+
+func TestGenerateAPIKey(t *testing.T) {
+	t.Run("should generate valid api key with prefix and checksum", func(t *testing.T) {
+		key, err := GenerateAPIKey(enums.APIKeyPrefix)
+
+		assert.NoError(t, err)
+		assert.Regexp(t, regexp.MustCompile(`^hsc_[0-9a-zA-Z]{36}$`), key)
+		assert.True(t, ValidateAPIKey(key, enums.APIKeyPrefix))
+	})
+
+	t.Run("should reject keys with wrong prefix, length or checksum", func(t *testing.T) {
+		key, _ := GenerateAPIKey(enums.APIKeyPrefix)
+		changed := key[:10] + strings.Map(func(r rune) rune {
+			if r == 'a' {
+				return 'b'
+			}
+
+			return 'a'
+		}, key[10:11]) + key[11:]
+
+		assert.False(t, ValidateAPIKey(key, "other"))
+		assert.False(t, ValidateAPIKey(key[:len(key)-1], enums.APIKeyPrefix))
+		assert.False(t, ValidateAPIKey(changed, enums.APIKeyPrefix))
+	})
+}
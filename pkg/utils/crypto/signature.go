@@ -0,0 +1,131 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto/enums"
+)
+
+// SignPayload returns the signature header value, like t=1640908799,v1=hex, where v1 is the HMAC-SHA256 of the
+// timestamp and the payload joined by a dot, so a captured request cannot be replayed with another timestamp
+func SignPayload(secret, payload []byte, timestamp time.Time) string {
+	return fmt.Sprintf(enums.SignatureHeaderFormat, timestamp.Unix(),
+		computeSignature(secret, payload, timestamp.Unix()))
+}
+
+// SignRequest sets the signature header of the request with its body, which is read and replaced by a copy
+func SignRequest(r *http.Request, secret []byte) error {
+	payload, err := readAndRestoreBody(r)
+	if err != nil {
+		return err
+	}
+
+	r.Header.Set(enums.SignatureHeader, SignPayload(secret, payload, time.Now()))
+
+	return nil
+}
+
+// VerifySignature checks the signature header against the payload using a constant time compare and rejects
+// timestamps older or newer than the tolerance. The header can have more than one v1 signature, which is accepted
+// when any of them matches, allowing the sender to sign with the old and new secrets while rotating them.
+func VerifySignature(secret, payload []byte, header string, tolerance time.Duration) error {
+	timestamp, signatures, err := parseSignatureHeader(header)
+	if err != nil {
+		return err
+	}
+
+	if age := time.Since(time.Unix(timestamp, 0)); age > tolerance || age < -tolerance {
+		return enums.ErrorSignatureExpired
+	}
+
+	expected := computeSignature(secret, payload, timestamp)
+	for _, signature := range signatures {
+		if hmac.Equal([]byte(expected), []byte(signature)) {
+			return nil
+		}
+	}
+
+	return enums.ErrorSignatureMismatch
+}
+
+// VerifyRequest verifies the signature header of the request and returns its body, which stays readable
+func VerifyRequest(r *http.Request, secret []byte, tolerance time.Duration) ([]byte, error) {
+	payload, err := readAndRestoreBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return payload, VerifySignature(secret, payload, r.Header.Get(enums.SignatureHeader), tolerance)
+}
+
+func computeSignature(secret, payload []byte, timestamp int64) string {
+	mac := hmac.New(sha256.New, secret)
+
+	_, _ = mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	_, _ = mac.Write(payload)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func parseSignatureHeader(header string) (timestamp int64, signatures []string, err error) {
+	for _, item := range strings.Split(header, ",") {
+		key, value, found := strings.Cut(strings.TrimSpace(item), "=")
+		if !found {
+			return 0, nil, enums.ErrorInvalidSignatureHeader
+		}
+
+		switch key {
+		case enums.SignatureTimestamp:
+			if timestamp, err = strconv.ParseInt(value, 10, 64); err != nil {
+				return 0, nil, enums.ErrorInvalidSignatureHeader
+			}
+		case enums.SignatureVersion:
+			signatures = append(signatures, value)
+		}
+	}
+
+	if timestamp == 0 || len(signatures) == 0 {
+		return 0, nil, enums.ErrorInvalidSignatureHeader
+	}
+
+	return timestamp, signatures, nil
+}
+
+func readAndRestoreBody(r *http.Request) ([]byte, error) {
+	if r.Body == nil {
+		return []byte{}, nil
+	}
+
+	payload, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(payload))
+
+	return payload, nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto/enums"
+)
+
+type errorReader struct{}
+
+func (e *errorReader) Read([]byte) (int, error) {
+	return 0, errors.New("test")
+}
+
+func TestVerifySignature(t *testing.T) {
+	secret := []byte("secret")
+	payload := []byte(`{"test":true}`)
+
+	t.Run("should verify signed payload", func(t *testing.T) {
+		header := SignPayload(secret, payload, time.Now())
+
+		assert.NoError(t, VerifySignature(secret, payload, header, enums.DefaultSignatureTolerance))
+	})
+
+	t.Run("should accept any of the signatures of the header", func(t *testing.T) {
+		now := time.Now()
+		header := SignPayload([]byte("old"), payload, now) + ",v1=" + computeSignature(secret, payload, now.Unix())
+
+		assert.NoError(t, VerifySignature(secret, payload, header, enums.DefaultSignatureTolerance))
+	})
+
+	t.Run("should return mismatch when payload or secret changed", func(t *testing.T) {
+		header := SignPayload(secret, payload, time.Now())
+
+		assert.ErrorIs(t, VerifySignature(secret, []byte("{}"), header, time.Minute), enums.ErrorSignatureMismatch)
+		assert.ErrorIs(t, VerifySignature([]byte("other"), payload, header, time.Minute),
+			enums.ErrorSignatureMismatch)
+	})
+
+	t.Run("should return expired when timestamp is outside the tolerance", func(t *testing.T) {
+		for _, timestamp := range []time.Time{time.Now().Add(-10 * time.Minute), time.Now().Add(10 * time.Minute)} {
+			header := SignPayload(secret, payload, timestamp)
+
+			assert.ErrorIs(t, VerifySignature(secret, payload, header, enums.DefaultSignatureTolerance),
+				enums.ErrorSignatureExpired)
+		}
+	})
+
+	t.Run("should return error when header is invalid", func(t *testing.T) {
+		for _, header := range []string{"", "test", "t=test,v1=test", "t=1640908799", "v1=test"} {
+			assert.ErrorIs(t, VerifySignature(secret, payload, header, time.Minute),
+				enums.ErrorInvalidSignatureHeader, header)
+		}
+	})
+}
+
+func TestSignAndVerifyRequest(t *testing.T) {
+	secret := []byte("secret")
+
+	t.Run("should sign and verify the request keeping the body readable", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader([]byte("test")))
+
+		assert.NoError(t, SignRequest(r, secret))
+
+		payload, err := VerifyRequest(r, secret, enums.DefaultSignatureTolerance)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("test"), payload)
+
+		payload, err = VerifyRequest(r, secret, enums.DefaultSignatureTolerance)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("test"), payload)
+	})
+
+	t.Run("should sign request without body", func(t *testing.T) {
+		r := &http.Request{Header: http.Header{}}
+
+		assert.NoError(t, SignRequest(r, secret))
+		assert.NotEmpty(t, r.Header.Get(enums.SignatureHeader))
+	})
+
+	t.Run("should return error when failed to read body", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodPost, "/", &errorReader{})
+
+		assert.Error(t, SignRequest(r, secret))
+
+		_, err := VerifyRequest(r, secret, time.Minute)
+		assert.Error(t, err)
+	})
+}
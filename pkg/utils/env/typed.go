@@ -0,0 +1,171 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package env
+
+import (
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env/enums"
+)
+
+type Option func(*variable)
+
+type variable struct {
+	name      string
+	required  bool
+	validator func(value string) error
+}
+
+// Required records the variable as missing in the Validate report when it is empty, the default is returned anyway
+func Required() Option {
+	return func(v *variable) {
+		v.required = true
+	}
+}
+
+// WithValidator records the error returned by the validator in the Validate report when the variable is set
+func WithValidator(validator func(value string) error) Option {
+	return func(v *variable) {
+		v.validator = validator
+	}
+}
+
+// lookup returns the variable value and if it should be parsed, recording the missing and invalid variables
+func lookup(name string, options []Option) (string, bool) {
+	v := &variable{name: name}
+	for _, option := range options {
+		option(v)
+	}
+
+	value := strings.TrimSpace(os.Getenv(name))
+	if value == "" {
+		if v.required {
+			report(name, enums.ErrorRequiredVariable)
+		}
+
+		return "", false
+	}
+
+	if v.validator != nil {
+		if err := v.validator(value); err != nil {
+			report(name, err)
+
+			return "", false
+		}
+	}
+
+	return value, true
+}
+
+func GetString(name, defaultValue string, options ...Option) string {
+	if value, ok := lookup(name, options); ok {
+		return value
+	}
+
+	return defaultValue
+}
+
+func GetInt(name string, defaultValue int, options ...Option) int {
+	value, ok := lookup(name, options)
+	if !ok {
+		return defaultValue
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		report(name, enums.ErrorInvalidInt)
+
+		return defaultValue
+	}
+
+	return parsed
+}
+
+func GetBool(name string, defaultValue bool, options ...Option) bool {
+	value, ok := lookup(name, options)
+	if !ok {
+		return defaultValue
+	}
+
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		report(name, enums.ErrorInvalidBool)
+
+		return defaultValue
+	}
+
+	return parsed
+}
+
+// GetDuration accepts go durations like 1m30s, and integers, which are read as seconds to keep compatibility with
+// the variables already ending with _SECONDS
+func GetDuration(name string, defaultValue time.Duration, options ...Option) time.Duration {
+	value, ok := lookup(name, options)
+	if !ok {
+		return defaultValue
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		report(name, enums.ErrorInvalidDuration)
+
+		return defaultValue
+	}
+
+	return parsed
+}
+
+// GetStringSlice splits the variable by comma, ignoring the empty items
+func GetStringSlice(name string, defaultValue []string, options ...Option) []string {
+	value, ok := lookup(name, options)
+	if !ok {
+		return defaultValue
+	}
+
+	var items []string
+
+	for _, item := range strings.Split(value, enums.StringSliceSeparator) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// GetURL only accepts absolute urls, since a relative one is always a misconfiguration of a service address
+func GetURL(name string, defaultValue *url.URL, options ...Option) *url.URL {
+	value, ok := lookup(name, options)
+	if !ok {
+		return defaultValue
+	}
+
+	parsed, err := url.Parse(value)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		report(name, enums.ErrorInvalidURL)
+
+		return defaultValue
+	}
+
+	return parsed
+}
@@ -0,0 +1,136 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package env
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env/enums"
+)
+
+func TestGetString(t *testing.T) {
+	t.Run("should return value or default", func(t *testing.T) {
+		t.Setenv("TEST_STRING", " test ")
+
+		assert.Equal(t, "test", GetString("TEST_STRING", "default"))
+		assert.Equal(t, "default", GetString("TEST_STRING_EMPTY", "default"))
+		assert.NoError(t, Validate())
+	})
+
+	t.Run("should report validator error and return default", func(t *testing.T) {
+		t.Setenv("TEST_STRING", "test")
+
+		assert.Equal(t, "default", GetString("TEST_STRING", "default", WithValidator(func(string) error {
+			return errors.New("test")
+		})))
+		assert.Error(t, Validate())
+	})
+}
+
+func TestGetInt(t *testing.T) {
+	t.Run("should parse integer", func(t *testing.T) {
+		t.Setenv("TEST_INT", "10")
+
+		assert.Equal(t, 10, GetInt("TEST_INT", 1))
+	})
+
+	t.Run("should report invalid integer and return default", func(t *testing.T) {
+		t.Setenv("TEST_INT", "test")
+
+		assert.Equal(t, 1, GetInt("TEST_INT", 1))
+		assert.ErrorIs(t, Validate(), enums.ErrorInvalidInt)
+	})
+}
+
+func TestGetBool(t *testing.T) {
+	t.Run("should parse boolean", func(t *testing.T) {
+		t.Setenv("TEST_BOOL", "true")
+
+		assert.True(t, GetBool("TEST_BOOL", false))
+	})
+
+	t.Run("should report invalid boolean and return default", func(t *testing.T) {
+		t.Setenv("TEST_BOOL", "test")
+
+		assert.True(t, GetBool("TEST_BOOL", true))
+		assert.ErrorIs(t, Validate(), enums.ErrorInvalidBool)
+	})
+}
+
+func TestGetDuration(t *testing.T) {
+	t.Run("should parse duration and seconds", func(t *testing.T) {
+		t.Setenv("TEST_DURATION", "1m30s")
+		t.Setenv("TEST_DURATION_SECONDS", "30")
+
+		assert.Equal(t, 90*time.Second, GetDuration("TEST_DURATION", time.Second))
+		assert.Equal(t, 30*time.Second, GetDuration("TEST_DURATION_SECONDS", time.Second))
+	})
+
+	t.Run("should report invalid duration and return default", func(t *testing.T) {
+		t.Setenv("TEST_DURATION", "test")
+
+		assert.Equal(t, time.Second, GetDuration("TEST_DURATION", time.Second))
+		assert.ErrorIs(t, Validate(), enums.ErrorInvalidDuration)
+	})
+}
+
+func TestGetStringSlice(t *testing.T) {
+	t.Run("should split by comma ignoring empty items", func(t *testing.T) {
+		t.Setenv("TEST_SLICE", "a, b,,c")
+
+		assert.Equal(t, []string{"a", "b", "c"}, GetStringSlice("TEST_SLICE", nil))
+		assert.Equal(t, []string{"default"}, GetStringSlice("TEST_SLICE_EMPTY", []string{"default"}))
+	})
+}
+
+func TestGetURL(t *testing.T) {
+	defaultURL, _ := url.Parse("http://localhost:8000")
+
+	t.Run("should parse absolute url", func(t *testing.T) {
+		t.Setenv("TEST_URL", "https://horusec.io/api")
+
+		assert.Equal(t, "horusec.io", GetURL("TEST_URL", defaultURL).Host)
+	})
+
+	t.Run("should report relative url and return default", func(t *testing.T) {
+		t.Setenv("TEST_URL", "/api")
+
+		assert.Equal(t, defaultURL, GetURL("TEST_URL", defaultURL))
+		assert.ErrorIs(t, Validate(), enums.ErrorInvalidURL)
+	})
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("should aggregate every missing and invalid variable", func(t *testing.T) {
+		t.Setenv("TEST_INT", "test")
+
+		GetString("TEST_REQUIRED", "", Required())
+		GetInt("TEST_INT", 0, Required())
+
+		err := Validate()
+
+		assert.ErrorIs(t, err, enums.ErrorInvalidVariables)
+		assert.ErrorIs(t, err, enums.ErrorRequiredVariable)
+		assert.ErrorIs(t, err, enums.ErrorInvalidInt)
+		assert.Contains(t, err.Error(), "TEST_INT")
+		assert.Contains(t, err.Error(), "TEST_REQUIRED")
+		assert.NoError(t, Validate())
+	})
+}
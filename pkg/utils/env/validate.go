@@ -0,0 +1,89 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package env
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env/enums"
+)
+
+// nolint:gochecknoglobals // the report is shared by every typed getter until the service validates it on startup
+var (
+	reportMutex sync.Mutex
+	reported    = map[string]error{}
+)
+
+// ValidationError contains every missing and invalid variable found by the typed getters
+type ValidationError struct {
+	Errors map[string]error
+}
+
+func (v *ValidationError) Error() string {
+	names := make([]string, 0, len(v.Errors))
+	for name := range v.Errors {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	messages := make([]string, 0, len(names))
+	for _, name := range names {
+		messages = append(messages, fmt.Sprintf("%s: %s", name, v.Errors[name]))
+	}
+
+	return fmt.Sprintf("%s: %s", enums.ErrorInvalidVariables, strings.Join(messages, "; "))
+}
+
+func (v *ValidationError) Is(target error) bool {
+	if errors.Is(enums.ErrorInvalidVariables, target) {
+		return true
+	}
+
+	for _, err := range v.Errors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func report(name string, err error) {
+	reportMutex.Lock()
+	defer reportMutex.Unlock()
+
+	reported[name] = err
+}
+
+// Validate returns a ValidationError with every variable reported since the last call, it should be called after
+// the service configuration is read, so all the problems are shown at once instead of one per restart
+func Validate() error {
+	reportMutex.Lock()
+	defer reportMutex.Unlock()
+
+	if len(reported) == 0 {
+		return nil
+	}
+
+	err := &ValidationError{Errors: reported}
+	reported = map[string]error{}
+
+	return err
+}
@@ -0,0 +1,53 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+import (
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/errorcode"
+)
+
+// nolint:gochecknoglobals // default http status of each error code
+var HTTPStatuses = map[errorcode.Code]int{
+	errorcode.InvalidArgument:  http.StatusBadRequest,
+	errorcode.Unauthenticated:  http.StatusUnauthorized,
+	errorcode.PermissionDenied: http.StatusForbidden,
+	errorcode.NotFound:         http.StatusNotFound,
+	errorcode.Conflict:         http.StatusConflict,
+	errorcode.Unprocessable:    http.StatusUnprocessableEntity,
+	errorcode.TooManyRequests:  http.StatusTooManyRequests,
+	errorcode.Timeout:          http.StatusGatewayTimeout,
+	errorcode.Unavailable:      http.StatusServiceUnavailable,
+	errorcode.Internal:         http.StatusInternalServerError,
+}
+
+// nolint:gochecknoglobals // default grpc code of each error code
+var GRPCCodes = map[errorcode.Code]codes.Code{
+	errorcode.InvalidArgument:  codes.InvalidArgument,
+	errorcode.Unauthenticated:  codes.Unauthenticated,
+	errorcode.PermissionDenied: codes.PermissionDenied,
+	errorcode.NotFound:         codes.NotFound,
+	errorcode.Conflict:         codes.AlreadyExists,
+	errorcode.Unprocessable:    codes.InvalidArgument,
+	errorcode.TooManyRequests:  codes.ResourceExhausted,
+	errorcode.Timeout:          codes.DeadlineExceeded,
+	errorcode.Unavailable:      codes.Unavailable,
+	errorcode.Internal:         codes.Internal,
+}
+
+const LogFieldCode = "code"
@@ -0,0 +1,170 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errors
+
+import (
+	stdErrors "errors"
+	"fmt"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/errorcode"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/errors/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+// AppError keeps the public message, which is sent to the clients, apart from the internal cause, which is only
+// logged. The http status and grpc code are set from the error code, unless they are overridden. The message key is
+// used instead of the message when the response is translated to the language of the client.
+type AppError struct {
+	Code        errorcode.Code
+	HTTPStatus  int
+	GRPCCode    codes.Code
+	Message     string
+	MessageKey  string
+	MessageData map[string]interface{}
+	Cause       error
+	Metadata    map[string]interface{}
+}
+
+func New(code errorcode.Code, message string) *AppError {
+	return &AppError{
+		Code:       code,
+		HTTPStatus: toHTTPStatus(code),
+		GRPCCode:   toGRPCCode(code),
+		Message:    message,
+	}
+}
+
+// Wrap returns nil when the error is nil, so it can wrap the result of a call directly
+func Wrap(err error, code errorcode.Code, message string) error {
+	if err == nil {
+		return nil
+	}
+
+	return New(code, message).WithCause(err)
+}
+
+// Is reports if any error in the chain matches the target, where an app error matches any other with the same code
+func Is(err, target error) bool {
+	return stdErrors.Is(err, target)
+}
+
+// As returns the first app error of the chain
+func As(err error) (*AppError, bool) {
+	var appError *AppError
+
+	ok := stdErrors.As(err, &appError)
+
+	return appError, ok
+}
+
+// HasCode reports if the chain has an app error with the code
+func HasCode(err error, code errorcode.Code) bool {
+	appError, ok := As(err)
+
+	return ok && appError.Code == code
+}
+
+func (a *AppError) Error() string {
+	if a.Cause == nil {
+		return fmt.Sprintf("%s: %s", a.Code, a.Message)
+	}
+
+	return fmt.Sprintf("%s: %s: %s", a.Code, a.Message, a.Cause.Error())
+}
+
+func (a *AppError) Unwrap() error {
+	return a.Cause
+}
+
+func (a *AppError) Is(target error) bool {
+	appError, ok := target.(*AppError) // nolint:errorlint // only the target itself is compared
+
+	return ok && appError.Code == a.Code
+}
+
+func (a *AppError) WithCause(cause error) *AppError {
+	a.Cause = cause
+
+	return a
+}
+
+func (a *AppError) WithMetadata(key string, value interface{}) *AppError {
+	if a.Metadata == nil {
+		a.Metadata = map[string]interface{}{}
+	}
+
+	a.Metadata[key] = value
+
+	return a
+}
+
+// WithMessageKey sets the i18n key of the public message and the data used to render it
+func (a *AppError) WithMessageKey(key string, data map[string]interface{}) *AppError {
+	a.MessageKey = key
+	a.MessageData = data
+
+	return a
+}
+
+func (a *AppError) WithHTTPStatus(status int) *AppError {
+	a.HTTPStatus = status
+
+	return a
+}
+
+func (a *AppError) WithGRPCCode(code codes.Code) *AppError {
+	a.GRPCCode = code
+
+	return a
+}
+
+// IsInternal reports if the error is caused by the server, so its cause should be logged
+func (a *AppError) IsInternal() bool {
+	return a.HTTPStatus >= http.StatusInternalServerError
+}
+
+// LogInternal logs the internal errors with the code and metadata as fields, since only the public message is sent
+// to the client, while the other errors are expected and not logged
+func LogInternal(appError *AppError) {
+	if !appError.IsInternal() {
+		return
+	}
+
+	fields := map[string]interface{}{enums.LogFieldCode: appError.Code}
+	for key, value := range appError.Metadata {
+		fields[key] = value
+	}
+
+	logger.LogError(enums.MessageInternalError, appError, fields)
+}
+
+func toHTTPStatus(code errorcode.Code) int {
+	if status, ok := enums.HTTPStatuses[code]; ok {
+		return status
+	}
+
+	return http.StatusInternalServerError
+}
+
+func toGRPCCode(code errorcode.Code) codes.Code {
+	if grpcCode, ok := enums.GRPCCodes[code]; ok {
+		return grpcCode
+	}
+
+	return codes.Internal
+}
@@ -0,0 +1,106 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errors
+
+import (
+	stdErrors "errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/errorcode"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("should set http status and grpc code from the error code", func(t *testing.T) {
+		appError := New(errorcode.NotFound, "repository not found")
+
+		assert.Equal(t, http.StatusNotFound, appError.HTTPStatus)
+		assert.Equal(t, codes.NotFound, appError.GRPCCode)
+		assert.Equal(t, "not_found: repository not found", appError.Error())
+	})
+
+	t.Run("should set internal status when unknown code", func(t *testing.T) {
+		appError := New("test", "test")
+
+		assert.Equal(t, http.StatusInternalServerError, appError.HTTPStatus)
+		assert.Equal(t, codes.Internal, appError.GRPCCode)
+		assert.True(t, appError.IsInternal())
+	})
+
+	t.Run("should override status, code and metadata", func(t *testing.T) {
+		appError := New(errorcode.Conflict, "test").WithHTTPStatus(http.StatusPreconditionFailed).
+			WithGRPCCode(codes.FailedPrecondition).WithMetadata("field", "name")
+
+		assert.Equal(t, http.StatusPreconditionFailed, appError.HTTPStatus)
+		assert.Equal(t, codes.FailedPrecondition, appError.GRPCCode)
+		assert.Equal(t, "name", appError.Metadata["field"])
+	})
+
+	t.Run("should set the message key and data", func(t *testing.T) {
+		appError := New(errorcode.NotFound, "test").WithMessageKey("test.key", map[string]interface{}{"id": 1})
+
+		assert.Equal(t, "test.key", appError.MessageKey)
+		assert.Equal(t, 1, appError.MessageData["id"])
+	})
+}
+
+func TestWrap(t *testing.T) {
+	t.Run("should return nil when error is nil", func(t *testing.T) {
+		assert.NoError(t, Wrap(nil, errorcode.Internal, "test"))
+	})
+
+	t.Run("should keep the cause on the chain", func(t *testing.T) {
+		cause := stdErrors.New("connection refused")
+		err := Wrap(cause, errorcode.Unavailable, "database unavailable")
+
+		assert.ErrorIs(t, err, cause)
+		assert.Equal(t, "unavailable: database unavailable: connection refused", err.Error())
+	})
+}
+
+func TestIsAndAs(t *testing.T) {
+	t.Run("should match app errors with the same code", func(t *testing.T) {
+		err := fmt.Errorf("test: %w", New(errorcode.NotFound, "test"))
+
+		assert.True(t, Is(err, New(errorcode.NotFound, "")))
+		assert.False(t, Is(err, New(errorcode.Conflict, "")))
+		assert.True(t, HasCode(err, errorcode.NotFound))
+		assert.False(t, HasCode(stdErrors.New("test"), errorcode.NotFound))
+	})
+
+	t.Run("should return the app error of the chain", func(t *testing.T) {
+		expected := New(errorcode.Timeout, "test")
+
+		appError, ok := As(fmt.Errorf("test: %w", expected))
+		assert.True(t, ok)
+		assert.Equal(t, expected, appError)
+
+		_, ok = As(stdErrors.New("test"))
+		assert.False(t, ok)
+	})
+}
+
+func TestLogInternal(t *testing.T) {
+	t.Run("should not panic when logging errors", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			LogInternal(New(errorcode.NotFound, "test"))
+			LogInternal(New(errorcode.Internal, "test").WithCause(stdErrors.New("test")).WithMetadata("id", 1))
+		})
+	})
+}
@@ -20,5 +20,6 @@ var (
 	ErrorBrokerIsNotHealth    = errors.New("{ERROR_HTTP} broker is not health")
 	ErrorDatabaseIsNotHealth  = errors.New("{ERROR_HTTP} database is not health")
 	ErrorGrpcIsNotHealth      = errors.New("{ERROR_HTTP} grpc is not health")
+	ErrorRedisIsNotHealth     = errors.New("{ERROR_HTTP} redis is not health")
 	ErrorGenericInternalError = errors.New("{ERROR_HTTP} something went wrong, sorry for the inconvenience")
 )
@@ -18,9 +18,14 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"github.com/ZupIT/horusec-devkit/pkg/enums/errorcode"
+	appErrors "github.com/ZupIT/horusec-devkit/pkg/utils/errors"
 	httpEntities "github.com/ZupIT/horusec-devkit/pkg/utils/http/entities"
 	httpEnums "github.com/ZupIT/horusec-devkit/pkg/utils/http/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/i18n"
+	i18nEnums "github.com/ZupIT/horusec-devkit/pkg/utils/i18n/enums"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/sanitize"
 )
 
 func StatusOK(w http.ResponseWriter, content interface{}) {
@@ -87,6 +92,22 @@ func StatusConflict(w http.ResponseWriter, err error) {
 	setResponseWriter(w, response)
 }
 
+func StatusTooManyRequests(w http.ResponseWriter, err error) {
+	response := &httpEntities.Response{}
+	response.SetResponseData(http.StatusTooManyRequests,
+		http.StatusText(http.StatusTooManyRequests), getErrorMessage(err))
+
+	setResponseWriter(w, response)
+}
+
+func StatusRequestEntityTooLarge(w http.ResponseWriter, err error) {
+	response := &httpEntities.Response{}
+	response.SetResponseData(http.StatusRequestEntityTooLarge,
+		http.StatusText(http.StatusRequestEntityTooLarge), getErrorMessage(err))
+
+	setResponseWriter(w, response)
+}
+
 func StatusInternalServerError(w http.ResponseWriter, err error) {
 	response := &httpEntities.Response{}
 	response.SetResponseData(http.StatusInternalServerError,
@@ -103,16 +124,63 @@ func StatusUnprocessableEntity(w http.ResponseWriter, err error) {
 	setResponseWriter(w, response)
 }
 
+// StatusError writes the status and public message of the app error, logging the cause of the internal ones, while
+// any other error is written as an internal server error
+func StatusError(w http.ResponseWriter, err error) {
+	appError, ok := appErrors.As(err)
+	if !ok {
+		StatusInternalServerError(w, err)
+
+		return
+	}
+
+	appErrors.LogInternal(appError)
+
+	response := &httpEntities.Response{}
+	response.SetResponseData(appError.HTTPStatus, http.StatusText(appError.HTTPStatus),
+		sanitize.ForResponse(appError.Message))
+
+	setResponseWriter(w, response)
+}
+
+// StatusLocalizedError works like StatusError, but translates the message to the language negotiated with the
+// Accept-Language header of the request. The app errors without message key keep their message, while any other
+// error is written with the generic internal error message.
+func StatusLocalizedError(w http.ResponseWriter, r *http.Request, err error) {
+	catalog := i18n.Default()
+	tag := catalog.Negotiate(r.Header.Get(i18nEnums.HeaderAcceptLanguage))
+	w.Header().Set(i18nEnums.HeaderContentLanguage, tag.String())
+
+	appError, ok := appErrors.As(err)
+	if !ok {
+		appError = appErrors.New(errorcode.Internal, "").WithCause(err).
+			WithMessageKey(i18n.ErrorKey(errorcode.Internal), nil)
+	}
+
+	message := appError.Message
+	if appError.MessageKey != "" {
+		message = catalog.Translate(tag, appError.MessageKey, appError.MessageData)
+	}
+
+	appErrors.LogInternal(appError)
+
+	response := &httpEntities.Response{}
+	response.SetResponseData(appError.HTTPStatus, http.StatusText(appError.HTTPStatus), sanitize.ForResponse(message))
+
+	setResponseWriter(w, response)
+}
+
 func setResponseWriter(w http.ResponseWriter, response *httpEntities.Response) {
 	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Access-Control-Allow-Origin", "*")
 	w.WriteHeader(response.GetStatusCode())
 	_ = json.NewEncoder(w).Encode(response)
 }
 
+// getErrorMessage sanitizes the message, since the errors may contain values sent by the user, like a crafted
+// repository name
 func getErrorMessage(err error) string {
 	if err != nil {
-		return err.Error()
+		return sanitize.ForResponse(err.Error())
 	}
 
 	return ""
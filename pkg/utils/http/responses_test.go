@@ -23,6 +23,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/ZupIT/horusec-devkit/pkg/enums/errorcode"
+	appErrors "github.com/ZupIT/horusec-devkit/pkg/utils/errors"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/http/enums"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
 )
@@ -58,6 +60,14 @@ func TestStatusBadRequest(t *testing.T) {
 
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 	})
+
+	t.Run("should strip control characters of the error message", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		StatusBadRequest(w, errors.New("invalid repository test\r\n<script>"))
+
+		assert.Contains(t, w.Body.String(), `"content":"invalid repository test\u003cscript\u003e"`)
+	})
 }
 
 func TestStatusNotFound(t *testing.T) {
@@ -82,6 +92,28 @@ func TestStatusConflict(t *testing.T) {
 	})
 }
 
+func TestStatusTooManyRequests(t *testing.T) {
+	t.Run("should return status code 429", func(t *testing.T) {
+		_, _ = http.NewRequest(http.MethodGet, "/test", nil)
+		w := httptest.NewRecorder()
+
+		StatusTooManyRequests(w, errors.New("test"))
+
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	})
+}
+
+func TestStatusRequestEntityTooLarge(t *testing.T) {
+	t.Run("should return status code 413", func(t *testing.T) {
+		_, _ = http.NewRequest(http.MethodPost, "/test", nil)
+		w := httptest.NewRecorder()
+
+		StatusRequestEntityTooLarge(w, errors.New("test"))
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	})
+}
+
 func TestStatusInternalServerError(t *testing.T) {
 	t.Run("should return status code 500", func(t *testing.T) {
 		_, _ = http.NewRequest(http.MethodPost, "/test", nil)
@@ -193,3 +225,63 @@ func TestStatusUnprocessableEntity(t *testing.T) {
 		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
 	})
 }
+
+func TestStatusError(t *testing.T) {
+	t.Run("should write the status and public message of the app error", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		StatusError(w, appErrors.Wrap(errors.New("secret"), errorcode.NotFound, "repository not found"))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), "repository not found")
+		assert.NotContains(t, w.Body.String(), "secret")
+	})
+
+	t.Run("should write internal server error when not an app error", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		StatusError(w, errors.New("secret"))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.NotContains(t, w.Body.String(), "secret")
+	})
+}
+
+func TestStatusLocalizedError(t *testing.T) {
+	newRequest := func(acceptLanguage string) *http.Request {
+		r := httptest.NewRequest(http.MethodGet, "/test", nil)
+		r.Header.Set("Accept-Language", acceptLanguage)
+
+		return r
+	}
+
+	t.Run("should translate the message key to the request language", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		StatusLocalizedError(w, newRequest("pt-BR,pt;q=0.9"), appErrors.New(errorcode.NotFound, "not found").
+			WithMessageKey("errors.not_found", nil))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, "pt-BR", w.Header().Get("Content-Language"))
+		assert.Contains(t, w.Body.String(), "O recurso solicitado não foi encontrado.")
+	})
+
+	t.Run("should keep the message when app error has no message key", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		StatusLocalizedError(w, newRequest("es"), appErrors.New(errorcode.Conflict, "repository already exists"))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), "repository already exists")
+	})
+
+	t.Run("should write the translated internal error when not an app error", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		StatusLocalizedError(w, newRequest("es"), errors.New("secret"))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Contains(t, w.Body.String(), "Algo salió mal, inténtelo de nuevo más tarde.")
+		assert.NotContains(t, w.Body.String(), "secret")
+	})
+}
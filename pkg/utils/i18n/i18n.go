@@ -0,0 +1,192 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package i18n
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+
+	"golang.org/x/text/language"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/errorcode"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/i18n/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+// nolint:gochecknoglobals // embedded catalogs of the languages supported by the platform ui
+var (
+	//go:embed catalogs/*.json
+	embeddedCatalogs embed.FS
+
+	defaultCatalog     *Catalog
+	defaultCatalogOnce sync.Once
+)
+
+// Catalog keeps the messages of each language, which are text templates, like "Hello {{.name}}", rendered with the
+// data given on Translate. The messages missing on a language are taken from the fallback language.
+type Catalog struct {
+	fallback  language.Tag
+	languages []language.Tag
+	matcher   language.Matcher
+	messages  map[language.Tag]map[string]*template.Template
+}
+
+// NewCatalog loads the json files on the root of the file system, named as their language tag, like pt-BR.json
+func NewCatalog(fsys fs.FS, fallback language.Tag) (*Catalog, error) {
+	catalog := &Catalog{fallback: fallback, messages: map[language.Tag]map[string]*template.Template{}}
+
+	files, err := fs.Glob(fsys, "*"+enums.CatalogExtension)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range files {
+		if err := catalog.load(fsys, file); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, ok := catalog.messages[fallback]; !ok {
+		return nil, enums.ErrorFallbackNotLoaded
+	}
+
+	catalog.languages = append([]language.Tag{fallback}, catalog.languagesWithoutFallback()...)
+	catalog.matcher = language.NewMatcher(catalog.languages)
+
+	return catalog, nil
+}
+
+// Default returns the catalog of the embedded en, pt-BR and es messages, using english as fallback
+func Default() *Catalog {
+	defaultCatalogOnce.Do(func() {
+		catalogs, err := fs.Sub(embeddedCatalogs, "catalogs")
+		if err == nil {
+			defaultCatalog, err = NewCatalog(catalogs, language.English)
+		}
+
+		logger.LogPanic(enums.MessageFailedToLoadCatalogs, err)
+	})
+
+	return defaultCatalog
+}
+
+func (c *Catalog) load(fsys fs.FS, file string) error {
+	tag, err := language.Parse(strings.TrimSuffix(path.Base(file), enums.CatalogExtension))
+	if err != nil {
+		return fmt.Errorf("%w: %s: %s", enums.ErrorInvalidCatalog, file, err.Error())
+	}
+
+	content, err := fs.ReadFile(fsys, file)
+	if err != nil {
+		return err
+	}
+
+	messages := map[string]string{}
+	if err := json.Unmarshal(content, &messages); err != nil {
+		return fmt.Errorf("%w: %s: %s", enums.ErrorInvalidCatalog, file, err.Error())
+	}
+
+	c.messages[tag] = make(map[string]*template.Template, len(messages))
+
+	for key, message := range messages {
+		parsed, err := template.New(key).Parse(message)
+		if err != nil {
+			return fmt.Errorf("%w: %s: %s", enums.ErrorInvalidCatalog, file, err.Error())
+		}
+
+		c.messages[tag][key] = parsed
+	}
+
+	return nil
+}
+
+func (c *Catalog) languagesWithoutFallback() []language.Tag {
+	languages := make([]language.Tag, 0, len(c.messages))
+
+	for tag := range c.messages {
+		if tag != c.fallback {
+			languages = append(languages, tag)
+		}
+	}
+
+	sort.Slice(languages, func(i, j int) bool {
+		return languages[i].String() < languages[j].String()
+	})
+
+	return languages
+}
+
+// Negotiate returns the loaded language that best matches the Accept-Language header, or the fallback language
+func (c *Catalog) Negotiate(acceptLanguage string) language.Tag {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return c.fallback
+	}
+
+	_, index, confidence := c.matcher.Match(tags...)
+	if confidence == language.No {
+		return c.fallback
+	}
+
+	return c.languages[index]
+}
+
+// Translate renders the message of the key on the language, using the fallback language when the key is missing and
+// the key itself when no language has it, so a missing translation never returns an empty message
+func (c *Catalog) Translate(tag language.Tag, key string, data interface{}) string {
+	message, ok := c.messages[tag][key]
+	if !ok {
+		if message, ok = c.messages[c.fallback][key]; !ok {
+			return key
+		}
+	}
+
+	buffer := &bytes.Buffer{}
+	if err := message.Execute(buffer, data); err != nil {
+		logger.LogError(enums.MessageFailedToRenderMessage, err, map[string]interface{}{enums.LogFieldKey: key})
+
+		return key
+	}
+
+	return buffer.String()
+}
+
+// Has reports if the key exists on the language or on the fallback language
+func (c *Catalog) Has(tag language.Tag, key string) bool {
+	_, ok := c.messages[tag][key]
+	if !ok {
+		_, ok = c.messages[c.fallback][key]
+	}
+
+	return ok
+}
+
+// ErrorKey returns the key of the generic message of the error code
+func ErrorKey(code errorcode.Code) string {
+	return enums.KeyErrorPrefix + code.ToString()
+}
+
+// EmailSubjectKey returns the key of the subject of the email template
+func EmailSubjectKey(templateName string) string {
+	return enums.KeyEmailPrefix + templateName + enums.KeyEmailSubjectSuffix
+}
@@ -0,0 +1,110 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package i18n
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/errorcode"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/i18n/enums"
+)
+
+func TestDefault(t *testing.T) {
+	t.Run("should load every embedded catalog with the same keys", func(t *testing.T) {
+		catalog := Default()
+
+		assert.Len(t, catalog.languages, 3)
+
+		for _, tag := range catalog.languages {
+			assert.Len(t, catalog.messages[tag], len(catalog.messages[language.English]), tag.String())
+		}
+
+		for _, code := range errorcode.Values() {
+			assert.True(t, catalog.Has(language.English, ErrorKey(code)), code)
+		}
+	})
+}
+
+func TestNewCatalog(t *testing.T) {
+	t.Run("should return error when invalid catalog", func(t *testing.T) {
+		for _, fsys := range []fstest.MapFS{
+			{"test.json": {Data: []byte("{}")}, "en.json": {Data: []byte("{}")}},
+			{"en.json": {Data: []byte("test")}},
+			{"en.json": {Data: []byte(`{"test": "{{.test"}`)}},
+		} {
+			_, err := NewCatalog(fsys, language.English)
+
+			assert.ErrorIs(t, err, enums.ErrorInvalidCatalog)
+		}
+	})
+
+	t.Run("should return error when fallback language is not loaded", func(t *testing.T) {
+		_, err := NewCatalog(fstest.MapFS{"es.json": {Data: []byte("{}")}}, language.English)
+
+		assert.ErrorIs(t, err, enums.ErrorFallbackNotLoaded)
+	})
+}
+
+func TestNegotiate(t *testing.T) {
+	catalog := Default()
+
+	t.Run("should return the best matching language", func(t *testing.T) {
+		assert.Equal(t, language.BrazilianPortuguese, catalog.Negotiate("pt-BR,pt;q=0.9,en;q=0.8"))
+		assert.Equal(t, language.BrazilianPortuguese, catalog.Negotiate("pt"))
+		assert.Equal(t, language.Spanish, catalog.Negotiate("fr;q=0.9,es-AR;q=0.8"))
+	})
+
+	t.Run("should return fallback when no language matches or header is invalid", func(t *testing.T) {
+		assert.Equal(t, language.English, catalog.Negotiate(""))
+		assert.Equal(t, language.English, catalog.Negotiate("ja"))
+		assert.Equal(t, language.English, catalog.Negotiate("!!!"))
+	})
+}
+
+func TestTranslate(t *testing.T) {
+	catalog, err := NewCatalog(fstest.MapFS{
+		"en.json":    {Data: []byte(`{"hello": "Hello {{.name}}", "only-en": "English", "invalid": "{{.name.test}}"}`)},
+		"pt-BR.json": {Data: []byte(`{"hello": "Olá {{.name}}"}`)},
+	}, language.English)
+	assert.NoError(t, err)
+
+	t.Run("should render the message of the language", func(t *testing.T) {
+		data := map[string]interface{}{"name": "test"}
+
+		assert.Equal(t, "Olá test", catalog.Translate(language.BrazilianPortuguese, "hello", data))
+		assert.Equal(t, "Hello test", catalog.Translate(language.English, "hello", data))
+	})
+
+	t.Run("should use the fallback language and then the key", func(t *testing.T) {
+		assert.Equal(t, "English", catalog.Translate(language.BrazilianPortuguese, "only-en", nil))
+		assert.Equal(t, "missing", catalog.Translate(language.BrazilianPortuguese, "missing", nil))
+		assert.False(t, catalog.Has(language.English, "missing"))
+	})
+
+	t.Run("should return the key when failed to render", func(t *testing.T) {
+		assert.Equal(t, "invalid", catalog.Translate(language.English, "invalid", map[string]string{"name": "a"}))
+	})
+}
+
+func TestKeys(t *testing.T) {
+	t.Run("should return the error and email subject keys", func(t *testing.T) {
+		assert.Equal(t, "errors.not_found", ErrorKey(errorcode.NotFound))
+		assert.Equal(t, "email.reset-password.subject", EmailSubjectKey("reset-password"))
+	})
+}
@@ -0,0 +1,54 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContextWithAccountID(t *testing.T) {
+	t.Run("should success set and get account id from context", func(t *testing.T) {
+		accountID := uuid.New()
+
+		result, ok := GetAccountIDFromContext(ContextWithAccountID(context.Background(), accountID))
+
+		assert.True(t, ok)
+		assert.Equal(t, accountID, result)
+	})
+
+	t.Run("should return false when context does not contain account id", func(t *testing.T) {
+		result, ok := GetAccountIDFromContext(context.Background())
+
+		assert.False(t, ok)
+		assert.Equal(t, uuid.Nil, result)
+	})
+
+	t.Run("should return false when account id is nil", func(t *testing.T) {
+		_, ok := GetAccountIDFromContext(ContextWithAccountID(context.Background(), uuid.Nil))
+
+		assert.False(t, ok)
+	})
+
+	t.Run("should return false when context is nil", func(t *testing.T) {
+		//nolint:staticcheck // testing nil context on purpose
+		_, ok := GetAccountIDFromContext(nil)
+
+		assert.False(t, ok)
+	})
+}
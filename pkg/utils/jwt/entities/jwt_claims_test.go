@@ -35,6 +35,18 @@ func TestValidate(t *testing.T) {
 		assert.NoError(t, claims.Validate())
 	})
 
+	t.Run("should return no error when the email is not a valid address", func(t *testing.T) {
+		claims := &JWTClaims{
+			Email:    "admin@localhost",
+			Username: "admin",
+			StandardClaims: jwt.StandardClaims{
+				Subject: uuid.New().String(),
+			},
+		}
+
+		assert.NoError(t, claims.Validate())
+	})
+
 	t.Run("should return error when invalid", func(t *testing.T) {
 		claims := &JWTClaims{}
 
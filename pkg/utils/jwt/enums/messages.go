@@ -17,4 +17,7 @@ package enums
 const (
 	MessageWarningDefaultJWTSecretKey = "{INSECURE_JWT_SECRET} horusec JWT secret key is the default one. " +
 		"Please, replace it for a secure value. JWT secret key environment variable name (HORUSEC_JWT_SECRET_KEY)"
+	MessageTokenExpired     = "token is expired"
+	MessageTokenNotIssued   = "token used before issued"
+	MessageTokenNotValidYet = "token is not valid yet"
 )
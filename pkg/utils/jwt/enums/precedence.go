@@ -0,0 +1,45 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+// HeaderPrecedence is the header checked first when a request has both the X-Horusec-Authorization and the standard
+// Authorization headers, the other one is only used when the first is empty
+type HeaderPrecedence string
+
+const (
+	PrecedenceHorusecHeader       HeaderPrecedence = "horusec"
+	PrecedenceAuthorizationHeader HeaderPrecedence = "authorization"
+)
+
+func (h HeaderPrecedence) ToString() string {
+	return string(h)
+}
+
+func HeaderPrecedenceValues() []HeaderPrecedence {
+	return []HeaderPrecedence{
+		PrecedenceHorusecHeader,
+		PrecedenceAuthorizationHeader,
+	}
+}
+
+func (h HeaderPrecedence) IsValid() bool {
+	for _, value := range HeaderPrecedenceValues() {
+		if h == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -17,4 +17,8 @@ package enums
 const (
 	DefaultSecretJWT = "horusec-secret"
 	HorusecJWTHeader = "X-Horusec-Authorization"
+
+	AuthorizationHeader        = "Authorization"
+	BearerPrefix               = "Bearer "
+	HorusecJWTHeaderPrecedence = "HORUSEC_JWT_HEADER_PRECEDENCE"
 )
@@ -0,0 +1,79 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwt
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt/enums"
+)
+
+// GetTokenFromRequest returns the token of the X-Horusec-Authorization header or of the standard Authorization header
+// with the Bearer scheme, checking first the header set by HORUSEC_JWT_HEADER_PRECEDENCE
+func GetTokenFromRequest(r *http.Request) string {
+	return GetTokenFromRequestWithPrecedence(r, GetHeaderPrecedence())
+}
+
+// GetTokenFromRequestWithPrecedence returns the token without the Bearer scheme, the Authorization header is ignored
+// when it has other schemes, like the basic credentials
+func GetTokenFromRequestWithPrecedence(r *http.Request, precedence enums.HeaderPrecedence) string {
+	horusecToken := trimBearerPrefix(r.Header.Get(enums.HorusecJWTHeader))
+	authorizationToken := getAuthorizationToken(r)
+
+	if precedence == enums.PrecedenceAuthorizationHeader && authorizationToken != "" {
+		return authorizationToken
+	}
+
+	if horusecToken != "" {
+		return horusecToken
+	}
+
+	return authorizationToken
+}
+
+// GetHeaderPrecedence returns the precedence of HORUSEC_JWT_HEADER_PRECEDENCE, which defaults to the
+// X-Horusec-Authorization header when it is empty or invalid
+func GetHeaderPrecedence() enums.HeaderPrecedence {
+	precedence := enums.HeaderPrecedence(strings.ToLower(
+		env.GetEnvOrDefault(enums.HorusecJWTHeaderPrecedence, enums.PrecedenceHorusecHeader.ToString())))
+	if !precedence.IsValid() {
+		return enums.PrecedenceHorusecHeader
+	}
+
+	return precedence
+}
+
+func getAuthorizationToken(r *http.Request) string {
+	authorization := r.Header.Get(enums.AuthorizationHeader)
+	if !hasBearerPrefix(authorization) {
+		return ""
+	}
+
+	return trimBearerPrefix(authorization)
+}
+
+func hasBearerPrefix(value string) bool {
+	return len(value) >= len(enums.BearerPrefix) && strings.EqualFold(value[:len(enums.BearerPrefix)], enums.BearerPrefix)
+}
+
+func trimBearerPrefix(value string) string {
+	if hasBearerPrefix(value) {
+		return strings.TrimSpace(value[len(enums.BearerPrefix):])
+	}
+
+	return strings.TrimSpace(value)
+}
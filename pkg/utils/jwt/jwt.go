@@ -25,28 +25,35 @@ import (
 	"github.com/golang-jwt/jwt"
 	"github.com/google/uuid"
 
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt/entities"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt/enums"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
 )
 
+// nolint:gochecknoglobals // replaced by a fake clock on the tests of the token expiry
+var tokenClock = clock.NewClock()
+
 func CreateToken(tokenData *entities.TokenData, permissions []string) (string, time.Time, error) {
-	expiresAt := time.Now().Add(time.Hour * time.Duration(1))
+	issuedAt := tokenClock.Now()
+	expiresAt := issuedAt.Add(time.Hour * time.Duration(1))
 
-	tokenSigned, err := newTokenNotSignedWithClaims(tokenData, expiresAt, permissions).SignedString(getHorusecJWTKey())
+	tokenSigned, err := newTokenNotSignedWithClaims(tokenData, issuedAt, expiresAt, permissions).
+		SignedString(getHorusecJWTKey())
 
 	return tokenSigned, expiresAt, err
 }
 
-func newTokenNotSignedWithClaims(account *entities.TokenData, expiresAt time.Time, permissions []string) *jwt.Token {
+func newTokenNotSignedWithClaims(account *entities.TokenData, issuedAt, expiresAt time.Time,
+	permissions []string) *jwt.Token {
 	return jwt.NewWithClaims(jwt.SigningMethodHS256, &entities.JWTClaims{
 		Email:       account.Email,
 		Username:    account.Username,
 		Permissions: permissions,
 		StandardClaims: jwt.StandardClaims{
 			ExpiresAt: expiresAt.Unix(),
-			IssuedAt:  time.Now().Unix(),
+			IssuedAt:  issuedAt.Unix(),
 			Issuer:    "horusec",
 			Subject:   account.AccountID.String(),
 		},
@@ -62,17 +69,50 @@ func DecodeToken(tokenString string) (*entities.JWTClaims, error) {
 	return token.Claims.(*entities.JWTClaims), nil
 }
 
+// parseStringToToken skips the claims validation of the jwt package, since it uses the jwt.TimeFunc global, and
+// validates the time claims with the token clock instead
 func parseStringToToken(tokenString string) (*jwt.Token, error) {
-	return jwt.ParseWithClaims(tokenString, &entities.JWTClaims{}, func(token *jwt.Token) (interface{}, error) {
-		return getHorusecJWTKey(), nil
-	})
+	parser := &jwt.Parser{SkipClaimsValidation: true}
+
+	token, err := parser.ParseWithClaims(tokenString, &entities.JWTClaims{},
+		func(token *jwt.Token) (interface{}, error) {
+			return getHorusecJWTKey(), nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return token, validateTimeClaims(token.Claims.(*entities.JWTClaims))
+}
+
+func validateTimeClaims(claims *entities.JWTClaims) error {
+	now := tokenClock.Now().Unix()
+
+	if !claims.VerifyExpiresAt(now, false) {
+		return jwt.NewValidationError(enums.MessageTokenExpired, jwt.ValidationErrorExpired)
+	}
+
+	if !claims.VerifyIssuedAt(now, false) {
+		return jwt.NewValidationError(enums.MessageTokenNotIssued, jwt.ValidationErrorIssuedAt)
+	}
+
+	if !claims.VerifyNotBefore(now, false) {
+		return jwt.NewValidationError(enums.MessageTokenNotValidYet, jwt.ValidationErrorNotValidYet)
+	}
+
+	return nil
 }
 
+// AuthMiddleware accepts the token on the X-Horusec-Authorization or on the standard Authorization header, following
+// the precedence of HORUSEC_JWT_HEADER_PRECEDENCE
 func AuthMiddleware(next http.Handler) http.Handler {
 	middleware := jwtMiddleware.New(jwtMiddleware.Options{
 		ValidationKeyGetter: func(token *jwtGO.Token) (interface{}, error) {
 			return getHorusecJWTKey(), nil
 		},
+		Extractor: func(r *http.Request) (string, error) {
+			return GetTokenFromRequest(r), nil
+		},
 		SigningMethod: jwt.SigningMethodHS256,
 	})
 
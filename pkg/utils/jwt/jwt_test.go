@@ -20,11 +20,14 @@ import (
 	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt/entities"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt/enums"
 )
 
 func testHandler(w http.ResponseWriter, r *http.Request) {
@@ -63,6 +66,29 @@ func TestDecodeToken(t *testing.T) {
 		assert.NoError(t, claims.Valid())
 	})
 
+	t.Run("should return error when token is expired or used before issued", func(t *testing.T) {
+		start := time.Now()
+		fakeClock := clock.NewFakeClock(start)
+		tokenClock = fakeClock
+		defer func() { tokenClock = clock.NewClock() }()
+
+		token, _, err := CreateToken(&entities.TokenData{Email: "test@test.com", Username: "test",
+			AccountID: uuid.New()}, nil)
+		assert.NoError(t, err)
+
+		fakeClock.Advance(time.Hour)
+		_, err = DecodeToken(token)
+		assert.NoError(t, err)
+
+		fakeClock.Advance(time.Second)
+		_, err = DecodeToken(token)
+		assert.EqualError(t, err, enums.MessageTokenExpired)
+
+		fakeClock.Set(start.Add(-time.Minute))
+		_, err = DecodeToken(token)
+		assert.EqualError(t, err, enums.MessageTokenNotIssued)
+	})
+
 	t.Run("should return error invalid signature", func(t *testing.T) {
 		account := &entities.TokenData{
 			Email:     "test@test.com",
@@ -117,6 +143,83 @@ func TestAuthMiddleware(t *testing.T) {
 
 		assert.Equal(t, http.StatusUnauthorized, rr.Code)
 	})
+
+	t.Run("should return 200 when valid token on the horusec header", func(t *testing.T) {
+		handler := AuthMiddleware(http.HandlerFunc(testHandler))
+
+		req, _ := http.NewRequest("GET", "http://test", nil)
+
+		token, _, _ := CreateToken(&entities.TokenData{
+			AccountID: uuid.New(),
+			Email:     "test@test.com",
+			Username:  "test",
+		}, nil)
+
+		req.Header.Set("X-Horusec-Authorization", token)
+
+		rr := httptest.NewRecorder()
+
+		handler.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("should return 401 when there is no token", func(t *testing.T) {
+		handler := AuthMiddleware(http.HandlerFunc(testHandler))
+
+		req, _ := http.NewRequest("GET", "http://test", nil)
+		req.Header.Set("Authorization", "Basic dGVzdDp0ZXN0")
+
+		rr := httptest.NewRecorder()
+
+		handler.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+}
+
+func TestGetTokenFromRequest(t *testing.T) {
+	newRequest := func(headers map[string]string) *http.Request {
+		req, _ := http.NewRequest("GET", "http://test", nil)
+		for key, value := range headers {
+			req.Header.Set(key, value)
+		}
+
+		return req
+	}
+
+	t.Run("should return the token of the horusec header first by default", func(t *testing.T) {
+		req := newRequest(map[string]string{"X-Horusec-Authorization": "horusec", "Authorization": "Bearer standard"})
+
+		assert.Equal(t, "horusec", GetTokenFromRequest(req))
+	})
+
+	t.Run("should return the token of the authorization header first when configured", func(t *testing.T) {
+		t.Setenv(enums.HorusecJWTHeaderPrecedence, "Authorization")
+		req := newRequest(map[string]string{"X-Horusec-Authorization": "horusec", "Authorization": "Bearer standard"})
+
+		assert.Equal(t, "standard", GetTokenFromRequest(req))
+	})
+
+	t.Run("should fallback to the other header when the first is empty", func(t *testing.T) {
+		assert.Equal(t, "standard", GetTokenFromRequestWithPrecedence(
+			newRequest(map[string]string{"Authorization": "bearer standard"}), enums.PrecedenceHorusecHeader))
+		assert.Equal(t, "horusec", GetTokenFromRequestWithPrecedence(
+			newRequest(map[string]string{"X-Horusec-Authorization": "Bearer horusec"}),
+			enums.PrecedenceAuthorizationHeader))
+	})
+
+	t.Run("should ignore the authorization header without the bearer scheme", func(t *testing.T) {
+		req := newRequest(map[string]string{"Authorization": "Basic dGVzdDp0ZXN0"})
+
+		assert.Empty(t, GetTokenFromRequestWithPrecedence(req, enums.PrecedenceAuthorizationHeader))
+	})
+
+	t.Run("should use the horusec header precedence when the env is invalid", func(t *testing.T) {
+		t.Setenv(enums.HorusecJWTHeaderPrecedence, "test")
+
+		assert.Equal(t, enums.PrecedenceHorusecHeader, GetHeaderPrecedence())
+	})
 }
 
 func TestGetAccountIDByJWTToken(t *testing.T) {
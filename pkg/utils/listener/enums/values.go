@@ -0,0 +1,35 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+const (
+	NetworkTCP     = "tcp"
+	NetworkUnix    = "unix"
+	NetworkSystemd = "systemd"
+
+	HTTPPrefix = "HORUSEC_HTTP"
+	GRPCPrefix = "HORUSEC_GRPC"
+
+	ListenerNetworkSuffix   = "_LISTENER_NETWORK"
+	UnixSocketPathSuffix    = "_UNIX_SOCKET_PATH"
+	UnixSocketModeSuffix    = "_UNIX_SOCKET_MODE"
+	SystemdSocketNameSuffix = "_SYSTEMD_SOCKET_NAME"
+	DefaultUnixSocketMode   = "0660"
+	SystemdListenPid        = "LISTEN_PID"
+	SystemdListenFds        = "LISTEN_FDS"
+	SystemdListenFdNames    = "LISTEN_FDNAMES"
+	SystemdListenFdsStart   = 3
+	SystemdFdNamesSeparator = ":"
+)
@@ -0,0 +1,105 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package listener
+
+import (
+	"net"
+	"os"
+	"strconv"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/listener/enums"
+)
+
+// Options selects where the server listens. The unix socket is useful when a sidecar proxy runs in the same pod,
+// and systemd allows the socket to be created before the service starts, without losing connections on restarts.
+type Options struct {
+	Network     string
+	Address     string
+	SocketPath  string
+	SocketMode  os.FileMode
+	SystemdName string
+}
+
+// NewOptions reads the envs with the given prefix, like HORUSEC_HTTP_LISTENER_NETWORK, so the http and grpc servers
+// of the same service can listen in different sockets
+func NewOptions(prefix, defaultAddress string) *Options {
+	return &Options{
+		Network:     env.GetEnvOrDefault(prefix+enums.ListenerNetworkSuffix, enums.NetworkTCP),
+		Address:     defaultAddress,
+		SocketPath:  env.GetEnvOrDefault(prefix+enums.UnixSocketPathSuffix, ""),
+		SocketMode:  parseMode(env.GetEnvOrDefault(prefix+enums.UnixSocketModeSuffix, enums.DefaultUnixSocketMode)),
+		SystemdName: env.GetEnvOrDefault(prefix+enums.SystemdSocketNameSuffix, ""),
+	}
+}
+
+func parseMode(mode string) os.FileMode {
+	parsed, err := strconv.ParseUint(mode, 8, 32)
+	if err != nil {
+		parsed, _ = strconv.ParseUint(enums.DefaultUnixSocketMode, 8, 32)
+	}
+
+	return os.FileMode(parsed)
+}
+
+func (o *Options) Listen() (net.Listener, error) {
+	switch o.Network {
+	case enums.NetworkTCP, "":
+		return net.Listen(enums.NetworkTCP, o.Address)
+	case enums.NetworkUnix:
+		return listenUnix(o.SocketPath, o.SocketMode)
+	case enums.NetworkSystemd:
+		return listenSystemd(o.SystemdName)
+	default:
+		return nil, enums.ErrorInvalidNetwork
+	}
+}
+
+func listenUnix(path string, mode os.FileMode) (net.Listener, error) {
+	if err := removeStaleSocket(path); err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen(enums.NetworkUnix, path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.Chmod(path, mode); err != nil {
+		_ = listener.Close()
+
+		return nil, err
+	}
+
+	return listener, nil
+}
+
+// removeStaleSocket removes the socket left by a process that was killed, refusing to remove other kind of files
+func removeStaleSocket(path string) error {
+	info, err := os.Lstat(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if info.Mode()&os.ModeSocket == 0 {
+		return enums.ErrorPathIsNotSocket
+	}
+
+	return os.Remove(path)
+}
@@ -0,0 +1,125 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package listener
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/listener/enums"
+)
+
+func TestNewOptions(t *testing.T) {
+	t.Run("should return tcp options by default", func(t *testing.T) {
+		options := NewOptions(enums.HTTPPrefix, ":8000")
+
+		assert.Equal(t, enums.NetworkTCP, options.Network)
+		assert.Equal(t, ":8000", options.Address)
+		assert.Equal(t, os.FileMode(0o660), options.SocketMode)
+	})
+
+	t.Run("should read options with the given prefix", func(t *testing.T) {
+		t.Setenv("HORUSEC_GRPC_LISTENER_NETWORK", "unix")
+		t.Setenv("HORUSEC_GRPC_UNIX_SOCKET_PATH", "/tmp/test.sock")
+		t.Setenv("HORUSEC_GRPC_UNIX_SOCKET_MODE", "0600")
+		t.Setenv("HORUSEC_GRPC_SYSTEMD_SOCKET_NAME", "grpc")
+
+		options := NewOptions(enums.GRPCPrefix, ":8007")
+
+		assert.Equal(t, enums.NetworkUnix, options.Network)
+		assert.Equal(t, "/tmp/test.sock", options.SocketPath)
+		assert.Equal(t, os.FileMode(0o600), options.SocketMode)
+		assert.Equal(t, "grpc", options.SystemdName)
+	})
+
+	t.Run("should use default mode when invalid", func(t *testing.T) {
+		assert.Equal(t, os.FileMode(0o660), parseMode("invalid"))
+	})
+}
+
+func TestListen(t *testing.T) {
+	t.Run("should listen in tcp address", func(t *testing.T) {
+		listener, err := (&Options{Network: enums.NetworkTCP, Address: "127.0.0.1:0"}).Listen()
+		assert.NoError(t, err)
+
+		assert.NoError(t, listener.Close())
+	})
+
+	t.Run("should listen in unix socket with mode and replace stale socket", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "test.sock")
+		options := &Options{Network: enums.NetworkUnix, SocketPath: path, SocketMode: 0o600}
+
+		stale, err := options.Listen()
+		assert.NoError(t, err)
+
+		listener, err := options.Listen()
+		assert.NoError(t, err)
+
+		info, err := os.Stat(path)
+		assert.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+		_ = stale.Close()
+		_ = listener.Close()
+	})
+
+	t.Run("should return error when socket path is not a socket", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "test.sock")
+		assert.NoError(t, os.WriteFile(path, []byte("test"), 0o600))
+
+		_, err := (&Options{Network: enums.NetworkUnix, SocketPath: path}).Listen()
+
+		assert.Equal(t, enums.ErrorPathIsNotSocket, err)
+	})
+
+	t.Run("should return error when invalid network", func(t *testing.T) {
+		_, err := (&Options{Network: "invalid"}).Listen()
+
+		assert.Equal(t, enums.ErrorInvalidNetwork, err)
+	})
+}
+
+func TestListenSystemd(t *testing.T) {
+	t.Run("should return error when not activated by systemd", func(t *testing.T) {
+		t.Setenv(enums.SystemdListenPid, "1")
+
+		_, err := (&Options{Network: enums.NetworkSystemd}).Listen()
+
+		assert.Equal(t, enums.ErrorSystemdNotActivated, err)
+	})
+
+	t.Run("should return error when there are no sockets", func(t *testing.T) {
+		t.Setenv(enums.SystemdListenPid, strconv.Itoa(os.Getpid()))
+		t.Setenv(enums.SystemdListenFds, "0")
+
+		_, err := listenSystemd("")
+
+		assert.Equal(t, enums.ErrorSystemdNotActivated, err)
+	})
+
+	t.Run("should return error when socket name was not found", func(t *testing.T) {
+		t.Setenv(enums.SystemdListenPid, strconv.Itoa(os.Getpid()))
+		t.Setenv(enums.SystemdListenFds, "1")
+		t.Setenv(enums.SystemdListenFdNames, "http")
+
+		_, err := listenSystemd("grpc")
+
+		assert.Equal(t, enums.ErrorSystemdSocketNotFound, err)
+	})
+}
@@ -0,0 +1,60 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package listener
+
+import (
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/listener/enums"
+)
+
+// listenSystemd inherits the sockets passed by systemd starting at the file descriptor 3. When a name is informed
+// the socket is selected by the FileDescriptorName of the socket unit, otherwise the first one is used.
+func listenSystemd(name string) (net.Listener, error) {
+	count, err := getSystemdFdsCount()
+	if err != nil {
+		return nil, err
+	}
+
+	names := strings.Split(os.Getenv(enums.SystemdListenFdNames), enums.SystemdFdNamesSeparator)
+
+	for index := 0; index < count; index++ {
+		if name == "" || (index < len(names) && names[index] == name) {
+			file := os.NewFile(uintptr(enums.SystemdListenFdsStart+index), name)
+			defer file.Close()
+
+			return net.FileListener(file)
+		}
+	}
+
+	return nil, enums.ErrorSystemdSocketNotFound
+}
+
+func getSystemdFdsCount() (int, error) {
+	pid, err := strconv.Atoi(os.Getenv(enums.SystemdListenPid))
+	if err != nil || pid != os.Getpid() {
+		return 0, enums.ErrorSystemdNotActivated
+	}
+
+	count, err := strconv.Atoi(os.Getenv(enums.SystemdListenFds))
+	if err != nil || count < 1 {
+		return 0, enums.ErrorSystemdNotActivated
+	}
+
+	return count, nil
+}
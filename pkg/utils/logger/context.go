@@ -0,0 +1,68 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/propagation"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/sanitize"
+)
+
+// ContextLogger logs with the request id of the context as a field, so the logs of a request can be found on all
+// services that handled it. It works like the package functions when the context has no request id.
+type ContextLogger struct {
+	requestID string
+}
+
+func FromContext(ctx context.Context) *ContextLogger {
+	return &ContextLogger{requestID: propagation.GetRequestID(ctx)}
+}
+
+func (c *ContextLogger) LogError(msg string, err error, args ...map[string]interface{}) {
+	if err != nil {
+		newEntryFrom(c.entry(), err, args).Error(sanitize.ForLog(msg))
+	}
+}
+
+func (c *ContextLogger) LogWarn(msg string, args ...interface{}) {
+	c.entry().Warn(formatMessage(msg, args))
+}
+
+func (c *ContextLogger) LogInfo(msg string, args ...interface{}) {
+	c.entry().Info(formatMessage(msg, args))
+}
+
+func (c *ContextLogger) LogInfoWithFields(msg string, fields map[string]interface{}) {
+	c.entry().WithFields(sanitizeFields(fields)).Info(sanitize.ForLog(msg))
+}
+
+func (c *ContextLogger) LogDebugWithLevel(msg string, args ...interface{}) {
+	if logrus.IsLevelEnabled(enums.DebugLevel) {
+		c.entry().Debug(formatMessage(msg, args))
+	}
+}
+
+func (c *ContextLogger) entry() *logrus.Entry {
+	entry := logrus.NewEntry(logrus.StandardLogger())
+	if c.requestID != "" {
+		return entry.WithField(enums.FieldRequestID, sanitize.ForLog(c.requestID))
+	}
+
+	return entry
+}
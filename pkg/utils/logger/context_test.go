@@ -0,0 +1,67 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/propagation"
+)
+
+func TestFromContext(t *testing.T) {
+	output := bytes.NewBufferString("")
+	LogSetOutput(output)
+	SetLogLevel("debug")
+
+	defer func() {
+		LogSetOutput(os.Stderr)
+		SetLogLevel("info")
+	}()
+
+	t.Run("should log with the request id of the context", func(t *testing.T) {
+		log := FromContext(propagation.ContextWithRequestID(context.Background(), "request\nid"))
+
+		log.LogError("error", errors.New("test"), map[string]interface{}{"field": "test"})
+		log.LogError("ignored", nil)
+		log.LogWarn("warn")
+		log.LogInfo("info")
+		log.LogInfoWithFields("fields", map[string]interface{}{"field": "test"})
+		log.LogDebugWithLevel("debug")
+
+		lines := bytes.Split(bytes.TrimSpace(output.Bytes()), []byte("\n"))
+		assert.Len(t, lines, 5)
+
+		for _, line := range lines {
+			assert.Contains(t, string(line), "request_id=requestid")
+		}
+
+		assert.NotContains(t, output.String(), "ignored")
+	})
+
+	t.Run("should log without the request id when it is not set", func(t *testing.T) {
+		output.Reset()
+
+		FromContext(context.Background()).LogInfo("info")
+
+		assert.Contains(t, output.String(), "info")
+		assert.NotContains(t, output.String(), "request_id")
+	})
+}
@@ -17,6 +17,8 @@ package enums
 import "github.com/sirupsen/logrus"
 
 const (
+	FieldRequestID = "request_id"
+
 	// PanicLevel level, highest level of severity. Logs and then calls panic with the
 	// message passed to Debug, Info, ...
 	PanicLevel = logrus.PanicLevel
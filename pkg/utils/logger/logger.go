@@ -23,50 +23,32 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/ZupIT/horusec-devkit/pkg/utils/logger/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/sanitize"
 )
 
 func LogPanic(msg string, err error, args ...map[string]interface{}) {
 	if err != nil {
-		if len(args) > 0 {
-			logrus.WithFields(args[0]).WithError(err).Panic(msg)
-
-			return
-		}
-
-		logrus.WithError(err).Panic(msg)
+		newEntry(err, args).Panic(sanitize.ForLog(msg))
 	}
 }
 
 func LogError(msg string, err error, args ...map[string]interface{}) {
 	if err != nil {
-		if len(args) > 0 {
-			logrus.WithFields(args[0]).WithError(err).Error(msg)
-
-			return
-		}
-
-		logrus.WithError(err).Error(msg)
+		newEntry(err, args).Error(sanitize.ForLog(msg))
 	}
 }
 
 func LogInfo(msg string, args ...interface{}) {
-	if args != nil {
-		logrus.Info(msg, args)
-
-		return
-	}
+	logrus.Info(formatMessage(msg, args))
+}
 
-	logrus.Info(msg)
+// LogInfoWithFields logs the message with each field as a structured key, so it can be queried by the log aggregator
+func LogInfoWithFields(msg string, fields map[string]interface{}) {
+	logrus.WithFields(sanitizeFields(fields)).Info(sanitize.ForLog(msg))
 }
 
 func LogWarn(msg string, args ...interface{}) {
-	if args != nil {
-		logrus.Warn(msg, args)
-
-		return
-	}
-
-	logrus.Warn(msg)
+	logrus.Warn(formatMessage(msg, args))
 }
 
 func LogPrint(msg string) {
@@ -77,7 +59,7 @@ func LogPrint(msg string) {
 func SetLogLevel(level string) {
 	logLevel, err := logrus.ParseLevel(level)
 	if err != nil {
-		logrus.Error(fmt.Sprintf(enums.MessageInvalidLogLevel, level, enums.InfoLevel.String()))
+		logrus.Error(sanitize.ForLog(fmt.Sprintf(enums.MessageInvalidLogLevel, level, enums.InfoLevel.String())))
 		logLevel = enums.InfoLevel
 	}
 
@@ -86,76 +68,42 @@ func SetLogLevel(level string) {
 
 func LogPanicWithLevel(msg string, err error, args ...map[string]interface{}) {
 	if logrus.IsLevelEnabled(enums.PanicLevel) && err != nil {
-		if len(args) > 0 {
-			logrus.WithFields(args[0]).WithError(err).Panic(msg)
-		}
-
-		logrus.WithError(err).Panic(msg)
+		newEntry(err, args).Panic(sanitize.ForLog(msg))
 	}
 }
 
 func LogErrorWithLevel(msg string, err error, args ...map[string]interface{}) {
 	if logrus.IsLevelEnabled(enums.ErrorLevel) && err != nil {
-		if len(args) > 0 {
-			logrus.WithFields(args[0]).WithError(err).Error(msg)
-
-			return
-		}
-
-		logrus.WithError(err).Error(msg)
+		newEntry(err, args).Error(sanitize.ForLog(msg))
 	}
 }
 
 func LogWarnWithLevel(msg string, args ...interface{}) {
 	if logrus.IsLevelEnabled(enums.WarnLevel) {
-		if args != nil {
-			logrus.Warn(msg, args)
-
-			return
-		}
-
-		logrus.Warn(msg)
+		logrus.Warn(formatMessage(msg, args))
 	}
 }
 
 func LogInfoWithLevel(msg string, args ...interface{}) {
 	if logrus.IsLevelEnabled(enums.InfoLevel) {
-		if args != nil {
-			logrus.Info(msg, args)
-
-			return
-		}
-
-		logrus.Info(msg)
+		logrus.Info(formatMessage(msg, args))
 	}
 }
 
 func LogDebugWithLevel(msg string, args ...interface{}) {
 	if logrus.IsLevelEnabled(enums.DebugLevel) {
-		if args != nil {
-			logrus.Debug(msg, args)
-
-			return
-		}
-
-		logrus.Debug(msg)
+		logrus.Debug(formatMessage(msg, args))
 	}
 }
 
 func LogTraceWithLevel(msg string, args ...interface{}) {
 	if logrus.IsLevelEnabled(enums.TraceLevel) {
-		if args != nil {
-			logrus.Trace(msg, args)
-
-			return
-		}
-
-		logrus.Trace(msg)
+		logrus.Trace(formatMessage(msg, args))
 	}
 }
 
 func LogStringAsError(msg string) {
-	logrus.Error(msg)
+	logrus.Error(sanitize.ForLog(msg))
 }
 
 func LogDebugJSON(message string, content interface{}) {
@@ -172,3 +120,57 @@ func LogSetOutput(writers ...io.Writer) {
 	mw := io.MultiWriter(writers...)
 	logrus.SetOutput(mw)
 }
+
+// formatMessage keeps the format of the args appended to the message as a list, stripping the control characters
+// of the result, so a value sent by the user cannot forge a new log line
+func formatMessage(msg string, args []interface{}) string {
+	if args != nil {
+		return sanitize.ForLog(fmt.Sprint(msg, args))
+	}
+
+	return sanitize.ForLog(msg)
+}
+
+func newEntry(err error, args []map[string]interface{}) *logrus.Entry {
+	return newEntryFrom(logrus.NewEntry(logrus.StandardLogger()), err, args)
+}
+
+func newEntryFrom(entry *logrus.Entry, err error, args []map[string]interface{}) *logrus.Entry {
+	fields := logrus.Fields{}
+	if len(args) > 0 {
+		fields = sanitizeFields(args[0])
+	}
+
+	return entry.WithFields(fields).WithError(&sanitizedError{err: err})
+}
+
+// sanitizeFields copies the fields, so the map of the caller is not changed, sanitizing the strings and the errors
+func sanitizeFields(fields map[string]interface{}) logrus.Fields {
+	sanitized := make(logrus.Fields, len(fields))
+
+	for key, value := range fields {
+		switch typed := value.(type) {
+		case string:
+			sanitized[key] = sanitize.ForLog(typed)
+		case error:
+			sanitized[key] = &sanitizedError{err: typed}
+		default:
+			sanitized[key] = value
+		}
+	}
+
+	return sanitized
+}
+
+// sanitizedError keeps the original error on the chain for the log hooks, only sanitizing its message
+type sanitizedError struct {
+	err error
+}
+
+func (s *sanitizedError) Error() string {
+	return sanitize.ForLog(s.err.Error())
+}
+
+func (s *sanitizedError) Unwrap() error {
+	return s.err
+}
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"io/ioutil"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -59,6 +60,12 @@ func TestLogInfo(t *testing.T) {
 	})
 }
 
+func TestLogInfoWithFields(t *testing.T) {
+	t.Run("should log information with fields without panic", func(t *testing.T) {
+		assert.NotPanics(t, func() { LogInfoWithFields("test", map[string]interface{}{"test": "test"}) })
+	})
+}
+
 func TestLogWarn(t *testing.T) {
 	t.Run("should log warning log without panic", func(t *testing.T) {
 		assert.NotPanics(t, func() { LogWarn("test") })
@@ -216,3 +223,27 @@ func TestLogSetOutput(t *testing.T) {
 		})
 	})
 }
+
+func TestSanitize(t *testing.T) {
+	t.Run("should strip line breaks of the message, fields and error", func(t *testing.T) {
+		output := bytes.NewBufferString("")
+		LogSetOutput(output)
+		defer LogSetOutput(os.Stderr)
+
+		fields := map[string]interface{}{"repository": "test\nlevel=info msg=forged"}
+		LogError("test\r\nlevel=info", errors.New("test\nforged"), fields)
+
+		assert.Equal(t, 1, strings.Count(output.String(), "\n"))
+		assert.NotContains(t, output.String(), `\n`)
+		assert.Contains(t, output.String(), "testlevel=info msg=forged")
+		assert.Equal(t, "test\nlevel=info msg=forged", fields["repository"])
+	})
+
+	t.Run("should keep the original error on the chain", func(t *testing.T) {
+		err := errors.New("test\n")
+		sanitized := &sanitizedError{err: err}
+
+		assert.ErrorIs(t, sanitized, err)
+		assert.Equal(t, "test", sanitized.Error())
+	})
+}
@@ -0,0 +1,34 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+const (
+	HeaderHorusecAuthorization = "X-Horusec-Authorization"
+	HeaderAuthorization        = "Authorization"
+	HeaderRequestID            = "X-Request-Id"
+	HeaderTraceParent          = "Traceparent"
+	HeaderTraceState           = "Tracestate"
+	HeaderB3                   = "B3"
+	HeaderB3TraceID            = "X-B3-Traceid"
+	HeaderB3SpanID             = "X-B3-Spanid"
+	HeaderB3Sampled            = "X-B3-Sampled"
+)
+
+// Headers are in the canonical format and are the identity, request id and trace headers copied from incoming requests to outgoing calls
+// nolint:gochecknoglobals // read only list of headers
+var Headers = []string{
+	HeaderHorusecAuthorization, HeaderAuthorization, HeaderRequestID, HeaderTraceParent, HeaderTraceState,
+	HeaderB3, HeaderB3TraceID, HeaderB3SpanID, HeaderB3Sampled,
+}
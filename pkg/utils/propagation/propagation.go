@@ -0,0 +1,75 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/propagation/enums"
+)
+
+type contextKey string
+
+const valuesContextKey contextKey = "horusec-propagation-values"
+
+// FromHTTPHeader returns the propagated headers present in the http header with canonical names
+func FromHTTPHeader(header http.Header) map[string]string {
+	values := map[string]string{}
+
+	for _, name := range enums.Headers {
+		if value := header.Get(name); value != "" {
+			values[name] = value
+		}
+	}
+
+	return values
+}
+
+func ContextWithValues(ctx context.Context, values map[string]string) context.Context {
+	return context.WithValue(ctx, valuesContextKey, values)
+}
+
+func GetValuesFromContext(ctx context.Context) map[string]string {
+	if ctx == nil {
+		return map[string]string{}
+	}
+
+	if values, ok := ctx.Value(valuesContextKey).(map[string]string); ok {
+		return values
+	}
+
+	return map[string]string{}
+}
+
+func GetValueFromContext(ctx context.Context, name string) string {
+	return GetValuesFromContext(ctx)[http.CanonicalHeaderKey(name)]
+}
+
+// ContextWithRequestID keeps the other propagated values of the context, replacing only the request id
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	values := map[string]string{}
+	for name, value := range GetValuesFromContext(ctx) {
+		values[name] = value
+	}
+
+	values[enums.HeaderRequestID] = requestID
+
+	return ContextWithValues(ctx, values)
+}
+
+func GetRequestID(ctx context.Context) string {
+	return GetValueFromContext(ctx, enums.HeaderRequestID)
+}
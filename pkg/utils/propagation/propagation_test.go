@@ -0,0 +1,69 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/propagation/enums"
+)
+
+func TestFromHTTPHeader(t *testing.T) {
+	t.Run("should return only propagated headers", func(t *testing.T) {
+		header := http.Header{}
+		header.Set("x-request-id", "test")
+		header.Set("traceparent", "test")
+		header.Set("Content-Type", "application/json")
+
+		values := FromHTTPHeader(header)
+
+		assert.Len(t, values, 2)
+		assert.Equal(t, "test", values[enums.HeaderRequestID])
+		assert.Equal(t, "test", values[enums.HeaderTraceParent])
+	})
+}
+
+func TestContextWithValues(t *testing.T) {
+	t.Run("should set and get values from context", func(t *testing.T) {
+		ctx := ContextWithValues(context.Background(), map[string]string{enums.HeaderRequestID: "test"})
+
+		assert.Equal(t, "test", GetValueFromContext(ctx, "x-request-id"))
+		assert.Len(t, GetValuesFromContext(ctx), 1)
+	})
+
+	t.Run("should return empty values when not set", func(t *testing.T) {
+		assert.Empty(t, GetValuesFromContext(context.Background()))
+		assert.Empty(t, GetValueFromContext(nil, enums.HeaderRequestID)) // nolint:staticcheck // testing nil context
+	})
+}
+
+func TestContextWithRequestID(t *testing.T) {
+	t.Run("should replace only the request id", func(t *testing.T) {
+		values := map[string]string{enums.HeaderRequestID: "old", enums.HeaderTraceParent: "test"}
+		ctx := ContextWithRequestID(ContextWithValues(context.Background(), values), "new")
+
+		assert.Equal(t, "new", GetRequestID(ctx))
+		assert.Equal(t, "test", GetValueFromContext(ctx, enums.HeaderTraceParent))
+		assert.Equal(t, "old", values[enums.HeaderRequestID])
+	})
+
+	t.Run("should return empty request id when not set", func(t *testing.T) {
+		assert.Empty(t, GetRequestID(context.Background()))
+	})
+}
@@ -0,0 +1,63 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reports
+
+import (
+	"fmt"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+)
+
+// nolint:gochecknoglobals // colors shared by the report formats
+var (
+	colorText      = rgbColor{red: 0.1, green: 0.1, blue: 0.1}
+	colorMuted     = rgbColor{red: 0.4, green: 0.4, blue: 0.4}
+	colorCode      = rgbColor{red: 0.2, green: 0.2, blue: 0.35}
+	severityColors = map[severities.Severity]rgbColor{
+		severities.Critical: {red: 0.55, green: 0.05, blue: 0.1},
+		severities.High:     {red: 0.85, green: 0.2, blue: 0.2},
+		severities.Medium:   {red: 0.95, green: 0.55, blue: 0.1},
+		severities.Low:      {red: 0.9, green: 0.75, blue: 0.15},
+		severities.Unknown:  {red: 0.6, green: 0.6, blue: 0.6},
+		severities.Info:     {red: 0.2, green: 0.5, blue: 0.85},
+	}
+)
+
+// rgbColor has each component between zero and one, as used by the pdf color operators
+type rgbColor struct {
+	red, green, blue float64
+}
+
+// hex returns the color in the #rrggbb format used by html and css
+func (c rgbColor) hex() string {
+	const maxComponent = 255
+
+	return fmt.Sprintf("#%02x%02x%02x", int(c.red*maxComponent+0.5), int(c.green*maxComponent+0.5),
+		int(c.blue*maxComponent+0.5))
+}
+
+func severityColor(severity severities.Severity) rgbColor {
+	if color, ok := severityColors[severity]; ok {
+		return color
+	}
+
+	return severityColors[severities.Unknown]
+}
+
+// SeverityHexColor returns the color of the severity on the reports in the #rrggbb format, so other presentations of
+// the analysis, like chat notifications, use the same colors
+func SeverityHexColor(severity severities.Severity) string {
+	return severityColor(normalizeSeverity(severity)).hex()
+}
@@ -0,0 +1,86 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+const (
+	DefaultTitle              = "Horusec analysis report"
+	DefaultMaxVulnerabilities = 500
+	DefaultMaxCodeLength      = 500
+	DefaultMaxDetailsLength   = 1000
+	DateTimeLayout            = "2006-01-02 15:04:05 MST"
+	TabReplacement            = "    "
+	TemplateHTML              = "report.html"
+	TemplateMarkdown          = "report.md"
+	MarkdownFence             = "```"
+	MarkdownFenceChar         = "`"
+)
+
+const (
+	PDFPageWidth        = 595.28
+	PDFPageHeight       = 841.89
+	PDFMargin           = 48.0
+	PDFFooterHeight     = 24.0
+	PDFLineSpacing      = 1.35
+	PDFFontRegular      = "F1"
+	PDFFontBold         = "F2"
+	PDFFontMono         = "F3"
+	PDFMonoCharWidth    = 600
+	PDFDefaultCharWidth = 556
+	PDFTitleSize        = 18.0
+	PDFHeadingSize      = 13.0
+	PDFTextSize         = 10.0
+	PDFSmallSize        = 8.0
+	PDFChartLabelWidth  = 90.0
+	PDFChartValueWidth  = 40.0
+	PDFTableValueOffset = 200.0
+	PDFIndent           = 12.0
+)
+
+const (
+	LabelRepository        = "Repository"
+	LabelWorkspace         = "Workspace"
+	LabelStatus            = "Status"
+	LabelCreatedAt         = "Created at"
+	LabelFinishedAt        = "Finished at"
+	LabelTotal             = "Total of vulnerabilities"
+	LabelErrors            = "Errors"
+	LabelBySeverity        = "Vulnerabilities by severity"
+	LabelByLanguage        = "Vulnerabilities by language"
+	LabelByType            = "Vulnerabilities by type"
+	LabelVulnerabilities   = "Vulnerabilities"
+	LabelTool              = "Tool"
+	LabelLanguage          = "Language"
+	LabelConfidence        = "Confidence"
+	LabelType              = "Type"
+	LabelSeverity          = "Severity"
+	LabelCount             = "Total"
+	LabelPage              = "Page %d of %d"
+	MessageOmittedFormat   = "%d vulnerabilities were omitted from this report, the totals above include them."
+	MessageNoVulnerability = "No vulnerabilities were found in this analysis."
+)
+
+const (
+	GitHubLevelFailure     = "failure"
+	GitHubLevelWarning     = "warning"
+	GitHubLevelNotice      = "notice"
+	GitHubMaxAnnotation    = 50
+	GitLabSeverityBlocker  = "blocker"
+	GitLabSeverityCritical = "critical"
+	GitLabSeverityMajor    = "major"
+	GitLabSeverityMinor    = "minor"
+	GitLabSeverityInfo     = "info"
+	GitLabIssueType        = "issue"
+	GitLabCategorySecurity = "Security"
+)
@@ -0,0 +1,254 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reports
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/reports/enums"
+)
+
+// GitHubAnnotation is an annotation of the github checks api output, the api accepts a limited number of annotations
+// for each request, so the integrations must send them in batches using BatchGitHubAnnotations
+type GitHubAnnotation struct {
+	Path            string `json:"path"`
+	StartLine       int    `json:"start_line"`
+	EndLine         int    `json:"end_line"`
+	StartColumn     int    `json:"start_column,omitempty"`
+	EndColumn       int    `json:"end_column,omitempty"`
+	AnnotationLevel string `json:"annotation_level"`
+	Title           string `json:"title"`
+	Message         string `json:"message"`
+	RawDetails      string `json:"raw_details,omitempty"`
+}
+
+// GitLabIssue is an issue of the gitlab code quality report, that is an array of issues
+type GitLabIssue struct {
+	Type        string              `json:"type"`
+	CheckName   string              `json:"check_name"`
+	Description string              `json:"description"`
+	Categories  []string            `json:"categories"`
+	Fingerprint string              `json:"fingerprint"`
+	Severity    string              `json:"severity"`
+	Location    GitLabIssueLocation `json:"location"`
+}
+
+type GitLabIssueLocation struct {
+	Path  string           `json:"path"`
+	Lines GitLabIssueLines `json:"lines"`
+}
+
+type GitLabIssueLines struct {
+	Begin int `json:"begin"`
+}
+
+// NewGitHubAnnotations converts the vulnerabilities of the analysis to github checks annotations. Only the
+// vulnerabilities with the vulnerability type are exported, since the false positives, accepted risks and corrected
+// ones should not be shown on code review. The options can be nil to use the default ones.
+func NewGitHubAnnotations(analysis *analysisEntities.Analysis, options *Options) ([]GitHubAnnotation, error) {
+	vulnerabilities, err := exportedVulnerabilities(analysis, options)
+	if err != nil {
+		return nil, err
+	}
+
+	annotations := make([]GitHubAnnotation, 0, len(vulnerabilities))
+	for index := range vulnerabilities {
+		annotations = append(annotations, newGitHubAnnotation(&vulnerabilities[index]))
+	}
+
+	return annotations, nil
+}
+
+// ToGitHubAnnotations returns the github checks annotations of the analysis as a json array
+func ToGitHubAnnotations(analysis *analysisEntities.Analysis, options *Options) ([]byte, error) {
+	annotations, err := NewGitHubAnnotations(analysis, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(annotations)
+}
+
+// BatchGitHubAnnotations splits the annotations in batches with the max number of annotations accepted by each
+// request of the github checks api
+func BatchGitHubAnnotations(annotations []GitHubAnnotation) [][]GitHubAnnotation {
+	var batches [][]GitHubAnnotation
+
+	for len(annotations) > enums.GitHubMaxAnnotation {
+		batches = append(batches, annotations[:enums.GitHubMaxAnnotation])
+		annotations = annotations[enums.GitHubMaxAnnotation:]
+	}
+
+	if len(annotations) > 0 {
+		batches = append(batches, annotations)
+	}
+
+	return batches
+}
+
+// NewGitLabCodeQuality converts the vulnerabilities of the analysis to gitlab code quality issues, exporting the same
+// vulnerabilities of NewGitHubAnnotations. The options can be nil to use the default ones.
+func NewGitLabCodeQuality(analysis *analysisEntities.Analysis, options *Options) ([]GitLabIssue, error) {
+	vulnerabilities, err := exportedVulnerabilities(analysis, options)
+	if err != nil {
+		return nil, err
+	}
+
+	issues := make([]GitLabIssue, 0, len(vulnerabilities))
+	for index := range vulnerabilities {
+		issues = append(issues, newGitLabIssue(&vulnerabilities[index]))
+	}
+
+	return issues, nil
+}
+
+// ToGitLabCodeQuality returns the gitlab code quality report of the analysis as a json array
+func ToGitLabCodeQuality(analysis *analysisEntities.Analysis, options *Options) ([]byte, error) {
+	issues, err := NewGitLabCodeQuality(analysis, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(issues)
+}
+
+func newGitHubAnnotation(v *vulnerability.Vulnerability) GitHubAnnotation {
+	line := max(parsePosition(v.Line), 1)
+	column := parsePosition(v.Column)
+
+	return GitHubAnnotation{
+		Path:            exportedPath(v.File),
+		StartLine:       line,
+		EndLine:         line,
+		StartColumn:     column,
+		EndColumn:       column,
+		AnnotationLevel: gitHubLevel(v.Severity),
+		Title:           v.Severity.ToString() + " - " + v.SecurityTool.ToString(),
+		Message:         v.Details,
+		RawDetails:      v.Code,
+	}
+}
+
+func newGitLabIssue(v *vulnerability.Vulnerability) GitLabIssue {
+	checkName := v.RuleID
+	if checkName == "" {
+		checkName = v.SecurityTool.ToString()
+	}
+
+	return GitLabIssue{
+		Type:        enums.GitLabIssueType,
+		CheckName:   checkName,
+		Description: v.Details,
+		Categories:  []string{enums.GitLabCategorySecurity},
+		Fingerprint: fingerprint(v),
+		Severity:    gitLabSeverity(v.Severity),
+		Location: GitLabIssueLocation{
+			Path:  exportedPath(v.File),
+			Lines: GitLabIssueLines{Begin: max(parsePosition(v.Line), 1)},
+		},
+	}
+}
+
+// exportedVulnerabilities returns the vulnerabilities ordered and limited as in the other reports, ignoring the
+// ones that are not of the vulnerability type
+func exportedVulnerabilities(analysis *analysisEntities.Analysis,
+	options *Options) ([]vulnerability.Vulnerability, error) {
+	if analysis == nil {
+		return nil, enums.ErrorNilAnalysis
+	}
+
+	active := &analysisEntities.Analysis{}
+	for index := range analysis.AnalysisVulnerabilities {
+		vulnType := analysis.AnalysisVulnerabilities[index].Vulnerability.Type
+		if vulnType == "" || vulnType == vulnerabilityEnums.Vulnerability {
+			active.AnalysisVulnerabilities = append(active.AnalysisVulnerabilities,
+				analysis.AnalysisVulnerabilities[index])
+		}
+	}
+
+	summary, err := newReportSummary(active, options)
+	if err != nil {
+		return nil, err
+	}
+
+	var vulnerabilities []vulnerability.Vulnerability
+	for _, group := range summary.Groups {
+		vulnerabilities = append(vulnerabilities, group.Vulnerabilities...)
+	}
+
+	return vulnerabilities, nil
+}
+
+func gitHubLevel(severity severities.Severity) string {
+	switch normalizeSeverity(severity) {
+	case severities.Critical, severities.High:
+		return enums.GitHubLevelFailure
+	case severities.Medium, severities.Low:
+		return enums.GitHubLevelWarning
+	default:
+		return enums.GitHubLevelNotice
+	}
+}
+
+func gitLabSeverity(severity severities.Severity) string {
+	switch normalizeSeverity(severity) {
+	case severities.Critical:
+		return enums.GitLabSeverityBlocker
+	case severities.High:
+		return enums.GitLabSeverityCritical
+	case severities.Medium:
+		return enums.GitLabSeverityMajor
+	case severities.Low:
+		return enums.GitLabSeverityMinor
+	default:
+		return enums.GitLabSeverityInfo
+	}
+}
+
+// parsePosition returns the line or column as a number, or zero when it is empty or invalid. Both platforms require
+// a line, so the vulnerabilities without it are exported on the first line of the file.
+func parsePosition(value string) int {
+	position, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || position < 0 {
+		return 0
+	}
+
+	return position
+}
+
+// exportedPath returns the file relative to the repository root, as expected by both platforms
+func exportedPath(file string) string {
+	return strings.TrimLeft(strings.TrimPrefix(strings.ReplaceAll(file, "\\", "/"), "./"), "/")
+}
+
+// fingerprint returns the vulnerability hash, which identifies the same vulnerability between analyses, or a hash
+// of its location and details for the vulnerabilities without it
+func fingerprint(v *vulnerability.Vulnerability) string {
+	if v.VulnHash != "" {
+		return v.VulnHash
+	}
+
+	hash := sha256.Sum256([]byte(strings.Join([]string{v.File, v.Line, v.Column, v.Details, v.Code}, "\x00")))
+
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,106 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reports
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/languages"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/reports/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/testutil"
+)
+
+func newTestExportVulnerabilities() []vulnerability.Vulnerability {
+	critical := newTestVulnerability("./cmd/main.go", "10", severities.Critical, languages.Go)
+	critical.VulnHash = "critical-hash"
+	critical.RuleID = "HS-GO-1"
+
+	medium := newTestVulnerability("src/app.js", "", severities.Medium, languages.Javascript)
+	medium.Column = ""
+
+	falsePositive := newTestVulnerability("b.go", "1", severities.High, languages.Go)
+	falsePositive.Type = vulnerabilityEnums.FalsePositive
+
+	return []vulnerability.Vulnerability{medium, falsePositive, critical}
+}
+
+func TestToGitHubAnnotations(t *testing.T) {
+	t.Run("should export active vulnerabilities as github annotations", func(t *testing.T) {
+		annotations, err := ToGitHubAnnotations(newTestAnalysis(newTestExportVulnerabilities()...), nil)
+
+		assert.NoError(t, err)
+		testutil.AssertGoldenJSON(t, annotations, "testdata/github_annotations.golden.json")
+	})
+
+	t.Run("should return error when analysis is nil", func(t *testing.T) {
+		_, err := ToGitHubAnnotations(nil, nil)
+
+		assert.ErrorIs(t, err, enums.ErrorNilAnalysis)
+	})
+}
+
+func TestToGitLabCodeQuality(t *testing.T) {
+	t.Run("should export active vulnerabilities as gitlab code quality issues", func(t *testing.T) {
+		issues, err := ToGitLabCodeQuality(newTestAnalysis(newTestExportVulnerabilities()...), nil)
+
+		assert.NoError(t, err)
+		testutil.AssertGoldenJSON(t, issues, "testdata/gitlab_code_quality.golden.json")
+	})
+
+	t.Run("should return empty array when there is no vulnerability", func(t *testing.T) {
+		issues, err := ToGitLabCodeQuality(newTestAnalysis(), nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "[]", string(issues))
+	})
+
+	t.Run("should return error when analysis is nil", func(t *testing.T) {
+		_, err := ToGitLabCodeQuality(nil, nil)
+
+		assert.ErrorIs(t, err, enums.ErrorNilAnalysis)
+	})
+}
+
+func TestSeverityMappings(t *testing.T) {
+	t.Run("should map every severity to the platform levels", func(t *testing.T) {
+		for severity, expected := range map[severities.Severity][2]string{
+			severities.Critical: {"failure", "blocker"},
+			severities.High:     {"failure", "critical"},
+			severities.Medium:   {"warning", "major"},
+			severities.Low:      {"warning", "minor"},
+			severities.Info:     {"notice", "info"},
+			"invalid":           {"notice", "info"},
+		} {
+			assert.Equal(t, expected[0], gitHubLevel(severity))
+			assert.Equal(t, expected[1], gitLabSeverity(severity))
+		}
+	})
+}
+
+func TestBatchGitHubAnnotations(t *testing.T) {
+	t.Run("should split annotations in batches of the max size", func(t *testing.T) {
+		batches := BatchGitHubAnnotations(make([]GitHubAnnotation, 120))
+
+		assert.Len(t, batches, 3)
+		assert.Len(t, batches[0], 50)
+		assert.Len(t, batches[2], 20)
+		assert.Empty(t, BatchGitHubAnnotations(nil))
+	})
+}
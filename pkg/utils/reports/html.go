@@ -0,0 +1,35 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reports
+
+import (
+	"bytes"
+
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/reports/enums"
+)
+
+// ToHTML renders the same content of the pdf report as a html document with inline styles, so it can be used as
+// the body of emails. The options can be nil to use the default ones.
+func ToHTML(analysis *analysisEntities.Analysis, options *Options) ([]byte, error) {
+	summary, err := newReportSummary(analysis, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return render(func(buffer *bytes.Buffer) error {
+		return htmlTemplates.ExecuteTemplate(buffer, enums.TemplateHTML, summary)
+	})
+}
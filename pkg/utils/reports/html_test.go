@@ -0,0 +1,61 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reports
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/languages"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/reports/enums"
+)
+
+func TestToHTML(t *testing.T) {
+	t.Run("should render vulnerabilities grouped by severity with escaped content", func(t *testing.T) {
+		vulnerability := newTestVulnerability("<script>.go", "1", severities.High, languages.Go)
+		vulnerability.Code = "<b>code</b>"
+
+		html, err := ToHTML(newTestAnalysis(vulnerability,
+			newTestVulnerability("a.go", "2", severities.Critical, languages.Go)), nil)
+
+		assert.NoError(t, err)
+		assert.Contains(t, string(html), "HIGH (1)")
+		assert.Contains(t, string(html), "<code>&lt;script&gt;.go:1:4</code>")
+		assert.Contains(t, string(html), "<code>&lt;b&gt;code&lt;/b&gt;</code>")
+		assert.Contains(t, string(html), "background-color: #8c0d1a")
+		assert.NotContains(t, string(html), "<script>")
+		assert.Less(t, strings.Index(string(html), "CRITICAL (1)"), strings.Index(string(html), "HIGH (1)"))
+	})
+
+	t.Run("should render omitted vulnerabilities message", func(t *testing.T) {
+		options := NewOptions()
+		options.MaxVulnerabilities = 1
+
+		html, err := ToHTML(newTestAnalysis(newTestVulnerability("a.go", "1", severities.High, languages.Go),
+			newTestVulnerability("b.go", "1", severities.High, languages.Go)), options)
+
+		assert.NoError(t, err)
+		assert.Contains(t, string(html), "1 vulnerabilities were omitted")
+	})
+
+	t.Run("should return error when analysis is nil", func(t *testing.T) {
+		_, err := ToHTML(nil, nil)
+
+		assert.ErrorIs(t, err, enums.ErrorNilAnalysis)
+	})
+}
@@ -0,0 +1,36 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reports
+
+import (
+	"bytes"
+
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/reports/enums"
+)
+
+// ToMarkdown renders the same content of the pdf report as github flavored markdown, to be used on pull request
+// comments. The platforms limit the size of the comments, so the max vulnerabilities of the options should be set
+// according to it. The options can be nil to use the default ones.
+func ToMarkdown(analysis *analysisEntities.Analysis, options *Options) ([]byte, error) {
+	summary, err := newReportSummary(analysis, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return render(func(buffer *bytes.Buffer) error {
+		return markdownTemplates.ExecuteTemplate(buffer, enums.TemplateMarkdown, summary)
+	})
+}
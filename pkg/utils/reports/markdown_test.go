@@ -0,0 +1,78 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reports
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/languages"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/reports/enums"
+)
+
+func TestToMarkdown(t *testing.T) {
+	t.Run("should render vulnerabilities grouped by severity with code snippets", func(t *testing.T) {
+		markdown, err := ToMarkdown(newTestAnalysis(
+			newTestVulnerability("b.go", "2", severities.Low, languages.Go),
+			newTestVulnerability("a_test.go", "1", severities.Critical, languages.Go),
+		), nil)
+
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(string(markdown), "# Horusec analysis report\n"))
+		assert.Contains(t, string(markdown), "| CRITICAL | 1 |")
+		assert.Contains(t, string(markdown), "### a\\_test.go:1:4")
+		assert.Contains(t, string(markdown), "```\npassword := \"123\"\n```")
+		assert.Less(t, strings.Index(string(markdown), "## CRITICAL (1)"), strings.Index(string(markdown), "## LOW (1)"))
+		assert.True(t, strings.HasSuffix(string(markdown), "```\n"))
+	})
+
+	t.Run("should render message when there is no vulnerability", func(t *testing.T) {
+		markdown, err := ToMarkdown(newTestAnalysis(), nil)
+
+		assert.NoError(t, err)
+		assert.Contains(t, string(markdown), enums.MessageNoVulnerability)
+		assert.NotContains(t, string(markdown), enums.LabelByLanguage)
+	})
+
+	t.Run("should return error when analysis is nil", func(t *testing.T) {
+		_, err := ToMarkdown(nil, nil)
+
+		assert.ErrorIs(t, err, enums.ErrorNilAnalysis)
+	})
+}
+
+func TestEscapeMarkdown(t *testing.T) {
+	t.Run("should escape markdown and html characters", func(t *testing.T) {
+		assert.Equal(t, `\*bold\* \<a\> \[link\]\(url\) a\|b`, escapeMarkdown("*bold* <a> [link](url) a|b"))
+	})
+}
+
+func TestFenceCode(t *testing.T) {
+	t.Run("should use a fence longer than the backticks of the code", func(t *testing.T) {
+		assert.Equal(t, "```\ncode\n```", fenceCode("code\n"))
+		assert.Equal(t, "````\na ``` b\n````", fenceCode("a ``` b"))
+	})
+}
+
+func TestLabel(t *testing.T) {
+	t.Run("should return error when label is unknown", func(t *testing.T) {
+		_, err := label("test")
+
+		assert.ErrorIs(t, err, enums.ErrorUnknownLabel)
+	})
+}
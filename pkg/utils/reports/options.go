@@ -0,0 +1,39 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reports
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/utils/reports/enums"
+)
+
+// Options configures the rendered reports. The vulnerabilities after the max are only counted on the summary, so a
+// big analysis does not generate a huge report, and a max lower or equal than zero disables the limit.
+type Options struct {
+	Title              string
+	MaxVulnerabilities int
+	IncludeCode        bool
+	MaxCodeLength      int
+	MaxDetailsLength   int
+}
+
+func NewOptions() *Options {
+	return &Options{
+		Title:              enums.DefaultTitle,
+		MaxVulnerabilities: enums.DefaultMaxVulnerabilities,
+		IncludeCode:        true,
+		MaxCodeLength:      enums.DefaultMaxCodeLength,
+		MaxDetailsLength:   enums.DefaultMaxDetailsLength,
+	}
+}
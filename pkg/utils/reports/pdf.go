@@ -0,0 +1,184 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reports
+
+import (
+	"fmt"
+
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/reports/enums"
+)
+
+const contentWidth = enums.PDFPageWidth - 2*enums.PDFMargin
+
+// ToPDF renders the analysis summary, the vulnerabilities by severity chart and the vulnerabilities grouped by
+// severity as a pdf document. The options can be nil to use the default ones.
+func ToPDF(analysis *analysisEntities.Analysis, options *Options) ([]byte, error) {
+	summary, err := newReportSummary(analysis, options)
+	if err != nil {
+		return nil, err
+	}
+
+	renderer := newPDFRenderer()
+
+	renderer.header(summary)
+	renderer.severityChart(summary)
+	renderer.countTable(enums.LabelByLanguage, summary.ByLanguage)
+	renderer.countTable(enums.LabelByType, summary.ByType)
+	renderer.vulnerabilities(summary)
+
+	return renderer.document.bytes(summary.Title), nil
+}
+
+type pdfRenderer struct {
+	document *pdfDocument
+	y        float64
+}
+
+func newPDFRenderer() *pdfRenderer {
+	renderer := &pdfRenderer{document: &pdfDocument{}}
+	renderer.newPage()
+
+	return renderer
+}
+
+func (r *pdfRenderer) newPage() {
+	r.document.addPage()
+	r.y = enums.PDFPageHeight - enums.PDFMargin
+}
+
+// ensureSpace starts a new page when the height does not fit above the footer of the current page
+func (r *pdfRenderer) ensureSpace(height float64) {
+	if r.y-height < enums.PDFMargin+enums.PDFFooterHeight {
+		r.newPage()
+	}
+}
+
+func (r *pdfRenderer) space(height float64) {
+	r.y -= height
+}
+
+func (r *pdfRenderer) line(font string, size, indent float64, color rgbColor, value string) {
+	height := size * enums.PDFLineSpacing
+	r.ensureSpace(height)
+
+	r.document.text(font, size, enums.PDFMargin+indent, r.y-size, color, value)
+	r.y -= height
+}
+
+func (r *pdfRenderer) paragraph(font string, size, indent float64, color rgbColor, value string) {
+	for _, line := range wrapText(font, size, contentWidth-indent, value) {
+		r.line(font, size, indent, color, line)
+	}
+}
+
+func (r *pdfRenderer) heading(value string, color rgbColor) {
+	r.ensureSpace(enums.PDFHeadingSize * enums.PDFLineSpacing * 3)
+	r.space(enums.PDFHeadingSize)
+	r.line(enums.PDFFontBold, enums.PDFHeadingSize, 0, color, value)
+}
+
+func (r *pdfRenderer) header(summary *Summary) {
+	r.paragraph(enums.PDFFontBold, enums.PDFTitleSize, 0, colorText, summary.Title)
+	r.space(enums.PDFTextSize)
+
+	for _, field := range summary.Fields() {
+		r.paragraph(enums.PDFFontRegular, enums.PDFTextSize, 0, colorText, field.Name+": "+field.Value)
+	}
+}
+
+// severityChart draws a horizontal bar for each severity, proportional to the severity with more vulnerabilities
+func (r *pdfRenderer) severityChart(summary *Summary) {
+	r.heading(enums.LabelBySeverity, colorText)
+
+	maxTotal := summary.MaxSeverityTotal()
+	barWidth := contentWidth - enums.PDFChartLabelWidth - enums.PDFChartValueWidth
+
+	for _, count := range summary.BySeverity {
+		height := enums.PDFTextSize * enums.PDFLineSpacing
+		r.ensureSpace(height)
+
+		baseline := r.y - enums.PDFTextSize
+		r.document.text(enums.PDFFontRegular, enums.PDFTextSize, enums.PDFMargin, baseline, colorText, count.Name)
+
+		width := 0.0
+		if maxTotal > 0 {
+			width = barWidth * float64(count.Total) / float64(maxTotal)
+			r.document.rect(enums.PDFMargin+enums.PDFChartLabelWidth, baseline-1, width, enums.PDFTextSize,
+				severityColor(severities.Severity(count.Name)))
+		}
+
+		r.document.text(enums.PDFFontRegular, enums.PDFTextSize, enums.PDFMargin+enums.PDFChartLabelWidth+width+4,
+			baseline, colorText, fmt.Sprint(count.Total))
+		r.space(height)
+	}
+}
+
+func (r *pdfRenderer) countTable(title string, counts []Count) {
+	if len(counts) == 0 {
+		return
+	}
+
+	r.heading(title, colorText)
+
+	for _, count := range counts {
+		height := enums.PDFTextSize * enums.PDFLineSpacing
+		r.ensureSpace(height)
+
+		baseline := r.y - enums.PDFTextSize
+		r.document.text(enums.PDFFontRegular, enums.PDFTextSize, enums.PDFMargin, baseline, colorText, count.Name)
+		r.document.text(enums.PDFFontRegular, enums.PDFTextSize, enums.PDFMargin+enums.PDFTableValueOffset,
+			baseline, colorText, fmt.Sprint(count.Total))
+		r.space(height)
+	}
+}
+
+func (r *pdfRenderer) vulnerabilities(summary *Summary) {
+	if len(summary.Groups) == 0 {
+		r.heading(enums.LabelVulnerabilities, colorText)
+		r.paragraph(enums.PDFFontRegular, enums.PDFTextSize, 0, colorMuted, enums.MessageNoVulnerability)
+
+		return
+	}
+
+	for _, group := range summary.Groups {
+		r.heading(fmt.Sprintf("%s (%d)", group.Severity, group.Total), severityColor(group.Severity))
+
+		for index := range group.Vulnerabilities {
+			r.vulnerability(&group.Vulnerabilities[index])
+		}
+	}
+
+	if summary.Omitted > 0 {
+		r.space(enums.PDFTextSize)
+		r.paragraph(enums.PDFFontRegular, enums.PDFTextSize, 0, colorMuted,
+			fmt.Sprintf(enums.MessageOmittedFormat, summary.Omitted))
+	}
+}
+
+func (r *pdfRenderer) vulnerability(v *vulnerability.Vulnerability) {
+	r.ensureSpace(enums.PDFTextSize * enums.PDFLineSpacing * 3)
+	r.space(enums.PDFTextSize / 2)
+
+	r.paragraph(enums.PDFFontBold, enums.PDFTextSize, 0, colorText, Location(v))
+	r.paragraph(enums.PDFFontRegular, enums.PDFTextSize, enums.PDFIndent, colorText, v.Details)
+	r.paragraph(enums.PDFFontRegular, enums.PDFSmallSize, enums.PDFIndent, colorMuted, MetadataLine(v))
+
+	if v.Code != "" {
+		r.paragraph(enums.PDFFontMono, enums.PDFSmallSize, enums.PDFIndent, colorCode, v.Code)
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reports
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/reports/enums"
+)
+
+// nolint:gochecknoglobals // widths of the ascii characters from the adobe font metrics of the standard pdf fonts
+var (
+	helveticaWidths = []int{
+		278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+		556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+		1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+		667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+		333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+		556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+	}
+	helveticaBoldWidths = []int{
+		278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+		556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+		975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+		667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+		333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+		611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+	}
+	winAnsiRunes = map[rune]byte{
+		'€': 0x80, '…': 0x85, '‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94, '•': 0x95, '–': 0x96, '—': 0x97,
+	}
+)
+
+// pdfDocument is a minimal pdf 1.4 writer that only supports text with the standard fonts and filled rectangles,
+// which is enough for the reports and avoids depending on a pdf library and its font files
+type pdfDocument struct {
+	pages []*bytes.Buffer
+}
+
+func (d *pdfDocument) addPage() {
+	d.pages = append(d.pages, &bytes.Buffer{})
+}
+
+func (d *pdfDocument) currentPage() *bytes.Buffer {
+	if len(d.pages) == 0 {
+		d.addPage()
+	}
+
+	return d.pages[len(d.pages)-1]
+}
+
+func (d *pdfDocument) text(font string, size, x, y float64, color rgbColor, value string) {
+	_, _ = fmt.Fprintf(d.currentPage(), "BT %.3f %.3f %.3f rg /%s %.1f Tf %.2f %.2f Td (%s) Tj ET\n",
+		color.red, color.green, color.blue, font, size, x, y, encodePDFText(value))
+}
+
+func (d *pdfDocument) rect(x, y, width, height float64, color rgbColor) {
+	_, _ = fmt.Fprintf(d.currentPage(), "%.3f %.3f %.3f rg %.2f %.2f %.2f %.2f re f\n",
+		color.red, color.green, color.blue, x, y, width, height)
+}
+
+// bytes writes the objects of the document, the first five are the catalog, the page tree and the three fonts,
+// followed by a page and a content stream for each page and the information dictionary
+func (d *pdfDocument) bytes(title string) []byte {
+	d.currentPage()
+	d.addFooters()
+
+	writer := &pdfWriter{}
+	writer.header()
+	writer.object("<< /Type /Catalog /Pages 2 0 R >>")
+	writer.object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", d.pageReferences(), len(d.pages)))
+
+	for _, font := range []string{"Helvetica", "Helvetica-Bold", "Courier"} {
+		writer.object(fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", font))
+	}
+
+	for index, page := range d.pages {
+		writer.object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] /Resources << /Font "+
+			"<< /F1 3 0 R /F2 4 0 R /F3 5 0 R >> >> /Contents %d 0 R >>",
+			enums.PDFPageWidth, enums.PDFPageHeight, d.pageObject(index)+1))
+		writer.object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", page.Len(), page.String()))
+	}
+
+	writer.object(fmt.Sprintf("<< /Title (%s) /Producer (Horusec) >>", encodePDFText(title)))
+
+	return writer.trailer()
+}
+
+func (d *pdfDocument) addFooters() {
+	for index, page := range d.pages {
+		label := fmt.Sprintf(enums.LabelPage, index+1, len(d.pages))
+		x := enums.PDFPageWidth - enums.PDFMargin - textWidth(enums.PDFFontRegular, enums.PDFSmallSize, label)
+
+		_, _ = fmt.Fprintf(page, "BT 0.400 0.400 0.400 rg /%s %.1f Tf %.2f %.2f Td (%s) Tj ET\n",
+			enums.PDFFontRegular, enums.PDFSmallSize, x, enums.PDFMargin/2, encodePDFText(label))
+	}
+}
+
+func (d *pdfDocument) pageObject(index int) int {
+	// nolint:gomnd // each page uses two objects and the pages start after the catalog, page tree and fonts
+	return 6 + 2*index
+}
+
+func (d *pdfDocument) pageReferences() string {
+	references := make([]string, 0, len(d.pages))
+	for index := range d.pages {
+		references = append(references, fmt.Sprintf("%d 0 R", d.pageObject(index)))
+	}
+
+	return strings.Join(references, " ")
+}
+
+type pdfWriter struct {
+	buffer  bytes.Buffer
+	offsets []int
+}
+
+func (w *pdfWriter) header() {
+	w.buffer.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+}
+
+func (w *pdfWriter) object(content string) {
+	w.offsets = append(w.offsets, w.buffer.Len())
+
+	_, _ = fmt.Fprintf(&w.buffer, "%d 0 obj\n%s\nendobj\n", len(w.offsets), content)
+}
+
+// trailer writes the cross reference table, where each entry must have exactly twenty bytes, and the trailer
+// pointing to the catalog and to the information dictionary, that is always the last object
+func (w *pdfWriter) trailer() []byte {
+	xref := w.buffer.Len()
+
+	_, _ = fmt.Fprintf(&w.buffer, "xref\n0 %d\n0000000000 65535 f \n", len(w.offsets)+1)
+	for _, offset := range w.offsets {
+		_, _ = fmt.Fprintf(&w.buffer, "%010d 00000 n \n", offset)
+	}
+
+	_, _ = fmt.Fprintf(&w.buffer, "trailer\n<< /Size %d /Root 1 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n",
+		len(w.offsets)+1, len(w.offsets), xref)
+
+	return w.buffer.Bytes()
+}
+
+// encodePDFText converts the value to the win ansi encoding used by the fonts, replacing the characters that are
+// not supported by a question mark, and escapes it to be used as a pdf literal string
+func encodePDFText(value string) string {
+	builder := strings.Builder{}
+
+	for _, char := range toWinAnsi(value) {
+		switch {
+		case char == '(' || char == ')' || char == '\\':
+			builder.WriteByte('\\')
+			builder.WriteByte(char)
+		case char < ' ' || char > '~':
+			_, _ = fmt.Fprintf(&builder, "\\%03o", char)
+		default:
+			builder.WriteByte(char)
+		}
+	}
+
+	return builder.String()
+}
+
+func toWinAnsi(value string) []byte {
+	encoded := make([]byte, 0, len(value))
+
+	for _, char := range strings.ReplaceAll(value, "\t", enums.TabReplacement) {
+		switch {
+		case char >= ' ' && char <= '~', char >= 0xA0 && char <= 0xFF:
+			encoded = append(encoded, byte(char))
+		case winAnsiRunes[char] != 0:
+			encoded = append(encoded, winAnsiRunes[char])
+		default:
+			encoded = append(encoded, '?')
+		}
+	}
+
+	return encoded
+}
+
+func textWidth(font string, size float64, value string) float64 {
+	total := 0
+
+	for _, char := range toWinAnsi(value) {
+		total += charWidth(font, char)
+	}
+
+	return float64(total) * size / 1000
+}
+
+func charWidth(font string, char byte) int {
+	if font == enums.PDFFontMono {
+		return enums.PDFMonoCharWidth
+	}
+
+	if char < ' ' || char > '~' {
+		return enums.PDFDefaultCharWidth
+	}
+
+	if font == enums.PDFFontBold {
+		return helveticaBoldWidths[char-' ']
+	}
+
+	return helveticaWidths[char-' ']
+}
@@ -0,0 +1,121 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reports
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/languages"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/reports/enums"
+)
+
+func assertValidXref(t *testing.T, pdf []byte) {
+	startxref := regexp.MustCompile(`startxref\n(\d+)\n%%EOF\n$`).FindSubmatch(pdf)
+	assert.Len(t, startxref, 2)
+
+	xref, _ := strconv.Atoi(string(startxref[1]))
+	assert.True(t, bytes.HasPrefix(pdf[xref:], []byte("xref\n")))
+
+	entries := regexp.MustCompile(`(\d{10}) 00000 n \n`).FindAllSubmatch(pdf[xref:], -1)
+	assert.NotEmpty(t, entries)
+
+	for index, entry := range entries {
+		offset, _ := strconv.Atoi(string(entry[1]))
+		assert.True(t, bytes.HasPrefix(pdf[offset:], []byte(fmt.Sprintf("%d 0 obj\n", index+1))))
+	}
+}
+
+func TestToPDF(t *testing.T) {
+	t.Run("should render a valid pdf document", func(t *testing.T) {
+		pdf, err := ToPDF(newTestAnalysis(
+			newTestVulnerability("a.go", "1", severities.Critical, languages.Go),
+			newTestVulnerability("b.js", "2", severities.Low, languages.Javascript),
+		), nil)
+
+		assert.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(pdf, []byte("%PDF-1.4\n")))
+		assert.True(t, bytes.HasSuffix(pdf, []byte("%%EOF\n")))
+		assert.Contains(t, string(pdf), "/Count 1")
+		assert.Contains(t, string(pdf), "(a.go:1:4)")
+		assert.Contains(t, string(pdf), "(hardcoded \\(secret\\) in a.go)")
+		assert.Contains(t, string(pdf), "(Page 1 of 1)")
+		assertValidXref(t, pdf)
+	})
+
+	t.Run("should add pages when content does not fit", func(t *testing.T) {
+		var vulnerabilities []vulnerability.Vulnerability
+		for index := 0; index < 100; index++ {
+			vulnerabilities = append(vulnerabilities,
+				newTestVulnerability(fmt.Sprintf("file%d.go", index), "1", severities.High, languages.Go))
+		}
+
+		options := NewOptions()
+		options.MaxVulnerabilities = 60
+
+		pdf, err := ToPDF(newTestAnalysis(vulnerabilities...), options)
+
+		assert.NoError(t, err)
+		assert.Regexp(t, `/Count ([2-9]|\d\d)`, string(pdf))
+		assert.Contains(t, string(pdf), fmt.Sprintf(enums.MessageOmittedFormat, 40))
+		assert.NotContains(t, string(pdf), "file99.go")
+		assertValidXref(t, pdf)
+	})
+
+	t.Run("should render analysis without vulnerabilities", func(t *testing.T) {
+		pdf, err := ToPDF(newTestAnalysis(), nil)
+
+		assert.NoError(t, err)
+		assert.Contains(t, string(pdf), enums.MessageNoVulnerability)
+	})
+
+	t.Run("should return error when analysis is nil", func(t *testing.T) {
+		pdf, err := ToPDF(nil, nil)
+
+		assert.ErrorIs(t, err, enums.ErrorNilAnalysis)
+		assert.Nil(t, pdf)
+	})
+}
+
+func TestEncodePDFText(t *testing.T) {
+	t.Run("should escape delimiters and encode non ascii characters", func(t *testing.T) {
+		assert.Equal(t, `a\(b\)\\ \351 \205 ?`, encodePDFText("a(b)\\ é … 世"))
+	})
+}
+
+func TestWrapText(t *testing.T) {
+	t.Run("should wrap text between words", func(t *testing.T) {
+		lines := wrapText(enums.PDFFontRegular, 10, 60, "some words to wrap in lines")
+
+		assert.Greater(t, len(lines), 1)
+
+		for _, line := range lines {
+			assert.LessOrEqual(t, textWidth(enums.PDFFontRegular, 10, line), 60.0)
+		}
+	})
+
+	t.Run("should split code keeping indentation", func(t *testing.T) {
+		lines := wrapText(enums.PDFFontMono, 10, 60, "\tabcdefghijklmnop\nx")
+
+		assert.Equal(t, []string{"\tabcdef", "ghijklmnop", "x"}, lines)
+	})
+}
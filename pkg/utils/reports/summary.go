@@ -0,0 +1,292 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reports
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/reports/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/sanitize"
+)
+
+type Count struct {
+	Name  string
+	Total int
+}
+
+type Field struct {
+	Name  string
+	Value string
+}
+
+type SeverityGroup struct {
+	Severity        severities.Severity
+	Total           int
+	Vulnerabilities []vulnerability.Vulnerability
+}
+
+// Summary is the data shared by every report format, the counts include every vulnerability of the analysis while
+// the groups only have the vulnerabilities until the max of the options, ordered by severity, file and line
+type Summary struct {
+	Title          string
+	RepositoryName string
+	WorkspaceName  string
+	Status         analysis.Status
+	Errors         string
+	CreatedAt      time.Time
+	FinishedAt     time.Time
+	Total          int
+	Omitted        int
+	BySeverity     []Count
+	ByLanguage     []Count
+	ByType         []Count
+	Groups         []SeverityGroup
+}
+
+func NewSummary(analysis *analysisEntities.Analysis, options *Options) *Summary {
+	summary := &Summary{
+		Title:          options.Title,
+		RepositoryName: analysis.RepositoryName,
+		WorkspaceName:  analysis.WorkspaceName,
+		Status:         analysis.Status,
+		Errors:         analysis.Errors,
+		CreatedAt:      analysis.CreatedAt,
+		FinishedAt:     analysis.FinishedAt,
+		Total:          analysis.GetTotalVulnerabilities(),
+	}
+
+	vulnerabilities := sortedVulnerabilities(analysis)
+	summary.BySeverity = countBySeverity(vulnerabilities)
+	summary.ByLanguage = countBy(vulnerabilities, func(v *vulnerability.Vulnerability) string {
+		return v.Language.ToString()
+	})
+	summary.ByType = countBy(vulnerabilities, func(v *vulnerability.Vulnerability) string {
+		return v.Type.ToString()
+	})
+
+	if options.MaxVulnerabilities > 0 && len(vulnerabilities) > options.MaxVulnerabilities {
+		summary.Omitted = len(vulnerabilities) - options.MaxVulnerabilities
+		vulnerabilities = vulnerabilities[:options.MaxVulnerabilities]
+	}
+
+	summary.Groups = groupBySeverity(summary.BySeverity, vulnerabilities, options)
+
+	return summary
+}
+
+// newReportSummary validates the arguments of the report renderers, using the default options when they are nil
+func newReportSummary(analysis *analysisEntities.Analysis, options *Options) (*Summary, error) {
+	if analysis == nil {
+		return nil, enums.ErrorNilAnalysis
+	}
+
+	if options == nil {
+		options = NewOptions()
+	}
+
+	return NewSummary(analysis, options), nil
+}
+
+// Fields returns the labeled information of the analysis shown on the top of the reports, without the empty ones
+func (s *Summary) Fields() []Field {
+	fields := make([]Field, 0, 7) // nolint:gomnd // number of fields of the analysis information
+
+	for _, field := range []Field{
+		{Name: enums.LabelRepository, Value: s.RepositoryName},
+		{Name: enums.LabelWorkspace, Value: s.WorkspaceName},
+		{Name: enums.LabelStatus, Value: s.Status.ToString()},
+		{Name: enums.LabelCreatedAt, Value: formatTime(s.CreatedAt)},
+		{Name: enums.LabelFinishedAt, Value: formatTime(s.FinishedAt)},
+		{Name: enums.LabelTotal, Value: fmt.Sprint(s.Total)},
+		{Name: enums.LabelErrors, Value: s.Errors},
+	} {
+		if field.Value != "" {
+			fields = append(fields, field)
+		}
+	}
+
+	return fields
+}
+
+// MaxSeverityTotal returns the total of the severity with more vulnerabilities, used as the scale of the charts
+func (s *Summary) MaxSeverityTotal() int {
+	maxTotal := 0
+
+	for _, count := range s.BySeverity {
+		if count.Total > maxTotal {
+			maxTotal = count.Total
+		}
+	}
+
+	return maxTotal
+}
+
+// Location returns the file, line and column of the vulnerability as file:line:column, without the empty parts
+func Location(v *vulnerability.Vulnerability) string {
+	location := v.File
+	for _, part := range []string{v.Line, v.Column} {
+		if part == "" {
+			break
+		}
+
+		location += ":" + part
+	}
+
+	return location
+}
+
+// MetadataLine returns the tool, language, confidence and type of the vulnerability, without the empty ones
+func MetadataLine(v *vulnerability.Vulnerability) string {
+	fields := make([]string, 0, 4) // nolint:gomnd // number of fields of the line
+
+	for _, field := range [][2]string{
+		{enums.LabelTool, v.SecurityTool.ToString()},
+		{enums.LabelLanguage, v.Language.ToString()},
+		{enums.LabelConfidence, v.Confidence.ToString()},
+		{enums.LabelType, v.Type.ToString()},
+	} {
+		if field[1] != "" {
+			fields = append(fields, field[0]+": "+field[1])
+		}
+	}
+
+	return strings.Join(fields, " | ")
+}
+
+func sortedVulnerabilities(analysis *analysisEntities.Analysis) []vulnerability.Vulnerability {
+	vulnerabilities := make([]vulnerability.Vulnerability, 0, len(analysis.AnalysisVulnerabilities))
+	for index := range analysis.AnalysisVulnerabilities {
+		vulnerabilities = append(vulnerabilities, analysis.AnalysisVulnerabilities[index].Vulnerability)
+	}
+
+	order := severityOrder()
+
+	sort.SliceStable(vulnerabilities, func(i, j int) bool {
+		first, second := &vulnerabilities[i], &vulnerabilities[j]
+		firstOrder, secondOrder := order[normalizeSeverity(first.Severity)], order[normalizeSeverity(second.Severity)]
+		if firstOrder != secondOrder {
+			return firstOrder < secondOrder
+		}
+
+		return Location(first) < Location(second)
+	})
+
+	return vulnerabilities
+}
+
+func severityOrder() map[severities.Severity]int {
+	order := map[severities.Severity]int{}
+	for index, severity := range severities.Values() {
+		order[severity] = index
+	}
+
+	return order
+}
+
+// normalizeSeverity ignores the case of the severity, the invalid ones are reported as unknown
+func normalizeSeverity(severity severities.Severity) severities.Severity {
+	for _, value := range severities.Values() {
+		if strings.EqualFold(value.ToString(), severity.ToString()) {
+			return value
+		}
+	}
+
+	return severities.Unknown
+}
+
+func countBySeverity(vulnerabilities []vulnerability.Vulnerability) []Count {
+	totals := map[severities.Severity]int{}
+	for index := range vulnerabilities {
+		totals[normalizeSeverity(vulnerabilities[index].Severity)]++
+	}
+
+	counts := make([]Count, 0, len(severities.Values()))
+	for _, severity := range severities.Values() {
+		counts = append(counts, Count{Name: severity.ToString(), Total: totals[severity]})
+	}
+
+	return counts
+}
+
+// countBy returns the counts ordered by total and then by name, the empty names are not counted
+func countBy(vulnerabilities []vulnerability.Vulnerability, name func(v *vulnerability.Vulnerability) string) []Count {
+	totals := map[string]int{}
+	for index := range vulnerabilities {
+		if value := name(&vulnerabilities[index]); value != "" {
+			totals[value]++
+		}
+	}
+
+	counts := make([]Count, 0, len(totals))
+	for value, total := range totals {
+		counts = append(counts, Count{Name: value, Total: total})
+	}
+
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Total != counts[j].Total {
+			return counts[i].Total > counts[j].Total
+		}
+
+		return counts[i].Name < counts[j].Name
+	})
+
+	return counts
+}
+
+func groupBySeverity(counts []Count, vulnerabilities []vulnerability.Vulnerability, options *Options) []SeverityGroup {
+	groups := make([]SeverityGroup, 0, len(counts))
+
+	for _, count := range counts {
+		group := SeverityGroup{Severity: severities.Severity(count.Name), Total: count.Total}
+
+		for index := range vulnerabilities {
+			if normalizeSeverity(vulnerabilities[index].Severity) == group.Severity {
+				group.Vulnerabilities = append(group.Vulnerabilities, truncate(vulnerabilities[index], options))
+			}
+		}
+
+		if len(group.Vulnerabilities) > 0 {
+			groups = append(groups, group)
+		}
+	}
+
+	return groups
+}
+
+func truncate(v vulnerability.Vulnerability, options *Options) vulnerability.Vulnerability {
+	v.Details = sanitize.Truncate(v.Details, options.MaxDetailsLength)
+	v.Code = sanitize.Truncate(v.Code, options.MaxCodeLength)
+
+	if !options.IncludeCode {
+		v.Code = ""
+	}
+
+	return v
+}
+
+func formatTime(value time.Time) string {
+	if value.IsZero() {
+		return ""
+	}
+
+	return value.Format(enums.DateTimeLayout)
+}
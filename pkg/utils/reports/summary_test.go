@@ -0,0 +1,100 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reports
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/languages"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/tools"
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+)
+
+func newTestVulnerability(file, line string, severity severities.Severity,
+	language languages.Language) vulnerability.Vulnerability {
+	return vulnerability.Vulnerability{
+		File:         file,
+		Line:         line,
+		Column:       "4",
+		Details:      "hardcoded (secret) in " + file,
+		Code:         "password := \"123\"",
+		Severity:     severity,
+		Language:     language,
+		SecurityTool: tools.HorusecEngine,
+		Type:         vulnerabilityEnums.Vulnerability,
+	}
+}
+
+func newTestAnalysis(vulnerabilities ...vulnerability.Vulnerability) *analysisEntities.Analysis {
+	analysis := &analysisEntities.Analysis{RepositoryName: "repository", WorkspaceName: "workspace"}
+	for index := range vulnerabilities {
+		analysis.AnalysisVulnerabilities = append(analysis.AnalysisVulnerabilities,
+			analysisEntities.AnalysisVulnerabilities{Vulnerability: vulnerabilities[index]})
+	}
+
+	return analysis
+}
+
+func TestNewSummary(t *testing.T) {
+	t.Run("should count and group vulnerabilities by severity", func(t *testing.T) {
+		summary := NewSummary(newTestAnalysis(
+			newTestVulnerability("b.go", "2", severities.Low, languages.Go),
+			newTestVulnerability("a.go", "1", severities.Critical, languages.Go),
+			newTestVulnerability("c.js", "3", "critical", languages.Javascript),
+			newTestVulnerability("d.js", "4", "invalid", languages.Javascript),
+			newTestVulnerability("e.go", "5", severities.Low, languages.Go),
+		), NewOptions())
+
+		assert.Equal(t, 5, summary.Total)
+		assert.Equal(t, []Count{
+			{Name: "CRITICAL", Total: 2}, {Name: "HIGH"}, {Name: "MEDIUM"}, {Name: "LOW", Total: 2},
+			{Name: "UNKNOWN", Total: 1}, {Name: "INFO"},
+		}, summary.BySeverity)
+		assert.Equal(t, []Count{{Name: "Go", Total: 3}, {Name: "JavaScript", Total: 2}}, summary.ByLanguage)
+		assert.Len(t, summary.Groups, 3)
+		assert.Equal(t, severities.Critical, summary.Groups[0].Severity)
+		assert.Equal(t, "a.go", summary.Groups[0].Vulnerabilities[0].File)
+		assert.Equal(t, "c.js", summary.Groups[0].Vulnerabilities[1].File)
+		assert.Equal(t, severities.Unknown, summary.Groups[2].Severity)
+	})
+
+	t.Run("should limit vulnerabilities and keep totals", func(t *testing.T) {
+		options := NewOptions()
+		options.MaxVulnerabilities = 1
+		options.IncludeCode = false
+
+		summary := NewSummary(newTestAnalysis(
+			newTestVulnerability("a.go", "1", severities.High, languages.Go),
+			newTestVulnerability("b.go", "1", severities.Low, languages.Go),
+		), options)
+
+		assert.Equal(t, 1, summary.Omitted)
+		assert.Len(t, summary.Groups, 1)
+		assert.Equal(t, 1, summary.BySeverity[3].Total)
+		assert.Empty(t, summary.Groups[0].Vulnerabilities[0].Code)
+	})
+}
+
+func TestLocation(t *testing.T) {
+	t.Run("should return file line and column without empty parts", func(t *testing.T) {
+		assert.Equal(t, "a.go:1:2", Location(&vulnerability.Vulnerability{File: "a.go", Line: "1", Column: "2"}))
+		assert.Equal(t, "a.go", Location(&vulnerability.Vulnerability{File: "a.go", Column: "2"}))
+	})
+}
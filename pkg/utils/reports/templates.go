@@ -0,0 +1,135 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reports
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmlTemplate "html/template"
+	"strings"
+	textTemplate "text/template"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/reports/enums"
+)
+
+// nolint:gochecknoglobals // templates are embedded and parsed only once
+var (
+	//go:embed templates
+	templatesFS embed.FS
+
+	htmlTemplates = htmlTemplate.Must(
+		htmlTemplate.New("").Funcs(htmlTemplate.FuncMap(templateFuncs())).
+			ParseFS(templatesFS, "templates/"+enums.TemplateHTML),
+	)
+	markdownTemplates = textTemplate.Must(
+		textTemplate.New("").Funcs(markdownFuncs()).ParseFS(templatesFS, "templates/"+enums.TemplateMarkdown),
+	)
+
+	labels = map[string]string{
+		"BySeverity":      enums.LabelBySeverity,
+		"ByLanguage":      enums.LabelByLanguage,
+		"ByType":          enums.LabelByType,
+		"Language":        enums.LabelLanguage,
+		"Type":            enums.LabelType,
+		"Severity":        enums.LabelSeverity,
+		"Total":           enums.LabelCount,
+		"NoVulnerability": enums.MessageNoVulnerability,
+	}
+)
+
+type countsTable struct {
+	Title  string
+	Column string
+	Counts []Count
+}
+
+func templateFuncs() textTemplate.FuncMap {
+	return textTemplate.FuncMap{
+		"label":    label,
+		"location": Location,
+		"metadata": MetadataLine,
+		"omitted": func(total int) string {
+			return fmt.Sprintf(enums.MessageOmittedFormat, total)
+		},
+		"counts": func(title, column string, counts []Count) countsTable {
+			return countsTable{Title: title, Column: column, Counts: counts}
+		},
+		"percent": func(total, maxTotal int) int {
+			if maxTotal == 0 {
+				return 0
+			}
+
+			return total * 100 / maxTotal // nolint:gomnd // percentage
+		},
+		"severityColor": func(severity string) string {
+			return severityColor(normalizeSeverity(severities.Severity(severity))).hex()
+		},
+		"textColor":  colorText.hex,
+		"mutedColor": colorMuted.hex,
+	}
+}
+
+func markdownFuncs() textTemplate.FuncMap {
+	funcs := templateFuncs()
+	funcs["md"] = escapeMarkdown
+	funcs["fence"] = fenceCode
+
+	return funcs
+}
+
+func label(name string) (string, error) {
+	if value, ok := labels[name]; ok {
+		return value, nil
+	}
+
+	return "", fmt.Errorf("%w: %s", enums.ErrorUnknownLabel, name)
+}
+
+// escapeMarkdown escapes the characters that would start a markdown or html element, so texts from the analyzed
+// code like details and file names are shown as written
+func escapeMarkdown(value string) string {
+	builder := strings.Builder{}
+
+	for _, char := range strings.ReplaceAll(value, "\r\n", "\n") {
+		if strings.ContainsRune("\\`*_{}[]<>()#+-!|~", char) {
+			builder.WriteRune('\\')
+		}
+
+		builder.WriteRune(char)
+	}
+
+	return builder.String()
+}
+
+// fenceCode returns the code inside a fenced block, using a fence longer than any backtick sequence of the code
+func fenceCode(code string) string {
+	fence := enums.MarkdownFence
+	for strings.Contains(code, fence) {
+		fence += enums.MarkdownFenceChar
+	}
+
+	return fence + "\n" + strings.TrimRight(code, "\n") + "\n" + fence
+}
+
+func render(execute func(buffer *bytes.Buffer) error) ([]byte, error) {
+	buffer := &bytes.Buffer{}
+	if err := execute(buffer); err != nil {
+		return nil, fmt.Errorf("%w: %s", enums.ErrorRenderFailed, err.Error())
+	}
+
+	return buffer.Bytes(), nil
+}
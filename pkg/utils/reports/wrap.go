@@ -0,0 +1,82 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reports
+
+import (
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/reports/enums"
+)
+
+// wrapText splits the value in lines that fit the width, keeping the line breaks of the value. The monospaced font
+// is used for code, so its lines are split at the width keeping the indentation, while the other fonts are split
+// between words and only the words wider than the line are split in the middle.
+func wrapText(font string, size, width float64, value string) []string {
+	var lines []string
+
+	for _, line := range strings.Split(strings.ReplaceAll(value, "\r\n", "\n"), "\n") {
+		if font == enums.PDFFontMono {
+			lines = append(lines, splitRunes(font, size, width, strings.TrimRight(line, " \t\r"))...)
+
+			continue
+		}
+
+		lines = append(lines, wrapWords(font, size, width, line)...)
+	}
+
+	return lines
+}
+
+func wrapWords(font string, size, width float64, line string) []string {
+	var lines []string
+
+	current := ""
+
+	for _, word := range strings.Fields(line) {
+		candidate := strings.TrimSpace(current + " " + word)
+		if textWidth(font, size, candidate) <= width {
+			current = candidate
+
+			continue
+		}
+
+		if current != "" {
+			lines = append(lines, current)
+		}
+
+		parts := splitRunes(font, size, width, word)
+		lines = append(lines, parts[:len(parts)-1]...)
+		current = parts[len(parts)-1]
+	}
+
+	return append(lines, current)
+}
+
+func splitRunes(font string, size, width float64, value string) []string {
+	var lines []string
+
+	current := ""
+
+	for _, char := range value {
+		if current != "" && textWidth(font, size, current+string(char)) > width {
+			lines = append(lines, current)
+			current = ""
+		}
+
+		current += string(char)
+	}
+
+	return append(lines, current)
+}
@@ -0,0 +1,74 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import "time"
+
+type permanentError struct {
+	err error
+}
+
+func (p *permanentError) Error() string {
+	return p.err.Error()
+}
+
+func (p *permanentError) Unwrap() error {
+	return p.err
+}
+
+type afterError struct {
+	err   error
+	delay time.Duration
+}
+
+func (a *afterError) Error() string {
+	return a.err.Error()
+}
+
+func (a *afterError) Unwrap() error {
+	return a.err
+}
+
+// Permanent stops the retries regardless of the policy classification, Do returns the wrapped error
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &permanentError{err: err}
+}
+
+// After asks to wait at least the delay before the next attempt, still limited by the max backoff of the policy
+func After(err error, delay time.Duration) error {
+	if err == nil {
+		return nil
+	}
+
+	return &afterError{err: err, delay: delay}
+}
+
+// unwrap removes the wrappers of this package, so the callers receive the error returned by the operation
+func unwrap(err error) error {
+	for {
+		switch wrapped := err.(type) { // nolint:errorlint // only the outer wrappers are removed
+		case *permanentError:
+			err = wrapped.err
+		case *afterError:
+			err = wrapped.err
+		default:
+			return err
+		}
+	}
+}
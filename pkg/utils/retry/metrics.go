@@ -0,0 +1,39 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/metrics"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/retry/enums"
+)
+
+// nolint:gochecknoglobals // metrics are registered only once on the shared registry
+var (
+	attempts     *prometheus.CounterVec
+	attemptsOnce sync.Once
+)
+
+func observe(policy *Policy, result string) {
+	attemptsOnce.Do(func() {
+		attempts = metrics.NewCounterVec(enums.MetricsAttempts, "Total of retried operation attempts by result.",
+			enums.MetricsLabelOperation, enums.MetricsLabelResult)
+	})
+
+	attempts.WithLabelValues(policy.Name, result).Inc()
+}
@@ -0,0 +1,159 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/retry/enums"
+)
+
+// Policy configures the retries of an operation. The backoff starts on InitialBackoff and is multiplied on each
+// attempt up to MaxBackoff, then randomized by the Jitter fraction. MaxAttempts and MaxElapsedTime are disabled when
+// zero and IsRetryable retries every error when nil. The name identifies the operation on the metrics and logs, and
+// the clock is replaced on tests to not wait the real backoff.
+type Policy struct {
+	Name           string
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+	Jitter         float64
+	MaxElapsedTime time.Duration
+	IsRetryable    func(err error) bool
+	OnRetry        func(attempt int, err error, backoff time.Duration)
+	Clock          clock.IClock
+}
+
+func NewPolicy(name string) *Policy {
+	return &Policy{
+		Name:           name,
+		MaxAttempts:    enums.DefaultMaxAttempts,
+		InitialBackoff: enums.DefaultInitialBackoff,
+		MaxBackoff:     enums.DefaultMaxBackoff,
+		Multiplier:     enums.DefaultMultiplier,
+		Jitter:         enums.DefaultJitter,
+		Clock:          clock.NewClock(),
+	}
+}
+
+// Do calls fn until it succeeds, returns a non retryable error or the policy limits are reached, returning the last
+// error of fn. When the context is done while waiting the backoff, the context error is returned instead.
+func Do(ctx context.Context, policy *Policy, fn func(ctx context.Context) error) error {
+	timer := clock.OrDefault(policy.Clock)
+	start, backoff := timer.Now(), policy.InitialBackoff
+
+	for attempt := 1; ; attempt++ {
+		err := fn(ctx)
+		if err == nil {
+			observe(policy, enums.ResultSuccess)
+
+			return nil
+		}
+
+		wait := policy.nextWait(backoff, err)
+		if !policy.shouldRetry(attempt, timer.Since(start)+wait, err) {
+			observe(policy, enums.ResultFailed)
+
+			return unwrap(err)
+		}
+
+		policy.notify(attempt, err, wait)
+
+		if waitErr := sleep(ctx, timer, wait); waitErr != nil {
+			observe(policy, enums.ResultCanceled)
+
+			return waitErr
+		}
+
+		backoff = policy.increase(backoff)
+	}
+}
+
+func (p *Policy) shouldRetry(attempt int, elapsed time.Duration, err error) bool {
+	var permanent *permanentError
+	if errors.As(err, &permanent) {
+		return false
+	}
+
+	if p.MaxAttempts > 0 && attempt >= p.MaxAttempts {
+		return false
+	}
+
+	if p.MaxElapsedTime > 0 && elapsed > p.MaxElapsedTime {
+		return false
+	}
+
+	return p.IsRetryable == nil || p.IsRetryable(unwrap(err))
+}
+
+func (p *Policy) notify(attempt int, err error, wait time.Duration) {
+	observe(p, enums.ResultRetry)
+
+	if p.OnRetry != nil {
+		p.OnRetry(attempt, unwrap(err), wait)
+
+		return
+	}
+
+	logger.LogError(enums.MessageRetrying, unwrap(err), map[string]interface{}{
+		enums.LogFieldOperation: p.Name, enums.LogFieldAttempt: attempt, enums.LogFieldBackoff: wait.String(),
+	})
+}
+
+func (p *Policy) increase(backoff time.Duration) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = enums.DefaultMultiplier
+	}
+
+	return p.limit(time.Duration(float64(backoff) * multiplier))
+}
+
+// nextWait randomizes the backoff to avoid every client retrying at the same time after a server restart, and waits
+// the delay asked by the error when it is longer, like the Retry-After header of an http response
+func (p *Policy) nextWait(backoff time.Duration, err error) time.Duration {
+	// nolint:gosec // jitter does not need a secure random
+	wait := p.limit(backoff + time.Duration((rand.Float64()*2-1)*p.Jitter*float64(backoff)))
+
+	var after *afterError
+	if errors.As(err, &after) && after.delay > wait {
+		wait = p.limit(after.delay)
+	}
+
+	return wait
+}
+
+func (p *Policy) limit(backoff time.Duration) time.Duration {
+	if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+
+	return backoff
+}
+
+func sleep(ctx context.Context, timer clock.IClock, wait time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.After(wait):
+		return nil
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/clock"
+)
+
+func newTestPolicy() *Policy {
+	policy := NewPolicy("test")
+	policy.InitialBackoff = time.Millisecond
+	policy.MaxBackoff = 10 * time.Millisecond
+	policy.Jitter = 0
+
+	return policy
+}
+
+func TestDo(t *testing.T) {
+	t.Run("should retry until success", func(t *testing.T) {
+		calls := 0
+
+		err := Do(context.Background(), newTestPolicy(), func(ctx context.Context) error {
+			if calls++; calls < 3 {
+				return errors.New("test")
+			}
+
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("should return last error after max attempts", func(t *testing.T) {
+		calls := 0
+		policy := newTestPolicy()
+		policy.MaxAttempts = 3
+
+		err := Do(context.Background(), policy, func(ctx context.Context) error {
+			calls++
+
+			return errors.New("test")
+		})
+
+		assert.EqualError(t, err, "test")
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("should not retry when error is not retryable", func(t *testing.T) {
+		calls := 0
+		expectedErr := errors.New("test")
+		policy := newTestPolicy()
+		policy.IsRetryable = func(err error) bool {
+			return !errors.Is(err, expectedErr)
+		}
+
+		err := Do(context.Background(), policy, func(ctx context.Context) error {
+			calls++
+
+			return expectedErr
+		})
+
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("should not retry and unwrap permanent error", func(t *testing.T) {
+		calls := 0
+		expectedErr := errors.New("test")
+
+		err := Do(context.Background(), newTestPolicy(), func(ctx context.Context) error {
+			calls++
+
+			return Permanent(expectedErr)
+		})
+
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("should stop when max elapsed time is reached", func(t *testing.T) {
+		calls := 0
+		policy := newTestPolicy()
+		policy.MaxAttempts = 0
+		policy.InitialBackoff = 20 * time.Minute
+		policy.MaxBackoff = 20 * time.Minute
+		policy.MaxElapsedTime = 50 * time.Minute
+		fakeClock := clock.NewFakeClock(time.Now())
+		policy.Clock = fakeClock
+		done := make(chan error)
+
+		go func() {
+			done <- Do(context.Background(), policy, func(ctx context.Context) error {
+				calls++
+
+				return errors.New("test")
+			})
+		}()
+
+		for i := 0; i < 2; i++ {
+			fakeClock.BlockUntil(1)
+			fakeClock.Advance(20 * time.Minute)
+		}
+
+		assert.Error(t, <-done)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("should return context error when canceled while waiting", func(t *testing.T) {
+		calls := 0
+		policy := newTestPolicy()
+		policy.InitialBackoff = time.Hour
+		policy.MaxBackoff = time.Hour
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		err := Do(ctx, policy, func(ctx context.Context) error {
+			calls++
+
+			return errors.New("test")
+		})
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("should call on retry with the unwrapped error and the backoff", func(t *testing.T) {
+		var backoffs []time.Duration
+
+		expectedErr := errors.New("test")
+		policy := newTestPolicy()
+		policy.MaxAttempts = 4
+		policy.OnRetry = func(attempt int, err error, backoff time.Duration) {
+			assert.Equal(t, expectedErr, err)
+			assert.Equal(t, len(backoffs)+1, attempt)
+
+			backoffs = append(backoffs, backoff)
+		}
+
+		_ = Do(context.Background(), policy, func(ctx context.Context) error {
+			return expectedErr
+		})
+
+		assert.Equal(t, []time.Duration{time.Millisecond, 2 * time.Millisecond, 4 * time.Millisecond}, backoffs)
+	})
+}
+
+func TestNextWait(t *testing.T) {
+	t.Run("should wait the delay of after error when longer than backoff", func(t *testing.T) {
+		policy := newTestPolicy()
+
+		assert.Equal(t, 5*time.Millisecond, policy.nextWait(time.Millisecond, After(errors.New("test"),
+			5*time.Millisecond)))
+		assert.Equal(t, 2*time.Millisecond, policy.nextWait(2*time.Millisecond, After(errors.New("test"),
+			time.Millisecond)))
+		assert.Equal(t, 10*time.Millisecond, policy.nextWait(time.Millisecond, After(errors.New("test"),
+			time.Hour)))
+	})
+
+	t.Run("should randomize the backoff by the jitter fraction", func(t *testing.T) {
+		policy := newTestPolicy()
+		policy.Jitter = 0.5
+		policy.MaxBackoff = 0
+
+		for i := 0; i < 100; i++ {
+			wait := policy.nextWait(time.Second, errors.New("test"))
+
+			assert.GreaterOrEqual(t, wait, 500*time.Millisecond)
+			assert.LessOrEqual(t, wait, 1500*time.Millisecond)
+		}
+	})
+}
+
+func TestWrappers(t *testing.T) {
+	t.Run("should return nil when wrapping nil errors", func(t *testing.T) {
+		assert.NoError(t, Permanent(nil))
+		assert.NoError(t, After(nil, time.Second))
+	})
+
+	t.Run("should keep the wrapped error on the chain", func(t *testing.T) {
+		expectedErr := errors.New("test")
+
+		assert.ErrorIs(t, Permanent(After(expectedErr, time.Second)), expectedErr)
+		assert.Equal(t, expectedErr, unwrap(Permanent(After(expectedErr, time.Second))))
+		assert.Equal(t, "test", Permanent(expectedErr).Error())
+	})
+}
@@ -0,0 +1,135 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sanitize
+
+import (
+	"html"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/sanitize/enums"
+)
+
+// nolint:gochecknoglobals // names of the fields, headers and parameters that usually have credentials
+var secretNames = []string{"password", "secret", "token", "privatekey", "apikey", "authorization", "cookie",
+	"credential"}
+
+// Normalize returns the user input in the unicode NFC form without leading and trailing spaces and with each
+// sequence of spaces replaced by a single one, so visually equal names are also equal when compared
+func Normalize(value string) string {
+	return strings.Join(strings.Fields(norm.NFC.String(value)), " ")
+}
+
+// NormalizeAll normalizes each value, removing the empty and duplicated ones while keeping their order
+func NormalizeAll(values []string) []string {
+	normalized := make([]string, 0, len(values))
+	seen := make(map[string]bool, len(values))
+
+	for _, value := range values {
+		value = Normalize(value)
+		if value == "" || seen[value] {
+			continue
+		}
+
+		seen[value] = true
+		normalized = append(normalized, value)
+	}
+
+	return normalized
+}
+
+// StripControlCharacters removes the line breaks, including the unicode line and paragraph separators, and the
+// other control characters except tab, so a crafted value, like a repository name, cannot forge a new log line
+func StripControlCharacters(value string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '\t' {
+			return r
+		}
+
+		if unicode.IsControl(r) || r == '\u2028' || r == '\u2029' {
+			return -1
+		}
+
+		return r
+	}, value)
+}
+
+// ForLog strips the control characters and truncates the value, it is applied by the logger on the messages, the
+// string fields and the errors
+func ForLog(value string) string {
+	return Truncate(StripControlCharacters(value), enums.MaxLogValueLength)
+}
+
+// ForResponse strips the control characters and truncates the messages written on the http responses, which may
+// contain values sent by the user
+func ForResponse(value string) string {
+	return Truncate(StripControlCharacters(value), enums.MaxResponseMessageLength)
+}
+
+// EscapeHTML escapes the characters that would be interpreted as html when the value is rendered on a page
+func EscapeHTML(value string) string {
+	return html.EscapeString(value)
+}
+
+// Truncate limits the value to the max length in runes, replacing the last one by an ellipsis when truncated, so
+// a multi-byte character is never split
+func Truncate(value string, maxLength int) string {
+	if maxLength <= 0 {
+		return ""
+	}
+
+	if utf8.RuneCountInString(value) <= maxLength {
+		return value
+	}
+
+	return string([]rune(value)[:maxLength-1]) + enums.Ellipsis
+}
+
+// IsSecretName ignores the case, underscores and hyphens of the name, so "Password", "api_key" and
+// "X-Horusec-Authorization" are all secret names
+func IsSecretName(name string) bool {
+	name = strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(name))
+
+	for _, secretName := range secretNames {
+		if strings.Contains(name, secretName) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MaskSecrets replaces the values of the secret fields in any depth of a decoded json, changing the value in place
+func MaskSecrets(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range typed {
+			if IsSecretName(key) && nested != nil {
+				typed[key] = enums.MaskedValue
+			} else {
+				typed[key] = MaskSecrets(nested)
+			}
+		}
+	case []interface{}:
+		for index, nested := range typed {
+			typed[index] = MaskSecrets(nested)
+		}
+	}
+
+	return value
+}
@@ -0,0 +1,96 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sanitize
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/sanitize/enums"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Run("should trim and collapse spaces", func(t *testing.T) {
+		assert.Equal(t, "horusec devkit", Normalize("  horusec \t\n devkit  "))
+	})
+
+	t.Run("should normalize to the composed form", func(t *testing.T) {
+		assert.Equal(t, "s\u00e3o", Normalize("sa\u0303o"))
+	})
+}
+
+func TestNormalizeAll(t *testing.T) {
+	t.Run("should remove empty and duplicated values keeping the order", func(t *testing.T) {
+		assert.Equal(t, []string{"b", "a"}, NormalizeAll([]string{" b", "", "a ", "b", "  "}))
+	})
+}
+
+func TestStripControlCharacters(t *testing.T) {
+	t.Run("should remove line breaks and control characters except tab", func(t *testing.T) {
+		assert.Equal(t, "test\tforged", StripControlCharacters("test\r\n\t  \u0085\x1bforged"))
+	})
+}
+
+func TestForLogAndForResponse(t *testing.T) {
+	t.Run("should strip and truncate the value", func(t *testing.T) {
+		value := strings.Repeat("a", enums.MaxLogValueLength+1)
+
+		assert.Equal(t, "test", ForLog("te\nst"))
+		assert.Equal(t, enums.MaxLogValueLength, len([]rune(ForLog(value))))
+		assert.Equal(t, enums.MaxResponseMessageLength, len([]rune(ForResponse(value))))
+	})
+}
+
+func TestEscapeHTML(t *testing.T) {
+	t.Run("should escape html characters", func(t *testing.T) {
+		assert.Equal(t, "&lt;script&gt;&#39;test&#39;&lt;/script&gt;", EscapeHTML("<script>'test'</script>"))
+	})
+}
+
+func TestTruncate(t *testing.T) {
+	t.Run("should not truncate when value fits", func(t *testing.T) {
+		assert.Equal(t, "test", Truncate("test", 4))
+	})
+
+	t.Run("should truncate by runes with ellipsis", func(t *testing.T) {
+		assert.Equal(t, "ção…", Truncate("çãoção", 4))
+	})
+
+	t.Run("should return empty when max length is not positive", func(t *testing.T) {
+		assert.Empty(t, Truncate("test", 0))
+	})
+}
+
+func TestIsSecretName(t *testing.T) {
+	t.Run("should ignore case, underscores and hyphens", func(t *testing.T) {
+		assert.True(t, IsSecretName("Password"))
+		assert.True(t, IsSecretName("api_key"))
+		assert.True(t, IsSecretName("X-Horusec-Authorization"))
+		assert.False(t, IsSecretName("repositoryName"))
+	})
+}
+
+func TestMaskSecrets(t *testing.T) {
+	t.Run("should mask secret fields in any depth", func(t *testing.T) {
+		value := map[string]interface{}{"name": "test", "password": "test", "empty_token": nil,
+			"items": []interface{}{map[string]interface{}{"clientSecret": "test", "id": 1.0}}}
+
+		assert.Equal(t, map[string]interface{}{"name": "test", "password": enums.MaskedValue, "empty_token": nil,
+			"items": []interface{}{map[string]interface{}{"clientSecret": enums.MaskedValue, "id": 1.0}}},
+			MaskSecrets(value))
+	})
+}
@@ -0,0 +1,314 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semver
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/semver/enums"
+)
+
+// nolint:gochecknoglobals // regex to find the hyphen ranges like 1.2.3 - 2.3.4 before splitting the comparators
+var hyphenRangeRegex = regexp.MustCompile(`^\s*(\S+)\s+-\s+(\S+)\s*$`)
+
+// nolint:gochecknoglobals // operators ordered from the longest to the shortest to be removed from the comparators
+var operators = []string{"~>", ">=", "<=", "==", "!=", "^", "~", ">", "<", "="}
+
+type comparator struct {
+	operator string
+	version  *Version
+}
+
+// Constraint is a set of comparators joined by ||, where the comparators of each set are joined by commas or spaces,
+// like >=1.0.0, <1.2.3 || ^2.0.0. Besides the comparators it accepts the x-ranges as 1.2.x, the hyphen ranges as
+// 1.2.3 - 2.3.4, the caret and tilde ranges as ^1.2.3 and ~1.2.3, and the pessimistic operator ~> of the ruby gems
+type Constraint struct {
+	// IncludePrerelease allows the prereleases to match any comparator, otherwise a prerelease only matches when some
+	// comparator of the set has a prerelease of the same major, minor and patch, as done by the npm ranges
+	IncludePrerelease bool
+	value             string
+	sets              [][]*comparator
+}
+
+func ParseConstraint(value string) (*Constraint, error) {
+	constraint := &Constraint{value: strings.TrimSpace(value)}
+
+	for _, set := range strings.Split(value, "||") {
+		comparators, err := parseComparatorSet(set)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", enums.ErrorInvalidConstraint, value)
+		}
+
+		constraint.sets = append(constraint.sets, comparators)
+	}
+
+	return constraint, nil
+}
+
+func parseComparatorSet(set string) ([]*comparator, error) {
+	if matches := hyphenRangeRegex.FindStringSubmatch(set); matches != nil {
+		return parseHyphenRange(matches[1], matches[2])
+	}
+
+	var comparators []*comparator
+
+	for _, token := range splitComparators(set) {
+		expanded, err := parseComparator(token)
+		if err != nil {
+			return nil, err
+		}
+
+		comparators = append(comparators, expanded...)
+	}
+
+	return comparators, nil
+}
+
+// splitComparators splits by commas and spaces joining the operators separated from its versions, like >= 1.0.0
+func splitComparators(set string) (tokens []string) {
+	operator := ""
+
+	for _, field := range strings.Fields(strings.ReplaceAll(set, ",", " ")) {
+		if isOperator(field) {
+			operator += field
+
+			continue
+		}
+
+		tokens = append(tokens, operator+field)
+		operator = ""
+	}
+
+	if operator != "" {
+		tokens = append(tokens, operator)
+	}
+
+	return tokens
+}
+
+func isOperator(value string) bool {
+	for _, operator := range operators {
+		if value == operator {
+			return true
+		}
+	}
+
+	return false
+}
+
+func splitOperator(token string) (string, string) {
+	for _, operator := range operators {
+		if strings.HasPrefix(token, operator) {
+			return operator, strings.TrimPrefix(token, operator)
+		}
+	}
+
+	return "", token
+}
+
+func parseHyphenRange(lower, upper string) ([]*comparator, error) {
+	lowerComparators, err := parseComparator(">=" + lower)
+	if err != nil {
+		return nil, err
+	}
+
+	upperComparators, err := parseComparator("<=" + upper)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(lowerComparators, upperComparators...), nil
+}
+
+//nolint:funlen,gocyclo // method need to have more then 15 lines to expand each operator
+func parseComparator(token string) ([]*comparator, error) {
+	operator, value := splitOperator(token)
+
+	version, err := parse(value)
+	if err != nil {
+		return nil, err
+	}
+
+	if version.components == 0 {
+		return parseAny(operator)
+	}
+
+	switch operator {
+	case "", "=", "==":
+		return version.toRange(version.nextComponent()), nil
+	case "!=":
+		return version.toExclusion()
+	case "^":
+		return version.toRange(version.nextCaret()), nil
+	case "~":
+		return version.toRange(version.nextTilde()), nil
+	case "~>":
+		return version.toRange(version.nextPessimistic()), nil
+	case ">":
+		if version.components < 3 {
+			return []*comparator{{operator: ">=", version: version.nextComponent()}}, nil
+		}
+	case "<=":
+		if version.components < 3 {
+			return []*comparator{{operator: "<", version: version.nextComponent()}}, nil
+		}
+	}
+
+	return []*comparator{{operator: operator, version: version.Version}}, nil
+}
+
+// parseAny handles the wildcards like * and >=x, where the comparators that can not be satisfied as <* or >* will
+// never match, and the others match any version
+func parseAny(operator string) ([]*comparator, error) {
+	switch operator {
+	case "", "=", "==", ">=", "<=", "^", "~", "~>":
+		return []*comparator{}, nil
+	case "<", ">":
+		return []*comparator{{operator: "<", version: &Version{}}}, nil
+	}
+
+	return nil, enums.ErrorInvalidConstraint
+}
+
+// toRange returns the comparators greater or equal than the version and lower than the upper limit, or equal to the
+// version when it is a complete version without an upper limit
+func (p *partialVersion) toRange(upper *Version) []*comparator {
+	if upper == nil {
+		return []*comparator{{operator: "=", version: p.Version}}
+	}
+
+	return []*comparator{{operator: ">=", version: p.Version}, {operator: "<", version: upper}}
+}
+
+func (p *partialVersion) toExclusion() ([]*comparator, error) {
+	if p.components < 3 {
+		return nil, enums.ErrorInvalidConstraint
+	}
+
+	return []*comparator{{operator: "!=", version: p.Version}}, nil
+}
+
+// nextComponent returns the upper limit of the partial versions, where 1 is lower than 2.0.0 and 1.2 is lower than
+// 1.3.0, and nil to the complete versions
+func (p *partialVersion) nextComponent() *Version {
+	switch p.components {
+	case 1:
+		return &Version{Major: p.Major + 1}
+	case 2:
+		return &Version{Major: p.Major, Minor: p.Minor + 1}
+	}
+
+	return nil
+}
+
+// nextCaret allows the changes that do not modify the leftmost non-zero component, where ^1.2.3 is lower than 2.0.0,
+// ^0.2.3 is lower than 0.3.0 and ^0.0.3 is lower than 0.0.4
+func (p *partialVersion) nextCaret() *Version {
+	switch {
+	case p.Major > 0 || p.components == 1:
+		return &Version{Major: p.Major + 1}
+	case p.Minor > 0 || p.components == 2:
+		return &Version{Minor: p.Minor + 1}
+	}
+
+	return &Version{Patch: p.Patch + 1}
+}
+
+// nextTilde allows the patch changes when the minor is informed, where ~1.2.3 and ~1.2 are lower than 1.3.0 and ~1
+// is lower than 2.0.0
+func (p *partialVersion) nextTilde() *Version {
+	if p.components == 1 {
+		return &Version{Major: p.Major + 1}
+	}
+
+	return &Version{Major: p.Major, Minor: p.Minor + 1}
+}
+
+// nextPessimistic increments the component before the last informed one, where ~>1.2.3 is lower than 1.3.0 and ~>1.2
+// is lower than 2.0.0
+func (p *partialVersion) nextPessimistic() *Version {
+	if p.components == 3 {
+		return &Version{Major: p.Major, Minor: p.Minor + 1}
+	}
+
+	return &Version{Major: p.Major + 1}
+}
+
+// Check returns true when the version matches all the comparators of any of the sets
+func (c *Constraint) Check(version *Version) bool {
+	for _, set := range c.sets {
+		if c.checkSet(set, version) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CheckString parses the version and checks it against the constraint
+func (c *Constraint) CheckString(value string) (bool, error) {
+	version, err := Parse(value)
+	if err != nil {
+		return false, err
+	}
+
+	return c.Check(version), nil
+}
+
+func (c *Constraint) checkSet(set []*comparator, version *Version) bool {
+	for _, comparator := range set {
+		if !comparator.check(version) {
+			return false
+		}
+	}
+
+	if !version.IsPrerelease() || c.IncludePrerelease {
+		return true
+	}
+
+	for _, comparator := range set {
+		if comparator.version.IsPrerelease() && comparator.version.Major == version.Major &&
+			comparator.version.Minor == version.Minor && comparator.version.Patch == version.Patch {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *Constraint) String() string {
+	return c.value
+}
+
+func (c *comparator) check(version *Version) bool {
+	result := version.Compare(c.version)
+
+	switch c.operator {
+	case ">":
+		return result > 0
+	case ">=":
+		return result >= 0
+	case "<":
+		return result < 0
+	case "<=":
+		return result <= 0
+	case "!=":
+		return result != 0
+	}
+
+	return result == 0
+}
@@ -0,0 +1,118 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/semver/enums"
+)
+
+func assertConstraint(t *testing.T, value string, matches, mismatches []string) {
+	constraint, err := ParseConstraint(value)
+	assert.NoError(t, err, value)
+
+	for _, version := range matches {
+		result, err := constraint.CheckString(version)
+
+		assert.NoError(t, err)
+		assert.True(t, result, "%s should match %s", version, value)
+	}
+
+	for _, version := range mismatches {
+		result, err := constraint.CheckString(version)
+
+		assert.NoError(t, err)
+		assert.False(t, result, "%s should not match %s", version, value)
+	}
+}
+
+func TestParseConstraint(t *testing.T) {
+	t.Run("should match the comparators", func(t *testing.T) {
+		assertConstraint(t, ">= 1.0.0, < 1.2.3 || ==2.0.0 || >3.0.0 <=3.1.0 != 3.0.5",
+			[]string{"1.0.0", "1.2.2", "v2.0.0", "3.0.1", "3.1.0"},
+			[]string{"0.9.9", "1.2.3", "2.0.1", "3.0.0", "3.0.5", "3.1.1"})
+	})
+
+	t.Run("should match the x-ranges", func(t *testing.T) {
+		assertConstraint(t, "1.2.x", []string{"1.2.0", "1.2.99"}, []string{"1.1.9", "1.3.0"})
+		assertConstraint(t, "1", []string{"1.0.0", "1.99.0"}, []string{"0.9.0", "2.0.0"})
+		assertConstraint(t, "*", []string{"0.0.0", "99.0.0"}, nil)
+		assertConstraint(t, "", []string{"1.0.0"}, nil)
+		assertConstraint(t, ">1.2", []string{"1.3.0"}, []string{"1.2.9"})
+		assertConstraint(t, "<=1.2", []string{"1.2.9"}, []string{"1.3.0"})
+		assertConstraint(t, ">=1.2 <1.4", []string{"1.2.0", "1.3.9"}, []string{"1.1.9", "1.4.0"})
+		assertConstraint(t, "<*", nil, []string{"0.0.0", "1.0.0"})
+	})
+
+	t.Run("should match the hyphen ranges", func(t *testing.T) {
+		assertConstraint(t, "1.2.3 - 2.3", []string{"1.2.3", "2.3.9"}, []string{"1.2.2", "2.4.0"})
+	})
+
+	t.Run("should match the caret ranges", func(t *testing.T) {
+		assertConstraint(t, "^1.2.3", []string{"1.2.3", "1.9.0"}, []string{"1.2.2", "2.0.0"})
+		assertConstraint(t, "^0.2.3", []string{"0.2.3", "0.2.9"}, []string{"0.3.0"})
+		assertConstraint(t, "^0.0.3", []string{"0.0.3"}, []string{"0.0.4"})
+		assertConstraint(t, "^0.0", []string{"0.0.9"}, []string{"0.1.0"})
+		assertConstraint(t, "^0", []string{"0.9.0"}, []string{"1.0.0"})
+	})
+
+	t.Run("should match the tilde ranges", func(t *testing.T) {
+		assertConstraint(t, "~1.2.3", []string{"1.2.3", "1.2.9"}, []string{"1.2.2", "1.3.0"})
+		assertConstraint(t, "~1.2", []string{"1.2.0"}, []string{"1.3.0"})
+		assertConstraint(t, "~1", []string{"1.9.0"}, []string{"2.0.0"})
+	})
+
+	t.Run("should match the pessimistic ranges", func(t *testing.T) {
+		assertConstraint(t, "~> 1.2.3", []string{"1.2.9"}, []string{"1.3.0"})
+		assertConstraint(t, "~>1.2", []string{"1.9.0"}, []string{"1.1.0", "2.0.0"})
+	})
+
+	t.Run("should only match the prereleases of the same version of some comparator", func(t *testing.T) {
+		assertConstraint(t, ">=1.2.3-alpha.3 <2.0.0", []string{"1.2.3-alpha.7", "1.2.3"},
+			[]string{"1.2.3-alpha.2", "1.2.4-beta", "2.0.0-rc.1"})
+	})
+
+	t.Run("should match any prerelease when including the prereleases", func(t *testing.T) {
+		constraint, err := ParseConstraint("<2.0.0")
+		assert.NoError(t, err)
+
+		constraint.IncludePrerelease = true
+
+		result, err := constraint.CheckString("2.0.0-rc.1")
+
+		assert.NoError(t, err)
+		assert.True(t, result)
+		assert.Equal(t, "<2.0.0", constraint.String())
+	})
+
+	t.Run("should return error when the constraint is invalid", func(t *testing.T) {
+		for _, value := range []string{">=", "invalid", "1.0.0 - ", "!=1.2", ">=1.0.0 || <a", "!=*"} {
+			_, err := ParseConstraint(value)
+
+			assert.ErrorIs(t, err, enums.ErrorInvalidConstraint, value)
+		}
+	})
+
+	t.Run("should return error when checking an invalid version", func(t *testing.T) {
+		constraint, err := ParseConstraint("^1.0.0")
+		assert.NoError(t, err)
+
+		_, err = constraint.CheckString("invalid")
+		assert.ErrorIs(t, err, enums.ErrorInvalidVersion)
+	})
+}
@@ -0,0 +1,233 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/semver/enums"
+)
+
+// Version is a semantic version, where the build metadata is kept but ignored on the comparisons
+type Version struct {
+	Major      uint64
+	Minor      uint64
+	Patch      uint64
+	Prerelease []string
+	Build      string
+}
+
+// Parse accepts the semantic versions with the v prefix used by the go modules and the tags, and the versions without
+// minor or patch, which are filled with zero, so 1.2 is 1.2.0
+func Parse(value string) (*Version, error) {
+	version, err := parse(value)
+	if err != nil {
+		return nil, err
+	}
+
+	if version.wildcard {
+		return nil, fmt.Errorf("%w: %s", enums.ErrorInvalidVersion, value)
+	}
+
+	return version.Version, nil
+}
+
+type partialVersion struct {
+	*Version
+	// components is the number of numeric components informed, being lower than 3 for the partial versions and the
+	// x-ranges, like 1.2 or 1.2.x
+	components int
+	wildcard   bool
+}
+
+func parse(value string) (*partialVersion, error) {
+	trimmed := strings.TrimPrefix(strings.TrimPrefix(strings.TrimSpace(value), "v"), "V")
+	version := &partialVersion{Version: &Version{}}
+
+	trimmed, version.Build, _ = strings.Cut(trimmed, "+")
+	release, prerelease, hasPrerelease := strings.Cut(trimmed, "-")
+
+	if err := version.parseRelease(release); err != nil {
+		return nil, fmt.Errorf("%w: %s", enums.ErrorInvalidVersion, value)
+	}
+
+	if hasPrerelease {
+		version.Prerelease = strings.Split(prerelease, ".")
+	}
+
+	if !areValidIdentifiers(version.Prerelease) || version.Build != "" &&
+		!areValidIdentifiers(strings.Split(version.Build, ".")) || hasPrerelease && version.components < 3 {
+		return nil, fmt.Errorf("%w: %s", enums.ErrorInvalidVersion, value)
+	}
+
+	return version, nil
+}
+
+// parseRelease stops on the first wildcard, so the components after it are ignored like on 1.x.x
+func (p *partialVersion) parseRelease(release string) error {
+	components := strings.Split(release, ".")
+	if len(components) > 3 {
+		return enums.ErrorInvalidVersion
+	}
+
+	for index, component := range components {
+		if isWildcard(component) {
+			p.wildcard = true
+
+			return nil
+		}
+
+		number, err := strconv.ParseUint(component, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		p.setComponent(index, number)
+	}
+
+	return nil
+}
+
+func (p *partialVersion) setComponent(index int, number uint64) {
+	switch index {
+	case 0:
+		p.Major = number
+	case 1:
+		p.Minor = number
+	default:
+		p.Patch = number
+	}
+
+	p.components++
+}
+
+func isWildcard(component string) bool {
+	return component == "x" || component == "X" || component == "*"
+}
+
+func areValidIdentifiers(identifiers []string) bool {
+	for _, identifier := range identifiers {
+		if identifier == "" || strings.IndexFunc(identifier, func(char rune) bool {
+			return !('a' <= char && char <= 'z' || 'A' <= char && char <= 'Z' || '0' <= char && char <= '9' ||
+				char == '-')
+		}) >= 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v *Version) IsPrerelease() bool {
+	return len(v.Prerelease) > 0
+}
+
+// Compare returns -1, 0 or 1 when the version is lower, equal or greater than the other, where a prerelease is lower
+// than its release and the prerelease identifiers are compared as defined by the semantic versioning
+func (v *Version) Compare(other *Version) int {
+	for _, numbers := range [][2]uint64{{v.Major, other.Major}, {v.Minor, other.Minor}, {v.Patch, other.Patch}} {
+		if result := compareNumbers(numbers[0], numbers[1]); result != 0 {
+			return result
+		}
+	}
+
+	switch {
+	case !v.IsPrerelease() && !other.IsPrerelease():
+		return 0
+	case !v.IsPrerelease():
+		return 1
+	case !other.IsPrerelease():
+		return -1
+	}
+
+	return comparePrerelease(v.Prerelease, other.Prerelease)
+}
+
+func (v *Version) LessThan(other *Version) bool {
+	return v.Compare(other) < 0
+}
+
+func (v *Version) Equal(other *Version) bool {
+	return v.Compare(other) == 0
+}
+
+func (v *Version) String() string {
+	value := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.IsPrerelease() {
+		value += "-" + strings.Join(v.Prerelease, ".")
+	}
+
+	if v.Build != "" {
+		value += "+" + v.Build
+	}
+
+	return value
+}
+
+// Compare parses and compares both versions
+func Compare(first, second string) (int, error) {
+	firstVersion, err := Parse(first)
+	if err != nil {
+		return 0, err
+	}
+
+	secondVersion, err := Parse(second)
+	if err != nil {
+		return 0, err
+	}
+
+	return firstVersion.Compare(secondVersion), nil
+}
+
+// comparePrerelease compares the numeric identifiers by their values, which are lower than the alphanumeric ones,
+// and a shorter prerelease is lower when all its identifiers are equal
+func comparePrerelease(first, second []string) int {
+	for index := 0; index < len(first) && index < len(second); index++ {
+		if result := compareIdentifiers(first[index], second[index]); result != 0 {
+			return result
+		}
+	}
+
+	return compareNumbers(uint64(len(first)), uint64(len(second)))
+}
+
+func compareIdentifiers(first, second string) int {
+	firstNumber, firstErr := strconv.ParseUint(first, 10, 64)
+	secondNumber, secondErr := strconv.ParseUint(second, 10, 64)
+
+	switch {
+	case firstErr == nil && secondErr == nil:
+		return compareNumbers(firstNumber, secondNumber)
+	case firstErr == nil:
+		return -1
+	case secondErr == nil:
+		return 1
+	}
+
+	return strings.Compare(first, second)
+}
+
+func compareNumbers(first, second uint64) int {
+	switch {
+	case first < second:
+		return -1
+	case first > second:
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,99 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/semver/enums"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("should parse the semantic version", func(t *testing.T) {
+		version, err := Parse("v1.2.3-beta.1+build.5")
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1), version.Major)
+		assert.Equal(t, uint64(2), version.Minor)
+		assert.Equal(t, uint64(3), version.Patch)
+		assert.Equal(t, []string{"beta", "1"}, version.Prerelease)
+		assert.Equal(t, "build.5", version.Build)
+		assert.True(t, version.IsPrerelease())
+		assert.Equal(t, "1.2.3-beta.1+build.5", version.String())
+	})
+
+	t.Run("should fill the missing components with zero", func(t *testing.T) {
+		version, err := Parse("1.2")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "1.2.0", version.String())
+		assert.False(t, version.IsPrerelease())
+	})
+
+	t.Run("should return error when the version is invalid", func(t *testing.T) {
+		for _, value := range []string{"", "a.b.c", "1.2.3.4", "1.x", "1.2.3-", "1.2.3-beta..1", "1.2-beta",
+			"1.2.3+build_1", "-1.0.0"} {
+			_, err := Parse(value)
+
+			assert.ErrorIs(t, err, enums.ErrorInvalidVersion, value)
+		}
+	})
+}
+
+func TestCompare(t *testing.T) {
+	t.Run("should sort the versions by the semantic versioning precedence", func(t *testing.T) {
+		ordered := []string{"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta", "1.0.0-beta.2",
+			"1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.0.1", "1.9.0", "1.10.0", "2.0.0"}
+
+		for index := 1; index < len(ordered); index++ {
+			result, err := Compare(ordered[index-1], ordered[index])
+
+			assert.NoError(t, err)
+			assert.Equal(t, -1, result, ordered[index])
+
+			result, err = Compare(ordered[index], ordered[index-1])
+
+			assert.NoError(t, err)
+			assert.Equal(t, 1, result, ordered[index])
+		}
+	})
+
+	t.Run("should ignore the build metadata", func(t *testing.T) {
+		result, err := Compare("1.0.0+build.1", "v1.0.0+build.2")
+
+		assert.NoError(t, err)
+		assert.Zero(t, result)
+	})
+
+	t.Run("should compare using the version methods", func(t *testing.T) {
+		first, _ := Parse("1.0.0")
+		second, _ := Parse("1.0.1")
+
+		assert.True(t, first.LessThan(second))
+		assert.False(t, second.LessThan(first))
+		assert.True(t, first.Equal(first))
+		assert.False(t, first.Equal(second))
+	})
+
+	t.Run("should return error when some version is invalid", func(t *testing.T) {
+		_, err := Compare("invalid", "1.0.0")
+		assert.ErrorIs(t, err, enums.ErrorInvalidVersion)
+
+		_, err = Compare("1.0.0", "invalid")
+		assert.ErrorIs(t, err, enums.ErrorInvalidVersion)
+	})
+}
@@ -0,0 +1,144 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/testutil/enums"
+)
+
+// ContainerOptions configures a disposable container. When the HORUSEC_TEST_REUSE_CONTAINERS env is true the
+// container is started with the reuse name and kept running after the tests, so the next runs start faster.
+type ContainerOptions struct {
+	Image     string
+	Port      string
+	ReuseName string
+	Env       map[string]string
+	Args      []string
+}
+
+type Container struct {
+	Name    string
+	Address string
+}
+
+// StartContainer runs the container with the port published on a random local port, skipping the test when docker
+// is not available, and removes it when the test finishes
+func StartContainer(t testing.TB, options *ContainerOptions) *Container {
+	t.Helper()
+
+	if _, err := exec.LookPath(enums.DockerCommand); err != nil {
+		t.Skip(enums.MessageDockerNotAvailable)
+	}
+
+	container := &Container{Name: enums.ContainerNamePrefix + uuid.New().String()}
+	if env.GetEnvOrDefaultBool(enums.HorusecTestReuseContainers, false) {
+		container.Name = options.ReuseName
+	}
+
+	if !isRunning(container.Name) {
+		startContainer(t, container, options)
+	}
+
+	address, err := getPublishedAddress(container.Name, options.Port)
+	if err != nil {
+		t.Fatalf("%s: %v", enums.MessageFailedToStart, err)
+	}
+
+	container.Address = address
+
+	return container
+}
+
+func startContainer(t testing.TB, container *Container, options *ContainerOptions) {
+	args := []string{"run", "-d", "--name", container.Name, "-p", "127.0.0.1::" + options.Port}
+	for name, value := range options.Env {
+		args = append(args, "-e", name+"="+value)
+	}
+
+	if output, err := docker(append(append(args, options.Image), options.Args...)...); err != nil {
+		t.Fatalf("%s: %v: %s", enums.MessageFailedToStart, err, output)
+	}
+
+	if container.Name == options.ReuseName {
+		return
+	}
+
+	t.Cleanup(func() {
+		if output, err := docker("rm", "-f", "-v", container.Name); err != nil {
+			t.Logf("%s: %v: %s", enums.MessageFailedToRemove, err, output)
+		}
+	})
+}
+
+func isRunning(name string) bool {
+	output, err := docker("inspect", "-f", "{{.State.Running}}", name)
+
+	return err == nil && output == "true"
+}
+
+// getPublishedAddress returns the first address of the port, since docker also publishes it on ipv6 when enabled
+func getPublishedAddress(name, port string) (string, error) {
+	output, err := docker("port", name, port)
+	if err != nil {
+		return "", err
+	}
+
+	for _, line := range strings.Split(output, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			return strings.Replace(line, "0.0.0.0", "127.0.0.1", 1), nil
+		}
+	}
+
+	return "", enums.ErrorContainerPortNotFound
+}
+
+func docker(args ...string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), enums.ContainerReadyLimit)
+	defer cancel()
+
+	// nolint:gosec // the arguments are built by the test helpers
+	output, err := exec.CommandContext(ctx, enums.DockerCommand, args...).CombinedOutput()
+
+	return strings.TrimSpace(string(output)), err
+}
+
+// WaitReady calls the check until it succeeds, failing the test when the timeout is reached
+func WaitReady(t testing.TB, timeout time.Duration, check func() error) {
+	t.Helper()
+
+	deadline := time.Now().Add(timeout)
+
+	for {
+		err := check()
+		if err == nil {
+			return
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("%s: %v", enums.MessageContainerNotReady, err)
+		}
+
+		time.Sleep(enums.ContainerReadyPeriod)
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/testutil/enums"
+)
+
+const fakeDocker = `#!/bin/sh
+echo "$@" >> "$FAKE_DOCKER_LOG"
+case "$1" in
+	inspect) [ "$FAKE_DOCKER_RUNNING" = "true" ] && echo true && exit 0; exit 1 ;;
+	port) printf '0.0.0.0:49153\n[::]:49153\n' ;;
+	run) echo container-id ;;
+esac
+`
+
+// useFakeDocker replaces the docker command by a script that logs the calls, returning the path of the log
+func useFakeDocker(t *testing.T) string {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, enums.DockerCommand), []byte(fakeDocker), 0o700))
+
+	t.Setenv("PATH", dir)
+	t.Setenv("FAKE_DOCKER_LOG", filepath.Join(dir, "calls.log"))
+
+	return filepath.Join(dir, "calls.log")
+}
+
+func readCalls(t *testing.T, path string) []string {
+	content, err := os.ReadFile(path)
+	assert.NoError(t, err)
+
+	return strings.Split(strings.TrimSpace(string(content)), "\n")
+}
+
+func TestStartContainer(t *testing.T) {
+	options := &ContainerOptions{Image: "postgres", Port: "5432/tcp", ReuseName: "horusec-test-postgres",
+		Env: map[string]string{"POSTGRES_DB": "test"}}
+
+	t.Run("should start container and remove it after the test", func(t *testing.T) {
+		log := useFakeDocker(t)
+
+		var container *Container
+
+		t.Run("test", func(t *testing.T) {
+			container = StartContainer(t, options)
+		})
+
+		calls := readCalls(t, log)
+
+		assert.Equal(t, "127.0.0.1:49153", container.Address)
+		assert.True(t, strings.HasPrefix(container.Name, enums.ContainerNamePrefix))
+		assert.Equal(t, "run -d --name "+container.Name+" -p 127.0.0.1::5432/tcp -e POSTGRES_DB=test postgres",
+			calls[1])
+		assert.Equal(t, "rm -f -v "+container.Name, calls[len(calls)-1])
+	})
+
+	t.Run("should reuse running container and keep it after the test", func(t *testing.T) {
+		log := useFakeDocker(t)
+		t.Setenv(enums.HorusecTestReuseContainers, "true")
+		t.Setenv("FAKE_DOCKER_RUNNING", "true")
+
+		t.Run("test", func(t *testing.T) {
+			assert.Equal(t, "horusec-test-postgres", StartContainer(t, options).Name)
+		})
+
+		assert.Equal(t, []string{"inspect -f {{.State.Running}} horusec-test-postgres",
+			"port horusec-test-postgres 5432/tcp"}, readCalls(t, log))
+	})
+
+	t.Run("should skip test when docker is not available", func(t *testing.T) {
+		t.Setenv("PATH", t.TempDir())
+
+		skipped := false
+
+		t.Run("test", func(t *testing.T) {
+			defer func() {
+				skipped = t.Skipped()
+			}()
+
+			StartContainer(t, options)
+		})
+
+		assert.True(t, skipped)
+	})
+}
+
+func TestWaitReady(t *testing.T) {
+	t.Run("should call check until it succeeds", func(t *testing.T) {
+		calls := 0
+
+		WaitReady(t, time.Minute, func() error {
+			if calls++; calls < 2 {
+				return errors.New("test")
+			}
+
+			return nil
+		})
+
+		assert.Equal(t, 2, calls)
+	})
+}
@@ -0,0 +1,27 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+const (
+	MessageDockerNotAvailable  = "{TESTUTIL} docker is not available, skipping test that needs a container"
+	MessageFailedToStart       = "{ERROR_TESTUTIL} failed to start container"
+	MessageFailedToRemove      = "{ERROR_TESTUTIL} failed to remove container"
+	MessageContainerNotReady   = "{ERROR_TESTUTIL} container was not ready before the timeout"
+	MessageFailedToConnectToDB = "{ERROR_TESTUTIL} failed to connect to the test database"
+	MessageGoldenMismatch      = "{ERROR_TESTUTIL} json does not match golden file %s, run the tests with -update or " +
+		"HORUSEC_TEST_UPDATE_GOLDEN=true to update it"
+	MessageGoldenNotFound = "{ERROR_TESTUTIL} golden file %s not found, run the tests with -update or " +
+		"HORUSEC_TEST_UPDATE_GOLDEN=true to create it"
+)
@@ -0,0 +1,48 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+import "time"
+
+const (
+	HorusecTestReuseContainers = "HORUSEC_TEST_REUSE_CONTAINERS"
+	HorusecTestUpdateGolden    = "HORUSEC_TEST_UPDATE_GOLDEN"
+
+	DockerCommand = "docker"
+
+	PostgresImage        = "postgres:14-alpine"
+	PostgresPort         = "5432/tcp"
+	PostgresUser         = "root"
+	PostgresPassword     = "root"
+	PostgresDatabase     = "horusec_db"
+	PostgresReuseName    = "horusec-test-postgres"
+	PostgresURIFormat    = "postgresql://%s:%s@%s/%s?sslmode=disable"
+	RabbitMQImage        = "rabbitmq:3-alpine"
+	RabbitMQPort         = "5672/tcp"
+	RabbitMQReuseName    = "horusec-test-rabbitmq"
+	RabbitMQUser         = "horusec"
+	RabbitMQPassword     = "horusec"
+	ContainerNamePrefix  = "horusec-test-"
+	ContainerReadyPeriod = 500 * time.Millisecond
+	ContainerReadyLimit  = 90 * time.Second
+
+	TestAccountEmail    = "test@horusec.io"
+	TestAccountUsername = "test"
+
+	GoldenUUIDPlaceholder      = "<uuid>"
+	GoldenTimestampPlaceholder = "<timestamp>"
+	GoldenFilePermission       = 0o600
+	GoldenDirPermission        = 0o750
+)
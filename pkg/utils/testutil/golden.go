@@ -0,0 +1,121 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/testutil/enums"
+)
+
+// nolint:gochecknoglobals // the flag must be registered before the tests parse the command line
+var (
+	updateGolden = flag.Bool("update", false, "update the golden files with the current values")
+	uuidRegex    = regexp.MustCompile(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`)
+	timeRegex    = regexp.MustCompile(`\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})`)
+)
+
+// AssertGoldenJSON compares the value encoded as json with the golden file, showing the diff when they are not
+// equal. Values that are already json, as []byte, string or json.RawMessage, are compared as they are. The uuids
+// and timestamps are replaced by placeholders on both sides, so generated ids and dates do not break the assertion.
+// Running the tests with -update or HORUSEC_TEST_UPDATE_GOLDEN=true writes the golden file instead.
+func AssertGoldenJSON(t testing.TB, got interface{}, path string) bool {
+	t.Helper()
+
+	actual := normalizeGoldenJSON(t, toJSON(t, got))
+
+	if isUpdateGolden() {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), enums.GoldenDirPermission))
+		require.NoError(t, os.WriteFile(path, actual, enums.GoldenFilePermission))
+
+		return true
+	}
+
+	expected, err := os.ReadFile(filepath.Clean(path))
+	if errors.Is(err, fs.ErrNotExist) {
+		return assert.Fail(t, "golden file not found", enums.MessageGoldenNotFound, path)
+	}
+
+	require.NoError(t, err)
+
+	return assert.Equal(t, string(normalizeGoldenJSON(t, expected)), string(actual), enums.MessageGoldenMismatch, path)
+}
+
+func isUpdateGolden() bool {
+	return *updateGolden || env.GetEnvOrDefaultBool(enums.HorusecTestUpdateGolden, false)
+}
+
+func toJSON(t testing.TB, value interface{}) []byte {
+	switch data := value.(type) {
+	case []byte:
+		return data
+	case json.RawMessage:
+		return data
+	case string:
+		return []byte(data)
+	}
+
+	data, err := json.Marshal(value)
+	require.NoError(t, err)
+
+	return data
+}
+
+// normalizeGoldenJSON decodes and encodes the json again, sorting the keys and indenting it, so the golden files are
+// readable on reviews and do not change with the order of the fields
+func normalizeGoldenJSON(t testing.TB, data []byte) []byte {
+	var value interface{}
+
+	require.NoError(t, json.Unmarshal(data, &value), "invalid json: %s", data)
+
+	normalized := &bytes.Buffer{}
+	encoder := json.NewEncoder(normalized)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	require.NoError(t, encoder.Encode(replacePlaceholders(value)))
+
+	return normalized.Bytes()
+}
+
+func replacePlaceholders(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, item := range typed {
+			typed[key] = replacePlaceholders(item)
+		}
+	case []interface{}:
+		for index, item := range typed {
+			typed[index] = replacePlaceholders(item)
+		}
+	case string:
+		return timeRegex.ReplaceAllString(uuidRegex.ReplaceAllString(typed, enums.GoldenUUIDPlaceholder),
+			enums.GoldenTimestampPlaceholder)
+	}
+
+	return value
+}
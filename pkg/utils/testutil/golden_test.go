@@ -0,0 +1,69 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/testutil/enums"
+)
+
+type goldenEntity struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+	Tags      []string  `json:"tags"`
+}
+
+func TestAssertGoldenJSON(t *testing.T) {
+	t.Run("should match golden file ignoring uuids and timestamps", func(t *testing.T) {
+		entity := &goldenEntity{ID: uuid.New(), Name: "test", CreatedAt: time.Now(), Tags: []string{uuid.NewString()}}
+
+		assert.True(t, AssertGoldenJSON(t, entity, "testdata/golden.json"))
+	})
+
+	t.Run("should compare json values without encoding them again", func(t *testing.T) {
+		data := `{"tags":["` + uuid.NewString() + `"],"name":"test","id":"` + uuid.NewString() +
+			`","createdAt":"2021-12-30T23:59:59Z"}`
+
+		assert.True(t, AssertGoldenJSON(t, data, "testdata/golden.json"))
+		assert.True(t, AssertGoldenJSON(t, []byte(data), "testdata/golden.json"))
+	})
+
+	t.Run("should fail when json is different", func(t *testing.T) {
+		assert.False(t, AssertGoldenJSON(&testing.T{}, &goldenEntity{Name: "other"}, "testdata/golden.json"))
+	})
+
+	t.Run("should fail when golden file does not exist", func(t *testing.T) {
+		assert.False(t, AssertGoldenJSON(&testing.T{}, &goldenEntity{}, filepath.Join(t.TempDir(), "test.json")))
+	})
+
+	t.Run("should write golden file when updating", func(t *testing.T) {
+		t.Setenv(enums.HorusecTestUpdateGolden, "true")
+		path := filepath.Join(t.TempDir(), "testdata", "test.json")
+
+		assert.True(t, AssertGoldenJSON(t, map[string]interface{}{"b": 1, "a": uuid.New()}, path))
+
+		data, err := os.ReadFile(path)
+		assert.NoError(t, err)
+		assert.Equal(t, "{\n  \"a\": \"<uuid>\",\n  \"b\": 1\n}\n", string(data))
+	})
+}
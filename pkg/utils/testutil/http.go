@@ -0,0 +1,237 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	versioningEnums "github.com/ZupIT/horusec-devkit/pkg/services/http/versioning/enums"
+	httpEntities "github.com/ZupIT/horusec-devkit/pkg/utils/http/entities"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
+	jwtEntities "github.com/ZupIT/horusec-devkit/pkg/utils/jwt/entities"
+	jwtEnums "github.com/ZupIT/horusec-devkit/pkg/utils/jwt/enums"
+	propagationEnums "github.com/ZupIT/horusec-devkit/pkg/utils/propagation/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/testutil/enums"
+)
+
+// NewTestAccount returns the token data of a new account with the default test email and username
+func NewTestAccount() *jwtEntities.TokenData {
+	return &jwtEntities.TokenData{
+		Email:     enums.TestAccountEmail,
+		Username:  enums.TestAccountUsername,
+		AccountID: uuid.New(),
+	}
+}
+
+// NewTestToken mints a valid jwt signed with the same secret used by the handlers under test
+func NewTestToken(t testing.TB, account *jwtEntities.TokenData, permissions ...string) string {
+	t.Helper()
+
+	token, _, err := jwt.CreateToken(account, permissions)
+	require.NoError(t, err)
+
+	return token
+}
+
+// HTTPClient sends requests straight to the handler under test, like a router or a middleware chain, and keeps the
+// headers set on it for every request
+type HTTPClient struct {
+	t       testing.TB
+	handler http.Handler
+	headers http.Header
+}
+
+func NewHTTPClient(t testing.TB, handler http.Handler) *HTTPClient {
+	return &HTTPClient{t: t, handler: handler, headers: http.Header{}}
+}
+
+// WithAccount returns a copy of the client authenticated with a new test jwt of the account
+func (c *HTTPClient) WithAccount(account *jwtEntities.TokenData, permissions ...string) *HTTPClient {
+	return c.WithToken(NewTestToken(c.t, account, permissions...))
+}
+
+func (c *HTTPClient) WithToken(token string) *HTTPClient {
+	return c.WithHeader(jwtEnums.HorusecJWTHeader, token)
+}
+
+func (c *HTTPClient) WithAPIVersion(version string) *HTTPClient {
+	return c.WithHeader(versioningEnums.HorusecAPIVersionHeader, version)
+}
+
+func (c *HTTPClient) WithRequestID(requestID string) *HTTPClient {
+	return c.WithHeader(propagationEnums.HeaderRequestID, requestID)
+}
+
+// WithHeader returns a copy of the client with the header, so a base client can be shared between tests
+func (c *HTTPClient) WithHeader(key, value string) *HTTPClient {
+	client := &HTTPClient{t: c.t, handler: c.handler, headers: c.headers.Clone()}
+	client.headers.Set(key, value)
+
+	return client
+}
+
+func (c *HTTPClient) Get(path string) *Response {
+	return c.NewRequest(http.MethodGet, path).Do()
+}
+
+func (c *HTTPClient) Post(path string, body interface{}) *Response {
+	return c.NewRequest(http.MethodPost, path).WithJSON(body).Do()
+}
+
+func (c *HTTPClient) Put(path string, body interface{}) *Response {
+	return c.NewRequest(http.MethodPut, path).WithJSON(body).Do()
+}
+
+func (c *HTTPClient) Patch(path string, body interface{}) *Response {
+	return c.NewRequest(http.MethodPatch, path).WithJSON(body).Do()
+}
+
+func (c *HTTPClient) Delete(path string) *Response {
+	return c.NewRequest(http.MethodDelete, path).Do()
+}
+
+func (c *HTTPClient) NewRequest(method, path string) *Request {
+	return &Request{client: c, method: method, path: path, headers: c.headers.Clone(), query: url.Values{}}
+}
+
+// Request builds a request of the client, adding headers, query params and a json body to it
+type Request struct {
+	client  *HTTPClient
+	method  string
+	path    string
+	headers http.Header
+	query   url.Values
+	body    []byte
+}
+
+func (r *Request) WithHeader(key, value string) *Request {
+	r.headers.Set(key, value)
+
+	return r
+}
+
+func (r *Request) WithQuery(key, value string) *Request {
+	r.query.Add(key, value)
+
+	return r
+}
+
+// WithJSON encodes the body as json, a nil body sends the request without body
+func (r *Request) WithJSON(body interface{}) *Request {
+	if body == nil {
+		return r
+	}
+
+	data, err := json.Marshal(body)
+	require.NoError(r.client.t, err)
+
+	r.body = data
+	r.headers.Set("Content-Type", "application/json")
+
+	return r
+}
+
+func (r *Request) Do() *Response {
+	path := r.path
+	if len(r.query) > 0 {
+		path += "?" + r.query.Encode()
+	}
+
+	request := httptest.NewRequest(r.method, path, bytes.NewReader(r.body))
+	for key, values := range r.headers {
+		request.Header[key] = values
+	}
+
+	recorder := httptest.NewRecorder()
+	r.client.handler.ServeHTTP(recorder, request)
+
+	return &Response{t: r.client.t, Recorder: recorder}
+}
+
+// Response has the assertions of the response, every assertion returns the response so they can be chained
+type Response struct {
+	t        testing.TB
+	Recorder *httptest.ResponseRecorder
+}
+
+func (r *Response) Code() int {
+	return r.Recorder.Code
+}
+
+func (r *Response) Body() string {
+	return r.Recorder.Body.String()
+}
+
+func (r *Response) AssertStatus(code int) *Response {
+	r.t.Helper()
+
+	assert.Equal(r.t, code, r.Recorder.Code, "response body: %s", r.Body())
+
+	return r
+}
+
+func (r *Response) AssertHeader(key, value string) *Response {
+	r.t.Helper()
+
+	assert.Equal(r.t, value, r.Recorder.Header().Get(key))
+
+	return r
+}
+
+// BindJSON decodes the whole body into the value
+func (r *Response) BindJSON(value interface{}) *Response {
+	r.t.Helper()
+
+	require.NoError(r.t, json.Unmarshal(r.Recorder.Body.Bytes(), value), "response body: %s", r.Body())
+
+	return r
+}
+
+// BindContent decodes the content of the horusec response entity into the value
+func (r *Response) BindContent(value interface{}) *Response {
+	r.t.Helper()
+
+	content := struct {
+		Content json.RawMessage `json:"content"`
+	}{}
+
+	r.BindJSON(&content)
+	require.NoError(r.t, json.Unmarshal(content.Content, value), "response body: %s", r.Body())
+
+	return r
+}
+
+// AssertResponse compares the code, status and content of the horusec response entity, comparing the content as
+// json so the expected value can be a map or the entity returned by the handler
+func (r *Response) AssertResponse(code int, content interface{}) *Response {
+	r.t.Helper()
+
+	expected := &httpEntities.Response{}
+	expected.SetResponseData(code, http.StatusText(code), content)
+
+	r.AssertStatus(code)
+	assert.JSONEq(r.t, expected.ToString(), r.Body())
+
+	return r
+}
@@ -0,0 +1,119 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	versioningEnums "github.com/ZupIT/horusec-devkit/pkg/services/http/versioning/enums"
+	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
+	jwtEnums "github.com/ZupIT/horusec-devkit/pkg/utils/jwt/enums"
+	propagationEnums "github.com/ZupIT/horusec-devkit/pkg/utils/propagation/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/testutil/enums"
+)
+
+type echoContent struct {
+	Method    string                 `json:"method"`
+	AccountID string                 `json:"accountID"`
+	Email     string                 `json:"email"`
+	Query     string                 `json:"query"`
+	Version   string                 `json:"version"`
+	RequestID string                 `json:"requestID"`
+	Body      map[string]interface{} `json:"body"`
+}
+
+func echoHandler(w http.ResponseWriter, r *http.Request) {
+	claims, err := jwt.DecodeToken(r.Header.Get(jwtEnums.HorusecJWTHeader))
+	if err != nil {
+		httpUtil.StatusUnauthorized(w, err)
+
+		return
+	}
+
+	content := &echoContent{
+		Method: r.Method, AccountID: claims.Subject, Email: claims.Email, Query: r.URL.Query().Get("page"),
+		Version:   r.Header.Get(versioningEnums.HorusecAPIVersionHeader),
+		RequestID: r.Header.Get(propagationEnums.HeaderRequestID),
+	}
+
+	_ = json.NewDecoder(r.Body).Decode(&content.Body)
+
+	w.Header().Set("X-Test", "test")
+	httpUtil.StatusOK(w, content)
+}
+
+func TestNewTestToken(t *testing.T) {
+	t.Run("should mint a valid token of the account", func(t *testing.T) {
+		account := NewTestAccount()
+
+		claims, err := jwt.DecodeToken(NewTestToken(t, account, "admin"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, account.AccountID.String(), claims.Subject)
+		assert.Equal(t, enums.TestAccountEmail, claims.Email)
+		assert.Equal(t, []string{"admin"}, claims.Permissions)
+	})
+}
+
+func TestHTTPClient(t *testing.T) {
+	account := NewTestAccount()
+	client := NewHTTPClient(t, http.HandlerFunc(echoHandler)).WithAccount(account).WithAPIVersion("v2")
+
+	t.Run("should send the request with the token and horusec headers", func(t *testing.T) {
+		content := &echoContent{}
+
+		client.WithRequestID("id").NewRequest(http.MethodGet, "/test").WithQuery("page", "2").Do().
+			AssertStatus(http.StatusOK).AssertHeader("X-Test", "test").BindContent(content)
+
+		assert.Equal(t, account.AccountID.String(), content.AccountID)
+		assert.Equal(t, enums.TestAccountEmail, content.Email)
+		assert.Equal(t, "2", content.Query)
+		assert.Equal(t, "v2", content.Version)
+		assert.Equal(t, "id", content.RequestID)
+	})
+
+	t.Run("should send the json body and assert the horusec response", func(t *testing.T) {
+		for method, send := range map[string]func() *Response{
+			http.MethodPost:  func() *Response { return client.Post("/", map[string]string{"name": "test"}) },
+			http.MethodPut:   func() *Response { return client.Put("/", map[string]string{"name": "test"}) },
+			http.MethodPatch: func() *Response { return client.Patch("/", map[string]string{"name": "test"}) },
+		} {
+			send().AssertResponse(http.StatusOK, map[string]interface{}{
+				"method": method, "accountID": account.AccountID.String(), "email": enums.TestAccountEmail,
+				"query": "", "version": "v2", "requestID": "", "body": map[string]string{"name": "test"},
+			})
+		}
+	})
+
+	t.Run("should bind the whole json response", func(t *testing.T) {
+		response := map[string]interface{}{}
+
+		client.Delete("/").BindJSON(&response)
+
+		assert.Equal(t, float64(http.StatusOK), response["code"])
+	})
+
+	t.Run("should not share headers between clients", func(t *testing.T) {
+		response := NewHTTPClient(t, http.HandlerFunc(echoHandler)).WithToken("invalid").Get("/")
+
+		assert.Equal(t, http.StatusUnauthorized, response.AssertStatus(http.StatusUnauthorized).Code())
+		assert.Contains(t, response.Body(), "Unauthorized")
+	})
+}
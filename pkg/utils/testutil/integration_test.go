@@ -0,0 +1,39 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+)
+
+func TestNewPostgres(t *testing.T) {
+	t.Run("should return available connection of a postgres container", func(t *testing.T) {
+		connection := NewPostgres(t)
+
+		assert.True(t, connection.Write.IsAvailable())
+		assert.True(t, connection.Read.(database.IHealthChecker).HealthCheck(context.Background()).Available)
+	})
+}
+
+func TestNewRabbitMQ(t *testing.T) {
+	t.Run("should return available broker of a rabbitmq container", func(t *testing.T) {
+		assert.True(t, NewRabbitMQ(t).IsAvailable())
+	})
+}
@@ -0,0 +1,77 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseConfig "github.com/ZupIT/horusec-devkit/pkg/services/database/config"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/testutil/enums"
+)
+
+// NewPostgresConfig starts a postgres container and returns the config of its database after it accepts connections
+func NewPostgresConfig(t testing.TB) databaseConfig.IConfig {
+	t.Helper()
+
+	container := StartContainer(t, &ContainerOptions{
+		Image: enums.PostgresImage, Port: enums.PostgresPort, ReuseName: enums.PostgresReuseName,
+		Env: map[string]string{
+			"POSTGRES_USER": enums.PostgresUser, "POSTGRES_PASSWORD": enums.PostgresPassword,
+			"POSTGRES_DB": enums.PostgresDatabase,
+		},
+	})
+
+	config := &databaseConfig.Config{}
+	config.SetDriver(databaseEnums.DriverPostgres)
+	config.SetURI(fmt.Sprintf(enums.PostgresURIFormat, enums.PostgresUser, enums.PostgresPassword,
+		container.Address, enums.PostgresDatabase))
+
+	WaitReady(t, enums.ContainerReadyLimit, func() error {
+		return pingPostgres(config.GetURI())
+	})
+
+	return config
+}
+
+func pingPostgres(uri string) error {
+	connection, err := pgx.Connect(context.Background(), uri)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = connection.Close(context.Background())
+	}()
+
+	return connection.Ping(context.Background())
+}
+
+// NewPostgres returns the read and write connections of a postgres container
+func NewPostgres(t testing.TB) *database.Connection {
+	t.Helper()
+
+	connection, err := database.NewDatabaseReadAndWrite(NewPostgresConfig(t))
+	if err != nil {
+		t.Fatalf("%s: %v", enums.MessageFailedToConnectToDB, err)
+	}
+
+	return connection
+}
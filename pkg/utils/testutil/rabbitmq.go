@@ -0,0 +1,72 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"net"
+	"testing"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	brokerConfig "github.com/ZupIT/horusec-devkit/pkg/services/broker/config"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/testutil/enums"
+)
+
+// NewRabbitMQConfig starts a rabbitmq container with a user allowed to connect from outside of the container, since
+// the default guest user only connects from localhost
+func NewRabbitMQConfig(t testing.TB) brokerConfig.IConfig {
+	t.Helper()
+
+	container := StartContainer(t, &ContainerOptions{
+		Image: enums.RabbitMQImage, Port: enums.RabbitMQPort, ReuseName: enums.RabbitMQReuseName,
+		Env: map[string]string{
+			"RABBITMQ_DEFAULT_USER": enums.RabbitMQUser, "RABBITMQ_DEFAULT_PASS": enums.RabbitMQPassword,
+		},
+	})
+
+	host, port, err := net.SplitHostPort(container.Address)
+	if err != nil {
+		t.Fatalf("%s: %v", enums.MessageFailedToStart, err)
+	}
+
+	config := &brokerConfig.Config{}
+	config.SetHost(host)
+	config.SetPort(port)
+	config.SetUsername(enums.RabbitMQUser)
+	config.SetPassword(enums.RabbitMQPassword)
+
+	return config
+}
+
+// NewRabbitMQ returns a broker connected to a rabbitmq container, waiting until it accepts connections and closing
+// it when the test finishes
+func NewRabbitMQ(t testing.TB) broker.IBroker {
+	t.Helper()
+
+	config := NewRabbitMQConfig(t)
+
+	var connected broker.IBroker
+
+	WaitReady(t, enums.ContainerReadyLimit, func() (err error) {
+		connected, err = broker.NewBroker(config)
+
+		return err
+	})
+
+	t.Cleanup(func() {
+		_ = connected.Close()
+	})
+
+	return connected
+}
@@ -0,0 +1,24 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+import "errors"
+
+var (
+	ErrorInvalidSecret    = errors.New("{ERROR_TOTP} secret must be a valid base32 value")
+	ErrorInvalidCode      = errors.New("{ERROR_TOTP} code is invalid or expired")
+	ErrorInvalidOptions   = errors.New("{ERROR_TOTP} digits must be between 6 and 8 and the period greater than 0")
+	ErrorInvalidAlgorithm = errors.New("{ERROR_TOTP} algorithm must be SHA1, SHA256 or SHA512")
+)
@@ -0,0 +1,43 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enums
+
+import "time"
+
+const (
+	DefaultDigits       = 6
+	DefaultPeriod       = 30 * time.Second
+	DefaultSkew         = 1
+	DefaultSecretLength = 20
+	MinDigits           = 6
+	MaxDigits           = 8
+
+	URLScheme           = "otpauth"
+	URLHost             = "totp"
+	URLParamSecret      = "secret"
+	URLParamIssuer      = "issuer"
+	URLParamAlgorithm   = "algorithm"
+	URLParamDigits      = "digits"
+	URLParamPeriod      = "period"
+	URLLabelSeparator   = ":"
+	DynamicTruncateMask = 0x7fffffff
+
+	DefaultRecoveryCodes      = 10
+	RecoveryCodeLength        = 10
+	RecoveryCodeSeparator     = "-"
+	RecoveryCodeAlphabet      = "23456789abcdefghjkmnpqrstuvwxyz"
+	RecoveryCodeIgnoredRunes  = "- "
+	RecoveryCodeNotFoundIndex = -1
+)
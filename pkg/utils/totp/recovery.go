@@ -0,0 +1,78 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package totp
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"math/big"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/totp/enums"
+)
+
+// GenerateRecoveryCodes returns the codes shown once to the user to log in without the authenticator app, formatted
+// like abcde-fghjk. The alphabet does not contain characters that are easy to confuse, like 0 and o or 1 and l.
+func GenerateRecoveryCodes(count int) ([]string, error) {
+	codes := make([]string, 0, count)
+	alphabetSize := big.NewInt(int64(len(enums.RecoveryCodeAlphabet)))
+
+	for len(codes) < count {
+		builder := strings.Builder{}
+
+		for index := 0; index < enums.RecoveryCodeLength; index++ {
+			if index == enums.RecoveryCodeLength/2 {
+				builder.WriteString(enums.RecoveryCodeSeparator)
+			}
+
+			position, err := rand.Int(rand.Reader, alphabetSize)
+			if err != nil {
+				return nil, err
+			}
+
+			builder.WriteByte(enums.RecoveryCodeAlphabet[position.Int64()])
+		}
+
+		codes = append(codes, builder.String())
+	}
+
+	return codes, nil
+}
+
+// HashRecoveryCode returns the hash that should be stored instead of the code, ignoring the case, the separator and
+// spaces typed by the user
+func HashRecoveryCode(code string) string {
+	normalized := strings.ToLower(code)
+	for _, ignored := range enums.RecoveryCodeIgnoredRunes {
+		normalized = strings.ReplaceAll(normalized, string(ignored), "")
+	}
+
+	return crypto.GenerateSHA256(normalized)
+}
+
+// VerifyRecoveryCode returns the index of the hash that matches the code, or -1 when none matches. Every hash is
+// compared, so the time does not depend on the position of the code. The matched hash must be removed by the caller.
+func VerifyRecoveryCode(code string, hashes []string) int {
+	hashed, found := []byte(HashRecoveryCode(code)), enums.RecoveryCodeNotFoundIndex
+
+	for index, hash := range hashes {
+		if subtle.ConstantTimeCompare(hashed, []byte(hash)) == 1 {
+			found = index
+		}
+	}
+
+	return found
+}
@@ -0,0 +1,57 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package totp
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateRecoveryCodes(t *testing.T) {
+	t.Run("should generate unique formatted codes", func(t *testing.T) {
+		codes, err := GenerateRecoveryCodes(10)
+
+		assert.NoError(t, err)
+		assert.Len(t, codes, 10)
+
+		unique := map[string]bool{}
+		for _, code := range codes {
+			assert.Regexp(t, regexp.MustCompile(`^[2-9a-hjkmnp-z]{5}-[2-9a-hjkmnp-z]{5}$`), code)
+			unique[code] = true
+		}
+
+		assert.Len(t, unique, 10)
+	})
+}
+
+func TestVerifyRecoveryCode(t *testing.T) {
+	t.Run("should return index of matched hash ignoring case and separators", func(t *testing.T) {
+		codes, err := GenerateRecoveryCodes(3)
+		assert.NoError(t, err)
+
+		hashes := make([]string, 0, len(codes))
+		for _, code := range codes {
+			hashes = append(hashes, HashRecoveryCode(code))
+		}
+
+		assert.Equal(t, 1, VerifyRecoveryCode(codes[1], hashes))
+		assert.Equal(t, 2, VerifyRecoveryCode(" "+strings.ToUpper(strings.ReplaceAll(codes[2], "-", "")), hashes))
+		assert.Equal(t, -1, VerifyRecoveryCode("abcde-fghjk", hashes))
+		assert.Equal(t, -1, VerifyRecoveryCode(codes[0], nil))
+	})
+}
@@ -0,0 +1,182 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/sha1" // nolint:gosec // sha1 is the default algorithm of rfc 6238, used by most authenticator apps
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/totp/enums"
+)
+
+// Options configures the codes as defined by rfc 6238. Skew is the number of periods accepted before and after the
+// current one, allowing small differences between the clocks of the server and the device of the user. Most
+// authenticator apps ignore the algorithm, digits and period of the provisioning url, so prefer the defaults.
+type Options struct {
+	Algorithm enums.Algorithm
+	Digits    int
+	Period    time.Duration
+	Skew      int
+}
+
+func NewOptions() *Options {
+	return &Options{
+		Algorithm: enums.SHA1,
+		Digits:    enums.DefaultDigits,
+		Period:    enums.DefaultPeriod,
+		Skew:      enums.DefaultSkew,
+	}
+}
+
+func (o *Options) validate() error {
+	if o.Digits < enums.MinDigits || o.Digits > enums.MaxDigits || o.Period < time.Second {
+		return enums.ErrorInvalidOptions
+	}
+
+	_, err := o.hash()
+
+	return err
+}
+
+func (o *Options) hash() (func() hash.Hash, error) {
+	switch o.Algorithm {
+	case enums.SHA1, "":
+		return sha1.New, nil
+	case enums.SHA256:
+		return sha256.New, nil
+	case enums.SHA512:
+		return sha512.New, nil
+	default:
+		return nil, enums.ErrorInvalidAlgorithm
+	}
+}
+
+// nolint:gochecknoglobals // encoding of the secrets, without padding as expected by the authenticator apps
+var secretEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret returns a random 160 bits secret encoded as base32, which should be stored encrypted
+func GenerateSecret() (string, error) {
+	bytes, err := crypto.GenerateRandomBytes(enums.DefaultSecretLength)
+	if err != nil {
+		return "", err
+	}
+
+	return secretEncoding.EncodeToString(bytes), nil
+}
+
+// ProvisioningURL returns the otpauth url of the secret, shown as a qr code to be scanned by the authenticator apps.
+// The account is usually the email of the user and the issuer the name of the service.
+func ProvisioningURL(issuer, account, secret string, options *Options) string {
+	query := url.Values{}
+	query.Set(enums.URLParamSecret, secret)
+	query.Set(enums.URLParamIssuer, issuer)
+	query.Set(enums.URLParamAlgorithm, string(options.Algorithm))
+	query.Set(enums.URLParamDigits, strconv.Itoa(options.Digits))
+	query.Set(enums.URLParamPeriod, strconv.Itoa(int(options.Period.Seconds())))
+
+	provisioning := url.URL{
+		Scheme:   enums.URLScheme,
+		Host:     enums.URLHost,
+		Path:     "/" + issuer + enums.URLLabelSeparator + account,
+		RawQuery: query.Encode(),
+	}
+
+	return provisioning.String()
+}
+
+// GenerateCode returns the code of the secret for the period of the time
+func GenerateCode(secret string, now time.Time, options *Options) (string, error) {
+	if err := options.validate(); err != nil {
+		return "", err
+	}
+
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+
+	return generateCode(key, counter(now, options), options), nil
+}
+
+// Verify checks the code against the current period and the skew periods around it, returning the counter of the
+// matched period. The caller should store the last counter used by the account and reject codes with a counter
+// lower or equal than it, so a code can not be used twice.
+func Verify(secret, code string, now time.Time, options *Options) (int64, error) {
+	if err := options.validate(); err != nil {
+		return 0, err
+	}
+
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return 0, err
+	}
+
+	code = strings.TrimSpace(code)
+	current := counter(now, options)
+
+	for offset := -int64(options.Skew); offset <= int64(options.Skew); offset++ {
+		expected := generateCode(key, current+offset, options)
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return current + offset, nil
+		}
+	}
+
+	return 0, enums.ErrorInvalidCode
+}
+
+// decodeSecret accepts lower case secrets and secrets with spaces, as they are usually typed by the users
+func decodeSecret(secret string) ([]byte, error) {
+	normalized := strings.ToUpper(strings.ReplaceAll(strings.TrimRight(secret, "="), " ", ""))
+
+	key, err := secretEncoding.DecodeString(normalized)
+	if err != nil || len(key) == 0 {
+		return nil, enums.ErrorInvalidSecret
+	}
+
+	return key, nil
+}
+
+func counter(now time.Time, options *Options) int64 {
+	return now.Unix() / int64(options.Period.Seconds())
+}
+
+// generateCode implements the hotp dynamic truncation of rfc 4226 for the counter
+func generateCode(key []byte, counter int64, options *Options) string {
+	newHash, _ := options.hash()
+	mac := hmac.New(newHash, key)
+
+	message := make([]byte, 8) // nolint:gomnd // counter is an 8 bytes big endian value
+	binary.BigEndian.PutUint64(message, uint64(counter))
+	_, _ = mac.Write(message)
+
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f // nolint:gomnd // the last 4 bits of the hash are the truncation offset
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & enums.DynamicTruncateMask
+
+	return fmt.Sprintf("%0*d", options.Digits, value%uint32(math.Pow10(options.Digits)))
+}
@@ -0,0 +1,161 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package totp
+
+import (
+	"encoding/base32"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/totp/enums"
+)
+
+func encodeTestSecret(value string) string {
+	return base32.StdEncoding.EncodeToString([]byte(value))
+}
+
+func TestGenerateCode(t *testing.T) {
+	t.Run("should generate the codes of rfc 6238 test vectors", func(t *testing.T) {
+		for _, vector := range []struct {
+			algorithm enums.Algorithm
+			secret    string
+			unix      int64
+			code      string
+		}{
+			{algorithm: enums.SHA1, secret: "12345678901234567890", unix: 59, code: "94287082"},
+			{algorithm: enums.SHA1, secret: "12345678901234567890", unix: 1111111109, code: "07081804"},
+			{algorithm: enums.SHA1, secret: "12345678901234567890", unix: 20000000000, code: "65353130"},
+			{algorithm: enums.SHA256, secret: strings.Repeat("1234567890", 3) + "12", unix: 59, code: "46119246"},
+			{algorithm: enums.SHA512, secret: strings.Repeat("1234567890", 6) + "1234", unix: 59, code: "90693936"},
+		} {
+			options := NewOptions()
+			options.Algorithm, options.Digits = vector.algorithm, 8
+
+			code, err := GenerateCode(encodeTestSecret(vector.secret), time.Unix(vector.unix, 0), options)
+
+			assert.NoError(t, err)
+			assert.Equal(t, vector.code, code)
+		}
+	})
+
+	t.Run("should accept lower case secrets with spaces", func(t *testing.T) {
+		secret := encodeTestSecret("12345678901234567890")
+
+		expected, err := GenerateCode(secret, time.Unix(59, 0), NewOptions())
+		assert.NoError(t, err)
+		assert.Equal(t, "287082", expected)
+
+		code, err := GenerateCode(strings.ToLower(secret[:4]+" "+secret[4:]), time.Unix(59, 0), NewOptions())
+		assert.NoError(t, err)
+		assert.Equal(t, expected, code)
+	})
+
+	t.Run("should return error when invalid secret or options", func(t *testing.T) {
+		_, err := GenerateCode("!!!", time.Now(), NewOptions())
+		assert.ErrorIs(t, err, enums.ErrorInvalidSecret)
+
+		_, err = GenerateCode("", time.Now(), NewOptions())
+		assert.ErrorIs(t, err, enums.ErrorInvalidSecret)
+
+		_, err = GenerateCode("JBSWY3DPEHPK3PXP", time.Now(), &Options{Digits: 4, Period: time.Second})
+		assert.ErrorIs(t, err, enums.ErrorInvalidOptions)
+
+		_, err = GenerateCode("JBSWY3DPEHPK3PXP", time.Now(), &Options{Digits: 6})
+		assert.ErrorIs(t, err, enums.ErrorInvalidOptions)
+
+		_, err = GenerateCode("JBSWY3DPEHPK3PXP", time.Now(), &Options{Algorithm: "MD5", Digits: 6, Period: time.Second})
+		assert.ErrorIs(t, err, enums.ErrorInvalidAlgorithm)
+	})
+}
+
+func TestVerify(t *testing.T) {
+	secret, err := GenerateSecret()
+	assert.NoError(t, err)
+
+	now := time.Unix(1600000000, 0)
+
+	t.Run("should accept codes inside the skew window", func(t *testing.T) {
+		for _, offset := range []time.Duration{-30 * time.Second, 0, 30 * time.Second} {
+			code, err := GenerateCode(secret, now.Add(offset), NewOptions())
+			assert.NoError(t, err)
+
+			counter, err := Verify(secret, " "+code+" ", now, NewOptions())
+			assert.NoError(t, err)
+			assert.Equal(t, now.Add(offset).Unix()/30, counter)
+		}
+	})
+
+	t.Run("should reject codes outside the skew window", func(t *testing.T) {
+		for _, offset := range []time.Duration{-60 * time.Second, 60 * time.Second} {
+			code, err := GenerateCode(secret, now.Add(offset), NewOptions())
+			assert.NoError(t, err)
+
+			_, err = Verify(secret, code, now, NewOptions())
+			assert.ErrorIs(t, err, enums.ErrorInvalidCode)
+		}
+	})
+
+	t.Run("should reject invalid codes and secrets", func(t *testing.T) {
+		_, err := Verify(secret, "", now, NewOptions())
+		assert.ErrorIs(t, err, enums.ErrorInvalidCode)
+
+		_, err = Verify("1", "123456", now, NewOptions())
+		assert.ErrorIs(t, err, enums.ErrorInvalidSecret)
+
+		_, err = Verify(secret, "123456", now, &Options{})
+		assert.ErrorIs(t, err, enums.ErrorInvalidOptions)
+	})
+}
+
+func TestGenerateSecret(t *testing.T) {
+	t.Run("should generate random 160 bits secrets without padding", func(t *testing.T) {
+		first, err := GenerateSecret()
+		assert.NoError(t, err)
+
+		second, err := GenerateSecret()
+		assert.NoError(t, err)
+
+		assert.Len(t, first, 32)
+		assert.NotEqual(t, first, second)
+		assert.NotContains(t, first, "=")
+	})
+}
+
+func TestProvisioningURL(t *testing.T) {
+	t.Run("should return otpauth url with label and parameters", func(t *testing.T) {
+		raw := ProvisioningURL("Horusec", "test@horusec.io", "JBSWY3DPEHPK3PXP", NewOptions())
+
+		parsed, err := url.Parse(raw)
+		assert.NoError(t, err)
+		assert.Equal(t, "otpauth", parsed.Scheme)
+		assert.Equal(t, "totp", parsed.Host)
+		assert.Equal(t, "/Horusec:test@horusec.io", parsed.Path)
+		assert.Equal(t, url.Values{
+			"secret": {"JBSWY3DPEHPK3PXP"}, "issuer": {"Horusec"}, "algorithm": {"SHA1"}, "digits": {"6"},
+			"period": {"30"},
+		}, parsed.Query())
+	})
+}
+
+func TestAlgorithm(t *testing.T) {
+	t.Run("should return 3 valid values", func(t *testing.T) {
+		assert.Len(t, enums.Values(), 3)
+		assert.Equal(t, "SHA256", enums.SHA256.ToString())
+	})
+}
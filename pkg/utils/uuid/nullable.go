@@ -0,0 +1,125 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package uuid
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/uuid/enums"
+)
+
+// NullableUUID represents a uuid that may be null, it is marshaled as a json null and saved as a database null when
+// it is not valid, instead of the nil uuid 00000000-0000-0000-0000-000000000000
+type NullableUUID struct {
+	UUID  uuid.UUID
+	Valid bool
+}
+
+func NewNullableUUID(id uuid.UUID) NullableUUID {
+	return NullableUUID{UUID: id, Valid: id != uuid.Nil}
+}
+
+func (n NullableUUID) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte(enums.JSONNull), nil
+	}
+
+	return json.Marshal(n.UUID.String())
+}
+
+// UnmarshalJSON accepts null and empty strings as an invalid uuid
+func (n *NullableUUID) UnmarshalJSON(data []byte) error {
+	if value := string(data); value == enums.JSONNull || value == enums.JSONEmptyValue {
+		*n = NullableUUID{}
+
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	parsed, err := Parse(value)
+	if err != nil {
+		return err
+	}
+
+	*n = NullableUUID{UUID: parsed, Valid: true}
+
+	return nil
+}
+
+func (n *NullableUUID) Scan(value interface{}) error {
+	switch typed := value.(type) {
+	case nil:
+		*n = NullableUUID{}
+
+		return nil
+	case string:
+		return n.scanString(typed)
+	case []byte:
+		if len(typed) == len(uuid.UUID{}) {
+			return n.scanBytes(typed)
+		}
+
+		return n.scanString(string(typed))
+	default:
+		return enums.ErrorInvalidScanValue
+	}
+}
+
+func (n NullableUUID) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.UUID.String(), nil
+}
+
+func (n NullableUUID) GormDataType() string {
+	return enums.GormDataType
+}
+
+func (n *NullableUUID) scanString(value string) error {
+	if value == "" {
+		*n = NullableUUID{}
+
+		return nil
+	}
+
+	parsed, err := Parse(value)
+	if err != nil {
+		return err
+	}
+
+	*n = NullableUUID{UUID: parsed, Valid: true}
+
+	return nil
+}
+
+func (n *NullableUUID) scanBytes(value []byte) error {
+	parsed, err := uuid.FromBytes(value)
+	if err != nil {
+		return err
+	}
+
+	*n = NullableUUID{UUID: parsed, Valid: true}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package uuid
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/uuid/enums"
+)
+
+type nullableTest struct {
+	ID NullableUUID `json:"id"`
+}
+
+func TestNullableUUIDJSON(t *testing.T) {
+	t.Run("should marshal invalid uuid as null", func(t *testing.T) {
+		data, err := json.Marshal(nullableTest{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, `{"id":null}`, string(data))
+	})
+
+	t.Run("should marshal and unmarshal valid uuid", func(t *testing.T) {
+		id := uuid.New()
+
+		data, err := json.Marshal(nullableTest{ID: NewNullableUUID(id)})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"id":"`+id.String()+`"}`, string(data))
+
+		result := nullableTest{}
+		assert.NoError(t, json.Unmarshal(data, &result))
+		assert.Equal(t, NullableUUID{UUID: id, Valid: true}, result.ID)
+	})
+
+	t.Run("should unmarshal null and empty string as invalid uuid", func(t *testing.T) {
+		for _, data := range []string{`{"id":null}`, `{"id":""}`} {
+			result := nullableTest{ID: NewNullableUUID(uuid.New())}
+
+			assert.NoError(t, json.Unmarshal([]byte(data), &result))
+			assert.False(t, result.ID.Valid)
+		}
+	})
+
+	t.Run("should return error when invalid uuid", func(t *testing.T) {
+		assert.ErrorIs(t, json.Unmarshal([]byte(`{"id":"test"}`), &nullableTest{}), enums.ErrorInvalidUUID)
+		assert.Error(t, json.Unmarshal([]byte(`{"id":1}`), &nullableTest{}))
+	})
+}
+
+func TestNullableUUIDSQL(t *testing.T) {
+	t.Run("should scan every supported value", func(t *testing.T) {
+		id := uuid.New()
+
+		for _, value := range []interface{}{id.String(), []byte(id.String()), id[:]} {
+			nullable := NullableUUID{}
+
+			assert.NoError(t, nullable.Scan(value))
+			assert.Equal(t, NullableUUID{UUID: id, Valid: true}, nullable)
+		}
+	})
+
+	t.Run("should scan nil and empty string as invalid uuid", func(t *testing.T) {
+		for _, value := range []interface{}{nil, ""} {
+			nullable := NewNullableUUID(uuid.New())
+
+			assert.NoError(t, nullable.Scan(value))
+			assert.False(t, nullable.Valid)
+		}
+	})
+
+	t.Run("should return error when unsupported value", func(t *testing.T) {
+		assert.ErrorIs(t, (&NullableUUID{}).Scan(1), enums.ErrorInvalidScanValue)
+		assert.ErrorIs(t, (&NullableUUID{}).Scan("test"), enums.ErrorInvalidUUID)
+	})
+
+	t.Run("should return nil value when invalid uuid", func(t *testing.T) {
+		value, err := NullableUUID{}.Value()
+
+		assert.NoError(t, err)
+		assert.Nil(t, value)
+	})
+
+	t.Run("should return string value when valid uuid", func(t *testing.T) {
+		id := uuid.New()
+
+		value, err := NewNullableUUID(id).Value()
+
+		assert.NoError(t, err)
+		assert.Equal(t, id.String(), value)
+		assert.Equal(t, "uuid", NullableUUID{}.GormDataType())
+	})
+}
@@ -0,0 +1,105 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package uuid
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/uuid/enums"
+)
+
+// New generates an ordered uuid v7 when HORUSEC_UUID_VERSION is v7, which keeps the postgres indexes compact since
+// the new ids are inserted at the end, otherwise a random uuid v4
+func New() uuid.UUID {
+	if env.GetEnvOrDefault(enums.HorusecUUIDVersion, enums.DefaultVersion) == enums.VersionOrdered {
+		return NewOrdered()
+	}
+
+	return uuid.New()
+}
+
+// NewOrdered generates a uuid v7, which starts with the creation time in milliseconds
+func NewOrdered() uuid.UUID {
+	return uuid.Must(uuid.NewV7())
+}
+
+// Parse returns ErrorInvalidUUID with the parse error when the id is invalid
+func Parse(id string) (uuid.UUID, error) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w: %s", enums.ErrorInvalidUUID, err.Error())
+	}
+
+	return parsed, nil
+}
+
+// MustParse panics when the id is invalid, so it should only be used with constant ids
+func MustParse(id string) uuid.UUID {
+	parsed, err := Parse(id)
+	if err != nil {
+		panic(err)
+	}
+
+	return parsed
+}
+
+// SafeMustParse runs the functions that call MustParse, returning the panic of an invalid id as error instead of
+// crashing, while the other panics are not recovered
+func SafeMustParse(parse func()) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			recoveredErr, ok := recovered.(error)
+			if !ok || !errors.Is(recoveredErr, enums.ErrorInvalidUUID) {
+				panic(recovered)
+			}
+
+			err = recoveredErr
+		}
+	}()
+
+	parse()
+
+	return nil
+}
+
+// ValidateAll parses every id, returning ErrorInvalidUUID with the position of each invalid id, so the ids given by
+// the user are not repeated on the error
+func ValidateAll(ids []string) ([]uuid.UUID, error) {
+	parsed := make([]uuid.UUID, 0, len(ids))
+	invalid := make([]string, 0)
+
+	for index, id := range ids {
+		current, err := uuid.Parse(id)
+		if err != nil {
+			invalid = append(invalid, strconv.Itoa(index))
+
+			continue
+		}
+
+		parsed = append(parsed, current)
+	}
+
+	if len(invalid) > 0 {
+		return nil, fmt.Errorf("%w at positions %s", enums.ErrorInvalidUUID, strings.Join(invalid, ", "))
+	}
+
+	return parsed, nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package uuid
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/uuid/enums"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("should generate random uuid by default", func(t *testing.T) {
+		assert.Equal(t, uuid.Version(4), New().Version())
+	})
+
+	t.Run("should generate ordered uuid when version is v7", func(t *testing.T) {
+		t.Setenv(enums.HorusecUUIDVersion, enums.VersionOrdered)
+
+		first := New()
+		second := New()
+
+		assert.Equal(t, uuid.Version(7), first.Version())
+		assert.LessOrEqual(t, first.String()[:8], second.String()[:8])
+	})
+}
+
+func TestMustParse(t *testing.T) {
+	t.Run("should parse valid uuid", func(t *testing.T) {
+		id := uuid.New()
+
+		assert.Equal(t, id, MustParse(id.String()))
+	})
+
+	t.Run("should panic with invalid uuid error", func(t *testing.T) {
+		assert.PanicsWithError(t, "{ERROR_UUID} invalid uuid: invalid UUID length: 4", func() {
+			MustParse("test")
+		})
+	})
+}
+
+func TestSafeMustParse(t *testing.T) {
+	t.Run("should return nil when every uuid is valid", func(t *testing.T) {
+		assert.NoError(t, SafeMustParse(func() {
+			MustParse(uuid.NewString())
+		}))
+	})
+
+	t.Run("should return invalid uuid error instead of panic", func(t *testing.T) {
+		err := SafeMustParse(func() {
+			MustParse("test")
+		})
+
+		assert.ErrorIs(t, err, enums.ErrorInvalidUUID)
+	})
+
+	t.Run("should not recover other panics", func(t *testing.T) {
+		assert.Panics(t, func() {
+			_ = SafeMustParse(func() {
+				panic(errors.New("test"))
+			})
+		})
+	})
+}
+
+func TestValidateAll(t *testing.T) {
+	t.Run("should return every parsed uuid", func(t *testing.T) {
+		first, second := uuid.New(), uuid.New()
+
+		ids, err := ValidateAll([]string{first.String(), second.String()})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{first, second}, ids)
+	})
+
+	t.Run("should return the positions of the invalid uuids", func(t *testing.T) {
+		ids, err := ValidateAll([]string{"test", uuid.NewString(), ""})
+
+		assert.ErrorIs(t, err, enums.ErrorInvalidUUID)
+		assert.Equal(t, "{ERROR_UUID} invalid uuid at positions 0, 2", err.Error())
+		assert.Nil(t, ids)
+	})
+}
@@ -0,0 +1,154 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/mail"
+	"regexp"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/validation/enums"
+)
+
+// nolint:gochecknoglobals // compiled only once since it is used on each email validation
+var emailDomainLabelRegex = regexp.MustCompile(enums.RegexEmailDomainLabel)
+
+type IMXResolver interface {
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+}
+
+// EmailValidator checks the syntax of the address and, when enabled, if its domain has mx records. IsDisposable is
+// a hook for the services that keep a list of disposable domains, it receives the normalized domain.
+type EmailValidator struct {
+	CheckMX      bool
+	Resolver     IMXResolver
+	IsDisposable func(domain string) bool
+}
+
+func NewEmailValidator() *EmailValidator {
+	return &EmailValidator{
+		CheckMX:  env.GetEnvOrDefaultBool(enums.HorusecEmailCheckMX, false),
+		Resolver: net.DefaultResolver,
+	}
+}
+
+func (e *EmailValidator) Validate(ctx context.Context, address string) error {
+	if err := ValidateEmail(address); err != nil {
+		return err
+	}
+
+	domain := emailDomain(NormalizeEmail(address))
+	if e.IsDisposable != nil && e.IsDisposable(domain) {
+		return enums.ErrorDisposableEmail
+	}
+
+	if e.CheckMX {
+		return e.checkMX(ctx, domain)
+	}
+
+	return nil
+}
+
+func (e *EmailValidator) checkMX(ctx context.Context, domain string) error {
+	records, err := e.Resolver.LookupMX(ctx, domain)
+
+	var dnsError *net.DNSError
+	if errors.As(err, &dnsError) && dnsError.IsNotFound {
+		return enums.ErrorEmailDomainWithoutMX
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if len(records) == 0 {
+		return enums.ErrorEmailDomainWithoutMX
+	}
+
+	return nil
+}
+
+// NormalizeEmail trims the address and lowercases its domain, the local part keeps its case since it may be case
+// sensitive on the mail server
+func NormalizeEmail(address string) string {
+	address = strings.TrimSpace(address)
+
+	index := strings.LastIndex(address, "@")
+	if index < 0 {
+		return address
+	}
+
+	return address[:index+1] + strings.ToLower(address[index+1:])
+}
+
+// ValidateEmail only accepts the plain address, without display name or angle brackets, with a domain that has at
+// least two valid labels, so it can be used on the headers of the emails and on the account identification
+func ValidateEmail(address string) error {
+	if address == "" || len(address) > enums.EmailMaxLength {
+		return enums.ErrorInvalidEmail
+	}
+
+	parsed, err := mail.ParseAddress(address)
+	if err != nil || parsed.Address != address {
+		return enums.ErrorInvalidEmail
+	}
+
+	index := strings.LastIndex(address, "@")
+	if index > enums.EmailLocalPartMaxLength || !isValidEmailDomain(address[index+1:]) {
+		return enums.ErrorInvalidEmail
+	}
+
+	return nil
+}
+
+// EmailValidationRules returns the ozzo rules of an email field, like PasswordValidationRules does for passwords
+func EmailValidationRules() []validation.Rule {
+	return []validation.Rule{
+		validation.Required,
+		validation.By(func(value interface{}) error {
+			address, _ := value.(string)
+			if address == "" || ValidateEmail(address) == nil {
+				return nil
+			}
+
+			return validation.NewError(enums.ErrorCodeInvalidEmail, enums.MessageMustBeValidEmail)
+		}),
+	}
+}
+
+func isValidEmailDomain(domain string) bool {
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return false
+	}
+
+	for _, label := range labels {
+		if !emailDomainLabelRegex.MatchString(label) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func emailDomain(address string) string {
+	return address[strings.LastIndex(address, "@")+1:]
+}
@@ -0,0 +1,110 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/validation/enums"
+)
+
+type resolverStub struct {
+	records []*net.MX
+	err     error
+}
+
+func (r *resolverStub) LookupMX(context.Context, string) ([]*net.MX, error) {
+	return r.records, r.err
+}
+
+func TestValidateEmail(t *testing.T) {
+	t.Run("should accept valid addresses", func(t *testing.T) {
+		for _, address := range []string{"test@test.com", "first.last+tag@sub.test-domain.io", "a@xn--bcher-kva.ch"} {
+			assert.NoError(t, ValidateEmail(address), address)
+		}
+	})
+
+	t.Run("should reject invalid addresses", func(t *testing.T) {
+		for _, address := range []string{"", "test", "test@", "@test.com", "test@test", "Test <test@test.com>",
+			"test@-test.com", "test@test..com", "test@test.com\r\nBcc: a@test.com", " test@test.com",
+			strings.Repeat("a", 65) + "@test.com", "test@" + strings.Repeat("a", 250) + ".com"} {
+			assert.ErrorIs(t, ValidateEmail(address), enums.ErrorInvalidEmail, address)
+		}
+	})
+}
+
+func TestNormalizeEmail(t *testing.T) {
+	t.Run("should trim and lowercase only the domain", func(t *testing.T) {
+		assert.Equal(t, "Test.User@test.com", NormalizeEmail("  Test.User@TEST.Com "))
+		assert.Equal(t, "test", NormalizeEmail(" test "))
+	})
+}
+
+func TestEmailValidator(t *testing.T) {
+	t.Run("should read check mx from env", func(t *testing.T) {
+		t.Setenv(enums.HorusecEmailCheckMX, "true")
+
+		assert.True(t, NewEmailValidator().CheckMX)
+	})
+
+	t.Run("should return error when invalid syntax", func(t *testing.T) {
+		assert.ErrorIs(t, NewEmailValidator().Validate(context.Background(), "test"), enums.ErrorInvalidEmail)
+	})
+
+	t.Run("should return error when disposable domain", func(t *testing.T) {
+		validator := &EmailValidator{IsDisposable: func(domain string) bool {
+			return domain == "disposable.com"
+		}}
+
+		assert.ErrorIs(t, validator.Validate(context.Background(), "test@Disposable.com"),
+			enums.ErrorDisposableEmail)
+		assert.NoError(t, validator.Validate(context.Background(), "test@test.com"))
+	})
+
+	t.Run("should check the mx records of the domain", func(t *testing.T) {
+		validator := &EmailValidator{CheckMX: true, Resolver: &resolverStub{records: []*net.MX{{Host: "mx.test.com"}}}}
+		assert.NoError(t, validator.Validate(context.Background(), "test@test.com"))
+
+		validator.Resolver = &resolverStub{}
+		assert.ErrorIs(t, validator.Validate(context.Background(), "test@test.com"), enums.ErrorEmailDomainWithoutMX)
+
+		validator.Resolver = &resolverStub{err: &net.DNSError{IsNotFound: true}}
+		assert.ErrorIs(t, validator.Validate(context.Background(), "test@test.com"), enums.ErrorEmailDomainWithoutMX)
+
+		validator.Resolver = &resolverStub{err: errors.New("test")}
+		assert.EqualError(t, validator.Validate(context.Background(), "test@test.com"), "test")
+	})
+}
+
+func TestEmailValidationRules(t *testing.T) {
+	t.Run("should return validation error when invalid email", func(t *testing.T) {
+		err := validation.Validate("test", EmailValidationRules()...)
+
+		var validationError validation.Error
+		assert.True(t, errors.As(err, &validationError))
+		assert.Equal(t, enums.ErrorCodeInvalidEmail, validationError.Code())
+	})
+
+	t.Run("should return no error when valid email", func(t *testing.T) {
+		assert.NoError(t, validation.Validate("test@test.com", EmailValidationRules()...))
+	})
+}
@@ -16,4 +16,11 @@ package enums
 
 import "errors"
 
-var ErrorInvalidLdapGroup = errors.New("admin ldap group should be a valid one for this user")
+var (
+	ErrorInvalidLdapGroup     = errors.New("admin ldap group should be a valid one for this user")
+	ErrorInvalidEmail         = errors.New("{ERROR_VALIDATION} invalid email address")
+	ErrorDisposableEmail      = errors.New("{ERROR_VALIDATION} email address from a disposable domain")
+	ErrorEmailDomainWithoutMX = errors.New("{ERROR_VALIDATION} email domain does not receive emails")
+	ErrorWeakPassword         = errors.New("{ERROR_VALIDATION} password does not match the password policy")
+	ErrorBreachCheckFailed    = errors.New("{ERROR_VALIDATION} failed to check if password was breached")
+)
@@ -19,4 +19,9 @@ const (
 	MessageMustContainNumericCharacter   = "must contain a numeric character"
 	MessageMustContainUppercaseCharacter = "must contain an uppercase character"
 	MessageMustContainLowercaseCharacter = "must contain a lowercase character"
+	MessageMustBeValidEmail              = "must be a valid email address"
+	MessageMustHaveMinLength             = "the length must be no less than %d"
+	MessageMustHaveMaxLength             = "the length must be no more than %d"
+	MessageMustNotBeCommon               = "must not be a common password"
+	MessageMustNotBeBreached             = "must not be a password exposed on a data breach"
 )
@@ -19,4 +19,21 @@ const (
 	RegexLowercaseCharacter = "[a-z]"
 	RegexNumericCharacter   = "\\d"
 	RegexEspecialCharacter  = "[!@#$&*-._%=+]"
+	RegexEmailDomainLabel   = "^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$"
+
+	HorusecEmailCheckMX     = "HORUSEC_EMAIL_CHECK_MX"
+	ErrorCodeInvalidEmail   = "validation_invalid_email"
+	EmailMaxLength          = 254
+	EmailLocalPartMaxLength = 64
+
+	HorusecPasswordMinLength        = "HORUSEC_PASSWORD_MIN_LENGTH"
+	HorusecPasswordMaxLength        = "HORUSEC_PASSWORD_MAX_LENGTH"
+	HorusecPasswordRequireUppercase = "HORUSEC_PASSWORD_REQUIRE_UPPERCASE"
+	HorusecPasswordRequireLowercase = "HORUSEC_PASSWORD_REQUIRE_LOWERCASE"
+	HorusecPasswordRequireNumeric   = "HORUSEC_PASSWORD_REQUIRE_NUMERIC"
+	HorusecPasswordRequireSpecial   = "HORUSEC_PASSWORD_REQUIRE_SPECIAL"
+	HorusecPasswordDenylist         = "HORUSEC_PASSWORD_DENYLIST"
+	DefaultPasswordMinLength        = 8
+	DefaultPasswordMaxLength        = 255
+	ErrorCodeWeakPassword           = "validation_weak_password"
 )
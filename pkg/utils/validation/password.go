@@ -0,0 +1,190 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	_ "embed" // used to embed the common passwords list
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/password"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/validation/enums"
+)
+
+// nolint:gochecknoglobals // embedded list of the most common passwords
+//
+//go:embed common_passwords.txt
+var commonPasswords string
+
+// nolint:gochecknoglobals // compiled only once since they are used on each password validation
+var (
+	uppercaseRegex = regexp.MustCompile(enums.RegexUppercaseCharacter)
+	lowercaseRegex = regexp.MustCompile(enums.RegexLowercaseCharacter)
+	numericRegex   = regexp.MustCompile(enums.RegexNumericCharacter)
+	specialRegex   = regexp.MustCompile(enums.RegexEspecialCharacter)
+)
+
+type IBreachedPasswordChecker interface {
+	IsBreached(ctx context.Context, password string) (bool, error)
+}
+
+type PasswordRuleFailure struct {
+	Rule    password.Rule `json:"rule"`
+	Message string        `json:"message"`
+}
+
+// PasswordPolicyError has every rule the password failed, so the user can fix all of them at once
+type PasswordPolicyError struct {
+	Failures []PasswordRuleFailure
+}
+
+func (p *PasswordPolicyError) Error() string {
+	messages := make([]string, 0, len(p.Failures))
+	for _, failure := range p.Failures {
+		messages = append(messages, failure.Message)
+	}
+
+	return fmt.Sprintf("%s: %s", enums.ErrorWeakPassword.Error(), strings.Join(messages, ", "))
+}
+
+func (p *PasswordPolicyError) Is(target error) bool {
+	return target == enums.ErrorWeakPassword
+}
+
+// PasswordPolicy is shared by the auth and account services, so both enforce the same rules. The denylist is
+// compared ignoring the case and the breached checker is optional, like a client of a breached passwords api.
+type PasswordPolicy struct {
+	MinLength        int
+	MaxLength        int
+	RequireUppercase bool
+	RequireLowercase bool
+	RequireNumeric   bool
+	RequireSpecial   bool
+	Denylist         []string
+	BreachedChecker  IBreachedPasswordChecker
+}
+
+func NewPasswordPolicy() *PasswordPolicy {
+	return &PasswordPolicy{
+		MinLength:        env.GetEnvOrDefaultInt(enums.HorusecPasswordMinLength, enums.DefaultPasswordMinLength),
+		MaxLength:        env.GetEnvOrDefaultInt(enums.HorusecPasswordMaxLength, enums.DefaultPasswordMaxLength),
+		RequireUppercase: env.GetEnvOrDefaultBool(enums.HorusecPasswordRequireUppercase, true),
+		RequireLowercase: env.GetEnvOrDefaultBool(enums.HorusecPasswordRequireLowercase, true),
+		RequireNumeric:   env.GetEnvOrDefaultBool(enums.HorusecPasswordRequireNumeric, true),
+		RequireSpecial:   env.GetEnvOrDefaultBool(enums.HorusecPasswordRequireSpecial, true),
+		Denylist: append(strings.Fields(commonPasswords),
+			env.GetStringSlice(enums.HorusecPasswordDenylist, nil)...),
+	}
+}
+
+// Validate returns a PasswordPolicyError with the failed rules, the breached checker is only called when the other
+// rules passed, so a weak password is not sent to it
+func (p *PasswordPolicy) Validate(ctx context.Context, value string) error {
+	if failures := p.Check(value); len(failures) > 0 {
+		return &PasswordPolicyError{Failures: failures}
+	}
+
+	if p.BreachedChecker == nil {
+		return nil
+	}
+
+	breached, err := p.BreachedChecker.IsBreached(ctx, value)
+	if err != nil {
+		return fmt.Errorf("%w: %s", enums.ErrorBreachCheckFailed, err.Error())
+	}
+
+	if breached {
+		return &PasswordPolicyError{Failures: []PasswordRuleFailure{
+			{Rule: password.Breached, Message: enums.MessageMustNotBeBreached},
+		}}
+	}
+
+	return nil
+}
+
+// Check returns the failed rules without calling the breached checker
+func (p *PasswordPolicy) Check(value string) []PasswordRuleFailure {
+	failures := p.checkLength(value)
+
+	for _, check := range []struct {
+		enabled bool
+		rule    password.Rule
+		regex   *regexp.Regexp
+		message string
+	}{
+		{p.RequireUppercase, password.Uppercase, uppercaseRegex, enums.MessageMustContainUppercaseCharacter},
+		{p.RequireLowercase, password.Lowercase, lowercaseRegex, enums.MessageMustContainLowercaseCharacter},
+		{p.RequireNumeric, password.Numeric, numericRegex, enums.MessageMustContainNumericCharacter},
+		{p.RequireSpecial, password.Special, specialRegex, enums.MessageMustContainEspecialCharacter},
+	} {
+		if check.enabled && !check.regex.MatchString(value) {
+			failures = append(failures, PasswordRuleFailure{Rule: check.rule, Message: check.message})
+		}
+	}
+
+	if p.isDenied(value) {
+		failures = append(failures, PasswordRuleFailure{Rule: password.Common, Message: enums.MessageMustNotBeCommon})
+	}
+
+	return failures
+}
+
+func (p *PasswordPolicy) checkLength(value string) []PasswordRuleFailure {
+	length := utf8.RuneCountInString(value)
+
+	if p.MinLength > 0 && length < p.MinLength {
+		return []PasswordRuleFailure{{Rule: password.MinLength,
+			Message: fmt.Sprintf(enums.MessageMustHaveMinLength, p.MinLength)}}
+	}
+
+	if p.MaxLength > 0 && length > p.MaxLength {
+		return []PasswordRuleFailure{{Rule: password.MaxLength,
+			Message: fmt.Sprintf(enums.MessageMustHaveMaxLength, p.MaxLength)}}
+	}
+
+	return []PasswordRuleFailure{}
+}
+
+func (p *PasswordPolicy) isDenied(value string) bool {
+	for _, denied := range p.Denylist {
+		if strings.EqualFold(value, denied) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Rules returns the ozzo rules of the policy with the message of the first failed rule, the breached checker is not
+// called since the ozzo rules have no context
+func (p *PasswordPolicy) Rules() []validation.Rule {
+	return []validation.Rule{
+		validation.Required,
+		validation.By(func(value interface{}) error {
+			text, _ := value.(string)
+			if failures := p.Check(text); text != "" && len(failures) > 0 {
+				return validation.NewError(enums.ErrorCodeWeakPassword, failures[0].Message)
+			}
+
+			return nil
+		}),
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2021 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/password"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/validation/enums"
+)
+
+type breachedCheckerStub struct {
+	breached bool
+	err      error
+	calls    int
+}
+
+func (b *breachedCheckerStub) IsBreached(context.Context, string) (bool, error) {
+	b.calls++
+
+	return b.breached, b.err
+}
+
+func TestNewPasswordPolicy(t *testing.T) {
+	t.Run("should read policy from env", func(t *testing.T) {
+		t.Setenv(enums.HorusecPasswordMinLength, "12")
+		t.Setenv(enums.HorusecPasswordRequireSpecial, "false")
+		t.Setenv(enums.HorusecPasswordDenylist, "company@2021")
+
+		policy := NewPasswordPolicy()
+
+		assert.Equal(t, 12, policy.MinLength)
+		assert.Equal(t, enums.DefaultPasswordMaxLength, policy.MaxLength)
+		assert.False(t, policy.RequireSpecial)
+		assert.Contains(t, policy.Denylist, "company@2021")
+		assert.Contains(t, policy.Denylist, "password")
+	})
+}
+
+func TestPasswordPolicyValidate(t *testing.T) {
+	t.Run("should return no error when valid password", func(t *testing.T) {
+		checker := &breachedCheckerStub{}
+		policy := NewPasswordPolicy()
+		policy.BreachedChecker = checker
+
+		assert.NoError(t, policy.Validate(context.Background(), "$3cur3Pa$$?"))
+		assert.Equal(t, 1, checker.calls)
+	})
+
+	t.Run("should return every failed rule without calling the breached checker", func(t *testing.T) {
+		checker := &breachedCheckerStub{}
+		policy := NewPasswordPolicy()
+		policy.BreachedChecker = checker
+
+		err := policy.Validate(context.Background(), "pass")
+
+		var policyError *PasswordPolicyError
+		assert.True(t, errors.As(err, &policyError))
+		assert.ErrorIs(t, err, enums.ErrorWeakPassword)
+		assert.Equal(t, []password.Rule{password.MinLength, password.Uppercase, password.Numeric, password.Special},
+			rulesOf(policyError.Failures))
+		assert.Zero(t, checker.calls)
+	})
+
+	t.Run("should return common rule when password is on the denylist", func(t *testing.T) {
+		err := NewPasswordPolicy().Validate(context.Background(), "P@ssw0rd!")
+
+		var policyError *PasswordPolicyError
+		assert.True(t, errors.As(err, &policyError))
+		assert.Equal(t, []password.Rule{password.Common}, rulesOf(policyError.Failures))
+	})
+
+	t.Run("should return max length rule", func(t *testing.T) {
+		policy := &PasswordPolicy{MaxLength: 3}
+
+		assert.Equal(t, []PasswordRuleFailure{{Rule: password.MaxLength,
+			Message: "the length must be no more than 3"}}, policy.Check("test"))
+	})
+
+	t.Run("should return breached rule or checker error", func(t *testing.T) {
+		policy := NewPasswordPolicy()
+		policy.BreachedChecker = &breachedCheckerStub{breached: true}
+
+		err := policy.Validate(context.Background(), "$3cur3Pa$$?")
+		assert.EqualError(t, err, enums.ErrorWeakPassword.Error()+": "+enums.MessageMustNotBeBreached)
+
+		policy.BreachedChecker = &breachedCheckerStub{err: errors.New("test")}
+		assert.ErrorIs(t, policy.Validate(context.Background(), "$3cur3Pa$$?"), enums.ErrorBreachCheckFailed)
+	})
+}
+
+func TestPasswordPolicyRules(t *testing.T) {
+	t.Run("should return the message of the first failed rule", func(t *testing.T) {
+		err := validation.Validate("short", NewPasswordPolicy().Rules()...)
+
+		assert.EqualError(t, err, "the length must be no less than 8")
+	})
+}
+
+func rulesOf(failures []PasswordRuleFailure) []password.Rule {
+	rules := make([]password.Rule, 0, len(failures))
+	for _, failure := range failures {
+		rules = append(rules, failure.Rule)
+	}
+
+	return rules
+}
@@ -15,7 +15,6 @@
 package utils
 
 import (
-	"regexp"
 	"strings"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
@@ -48,18 +47,7 @@ func isInvalidGroup(groups, permissions []string) bool {
 	return true
 }
 
-// nolint // necessary magic number and valid suspicious regex
+// PasswordValidationRules returns the rules of the password policy configured by the environment variables
 func PasswordValidationRules() []validation.Rule {
-	return []validation.Rule{
-		validation.Required,
-		validation.Length(8, 255),
-		validation.Match(regexp.MustCompile(enums.RegexUppercaseCharacter)).
-			Error(enums.MessageMustContainUppercaseCharacter),
-		validation.Match(regexp.MustCompile(enums.RegexLowercaseCharacter)).
-			Error(enums.MessageMustContainLowercaseCharacter),
-		validation.Match(regexp.MustCompile(enums.RegexNumericCharacter)).
-			Error(enums.MessageMustContainNumericCharacter),
-		validation.Match(regexp.MustCompile(enums.RegexEspecialCharacter)).
-			Error(enums.MessageMustContainEspecialCharacter),
-	}
+	return NewPasswordPolicy().Rules()
 }